@@ -0,0 +1,291 @@
+package app
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	"github.com/fetchai/fetchd/x/wasm"
+)
+
+const (
+	// EventTypeFeeSubsidy is emitted when a share of the tx fee was paid from the community pool
+	EventTypeFeeSubsidy  = "fee_subsidy"
+	AttributeKeyFeePayer = "fee_payer"
+)
+
+// FeeDiscountKeeper returns the fee discount of a contract
+type FeeDiscountKeeper interface {
+	GetFeeDiscount(ctx sdk.Context, contractAddr sdk.AccAddress) (sdk.Dec, bool)
+}
+
+// ContractRewardsKeeper accrues the developer share of the fees for executed contracts
+type ContractRewardsKeeper interface {
+	GetDeveloperFeeShare(ctx sdk.Context) sdk.Dec
+	AccrueContractRewards(ctx sdk.Context, feeCollector, contractAddr sdk.AccAddress, amount sdk.Coins) (bool, error)
+}
+
+// CommunityPoolKeeper pays out of the community pool
+type CommunityPoolKeeper interface {
+	DistributeFromFeePool(ctx sdk.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) error
+}
+
+// BaseFeeKeeper returns the min fee of a tx at the network-wide base fee and the value of a fee in its denom.
+// It records the fee of a tx that the fee payer paid and the fee collector kept, the gas refund of the tx is a share of it.
+type BaseFeeKeeper interface {
+	RequiredFee(ctx sdk.Context, gas uint64) sdk.Coins
+	FeeValue(ctx sdk.Context, fee sdk.Coins) sdk.Int
+	SetPaidFee(ctx sdk.Context, txHash []byte, fee sdk.Coins)
+}
+
+// NewAnteHandler returns the sdk default ante handler with the fee deduction replaced by
+// the DiscountedDeductFeeDecorator and the signature verification by the SummarySigVerificationDecorator.
+// The BaseFeeDecorator enforces the base fee of the fee market on top of the min gas prices of the node.
+// See NewDefaultAnteDecorators for the decorators to customize.
+func NewAnteHandler(ak auth.AccountKeeper, supplyKeeper authtypes.SupplyKeeper, discountKeeper FeeDiscountKeeper, rewardsKeeper ContractRewardsKeeper, poolKeeper CommunityPoolKeeper, baseFeeKeeper BaseFeeKeeper, sigGasConsumer ante.SignatureVerificationGasConsumer) sdk.AnteHandler {
+	return NewDefaultAnteDecorators(ak, supplyKeeper, discountKeeper, rewardsKeeper, poolKeeper, baseFeeKeeper, sigGasConsumer).AnteHandler()
+}
+
+// DiscountedDeductFeeDecorator deducts the fee from the fee payer like the sdk DeductFeeDecorator.
+// When all messages of the tx execute contracts with a fee discount set by governance, the discounted share
+// of the fee is paid from the community pool instead. The lowest discount of the executed contracts applies.
+// The discount only applies to the fee required at the base fee of the fee market, so a tx can not draw more from
+// the pool by raising its fee, and there is no subsidy while the fee market is disabled.
+// When the community pool can not cover the discount, the full fee is charged to the fee payer.
+// The developer share of the fee paid by the fee payer, without the subsidy, is then moved from the fee collector to
+// the rewards of the executed contracts. The paid fee without the accrued developer fees is recorded with the
+// BaseFeeKeeper for the gas refund, so a refund never draws on the fees of other txs.
+type DiscountedDeductFeeDecorator struct {
+	ak             auth.AccountKeeper
+	supplyKeeper   authtypes.SupplyKeeper
+	discountKeeper FeeDiscountKeeper
+	rewardsKeeper  ContractRewardsKeeper
+	poolKeeper     CommunityPoolKeeper
+	baseFeeKeeper  BaseFeeKeeper
+}
+
+func NewDiscountedDeductFeeDecorator(ak auth.AccountKeeper, sk authtypes.SupplyKeeper, dk FeeDiscountKeeper, rk ContractRewardsKeeper, pk CommunityPoolKeeper, bk BaseFeeKeeper) DiscountedDeductFeeDecorator {
+	return DiscountedDeductFeeDecorator{
+		ak:             ak,
+		supplyKeeper:   sk,
+		discountKeeper: dk,
+		rewardsKeeper:  rk,
+		poolKeeper:     pk,
+		baseFeeKeeper:  bk,
+	}
+}
+
+func (d DiscountedDeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(ante.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	feeCollector := d.supplyKeeper.GetModuleAddress(auth.FeeCollectorName)
+	if feeCollector == nil {
+		panic(fmt.Sprintf("%s module account has not been set", auth.FeeCollectorName))
+	}
+
+	feePayer := feeTx.FeePayer()
+	feePayerAcc := d.ak.GetAccount(ctx, feePayer)
+	if feePayerAcc == nil {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "fee payer address: %s does not exist", feePayer)
+	}
+
+	fee := feeTx.GetFee()
+	if fee.IsZero() {
+		return next(ctx, tx, simulate)
+	}
+
+	if discount, ok := txFeeDiscount(ctx, d.discountKeeper, tx.GetMsgs()); ok {
+		subsidy := feeSubsidy(subsidisedFee(fee, d.baseFeeKeeper.RequiredFee(ctx, feeTx.GetGas())), discount)
+		if !subsidy.IsZero() && d.poolKeeper.DistributeFromFeePool(ctx, subsidy, feeCollector) == nil {
+			fee = fee.Sub(subsidy)
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				EventTypeFeeSubsidy,
+				sdk.NewAttribute(AttributeKeyFeePayer, feePayer.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, subsidy.String()),
+			))
+		}
+	}
+
+	if !fee.IsZero() {
+		if err := ante.DeductFees(d.supplyKeeper, ctx, feePayerAcc, fee); err != nil {
+			return ctx, err
+		}
+	}
+
+	// the developer fees leave the fee collector, only the rest of the fee is refundable
+	refundable := fee
+	if share := d.rewardsKeeper.GetDeveloperFeeShare(ctx); share.IsPositive() {
+		for _, r := range developerFees(fee, share, tx.GetMsgs()) {
+			accrued, err := d.rewardsKeeper.AccrueContractRewards(ctx, feeCollector, r.contract, r.amount)
+			if err != nil {
+				return ctx, err
+			}
+			if accrued {
+				refundable = refundable.Sub(r.amount)
+			}
+		}
+	}
+	if !refundable.IsZero() {
+		d.baseFeeKeeper.SetPaidFee(ctx, tmhash.Sum(ctx.TxBytes()), refundable)
+	}
+	return next(ctx, tx, simulate)
+}
+
+// BaseFeeDecorator rejects txs whose fee is below the gas limit at the base fee of the fee market. Unlike the min gas
+// prices of the node it is checked in DeliverTx as well, so all nodes accept the same txs. The txs of the genesis and
+// simulations are not checked. Fees in the other fee denoms of the fee market count at their value in its denom.
+type BaseFeeDecorator struct {
+	baseFeeKeeper BaseFeeKeeper
+}
+
+func NewBaseFeeDecorator(bk BaseFeeKeeper) BaseFeeDecorator {
+	return BaseFeeDecorator{baseFeeKeeper: bk}
+}
+
+func (d BaseFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if simulate || ctx.BlockHeight() == 0 {
+		return next(ctx, tx, simulate)
+	}
+	feeTx, ok := tx.(ante.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+	required := d.baseFeeKeeper.RequiredFee(ctx, feeTx.GetGas())
+	if !required.IsZero() && d.baseFeeKeeper.FeeValue(ctx, feeTx.GetFee()).LT(required[0].Amount) {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees at the base fee; got: %s required: %s", feeTx.GetFee(), required)
+	}
+	return next(ctx, tx, simulate)
+}
+
+// SummarySigVerificationDecorator verifies the signatures like the sdk SigVerificationDecorator, but also accepts
+// signatures of the summary sign bytes of the wasm msgs, see wasm.SummarySignBytes. Hardware wallets can sign those
+// when the byte code or contract msgs of the tx exceed the size they can display.
+type SummarySigVerificationDecorator struct {
+	ak auth.AccountKeeper
+}
+
+func NewSummarySigVerificationDecorator(ak auth.AccountKeeper) SummarySigVerificationDecorator {
+	return SummarySigVerificationDecorator{ak: ak}
+}
+
+func (d SummarySigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	// no need to verify signatures on recheck tx
+	if ctx.IsReCheckTx() {
+		return next(ctx, tx, simulate)
+	}
+	stdTx, ok := tx.(auth.StdTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a StdTx")
+	}
+
+	sigs := stdTx.GetSignatures()
+	signerAddrs := stdTx.GetSigners()
+	if len(sigs) != len(signerAddrs) {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid number of signer;  expected: %d, got %d", len(signerAddrs), len(sigs))
+	}
+	for i, sig := range sigs {
+		acc, err := ante.GetSignerAcc(ctx, d.ak, signerAddrs[i])
+		if err != nil {
+			return ctx, err
+		}
+		if simulate {
+			continue
+		}
+		pubKey := acc.GetPubKey()
+		if pubKey == nil {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey on account is not set")
+		}
+		// the account number is 0 for the txs of the genesis, like in the sdk sign bytes
+		var accNum uint64
+		if ctx.BlockHeight() != 0 {
+			accNum = acc.GetAccountNumber()
+		}
+		if !verifyTxSignature(pubKey, sig, ctx.ChainID(), accNum, acc.GetSequence(), stdTx) {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "signature verification failed; verify correct account sequence and chain-id")
+		}
+	}
+	return next(ctx, tx, simulate)
+}
+
+// verifyTxSignature returns true when the signature is valid for the sign bytes or the summary sign bytes of the tx
+func verifyTxSignature(pubKey crypto.PubKey, sig []byte, chainID string, accNum, sequence uint64, tx auth.StdTx) bool {
+	if pubKey.VerifyBytes(auth.StdSignBytes(chainID, accNum, sequence, tx.Fee, tx.Msgs, tx.Memo), sig) {
+		return true
+	}
+	summary, ok := wasm.SummarySignBytes(chainID, accNum, sequence, tx.Fee, tx.Msgs, tx.Memo)
+	return ok && pubKey.VerifyBytes(summary, sig)
+}
+
+type contractFee struct {
+	contract sdk.AccAddress
+	amount   sdk.Coins
+}
+
+// developerFees splits the developer share of the fee evenly over the msgs of the tx and returns the part of every
+// contract execution, rounded down. The parts of other msgs stay with the fee collector.
+func developerFees(fee sdk.Coins, share sdk.Dec, msgs []sdk.Msg) []contractFee {
+	if len(msgs) == 0 {
+		return nil
+	}
+	perMsg := sdk.NewDecCoinsFromCoins(fee...).MulDecTruncate(share).QuoDecTruncate(sdk.NewDec(int64(len(msgs))))
+	amount, _ := perMsg.TruncateDecimal()
+	if amount.IsZero() {
+		return nil
+	}
+	var res []contractFee
+	for _, msg := range msgs {
+		if execMsg, ok := msg.(wasm.MsgExecuteContract); ok {
+			res = append(res, contractFee{contract: execMsg.Contract, amount: amount})
+		}
+	}
+	return res
+}
+
+// txFeeDiscount returns the lowest fee discount of the executed contracts when all msgs are contract executions
+func txFeeDiscount(ctx sdk.Context, k FeeDiscountKeeper, msgs []sdk.Msg) (sdk.Dec, bool) {
+	if len(msgs) == 0 {
+		return sdk.ZeroDec(), false
+	}
+	var lowest sdk.Dec
+	for i, msg := range msgs {
+		execMsg, ok := msg.(wasm.MsgExecuteContract)
+		if !ok {
+			return sdk.ZeroDec(), false
+		}
+		discount, ok := k.GetFeeDiscount(ctx, execMsg.Contract)
+		if !ok {
+			return sdk.ZeroDec(), false
+		}
+		if i == 0 || discount.LT(lowest) {
+			lowest = discount
+		}
+	}
+	return lowest, true
+}
+
+// subsidisedFee returns the part of the fee up to the required fee, per denom
+func subsidisedFee(fee, required sdk.Coins) sdk.Coins {
+	var res sdk.Coins
+	for _, c := range fee {
+		amount := sdk.MinInt(c.Amount, required.AmountOf(c.Denom))
+		if amount.IsPositive() {
+			res = append(res, sdk.NewCoin(c.Denom, amount))
+		}
+	}
+	return res
+}
+
+// feeSubsidy returns the discounted share of the fee, rounded down
+func feeSubsidy(fee sdk.Coins, discount sdk.Dec) sdk.Coins {
+	subsidy, _ := sdk.NewDecCoinsFromCoins(fee...).MulDecTruncate(discount).TruncateDecimal()
+	return subsidy
+}
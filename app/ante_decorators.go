@@ -0,0 +1,143 @@
+package app
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// names of the default ante decorators, in the order they run
+const (
+	AnteSetUpContext      = "set_up_context"
+	AnteMempoolFee        = "mempool_fee"
+	AnteBaseFee           = "base_fee"
+	AnteValidateBasic     = "validate_basic"
+	AnteValidateMemo      = "validate_memo"
+	AnteConsumeTxSizeGas  = "consume_tx_size_gas"
+	AnteSetPubKey         = "set_pub_key"
+	AnteValidateSigCount  = "validate_sig_count"
+	AnteDeductFee         = "deduct_fee"
+	AnteSigGasConsume     = "sig_gas_consume"
+	AnteSigVerification   = "sig_verification"
+	AnteIncrementSequence = "increment_sequence"
+)
+
+// AnteDecorators is the ordered list of named decorators the ante handler is chained from.
+// Custom decorators are inserted relative to the default ones by name.
+type AnteDecorators struct {
+	names      []string
+	decorators []sdk.AnteDecorator
+}
+
+// NewDefaultAnteDecorators returns the decorators of the default ante handler of the app
+func NewDefaultAnteDecorators(ak auth.AccountKeeper, supplyKeeper authtypes.SupplyKeeper, discountKeeper FeeDiscountKeeper, rewardsKeeper ContractRewardsKeeper, poolKeeper CommunityPoolKeeper, baseFeeKeeper BaseFeeKeeper, sigGasConsumer ante.SignatureVerificationGasConsumer) *AnteDecorators {
+	d := &AnteDecorators{}
+	d.mustAppend(AnteSetUpContext, ante.NewSetUpContextDecorator()) // outermost AnteDecorator. SetUpContext must be called first
+	d.mustAppend(AnteMempoolFee, ante.NewMempoolFeeDecorator())
+	d.mustAppend(AnteBaseFee, NewBaseFeeDecorator(baseFeeKeeper))
+	d.mustAppend(AnteValidateBasic, ante.NewValidateBasicDecorator())
+	d.mustAppend(AnteValidateMemo, ante.NewValidateMemoDecorator(ak))
+	d.mustAppend(AnteConsumeTxSizeGas, ante.NewConsumeGasForTxSizeDecorator(ak))
+	d.mustAppend(AnteSetPubKey, ante.NewSetPubKeyDecorator(ak)) // SetPubKeyDecorator must be called before all signature verification decorators
+	d.mustAppend(AnteValidateSigCount, ante.NewValidateSigCountDecorator(ak))
+	d.mustAppend(AnteDeductFee, NewDiscountedDeductFeeDecorator(ak, supplyKeeper, discountKeeper, rewardsKeeper, poolKeeper, baseFeeKeeper))
+	d.mustAppend(AnteSigGasConsume, ante.NewSigGasConsumeDecorator(ak, sigGasConsumer))
+	d.mustAppend(AnteSigVerification, NewSummarySigVerificationDecorator(ak))
+	d.mustAppend(AnteIncrementSequence, ante.NewIncrementSequenceDecorator(ak))
+	return d
+}
+
+// Names returns the names of the decorators in the order they run
+func (d *AnteDecorators) Names() []string {
+	return append([]string(nil), d.names...)
+}
+
+// Append adds the decorator to run after all others
+func (d *AnteDecorators) Append(name string, decorator sdk.AnteDecorator) error {
+	return d.insert(len(d.names), name, decorator)
+}
+
+// InsertBefore adds the decorator to run right before the one of the given name
+func (d *AnteDecorators) InsertBefore(before, name string, decorator sdk.AnteDecorator) error {
+	i, err := d.index(before)
+	if err != nil {
+		return err
+	}
+	return d.insert(i, name, decorator)
+}
+
+// InsertAfter adds the decorator to run right after the one of the given name
+func (d *AnteDecorators) InsertAfter(after, name string, decorator sdk.AnteDecorator) error {
+	i, err := d.index(after)
+	if err != nil {
+		return err
+	}
+	return d.insert(i+1, name, decorator)
+}
+
+// Replace swaps the decorator of the given name, it keeps its position
+func (d *AnteDecorators) Replace(name string, decorator sdk.AnteDecorator) error {
+	i, err := d.index(name)
+	if err != nil {
+		return err
+	}
+	d.decorators[i] = decorator
+	return nil
+}
+
+// Remove drops the decorator of the given name
+func (d *AnteDecorators) Remove(name string) error {
+	i, err := d.index(name)
+	if err != nil {
+		return err
+	}
+	d.names = append(d.names[:i], d.names[i+1:]...)
+	d.decorators = append(d.decorators[:i], d.decorators[i+1:]...)
+	return nil
+}
+
+// AnteHandler chains the decorators to the ante handler
+func (d *AnteDecorators) AnteHandler() sdk.AnteHandler {
+	return sdk.ChainAnteDecorators(d.decorators...)
+}
+
+func (d *AnteDecorators) index(name string) (int, error) {
+	for i, n := range d.names {
+		if n == name {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown ante decorator: %s", name)
+}
+
+func (d *AnteDecorators) insert(i int, name string, decorator sdk.AnteDecorator) error {
+	if name == "" {
+		return fmt.Errorf("empty ante decorator name")
+	}
+	if _, err := d.index(name); err == nil {
+		return fmt.Errorf("duplicate ante decorator: %s", name)
+	}
+	d.names = append(d.names[:i], append([]string{name}, d.names[i:]...)...)
+	d.decorators = append(d.decorators[:i], append([]sdk.AnteDecorator{decorator}, d.decorators[i:]...)...)
+	return nil
+}
+
+func (d *AnteDecorators) mustAppend(name string, decorator sdk.AnteDecorator) {
+	if err := d.Append(name, decorator); err != nil {
+		panic(err)
+	}
+}
+
+// AnteDecoratorsOption customizes the ante decorators of the app before its ante handler is built
+type AnteDecoratorsOption func(*AnteDecorators) error
+
+var anteDecoratorsOptions []AnteDecoratorsOption
+
+// RegisterAnteDecoratorsOption registers the option for all apps created afterwards with NewWasmApp.
+// Node binaries embedding the app register their custom decorators this way, e.g. in an init function.
+func RegisterAnteDecoratorsOption(opt AnteDecoratorsOption) {
+	anteDecoratorsOptions = append(anteDecoratorsOptions, opt)
+}
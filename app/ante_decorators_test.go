@@ -0,0 +1,86 @@
+package app
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingDecorator appends its name to the calls when it runs
+type recordingDecorator struct {
+	name  string
+	calls *[]string
+}
+
+func (d recordingDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	*d.calls = append(*d.calls, d.name)
+	return next(ctx, tx, simulate)
+}
+
+func TestAnteDecorators(t *testing.T) {
+	specs := map[string]struct {
+		mutate   func(d *AnteDecorators, x sdk.AnteDecorator) error
+		expCalls []string
+		expErr   bool
+	}{
+		"unchanged": {
+			mutate:   func(*AnteDecorators, sdk.AnteDecorator) error { return nil },
+			expCalls: []string{"a", "b", "c"},
+		},
+		"append": {
+			mutate:   func(d *AnteDecorators, x sdk.AnteDecorator) error { return d.Append("x", x) },
+			expCalls: []string{"a", "b", "c", "x"},
+		},
+		"insert before": {
+			mutate:   func(d *AnteDecorators, x sdk.AnteDecorator) error { return d.InsertBefore("a", "x", x) },
+			expCalls: []string{"x", "a", "b", "c"},
+		},
+		"insert after": {
+			mutate:   func(d *AnteDecorators, x sdk.AnteDecorator) error { return d.InsertAfter("b", "x", x) },
+			expCalls: []string{"a", "b", "x", "c"},
+		},
+		"replace": {
+			mutate:   func(d *AnteDecorators, x sdk.AnteDecorator) error { return d.Replace("b", x) },
+			expCalls: []string{"a", "x", "c"},
+		},
+		"remove": {
+			mutate:   func(d *AnteDecorators, x sdk.AnteDecorator) error { return d.Remove("a") },
+			expCalls: []string{"b", "c"},
+		},
+		"insert after unknown": {
+			mutate: func(d *AnteDecorators, x sdk.AnteDecorator) error { return d.InsertAfter("y", "x", x) },
+			expErr: true,
+		},
+		"duplicate name": {
+			mutate: func(d *AnteDecorators, x sdk.AnteDecorator) error { return d.InsertBefore("c", "a", x) },
+			expErr: true,
+		},
+		"remove unknown": {
+			mutate: func(d *AnteDecorators, x sdk.AnteDecorator) error { return d.Remove("y") },
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			var calls []string
+			dec := func(name string) sdk.AnteDecorator { return recordingDecorator{name: name, calls: &calls} }
+			d := &AnteDecorators{}
+			for _, name := range []string{"a", "b", "c"} {
+				require.NoError(t, d.Append(name, dec(name)))
+			}
+
+			err := spec.mutate(d, dec("x"))
+			if spec.expErr {
+				require.Error(t, err)
+				assert.Equal(t, []string{"a", "b", "c"}, d.Names())
+				return
+			}
+			require.NoError(t, err)
+			_, err = d.AnteHandler()(sdk.Context{}, nil, false)
+			require.NoError(t, err)
+			assert.Equal(t, spec.expCalls, calls)
+		})
+	}
+}
@@ -0,0 +1,233 @@
+package app
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/fetchai/fetchd/x/wasm"
+)
+
+type mockFeeDiscountKeeper map[string]sdk.Dec
+
+func (m mockFeeDiscountKeeper) GetFeeDiscount(_ sdk.Context, contractAddr sdk.AccAddress) (sdk.Dec, bool) {
+	d, ok := m[contractAddr.String()]
+	return d, ok
+}
+
+func TestTxFeeDiscount(t *testing.T) {
+	var (
+		fullDiscount = sdk.AccAddress(make([]byte, sdk.AddrLen))
+		halfDiscount = sdk.AccAddress(append(make([]byte, sdk.AddrLen-1), 1))
+		noDiscount   = sdk.AccAddress(append(make([]byte, sdk.AddrLen-1), 2))
+		executeMsg   = func(contract sdk.AccAddress) sdk.Msg { return wasm.MsgExecuteContract{Contract: contract} }
+		discounts    = mockFeeDiscountKeeper{
+			fullDiscount.String(): sdk.OneDec(),
+			halfDiscount.String(): sdk.NewDecWithPrec(5, 1),
+		}
+	)
+	specs := map[string]struct {
+		msgs   []sdk.Msg
+		expOK  bool
+		expDec sdk.Dec
+	}{
+		"single contract":           {msgs: []sdk.Msg{executeMsg(fullDiscount)}, expOK: true, expDec: sdk.OneDec()},
+		"lowest discount applies":   {msgs: []sdk.Msg{executeMsg(fullDiscount), executeMsg(halfDiscount)}, expOK: true, expDec: sdk.NewDecWithPrec(5, 1)},
+		"contract without discount": {msgs: []sdk.Msg{executeMsg(fullDiscount), executeMsg(noDiscount)}},
+		"other msg":                 {msgs: []sdk.Msg{executeMsg(fullDiscount), bank.MsgSend{}}},
+		"no msgs":                   {},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			d, ok := txFeeDiscount(sdk.Context{}, discounts, spec.msgs)
+			assert.Equal(t, spec.expOK, ok)
+			if spec.expOK {
+				assert.Equal(t, spec.expDec, d)
+			}
+		})
+	}
+}
+
+func TestFeeSubsidy(t *testing.T) {
+	fee := sdk.NewCoins(sdk.NewInt64Coin("afet", 1001), sdk.NewInt64Coin("stake", 3))
+	assert.Equal(t, fee, feeSubsidy(fee, sdk.OneDec()))
+	// rounded down
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("afet", 500), sdk.NewInt64Coin("stake", 1)), feeSubsidy(fee, sdk.NewDecWithPrec(5, 1)))
+	assert.True(t, feeSubsidy(sdk.NewCoins(sdk.NewInt64Coin("afet", 1)), sdk.NewDecWithPrec(5, 1)).IsZero())
+}
+
+func TestSubsidisedFee(t *testing.T) {
+	required := sdk.NewCoins(sdk.NewInt64Coin("afet", 1000))
+	specs := map[string]struct {
+		fee sdk.Coins
+		exp sdk.Coins
+	}{
+		"below required": {fee: sdk.NewCoins(sdk.NewInt64Coin("afet", 600)), exp: sdk.NewCoins(sdk.NewInt64Coin("afet", 600))},
+		"above required": {fee: sdk.NewCoins(sdk.NewInt64Coin("afet", 1000000)), exp: required},
+		"other denom":    {fee: sdk.NewCoins(sdk.NewInt64Coin("afet", 2000), sdk.NewInt64Coin("uusdc", 500)), exp: required},
+		"no fee":         {},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			assert.Equal(t, spec.exp, subsidisedFee(spec.fee, required))
+		})
+	}
+	assert.Nil(t, subsidisedFee(sdk.NewCoins(sdk.NewInt64Coin("afet", 2000)), nil), "disabled fee market")
+}
+
+func TestDeveloperFees(t *testing.T) {
+	var (
+		contract      = sdk.AccAddress(make([]byte, sdk.AddrLen))
+		otherContract = sdk.AccAddress(append(make([]byte, sdk.AddrLen-1), 1))
+		executeMsg    = func(contract sdk.AccAddress) sdk.Msg { return wasm.MsgExecuteContract{Contract: contract} }
+		fee           = sdk.NewCoins(sdk.NewInt64Coin("afet", 1000), sdk.NewInt64Coin("stake", 3))
+	)
+	specs := map[string]struct {
+		msgs  []sdk.Msg
+		share sdk.Dec
+		exp   []contractFee
+	}{
+		"single execution": {
+			msgs:  []sdk.Msg{executeMsg(contract)},
+			share: sdk.NewDecWithPrec(5, 1),
+			exp:   []contractFee{{contract: contract, amount: sdk.NewCoins(sdk.NewInt64Coin("afet", 500), sdk.NewInt64Coin("stake", 1))}},
+		},
+		"split over msgs": {
+			msgs:  []sdk.Msg{executeMsg(contract), executeMsg(otherContract), bank.MsgSend{}, executeMsg(contract)},
+			share: sdk.OneDec(),
+			exp: []contractFee{
+				{contract: contract, amount: sdk.NewCoins(sdk.NewInt64Coin("afet", 250))},
+				{contract: otherContract, amount: sdk.NewCoins(sdk.NewInt64Coin("afet", 250))},
+				{contract: contract, amount: sdk.NewCoins(sdk.NewInt64Coin("afet", 250))},
+			},
+		},
+		"other msgs only": {
+			msgs:  []sdk.Msg{bank.MsgSend{}},
+			share: sdk.OneDec(),
+		},
+		"rounded down to nothing": {
+			msgs:  []sdk.Msg{executeMsg(contract)},
+			share: sdk.NewDecWithPrec(1, 4),
+		},
+		"no msgs": {
+			share: sdk.OneDec(),
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			assert.Equal(t, spec.exp, developerFees(fee, spec.share, spec.msgs))
+		})
+	}
+}
+
+func TestVerifyTxSignature(t *testing.T) {
+	var (
+		privKey = secp256k1.GenPrivKey()
+		sender  = sdk.AccAddress(privKey.PubKey().Address())
+		fee     = auth.NewStdFee(100000, sdk.NewCoins(sdk.NewInt64Coin("afet", 1000)))
+		execTx  = auth.NewStdTx([]sdk.Msg{wasm.MsgExecuteContract{Sender: sender, Contract: sender, Msg: []byte(`{"release":{}}`)}}, fee, nil, "memo")
+		sendTx  = auth.NewStdTx([]sdk.Msg{bank.MsgSend{FromAddress: sender, ToAddress: sender}}, fee, nil, "memo")
+	)
+	summary := func(tx auth.StdTx) []byte {
+		bz, ok := wasm.SummarySignBytes("testing", 1, 2, tx.Fee, tx.Msgs, tx.Memo)
+		require.True(t, ok)
+		return bz
+	}
+	specs := map[string]struct {
+		srcTx        auth.StdTx
+		srcSignBytes []byte
+		exp          bool
+	}{
+		"sign bytes": {
+			srcTx:        execTx,
+			srcSignBytes: auth.StdSignBytes("testing", 1, 2, fee, execTx.Msgs, execTx.Memo),
+			exp:          true,
+		},
+		"summary sign bytes": {
+			srcTx:        execTx,
+			srcSignBytes: summary(execTx),
+			exp:          true,
+		},
+		"summary of other msg": {
+			srcTx:        execTx,
+			srcSignBytes: summary(auth.NewStdTx([]sdk.Msg{wasm.MsgExecuteContract{Sender: sender, Contract: sender, Msg: []byte(`{}`)}}, fee, nil, "memo")),
+		},
+		"other sequence": {
+			srcTx:        execTx,
+			srcSignBytes: auth.StdSignBytes("testing", 1, 3, fee, execTx.Msgs, execTx.Memo),
+		},
+		"tx without wasm payload": {
+			srcTx:        sendTx,
+			srcSignBytes: auth.StdSignBytes("testing"+wasm.SummaryChainIDSuffix, 1, 2, fee, sendTx.Msgs, sendTx.Memo),
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			sig, err := privKey.Sign(spec.srcSignBytes)
+			require.NoError(t, err)
+			assert.Equal(t, spec.exp, verifyTxSignature(privKey.PubKey(), sig, "testing", 1, 2, spec.srcTx))
+		})
+	}
+}
+
+type mockBaseFeeKeeper struct {
+	baseFee sdk.Dec
+}
+
+func (m mockBaseFeeKeeper) RequiredFee(_ sdk.Context, gas uint64) sdk.Coins {
+	amount := m.baseFee.MulInt64(int64(gas)).Ceil().TruncateInt()
+	return sdk.NewCoins(sdk.NewCoin("afet", amount))
+}
+
+// FeeValue values uusdc at twice the amount of afet
+func (m mockBaseFeeKeeper) FeeValue(_ sdk.Context, fee sdk.Coins) sdk.Int {
+	return fee.AmountOf("afet").Add(fee.AmountOf("uusdc").MulRaw(2))
+}
+
+func (m mockBaseFeeKeeper) SetPaidFee(_ sdk.Context, _ []byte, _ sdk.Coins) {}
+
+func TestBaseFeeDecorator(t *testing.T) {
+	var (
+		afet  = func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("afet", amount)) }
+		ctx   = sdk.Context{}.WithBlockHeight(10)
+		next  = func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+		price = sdk.NewDecWithPrec(1, 2)
+	)
+	specs := map[string]struct {
+		fee      sdk.Coins
+		baseFee  sdk.Dec
+		ctx      sdk.Context
+		simulate bool
+		expErr   bool
+	}{
+		"at base fee":     {fee: afet(1000), baseFee: price, ctx: ctx},
+		"above base fee":  {fee: afet(1001).Add(sdk.NewInt64Coin("ustake", 1)), baseFee: price, ctx: ctx},
+		"below base fee":  {fee: afet(999), baseFee: price, ctx: ctx, expErr: true},
+		"other denom":     {fee: sdk.NewCoins(sdk.NewInt64Coin("ustake", 1000)), baseFee: price, ctx: ctx, expErr: true},
+		"fee denom":       {fee: sdk.NewCoins(sdk.NewInt64Coin("uusdc", 500)), baseFee: price, ctx: ctx},
+		"mixed denoms":    {fee: afet(500).Add(sdk.NewInt64Coin("uusdc", 250)), baseFee: price, ctx: ctx},
+		"below in denom":  {fee: sdk.NewCoins(sdk.NewInt64Coin("uusdc", 499)), baseFee: price, ctx: ctx, expErr: true},
+		"no fee":          {baseFee: price, ctx: ctx, expErr: true},
+		"disabled market": {baseFee: sdk.ZeroDec(), ctx: ctx},
+		"genesis tx":      {baseFee: price, ctx: ctx.WithBlockHeight(0)},
+		"simulation":      {baseFee: price, ctx: ctx, simulate: true},
+		"recheck":         {fee: afet(999), baseFee: price, ctx: ctx.WithIsReCheckTx(true), expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			tx := auth.NewStdTx([]sdk.Msg{bank.MsgSend{}}, auth.NewStdFee(100000, spec.fee), nil, "")
+			_, err := NewBaseFeeDecorator(mockBaseFeeKeeper{baseFee: spec.baseFee}).AnteHandle(spec.ctx, tx, spec.simulate, next)
+			if spec.expErr {
+				assert.True(t, sdkerrors.ErrInsufficientFee.Is(err), "got %v", err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server/api"
 	"github.com/cosmos/cosmos-sdk/simapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/version"
@@ -31,7 +32,28 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/supply"
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
 	upgradeclient "github.com/cosmos/cosmos-sdk/x/upgrade/client"
-
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/fetchai/fetchd/indexer"
+	"github.com/fetchai/fetchd/x/airdrop"
+	airdropclient "github.com/fetchai/fetchd/x/airdrop/client"
+	"github.com/fetchai/fetchd/x/almanac"
+	"github.com/fetchai/fetchd/x/aname"
+	"github.com/fetchai/fetchd/x/auction"
+	"github.com/fetchai/fetchd/x/beacon"
+	"github.com/fetchai/fetchd/x/bridge"
+	"github.com/fetchai/fetchd/x/denom"
+	denomclient "github.com/fetchai/fetchd/x/denom/client"
+	"github.com/fetchai/fetchd/x/did"
+	"github.com/fetchai/fetchd/x/feemarket"
+	"github.com/fetchai/fetchd/x/group"
+	"github.com/fetchai/fetchd/x/htlc"
+	"github.com/fetchai/fetchd/x/liquidity"
+	"github.com/fetchai/fetchd/x/oracle"
+	"github.com/fetchai/fetchd/x/paychan"
+	"github.com/fetchai/fetchd/x/reconciliation"
+	"github.com/fetchai/fetchd/x/vesting"
+	vestingclient "github.com/fetchai/fetchd/x/vesting/client"
 	"github.com/fetchai/fetchd/x/wasm"
 	wasmclient "github.com/fetchai/fetchd/x/wasm/client"
 
@@ -45,6 +67,21 @@ import (
 
 const appName = "WasmApp"
 
+// wasmBytecodeUpgrade is the name of the upgrade plan that stores the wasm code of existing codes in the state and
+// indexes the existing contracts by code and creator
+const wasmBytecodeUpgrade = "wasm-bytecode-in-state"
+
+// nativeModulesUpgrade is the name of the upgrade plan that adds the native modules to existing chains
+const nativeModulesUpgrade = "native-modules"
+
+// nativeModules are the modules added by the nativeModulesUpgrade. Their store keys are their names, the stores are
+// created by the upgrade and their params and state are initialized with the default genesis.
+var nativeModules = []string{
+	denom.ModuleName, bridge.ModuleName, oracle.ModuleName, beacon.ModuleName, almanac.ModuleName, aname.ModuleName,
+	did.ModuleName, paychan.ModuleName, htlc.ModuleName, auction.ModuleName, reconciliation.ModuleName,
+	airdrop.ModuleName, group.ModuleName, liquidity.ModuleName, feemarket.ModuleName,
+}
+
 // We pull these out so we can set them with LDFLAGS in the Makefile
 var (
 	CLIDir       = ".fetchcli"
@@ -111,9 +148,25 @@ var (
 		staking.AppModuleBasic{},
 		mint.AppModuleBasic{},
 		distr.AppModuleBasic{},
-		gov.NewAppModuleBasic(append(wasmclient.ProposalHandlers, paramsclient.ProposalHandler, distr.ProposalHandler, upgradeclient.ProposalHandler)...),
+		gov.NewAppModuleBasic(append(append(append(append(wasmclient.ProposalHandlers, vestingclient.ProposalHandlers...), denomclient.ProposalHandlers...), airdropclient.ProposalHandlers...), paramsclient.ProposalHandler, distr.ProposalHandler, upgradeclient.ProposalHandler)...),
 		params.AppModuleBasic{},
 		wasm.AppModuleBasic{},
+		vesting.AppModuleBasic{},
+		denom.AppModuleBasic{},
+		bridge.AppModuleBasic{},
+		oracle.AppModuleBasic{},
+		beacon.AppModuleBasic{},
+		almanac.AppModuleBasic{},
+		aname.AppModuleBasic{},
+		did.AppModuleBasic{},
+		paychan.AppModuleBasic{},
+		htlc.AppModuleBasic{},
+		auction.AppModuleBasic{},
+		reconciliation.AppModuleBasic{},
+		airdrop.AppModuleBasic{},
+		group.AppModuleBasic{},
+		liquidity.AppModuleBasic{},
+		feemarket.AppModuleBasic{},
 		crisis.AppModuleBasic{},
 		slashing.AppModuleBasic{},
 		supply.AppModuleBasic{},
@@ -129,6 +182,17 @@ var (
 		staking.BondedPoolName:    {supply.Burner, supply.Staking},
 		staking.NotBondedPoolName: {supply.Burner, supply.Staking},
 		gov.ModuleName:            {supply.Burner},
+		denom.ModuleName:          {supply.Burner},
+		bridge.ModuleName:         {supply.Minter, supply.Burner},
+		paychan.ModuleName:        nil,
+		htlc.ModuleName:           nil,
+		auction.ModuleName:        nil,
+		// the module account holds the stake migrated from Ethereum until it is claimed
+		reconciliation.ModuleName: nil,
+		// the module account holds the unclaimed amounts of the open airdrops
+		airdrop.ModuleName: nil,
+		// the module account holds the reserves of the pools and mints and burns their shares
+		liquidity.ModuleName: {supply.Minter, supply.Burner},
 	}
 )
 
@@ -164,25 +228,57 @@ type WasmApp struct {
 	subspaces map[string]params.Subspace
 
 	// keepers
-	accountKeeper  auth.AccountKeeper
-	bankKeeper     bank.Keeper
-	supplyKeeper   supply.Keeper
-	stakingKeeper  staking.Keeper
-	slashingKeeper slashing.Keeper
-	mintKeeper     mint.Keeper
-	distrKeeper    distr.Keeper
-	govKeeper      gov.Keeper
-	crisisKeeper   crisis.Keeper
-	paramsKeeper   params.Keeper
-	evidenceKeeper *evidence.Keeper
-	upgradeKeeper  upgrade.Keeper
-	wasmKeeper     wasm.Keeper
+	accountKeeper   auth.AccountKeeper
+	bankKeeper      bank.Keeper
+	supplyKeeper    supply.Keeper
+	stakingKeeper   staking.Keeper
+	slashingKeeper  slashing.Keeper
+	mintKeeper      mint.Keeper
+	distrKeeper     distr.Keeper
+	govKeeper       gov.Keeper
+	crisisKeeper    crisis.Keeper
+	paramsKeeper    params.Keeper
+	evidenceKeeper  *evidence.Keeper
+	upgradeKeeper   upgrade.Keeper
+	wasmKeeper      wasm.Keeper
+	vestingKeeper   vesting.Keeper
+	denomKeeper     denom.Keeper
+	bridgeKeeper    bridge.Keeper
+	oracleKeeper    oracle.Keeper
+	beaconKeeper    beacon.Keeper
+	almanacKeeper   almanac.Keeper
+	anameKeeper     aname.Keeper
+	didKeeper       did.Keeper
+	paychanKeeper   paychan.Keeper
+	htlcKeeper      htlc.Keeper
+	auctionKeeper   auction.Keeper
+	reconKeeper     reconciliation.Keeper
+	airdropKeeper   airdrop.Keeper
+	groupKeeper     group.Keeper
+	liquidityKeeper liquidity.Keeper
+	feemarketKeeper feemarket.Keeper
 
 	// the module manager
 	mm *module.Manager
 
 	// simulation manager
 	sm *module.SimulationManager
+
+	// node local block statistics, nil when disabled
+	blockStats *blockStatsRecorder
+
+	// node local pending tx limits of CheckTx
+	pendingTxs *pendingTxLimiter
+
+	// the txs of the block in delivery whose unused gas is refunded in the end blocker
+	gasRefunds *gasRefundCollector
+
+	// node local serving of the OpenAPI spec and of GraphQL queries on the API server
+	swagger SwaggerConfig
+	graphQL GraphQLConfig
+
+	// node local streaming of the blocks into a sql database, nil if disabled
+	indexer *blockIndexer
 }
 
 // WasmWrapper allows us to use namespacing in the config file
@@ -209,9 +305,12 @@ func NewWasmApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest b
 		bam.MainStoreKey, auth.StoreKey, staking.StoreKey,
 		supply.StoreKey, mint.StoreKey, distr.StoreKey, slashing.StoreKey,
 		gov.StoreKey, params.StoreKey, evidence.StoreKey, upgrade.StoreKey,
-		wasm.StoreKey,
+		wasm.StoreKey, denom.StoreKey, bridge.StoreKey, oracle.StoreKey, beacon.StoreKey,
+		almanac.StoreKey, aname.StoreKey, did.StoreKey, paychan.StoreKey, htlc.StoreKey,
+		auction.StoreKey, reconciliation.StoreKey, airdrop.StoreKey, group.StoreKey,
+		liquidity.StoreKey, feemarket.StoreKey,
 	)
-	tKeys := sdk.NewTransientStoreKeys(staking.TStoreKey, params.TStoreKey)
+	tKeys := sdk.NewTransientStoreKeys(staking.TStoreKey, params.TStoreKey, feemarket.TStoreKey)
 
 	app := &WasmApp{
 		BaseApp:        bApp,
@@ -234,6 +333,16 @@ func NewWasmApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest b
 	app.subspaces[crisis.ModuleName] = app.paramsKeeper.Subspace(crisis.DefaultParamspace)
 	app.subspaces[evidence.ModuleName] = app.paramsKeeper.Subspace(evidence.DefaultParamspace)
 	app.subspaces[wasm.ModuleName] = app.paramsKeeper.Subspace(wasm.DefaultParamspace)
+	app.subspaces[bridge.ModuleName] = app.paramsKeeper.Subspace(bridge.DefaultParamspace)
+	app.subspaces[oracle.ModuleName] = app.paramsKeeper.Subspace(oracle.DefaultParamspace)
+	app.subspaces[beacon.ModuleName] = app.paramsKeeper.Subspace(beacon.DefaultParamspace)
+	app.subspaces[almanac.ModuleName] = app.paramsKeeper.Subspace(almanac.DefaultParamspace)
+	app.subspaces[aname.ModuleName] = app.paramsKeeper.Subspace(aname.DefaultParamspace)
+	app.subspaces[paychan.ModuleName] = app.paramsKeeper.Subspace(paychan.DefaultParamspace)
+	app.subspaces[htlc.ModuleName] = app.paramsKeeper.Subspace(htlc.DefaultParamspace)
+	app.subspaces[auction.ModuleName] = app.paramsKeeper.Subspace(auction.DefaultParamspace)
+	app.subspaces[liquidity.ModuleName] = app.paramsKeeper.Subspace(liquidity.DefaultParamspace)
+	app.subspaces[feemarket.ModuleName] = app.paramsKeeper.Subspace(feemarket.DefaultParamspace)
 
 	// add keepers
 	app.accountKeeper = auth.NewAccountKeeper(
@@ -291,6 +400,43 @@ func NewWasmApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest b
 	// Set function for obtaining bond denomination in bank
 	app.bankKeeper = bankKeeper.SetBondDenomFunc(app.stakingKeeper.BondDenom)
 
+	// vesting accounts funded by the community pool are created by gov proposals, clawback vesting accounts by
+	// their funders
+	app.vestingKeeper = vesting.NewKeeper(app.accountKeeper, app.bankKeeper, app.distrKeeper)
+	govRouter.AddRoute(vesting.RouterKey, vesting.NewProposalHandler(app.vestingKeeper))
+	// the denom metadata for wallets and clients is set at genesis or by gov proposals
+	app.denomKeeper = denom.NewKeeper(app.cdc, keys[denom.StoreKey], app.supplyKeeper)
+	govRouter.AddRoute(denom.RouterKey, denom.NewProposalHandler(app.denomKeeper))
+	// the airdrops are registered by gov proposals and funded by the community pool, which gets their unclaimed amounts
+	app.airdropKeeper = airdrop.NewKeeper(app.cdc, keys[airdrop.StoreKey], app.supplyKeeper, app.distrKeeper)
+	govRouter.AddRoute(airdrop.RouterKey, airdrop.NewProposalHandler(app.airdropKeeper))
+	// the relayer set of the Ethereum bridge is changed by param change proposals
+	app.bridgeKeeper = bridge.NewKeeper(
+		app.cdc, keys[bridge.StoreKey], app.subspaces[bridge.ModuleName], app.supplyKeeper, app.distrKeeper,
+	)
+	// the bonded validators vote the oracle feeds, which are whitelisted by param change proposals
+	app.oracleKeeper = oracle.NewKeeper(app.cdc, keys[oracle.StoreKey], app.subspaces[oracle.ModuleName], app.stakingKeeper)
+	// the randomness of the blocks is the entropy of the threshold signatures of the validators
+	app.beaconKeeper = beacon.NewKeeper(app.cdc, keys[beacon.StoreKey], app.subspaces[beacon.ModuleName])
+	// the agents register for discovery in the almanac, the registration fees fund the community pool
+	app.almanacKeeper = almanac.NewKeeper(app.cdc, keys[almanac.StoreKey], app.subspaces[almanac.ModuleName], app.distrKeeper)
+	// the names of agents and accounts, the domain fees fund the community pool
+	app.anameKeeper = aname.NewKeeper(app.cdc, keys[aname.StoreKey], app.subspaces[aname.ModuleName], app.distrKeeper)
+	// the DID documents of agents and the revocation registries of their credentials
+	app.didKeeper = did.NewKeeper(app.cdc, keys[did.StoreKey])
+	// the payment channels of agents, the module account holds the deposits
+	app.paychanKeeper = paychan.NewKeeper(app.cdc, keys[paychan.StoreKey], app.subspaces[paychan.ModuleName], app.supplyKeeper)
+	// the hash time locked swaps, the module account holds the locked amounts
+	app.htlcKeeper = htlc.NewKeeper(app.cdc, keys[htlc.StoreKey], app.subspaces[htlc.ModuleName], app.supplyKeeper)
+	app.auctionKeeper = auction.NewKeeper(app.cdc, keys[auction.StoreKey], app.subspaces[auction.ModuleName], app.supplyKeeper)
+	app.reconKeeper = reconciliation.NewKeeper(app.cdc, keys[reconciliation.StoreKey], app.supplyKeeper, app.stakingKeeper)
+	// the group policy accounts execute the msgs of the accepted proposals with the full router, like the contracts
+	app.groupKeeper = group.NewKeeper(app.cdc, keys[group.StoreKey], bApp.Router())
+	app.liquidityKeeper = liquidity.NewKeeper(app.cdc, keys[liquidity.StoreKey], app.subspaces[liquidity.ModuleName], app.supplyKeeper)
+	// the base fee follows the gas used by the blocks and is enforced by the ante handler, the other fee denoms are
+	// valued by the liquidity pools and the fee collector refunds unused gas
+	app.feemarketKeeper = feemarket.NewKeeper(app.cdc, keys[feemarket.StoreKey], tKeys[feemarket.TStoreKey], app.subspaces[feemarket.ModuleName], app.liquidityKeeper, app.supplyKeeper)
+
 	// just re-use the full router - do we want to limit this more?
 	var wasmRouter = bApp.Router()
 	// better way to get this dir???
@@ -303,11 +449,63 @@ func NewWasmApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest b
 		panic("error while reading wasm config: " + err.Error())
 	}
 	wasmConfig := wasmWrap.Wasm
+	if err := wasmConfig.ValidateBasic(); err != nil {
+		panic("invalid wasm config: " + err.Error())
+	}
+	if wasmConfig.CacheDir != "" && !filepath.IsAbs(wasmConfig.CacheDir) {
+		wasmConfig.CacheDir = filepath.Join(homeDir, wasmConfig.CacheDir)
+	}
 
 	// The last arguments can contain custom message handlers, and custom query handlers,
 	// if we want to allow any custom callbacks
 	supportedFeatures := "staking"
-	app.wasmKeeper = wasm.NewKeeper(app.cdc, keys[wasm.StoreKey], app.subspaces[wasm.ModuleName], app.accountKeeper, app.bankKeeper, app.stakingKeeper, wasmRouter, fetchdir, wasmConfig, supportedFeatures, nil, nil)
+	// contracts can call the whitelisted module queriers with a custom {"stargate": {"path": ..., "data": ...}} query
+	// and read the oracle feeds with {"oracle": {"price": {"feed": ...}}}, the block randomness with
+	// {"beacon": {"randomness": {"height": ...}}}, resolve names with {"aname": {"resolve": {"name": ...}}}, DIDs
+	// with {"did": {"resolve": {"did": ...}}}, read auctions with {"auction": {"auction": {"id": ...}}} and the prices
+	// of the liquidity pools with {"liquidity": {"price": {"pool_id": ..., "base_denom": ...}}}, more custom query
+	// routes can be registered here
+	wasmQueries := wasm.NewQueryRegistry().
+		Register(wasm.StargateQueryRoute, wasm.StargateQuerier(app.QueryRouter(), wasm.DefaultStargateWhitelist())).
+		Register(oracle.WasmQueryRoute, oracle.NewWasmQuerier(app.oracleKeeper)).
+		Register(beacon.WasmQueryRoute, beacon.NewWasmQuerier(app.beaconKeeper)).
+		Register(aname.WasmQueryRoute, aname.NewWasmQuerier(app.anameKeeper)).
+		Register(did.WasmQueryRoute, did.NewWasmQuerier(app.didKeeper)).
+		Register(auction.WasmQueryRoute, auction.NewWasmQuerier(app.auctionKeeper)).
+		Register(liquidity.WasmQueryRoute, liquidity.NewWasmQuerier(app.liquidityKeeper))
+	// custom messages of contracts are routed to the native module encoders registered here, contracts create, bid
+	// in and reveal sealed-bid auctions with {"auction": {"create": ...}}, {"auction": {"commit": ...}} and
+	// {"auction": {"reveal": ...}}
+	wasmMsgs := wasm.NewMessageRegistry().
+		Register(auction.WasmMsgRoute, auction.NewWasmEncoder(), auction.WasmMsgGasLimit)
+	app.wasmKeeper = wasm.NewKeeper(app.cdc, keys[wasm.StoreKey], app.subspaces[wasm.ModuleName], app.accountKeeper, app.bankKeeper, app.stakingKeeper, wasmRouter, fetchdir, wasmConfig, supportedFeatures,
+		&wasm.MessageEncoders{Custom: wasmMsgs.Encoder()}, &wasm.QueryPlugins{Custom: wasmQueries.Querier()})
+	// the contract call metrics are served next to the Tendermint metrics, when they are enabled in config.toml
+	if viper.GetBool("instrumentation.prometheus") {
+		app.wasmKeeper.SetMetrics(wasm.PrometheusMetrics(viper.GetString("instrumentation.namespace")))
+	}
+	// the wasm code of chains started before it was kept in the state is copied from the VM cache of the nodes
+	app.upgradeKeeper.SetUpgradeHandler(wasmBytecodeUpgrade, func(ctx sdk.Context, _ upgrade.Plan) {
+		if err := app.wasmKeeper.MigrateBytecodeToState(ctx); err != nil {
+			panic(err)
+		}
+		app.wasmKeeper.RebuildContractIndexes(ctx)
+	})
+	// the native modules start from their default genesis, the stores are added when the node restarts at the
+	// upgrade height with the new binary
+	app.upgradeKeeper.SetUpgradeHandler(nativeModulesUpgrade, func(ctx sdk.Context, _ upgrade.Plan) {
+		for _, name := range nativeModules {
+			m := app.mm.Modules[name]
+			m.InitGenesis(ctx, m.DefaultGenesis())
+		}
+	})
+	upgradeInfo, err := app.upgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		tmos.Exit(err.Error())
+	}
+	if upgradeInfo.Name == nativeModulesUpgrade && !app.upgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storetypes.StoreUpgrades{Added: nativeModules}))
+	}
 
 	// The gov proposal types can be individually enabled
 	if len(enabledProposals) != 0 {
@@ -333,7 +531,23 @@ func NewWasmApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest b
 		distr.NewAppModule(app.distrKeeper, app.accountKeeper, app.supplyKeeper, app.stakingKeeper),
 		staking.NewAppModule(app.stakingKeeper, app.accountKeeper, app.supplyKeeper),
 		evidence.NewAppModule(*app.evidenceKeeper),
-		wasm.NewAppModule(app.wasmKeeper),
+		wasm.NewAppModule(app.wasmKeeper, app.accountKeeper),
+		vesting.NewAppModule(app.vestingKeeper),
+		denom.NewAppModule(app.denomKeeper),
+		bridge.NewAppModule(app.bridgeKeeper),
+		oracle.NewAppModule(app.oracleKeeper),
+		beacon.NewAppModule(app.beaconKeeper),
+		almanac.NewAppModule(app.almanacKeeper),
+		aname.NewAppModule(app.anameKeeper),
+		did.NewAppModule(app.didKeeper),
+		paychan.NewAppModule(app.paychanKeeper),
+		htlc.NewAppModule(app.htlcKeeper),
+		auction.NewAppModule(app.auctionKeeper),
+		reconciliation.NewAppModule(app.reconKeeper),
+		airdrop.NewAppModule(app.airdropKeeper),
+		group.NewAppModule(app.groupKeeper),
+		liquidity.NewAppModule(app.liquidityKeeper),
+		feemarket.NewAppModule(app.feemarketKeeper),
 		upgrade.NewAppModule(app.upgradeKeeper),
 		evidence.NewAppModule(*app.evidenceKeeper),
 	)
@@ -341,20 +555,69 @@ func NewWasmApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest b
 	// there is nothing left over in the validator fee pool, so as to keep the
 	// CanWithdrawInvariant invariant.
 
-	app.mm.SetOrderBeginBlockers(upgrade.ModuleName, staking.ModuleName, mint.ModuleName, distr.ModuleName, evidence.ModuleName, slashing.ModuleName)
-	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, staking.ModuleName)
+	app.mm.SetOrderBeginBlockers(upgrade.ModuleName, beacon.ModuleName, staking.ModuleName, mint.ModuleName, distr.ModuleName, evidence.ModuleName, slashing.ModuleName)
+	// the oracle jails the validators that missed votes before staking updates the validator set
+	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, oracle.ModuleName, staking.ModuleName, wasm.ModuleName, almanac.ModuleName, paychan.ModuleName, auction.ModuleName, airdrop.ModuleName, feemarket.ModuleName)
 
 	// NOTE: The genutils module must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
 	app.mm.SetOrderInitGenesis(
 		distr.ModuleName, staking.ModuleName, auth.ModuleName, bank.ModuleName,
 		slashing.ModuleName, gov.ModuleName, mint.ModuleName, supply.ModuleName,
-		crisis.ModuleName, genutil.ModuleName, evidence.ModuleName, wasm.ModuleName,
+		crisis.ModuleName, genutil.ModuleName, evidence.ModuleName, wasm.ModuleName, vesting.ModuleName,
+		denom.ModuleName, bridge.ModuleName, oracle.ModuleName, beacon.ModuleName,
+		almanac.ModuleName, aname.ModuleName, did.ModuleName, paychan.ModuleName,
+		htlc.ModuleName, auction.ModuleName, reconciliation.ModuleName, airdrop.ModuleName, group.ModuleName,
+		liquidity.ModuleName, feemarket.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.crisisKeeper)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter())
 
+	blockStatsWrap := BlockStatsWrapper{BlockStats: DefaultBlockStatsConfig()}
+	if err := viper.Unmarshal(&blockStatsWrap); err != nil {
+		panic("error while reading blockstats config: " + err.Error())
+	}
+	if window := blockStatsWrap.BlockStats.Window; window > 0 && homeDir != "" {
+		statsDB, err := sdk.NewLevelDB("blockstats", filepath.Join(homeDir, "data"))
+		if err != nil {
+			panic("error while opening blockstats db: " + err.Error())
+		}
+		app.blockStats = newBlockStatsRecorder(app.cdc, auth.DefaultTxDecoder(cdc), statsDB, window)
+		app.QueryRouter().AddRoute(BlockStatsQuerierRoute, app.blockStats.querier)
+	}
+
+	mempoolLimitsWrap := MempoolLimitsWrapper{MempoolLimits: DefaultMempoolLimitsConfig()}
+	if err := viper.Unmarshal(&mempoolLimitsWrap); err != nil {
+		panic("error while reading mempool_limits config: " + err.Error())
+	}
+	app.pendingTxs = newPendingTxLimiter(auth.DefaultTxDecoder(cdc), mempoolLimitsWrap.MempoolLimits)
+	app.gasRefunds = newGasRefundCollector(auth.DefaultTxDecoder(cdc))
+
+	swaggerWrap := SwaggerWrapper{Swagger: DefaultSwaggerConfig()}
+	if err := viper.Unmarshal(&swaggerWrap); err != nil {
+		panic("error while reading swagger config: " + err.Error())
+	}
+	app.swagger = swaggerWrap.Swagger
+
+	graphQLWrap := GraphQLWrapper{GraphQL: DefaultGraphQLConfig()}
+	if err := viper.Unmarshal(&graphQLWrap); err != nil {
+		panic("error while reading graphql config: " + err.Error())
+	}
+	app.graphQL = graphQLWrap.GraphQL
+
+	indexerWrap := IndexerWrapper{Indexer: DefaultIndexerConfig()}
+	if err := viper.Unmarshal(&indexerWrap); err != nil {
+		panic("error while reading indexer config: " + err.Error())
+	}
+	if cfg := indexerWrap.Indexer; cfg.Enable {
+		idx, err := indexer.Open(cfg.Driver, cfg.DSN, cfg.EventTypes)
+		if err != nil {
+			panic("error while opening indexer db: " + err.Error())
+		}
+		app.indexer = newBlockIndexer(idx, logger.With("module", "indexer"))
+	}
+
 	// create the simulation manager and define the order of the modules for deterministic simulations
 	//
 	// NOTE: This is not required for apps that don't use the simulator for fuzz testing
@@ -368,6 +631,7 @@ func NewWasmApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest b
 		distr.NewAppModule(app.distrKeeper, app.accountKeeper, app.supplyKeeper, app.stakingKeeper),
 		staking.NewAppModule(app.stakingKeeper, app.accountKeeper, app.supplyKeeper),
 		slashing.NewAppModule(app.slashingKeeper, app.accountKeeper, app.stakingKeeper),
+		wasm.NewAppModule(app.wasmKeeper, app.accountKeeper),
 	)
 
 	app.sm.RegisterStoreDecoders()
@@ -379,7 +643,13 @@ func NewWasmApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest b
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
-	app.SetAnteHandler(auth.NewAnteHandler(app.accountKeeper, app.supplyKeeper, auth.DefaultSigVerificationGasConsumer))
+	anteDecorators := NewDefaultAnteDecorators(app.accountKeeper, app.supplyKeeper, app.wasmKeeper, app.wasmKeeper, app.distrKeeper, app.feemarketKeeper, auth.DefaultSigVerificationGasConsumer)
+	for _, opt := range anteDecoratorsOptions {
+		if err := opt(anteDecorators); err != nil {
+			panic("error while applying ante decorators option: " + err.Error())
+		}
+	}
+	app.SetAnteHandler(anteDecorators.AnteHandler())
 	app.SetEndBlocker(app.EndBlocker)
 
 	if loadLatest {
@@ -395,13 +665,82 @@ func NewWasmApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest b
 // Name returns the name of the App
 func (app *WasmApp) Name() string { return app.BaseApp.Name() }
 
+// BeginBlock implements the ABCI interface and starts recording the block statistics, collecting the gas refunds
+// and indexing the block
+func (app *WasmApp) BeginBlock(req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	if app.blockStats != nil {
+		app.blockStats.beginBlock(req.Header)
+	}
+	app.gasRefunds.beginBlock()
+	res := app.BaseApp.BeginBlock(req)
+	if app.indexer != nil {
+		app.indexer.beginBlock(req, res)
+	}
+	return res
+}
+
+// CheckTx implements the ABCI interface and enforces the pending tx limits per sender and contract
+func (app *WasmApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
+	return app.pendingTxs.checkTx(req, app.BaseApp.CheckTx)
+}
+
+// DeliverTx implements the ABCI interface and adds the transaction to the block statistics, the gas refunds and
+// the indexed block
+func (app *WasmApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+	res := app.BaseApp.DeliverTx(req)
+	if app.blockStats != nil {
+		app.blockStats.deliverTx(req.Tx, res)
+	}
+	app.gasRefunds.deliverTx(req.Tx, res)
+	if app.indexer != nil {
+		app.indexer.deliverTx(req.Tx, res)
+	}
+	return res
+}
+
+// EndBlock implements the ABCI interface and adds the end block events to the indexed block
+func (app *WasmApp) EndBlock(req abci.RequestEndBlock) abci.ResponseEndBlock {
+	res := app.BaseApp.EndBlock(req)
+	if app.indexer != nil {
+		app.indexer.endBlock(res)
+	}
+	return res
+}
+
+// Commit implements the ABCI interface, persists the block statistics, resets the pending tx counts and indexes the block
+func (app *WasmApp) Commit() abci.ResponseCommit {
+	res := app.BaseApp.Commit()
+	if app.blockStats != nil {
+		app.blockStats.commit()
+	}
+	app.pendingTxs.commit()
+	if app.indexer != nil {
+		app.indexer.commit()
+	}
+	return res
+}
+
+// Close closes the node local dbs of the block statistics and the indexer. The app must not be used afterwards.
+func (app *WasmApp) Close() error {
+	if app.blockStats != nil {
+		if err := app.blockStats.close(); err != nil {
+			return err
+		}
+	}
+	if app.indexer != nil {
+		return app.indexer.idx.Close()
+	}
+	return nil
+}
+
 // application updates every begin block
 func (app *WasmApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
 	return app.mm.BeginBlock(ctx, req)
 }
 
-// EndBlocker application updates every end block
+// EndBlocker application updates every end block, the unused gas of the txs of the block is refunded first
 func (app *WasmApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
+	app.feemarketKeeper.RefundGas(ctx, app.gasRefunds.endBlock(ctx, app.feemarketKeeper))
 	return app.mm.EndBlock(ctx, req)
 }
 
@@ -451,4 +790,11 @@ func (app *WasmApp) RegisterAPIRoutes(server *api.Server) {
 	client.RegisterRoutes(server.ClientCtx, server.Router)
 	authrest.RegisterTxRoutes(server.ClientCtx, server.Router)
 	ModuleBasics.RegisterRESTRoutes(server.ClientCtx, server.Router)
+	if app.graphQL.Enable {
+		registerGraphQLRoute(app.cdc, server.ClientCtx, server.Router, app.graphQL)
+	}
+	// registered last, the spec covers the routes registered before
+	if app.swagger.Enable {
+		registerSwaggerRoute(server.Router)
+	}
 }
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"encoding/json"
 	"os"
 	"testing"
 
@@ -29,6 +30,23 @@ func TestFetchdExport(t *testing.T) {
 	require.NoError(t, err, "ExportAppStateAndValidators should not have an error")
 }
 
+func TestFetchdExportModules(t *testing.T) {
+	db := db.NewMemDB()
+	gapp := NewWasmApp(log.NewNopLogger(), db, nil, true, 0, wasm.EnableAllProposals, map[int64]bool{})
+	require.NoError(t, setGenesis(gapp))
+
+	newGapp := NewWasmApp(log.NewNopLogger(), db, nil, true, 0, wasm.EnableAllProposals, map[int64]bool{})
+	appState, _, err := newGapp.ExportModulesAndValidators(false, []string{}, []string{wasm.ModuleName})
+	require.NoError(t, err)
+	var genState map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(appState, &genState))
+	require.Len(t, genState, 1)
+	require.Contains(t, genState, wasm.ModuleName)
+
+	_, _, err = newGapp.ExportModulesAndValidators(false, []string{}, []string{"unknown"})
+	require.Error(t, err)
+}
+
 // ensure that black listed addresses are properly set in bank keeper
 func TestBlackListedAddrs(t *testing.T) {
 	db := db.NewMemDB()
@@ -0,0 +1,262 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/spf13/viper"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/fetchai/fetchd/x/wasm"
+)
+
+const (
+	// BlockStatsQuerierRoute is the custom query route the block statistics are served on
+	BlockStatsQuerierRoute = "blockstats"
+
+	QueryBlockStatsHeight = "height"
+	QueryBlockStatsRange  = "range"
+
+	// MaxBlockStatsRange is the maximum number of blocks returned by a single range query
+	MaxBlockStatsRange = 100
+
+	defaultBlockStatsWindow = 1000
+)
+
+// BlockStatsConfig is the [blockstats] section of app.toml
+type BlockStatsConfig struct {
+	// Window is the number of most recent blocks to keep statistics for, 0 disables the recording
+	Window int64 `mapstructure:"window"`
+}
+
+// DefaultBlockStatsConfig returns the default settings for BlockStatsConfig
+func DefaultBlockStatsConfig() BlockStatsConfig {
+	return BlockStatsConfig{Window: defaultBlockStatsWindow}
+}
+
+// BlockStatsWrapper allows us to use namespacing in the config file
+type BlockStatsWrapper struct {
+	BlockStats BlockStatsConfig `mapstructure:"blockstats"`
+}
+
+// DisableBlockStats turns the block statistics off for the apps created afterwards. Apps that do not run a node, like
+// the in-memory apps of the simulation and the state tools, must not open the statistics db in the home dir, whose lock
+// is held by a single app.
+func DisableBlockStats() {
+	viper.Set("blockstats.window", 0)
+}
+
+// MsgTypeCount is the number of messages of a route and type in a block
+type MsgTypeCount struct {
+	Route string `json:"route"`
+	Type  string `json:"type"`
+	Count uint64 `json:"count"`
+}
+
+// BlockStats are the execution statistics of a single block.
+// They are derived while delivering the block and kept in node local storage only, they are not part of the consensus state.
+type BlockStats struct {
+	Height    int64     `json:"height"`
+	Time      time.Time `json:"time"`
+	GasWanted int64     `json:"gas_wanted"`
+	GasUsed   int64     `json:"gas_used"`
+	TxCount   uint64    `json:"tx_count"`
+	FailedTxs uint64    `json:"failed_txs"`
+	// MsgCounts are counted for successful transactions only, sorted by route and type
+	MsgCounts []MsgTypeCount `json:"msg_counts"`
+	// WasmExecutions are the wasm execute messages of successful transactions.
+	// Contract to contract calls are not included.
+	WasmExecutions uint64 `json:"wasm_executions"`
+}
+
+// blockStatsRecorder collects BlockStats of the block in delivery and keeps a rolling window of them
+type blockStatsRecorder struct {
+	cdc       *codec.Codec
+	txDecoder sdk.TxDecoder
+	window    int64
+
+	mtx     sync.RWMutex
+	db      dbm.DB
+	current *BlockStats
+}
+
+func newBlockStatsRecorder(cdc *codec.Codec, txDecoder sdk.TxDecoder, db dbm.DB, window int64) *blockStatsRecorder {
+	return &blockStatsRecorder{
+		cdc:       cdc,
+		txDecoder: txDecoder,
+		db:        db,
+		window:    window,
+	}
+}
+
+func (r *blockStatsRecorder) beginBlock(header abci.Header) {
+	r.current = &BlockStats{Height: header.Height, Time: header.Time}
+}
+
+func (r *blockStatsRecorder) deliverTx(txBytes []byte, res abci.ResponseDeliverTx) {
+	if r.current == nil {
+		return
+	}
+	s := r.current
+	s.TxCount++
+	s.GasWanted += res.GasWanted
+	s.GasUsed += res.GasUsed
+	if !res.IsOK() {
+		s.FailedTxs++
+		return
+	}
+	tx, err := r.txDecoder(txBytes)
+	if err != nil {
+		return
+	}
+	for _, msg := range tx.GetMsgs() {
+		s.addMsg(msg.Route(), msg.Type())
+		if _, ok := msg.(wasm.MsgExecuteContract); ok {
+			s.WasmExecutions++
+		}
+	}
+}
+
+func (s *BlockStats) addMsg(route, msgType string) {
+	for i := range s.MsgCounts {
+		if s.MsgCounts[i].Route == route && s.MsgCounts[i].Type == msgType {
+			s.MsgCounts[i].Count++
+			return
+		}
+	}
+	s.MsgCounts = append(s.MsgCounts, MsgTypeCount{Route: route, Type: msgType, Count: 1})
+}
+
+// commit persists the stats of the committed block and prunes the ones out of the window
+func (r *blockStatsRecorder) commit() {
+	if r.current == nil {
+		return
+	}
+	s := r.current
+	r.current = nil
+	sort.Slice(s.MsgCounts, func(i, j int) bool {
+		if s.MsgCounts[i].Route != s.MsgCounts[j].Route {
+			return s.MsgCounts[i].Route < s.MsgCounts[j].Route
+		}
+		return s.MsgCounts[i].Type < s.MsgCounts[j].Type
+	})
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	if r.db == nil {
+		return
+	}
+	batch := r.db.NewBatch()
+	defer batch.Close()
+	batch.Set(blockStatsKey(s.Height), r.cdc.MustMarshalBinaryBare(s))
+	// deletes the whole range below the window so that the stats kept by a larger window of a previous run are pruned too
+	if pruneHeight := s.Height - r.window; pruneHeight > 0 {
+		it, err := r.db.Iterator(nil, blockStatsKey(pruneHeight+1))
+		if err != nil {
+			panic(err)
+		}
+		for ; it.Valid(); it.Next() {
+			batch.Delete(it.Key())
+		}
+		it.Close()
+	}
+	if err := batch.Write(); err != nil {
+		panic(err)
+	}
+}
+
+// close closes the statistics db, the blocks committed afterwards are not recorded
+func (r *blockStatsRecorder) close() error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	if r.db == nil {
+		return nil
+	}
+	err := r.db.Close()
+	r.db = nil
+	return err
+}
+
+func (r *blockStatsRecorder) get(height int64) (*BlockStats, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+	if r.db == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "blockstats db closed")
+	}
+	bz, err := r.db.Get(blockStatsKey(height))
+	if err != nil || bz == nil {
+		return nil, err
+	}
+	var s BlockStats
+	if err := r.cdc.UnmarshalBinaryBare(bz, &s); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// querier serves custom/blockstats/height/<height> and custom/blockstats/range/<from>/<to>
+func (r *blockStatsRecorder) querier(_ sdk.Context, path []string, _ abci.RequestQuery) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown blockstats query endpoint")
+	}
+	var from, to int64
+	var err error
+	switch path[0] {
+	case QueryBlockStatsHeight:
+		if len(path) != 2 {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "height required")
+		}
+		if from, err = strconv.ParseInt(path[1], 10, 64); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		s, err := r.get(from)
+		if err != nil {
+			return nil, err
+		}
+		if s == nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "no stats for height %d", from)
+		}
+		return codec.MarshalJSONIndent(r.cdc, s)
+	case QueryBlockStatsRange:
+		if len(path) != 3 {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "from and to heights required")
+		}
+		if from, err = strconv.ParseInt(path[1], 10, 64); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		if to, err = strconv.ParseInt(path[2], 10, 64); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		if from > to {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "from must not be greater than to")
+		}
+		if to-from >= MaxBlockStatsRange {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "range must not exceed %d blocks", MaxBlockStatsRange)
+		}
+		res := make([]BlockStats, 0, to-from+1)
+		for h := from; h <= to; h++ {
+			s, err := r.get(h)
+			if err != nil {
+				return nil, err
+			}
+			// heights out of the window are skipped
+			if s != nil {
+				res = append(res, *s)
+			}
+		}
+		return codec.MarshalJSONIndent(r.cdc, res)
+	default:
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, fmt.Sprintf("unknown blockstats query endpoint: %s", path[0]))
+	}
+}
+
+func blockStatsKey(height int64) []byte {
+	return sdk.Uint64ToBigEndian(uint64(height))
+}
@@ -0,0 +1,97 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/fetchai/fetchd/x/wasm"
+)
+
+func TestBlockStatsRecorder(t *testing.T) {
+	cdc := MakeCodec()
+	r := newBlockStatsRecorder(cdc, auth.DefaultTxDecoder(cdc), dbm.NewMemDB(), 2)
+
+	anyAddr := sdk.AccAddress(make([]byte, sdk.AddrLen))
+	txBz := cdc.MustMarshalBinaryLengthPrefixed(auth.StdTx{Msgs: []sdk.Msg{
+		wasm.MsgExecuteContract{Sender: anyAddr, Contract: anyAddr, Msg: []byte(`{}`)},
+		bank.MsgSend{FromAddress: anyAddr, ToAddress: anyAddr},
+		wasm.MsgExecuteContract{Sender: anyAddr, Contract: anyAddr, Msg: []byte(`{}`)},
+	}})
+
+	for h := int64(1); h <= 3; h++ {
+		r.beginBlock(abci.Header{Height: h})
+		r.deliverTx(txBz, abci.ResponseDeliverTx{GasWanted: 200, GasUsed: 100})
+		r.deliverTx(txBz, abci.ResponseDeliverTx{Code: 1, GasWanted: 200, GasUsed: 50})
+		r.commit()
+	}
+
+	// pruned out of the window
+	s, err := r.get(1)
+	require.NoError(t, err)
+	assert.Nil(t, s)
+
+	bz, err := r.querier(sdk.Context{}, []string{QueryBlockStatsHeight, "3"}, abci.RequestQuery{})
+	require.NoError(t, err)
+	var got BlockStats
+	require.NoError(t, cdc.UnmarshalJSON(bz, &got))
+	assert.Equal(t, BlockStats{
+		Height:    3,
+		GasWanted: 400,
+		GasUsed:   150,
+		TxCount:   2,
+		FailedTxs: 1,
+		MsgCounts: []MsgTypeCount{
+			{Route: "bank", Type: "send", Count: 1},
+			{Route: "wasm", Type: "execute", Count: 2},
+		},
+		WasmExecutions: 2,
+	}, got)
+
+	bz, err = r.querier(sdk.Context{}, []string{QueryBlockStatsRange, "1", "3"}, abci.RequestQuery{})
+	require.NoError(t, err)
+	var gotRange []json.RawMessage
+	require.NoError(t, json.Unmarshal(bz, &gotRange))
+	assert.Len(t, gotRange, 2)
+
+	_, err = r.querier(sdk.Context{}, []string{QueryBlockStatsRange, "3", "1"}, abci.RequestQuery{})
+	assert.Error(t, err)
+	_, err = r.querier(sdk.Context{}, []string{QueryBlockStatsRange, "1", "1000"}, abci.RequestQuery{})
+	assert.Error(t, err)
+	_, err = r.querier(sdk.Context{}, []string{QueryBlockStatsHeight, "1"}, abci.RequestQuery{})
+	assert.Error(t, err)
+}
+
+func TestBlockStatsRecorderPrunesRange(t *testing.T) {
+	cdc := MakeCodec()
+	db := dbm.NewMemDB()
+	r := newBlockStatsRecorder(cdc, auth.DefaultTxDecoder(cdc), db, 10)
+	for h := int64(1); h <= 5; h++ {
+		r.beginBlock(abci.Header{Height: h})
+		r.commit()
+	}
+
+	// a smaller window of a restart prunes all the heights below it
+	r = newBlockStatsRecorder(cdc, auth.DefaultTxDecoder(cdc), db, 2)
+	r.beginBlock(abci.Header{Height: 6})
+	r.commit()
+	for h := int64(1); h <= 6; h++ {
+		s, err := r.get(h)
+		require.NoError(t, err)
+		assert.Equal(t, h > 4, s != nil, "height %d", h)
+	}
+
+	require.NoError(t, r.close())
+	require.NoError(t, r.close())
+	r.beginBlock(abci.Header{Height: 7})
+	r.commit()
+	_, err := r.get(6)
+	assert.Error(t, err)
+}
@@ -2,6 +2,7 @@ package app
 
 import (
 	"encoding/json"
+	"fmt"
 	"log"
 
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -11,10 +12,19 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	"github.com/fetchai/fetchd/x/wasm"
 )
 
 // ExportAppStateAndValidators export the state of gaia for a genesis file
 func (app *WasmApp) ExportAppStateAndValidators(forZeroHeight bool, jailWhiteList []string,
+) (appState json.RawMessage, validators []tmtypes.GenesisValidator, err error) {
+	return app.ExportModulesAndValidators(forZeroHeight, jailWhiteList, nil)
+}
+
+// ExportModulesAndValidators exports the app state of the given modules only, e.g. to carry the deployed contracts
+// over to a new chain. All modules are exported when modules is empty.
+func (app *WasmApp) ExportModulesAndValidators(forZeroHeight bool, jailWhiteList []string, modules []string,
 ) (appState json.RawMessage, validators []tmtypes.GenesisValidator, err error) {
 	// as if they could withdraw from the start of the next block
 	ctx := app.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
@@ -23,7 +33,10 @@ func (app *WasmApp) ExportAppStateAndValidators(forZeroHeight bool, jailWhiteLis
 		app.prepForZeroHeightGenesis(ctx, jailWhiteList)
 	}
 
-	genState := app.mm.ExportGenesis(ctx)
+	genState, err := app.exportGenesis(ctx, modules)
+	if err != nil {
+		return nil, nil, err
+	}
 	appState, err = codec.MarshalJSONIndent(app.cdc, genState)
 	if err != nil {
 		return nil, nil, err
@@ -32,6 +45,27 @@ func (app *WasmApp) ExportAppStateAndValidators(forZeroHeight bool, jailWhiteLis
 	return appState, validators, nil
 }
 
+func (app *WasmApp) exportGenesis(ctx sdk.Context, modules []string) (map[string]json.RawMessage, error) {
+	if len(modules) == 0 {
+		return app.mm.ExportGenesis(ctx), nil
+	}
+	genState := make(map[string]json.RawMessage, len(modules))
+	for _, name := range modules {
+		m, ok := app.mm.Modules[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown module %q", name)
+		}
+		genState[name] = m.ExportGenesis(ctx)
+	}
+	return genState, nil
+}
+
+// ExportContract exports a single contract with its code and state at the last committed height
+func (app *WasmApp) ExportContract(contractAddr sdk.AccAddress) (wasm.ContractDump, error) {
+	ctx := app.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
+	return wasm.ExportContract(ctx, app.wasmKeeper, contractAddr)
+}
+
 // prepare for fresh start at zero height
 // NOTE zero height genesis is a temporary feature which will be deprecated
 //      in favour of export at a block height
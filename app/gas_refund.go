@@ -0,0 +1,79 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	"github.com/fetchai/fetchd/x/feemarket"
+)
+
+// paidFeeKeeper returns the fee the fee payer paid for a tx of the block in delivery, as recorded in the ante handler
+type paidFeeKeeper interface {
+	GetPaidFee(ctx sdk.Context, txHash []byte) sdk.Coins
+}
+
+// gasRefundCollector collects the fee payer and the gas of the successful txs of the block in delivery.
+// The gas used is only known after the msgs ran, so the refunds of the unused gas are paid in the end blocker.
+// The refunds are a share of the fee the fee payer paid, without the subsidy from the community pool. It is recorded
+// in the ante handler because its events are not part of the tx result.
+type gasRefundCollector struct {
+	txDecoder sdk.TxDecoder
+	txs       []refundTx
+}
+
+// refundTx is a tx with unused gas, the fee it paid is resolved at the end of the block
+type refundTx struct {
+	hash      []byte
+	feePayer  sdk.AccAddress
+	gasWanted uint64
+	gasUsed   uint64
+}
+
+func newGasRefundCollector(txDecoder sdk.TxDecoder) *gasRefundCollector {
+	return &gasRefundCollector{txDecoder: txDecoder}
+}
+
+func (c *gasRefundCollector) beginBlock() {
+	c.txs = nil
+}
+
+func (c *gasRefundCollector) deliverTx(txBytes []byte, res abci.ResponseDeliverTx) {
+	if !res.IsOK() || res.GasUsed >= res.GasWanted {
+		return
+	}
+	tx, err := c.txDecoder(txBytes)
+	if err != nil {
+		return
+	}
+	feeTx, ok := tx.(ante.FeeTx)
+	if !ok || feeTx.GetFee().IsZero() {
+		return
+	}
+	c.txs = append(c.txs, refundTx{
+		hash:      tmhash.Sum(txBytes),
+		feePayer:  feeTx.FeePayer(),
+		gasWanted: uint64(res.GasWanted),
+		gasUsed:   uint64(res.GasUsed),
+	})
+}
+
+// endBlock returns the refunds of the collected txs of the block, a tx without a paid fee is skipped
+func (c *gasRefundCollector) endBlock(ctx sdk.Context, k paidFeeKeeper) []feemarket.GasRefund {
+	var refunds []feemarket.GasRefund
+	for _, tx := range c.txs {
+		fee := k.GetPaidFee(ctx, tx.hash)
+		if fee.IsZero() {
+			continue
+		}
+		refunds = append(refunds, feemarket.GasRefund{
+			FeePayer:  tx.feePayer,
+			Fee:       fee,
+			GasWanted: tx.gasWanted,
+			GasUsed:   tx.gasUsed,
+		})
+	}
+	c.txs = nil
+	return refunds
+}
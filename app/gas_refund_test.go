@@ -0,0 +1,191 @@
+package app
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/tendermint/tendermint/libs/log"
+	db "github.com/tendermint/tm-db"
+
+	"github.com/fetchai/fetchd/x/feemarket"
+	"github.com/fetchai/fetchd/x/wasm"
+)
+
+type mockPaidFeeKeeper map[string]sdk.Coins
+
+func (m mockPaidFeeKeeper) GetPaidFee(_ sdk.Context, txHash []byte) sdk.Coins {
+	return m[string(txHash)]
+}
+
+func TestGasRefundCollector(t *testing.T) {
+	cdc := MakeCodec()
+	c := newGasRefundCollector(auth.DefaultTxDecoder(cdc))
+
+	payer := sdk.AccAddress([]byte("payer_______________"))
+	fee := sdk.NewCoins(sdk.NewInt64Coin("afet", 1000))
+	newTx := func(memo string) []byte {
+		return cdc.MustMarshalBinaryLengthPrefixed(auth.NewStdTx(
+			[]sdk.Msg{bank.MsgSend{FromAddress: payer, ToAddress: payer}}, auth.NewStdFee(100000, fee), nil, memo))
+	}
+	paid, subsidised, unrecorded := newTx("paid"), newTx("subsidised"), newTx("unrecorded")
+	paidFees := mockPaidFeeKeeper{
+		string(tmhash.Sum(paid)):       fee,
+		string(tmhash.Sum(subsidised)): sdk.NewCoins(sdk.NewInt64Coin("afet", 600)),
+	}
+
+	c.beginBlock()
+	c.deliverTx(paid, abci.ResponseDeliverTx{GasWanted: 100000, GasUsed: 40000})
+	c.deliverTx(subsidised, abci.ResponseDeliverTx{GasWanted: 100000, GasUsed: 40000})
+	// txs without a recorded fee, failed, all gas used and undecodable txs are skipped
+	c.deliverTx(unrecorded, abci.ResponseDeliverTx{GasWanted: 100000, GasUsed: 40000})
+	c.deliverTx(paid, abci.ResponseDeliverTx{Code: 1, GasWanted: 100000, GasUsed: 40000})
+	c.deliverTx(paid, abci.ResponseDeliverTx{GasWanted: 100000, GasUsed: 100000})
+	c.deliverTx([]byte("invalid"), abci.ResponseDeliverTx{GasWanted: 100000, GasUsed: 40000})
+	assert.Equal(t, []feemarket.GasRefund{
+		{FeePayer: payer, Fee: fee, GasWanted: 100000, GasUsed: 40000},
+		{FeePayer: payer, Fee: sdk.NewCoins(sdk.NewInt64Coin("afet", 600)), GasWanted: 100000, GasUsed: 40000},
+	}, c.endBlock(sdk.Context{}, paidFees))
+	assert.Empty(t, c.endBlock(sdk.Context{}, paidFees))
+}
+
+func TestDeliverTxRefundsPaidFeeOfDiscountedTx(t *testing.T) {
+	gapp := NewWasmApp(log.NewNopLogger(), db.NewMemDB(), nil, true, 0, wasm.EnableAllProposals, map[int64]bool{})
+	genesisState := ModuleBasics.DefaultGenesis()
+	feemarketGenesis := feemarket.DefaultGenesisState()
+	feemarketGenesis.BaseFee = sdk.NewDecWithPrec(1, 2)
+	feemarketGenesis.Params.GasRefundRate = sdk.NewDecWithPrec(5, 1)
+	genesisState[feemarket.ModuleName] = gapp.Codec().MustMarshalJSON(feemarketGenesis)
+	stateBytes, err := codec.MarshalJSONIndent(gapp.Codec(), genesisState)
+	require.NoError(t, err)
+	gapp.InitChain(abci.RequestInitChain{ChainId: "testing", AppStateBytes: stateBytes})
+	gapp.Commit()
+
+	header := abci.Header{Height: gapp.LastBlockHeight() + 1, ChainID: "testing"}
+	gapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := gapp.NewContext(false, header)
+
+	var (
+		afet        = func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("afet", amount)) }
+		privKey     = secp256k1.GenPrivKey()
+		payer       = sdk.AccAddress(privKey.PubKey().Address())
+		beneficiary = sdk.AccAddress([]byte("beneficiary_________"))
+	)
+	require.NoError(t, gapp.supplyKeeper.MintCoins(ctx, mint.ModuleName, afet(1000000)))
+	require.NoError(t, gapp.supplyKeeper.SendCoinsFromModuleToAccount(ctx, mint.ModuleName, payer, afet(1000000)))
+	require.NoError(t, gapp.distrKeeper.FundCommunityPool(ctx, afet(100000), payer))
+
+	wasmCode, err := ioutil.ReadFile("../x/wasm/internal/keeper/testdata/contract.wasm")
+	require.NoError(t, err)
+	codeID, err := gapp.wasmKeeper.Create(ctx, payer, wasmCode, "", "", nil)
+	require.NoError(t, err)
+	initMsg, err := json.Marshal(map[string]sdk.AccAddress{"verifier": payer, "beneficiary": beneficiary})
+	require.NoError(t, err)
+	contract, _, err := gapp.wasmKeeper.Instantiate(ctx, codeID, payer, nil, initMsg, "discounted", afet(100))
+	require.NoError(t, err)
+	gapp.subspaces[wasm.ModuleName].Set(ctx, wasm.ParamStoreKeyFeeDiscounts, []wasm.FeeDiscount{{Contract: contract, Discount: sdk.NewDecWithPrec(5, 1)}})
+
+	acc := gapp.accountKeeper.GetAccount(ctx, payer)
+	before := acc.GetCoins()
+	msgs := []sdk.Msg{wasm.MsgExecuteContract{Sender: payer, Contract: contract, Msg: []byte(`{"release":{}}`)}}
+	fee := auth.NewStdFee(500000, afet(10000))
+	sig, err := privKey.Sign(auth.StdSignBytes("testing", acc.GetAccountNumber(), acc.GetSequence(), fee, msgs, ""))
+	require.NoError(t, err)
+	txBz := gapp.Codec().MustMarshalBinaryLengthPrefixed(auth.NewStdTx(msgs, fee, []auth.StdSignature{{PubKey: privKey.PubKey(), Signature: sig}}, ""))
+
+	res := gapp.DeliverTx(abci.RequestDeliverTx{Tx: txBz})
+	require.True(t, res.IsOK(), res.Log)
+	gapp.EndBlock(abci.RequestEndBlock{Height: header.Height})
+
+	// half of the required fee of 5000afet at the base fee is subsidised, the refund is a share of the rest
+	paid := afet(7500)
+	refund := feemarket.RefundAmount(feemarket.GasRefund{FeePayer: payer, Fee: paid, GasWanted: 500000, GasUsed: uint64(res.GasUsed)}, gapp.feemarketKeeper.GetParams(ctx))
+	require.False(t, refund.IsZero())
+	assert.Equal(t, before.Sub(paid).Add(refund...).String(), gapp.accountKeeper.GetAccount(ctx, payer).GetCoins().String())
+}
+
+func TestDeliverTxRefundExcludesDeveloperFees(t *testing.T) {
+	gapp := NewWasmApp(log.NewNopLogger(), db.NewMemDB(), nil, true, 0, wasm.EnableAllProposals, map[int64]bool{})
+	genesisState := ModuleBasics.DefaultGenesis()
+	feemarketGenesis := feemarket.DefaultGenesisState()
+	feemarketGenesis.Params.GasRefundRate = sdk.OneDec()
+	feemarketGenesis.Params.GasRefundThreshold = sdk.ZeroDec()
+	genesisState[feemarket.ModuleName] = gapp.Codec().MustMarshalJSON(feemarketGenesis)
+	stateBytes, err := codec.MarshalJSONIndent(gapp.Codec(), genesisState)
+	require.NoError(t, err)
+	gapp.InitChain(abci.RequestInitChain{ChainId: "testing", AppStateBytes: stateBytes})
+	gapp.Commit()
+
+	header := abci.Header{Height: gapp.LastBlockHeight() + 1, ChainID: "testing"}
+	gapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := gapp.NewContext(false, header)
+
+	var (
+		afet         = func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("afet", amount)) }
+		privKey      = secp256k1.GenPrivKey()
+		payer        = sdk.AccAddress(privKey.PubKey().Address())
+		otherKey     = secp256k1.GenPrivKey()
+		other        = sdk.AccAddress(otherKey.PubKey().Address())
+		beneficiary  = sdk.AccAddress([]byte("beneficiary_________"))
+		feeCollector = gapp.supplyKeeper.GetModuleAddress(auth.FeeCollectorName)
+	)
+	require.NoError(t, gapp.supplyKeeper.MintCoins(ctx, mint.ModuleName, afet(2000000)))
+	require.NoError(t, gapp.supplyKeeper.SendCoinsFromModuleToAccount(ctx, mint.ModuleName, payer, afet(1000000)))
+	require.NoError(t, gapp.supplyKeeper.SendCoinsFromModuleToAccount(ctx, mint.ModuleName, other, afet(1000000)))
+
+	wasmCode, err := ioutil.ReadFile("../x/wasm/internal/keeper/testdata/contract.wasm")
+	require.NoError(t, err)
+	codeID, err := gapp.wasmKeeper.Create(ctx, payer, wasmCode, "", "", nil)
+	require.NoError(t, err)
+	initMsg, err := json.Marshal(map[string]sdk.AccAddress{"verifier": payer, "beneficiary": beneficiary})
+	require.NoError(t, err)
+	contract, _, err := gapp.wasmKeeper.Instantiate(ctx, codeID, payer, payer, initMsg, "rewarded", afet(100))
+	require.NoError(t, err)
+	require.NoError(t, gapp.wasmKeeper.SetContractRewardAddress(ctx, contract, payer, beneficiary))
+	// the developer share and the refund rate add up to more than the fee
+	share := sdk.NewDecWithPrec(9, 1)
+	gapp.subspaces[wasm.ModuleName].Set(ctx, wasm.ParamStoreKeyDeveloperFeeShare, &share)
+
+	signTx := func(key secp256k1.PrivKeySecp256k1, msgs []sdk.Msg, fee auth.StdFee) []byte {
+		acc := gapp.accountKeeper.GetAccount(ctx, sdk.AccAddress(key.PubKey().Address()))
+		sig, err := key.Sign(auth.StdSignBytes("testing", acc.GetAccountNumber(), acc.GetSequence(), fee, msgs, ""))
+		require.NoError(t, err)
+		return gapp.Codec().MustMarshalBinaryLengthPrefixed(auth.NewStdTx(msgs, fee, []auth.StdSignature{{PubKey: key.PubKey(), Signature: sig}}, ""))
+	}
+	collectedBefore := gapp.bankKeeper.GetCoins(ctx, feeCollector)
+	payerBefore := gapp.accountKeeper.GetAccount(ctx, payer).GetCoins()
+
+	execFee := auth.NewStdFee(500000, afet(10000))
+	execRes := gapp.DeliverTx(abci.RequestDeliverTx{Tx: signTx(privKey,
+		[]sdk.Msg{wasm.MsgExecuteContract{Sender: payer, Contract: contract, Msg: []byte(`{"release":{}}`)}}, execFee)})
+	require.True(t, execRes.IsOK(), execRes.Log)
+	sendFee := auth.NewStdFee(200000, afet(4000))
+	sendRes := gapp.DeliverTx(abci.RequestDeliverTx{Tx: signTx(otherKey,
+		[]sdk.Msg{bank.MsgSend{FromAddress: other, ToAddress: beneficiary, Amount: afet(1)}}, sendFee)})
+	require.True(t, sendRes.IsOK(), sendRes.Log)
+	gapp.EndBlock(abci.RequestEndBlock{Height: header.Height})
+
+	// 9000afet of the fee are accrued for the contract, the refund is a share of the rest
+	params := gapp.feemarketKeeper.GetParams(ctx)
+	kept := afet(1000)
+	execRefund := feemarket.RefundAmount(feemarket.GasRefund{FeePayer: payer, Fee: kept, GasWanted: 500000, GasUsed: uint64(execRes.GasUsed)}, params)
+	sendRefund := feemarket.RefundAmount(feemarket.GasRefund{FeePayer: other, Fee: sendFee.Amount, GasWanted: 200000, GasUsed: uint64(sendRes.GasUsed)}, params)
+	require.False(t, execRefund.IsZero())
+	assert.Equal(t, payerBefore.Sub(execFee.Amount).Add(execRefund...).String(), gapp.accountKeeper.GetAccount(ctx, payer).GetCoins().String())
+
+	// the fee collector keeps the rest of both fees, the refund of the contract execution leaves the other fee untouched
+	collected := gapp.bankKeeper.GetCoins(ctx, feeCollector)
+	expected := collectedBefore.Add(kept.Sub(execRefund)...).Add(sendFee.Amount.Sub(sendRefund)...)
+	assert.Equal(t, expected.String(), collected.String())
+	assert.True(t, collected.IsAllGTE(collectedBefore.Add(sendFee.Amount.Sub(sendRefund)...)))
+}
@@ -0,0 +1,44 @@
+package app
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/gorilla/mux"
+
+	"github.com/fetchai/fetchd/graphql"
+)
+
+const (
+	// GraphQLRoute is the path of the API server the GraphQL queries are served on
+	GraphQLRoute = "/graphql"
+
+	defaultGraphQLMaxBatch = 20
+)
+
+// GraphQLConfig is the [graphql] section of app.toml
+type GraphQLConfig struct {
+	// Enable serves GraphQL queries of the chain state on the API server
+	Enable bool `mapstructure:"enable"`
+	// MaxBatch is the maximum number of queries of a batch request, 0 disables the limit
+	MaxBatch int `mapstructure:"max_batch"`
+}
+
+// DefaultGraphQLConfig returns the default settings for GraphQLConfig
+func DefaultGraphQLConfig() GraphQLConfig {
+	return GraphQLConfig{MaxBatch: defaultGraphQLMaxBatch}
+}
+
+// GraphQLWrapper allows us to use namespacing in the config file
+type GraphQLWrapper struct {
+	GraphQL GraphQLConfig `mapstructure:"graphql"`
+}
+
+func registerGraphQLRoute(cdc *codec.Codec, cliCtx context.CLIContext, router *mux.Router, config GraphQLConfig) {
+	query := func(path string, data []byte) ([]byte, error) {
+		res, _, err := cliCtx.QueryWithData(path, data)
+		return res, err
+	}
+	router.Methods(http.MethodPost).Path(GraphQLRoute).Handler(graphql.NewServer(cdc, query, config.MaxBatch).Handler())
+}
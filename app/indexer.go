@@ -0,0 +1,67 @@
+package app
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/fetchai/fetchd/indexer"
+)
+
+// IndexerConfig is the [indexer] section of app.toml
+type IndexerConfig struct {
+	// Enable streams the blocks, txs and events into the database
+	Enable bool `mapstructure:"enable"`
+	// Driver is the sql driver, postgres or sqlite3
+	Driver string `mapstructure:"driver"`
+	// DSN is the data source name of the database for the driver
+	DSN string `mapstructure:"dsn"`
+	// EventTypes are the types of the events to store, all are stored if empty
+	EventTypes []string `mapstructure:"event_types"`
+}
+
+// DefaultIndexerConfig returns the default settings for IndexerConfig
+func DefaultIndexerConfig() IndexerConfig {
+	return IndexerConfig{Driver: indexer.DriverPostgres}
+}
+
+// IndexerWrapper allows us to use namespacing in the config file
+type IndexerWrapper struct {
+	Indexer IndexerConfig `mapstructure:"indexer"`
+}
+
+// blockIndexer collects the events of the block in delivery and stores the block on commit. Indexing is node local
+// and must not halt the node, failures are logged and the missed blocks can be backfilled from the node's RPC.
+type blockIndexer struct {
+	idx    *indexer.Indexer
+	logger log.Logger
+	block  indexer.Block
+}
+
+func newBlockIndexer(idx *indexer.Indexer, logger log.Logger) *blockIndexer {
+	return &blockIndexer{idx: idx, logger: logger}
+}
+
+func (b *blockIndexer) beginBlock(req abci.RequestBeginBlock, res abci.ResponseBeginBlock) {
+	b.block = indexer.Block{
+		Height:           req.Header.Height,
+		Hash:             req.Hash,
+		Time:             req.Header.Time,
+		BeginBlockEvents: res.Events,
+	}
+}
+
+func (b *blockIndexer) deliverTx(txBytes []byte, res abci.ResponseDeliverTx) {
+	b.block.Txs = append(b.block.Txs, indexer.Tx{Hash: tmtypes.Tx(txBytes).Hash(), Result: res})
+}
+
+func (b *blockIndexer) endBlock(res abci.ResponseEndBlock) {
+	b.block.EndBlockEvents = res.Events
+}
+
+func (b *blockIndexer) commit() {
+	if err := b.idx.Index(b.block); err != nil {
+		b.logger.Error("failed to index block", "height", b.block.Height, "err", err)
+	}
+	b.block = indexer.Block{}
+}
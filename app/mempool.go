@@ -0,0 +1,131 @@
+package app
+
+import (
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/fetchai/fetchd/x/wasm"
+)
+
+const (
+	defaultMaxPendingPerSender   = 100
+	defaultMaxPendingPerContract = 1000
+)
+
+// MempoolLimitsConfig is the [mempool_limits] section of app.toml
+type MempoolLimitsConfig struct {
+	// MaxPendingPerSender is the maximum number of txs of a fee payer in the mempool, 0 disables the limit
+	MaxPendingPerSender int `mapstructure:"max_pending_per_sender"`
+	// MaxPendingPerContract is the maximum number of txs in the mempool executing a contract, 0 disables the limit
+	MaxPendingPerContract int `mapstructure:"max_pending_per_contract"`
+}
+
+// DefaultMempoolLimitsConfig returns the default settings for MempoolLimitsConfig
+func DefaultMempoolLimitsConfig() MempoolLimitsConfig {
+	return MempoolLimitsConfig{
+		MaxPendingPerSender:   defaultMaxPendingPerSender,
+		MaxPendingPerContract: defaultMaxPendingPerContract,
+	}
+}
+
+// MempoolLimitsWrapper allows us to use namespacing in the config file
+type MempoolLimitsWrapper struct {
+	MempoolLimits MempoolLimitsConfig `mapstructure:"mempool_limits"`
+}
+
+// pendingTxLimiter counts the txs accepted by CheckTx per fee payer and executed contract and rejects new txs over
+// the limits. The counts are reset on commit and rebuilt by the recheck of the txs left in the mempool, so they only
+// hold with tendermint's mempool recheck enabled (the default).
+type pendingTxLimiter struct {
+	txDecoder sdk.TxDecoder
+	config    MempoolLimitsConfig
+
+	mtx       sync.Mutex
+	senders   map[string]int
+	contracts map[string]int
+}
+
+func newPendingTxLimiter(txDecoder sdk.TxDecoder, config MempoolLimitsConfig) *pendingTxLimiter {
+	return &pendingTxLimiter{
+		txDecoder: txDecoder,
+		config:    config,
+		senders:   make(map[string]int),
+		contracts: make(map[string]int),
+	}
+}
+
+// checkTx runs next for the tx unless it exceeds a limit and counts it when it is accepted.
+// Rechecked txs were accepted before and are counted without the limits.
+func (l *pendingTxLimiter) checkTx(req abci.RequestCheckTx, next func(abci.RequestCheckTx) abci.ResponseCheckTx) abci.ResponseCheckTx {
+	tx, err := l.txDecoder(req.Tx)
+	if err != nil {
+		return next(req)
+	}
+	sender, contracts := pendingTxKeys(tx)
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	if req.Type == abci.CheckTxType_New {
+		if err := l.checkLimits(sender, contracts); err != nil {
+			return sdkerrors.ResponseCheckTx(err, 0, 0)
+		}
+	}
+	res := next(req)
+	if res.IsOK() {
+		if sender != "" {
+			l.senders[sender]++
+		}
+		for _, c := range contracts {
+			l.contracts[c]++
+		}
+	}
+	return res
+}
+
+func (l *pendingTxLimiter) checkLimits(sender string, contracts []string) error {
+	if max := l.config.MaxPendingPerSender; max > 0 && sender != "" && l.senders[sender] >= max {
+		return sdkerrors.Wrapf(sdkerrors.ErrMempoolIsFull, "sender %s has %d pending txs", sender, l.senders[sender])
+	}
+	if max := l.config.MaxPendingPerContract; max > 0 {
+		for _, c := range contracts {
+			if l.contracts[c] >= max {
+				return sdkerrors.Wrapf(sdkerrors.ErrMempoolIsFull, "contract %s has %d pending txs", c, l.contracts[c])
+			}
+		}
+	}
+	return nil
+}
+
+// commit drops the counts, the txs left in the mempool are rechecked after the block
+func (l *pendingTxLimiter) commit() {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	l.senders = make(map[string]int)
+	l.contracts = make(map[string]int)
+}
+
+// pendingTxKeys returns the fee payer and the distinct contracts executed by the tx
+func pendingTxKeys(tx sdk.Tx) (string, []string) {
+	var sender string
+	if stdTx, ok := tx.(auth.StdTx); ok && len(stdTx.GetSigners()) != 0 {
+		sender = stdTx.FeePayer().String()
+	}
+	var contracts []string
+	seen := make(map[string]bool)
+	for _, msg := range tx.GetMsgs() {
+		exec, ok := msg.(wasm.MsgExecuteContract)
+		if !ok {
+			continue
+		}
+		c := exec.Contract.String()
+		if !seen[c] {
+			seen[c] = true
+			contracts = append(contracts, c)
+		}
+	}
+	return sender, contracts
+}
@@ -0,0 +1,69 @@
+package app
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/fetchai/fetchd/x/wasm"
+)
+
+func TestPendingTxLimiter(t *testing.T) {
+	cdc := MakeCodec()
+	var (
+		alice    = sdk.AccAddress([]byte("alice_______________"))
+		bob      = sdk.AccAddress([]byte("bob_________________"))
+		carol    = sdk.AccAddress([]byte("carol_______________"))
+		contract = sdk.AccAddress([]byte("contract____________"))
+	)
+	execTx := func(sender sdk.AccAddress) []byte {
+		return cdc.MustMarshalBinaryLengthPrefixed(auth.StdTx{Msgs: []sdk.Msg{
+			wasm.MsgExecuteContract{Sender: sender, Contract: contract, Msg: []byte(`{}`)},
+			wasm.MsgExecuteContract{Sender: sender, Contract: contract, Msg: []byte(`{}`)},
+		}})
+	}
+	sendTx := func(sender sdk.AccAddress) []byte {
+		return cdc.MustMarshalBinaryLengthPrefixed(auth.StdTx{Msgs: []sdk.Msg{bank.MsgSend{FromAddress: sender, ToAddress: bob}}})
+	}
+	accept := func(abci.RequestCheckTx) abci.ResponseCheckTx { return abci.ResponseCheckTx{} }
+	reject := func(abci.RequestCheckTx) abci.ResponseCheckTx { return abci.ResponseCheckTx{Code: 1} }
+	isLimited := func(res abci.ResponseCheckTx) bool {
+		return res.Codespace == sdkerrors.ErrMempoolIsFull.Codespace() && res.Code == sdkerrors.ErrMempoolIsFull.ABCICode()
+	}
+
+	l := newPendingTxLimiter(auth.DefaultTxDecoder(cdc), MempoolLimitsConfig{MaxPendingPerSender: 2, MaxPendingPerContract: 3})
+	newTx := func(bz []byte) abci.RequestCheckTx { return abci.RequestCheckTx{Tx: bz, Type: abci.CheckTxType_New} }
+
+	// rejected txs are not counted
+	require.False(t, isLimited(l.checkTx(newTx(sendTx(alice)), reject)))
+	require.True(t, l.checkTx(newTx(sendTx(alice)), accept).IsOK())
+	require.True(t, l.checkTx(newTx(execTx(alice)), accept).IsOK())
+	assert.True(t, isLimited(l.checkTx(newTx(sendTx(alice)), accept)), "sender limit")
+
+	require.True(t, l.checkTx(newTx(execTx(bob)), accept).IsOK())
+	require.True(t, l.checkTx(newTx(execTx(bob)), accept).IsOK())
+	assert.True(t, isLimited(l.checkTx(newTx(execTx(carol)), accept)), "contract limit")
+	// txs without the contract are not affected
+	assert.True(t, l.checkTx(newTx(sendTx(carol)), accept).IsOK())
+
+	// the txs left in the mempool are counted again by the recheck after the commit
+	l.commit()
+	assert.True(t, l.checkTx(newTx(sendTx(alice)), accept).IsOK())
+	recheck := abci.RequestCheckTx{Tx: execTx(bob), Type: abci.CheckTxType_Recheck}
+	for i := 0; i < 3; i++ {
+		assert.True(t, l.checkTx(recheck, accept).IsOK(), "rechecks are not limited")
+	}
+	assert.True(t, isLimited(l.checkTx(newTx(execTx(alice)), accept)), "contract limit")
+
+	// 0 disables the limits
+	l = newPendingTxLimiter(auth.DefaultTxDecoder(cdc), MempoolLimitsConfig{})
+	for i := 0; i < 5; i++ {
+		require.True(t, l.checkTx(newTx(execTx(alice)), accept).IsOK())
+	}
+}
@@ -0,0 +1,261 @@
+package app
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	distr "github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/fetchai/fetchd/x/wasm"
+)
+
+type StoreKeysPrefixes struct {
+	A        sdk.StoreKey
+	B        sdk.StoreKey
+	Prefixes [][]byte
+}
+
+// fauxMerkleModeOpt returns a BaseApp option to use a dbStoreAdapter instead of
+// an IAVLStore for faster simulation speed.
+func fauxMerkleModeOpt(bapp *baseapp.BaseApp) {
+	bapp.SetFauxMerkleMode()
+}
+
+// SimulateChain runs the randomized simulation of all modules registered with the simulation manager for
+// config.NumBlocks blocks. Invariants are asserted every invCheckPeriod blocks. The state is then exported and
+// imported into a new app and all stores compared.
+// The simulation is deterministic for the same config, so config.Seed reproduces a failure.
+func SimulateChain(w io.Writer, config simulation.Config, invCheckPeriod uint) error {
+	DisableBlockStats()
+	app := NewWasmApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, invCheckPeriod, wasm.EnableAllProposals, map[int64]bool{}, fauxMerkleModeOpt)
+
+	stopEarly, simParams, err := runSimulation(w, app, config)
+	// export state and simParams before the simulation error is checked
+	if exportErr := simapp.CheckExportSimulation(app, config, simParams); exportErr != nil {
+		return exportErr
+	}
+	if err != nil {
+		return err
+	}
+	if stopEarly {
+		_, _ = fmt.Fprintln(w, "can't export or import a zero-validator genesis, skipping import/export check")
+		return nil
+	}
+
+	_, _ = fmt.Fprintln(w, "exporting genesis...")
+	appState, _, err := app.ExportAppStateAndValidators(false, []string{})
+	if err != nil {
+		return fmt.Errorf("export: %s", err)
+	}
+
+	_, _ = fmt.Fprintln(w, "importing genesis...")
+	newApp := NewWasmApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, invCheckPeriod, wasm.EnableAllProposals, map[int64]bool{}, fauxMerkleModeOpt)
+	var genesisState GenesisState
+	if err := app.Codec().UnmarshalJSON(appState, &genesisState); err != nil {
+		return fmt.Errorf("import: %s", err)
+	}
+	ctxA := app.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
+	ctxB := newApp.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
+	newApp.mm.InitGenesis(ctxB, genesisState)
+
+	_, _ = fmt.Fprintln(w, "comparing stores...")
+	for _, skp := range exportedStores(app, newApp) {
+		failedKVAs, failedKVBs := sdk.DiffKVStores(ctxA.KVStore(skp.A), ctxB.KVStore(skp.B), skp.Prefixes)
+		if len(failedKVAs) != len(failedKVBs) {
+			return fmt.Errorf("unequal sets of key-values to compare in %s", skp.A.Name())
+		}
+		_, _ = fmt.Fprintf(w, "compared %d key/value pairs between %s and %s\n", len(failedKVAs), skp.A, skp.B)
+		if len(failedKVAs) != 0 {
+			return fmt.Errorf("store %s differs after import:\n%s", skp.A.Name(),
+				simapp.GetSimulationLog(skp.A.Name(), app.SimulationManager().StoreDecoders, app.Codec(), failedKVAs, failedKVBs))
+		}
+	}
+	return nil
+}
+
+// runSimulation runs the weighted operations of the simulation manager for config.NumBlocks blocks of
+// config.BlockSize operations, like simulation.SimulateFromSeed but without the testing package. All validators
+// sign every block. Invariant violations panic and are returned as error, like failed operations.
+func runSimulation(w io.Writer, app *WasmApp, config simulation.Config) (stopEarly bool, simParams simulation.Params, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("simulation halted due to panic: %v", r)
+		}
+	}()
+	r := rand.New(rand.NewSource(config.Seed))
+	simParams = simulation.RandomParams(r)
+	accs := simulation.RandomAccounts(r, simParams.NumKeys)
+	appState, accs, chainID, genesisTime := simapp.AppStateFn(app.Codec(), app.SimulationManager())(r, accs, config)
+	if len(accs) == 0 {
+		return true, simParams, fmt.Errorf("must have greater than zero genesis accounts")
+	}
+	res := app.InitChain(abci.RequestInitChain{AppStateBytes: appState, ChainId: chainID})
+	validators := newSimValidators(res.Validators)
+
+	ops := simapp.SimulationOperations(app, app.Codec(), config)
+	var totalWeight int
+	for _, op := range ops {
+		totalWeight += op.Weight
+	}
+	if totalWeight == 0 {
+		return true, simParams, fmt.Errorf("no simulation operations with a weight")
+	}
+	selectOp := func() simulation.Operation {
+		x := r.Intn(totalWeight)
+		for _, op := range ops {
+			if x < op.Weight {
+				return op.Op
+			}
+			x -= op.Weight
+		}
+		return ops[len(ops)-1].Op
+	}
+
+	var futureOps []simulation.FutureOperation
+	header := abci.Header{ChainID: chainID, Height: int64(config.InitialBlockHeight), Time: genesisTime}
+	for i := 0; i < config.NumBlocks; i++ {
+		if validators.empty() {
+			_, _ = fmt.Fprintf(w, "no validators left at height %d\n", header.Height)
+			return true, simParams, nil
+		}
+		header.ProposerAddress = validators.proposer(r)
+		app.BeginBlock(abci.RequestBeginBlock{Header: header, LastCommitInfo: validators.commitInfo()})
+		ctx := app.NewContext(false, header)
+
+		var due []simulation.Operation
+		due, futureOps = dueOperations(futureOps, header)
+		for j := 0; j < config.BlockSize+len(due); j++ {
+			var op simulation.Operation
+			if j < len(due) {
+				op = due[j]
+			} else {
+				op = selectOp()
+			}
+			opMsg, next, err := op(r, app.BaseApp, ctx, accs, chainID)
+			if err != nil {
+				return false, simParams, fmt.Errorf("operation %s %s at height %d: %s", opMsg.Route, opMsg.Name, header.Height, err)
+			}
+			futureOps = append(futureOps, next...)
+			if config.AllInvariants {
+				app.crisisKeeper.AssertInvariants(ctx)
+			}
+		}
+
+		endRes := app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+		validators.update(endRes.ValidatorUpdates)
+		if config.Commit {
+			app.Commit()
+		}
+		if header.Height%100 == 0 {
+			_, _ = fmt.Fprintf(w, "simulated block %d\n", header.Height)
+		}
+		header.Height++
+		header.Time = header.Time.Add(time.Duration(5+r.Intn(5)) * time.Second)
+	}
+	return false, simParams, nil
+}
+
+// dueOperations splits the future operations into the ones due in the block of the header and the others
+func dueOperations(futureOps []simulation.FutureOperation, header abci.Header) (due []simulation.Operation, rest []simulation.FutureOperation) {
+	for _, f := range futureOps {
+		if (f.BlockHeight != 0 && int64(f.BlockHeight) <= header.Height) || (!f.BlockTime.IsZero() && !f.BlockTime.After(header.Time)) {
+			due = append(due, f.Op)
+			continue
+		}
+		rest = append(rest, f)
+	}
+	return due, rest
+}
+
+// simValidators is the validator set of the simulated chain, sorted by address so that the simulation is
+// deterministic
+type simValidators []abci.Validator
+
+func newSimValidators(updates []abci.ValidatorUpdate) *simValidators {
+	v := &simValidators{}
+	v.update(updates)
+	return v
+}
+
+func (v *simValidators) empty() bool {
+	return len(*v) == 0
+}
+
+// update applies the validator updates of the end blocker, a power of 0 removes the validator
+func (v *simValidators) update(updates []abci.ValidatorUpdate) {
+	for _, u := range updates {
+		pubKey, err := tmtypes.PB2TM.PubKey(u.PubKey)
+		if err != nil {
+			panic(err)
+		}
+		address := pubKey.Address().Bytes()
+		vals := *v
+		i := sort.Search(len(vals), func(i int) bool { return bytes.Compare(vals[i].Address, address) >= 0 })
+		found := i < len(vals) && bytes.Equal(vals[i].Address, address)
+		switch {
+		case found && u.Power == 0:
+			vals = append(vals[:i], vals[i+1:]...)
+		case found:
+			vals[i].Power = u.Power
+		case u.Power != 0:
+			vals = append(vals, abci.Validator{})
+			copy(vals[i+1:], vals[i:])
+			vals[i] = abci.Validator{Address: address, Power: u.Power}
+		}
+		*v = vals
+	}
+}
+
+func (v *simValidators) proposer(r *rand.Rand) []byte {
+	return (*v)[r.Intn(len(*v))].Address
+}
+
+// commitInfo returns the last commit signed by all validators
+func (v *simValidators) commitInfo() abci.LastCommitInfo {
+	votes := make([]abci.VoteInfo, len(*v))
+	for i, val := range *v {
+		votes[i] = abci.VoteInfo{Validator: val, SignedLastBlock: true}
+	}
+	return abci.LastCommitInfo{Votes: votes}
+}
+
+// exportedStores returns the stores that must be equal after an export and import
+func exportedStores(a, b *WasmApp) []StoreKeysPrefixes {
+	return []StoreKeysPrefixes{
+		{a.keys[baseapp.MainStoreKey], b.keys[baseapp.MainStoreKey], [][]byte{}},
+		{a.keys[auth.StoreKey], b.keys[auth.StoreKey], [][]byte{}},
+		{a.keys[staking.StoreKey], b.keys[staking.StoreKey],
+			[][]byte{
+				staking.UnbondingQueueKey, staking.RedelegationQueueKey, staking.ValidatorQueueKey,
+			}}, // ordering may change but it doesn't matter
+		{a.keys[slashing.StoreKey], b.keys[slashing.StoreKey], [][]byte{}},
+		{a.keys[mint.StoreKey], b.keys[mint.StoreKey], [][]byte{}},
+		{a.keys[distr.StoreKey], b.keys[distr.StoreKey], [][]byte{}},
+		{a.keys[supply.StoreKey], b.keys[supply.StoreKey], [][]byte{}},
+		{a.keys[params.StoreKey], b.keys[params.StoreKey], [][]byte{}},
+		{a.keys[gov.StoreKey], b.keys[gov.StoreKey], [][]byte{}},
+		{a.keys[wasm.StoreKey], b.keys[wasm.StoreKey],
+			[][]byte{
+				wasm.ContractKeyPrefix, wasm.ContractHistoryStorePrefix,
+			}}, // the created position and the history of contracts are reset on import
+	}
+}
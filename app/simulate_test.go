@@ -0,0 +1,74 @@
+package app
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+func TestSimValidators(t *testing.T) {
+	keys := make([]crypto.PubKey, 3)
+	for i := range keys {
+		keys[i] = ed25519.GenPrivKey().PubKey()
+	}
+	var (
+		update = func(i int, power int64) abci.ValidatorUpdate {
+			return abci.ValidatorUpdate{PubKey: tmtypes.TM2PB.PubKey(keys[i]), Power: power}
+		}
+		powerOf = func(v *simValidators, i int) int64 {
+			for _, val := range *v {
+				if bytes.Equal(val.Address, keys[i].Address()) {
+					return val.Power
+				}
+			}
+			return 0
+		}
+	)
+	v := newSimValidators([]abci.ValidatorUpdate{update(0, 10), update(1, 20), update(2, 30)})
+	require.Len(t, *v, 3)
+	for i := 1; i < len(*v); i++ {
+		assert.True(t, bytes.Compare((*v)[i-1].Address, (*v)[i].Address) < 0, "sorted by address")
+	}
+
+	v.update([]abci.ValidatorUpdate{update(1, 0), update(2, 5)})
+	require.Len(t, *v, 2)
+	assert.Equal(t, int64(10), powerOf(v, 0))
+	assert.Equal(t, int64(0), powerOf(v, 1))
+	assert.Equal(t, int64(5), powerOf(v, 2))
+	assert.Len(t, v.commitInfo().Votes, 2)
+	assert.NotEmpty(t, v.proposer(rand.New(rand.NewSource(1))))
+
+	v.update([]abci.ValidatorUpdate{update(0, 0), update(2, 0)})
+	assert.True(t, v.empty())
+}
+
+func TestDueOperations(t *testing.T) {
+	var (
+		now    = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		header = abci.Header{Height: 10, Time: now}
+		noop   = func(_ *rand.Rand, _ *baseapp.BaseApp, _ sdk.Context, _ []simulation.Account, _ string) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+			return simulation.NoOpMsg(""), nil, nil
+		}
+	)
+	due, rest := dueOperations([]simulation.FutureOperation{
+		{BlockHeight: 10, Op: noop},
+		{BlockHeight: 11, Op: noop},
+		{BlockTime: now, Op: noop},
+		{BlockTime: now.Add(time.Second), Op: noop},
+	}, header)
+	assert.Len(t, due, 2)
+	require.Len(t, rest, 2)
+	assert.Equal(t, 11, rest[0].BlockHeight)
+	assert.Equal(t, now.Add(time.Second), rest[1].BlockTime)
+}
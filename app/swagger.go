@@ -0,0 +1,118 @@
+package app
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/gorilla/mux"
+)
+
+// SwaggerRoute is the path of the API server the OpenAPI spec is served on
+const SwaggerRoute = "/swagger/"
+
+// SwaggerConfig is the [swagger] section of app.toml
+type SwaggerConfig struct {
+	// Enable serves the OpenAPI spec of the REST routes on the API server
+	Enable bool `mapstructure:"enable"`
+}
+
+// DefaultSwaggerConfig returns the default settings for SwaggerConfig
+func DefaultSwaggerConfig() SwaggerConfig {
+	return SwaggerConfig{Enable: true}
+}
+
+// SwaggerWrapper allows us to use namespacing in the config file
+type SwaggerWrapper struct {
+	Swagger SwaggerConfig `mapstructure:"swagger"`
+}
+
+// pathParam matches the variables of mux path templates, with an optional pattern
+var pathParam = regexp.MustCompile(`{([^}:]+)(:[^}]*)?}`)
+
+type swaggerSpec struct {
+	Swagger  string                                 `json:"swagger"`
+	Info     swaggerInfo                            `json:"info"`
+	Produces []string                               `json:"produces"`
+	Paths    map[string]map[string]swaggerOperation `json:"paths"`
+}
+
+type swaggerInfo struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+type swaggerOperation struct {
+	Tags       []string                   `json:"tags"`
+	Parameters []swaggerParameter         `json:"parameters,omitempty"`
+	Responses  map[string]swaggerResponse `json:"responses"`
+}
+
+type swaggerParameter struct {
+	Name     string `json:"name"`
+	In       string `json:"in"`
+	Required bool   `json:"required"`
+	Type     string `json:"type"`
+}
+
+type swaggerResponse struct {
+	Description string `json:"description"`
+}
+
+// buildSwaggerSpec returns the OpenAPI 2.0 spec of all routes of the router with methods, tagged with the first
+// segment of their path, which is the module for the module routes. The request and response bodies are amino
+// json, they are not described.
+func buildSwaggerSpec(router *mux.Router) (swaggerSpec, error) {
+	spec := swaggerSpec{
+		Swagger:  "2.0",
+		Info:     swaggerInfo{Title: version.Name + " REST API", Version: version.Version},
+		Produces: []string{"application/json"},
+		Paths:    make(map[string]map[string]swaggerOperation),
+	}
+	err := router.Walk(func(route *mux.Route, _ *mux.Router, _ []*mux.Route) error {
+		tmpl, err := route.GetPathTemplate()
+		if err != nil {
+			return nil
+		}
+		methods, err := route.GetMethods()
+		if err != nil {
+			return nil
+		}
+		var params []swaggerParameter
+		for _, m := range pathParam.FindAllStringSubmatch(tmpl, -1) {
+			params = append(params, swaggerParameter{Name: m[1], In: "path", Required: true, Type: "string"})
+		}
+		path := pathParam.ReplaceAllString(tmpl, "{$1}")
+		tag := strings.SplitN(strings.TrimPrefix(path, "/"), "/", 2)[0]
+		if spec.Paths[path] == nil {
+			spec.Paths[path] = make(map[string]swaggerOperation)
+		}
+		for _, method := range methods {
+			spec.Paths[path][strings.ToLower(method)] = swaggerOperation{
+				Tags:       []string{tag},
+				Parameters: params,
+				Responses:  map[string]swaggerResponse{"200": {Description: "amino json result"}},
+			}
+		}
+		return nil
+	})
+	return spec, err
+}
+
+// registerSwaggerRoute serves the spec of the routes registered on the router so far
+func registerSwaggerRoute(router *mux.Router) {
+	spec, err := buildSwaggerSpec(router)
+	if err != nil {
+		panic("error while building swagger spec: " + err.Error())
+	}
+	bz, err := json.MarshalIndent(spec, "", "  ")
+	if err != nil {
+		panic("error while encoding swagger spec: " + err.Error())
+	}
+	router.Methods(http.MethodGet).Path(SwaggerRoute).HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(bz)
+	})
+}
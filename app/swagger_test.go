@@ -0,0 +1,41 @@
+package app
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSwaggerRoute(t *testing.T) {
+	noop := func(http.ResponseWriter, *http.Request) {}
+	router := mux.NewRouter()
+	router.HandleFunc("/wasm/contract/{contractAddr}/smart/{query}", noop).Methods("GET")
+	router.HandleFunc("/bank/accounts/{address:[a-z0-9]+}/transfers", noop).Methods("POST")
+	router.HandleFunc("/txs", noop).Methods("GET", "POST")
+	router.HandleFunc("/no_methods", noop)
+	registerSwaggerRoute(router)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, SwaggerRoute, nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var spec swaggerSpec
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &spec))
+
+	assert.Equal(t, "2.0", spec.Swagger)
+	require.Len(t, spec.Paths, 3)
+	op := spec.Paths["/wasm/contract/{contractAddr}/smart/{query}"]["get"]
+	assert.Equal(t, []string{"wasm"}, op.Tags)
+	assert.Equal(t, []swaggerParameter{
+		{Name: "contractAddr", In: "path", Required: true, Type: "string"},
+		{Name: "query", In: "path", Required: true, Type: "string"},
+	}, op.Parameters)
+	op = spec.Paths["/bank/accounts/{address}/transfers"]["post"]
+	assert.Equal(t, []string{"bank"}, op.Tags)
+	assert.Equal(t, []swaggerParameter{{Name: "address", In: "path", Required: true, Type: "string"}}, op.Parameters)
+	assert.Len(t, spec.Paths["/txs"], 2)
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/fetchai/fetchd/app"
+)
+
+// blockStatsCmd queries the block statistics a node keeps for its most recent blocks
+func blockStatsCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "blockstats [height|from-to]",
+		Short: "Query gas and execution statistics of a block or of a range of blocks",
+		Long: fmt.Sprintf(`Query gas used, transaction counts by message type, wasm executions and failed transactions
+of a block, or of a range of at most %d blocks given as from-to.
+The statistics are kept in the local storage of the queried node for its most recent blocks only.
+`, app.MaxBlockStatsRange),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var route string
+			if parts := strings.SplitN(args[0], "-", 2); len(parts) == 2 {
+				from, err := strconv.ParseInt(parts[0], 10, 64)
+				if err != nil {
+					return fmt.Errorf("from height: %s", err)
+				}
+				to, err := strconv.ParseInt(parts[1], 10, 64)
+				if err != nil {
+					return fmt.Errorf("to height: %s", err)
+				}
+				route = fmt.Sprintf("custom/%s/%s/%d/%d", app.BlockStatsQuerierRoute, app.QueryBlockStatsRange, from, to)
+			} else {
+				height, err := strconv.ParseInt(args[0], 10, 64)
+				if err != nil {
+					return fmt.Errorf("height: %s", err)
+				}
+				route = fmt.Sprintf("custom/%s/%s/%d", app.BlockStatsQuerierRoute, app.QueryBlockStatsHeight, height)
+			}
+
+			res, _, err := cliCtx.Query(route)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+	return flags.GetCommands(cmd)[0]
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+
+	"github.com/fetchai/fetchd/x/denom"
+	denomcli "github.com/fetchai/fetchd/x/denom/client/cli"
+)
+
+// amountFlags are the tx flags whose coins can be given in the units of the denom metadata
+var amountFlags = []string{"amount", "deposit", flags.FlagFees}
+
+// wrapDisplayAmounts lets the tx commands take amounts in the units of the denom metadata, e.g. 1.5fet, which are
+// converted to coins of their base denom before the command parses them. Amounts without such units are passed on
+// as they are, without querying the metadata.
+func wrapDisplayAmounts(cdc *codec.Codec, txCmd *cobra.Command) {
+	for _, c := range txCmd.Commands() {
+		switch {
+		case c.HasSubCommands():
+			wrapDisplayAmounts(cdc, c)
+		case c.RunE == nil:
+		default:
+			amountArg := amountArgIndex(c)
+			runE := c.RunE
+			c.RunE = func(cmd *cobra.Command, args []string) error {
+				conv := displayAmountConverter{cliCtx: context.NewCLIContext().WithCodec(cdc)}
+				if amountArg >= 0 && amountArg < len(args) {
+					amount, err := conv.toBase(args[amountArg])
+					if err != nil {
+						return err
+					}
+					args = append([]string{}, args...)
+					args[amountArg] = amount
+				}
+				for _, name := range amountFlags {
+					if f := cmd.Flags().Lookup(name); f == nil || !f.Changed || f.Value.Type() != "string" {
+						continue
+					}
+					amount, err := conv.toBase(viper.GetString(name))
+					if err != nil {
+						return err
+					}
+					if err := cmd.Flags().Set(name, amount); err != nil {
+						return err
+					}
+					viper.Set(name, amount)
+				}
+				return runE(cmd, args)
+			}
+		}
+	}
+}
+
+// amountArgIndex returns the index of the amount arg of tx send and tx bank burn, -1 for other commands
+func amountArgIndex(c *cobra.Command) int {
+	switch {
+	case c.Name() == "send" && c.Parent().Name() == "tx":
+		return 2
+	case c.Name() == "burn" && c.Parent().Name() == bank.ModuleName:
+		return 0
+	}
+	return -1
+}
+
+// displayAmountConverter queries the denom metadata once, when the first amount with units is converted
+type displayAmountConverter struct {
+	cliCtx   context.CLIContext
+	metadata []denom.Metadata
+	queried  bool
+}
+
+// toBase returns the amount in base denoms when it has coins of units other than the base denom of their metadata.
+// Anything else, also amounts that are no coins like cw20 token amounts, is returned as it is.
+func (c *displayAmountConverter) toBase(amount string) (string, error) {
+	decCoins, err := sdk.ParseDecCoins(amount)
+	if err != nil || len(decCoins) == 0 {
+		return amount, nil
+	}
+	if !c.queried {
+		c.queried = true
+		// offline commands and nodes without the denom module use the amount as it is
+		c.metadata, _ = denomcli.QueryAllDenomMetadata(c.cliCtx)
+	}
+	for _, d := range decCoins {
+		for _, m := range c.metadata {
+			if _, ok := m.Unit(d.Denom); ok && d.Denom != m.Base {
+				coins, err := denom.ParseCoins(amount, c.metadata)
+				if err != nil {
+					return "", err
+				}
+				return coins.String(), nil
+			}
+		}
+	}
+	return amount, nil
+}
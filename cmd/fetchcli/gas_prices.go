@@ -0,0 +1,50 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	feemarketcli "github.com/fetchai/fetchd/x/feemarket/client/cli"
+)
+
+// autoGasPrices is the --gas-prices value that is replaced by the gas price of the fee market
+const autoGasPrices = "auto"
+
+// wrapAutoGasPrices lets the tx commands take --gas-prices auto, which is replaced by the base fee of the fee market
+// with a margin for its growth before the command builds the tx. While the fee market is disabled the tx has no gas
+// prices.
+func wrapAutoGasPrices(cdc *codec.Codec, txCmd *cobra.Command) {
+	for _, c := range txCmd.Commands() {
+		switch {
+		case c.HasSubCommands():
+			wrapAutoGasPrices(cdc, c)
+		case c.RunE == nil || c.Flags().Lookup(flags.FlagGasPrices) == nil:
+		default:
+			runE := c.RunE
+			c.RunE = func(cmd *cobra.Command, args []string) error {
+				if viper.GetString(flags.FlagGasPrices) != autoGasPrices {
+					return runE(cmd, args)
+				}
+				gasPrice, err := feemarketcli.QueryAutoGasPrice(context.NewCLIContext().WithCodec(cdc))
+				if err != nil {
+					return fmt.Errorf("auto gas prices: %s", err)
+				}
+				var gasPrices string
+				if gasPrice.IsPositive() {
+					gasPrices = gasPrice.String()
+				}
+				if err := cmd.Flags().Set(flags.FlagGasPrices, gasPrices); err != nil {
+					return err
+				}
+				viper.Set(flags.FlagGasPrices, gasPrices)
+				return runE(cmd, args)
+			}
+		}
+	}
+}
@@ -0,0 +1,515 @@
+package main
+
+import (
+	"bufio"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v2"
+
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	clientkeys "github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/mintkey"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	flagDescription = "description"
+	flagTags        = "tags"
+	flagKeyChain    = "chain"
+	flagArchived    = "archived"
+	flagListNames   = "list-names"
+	flagCoinType    = "coin-type"
+	flagUnarmored   = "unarmored-hex"
+	flagUnsafe      = "unsafe"
+	// flags of the sdk keys add command
+	flagHDPath  = "hd-path"
+	flagAccount = "account"
+	flagIndex   = "index"
+
+	keyMetadataFile = "metadata.json"
+)
+
+// KeyMetadata is the operator provided information kept next to a key of the keyring
+type KeyMetadata struct {
+	Description string   `json:"description,omitempty" yaml:"description,omitempty"`
+	Tags        []string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	// Chain is the chain id the key is intended to be used on
+	Chain string `json:"chain,omitempty" yaml:"chain,omitempty"`
+	// Archived keys are soft deleted, they are hidden from the key list but kept in the keyring
+	Archived bool `json:"archived,omitempty" yaml:"archived,omitempty"`
+}
+
+// KeyOutputWithMetadata is a key list entry
+type KeyOutputWithMetadata struct {
+	keys.KeyOutput `yaml:",inline"`
+	KeyMetadata    `yaml:",inline"`
+}
+
+// keyMetadataStore keeps the metadata of all keys in a single json file of the client home
+type keyMetadataStore struct {
+	path string
+	Keys map[string]KeyMetadata `json:"keys"`
+}
+
+func loadKeyMetadata(home string) (*keyMetadataStore, error) {
+	s := &keyMetadataStore{
+		path: filepath.Join(home, "keys", keyMetadataFile),
+		Keys: make(map[string]KeyMetadata),
+	}
+	bz, err := ioutil.ReadFile(s.path)
+	switch {
+	case os.IsNotExist(err):
+		return s, nil
+	case err != nil:
+		return nil, err
+	}
+	if err := json.Unmarshal(bz, s); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", s.path, err)
+	}
+	if s.Keys == nil {
+		s.Keys = make(map[string]KeyMetadata)
+	}
+	return s, nil
+}
+
+func (s *keyMetadataStore) save() error {
+	bz, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0700); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(s.path, bz, 0600)
+}
+
+// extendKeysCmd adds the metadata handling to the keys commands of the sdk
+func extendKeysCmd(keysCmd *cobra.Command) *cobra.Command {
+	for _, c := range keysCmd.Commands() {
+		switch c.Name() {
+		case "list":
+			keysCmd.RemoveCommand(c)
+		case "delete":
+			wrapDeleteKeyCmd(c)
+		case "show":
+			wrapShowKeyCmd(c)
+		case "add":
+			wrapAddKeyCmd(c)
+		case "export":
+			wrapExportKeyCmd(c)
+		}
+	}
+	keysCmd.AddCommand(
+		listKeysCmd(),
+		keyMetadataCmd(),
+		renameKeyCmd(),
+		migrateBackendCmd(),
+		importHexKeyCmd(),
+		signMessageCmd(),
+		verifyMessageCmd(),
+		archiveKeyCmd(true),
+		archiveKeyCmd(false),
+	)
+	return keysCmd
+}
+
+func newKeybase(cmd *cobra.Command) (keys.Keybase, error) {
+	return keys.NewKeyring(
+		sdk.KeyringServiceName(),
+		viper.GetString(flags.FlagKeyringBackend),
+		viper.GetString(flags.FlagHome),
+		bufio.NewReader(cmd.InOrStdin()),
+	)
+}
+
+func listKeysCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List all keys",
+		Long: `Return a list of all public keys stored by this key manager
+along with their associated name, address and metadata. Archived keys are only listed with --archived.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			kb, err := newKeybase(cmd)
+			if err != nil {
+				return err
+			}
+			meta, err := loadKeyMetadata(viper.GetString(flags.FlagHome))
+			if err != nil {
+				return err
+			}
+			infos, err := kb.List()
+			if err != nil {
+				return err
+			}
+			outputs, err := keys.Bech32KeysOutput(infos)
+			if err != nil {
+				return err
+			}
+
+			withArchived := viper.GetBool(flagArchived)
+			res := make([]KeyOutputWithMetadata, 0, len(outputs))
+			for _, o := range outputs {
+				m := meta.Keys[o.Name]
+				if m.Archived && !withArchived {
+					continue
+				}
+				res = append(res, KeyOutputWithMetadata{KeyOutput: o, KeyMetadata: m})
+			}
+
+			if viper.GetBool(flagListNames) {
+				for _, o := range res {
+					fmt.Fprintln(cmd.OutOrStdout(), o.Name)
+				}
+				return nil
+			}
+			return printKeyOutput(cmd, res)
+		},
+	}
+	cmd.Flags().Bool(flagArchived, false, "Include archived keys")
+	cmd.Flags().BoolP(flagListNames, "n", false, "List names only")
+	return cmd
+}
+
+func keyMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "metadata [name]",
+		Short: "Show or update the description, tags and intended chain of a key",
+		Long: `Show the metadata of a key. When any of --description, --tags or --chain is given,
+the value is replaced, an empty value clears it.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			kb, err := newKeybase(cmd)
+			if err != nil {
+				return err
+			}
+			if _, err := kb.Get(args[0]); err != nil {
+				return err
+			}
+			meta, err := loadKeyMetadata(viper.GetString(flags.FlagHome))
+			if err != nil {
+				return err
+			}
+
+			m := meta.Keys[args[0]]
+			changed := false
+			if cmd.Flags().Changed(flagDescription) {
+				m.Description = viper.GetString(flagDescription)
+				changed = true
+			}
+			if cmd.Flags().Changed(flagTags) {
+				m.Tags = parseTags(viper.GetString(flagTags))
+				changed = true
+			}
+			if cmd.Flags().Changed(flagKeyChain) {
+				m.Chain = viper.GetString(flagKeyChain)
+				changed = true
+			}
+			if changed {
+				meta.Keys[args[0]] = m
+				if err := meta.save(); err != nil {
+					return err
+				}
+			}
+			return printKeyOutput(cmd, m)
+		},
+	}
+	cmd.Flags().String(flagDescription, "", "Free text description of the key")
+	cmd.Flags().String(flagTags, "", "Comma separated list of tags, e.g. deployer,testnet")
+	cmd.Flags().String(flagKeyChain, "", "Chain id the key is intended to be used on")
+	return cmd
+}
+
+func renameKeyCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "rename [old_name] [new_name]",
+		Short: "Rename a key",
+		Long: `Rename a key of the keyring together with its metadata.
+The key is re-imported under the new name before the old entry is removed.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			oldName, newName := args[0], args[1]
+			kb, err := newKeybase(cmd)
+			if err != nil {
+				return err
+			}
+			info, err := kb.Get(oldName)
+			if err != nil {
+				return err
+			}
+			if _, err := kb.Get(newName); err == nil {
+				return fmt.Errorf("key %s already exists", newName)
+			}
+
+			if err := copyKey(kb, kb, info, newName); err != nil {
+				return err
+			}
+			if err := kb.Delete(oldName, "", true); err != nil {
+				return err
+			}
+
+			meta, err := loadKeyMetadata(viper.GetString(flags.FlagHome))
+			if err != nil {
+				return err
+			}
+			if m, ok := meta.Keys[oldName]; ok {
+				meta.Keys[newName] = m
+				delete(meta.Keys, oldName)
+				if err := meta.save(); err != nil {
+					return err
+				}
+			}
+			fmt.Fprintf(cmd.ErrOrStderr(), "Key %s renamed to %s\n", oldName, newName)
+			return nil
+		},
+	}
+}
+
+func migrateBackendCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "migrate-backend [source_backend]",
+		Short: "Copy all keys of another keyring backend into the keyring of --keyring-backend",
+		Long: `Copy the keys of the keyring of the source backend, e.g. test or file, into the keyring of --keyring-backend,
+e.g. os. Keys whose name already exists in the target keyring are skipped. The keys are kept in the source keyring,
+delete them with "keys delete --keyring-backend <source_backend>" once the migration was checked.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			backend := viper.GetString(flags.FlagKeyringBackend)
+			if args[0] == backend {
+				return fmt.Errorf("source and target backend are both %s", backend)
+			}
+			// both keyrings read their passphrase prompts from the same input
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			home := viper.GetString(flags.FlagHome)
+			src, err := keys.NewKeyring(sdk.KeyringServiceName(), args[0], home, inBuf)
+			if err != nil {
+				return err
+			}
+			dst, err := keys.NewKeyring(sdk.KeyringServiceName(), backend, home, inBuf)
+			if err != nil {
+				return err
+			}
+			infos, err := src.List()
+			if err != nil {
+				return err
+			}
+			for _, info := range infos {
+				if _, err := dst.Get(info.GetName()); err == nil {
+					fmt.Fprintf(cmd.ErrOrStderr(), "Key %s exists in the %s keyring, skipped\n", info.GetName(), backend)
+					continue
+				}
+				if err := copyKey(src, dst, info, info.GetName()); err != nil {
+					return fmt.Errorf("key %s: %w", info.GetName(), err)
+				}
+				fmt.Fprintf(cmd.ErrOrStderr(), "Key %s migrated to the %s keyring\n", info.GetName(), backend)
+			}
+			return nil
+		},
+	}
+}
+
+// copyKey imports the key of the src keyring into the dst keyring under the name
+func copyKey(src, dst keys.Keybase, info keys.Info, name string) error {
+	if info.GetType() != keys.TypeLocal {
+		// ledger, offline and multisig keys have no private key in the keyring
+		armor, err := src.Export(info.GetName())
+		if err != nil {
+			return err
+		}
+		return dst.Import(name, armor)
+	}
+	armor, err := src.ExportPrivKey(info.GetName(), clientkeys.DefaultKeyPass, clientkeys.DefaultKeyPass)
+	if err != nil {
+		return err
+	}
+	return dst.ImportPrivKey(name, armor, clientkeys.DefaultKeyPass)
+}
+
+func archiveKeyCmd(archive bool) *cobra.Command {
+	use, short := "archive [name]", "Hide a key from the key list without deleting it"
+	if !archive {
+		use, short = "unarchive [name]", "Show an archived key in the key list again"
+	}
+	return &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			kb, err := newKeybase(cmd)
+			if err != nil {
+				return err
+			}
+			if _, err := kb.Get(args[0]); err != nil {
+				return err
+			}
+			meta, err := loadKeyMetadata(viper.GetString(flags.FlagHome))
+			if err != nil {
+				return err
+			}
+			m := meta.Keys[args[0]]
+			m.Archived = archive
+			meta.Keys[args[0]] = m
+			return meta.save()
+		},
+	}
+}
+
+// wrapAddKeyCmd adds --coin-type to keys add, it sets the BIP44 hd path of the coin type with --account and --index.
+// Keys of other chains and wallets, e.g. of Ethereum with coin type 60, are recovered with the same private key.
+func wrapAddKeyCmd(addCmd *cobra.Command) {
+	addCmd.Flags().Uint32(flagCoinType, sdk.GetConfig().GetCoinType(), "Coin type of the BIP44 hd path, e.g. 60 for keys of Ethereum wallets")
+	runE := addCmd.RunE
+	addCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if cmd.Flags().Changed(flagCoinType) {
+			if cmd.Flags().Changed(flagHDPath) {
+				return fmt.Errorf("--%s and --%s are mutually exclusive", flagCoinType, flagHDPath)
+			}
+			coinType, err := cmd.Flags().GetUint32(flagCoinType)
+			if err != nil {
+				return err
+			}
+			path := hd.NewFundraiserParams(viper.GetUint32(flagAccount), coinType, viper.GetUint32(flagIndex))
+			if err := cmd.Flags().Set(flagHDPath, path.String()); err != nil {
+				return err
+			}
+		}
+		return runE(cmd, args)
+	}
+}
+
+// wrapExportKeyCmd adds --unarmored-hex to keys export, it prints the unencrypted private key as hex for wallets and
+// custody systems that can not import the armor. It requires --unsafe and a confirmation.
+func wrapExportKeyCmd(exportCmd *cobra.Command) {
+	exportCmd.Flags().Bool(flagUnarmored, false, "Print the unencrypted private key as hex instead of the armor")
+	exportCmd.Flags().Bool(flagUnsafe, false, "Enable the unsafe --unarmored-hex export")
+	runE := exportCmd.RunE
+	exportCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if !viper.GetBool(flagUnarmored) {
+			return runE(cmd, args)
+		}
+		if !viper.GetBool(flagUnsafe) {
+			return fmt.Errorf("--%s requires --%s", flagUnarmored, flagUnsafe)
+		}
+		inBuf := bufio.NewReader(cmd.InOrStdin())
+		ok, err := input.GetConfirmation("WARNING: The private key will be printed unencrypted, anyone who sees it controls the account. Continue?", inBuf)
+		if err != nil || !ok {
+			return err
+		}
+		kb, err := keys.NewKeyring(sdk.KeyringServiceName(), viper.GetString(flags.FlagKeyringBackend), viper.GetString(flags.FlagHome), inBuf)
+		if err != nil {
+			return err
+		}
+		priv, err := kb.ExportPrivateKeyObject(args[0], clientkeys.DefaultKeyPass)
+		if err != nil {
+			return err
+		}
+		secpPriv, ok := priv.(secp256k1.PrivKeySecp256k1)
+		if !ok {
+			return fmt.Errorf("key %s is not a secp256k1 key", args[0])
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), hex.EncodeToString(secpPriv[:]))
+		return nil
+	}
+}
+
+func importHexKeyCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "import-hex [name] [hex]",
+		Short: "Import an unencrypted hex encoded secp256k1 private key into the keyring",
+		Long: `Import the private key printed by "keys export --unarmored-hex" or by other wallets as 32 bytes of hex.
+The key is stored encrypted like keys added with a mnemonic, but it has no mnemonic to recover it from.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bz, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(args[1]), "0x"))
+			if err != nil {
+				return err
+			}
+			var priv secp256k1.PrivKeySecp256k1
+			if len(bz) != len(priv) {
+				return fmt.Errorf("private key must be %d bytes, got %d", len(priv), len(bz))
+			}
+			copy(priv[:], bz)
+
+			kb, err := newKeybase(cmd)
+			if err != nil {
+				return err
+			}
+			if _, err := kb.Get(args[0]); err == nil {
+				return fmt.Errorf("key %s already exists", args[0])
+			}
+			armor := mintkey.EncryptArmorPrivKey(priv, clientkeys.DefaultKeyPass, string(keys.Secp256k1))
+			if err := kb.ImportPrivKey(args[0], armor, clientkeys.DefaultKeyPass); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.ErrOrStderr(), "Key %s imported with address %s\n", args[0], sdk.AccAddress(priv.PubKey().Address()))
+			return nil
+		},
+	}
+}
+
+// wrapDeleteKeyCmd removes the metadata of the keys deleted from the keyring
+func wrapDeleteKeyCmd(deleteCmd *cobra.Command) {
+	runE := deleteCmd.RunE
+	deleteCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if err := runE(cmd, args); err != nil {
+			return err
+		}
+		kb, err := newKeybase(cmd)
+		if err != nil {
+			return err
+		}
+		meta, err := loadKeyMetadata(viper.GetString(flags.FlagHome))
+		if err != nil {
+			return err
+		}
+		for _, name := range args {
+			// the deletion can be aborted at the confirmation prompt
+			if _, err := kb.Get(name); err == nil {
+				continue
+			}
+			delete(meta.Keys, name)
+		}
+		return meta.save()
+	}
+}
+
+func parseTags(s string) []string {
+	var tags []string
+	for _, t := range strings.Split(s, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			tags = append(tags, t)
+		}
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+func printKeyOutput(cmd *cobra.Command, o interface{}) error {
+	var bz []byte
+	var err error
+	if viper.GetString(cli.OutputFlag) == "json" {
+		bz, err = json.MarshalIndent(o, "", "  ")
+	} else {
+		bz, err = yaml.Marshal(o)
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+	return nil
+}
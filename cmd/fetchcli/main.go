@@ -23,6 +23,9 @@ import (
 	"github.com/tendermint/tendermint/libs/cli"
 
 	"github.com/fetchai/fetchd/app"
+	denomcli "github.com/fetchai/fetchd/x/denom/client/cli"
+	vestingcli "github.com/fetchai/fetchd/x/vesting/client/cli"
+	wasmUtils "github.com/fetchai/fetchd/x/wasm/client/utils"
 )
 
 func main() {
@@ -60,8 +63,9 @@ func main() {
 		client.ConfigCmd(app.DefaultCLIHome),
 		queryCmd(cdc),
 		txCmd(cdc),
+		wasmCmd(cdc),
 		flags.LineBreak,
-		keys.Commands(),
+		extendKeysCmd(keys.Commands()),
 		flags.LineBreak,
 		version.Cmd,
 		flags.NewCompletionCmd(rootCmd, true),
@@ -91,11 +95,17 @@ func queryCmd(cdc *amino.Codec) *cobra.Command {
 		rpc.BlockCommand(),
 		authcmd.QueryTxsByEventsCmd(cdc),
 		authcmd.QueryTxCmd(cdc),
+		blockStatsCmd(cdc),
 		flags.LineBreak,
 	)
 
 	// add modules' query commands
 	app.ModuleBasics.AddQueryCommands(queryCmd, cdc)
+	for _, cmd := range queryCmd.Commands() {
+		if cmd.Use == auth.ModuleName {
+			cmd.AddCommand(multisigStatusCmd(cdc), vestingcli.GetCmdQueryVestingSchedule(cdc))
+		}
+	}
 
 	return queryCmd
 }
@@ -111,6 +121,7 @@ func txCmd(cdc *amino.Codec) *cobra.Command {
 		flags.LineBreak,
 		authcmd.GetSignCommand(cdc),
 		authcmd.GetMultiSignCommand(cdc),
+		signBatchCmd(cdc),
 		flags.LineBreak,
 		authcmd.GetBroadcastCommand(cdc),
 		authcmd.GetEncodeCommand(cdc),
@@ -133,6 +144,19 @@ func txCmd(cdc *amino.Codec) *cobra.Command {
 	}
 
 	txCmd.RemoveCommand(cmdsToRemove...)
+	// bank send stays the root send command, the bank group holds the coin burning
+	bankTxCmd := &cobra.Command{
+		Use:                        bank.ModuleName,
+		Short:                      "Bank transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	bankTxCmd.AddCommand(wasmUtils.AddBroadcastFlags(flags.PostCommands(denomcli.BurnCmd(cdc))...)...)
+	txCmd.AddCommand(bankTxCmd)
+	wrapWatchOnlyTxCmds(txCmd)
+	wrapDisplayAmounts(cdc, txCmd)
+	wrapAutoGasPrices(cdc, txCmd)
 
 	return txCmd
 }
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	wasmUtils "github.com/fetchai/fetchd/x/wasm/client/utils"
+)
+
+const flagOffline = "offline"
+
+// multisigStatusCmd prints which keys of the signers of a tx have signed it and which signatures are missing
+func multisigStatusCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multisig-status [tx_file]",
+		Short: "Show the signatures of a multisig tx that are still missing",
+		Long: `Print for every signer of the tx whether it has signed and, for multisig signers, which of its keys have
+signed, nested multisig keys included. The tx is a tx as generated or assembled by tx multisign. The pub keys of
+signers without a signature in the tx are taken from the keyring or, unless --offline, from their account.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			stdTx, err := utils.ReadStdTxFromFile(cdc, args[0])
+			if err != nil {
+				return err
+			}
+			kb, err := keys.NewKeyring(sdk.KeyringServiceName(), viper.GetString(flags.FlagKeyringBackend), viper.GetString(flags.FlagHome), bufio.NewReader(cmd.InOrStdin()))
+			if err != nil {
+				return err
+			}
+			pubKeyOf := func(addr sdk.AccAddress) (crypto.PubKey, error) {
+				if info, err := kb.GetByAddress(addr); err == nil {
+					return info.GetPubKey(), nil
+				}
+				if viper.GetBool(flagOffline) {
+					return nil, nil
+				}
+				acc, err := auth.NewAccountRetriever(cliCtx).GetAccount(addr)
+				if err != nil {
+					return nil, err
+				}
+				return acc.GetPubKey(), nil
+			}
+			statuses, err := wasmUtils.MultisigStatus(stdTx, pubKeyOf)
+			if err != nil {
+				return err
+			}
+			return wasmUtils.PrintMultisigStatus(cmd.OutOrStdout(), statuses)
+		},
+	}
+	cmd.Flags().Bool(flagOffline, false, "Do not query the accounts of the signers for their pub keys")
+	cmd = flags.GetCommands(cmd)[0]
+	// the keyring is read like by the tx commands
+	if cmd.Flags().Lookup(flags.FlagKeyringBackend) == nil {
+		cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|test)")
+	}
+	return cmd
+}
+
+// wrapShowKeyCmd sorts the keys of a multisig shown with --multisig-threshold by address, like keys add --multisig
+// does, so that the same keys always show the same multisig address whatever the order of the names
+func wrapShowKeyCmd(showCmd *cobra.Command) {
+	runE := showCmd.RunE
+	showCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if len(args) < 2 {
+			return runE(cmd, args)
+		}
+		kb, err := newKeybase(cmd)
+		if err != nil {
+			return err
+		}
+		addrs := make(map[string][]byte, len(args))
+		for _, name := range args {
+			info, err := kb.Get(name)
+			if err != nil {
+				return err
+			}
+			addrs[name] = info.GetPubKey().Address()
+		}
+		sorted := append([]string{}, args...)
+		sort.Slice(sorted, func(i, j int) bool { return bytes.Compare(addrs[sorted[i]], addrs[sorted[j]]) < 0 })
+		return runE(cmd, sorted)
+	}
+}
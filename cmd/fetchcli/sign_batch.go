@@ -0,0 +1,101 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	wasmUtils "github.com/fetchai/fetchd/x/wasm/client/utils"
+)
+
+const flagOutputDocument = "output-document"
+
+// signBatchCmd signs a file of unsigned txs offline, with consecutive sequences starting at --sequence
+func signBatchCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-batch [file]",
+		Short: "Sign a file of unsigned transactions offline",
+		Long: `Sign the unsigned transactions of the file, one amino json tx per line as generated with --generate-only, with the
+--from key. No node is queried: the txs are signed with --account-number and the sequences --sequence, --sequence+1,
+up to the last line, so that they can be broadcast in the same order later. The signed txs are printed one per line,
+a summary of the wasm msgs of every tx goes to stderr for review.
+
+fetchcli tx sign-batch unsigned.jsonl --from deployer --chain-id fetchhub-1 --account-number 12 --sequence 40 > signed.jsonl
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			if !cmd.Flags().Changed(flags.FlagAccountNumber) || !cmd.Flags().Changed(flags.FlagSequence) {
+				return fmt.Errorf("--%s and --%s are required to sign offline", flags.FlagAccountNumber, flags.FlagSequence)
+			}
+
+			f, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			out := cmd.OutOrStdout()
+			if doc := viper.GetString(flagOutputDocument); doc != "" {
+				outFile, err := os.Create(doc)
+				if err != nil {
+					return err
+				}
+				defer outFile.Close()
+				out = outFile
+			}
+			return signBatch(cliCtx, txBldr, bufio.NewReader(f), out)
+		},
+	}
+	cmd.Flags().String(flagOutputDocument, "", "The document will be written to the given file instead of STDOUT")
+	return flags.PostCommands(cmd)[0]
+}
+
+// signBatch signs the txs read line by line, the sequence of the tx builder is incremented for every tx
+func signBatch(cliCtx context.CLIContext, txBldr auth.TxBuilder, r *bufio.Reader, w io.Writer) error {
+	sequence := txBldr.Sequence()
+	for line := 1; ; line++ {
+		// lines of store code txs exceed the buffer of a bufio.Scanner
+		bz, err := r.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return err
+		}
+		if bz = bytes.TrimSpace(bz); len(bz) != 0 {
+			var stdTx auth.StdTx
+			if err := cliCtx.Codec.UnmarshalJSON(bz, &stdTx); err != nil {
+				return fmt.Errorf("line %d: %w", line, err)
+			}
+			_, _ = fmt.Fprintf(os.Stderr, "tx of line %d, sequence %d\n", line, sequence)
+			if err := wasmUtils.PrintTxSummary(os.Stderr, stdTx); err != nil {
+				return err
+			}
+			signed, err := utils.SignStdTx(txBldr.WithSequence(sequence), cliCtx, cliCtx.GetFromName(), stdTx, false, true)
+			if err != nil {
+				return fmt.Errorf("line %d: %w", line, err)
+			}
+			json, err := cliCtx.Codec.MarshalJSON(signed)
+			if err != nil {
+				return err
+			}
+			if _, err := fmt.Fprintf(w, "%s\n", json); err != nil {
+				return err
+			}
+			sequence++
+		}
+		if err == io.EOF {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/spf13/cobra"
+
+	clientkeys "github.com/cosmos/cosmos-sdk/client/keys"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	wasmUtils "github.com/fetchai/fetchd/x/wasm/client/utils"
+)
+
+func signMessageCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "sign-message [name] [message]",
+		Short: "Sign an off-chain message with a key",
+		Long: `Sign the message with the key for off-chain authentication, e.g. to log in to a service with an account.
+The message is signed as ADR-036 sign doc, like a tx of a single sign/MsgSignData msg with an empty chain id, fee and
+memo, so that the signature is never valid for a tx. Ledger keys sign it like a tx. The printed json with the signer,
+the message as base64, the pub key and the signature is verified with "keys verify-message" or by services and
+contracts that rebuild the sign doc.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			kb, err := newKeybase(cmd)
+			if err != nil {
+				return err
+			}
+			info, err := kb.Get(args[0])
+			if err != nil {
+				return err
+			}
+			data := []byte(args[1])
+			sig, pubKey, err := kb.Sign(args[0], clientkeys.DefaultKeyPass, wasmUtils.MessageSignBytes(info.GetAddress(), data))
+			if err != nil {
+				return err
+			}
+			bechPubKey, err := sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, pubKey)
+			if err != nil {
+				return err
+			}
+			bz, err := json.MarshalIndent(wasmUtils.SignedMessage{
+				Signer:    info.GetAddress(),
+				Data:      data,
+				PubKey:    bechPubKey,
+				Signature: sig,
+			}, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+			return nil
+		},
+	}
+}
+
+func verifyMessageCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "verify-message [file]",
+		Short: "Verify an off-chain message signed with sign-message",
+		Long: `Verify the signed message json printed by "keys sign-message": the signature must be made by the key of
+the pub key over the ADR-036 sign doc of the message, and the pub key must belong to the signer. No key of the keyring
+is needed.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var msg wasmUtils.SignedMessage
+			if err := json.Unmarshal(bz, &msg); err != nil {
+				return err
+			}
+			if err := wasmUtils.VerifyMessage(msg); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "valid signature of %s\n", msg.Signer)
+			return nil
+		},
+	}
+}
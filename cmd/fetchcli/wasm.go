@@ -0,0 +1,25 @@
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	wasmcli "github.com/fetchai/fetchd/x/wasm/client/cli"
+)
+
+// wasmCmd groups the client side tooling for the wasm module that is neither a query nor a tx
+func wasmCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "wasm",
+		Short:                      "Wasm contract tooling",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(
+		wasmcli.GetCmdSubscribe(cdc),
+	)
+	return cmd
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	wasmUtils "github.com/fetchai/fetchd/x/wasm/client/utils"
+)
+
+// signCmds are the tx commands whose --from key signs a given tx, they are not switched to --generate-only
+var signCmds = map[string]bool{"sign": true, "multisign": true, "sign-batch": true}
+
+// wrapWatchOnlyTxCmds lets the tx commands build unsigned txs from watch-only keys, the pub key only keys added with
+// `keys add --pubkey`. The --from key name is resolved to its address, which the sdk requires with --generate-only,
+// and a tx from a key without private key is generated instead of signed, so that the online machine of an offline
+// signer workflow never needs the private key.
+func wrapWatchOnlyTxCmds(txCmd *cobra.Command) {
+	for _, c := range txCmd.Commands() {
+		switch {
+		case c.HasSubCommands():
+			wrapWatchOnlyTxCmds(c)
+		case c.RunE == nil || signCmds[c.Name()] || c.Flags().Lookup(flags.FlagGenerateOnly) == nil:
+		case c.Name() == "send" && c.Parent() == txCmd:
+			// bank send takes the sender as first arg instead of --from
+			runE := c.RunE
+			c.RunE = func(cmd *cobra.Command, args []string) error {
+				if len(args) != 0 {
+					addr, err := watchOnlyFrom(cmd, args[0])
+					if err != nil {
+						return err
+					}
+					if addr != "" {
+						args = append([]string{addr}, args[1:]...)
+					}
+				}
+				return runE(cmd, args)
+			}
+		default:
+			runE := c.RunE
+			c.RunE = func(cmd *cobra.Command, args []string) error {
+				addr, err := watchOnlyFrom(cmd, viper.GetString(flags.FlagFrom))
+				if err != nil {
+					return err
+				}
+				if addr != "" {
+					viper.Set(flags.FlagFrom, addr)
+				}
+				return runE(cmd, args)
+			}
+		}
+	}
+}
+
+// watchOnlyFrom returns the address to use as sender instead of the key name from when the tx is generated only, and
+// switches to --generate-only for keys without private key. It returns an empty address when from is used as is.
+func watchOnlyFrom(cmd *cobra.Command, from string) (string, error) {
+	if from == "" || viper.GetString(wasmUtils.FlagMultisig) != "" || viper.GetString(wasmUtils.FlagRemoteSigner) != "" {
+		return "", nil
+	}
+	if _, err := sdk.AccAddressFromBech32(from); err == nil {
+		return "", nil
+	}
+	kb, err := newKeybase(cmd)
+	if err != nil {
+		return "", err
+	}
+	info, err := kb.Get(from)
+	if err != nil {
+		// unknown keys are reported by the command
+		return "", nil
+	}
+	watchOnly := info.GetType() == keys.TypeOffline || info.GetType() == keys.TypeMulti
+	if !viper.GetBool(flags.FlagGenerateOnly) {
+		if !watchOnly {
+			return "", nil
+		}
+		_, _ = fmt.Fprintf(os.Stderr, "key %q has no private key, generating the unsigned tx to be signed offline\n", from)
+		viper.Set(flags.FlagGenerateOnly, true)
+	}
+	return info.GetAddress().String(), nil
+}
@@ -0,0 +1,223 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/tendermint/tendermint/crypto"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/gaskv"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fetchai/fetchd/x/wasm"
+)
+
+const (
+	flagBenchRuns     = "runs"
+	flagBenchBaseline = "baseline"
+)
+
+// benchBaselineOps is the number of store writes and reads that measure the time of native sdk gas
+const benchBaselineOps = 100000
+
+// benchCall is the outcome of the runs of one contract call
+type benchCall struct {
+	call       string
+	runs       int
+	elapsed    time.Duration
+	vmGas      uint64
+	storageGas uint64
+}
+
+// vmTime is the elapsed time less the time the storage gas stands for
+func (c benchCall) vmTime(baseline float64) float64 {
+	return math.Max(float64(c.elapsed.Nanoseconds())-float64(c.storageGas)*baseline, 0)
+}
+
+// wasmGas is the gas of the VM before the gas multiplier converts it to sdk gas
+func (c benchCall) wasmGas() uint64 {
+	return c.vmGas * wasm.GasMultiplier
+}
+
+// wasmBenchCmd runs a contract through the VM and compares the wall clock time to the gas charged for it
+func wasmBenchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wasm-bench [wasm_file] [init_msg]",
+		Short: "Benchmark a contract and propose a calibrated gas multiplier",
+		Long: `Run the instantiation of the contract of the wasm file with the init msg and the --execute and --query msgs
+--runs times each on an in-memory store, and print the average time and sdk gas of every call:
+
+fetchd debug wasm-bench contract.wasm '{"count":1}' --execute '{"increment":{}}' --query '{"get_count":{}}'
+
+The time of sdk gas is measured with store writes and reads on an in-memory store of the same type, or set with
+--baseline in nanoseconds per gas, e.g. as measured on a node. The proposed gas_multiplier param charges the VM time
+of the calls the same sdk gas as native code of the same time, the current multiplier is the default of the param.
+The msgs are sent by --sender, the instantiations with --funds, see simulate-local.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			wasmCode, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			sender := sdk.AccAddress(crypto.AddressHash([]byte("local_sender")))
+			if s := viper.GetString(flagLocalSender); s != "" {
+				if sender, err = sdk.AccAddressFromBech32(s); err != nil {
+					return err
+				}
+			}
+			funds, err := sdk.ParseCoins(viper.GetString(flagLocalFunds))
+			if err != nil {
+				return err
+			}
+			// read the arrays from the flags, viper splits them at commas
+			executes, err := cmd.Flags().GetStringArray(flagLocalExecute)
+			if err != nil {
+				return err
+			}
+			queries, err := cmd.Flags().GetStringArray(flagLocalQuery)
+			if err != nil {
+				return err
+			}
+			for _, m := range append(append([]string{args[1]}, executes...), queries...) {
+				if !json.Valid([]byte(m)) {
+					return fmt.Errorf("msg must be json: %s", m)
+				}
+			}
+			runs := viper.GetInt(flagBenchRuns)
+			if runs <= 0 {
+				return fmt.Errorf("runs must be positive")
+			}
+
+			cacheDir, err := ioutil.TempDir("", "fetchd-wasm-bench")
+			if err != nil {
+				return err
+			}
+			defer os.RemoveAll(cacheDir)
+			runner, err := wasm.NewLocalRunner(cacheDir, wasmCode, "staking", viper.GetUint64(flagLocalGas))
+			if err != nil {
+				return err
+			}
+			runner.Block.Height = 1
+			runner.Block.Time = uint64(time.Now().Unix())
+			runner.Block.ChainID = "local"
+
+			var calls []benchCall
+			instantiate := func() (*wasm.LocalResult, error) { return runner.Instantiate(sender, funds, []byte(args[1])) }
+			c, err := benchRun("instantiate", runs, instantiate)
+			if err != nil {
+				return err
+			}
+			calls = append(calls, c)
+			for _, m := range executes {
+				msg := []byte(m)
+				c, err := benchRun("execute "+m, runs, func() (*wasm.LocalResult, error) { return runner.Execute(sender, nil, msg) })
+				if err != nil {
+					return err
+				}
+				calls = append(calls, c)
+			}
+			for _, m := range queries {
+				msg := []byte(m)
+				c, err := benchRun("query "+m, runs, func() (*wasm.LocalResult, error) { return runner.Query(msg) })
+				if err != nil {
+					return err
+				}
+				calls = append(calls, c)
+			}
+
+			baseline := viper.GetFloat64(flagBenchBaseline)
+			if baseline <= 0 {
+				baseline = measureStoreBaseline()
+			}
+			return printBench(cmd.OutOrStdout(), calls, baseline)
+		},
+	}
+	cmd.Flags().String(flagLocalSender, "", "Bech32 address of the sender of the msgs, a fixed local address by default")
+	cmd.Flags().String(flagLocalFunds, "", "Coins to send with the instantiations, e.g. 100afet")
+	cmd.Flags().StringArray(flagLocalExecute, nil, "Msg to execute after the instantiation, repeatable")
+	cmd.Flags().StringArray(flagLocalQuery, nil, "Msg to query after the executions, repeatable")
+	cmd.Flags().Uint64(flagLocalGas, 10000000, "Gas limit of every call, in sdk gas")
+	cmd.Flags().Int(flagBenchRuns, 100, "Number of runs of every call")
+	cmd.Flags().Float64(flagBenchBaseline, 0, "Time of one sdk gas in nanoseconds, 0 to measure it with an in-memory store")
+	return cmd
+}
+
+// benchRun runs the call and adds up its time and gas, it stops at the first error
+func benchRun(call string, runs int, run func() (*wasm.LocalResult, error)) (benchCall, error) {
+	res := benchCall{call: call, runs: runs}
+	for i := 0; i < runs; i++ {
+		start := time.Now()
+		r, err := run()
+		res.elapsed += time.Since(start)
+		if err != nil {
+			return res, fmt.Errorf("%s: %w", call, err)
+		}
+		res.vmGas += r.VMGas
+		res.storageGas += r.StorageGas
+	}
+	return res, nil
+}
+
+// measureStoreBaseline returns the time of one sdk gas in nanoseconds, spent on writes and reads of a gas metered
+// in-memory store
+func measureStoreBaseline() float64 {
+	meter := sdk.NewInfiniteGasMeter()
+	store := gaskv.NewStore(cachekv.NewStore(dbadapter.Store{DB: dbm.NewMemDB()}), meter, storetypes.KVGasConfig())
+	keys := make([][]byte, benchBaselineOps)
+	for i := range keys {
+		keys[i] = []byte(fmt.Sprintf("key%08d", i))
+	}
+	value := make([]byte, 64)
+
+	start := time.Now()
+	for _, key := range keys {
+		store.Set(key, value)
+		store.Get(key)
+	}
+	return float64(time.Since(start).Nanoseconds()) / float64(meter.GasConsumed())
+}
+
+// printBench prints the table of the calls and the proposed gas multiplier
+func printBench(w io.Writer, calls []benchCall, baseline float64) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', tabwriter.AlignRight)
+	_, _ = fmt.Fprintln(tw, "call\truns\tavg time\tavg vm gas\tavg storage gas\tns per wasm gas\t")
+	var vmTime float64
+	var wasmGas uint64
+	for _, c := range calls {
+		vmTime += c.vmTime(baseline)
+		wasmGas += c.wasmGas()
+		var nsPerWasmGas float64
+		if c.wasmGas() != 0 {
+			nsPerWasmGas = c.vmTime(baseline) / float64(c.wasmGas())
+		}
+		_, _ = fmt.Fprintf(tw, "%s\t%d\t%s\t%d\t%d\t%.4f\t\n", c.call, c.runs, c.elapsed/time.Duration(c.runs),
+			c.vmGas/uint64(c.runs), c.storageGas/uint64(c.runs), nsPerWasmGas)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "time of one sdk gas: %.2fns\n", baseline); err != nil {
+		return err
+	}
+	if wasmGas == 0 || vmTime == 0 {
+		_, err := fmt.Fprintln(w, "no vm gas was charged, no gas multiplier can be proposed")
+		return err
+	}
+	// the multiplier of wasm gas per sdk gas that charges the vm time at the baseline
+	proposed := math.Max(math.Round(baseline/(vmTime/float64(wasmGas))), 1)
+	_, err := fmt.Fprintf(w, "proposed gas_multiplier: %d (current %d)\n", uint64(proposed), wasm.GasMultiplier)
+	return err
+}
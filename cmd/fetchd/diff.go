@@ -0,0 +1,168 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"unicode"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/tendermint/tendermint/libs/cli"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fetchai/fetchd/x/wasm"
+)
+
+const flagDecodeJSON = "decode-json"
+
+// wasmDiffCmd prints the changes of the raw contract state between two heights or two contract dumps
+func wasmDiffCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wasm-diff [contract_addr] [height1|dump_file1] [height2|dump_file2]",
+		Short: "Print the changes of the contract state between two heights",
+		Long: `Print the keys of the raw contract state that were added (+), removed (-) or changed (~) from the first
+to the second height, e.g. around a contract migration:
+
+fetchd debug wasm-diff fetch18vd8fpwxzck93qlwghaj6arh4p7c5n89x8kskz 1200 1201 --decode-json
+
+The state is read from the local node database, the node must not be running and must still have both heights.
+Instead of a height, a contract dump written by "fetchd wasm dump" is read from the file.
+
+Keys are printed as text when they are printable, as hex otherwise. Values are printed as hex, or as json
+with --decode-json when they are json.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			contractAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			// the node database is only opened when a height is diffed
+			var db dbm.DB
+			load := func(src string) (wasm.ContractDump, error) {
+				height, err := strconv.ParseInt(src, 10, 64)
+				if err != nil {
+					return readContractDump(cdc, contractAddr, src)
+				}
+				if db == nil {
+					dataDir := filepath.Join(viper.GetString(cli.HomeFlag), "data")
+					if db, err = sdk.NewLevelDB("application", dataDir); err != nil {
+						return wasm.ContractDump{}, err
+					}
+				}
+				return loadContractDump(ctx, db, contractAddr, height)
+			}
+			defer func() {
+				if db != nil {
+					db.Close()
+				}
+			}()
+
+			before, err := load(args[1])
+			if err != nil {
+				return err
+			}
+			after, err := load(args[2])
+			if err != nil {
+				return err
+			}
+			printContractDiff(cmd.OutOrStdout(), before, after, viper.GetBool(flagDecodeJSON))
+			return nil
+		},
+	}
+	cmd.Flags().Bool(flagDecodeJSON, false, "Print values that are json as json instead of hex")
+	return cmd
+}
+
+// readContractDump reads a contract dump file of the contract
+func readContractDump(cdc *codec.Codec, contractAddr sdk.AccAddress, file string) (wasm.ContractDump, error) {
+	bz, err := ioutil.ReadFile(file)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return wasm.ContractDump{}, fmt.Errorf("%s is neither a height nor a contract dump file", file)
+		}
+		return wasm.ContractDump{}, err
+	}
+	var dump wasm.ContractDump
+	if err := cdc.UnmarshalJSON(bz, &dump); err != nil {
+		return wasm.ContractDump{}, fmt.Errorf("failed to unmarshal contract dump %s: %w", file, err)
+	}
+	if !dump.Contract.ContractAddress.Equals(contractAddr) {
+		return wasm.ContractDump{}, fmt.Errorf("contract dump %s is of contract %s", file, dump.Contract.ContractAddress)
+	}
+	return dump, nil
+}
+
+// printContractDiff writes a line for every changed code id and state key, the keys in byte order
+func printContractDiff(w io.Writer, before, after wasm.ContractDump, decodeJSON bool) {
+	if before.Code.CodeID != after.Code.CodeID {
+		fmt.Fprintf(w, "~ code_id: %d -> %d\n", before.Code.CodeID, after.Code.CodeID)
+	}
+
+	oldState := make(map[string][]byte, len(before.Contract.ContractState))
+	for _, m := range before.Contract.ContractState {
+		oldState[string(m.Key)] = m.Value
+	}
+	newState := make(map[string][]byte, len(after.Contract.ContractState))
+	for _, m := range after.Contract.ContractState {
+		newState[string(m.Key)] = m.Value
+	}
+	keys := make([]string, 0, len(oldState)+len(newState))
+	for k := range oldState {
+		keys = append(keys, k)
+	}
+	for k := range newState {
+		if _, ok := oldState[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		oldVal, inOld := oldState[k]
+		newVal, inNew := newState[k]
+		switch {
+		case !inOld:
+			fmt.Fprintf(w, "+ %s: %s\n", formatStateKey([]byte(k)), formatStateValue(newVal, decodeJSON))
+		case !inNew:
+			fmt.Fprintf(w, "- %s: %s\n", formatStateKey([]byte(k)), formatStateValue(oldVal, decodeJSON))
+		case !bytes.Equal(oldVal, newVal):
+			fmt.Fprintf(w, "~ %s: %s -> %s\n", formatStateKey([]byte(k)),
+				formatStateValue(oldVal, decodeJSON), formatStateValue(newVal, decodeJSON))
+		}
+	}
+}
+
+// formatStateKey returns the key as quoted text when it is printable, as hex otherwise
+func formatStateKey(key []byte) string {
+	for _, r := range string(key) {
+		if r == unicode.ReplacementChar || !unicode.IsPrint(r) {
+			return hex.EncodeToString(key)
+		}
+	}
+	return strconv.Quote(string(key))
+}
+
+// formatStateValue returns the value as compact json when it is json and decodeJSON is set, as hex otherwise
+func formatStateValue(value []byte, decodeJSON bool) string {
+	if decodeJSON && json.Valid(value) {
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, value); err == nil {
+			return buf.String()
+		}
+	}
+	return hex.EncodeToString(value)
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/tendermint/tendermint/libs/cli"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+
+	"github.com/fetchai/fetchd/app"
+	"github.com/fetchai/fetchd/x/wasm"
+)
+
+const flagDumpHeight = "height"
+
+// dumpContractCmd writes a single contract with its code and state from the local node database to stdout
+func dumpContractCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dump [contract_addr]",
+		Short: "Dump a single contract with its code and state to json",
+		Long: `Dump a single contract with its code and state from the local node database to json,
+e.g. fetchd wasm dump fetch18vd8fpwxzck93qlwghaj6arh4p7c5n89x8kskz > state.json
+
+The node must not be running. The dump is added to the genesis of another chain with import-genesis.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			contractAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			dataDir := filepath.Join(viper.GetString(cli.HomeFlag), "data")
+			db, err := sdk.NewLevelDB("application", dataDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			dump, err := loadContractDump(ctx, db, contractAddr, viper.GetInt64(flagDumpHeight))
+			if err != nil {
+				return err
+			}
+			bz, err := codec.MarshalJSONIndent(cdc, dump)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(bz))
+			return nil
+		},
+	}
+	cmd.Flags().Int64(flagDumpHeight, -1, "Dump the contract at this height, -1 for the latest height")
+	return cmd
+}
+
+// loadContractDump exports the contract from the node database at the height, -1 for the latest height. The diff
+// command loads two heights, so the apps must not open the block statistics db.
+func loadContractDump(ctx *server.Context, db dbm.DB, contractAddr sdk.AccAddress, height int64) (wasm.ContractDump, error) {
+	app.DisableBlockStats()
+	gapp := app.NewWasmApp(ctx.Logger, db, nil, height == -1, uint(1), app.GetEnabledProposals(), nil)
+	defer gapp.Close()
+	if height != -1 {
+		if err := gapp.LoadHeight(height); err != nil {
+			return wasm.ContractDump{}, err
+		}
+	}
+	return gapp.ExportContract(contractAddr)
+}
+
+// importGenesisContractCmd adds a contract dump to the wasm state of genesis.json
+func importGenesisContractCmd(ctx *server.Context, cdc *codec.Codec, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-genesis [dump_file]",
+		Short: "Add a contract dumped with the dump command to genesis.json",
+		Long: `Add a contract dumped with the dump command to genesis.json. The contract keeps its address
+and state. Its code is reused when genesis.json holds a code with the same checksum, otherwise it is added
+with the next free code id.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(cli.HomeFlag))
+
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var dump wasm.ContractDump
+			if err := cdc.UnmarshalJSON(bz, &dump); err != nil {
+				return fmt.Errorf("failed to unmarshal contract dump: %w", err)
+			}
+
+			genFile := config.GenesisFile()
+			appState, genDoc, err := genutil.GenesisStateFromGenFile(cdc, genFile)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+
+			var wasmGenState wasm.GenesisState
+			if err := cdc.UnmarshalJSON(appState[wasm.ModuleName], &wasmGenState); err != nil {
+				return fmt.Errorf("failed to unmarshal wasm genesis state: %w", err)
+			}
+			if err := wasmGenState.ImportContract(dump); err != nil {
+				return fmt.Errorf("failed to import contract: %w", err)
+			}
+
+			wasmGenStateBz, err := cdc.MarshalJSON(wasmGenState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal wasm genesis state: %w", err)
+			}
+			appState[wasm.ModuleName] = wasmGenStateBz
+
+			appStateJSON, err := cdc.MarshalJSON(appState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal application genesis state: %w", err)
+			}
+
+			genDoc.AppState = appStateJSON
+			return genutil.ExportGenesisFile(genDoc, genFile)
+		},
+	}
+	cmd.Flags().String(cli.HomeFlag, defaultNodeHome, "node's home directory")
+	return cmd
+}
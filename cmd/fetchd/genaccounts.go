@@ -2,7 +2,6 @@ package main
 
 import (
 	"bufio"
-	"errors"
 	"fmt"
 
 	"github.com/spf13/cobra"
@@ -17,8 +16,11 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
-	authvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
+
+	"github.com/fetchai/fetchd/x/vesting"
+	vestingcli "github.com/fetchai/fetchd/x/vesting/client/cli"
 )
 
 const (
@@ -26,6 +28,8 @@ const (
 	flagVestingStart = "vesting-start-time"
 	flagVestingEnd   = "vesting-end-time"
 	flagVestingAmt   = "vesting-amount"
+	flagVestingPer   = "vesting-periods"
+	flagVestingFund  = "vesting-funder"
 )
 
 // AddGenesisAccountCmd returns add-genesis-account cobra Command.
@@ -39,7 +43,10 @@ func AddGenesisAccountCmd(
 		Long: `Add a genesis account to genesis.json. The provided account must specify
 the account address or key name and a list of initial coins. If a key name is given,
 the address will be looked up in the local Keybase. The list of initial tokens must
-contain valid denominations. Accounts may optionally be supplied with vesting parameters.
+contain valid denominations. Accounts may optionally be supplied with vesting parameters:
+a start and end time for continuous, an end time only for delayed and a start time and
+a --vesting-periods file for periodic vesting, the vesting amount is then the total of
+the periods unless given.
 `,
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -79,26 +86,29 @@ contain valid denominations. Accounts may optionally be supplied with vesting pa
 			if err != nil {
 				return fmt.Errorf("failed to parse vesting amount: %w", err)
 			}
+			var vestingPeriods vestingtypes.Periods
+			if file := viper.GetString(flagVestingPer); file != "" {
+				if vestingPeriods, err = vestingcli.ReadPeriods(cdc, file); err != nil {
+					return fmt.Errorf("failed to read vesting periods: %w", err)
+				}
+			}
 
 			// create concrete account type based on input parameters
 			var genAccount authexported.GenesisAccount
 
 			baseAccount := auth.NewBaseAccount(addr, coins.Sort(), nil, 0, 0)
-			if !vestingAmt.IsZero() {
-				baseVestingAccount, err := authvesting.NewBaseVestingAccount(baseAccount, vestingAmt.Sort(), vestingEnd)
+			if funder := viper.GetString(flagVestingFund); funder != "" {
+				funderAddr, err := sdk.AccAddressFromBech32(funder)
 				if err != nil {
-					return fmt.Errorf("failed to create base vesting account: %w", err)
+					return fmt.Errorf("invalid vesting funder: %w", err)
 				}
-
-				switch {
-				case vestingStart != 0 && vestingEnd != 0:
-					genAccount = authvesting.NewContinuousVestingAccountRaw(baseVestingAccount, vestingStart)
-
-				case vestingEnd != 0:
-					genAccount = authvesting.NewDelayedVestingAccountRaw(baseVestingAccount)
-
-				default:
-					return errors.New("invalid vesting parameters; must supply start and end time or end time")
+				if genAccount, err = vesting.NewClawbackVestingAccount(baseAccount, funderAddr, vestingStart, vestingPeriods); err != nil {
+					return fmt.Errorf("invalid vesting parameters: %w", err)
+				}
+			} else if !vestingAmt.IsZero() || len(vestingPeriods) != 0 {
+				genAccount, err = vesting.NewVestingAccount(baseAccount, vestingAmt, vestingStart, vestingEnd, vestingPeriods)
+				if err != nil {
+					return fmt.Errorf("invalid vesting parameters: %w", err)
 				}
 			} else {
 				genAccount = baseAccount
@@ -148,6 +158,8 @@ contain valid denominations. Accounts may optionally be supplied with vesting pa
 	cmd.Flags().String(flagVestingAmt, "", "amount of coins for vesting accounts")
 	cmd.Flags().Uint64(flagVestingStart, 0, "schedule start time (unix epoch) for vesting accounts")
 	cmd.Flags().Uint64(flagVestingEnd, 0, "schedule end time (unix epoch) for vesting accounts")
+	cmd.Flags().String(flagVestingPer, "", "file of the amino json periods of periodic vesting accounts")
+	cmd.Flags().String(flagVestingFund, "", "funder address of clawback vesting accounts, which can claw back the unvested coins of the periods")
 
 	return cmd
 }
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/fetchai/fetchd/app"
+	"github.com/fetchai/fetchd/indexer"
+)
+
+const (
+	flagBackfillFrom = "from"
+	flagBackfillTo   = "to"
+)
+
+// indexBackfillCmd indexes historical blocks into the database of the [indexer] section of app.toml
+func indexBackfillCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "index-backfill",
+		Short: "Index historical blocks from the RPC of a node into the database of the indexer",
+		Long: `Index the blocks from --from to --to, by default from the block after the last indexed one to the latest
+block, into the database configured in the [indexer] section of app.toml. The blocks and their events are read from
+the RPC of the node at --node, which must still hold the results of the blocks. Blocks indexed before are replaced,
+so the command can also fill gaps and re-index with other event_types.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			wrap := app.IndexerWrapper{Indexer: app.DefaultIndexerConfig()}
+			if err := viper.Unmarshal(&wrap); err != nil {
+				return err
+			}
+			cfg := wrap.Indexer
+			idx, err := indexer.Open(cfg.Driver, cfg.DSN, cfg.EventTypes)
+			if err != nil {
+				return err
+			}
+			defer idx.Close()
+
+			node, err := context.NewCLIContext().GetNode()
+			if err != nil {
+				return err
+			}
+			from, to := viper.GetInt64(flagBackfillFrom), viper.GetInt64(flagBackfillTo)
+			if from == 0 {
+				last, err := idx.LastHeight()
+				if err != nil {
+					return err
+				}
+				from = last + 1
+			}
+			if to == 0 {
+				status, err := node.Status()
+				if err != nil {
+					return err
+				}
+				to = status.SyncInfo.LatestBlockHeight
+			}
+			for height := from; height <= to; height++ {
+				h := height
+				block, err := node.Block(&h)
+				if err != nil {
+					return err
+				}
+				results, err := node.BlockResults(&h)
+				if err != nil {
+					return err
+				}
+				txs := block.Block.Data.Txs
+				if len(results.TxsResults) != len(txs) {
+					return fmt.Errorf("block %d has %d txs but %d results", height, len(txs), len(results.TxsResults))
+				}
+				b := indexer.Block{
+					Height:           height,
+					Hash:             block.BlockID.Hash,
+					Time:             block.Block.Time,
+					BeginBlockEvents: results.BeginBlockEvents,
+					EndBlockEvents:   results.EndBlockEvents,
+				}
+				for i, tx := range txs {
+					b.Txs = append(b.Txs, indexer.Tx{Hash: tx.Hash(), Result: *results.TxsResults[i]})
+				}
+				if err := idx.Index(b); err != nil {
+					return err
+				}
+			}
+			cmd.Printf("indexed blocks %d to %d\n", from, to)
+			return nil
+		},
+	}
+	cmd.Flags().Int64(flagBackfillFrom, 0, "First height to index, 0 for the height after the last indexed one")
+	cmd.Flags().Int64(flagBackfillTo, 0, "Last height to index, 0 for the latest height of the node")
+	return flags.GetCommands(cmd)[0]
+}
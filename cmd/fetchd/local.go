@@ -0,0 +1,145 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fetchai/fetchd/x/wasm"
+)
+
+const (
+	flagLocalSender  = "sender"
+	flagLocalFunds   = "funds"
+	flagLocalExecute = "execute"
+	flagLocalQuery   = "query"
+	flagLocalHeight  = "height"
+	flagLocalTime    = "time"
+	flagLocalChainID = "chain-id"
+	flagLocalGas     = "gas"
+)
+
+// localStep is the outcome of one call of a local run
+type localStep struct {
+	Call   string            `json:"call"`
+	Msg    json.RawMessage   `json:"msg"`
+	Result *wasm.LocalResult `json:"result,omitempty"`
+	Error  string            `json:"error,omitempty"`
+}
+
+// simulateLocalCmd runs a wasm file on an in-memory store, without a chain
+func simulateLocalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-local [wasm_file] [init_msg]",
+		Short: "Run a contract locally, without a chain",
+		Long: `Instantiate the contract of the wasm file with the init msg, then run the --execute msgs and the --query
+msgs in the order given, on an in-memory store. Every call is printed as a json line with its result and its gas,
+the run stops at the first failing call:
+
+fetchd wasm simulate-local contract.wasm '{"count":1}' --execute '{"increment":{}}' --query '{"get_count":{}}'
+
+The msgs are sent by --sender, the instantiation with --funds, which are the balance of the contract for its bank
+queries. The messages the contract returns are printed, not dispatched, and other queries to the chain fail.
+The block of the env is set with --height, --time and --chain-id.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			wasmCode, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			sender := sdk.AccAddress(crypto.AddressHash([]byte("local_sender")))
+			if s := viper.GetString(flagLocalSender); s != "" {
+				if sender, err = sdk.AccAddressFromBech32(s); err != nil {
+					return err
+				}
+			}
+			funds, err := sdk.ParseCoins(viper.GetString(flagLocalFunds))
+			if err != nil {
+				return err
+			}
+			// read the arrays from the flags, viper splits them at commas
+			executes, err := cmd.Flags().GetStringArray(flagLocalExecute)
+			if err != nil {
+				return err
+			}
+			queries, err := cmd.Flags().GetStringArray(flagLocalQuery)
+			if err != nil {
+				return err
+			}
+			msgs := append([]string{args[1]}, executes...)
+			for _, m := range append(msgs, queries...) {
+				if !json.Valid([]byte(m)) {
+					return fmt.Errorf("msg must be json: %s", m)
+				}
+			}
+
+			// keep the compiled code away from the node home
+			cacheDir, err := ioutil.TempDir("", "fetchd-simulate-local")
+			if err != nil {
+				return err
+			}
+			defer os.RemoveAll(cacheDir)
+			runner, err := wasm.NewLocalRunner(cacheDir, wasmCode, "staking", viper.GetUint64(flagLocalGas))
+			if err != nil {
+				return err
+			}
+			runner.Block.Height = viper.GetUint64(flagLocalHeight)
+			runner.Block.Time = viper.GetUint64(flagLocalTime)
+			if runner.Block.Time == 0 {
+				runner.Block.Time = uint64(time.Now().Unix())
+			}
+			runner.Block.ChainID = viper.GetString(flagLocalChainID)
+
+			enc := json.NewEncoder(cmd.OutOrStdout())
+			for i, m := range msgs {
+				step := localStep{Call: "execute", Msg: json.RawMessage(m)}
+				if i == 0 {
+					step.Call = "instantiate"
+					step.Result, err = runner.Instantiate(sender, funds, step.Msg)
+				} else {
+					step.Result, err = runner.Execute(sender, nil, step.Msg)
+				}
+				if err := printLocalStep(enc, step, err); err != nil {
+					return err
+				}
+			}
+			for _, m := range queries {
+				step := localStep{Call: "query", Msg: json.RawMessage(m)}
+				step.Result, err = runner.Query(step.Msg)
+				if err := printLocalStep(enc, step, err); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+	cmd.Flags().String(flagLocalSender, "", "Bech32 address of the sender of the msgs, a fixed local address by default")
+	cmd.Flags().String(flagLocalFunds, "", "Coins to send with the instantiation, e.g. 100afet")
+	cmd.Flags().StringArray(flagLocalExecute, nil, "Msg to execute after the instantiation, repeatable")
+	cmd.Flags().StringArray(flagLocalQuery, nil, "Msg to query after the executions, repeatable")
+	cmd.Flags().Uint64(flagLocalHeight, 1, "Block height of the env")
+	cmd.Flags().Uint64(flagLocalTime, 0, "Block time of the env in unix seconds, 0 for the current time")
+	cmd.Flags().String(flagLocalChainID, "local", "Chain id of the env")
+	cmd.Flags().Uint64(flagLocalGas, 10000000, "Gas limit of every call, in sdk gas")
+	return cmd
+}
+
+// printLocalStep writes the step as a json line, and returns the error of the call to stop the run
+func printLocalStep(enc *json.Encoder, step localStep, err error) error {
+	if err != nil {
+		step.Error = err.Error()
+	}
+	if encErr := enc.Encode(step); encErr != nil {
+		return encErr
+	}
+	return err
+}
@@ -2,7 +2,11 @@ package main
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
+	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/cosmos/cosmos-sdk/version"
 
@@ -27,7 +31,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking"
 )
 
-const flagInvCheckPeriod = "inv-check-period"
+const (
+	flagInvCheckPeriod = "inv-check-period"
+	flagExportModules  = "modules"
+	flagWasmTrace      = "wasm-trace"
+)
 
 var invCheckPeriod uint
 
@@ -62,9 +70,27 @@ func main() {
 	rootCmd.AddCommand(flags.NewCompletionCmd(rootCmd, true))
 	// rootCmd.AddCommand(testnetCmd(ctx, cdc, app.ModuleBasics, auth.GenesisAccountIterator{}))
 	rootCmd.AddCommand(replayCmd())
-	rootCmd.AddCommand(debug.Cmd(cdc))
+	rootCmd.AddCommand(simulateChainCmd())
+	rootCmd.AddCommand(rosettaCmd(cdc))
+	rootCmd.AddCommand(indexBackfillCmd())
+	rootCmd.AddCommand(wasmCmd(ctx, cdc, app.DefaultNodeHome, app.DefaultCLIHome))
+	debugCmd := debug.Cmd(cdc)
+	debugCmd.AddCommand(wasmDiffCmd(ctx, cdc), wasmBenchCmd())
+	rootCmd.AddCommand(debugCmd)
 
 	server.AddCommands(ctx, cdc, rootCmd, newApp, exportAppStateAndTMValidators)
+	for _, c := range rootCmd.Commands() {
+		if c.Name() == "export" {
+			c.Flags().StringSlice(flagExportModules, nil, "Export the state of these modules only, e.g. --modules wasm")
+		}
+		if c.Name() == "start" {
+			c.Flags().Bool(flagWasmTrace, false, "Record the host function calls of contracts for the tx-trace query, same as contract_trace_mode in app.toml")
+			// the app reads the [wasm] section of app.toml, the flag overrides its setting
+			if err := viper.BindPFlag("wasm.contract_trace_mode", c.Flags().Lookup(flagWasmTrace)); err != nil {
+				panic(err)
+			}
+		}
+	}
 
 	// prepare and add flags
 	executor := cli.PrepareBaseCmd(rootCmd, "WM", app.DefaultNodeHome)
@@ -91,7 +117,7 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer) server.Applicati
 		skipUpgradeHeights[int64(h)] = true
 	}
 
-	return app.NewWasmApp(logger, db, traceStore, true, invCheckPeriod,
+	gapp := app.NewWasmApp(logger, db, traceStore, true, invCheckPeriod,
 		app.GetEnabledProposals(),
 		skipUpgradeHeights,
 		baseapp.SetPruning(pruningOpts),
@@ -99,21 +125,38 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer) server.Applicati
 		baseapp.SetHaltHeight(viper.GetUint64(server.FlagHaltHeight)),
 		baseapp.SetHaltTime(viper.GetUint64(server.FlagHaltTime)),
 		baseapp.SetInterBlockCache(cache))
+	closeOnExit(gapp)
+	return gapp
+}
+
+// closeOnExit closes the app on the signals the start command stops the node and exits on, the sdk does not close
+// the app itself. A block committed meanwhile is not recorded in the closed dbs.
+func closeOnExit(gapp *app.WasmApp) {
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-sigs
+		if err := gapp.Close(); err != nil {
+			fmt.Fprintln(os.Stderr, "error while closing the app:", err)
+		}
+	}()
 }
 
 func exportAppStateAndTMValidators(
 	logger log.Logger, db dbm.DB, traceStore io.Writer, height int64, forZeroHeight bool, jailWhiteList []string,
 ) (json.RawMessage, []tmtypes.GenesisValidator, error) {
-
+	modules := viper.GetStringSlice(flagExportModules)
 	if height != -1 {
 		gapp := app.NewWasmApp(logger, db, traceStore, false, uint(1), app.GetEnabledProposals(), nil)
+		defer gapp.Close()
 		err := gapp.LoadHeight(height)
 		if err != nil {
 			return nil, nil, err
 		}
-		return gapp.ExportAppStateAndValidators(forZeroHeight, jailWhiteList)
+		return gapp.ExportModulesAndValidators(forZeroHeight, jailWhiteList, modules)
 	}
 
 	gapp := app.NewWasmApp(logger, db, traceStore, true, uint(1), app.GetEnabledProposals(), nil)
-	return gapp.ExportAppStateAndValidators(forZeroHeight, jailWhiteList)
+	defer gapp.Close()
+	return gapp.ExportModulesAndValidators(forZeroHeight, jailWhiteList, modules)
 }
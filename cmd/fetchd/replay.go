@@ -94,6 +94,7 @@ func replayTxs(rootDir string) error {
 		// TODO: do we want to set skipUpgradeHieghts here?
 		ctx.Logger, appDB, traceStoreWriter, true, uint(1), app.GetEnabledProposals(), nil,
 		baseapp.SetPruning(storetypes.PruneEverything))
+	defer gapp.Close()
 
 	// Genesis
 	var genDocPath = filepath.Join(configDir, "genesis.json")
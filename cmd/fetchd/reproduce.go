@@ -0,0 +1,289 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clientkeys "github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fetchai/fetchd/x/wasm"
+)
+
+const (
+	flagSourceDir  = "source-dir"
+	flagAttestKey  = "attest-key"
+	flagKeepSource = "keep-source"
+)
+
+// BuildAttestation is the statement a verifier signs after reproducing a code build
+type BuildAttestation struct {
+	ChainID   string           `json:"chain_id"`
+	CodeID    uint64           `json:"code_id"`
+	CodeHash  tmbytes.HexBytes `json:"code_hash"`
+	BuildHash tmbytes.HexBytes `json:"build_hash"`
+	Source    string           `json:"source"`
+	// Commit is the git commit the source was built from, empty for a --source-dir that is not a git checkout
+	Commit   string `json:"commit"`
+	Builder  string `json:"builder"`
+	Verified bool   `json:"verified"`
+}
+
+// SignedBuildAttestation is a BuildAttestation with the signature of the verifier
+type SignedBuildAttestation struct {
+	Attestation BuildAttestation `json:"attestation"`
+	Verifier    sdk.AccAddress   `json:"verifier"`
+	PubKey      string           `json:"pub_key"`
+	Signature   []byte           `json:"signature"`
+}
+
+// reproduceCmd rebuilds the source of a stored code with its docker builder and compares the checksums
+func reproduceCmd(cdc *codec.Codec, defaultClientHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reproduce [code_id]",
+		Short: "Rebuild a stored wasm code from its source and builder and verify the checksum",
+		Long: `Rebuild a stored wasm code from the source URI and builder tag stored on chain.
+The source URI must pin a commit or tag, either as fragment (https://host/repo.git#v1.0.0) or as
+tree path of the repository (https://github.com/org/repo/tree/v1.0.0/contracts/escrow), where the
+path after the ref is the directory of the contract. The repository is cloned with git and the ref
+checked out (unless --source-dir is given), then compiled with the documented docker invocation of
+the builder image. The checksum of the build output is compared with the code hash on chain. When
+--attest-key is given, a signed attestation of the result is printed so that it can be published.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			code, err := queryCode(cliCtx, codeID)
+			if err != nil {
+				return err
+			}
+			if code.Source == "" || code.Builder == "" {
+				return fmt.Errorf("code %d has no source or builder stored", codeID)
+			}
+
+			srcDir, buildDir := viper.GetString(flagSourceDir), viper.GetString(flagSourceDir)
+			if srcDir == "" {
+				src, err := parseSource(code.Source)
+				if err != nil {
+					return err
+				}
+				if srcDir, err = ioutil.TempDir("", "wasm-reproduce"); err != nil {
+					return err
+				}
+				if !viper.GetBool(flagKeepSource) {
+					defer os.RemoveAll(srcDir)
+				}
+				if err := runCmd(cmd, "", "git", "clone", src.repo, srcDir); err != nil {
+					return fmt.Errorf("clone source: %w", err)
+				}
+				if err := runCmd(cmd, srcDir, "git", "checkout", "--detach", src.ref); err != nil {
+					return fmt.Errorf("checkout %s: %w", src.ref, err)
+				}
+				buildDir = filepath.Join(srcDir, filepath.FromSlash(src.dir))
+			}
+			// empty when the source dir is not a git checkout
+			commit, _ := exec.Command("git", "-C", srcDir, "rev-parse", "HEAD").Output()
+
+			buildHash, err := dockerBuild(cmd, buildDir, code.Builder, code.DataHash)
+			if err != nil {
+				return err
+			}
+
+			attestation := BuildAttestation{
+				ChainID:   viper.GetString(flags.FlagChainID),
+				CodeID:    codeID,
+				CodeHash:  code.DataHash,
+				BuildHash: buildHash,
+				Source:    code.Source,
+				Commit:    strings.TrimSpace(string(commit)),
+				Builder:   code.Builder,
+				Verified:  bytes.Equal(buildHash, code.DataHash),
+			}
+
+			keyName := viper.GetString(flagAttestKey)
+			if keyName == "" {
+				return cliCtx.PrintOutput(attestation)
+			}
+			signed, err := signAttestation(cmd, keyName, attestation)
+			if err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(signed)
+		},
+	}
+	cmd.Flags().String(flagSourceDir, "", "Use an already checked out source directory instead of cloning the source URI")
+	cmd.Flags().Bool(flagKeepSource, false, "Do not remove the cloned source directory after the build")
+	cmd.Flags().String(flagAttestKey, "", "Name of the key to sign the build attestation with")
+	cmd.Flags().String(flags.FlagChainID, "", "Chain ID of tendermint node")
+	cmd.Flags().String(flagClientHome, defaultClientHome, "client's home directory")
+	return flags.GetCommands(cmd)[0]
+}
+
+// source is a git repository with the commit or tag to build and the directory of the contract in it
+type source struct {
+	repo string
+	ref  string
+	dir  string
+}
+
+// parseSource returns the repository, ref and contract directory of a source URI that pins a commit or tag, as
+// fragment of the repository URI or as tree path like https://github.com/org/repo/tree/<ref>/<dir>. A source
+// without a ref is rejected as a clone of the default branch is not reproducible.
+func parseSource(src string) (source, error) {
+	u, err := url.Parse(src)
+	if err != nil {
+		return source{}, fmt.Errorf("invalid source uri %q: %w", src, err)
+	}
+	if u.Fragment != "" {
+		ref := u.Fragment
+		u.Fragment = ""
+		return source{repo: u.String(), ref: ref}, nil
+	}
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	for i, p := range parts {
+		if p != "tree" || i == 0 || i+1 >= len(parts) {
+			continue
+		}
+		repoPath := parts[:i]
+		if repoPath[len(repoPath)-1] == "-" { // gitlab
+			repoPath = repoPath[:len(repoPath)-1]
+		}
+		u.Path = "/" + path.Join(repoPath...)
+		return source{repo: u.String(), ref: parts[i+1], dir: path.Join(parts[i+2:]...)}, nil
+	}
+	return source{}, fmt.Errorf("source uri %q does not pin a commit or tag, use <repo>#<ref> or <repo>/tree/<ref>", src)
+}
+
+func queryCode(cliCtx context.CLIContext, codeID uint64) (*wasm.GetCodeResponse, error) {
+	route := fmt.Sprintf("custom/%s/%s/%d", wasm.QuerierRoute, wasm.QueryGetCode, codeID)
+	res, _, err := cliCtx.Query(route)
+	if err != nil {
+		return nil, err
+	}
+	if len(res) == 0 {
+		return nil, fmt.Errorf("code %d not found", codeID)
+	}
+	var code wasm.GetCodeResponse
+	if err := json.Unmarshal(res, &code); err != nil {
+		return nil, err
+	}
+	return &code, nil
+}
+
+// dockerBuild runs the builder image on the source directory the same way the cosmwasm optimizers
+// are documented to be used and returns the checksum of the build output, see buildHash.
+func dockerBuild(cmd *cobra.Command, srcDir, builder string, expHash []byte) ([]byte, error) {
+	srcDir, err := filepath.Abs(srcDir)
+	if err != nil {
+		return nil, err
+	}
+	cacheName := filepath.Base(srcDir) + "_cache"
+	err = runCmd(cmd, srcDir, "docker", "run", "--rm",
+		"-v", srcDir+":/code",
+		"--mount", "type=volume,source="+cacheName+",target=/code/target",
+		"--mount", "type=volume,source=registry_cache,target=/usr/local/cargo/registry",
+		builder,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("docker build: %w", err)
+	}
+	return buildHash(srcDir, expHash)
+}
+
+// buildHash returns the sha256 checksum of the build output in the source directory matching the expected hash, or
+// of the first output found when none matches
+func buildHash(srcDir string, expHash []byte) ([]byte, error) {
+	// cosmwasm-opt writes contract.wasm, the rust optimizers write into artifacts/
+	candidates, err := filepath.Glob(filepath.Join(srcDir, "artifacts", "*.wasm"))
+	if err != nil {
+		return nil, err
+	}
+	candidates = append([]string{filepath.Join(srcDir, "contract.wasm")}, candidates...)
+
+	var first []byte
+	for _, c := range candidates {
+		bz, err := ioutil.ReadFile(c)
+		if err != nil {
+			continue
+		}
+		hash := sha256.Sum256(bz)
+		if bytes.Equal(hash[:], expHash) {
+			return hash[:], nil
+		}
+		if first == nil {
+			first = hash[:]
+		}
+	}
+	if first == nil {
+		return nil, fmt.Errorf("no wasm build output found in %s", srcDir)
+	}
+	return first, nil
+}
+
+func signAttestation(cmd *cobra.Command, keyName string, attestation BuildAttestation) (*SignedBuildAttestation, error) {
+	kb, err := keys.NewKeyring(
+		sdk.KeyringServiceName(),
+		viper.GetString(flags.FlagKeyringBackend),
+		viper.GetString(flagClientHome),
+		bufio.NewReader(cmd.InOrStdin()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	info, err := kb.Get(keyName)
+	if err != nil {
+		return nil, err
+	}
+	bz, err := json.Marshal(attestation)
+	if err != nil {
+		return nil, err
+	}
+	sig, pubKey, err := kb.Sign(keyName, clientkeys.DefaultKeyPass, sdk.MustSortJSON(bz))
+	if err != nil {
+		return nil, err
+	}
+	bechPubKey, err := sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, pubKey)
+	if err != nil {
+		return nil, err
+	}
+	return &SignedBuildAttestation{
+		Attestation: attestation,
+		Verifier:    info.GetAddress(),
+		PubKey:      bechPubKey,
+		Signature:   sig,
+	}, nil
+}
+
+func runCmd(cmd *cobra.Command, dir string, name string, args ...string) error {
+	fmt.Fprintf(cmd.ErrOrStderr(), "running: %s %s\n", name, strings.Join(args, " "))
+	c := exec.Command(name, args...)
+	c.Dir = dir
+	c.Stdout = cmd.ErrOrStderr()
+	c.Stderr = cmd.ErrOrStderr()
+	return c.Run()
+}
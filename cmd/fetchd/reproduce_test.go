@@ -0,0 +1,109 @@
+package main
+
+import (
+	"crypto/sha256"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSource(t *testing.T) {
+	specs := map[string]struct {
+		src    string
+		exp    source
+		expErr bool
+	}{
+		"fragment tag": {
+			src: "https://github.com/CosmWasm/cosmwasm-examples.git#escrow-0.3.0",
+			exp: source{repo: "https://github.com/CosmWasm/cosmwasm-examples.git", ref: "escrow-0.3.0"},
+		},
+		"fragment commit": {
+			src: "https://example.com/repo#96f2b9c1",
+			exp: source{repo: "https://example.com/repo", ref: "96f2b9c1"},
+		},
+		"tree path": {
+			src: "https://github.com/CosmWasm/cosmwasm-examples/tree/escrow-0.3.0/escrow",
+			exp: source{repo: "https://github.com/CosmWasm/cosmwasm-examples", ref: "escrow-0.3.0", dir: "escrow"},
+		},
+		"tree path without dir": {
+			src: "https://github.com/fetchai/contract/tree/v1.0.0",
+			exp: source{repo: "https://github.com/fetchai/contract", ref: "v1.0.0"},
+		},
+		"gitlab tree path": {
+			src: "https://gitlab.com/org/repo/-/tree/v1.0.0/contracts/escrow/",
+			exp: source{repo: "https://gitlab.com/org/repo", ref: "v1.0.0", dir: "contracts/escrow"},
+		},
+		"no ref":           {src: "https://github.com/CosmWasm/cosmwasm-examples", expErr: true},
+		"tree without ref": {src: "https://github.com/CosmWasm/cosmwasm-examples/tree", expErr: true},
+		"invalid":          {src: "https://github.com/%zz", expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			src, err := parseSource(spec.src)
+			if spec.expErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.exp, src)
+		})
+	}
+}
+
+func TestBuildHash(t *testing.T) {
+	var (
+		contract, artifact, other = []byte("contract"), []byte("artifact"), []byte("other")
+		sum                       = func(bz []byte) []byte { h := sha256.Sum256(bz); return h[:] }
+	)
+	specs := map[string]struct {
+		files   map[string][]byte
+		expHash []byte
+		exp     []byte
+		expErr  bool
+	}{
+		"contract.wasm": {
+			files:   map[string][]byte{"contract.wasm": contract},
+			expHash: sum(contract),
+			exp:     sum(contract),
+		},
+		"matching artifact": {
+			files:   map[string][]byte{"contract.wasm": other, "artifacts/a.wasm": other, "artifacts/b.wasm": artifact},
+			expHash: sum(artifact),
+			exp:     sum(artifact),
+		},
+		"no match returns first output": {
+			files:   map[string][]byte{"artifacts/a.wasm": artifact, "artifacts/b.wasm": other},
+			expHash: sum(contract),
+			exp:     sum(artifact),
+		},
+		"no output": {
+			files:   map[string][]byte{"artifacts/a.txt": artifact},
+			expHash: sum(artifact),
+			expErr:  true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "wasm-reproduce-test")
+			require.NoError(t, err)
+			defer os.RemoveAll(dir)
+			for name, bz := range spec.files {
+				p := filepath.Join(dir, filepath.FromSlash(name))
+				require.NoError(t, os.MkdirAll(filepath.Dir(p), 0755))
+				require.NoError(t, ioutil.WriteFile(p, bz, 0644))
+			}
+
+			hash, err := buildHash(dir, spec.expHash)
+			if spec.expErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.exp, hash)
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/fetchai/fetchd/rosetta"
+)
+
+const (
+	flagRosettaListenAddr = "laddr"
+	flagRosettaGas        = "gas"
+)
+
+// rosettaCmd serves the Rosetta APIs for the chain of a node
+func rosettaCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rosetta",
+		Short: "Serve the Rosetta Data and Construction APIs backed by the RPC of a node",
+		Long: fmt.Sprintf(`Serve the Rosetta API (version %s) for exchange integrations on --laddr, backed by the RPC of
+the node at --node. The Data API serves the blocks with the transfers of their txs and of the begin and end blocker,
+the account balances, also at past heights that the node did not prune, and the mempool. The Construction API builds,
+signs and submits bank sends with the --gas limit and a fee at the base fee of the fee market. The currencies are the
+base denoms with the decimals of their display unit.`, rosetta.Version),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			chainID := viper.GetString(flags.FlagChainID)
+			if chainID == "" {
+				return fmt.Errorf("--%s is required", flags.FlagChainID)
+			}
+			// the server is run next to its own node, there is no light client to verify the query proofs with
+			viper.Set(flags.FlagTrustNode, true)
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			server := rosetta.NewServer(cdc, cliCtx, chainID, viper.GetUint64(flagRosettaGas))
+
+			laddr := viper.GetString(flagRosettaListenAddr)
+			cmd.Printf("serving the rosetta api for %s on %s\n", chainID, laddr)
+			return http.ListenAndServe(laddr, server.Handler())
+		},
+	}
+	cmd.Flags().String(flagRosettaListenAddr, "localhost:8080", "The address to serve the Rosetta API on")
+	cmd.Flags().Uint64(flagRosettaGas, 200000, "Gas limit of the txs of the Construction API")
+	cmd.Flags().String(flags.FlagChainID, "", "Chain ID of tendermint node")
+	return flags.GetCommands(cmd)[0]
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/fetchai/fetchd/app"
+)
+
+const (
+	flagSimBlocks        = "blocks"
+	flagSimSeed          = "seed"
+	flagSimBlockSize     = "block-size"
+	flagSimGenesis       = "genesis"
+	flagSimPeriod        = "period"
+	flagSimAllInvariants = "all-invariants"
+	flagSimCommit        = "commit"
+)
+
+// simulateChainCmd runs the randomized multi module simulation for release validation
+func simulateChainCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-chain",
+		Short: "Run the deterministic multi module chain simulation",
+		Long: `Run the randomized simulation of all modules for --blocks blocks, asserting invariants
+every --period blocks, then export the state, import it into a new app and compare all stores.
+The run is fully determined by the flags, a failure prints the command to reproduce it.
+Without --genesis a random genesis is generated from the seed.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			config := simulation.Config{
+				GenesisFile:        viper.GetString(flagSimGenesis),
+				Seed:               viper.GetInt64(flagSimSeed),
+				InitialBlockHeight: 1,
+				NumBlocks:          viper.GetInt(flagSimBlocks),
+				BlockSize:          viper.GetInt(flagSimBlockSize),
+				ChainID:            helpers.SimAppChainID,
+				Lean:               true,
+				Commit:             viper.GetBool(flagSimCommit),
+				AllInvariants:      viper.GetBool(flagSimAllInvariants),
+			}
+			if config.NumBlocks <= 0 {
+				return fmt.Errorf("--%s must be positive", flagSimBlocks)
+			}
+			period := viper.GetUint(flagSimPeriod)
+
+			// keep the wasm cache of the simulated apps away from the node home
+			home, err := ioutil.TempDir("", "fetchd-simulate-chain")
+			if err != nil {
+				return err
+			}
+			defer os.RemoveAll(home)
+			viper.Set(cli.HomeFlag, home)
+
+			if err := app.SimulateChain(cmd.OutOrStdout(), config, period); err != nil {
+				_, _ = fmt.Fprintf(os.Stderr, "simulation failed with seed %d, reproduce with:\n  %s\n", config.Seed, reproduceArgs(config, period))
+				return err
+			}
+			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "simulation of %d blocks with seed %d passed\n", config.NumBlocks, config.Seed)
+			return nil
+		},
+	}
+	cmd.Flags().Int(flagSimBlocks, 500, "Number of blocks to simulate")
+	cmd.Flags().Int64(flagSimSeed, 42, "Seed of the simulation randomness")
+	cmd.Flags().Int(flagSimBlockSize, 200, "Operations per block")
+	cmd.Flags().String(flagSimGenesis, "", "Genesis file to start the simulation from instead of a random genesis")
+	cmd.Flags().Uint(flagSimPeriod, 1, "Assert the registered invariants every N blocks")
+	cmd.Flags().Bool(flagSimAllInvariants, false, "Assert all invariants after every operation")
+	cmd.Flags().Bool(flagSimCommit, true, "Commit every block")
+	return cmd
+}
+
+// reproduceArgs returns the command line that runs the same simulation again
+func reproduceArgs(config simulation.Config, period uint) string {
+	args := []string{
+		"fetchd simulate-chain",
+		fmt.Sprintf("--%s=%d", flagSimBlocks, config.NumBlocks),
+		fmt.Sprintf("--%s=%d", flagSimSeed, config.Seed),
+		fmt.Sprintf("--%s=%d", flagSimBlockSize, config.BlockSize),
+		fmt.Sprintf("--%s=%d", flagSimPeriod, period),
+		fmt.Sprintf("--%s=%t", flagSimAllInvariants, config.AllInvariants),
+		fmt.Sprintf("--%s=%t", flagSimCommit, config.Commit),
+	}
+	if config.GenesisFile != "" {
+		args = append(args, fmt.Sprintf("--%s=%s", flagSimGenesis, config.GenesisFile))
+	}
+	return strings.Join(args, " ")
+}
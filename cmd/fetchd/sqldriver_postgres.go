@@ -0,0 +1,7 @@
+//go:build postgres
+// +build postgres
+
+package main
+
+// the postgres driver of the indexer
+import _ "github.com/lib/pq"
@@ -0,0 +1,7 @@
+//go:build sqlite3
+// +build sqlite3
+
+package main
+
+// the sqlite3 driver of the indexer, it needs cgo
+import _ "github.com/mattn/go-sqlite3"
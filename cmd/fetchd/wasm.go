@@ -0,0 +1,27 @@
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+// wasmCmd groups the node side tooling for the wasm module
+func wasmCmd(ctx *server.Context, cdc *codec.Codec, defaultNodeHome, defaultClientHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "wasm",
+		Short:                      "Wasm contract tooling",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(
+		reproduceCmd(cdc, defaultClientHome),
+		dumpContractCmd(ctx, cdc),
+		importGenesisContractCmd(ctx, cdc, defaultNodeHome),
+		simulateLocalCmd(),
+	)
+	return cmd
+}
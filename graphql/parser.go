@@ -0,0 +1,374 @@
+package graphql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// field is a field of a selection set with its arguments, the values are decoded json values or variables
+type field struct {
+	Alias      string
+	Name       string
+	Arguments  map[string]interface{}
+	Selections []field
+}
+
+// key is the name of the field in the result
+func (f field) key() string {
+	if f.Alias != "" {
+		return f.Alias
+	}
+	return f.Name
+}
+
+// variable is a reference to a variable of the request in an argument value
+type variable string
+
+// operation is a query of a document
+type operation struct {
+	Name       string
+	Selections []field
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenPunct
+	tokenName
+	tokenString
+	tokenNumber
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+}
+
+// lex splits the document into tokens, commas and comments are ignored like white space
+func lex(doc string) ([]token, error) {
+	var tokens []token
+	rs := []rune(doc)
+	for i := 0; i < len(rs); {
+		r := rs[i]
+		switch {
+		case unicode.IsSpace(r) || r == ',' || r == '\uFEFF':
+			i++
+		case r == '#':
+			for i < len(rs) && rs[i] != '\n' {
+				i++
+			}
+		case strings.ContainsRune("{}()[]:!$=@", r):
+			tokens = append(tokens, token{kind: tokenPunct, value: string(r)})
+			i++
+		case r == '.':
+			if i+2 >= len(rs) || rs[i+1] != '.' || rs[i+2] != '.' {
+				return nil, fmt.Errorf("unexpected character %q", r)
+			}
+			tokens = append(tokens, token{kind: tokenPunct, value: "..."})
+			i += 3
+		case r == '_' || unicode.IsLetter(r):
+			j := i
+			for j < len(rs) && (rs[j] == '_' || unicode.IsLetter(rs[j]) || unicode.IsDigit(rs[j])) {
+				j++
+			}
+			tokens = append(tokens, token{kind: tokenName, value: string(rs[i:j])})
+			i = j
+		case r == '-' || unicode.IsDigit(r):
+			j := i + 1
+			for j < len(rs) && (unicode.IsDigit(rs[j]) || strings.ContainsRune(".eE+-", rs[j])) {
+				j++
+			}
+			tokens = append(tokens, token{kind: tokenNumber, value: string(rs[i:j])})
+			i = j
+		case r == '"':
+			j := i + 1
+			for ; j < len(rs) && rs[j] != '"'; j++ {
+				if rs[j] == '\\' {
+					j++
+				} else if rs[j] == '\n' {
+					break
+				}
+			}
+			if j >= len(rs) || rs[j] != '"' {
+				return nil, fmt.Errorf("unterminated string")
+			}
+			s, err := strconv.Unquote(string(rs[i : j+1]))
+			if err != nil {
+				return nil, fmt.Errorf("invalid string %s", string(rs[i:j+1]))
+			}
+			tokens = append(tokens, token{kind: tokenString, value: s})
+			i = j + 1
+		default:
+			return nil, fmt.Errorf("unexpected character %q", r)
+		}
+	}
+	return append(tokens, token{kind: tokenEOF}), nil
+}
+
+// maxDepth is the maximum nesting of the selection sets of a query
+const maxDepth = 10
+
+type parser struct {
+	tokens []token
+	pos    int
+	// depth is the nesting of the selection set in parsing
+	depth int
+}
+
+// parse returns the operation of the document with the name, which may be empty if the document has one only.
+// Fragments, directives, mutations and subscriptions are not supported, selection sets nest up to maxDepth.
+func parse(doc, operationName string) (operation, error) {
+	tokens, err := lex(doc)
+	if err != nil {
+		return operation{}, err
+	}
+	p := &parser{tokens: tokens}
+	var ops []operation
+	for p.peek().kind != tokenEOF {
+		op, err := p.operation()
+		if err != nil {
+			return operation{}, err
+		}
+		ops = append(ops, op)
+	}
+	switch {
+	case len(ops) == 0:
+		return operation{}, fmt.Errorf("no operation")
+	case operationName == "" && len(ops) == 1:
+		return ops[0], nil
+	case operationName == "":
+		return operation{}, fmt.Errorf("operation name required for documents with several operations")
+	}
+	for _, op := range ops {
+		if op.Name == operationName {
+			return op, nil
+		}
+	}
+	return operation{}, fmt.Errorf("unknown operation %s", operationName)
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) isPunct(value string) bool {
+	t := p.peek()
+	return t.kind == tokenPunct && t.value == value
+}
+
+func (p *parser) expectPunct(value string) error {
+	if t := p.next(); t.kind != tokenPunct || t.value != value {
+		return p.unexpected(t, value)
+	}
+	return nil
+}
+
+func (p *parser) expectName() (string, error) {
+	t := p.next()
+	if t.kind != tokenName {
+		return "", p.unexpected(t, "name")
+	}
+	return t.value, nil
+}
+
+func (p *parser) unexpected(t token, expected string) error {
+	if t.kind == tokenEOF {
+		return fmt.Errorf("unexpected end of document, expected %s", expected)
+	}
+	return fmt.Errorf("unexpected %q, expected %s", t.value, expected)
+}
+
+func (p *parser) operation() (operation, error) {
+	var op operation
+	if p.peek().kind == tokenName {
+		switch kind := p.next().value; kind {
+		case "query":
+		case "fragment":
+			return op, fmt.Errorf("fragments are not supported")
+		default:
+			return op, fmt.Errorf("%s operations are not supported", kind)
+		}
+		if p.peek().kind == tokenName {
+			op.Name = p.next().value
+		}
+		if p.isPunct("(") {
+			if err := p.skipVariableDefinitions(); err != nil {
+				return op, err
+			}
+		}
+		if p.isPunct("@") {
+			return op, fmt.Errorf("directives are not supported")
+		}
+	}
+	selections, err := p.selectionSet()
+	if err != nil {
+		return op, err
+	}
+	op.Selections = selections
+	return op, nil
+}
+
+// skipVariableDefinitions skips the definitions, the variables of the request are used untyped
+func (p *parser) skipVariableDefinitions() error {
+	p.next()
+	for !p.isPunct(")") {
+		if err := p.expectPunct("$"); err != nil {
+			return err
+		}
+		if _, err := p.expectName(); err != nil {
+			return err
+		}
+		if err := p.expectPunct(":"); err != nil {
+			return err
+		}
+		for p.isPunct("[") || p.isPunct("]") || p.isPunct("!") || p.peek().kind == tokenName {
+			p.next()
+		}
+		if p.isPunct("=") {
+			return fmt.Errorf("variable default values are not supported")
+		}
+		if p.peek().kind == tokenEOF {
+			return p.unexpected(p.peek(), ")")
+		}
+	}
+	p.next()
+	return nil
+}
+
+func (p *parser) selectionSet() ([]field, error) {
+	if err := p.expectPunct("{"); err != nil {
+		return nil, err
+	}
+	if p.depth++; p.depth > maxDepth {
+		return nil, fmt.Errorf("selection sets nested deeper than %d", maxDepth)
+	}
+	defer func() { p.depth-- }()
+	var fields []field
+	for !p.isPunct("}") {
+		if p.isPunct("...") {
+			return nil, fmt.Errorf("fragments are not supported")
+		}
+		f, err := p.field()
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, f)
+	}
+	p.next()
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("empty selection set")
+	}
+	return fields, nil
+}
+
+func (p *parser) field() (field, error) {
+	var f field
+	name, err := p.expectName()
+	if err != nil {
+		return f, err
+	}
+	if p.isPunct(":") {
+		p.next()
+		f.Alias = name
+		if name, err = p.expectName(); err != nil {
+			return f, err
+		}
+	}
+	f.Name = name
+	if p.isPunct("(") {
+		p.next()
+		f.Arguments = make(map[string]interface{})
+		for !p.isPunct(")") {
+			arg, err := p.expectName()
+			if err != nil {
+				return f, err
+			}
+			if err := p.expectPunct(":"); err != nil {
+				return f, err
+			}
+			if f.Arguments[arg], err = p.value(); err != nil {
+				return f, err
+			}
+		}
+		p.next()
+	}
+	if p.isPunct("@") {
+		return f, fmt.Errorf("directives are not supported")
+	}
+	if p.isPunct("{") {
+		if f.Selections, err = p.selectionSet(); err != nil {
+			return f, err
+		}
+	}
+	return f, nil
+}
+
+// value returns the argument value as a json value, enum values are strings
+func (p *parser) value() (interface{}, error) {
+	t := p.next()
+	switch t.kind {
+	case tokenString:
+		return t.value, nil
+	case tokenNumber:
+		n, err := strconv.ParseFloat(t.value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %s", t.value)
+		}
+		return n, nil
+	case tokenName:
+		switch t.value {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		case "null":
+			return nil, nil
+		}
+		return t.value, nil
+	case tokenPunct:
+		switch t.value {
+		case "$":
+			name, err := p.expectName()
+			return variable(name), err
+		case "[":
+			list := []interface{}{}
+			for !p.isPunct("]") {
+				v, err := p.value()
+				if err != nil {
+					return nil, err
+				}
+				list = append(list, v)
+			}
+			p.next()
+			return list, nil
+		case "{":
+			obj := map[string]interface{}{}
+			for !p.isPunct("}") {
+				key, err := p.expectName()
+				if err != nil {
+					return nil, err
+				}
+				if err := p.expectPunct(":"); err != nil {
+					return nil, err
+				}
+				if obj[key], err = p.value(); err != nil {
+					return nil, err
+				}
+			}
+			p.next()
+			return obj, nil
+		}
+	}
+	return nil, p.unexpected(t, "value")
+}
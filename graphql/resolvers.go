@@ -0,0 +1,182 @@
+package graphql
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	"github.com/fetchai/fetchd/x/wasm"
+)
+
+// resolver resolves a query field with its arguments to a json value
+type resolver struct {
+	args    []string
+	resolve func(args arguments) (interface{}, error)
+}
+
+func (r resolver) accepts(arg string) bool {
+	for _, a := range r.args {
+		if a == arg {
+			return true
+		}
+	}
+	return false
+}
+
+// arguments are the arguments of a query field with their variables substituted
+type arguments map[string]interface{}
+
+func (a arguments) string(name string) (string, error) {
+	s, ok := a[name].(string)
+	if !ok || s == "" {
+		return "", fmt.Errorf("argument %s must be a non empty string", name)
+	}
+	return s, nil
+}
+
+func (a arguments) int(name string, defaultValue int) (int, error) {
+	v, ok := a[name]
+	if !ok || v == nil {
+		return defaultValue, nil
+	}
+	n, ok := v.(float64)
+	if !ok || n != float64(int(n)) || n < 1 {
+		return 0, fmt.Errorf("argument %s must be a positive integer", name)
+	}
+	return int(n), nil
+}
+
+// json returns the argument as json, a string argument is taken as json text
+func (a arguments) json(name string) ([]byte, error) {
+	v, ok := a[name]
+	if !ok || v == nil {
+		return nil, fmt.Errorf("argument %s is required", name)
+	}
+	if s, ok := v.(string); ok {
+		if !json.Valid([]byte(s)) {
+			return nil, fmt.Errorf("argument %s must be json", name)
+		}
+		return []byte(s), nil
+	}
+	return json.Marshal(v)
+}
+
+func (a arguments) accAddress(name string) (sdk.AccAddress, error) {
+	s, err := a.string(name)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.AccAddressFromBech32(s)
+}
+
+// newResolvers returns the query fields of the schema:
+//
+//	account(address: String!)                               the auth account
+//	balance(address: String!)                               the coins of the account
+//	validators(status: String = "Bonded", page: Int = 1, limit: Int = 100)
+//	validator(address: String!)                             the validator of the operator address
+//	contract(address: String!)                              the contract info
+//	smart(contract: String!, query: JSON!)                  the result of the smart query of the contract
+func newResolvers(cdc *codec.Codec, query QueryFunc) map[string]resolver {
+	// queryJSON queries the path with the amino json of the params and decodes the json result
+	queryJSON := func(path string, params interface{}) (interface{}, error) {
+		var data []byte
+		if params != nil {
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return nil, err
+			}
+			data = bz
+		}
+		res, err := query(path, data)
+		if err != nil {
+			return nil, err
+		}
+		return decodeJSON(res)
+	}
+	return map[string]resolver{
+		"account": {args: []string{"address"}, resolve: func(args arguments) (interface{}, error) {
+			addr, err := args.accAddress("address")
+			if err != nil {
+				return nil, err
+			}
+			return queryJSON(fmt.Sprintf("custom/%s/%s", auth.QuerierRoute, auth.QueryAccount), auth.NewQueryAccountParams(addr))
+		}},
+		"balance": {args: []string{"address"}, resolve: func(args arguments) (interface{}, error) {
+			addr, err := args.accAddress("address")
+			if err != nil {
+				return nil, err
+			}
+			return queryJSON(fmt.Sprintf("custom/%s/%s", bank.QuerierRoute, bank.QueryBalance), bank.NewQueryBalanceParams(addr))
+		}},
+		"validators": {args: []string{"status", "page", "limit"}, resolve: func(args arguments) (interface{}, error) {
+			status := sdk.BondStatusBonded
+			if _, ok := args["status"]; ok {
+				s, err := args.string("status")
+				if err != nil {
+					return nil, err
+				}
+				status = s
+			}
+			page, err := args.int("page", 1)
+			if err != nil {
+				return nil, err
+			}
+			limit, err := args.int("limit", 100)
+			if err != nil {
+				return nil, err
+			}
+			return queryJSON(fmt.Sprintf("custom/%s/%s", staking.QuerierRoute, staking.QueryValidators), staking.NewQueryValidatorsParams(page, limit, status))
+		}},
+		"validator": {args: []string{"address"}, resolve: func(args arguments) (interface{}, error) {
+			s, err := args.string("address")
+			if err != nil {
+				return nil, err
+			}
+			addr, err := sdk.ValAddressFromBech32(s)
+			if err != nil {
+				return nil, err
+			}
+			return queryJSON(fmt.Sprintf("custom/%s/%s", staking.QuerierRoute, staking.QueryValidator), staking.NewQueryValidatorParams(addr))
+		}},
+		"contract": {args: []string{"address"}, resolve: func(args arguments) (interface{}, error) {
+			addr, err := args.accAddress("address")
+			if err != nil {
+				return nil, err
+			}
+			return queryJSON(fmt.Sprintf("custom/%s/%s/%s", wasm.QuerierRoute, wasm.QueryGetContract, addr), nil)
+		}},
+		"smart": {args: []string{"contract", "query"}, resolve: func(args arguments) (interface{}, error) {
+			addr, err := args.accAddress("contract")
+			if err != nil {
+				return nil, err
+			}
+			msg, err := args.json("query")
+			if err != nil {
+				return nil, err
+			}
+			res, err := query(fmt.Sprintf("custom/%s/%s/%s/%s", wasm.QuerierRoute, wasm.QueryGetContractState, addr, wasm.QueryMethodContractStateSmart), msg)
+			if err != nil {
+				return nil, err
+			}
+			return decodeJSON(res)
+		}},
+	}
+}
+
+// decodeJSON decodes the json keeping numbers as they are
+func decodeJSON(bz []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
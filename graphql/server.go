@@ -0,0 +1,203 @@
+package graphql
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// maxBodyBytes is the maximum size of a request body, of a single request or a batch
+const maxBodyBytes = 1 << 20
+
+// QueryFunc queries the node at the abci query path with the data, like context.CLIContext.QueryWithData
+type QueryFunc func(path string, data []byte) ([]byte, error)
+
+// Server serves GraphQL queries of the chain state. The result of a query field is the json returned by the node,
+// the selection sets pick the fields of its objects by their json names; a field without selection set returns
+// its whole value.
+type Server struct {
+	resolvers map[string]resolver
+	maxBatch  int
+}
+
+// NewServer returns a Server querying the node with query, maxBatch limits the number of requests of a batch
+func NewServer(cdc *codec.Codec, query QueryFunc, maxBatch int) *Server {
+	return &Server{resolvers: newResolvers(cdc, query), maxBatch: maxBatch}
+}
+
+// Request is a GraphQL request, a batch is a json list of them
+type Request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName,omitempty"`
+	Variables     map[string]interface{} `json:"variables,omitempty"`
+}
+
+// Response is the response to a Request
+type Response struct {
+	Data   map[string]interface{} `json:"data"`
+	Errors []Error                `json:"errors,omitempty"`
+}
+
+// Error is an error of a Response, the path is the one of the query field that failed
+type Error struct {
+	Message string   `json:"message"`
+	Path    []string `json:"path,omitempty"`
+}
+
+// Handler returns the http handler answering POST requests with a single request or a batch of up to maxBodyBytes
+func (s *Server) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxBodyBytes))
+		if err != nil {
+			status := http.StatusBadRequest
+			if len(body) >= maxBodyBytes {
+				status = http.StatusRequestEntityTooLarge
+			}
+			http.Error(w, err.Error(), status)
+			return
+		}
+		var res interface{}
+		if body = bytes.TrimSpace(body); len(body) > 0 && body[0] == '[' {
+			var reqs []Request
+			if err := json.Unmarshal(body, &reqs); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			if s.maxBatch > 0 && len(reqs) > s.maxBatch {
+				http.Error(w, fmt.Sprintf("batch of %d requests exceeds the maximum of %d", len(reqs), s.maxBatch), http.StatusBadRequest)
+				return
+			}
+			resps := make([]Response, len(reqs))
+			for i, req := range reqs {
+				resps[i] = s.Execute(req)
+			}
+			res = resps
+		} else {
+			var req Request
+			if err := json.Unmarshal(body, &req); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			res = s.Execute(req)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(res)
+	})
+}
+
+// Execute runs the query of the request. The fields of the query resolve independently, a failing field is null
+// in the data and reported in the errors.
+func (s *Server) Execute(req Request) Response {
+	op, err := parse(req.Query, req.OperationName)
+	if err != nil {
+		return Response{Errors: []Error{{Message: err.Error()}}}
+	}
+	res := Response{Data: make(map[string]interface{})}
+	for _, f := range op.Selections {
+		value, err := s.resolve(f, req.Variables)
+		if err != nil {
+			res.Errors = append(res.Errors, Error{Message: err.Error(), Path: []string{f.key()}})
+		}
+		res.Data[f.key()] = value
+	}
+	return res
+}
+
+func (s *Server) resolve(f field, variables map[string]interface{}) (interface{}, error) {
+	r, ok := s.resolvers[f.Name]
+	if !ok {
+		return nil, fmt.Errorf("unknown query field %s", f.Name)
+	}
+	args := make(map[string]interface{}, len(f.Arguments))
+	for name, value := range f.Arguments {
+		v, err := substitute(value, variables)
+		if err != nil {
+			return nil, err
+		}
+		args[name] = v
+	}
+	for name := range args {
+		if !r.accepts(name) {
+			return nil, fmt.Errorf("unknown argument %s of %s", name, f.Name)
+		}
+	}
+	value, err := r.resolve(arguments(args))
+	if err != nil {
+		return nil, err
+	}
+	return project(value, f.Selections)
+}
+
+// substitute replaces the variables in the argument value by their values
+func substitute(value interface{}, variables map[string]interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case variable:
+		val, ok := variables[string(v)]
+		if !ok {
+			return nil, fmt.Errorf("undefined variable $%s", v)
+		}
+		return val, nil
+	case []interface{}:
+		list := make([]interface{}, len(v))
+		for i, e := range v {
+			val, err := substitute(e, variables)
+			if err != nil {
+				return nil, err
+			}
+			list[i] = val
+		}
+		return list, nil
+	case map[string]interface{}:
+		obj := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			val, err := substitute(e, variables)
+			if err != nil {
+				return nil, err
+			}
+			obj[k] = val
+		}
+		return obj, nil
+	}
+	return value, nil
+}
+
+// project returns the fields of the selections of the json value, for every element of lists
+func project(value interface{}, selections []field) (interface{}, error) {
+	if len(selections) == 0 || value == nil {
+		return value, nil
+	}
+	switch v := value.(type) {
+	case []interface{}:
+		list := make([]interface{}, len(v))
+		for i, e := range v {
+			val, err := project(e, selections)
+			if err != nil {
+				return nil, err
+			}
+			list[i] = val
+		}
+		return list, nil
+	case map[string]interface{}:
+		obj := make(map[string]interface{}, len(selections))
+		for _, f := range selections {
+			if len(f.Arguments) > 0 {
+				return nil, fmt.Errorf("arguments are only supported on query fields, not on %s", f.Name)
+			}
+			val, err := project(v[f.Name], f.Selections)
+			if err != nil {
+				return nil, err
+			}
+			obj[f.key()] = val
+		}
+		return obj, nil
+	}
+	return nil, fmt.Errorf("selection set on a scalar value")
+}
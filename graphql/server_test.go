@@ -0,0 +1,111 @@
+package graphql
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecute(t *testing.T) {
+	alice := sdk.AccAddress([]byte("alice_______________")).String()
+	contract := sdk.AccAddress([]byte("contract____________")).String()
+	var smartQuery string
+	query := func(path string, data []byte) ([]byte, error) {
+		switch {
+		case path == "custom/bank/balances":
+			return []byte(`[{"denom":"atestfet","amount":"100"},{"denom":"uusdc","amount":"7"}]`), nil
+		case path == "custom/staking/validators":
+			return []byte(`[{"operator_address":"fetchvaloper1","status":2,"tokens":"10"}]`), nil
+		case strings.HasSuffix(path, "/smart"):
+			smartQuery = string(data)
+			return []byte(`{"count":3}`), nil
+		}
+		return nil, errors.New("unknown path " + path)
+	}
+	s := NewServer(codec.New(), query, 0)
+
+	specs := map[string]struct {
+		req       Request
+		expData   string
+		expErrors []Error
+	}{
+		"selections and aliases": {
+			req:     Request{Query: `{ mine: balance(address: "` + alice + `") { denom } validators { operator_address tokens } }`},
+			expData: `{"mine":[{"denom":"atestfet"},{"denom":"uusdc"}],"validators":[{"operator_address":"fetchvaloper1","tokens":"10"}]}`,
+		},
+		"whole value without selection": {
+			req:     Request{Query: `query { balance(address: "` + alice + `") }`},
+			expData: `{"balance":[{"amount":"100","denom":"atestfet"},{"amount":"7","denom":"uusdc"}]}`,
+		},
+		"variables": {
+			req: Request{
+				Query:     `query Count($addr: String!, $q: JSON!) { smart(contract: $addr, query: $q) { count } }`,
+				Variables: map[string]interface{}{"addr": contract, "q": map[string]interface{}{"get_count": map[string]interface{}{}}},
+			},
+			expData: `{"smart":{"count":3}}`,
+		},
+		"failing field": {
+			req:       Request{Query: `{ balance(address: "` + alice + `") { denom } contract(address: "` + contract + `") }`},
+			expData:   `{"balance":[{"denom":"atestfet"},{"denom":"uusdc"}],"contract":null}`,
+			expErrors: []Error{{Message: "unknown path custom/wasm/contract-info/" + contract, Path: []string{"contract"}}},
+		},
+		"unknown argument": {
+			req:       Request{Query: `{ validators(height: 3) { tokens } }`},
+			expData:   `{"validators":null}`,
+			expErrors: []Error{{Message: "unknown argument height of validators", Path: []string{"validators"}}},
+		},
+		"fragments": {
+			req:       Request{Query: `{ validators { ...fields } }`},
+			expData:   `null`,
+			expErrors: []Error{{Message: "fragments are not supported"}},
+		},
+		"nesting too deep": {
+			req:       Request{Query: `{ validators ` + strings.Repeat("{ a ", maxDepth) + strings.Repeat("}", maxDepth) + ` }`},
+			expData:   `null`,
+			expErrors: []Error{{Message: "selection sets nested deeper than 10"}},
+		},
+		"mutations": {
+			req:       Request{Query: `mutation { send }`},
+			expData:   `null`,
+			expErrors: []Error{{Message: "mutation operations are not supported"}},
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			res := s.Execute(spec.req)
+			bz, err := json.Marshal(res.Data)
+			require.NoError(t, err)
+			assert.JSONEq(t, spec.expData, string(bz))
+			assert.Equal(t, spec.expErrors, res.Errors)
+		})
+	}
+	assert.JSONEq(t, `{"get_count":{}}`, smartQuery)
+}
+
+func TestHandlerBatch(t *testing.T) {
+	query := func(path string, data []byte) ([]byte, error) {
+		return []byte(`[{"tokens":"10"}]`), nil
+	}
+	h := NewServer(codec.New(), query, 2).Handler()
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`[{"query":"{ validators { tokens } }"},{"query":"{ v: validators(limit: 1) { tokens } }"}]`)))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `[{"data":{"validators":[{"tokens":"10"}]}},{"data":{"v":[{"tokens":"10"}]}}]`, rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`[{"query":"{a}"},{"query":"{b}"},{"query":"{c}"}]`)))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"query":"{ validators }","variables":{"pad":"`+strings.Repeat("a", maxBodyBytes)+`"}}`)))
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+}
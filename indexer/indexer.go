@@ -0,0 +1,237 @@
+package indexer
+
+import (
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// Supported drivers, the binary must be built with the build tag of the same name to link them
+const (
+	DriverPostgres = "postgres"
+	DriverSQLite   = "sqlite3"
+)
+
+// Event sources
+const (
+	SourceBeginBlock = "begin_block"
+	SourceTx         = "tx"
+	SourceEndBlock   = "end_block"
+)
+
+// schema is created on open, the statements are valid for postgres and sqlite
+var schema = []string{
+	`CREATE TABLE IF NOT EXISTS blocks (
+		height BIGINT PRIMARY KEY,
+		hash TEXT NOT NULL,
+		time TIMESTAMP NOT NULL,
+		num_txs INTEGER NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS txs (
+		height BIGINT NOT NULL,
+		tx_index INTEGER NOT NULL,
+		hash TEXT NOT NULL,
+		code INTEGER NOT NULL,
+		codespace TEXT NOT NULL,
+		gas_wanted BIGINT NOT NULL,
+		gas_used BIGINT NOT NULL,
+		PRIMARY KEY (height, tx_index)
+	)`,
+	`CREATE INDEX IF NOT EXISTS txs_hash ON txs (hash)`,
+	`CREATE TABLE IF NOT EXISTS events (
+		height BIGINT NOT NULL,
+		event_index INTEGER NOT NULL,
+		source TEXT NOT NULL,
+		tx_index INTEGER,
+		type TEXT NOT NULL,
+		PRIMARY KEY (height, event_index)
+	)`,
+	`CREATE INDEX IF NOT EXISTS events_type ON events (type, height)`,
+	`CREATE TABLE IF NOT EXISTS attributes (
+		height BIGINT NOT NULL,
+		event_index INTEGER NOT NULL,
+		attribute_index INTEGER NOT NULL,
+		key TEXT NOT NULL,
+		value TEXT NOT NULL,
+		PRIMARY KEY (height, event_index, attribute_index)
+	)`,
+	`CREATE INDEX IF NOT EXISTS attributes_key_value ON attributes (key, value)`,
+}
+
+// Block is the data of a block the indexer stores
+type Block struct {
+	Height           int64
+	Hash             []byte
+	Time             time.Time
+	BeginBlockEvents []abci.Event
+	Txs              []Tx
+	EndBlockEvents   []abci.Event
+}
+
+// Tx is a delivered tx of a block with its result
+type Tx struct {
+	Hash   []byte
+	Result abci.ResponseDeliverTx
+}
+
+// Event is an event of a block in the order of delivery, TxIndex is -1 for the begin and end block events
+type Event struct {
+	Index   int
+	Source  string
+	TxIndex int
+	abci.Event
+}
+
+// Filter selects the events to store by type, an empty filter selects all
+type Filter []string
+
+// Events returns the selected events of the block, numbered in the order of delivery of all events so that the
+// index of an event does not depend on the filter
+func (f Filter) Events(b Block) []Event {
+	var events []Event
+	index := 0
+	add := func(source string, txIndex int, evts []abci.Event) {
+		for _, e := range evts {
+			if f.selects(e.Type) {
+				events = append(events, Event{Index: index, Source: source, TxIndex: txIndex, Event: e})
+			}
+			index++
+		}
+	}
+	add(SourceBeginBlock, -1, b.BeginBlockEvents)
+	for i, tx := range b.Txs {
+		add(SourceTx, i, tx.Result.Events)
+	}
+	add(SourceEndBlock, -1, b.EndBlockEvents)
+	return events
+}
+
+func (f Filter) selects(eventType string) bool {
+	if len(f) == 0 {
+		return true
+	}
+	for _, t := range f {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// Indexer stores blocks, their txs and the events selected by the filter in a sql database
+type Indexer struct {
+	db      *sql.DB
+	driver  string
+	filter  Filter
+	inserts map[string]string
+	deletes []string
+}
+
+// Open connects to the database of the driver and creates the schema if it does not exist
+func Open(driver, dsn string, filter Filter) (*Indexer, error) {
+	if driver != DriverPostgres && driver != DriverSQLite {
+		return nil, fmt.Errorf("unsupported driver %s", driver)
+	}
+	db, err := sql.Open(driver, dsn)
+	if err != nil {
+		return nil, err
+	}
+	for _, stmt := range schema {
+		if _, err := db.Exec(stmt); err != nil {
+			db.Close()
+			return nil, fmt.Errorf("create schema: %s", err)
+		}
+	}
+	idx := &Indexer{db: db, driver: driver, filter: filter}
+	idx.inserts = map[string]string{
+		"blocks":     idx.rebind("INSERT INTO blocks (height, hash, time, num_txs) VALUES (?, ?, ?, ?)"),
+		"txs":        idx.rebind("INSERT INTO txs (height, tx_index, hash, code, codespace, gas_wanted, gas_used) VALUES (?, ?, ?, ?, ?, ?, ?)"),
+		"events":     idx.rebind("INSERT INTO events (height, event_index, source, tx_index, type) VALUES (?, ?, ?, ?, ?)"),
+		"attributes": idx.rebind("INSERT INTO attributes (height, event_index, attribute_index, key, value) VALUES (?, ?, ?, ?, ?)"),
+	}
+	for _, table := range []string{"attributes", "events", "txs", "blocks"} {
+		idx.deletes = append(idx.deletes, idx.rebind("DELETE FROM "+table+" WHERE height = ?"))
+	}
+	return idx, nil
+}
+
+// rebind replaces the ? placeholders by the numbered ones of postgres
+func (idx *Indexer) rebind(query string) string {
+	if idx.driver != DriverPostgres {
+		return query
+	}
+	var b strings.Builder
+	n := 0
+	for _, r := range query {
+		if r == '?' {
+			n++
+			fmt.Fprintf(&b, "$%d", n)
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// Index stores the block in a single db transaction, replacing what is stored for its height
+func (idx *Indexer) Index(b Block) error {
+	dbTx, err := idx.db.Begin()
+	if err != nil {
+		return err
+	}
+	if err := idx.index(dbTx, b); err != nil {
+		_ = dbTx.Rollback()
+		return fmt.Errorf("index block %d: %s", b.Height, err)
+	}
+	return dbTx.Commit()
+}
+
+func (idx *Indexer) index(dbTx *sql.Tx, b Block) error {
+	for _, stmt := range idx.deletes {
+		if _, err := dbTx.Exec(stmt, b.Height); err != nil {
+			return err
+		}
+	}
+	if _, err := dbTx.Exec(idx.inserts["blocks"], b.Height, hex.EncodeToString(b.Hash), b.Time.UTC(), len(b.Txs)); err != nil {
+		return err
+	}
+	for i, tx := range b.Txs {
+		res := tx.Result
+		if _, err := dbTx.Exec(idx.inserts["txs"], b.Height, i, hex.EncodeToString(tx.Hash), res.Code, res.Codespace, res.GasWanted, res.GasUsed); err != nil {
+			return err
+		}
+	}
+	for _, e := range idx.filter.Events(b) {
+		var txIndex interface{}
+		if e.TxIndex >= 0 {
+			txIndex = e.TxIndex
+		}
+		if _, err := dbTx.Exec(idx.inserts["events"], b.Height, e.Index, e.Source, txIndex, e.Type); err != nil {
+			return err
+		}
+		for i, attr := range e.Attributes {
+			if _, err := dbTx.Exec(idx.inserts["attributes"], b.Height, e.Index, i, string(attr.Key), string(attr.Value)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// LastHeight returns the highest indexed height, 0 if there is none
+func (idx *Indexer) LastHeight() (int64, error) {
+	var height sql.NullInt64
+	if err := idx.db.QueryRow("SELECT MAX(height) FROM blocks").Scan(&height); err != nil {
+		return 0, err
+	}
+	return height.Int64, nil
+}
+
+// Close closes the database
+func (idx *Indexer) Close() error {
+	return idx.db.Close()
+}
@@ -0,0 +1,56 @@
+package indexer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestFilterEvents(t *testing.T) {
+	block := Block{
+		Height:           3,
+		BeginBlockEvents: []abci.Event{{Type: "transfer"}},
+		Txs: []Tx{
+			{Result: abci.ResponseDeliverTx{Events: []abci.Event{{Type: "message"}, {Type: "wasm"}}}},
+			{Result: abci.ResponseDeliverTx{Events: []abci.Event{{Type: "wasm"}}}},
+		},
+		EndBlockEvents: []abci.Event{{Type: "wasm"}},
+	}
+	specs := map[string]struct {
+		filter    Filter
+		expEvents []Event
+	}{
+		"all": {
+			expEvents: []Event{
+				{Index: 0, Source: SourceBeginBlock, TxIndex: -1, Event: abci.Event{Type: "transfer"}},
+				{Index: 1, Source: SourceTx, TxIndex: 0, Event: abci.Event{Type: "message"}},
+				{Index: 2, Source: SourceTx, TxIndex: 0, Event: abci.Event{Type: "wasm"}},
+				{Index: 3, Source: SourceTx, TxIndex: 1, Event: abci.Event{Type: "wasm"}},
+				{Index: 4, Source: SourceEndBlock, TxIndex: -1, Event: abci.Event{Type: "wasm"}},
+			},
+		},
+		"wasm only": {
+			filter: Filter{"wasm"},
+			expEvents: []Event{
+				{Index: 2, Source: SourceTx, TxIndex: 0, Event: abci.Event{Type: "wasm"}},
+				{Index: 3, Source: SourceTx, TxIndex: 1, Event: abci.Event{Type: "wasm"}},
+				{Index: 4, Source: SourceEndBlock, TxIndex: -1, Event: abci.Event{Type: "wasm"}},
+			},
+		},
+		"no match": {
+			filter: Filter{"unknown"},
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			assert.Equal(t, spec.expEvents, spec.filter.Events(block))
+		})
+	}
+}
+
+func TestRebind(t *testing.T) {
+	query := "INSERT INTO txs (height, hash) VALUES (?, ?)"
+	assert.Equal(t, "INSERT INTO txs (height, hash) VALUES ($1, $2)", (&Indexer{driver: DriverPostgres}).rebind(query))
+	assert.Equal(t, query, (&Indexer{driver: DriverSQLite}).rebind(query))
+}
@@ -0,0 +1,312 @@
+package rosetta
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	feemarketcli "github.com/fetchai/fetchd/x/feemarket/client/cli"
+)
+
+// txMetadata is the metadata of /construction/metadata the unsigned tx is built with
+type txMetadata struct {
+	ChainID       string `json:"chain_id"`
+	AccountNumber uint64 `json:"account_number"`
+	Sequence      uint64 `json:"sequence"`
+	Gas           uint64 `json:"gas"`
+	Fee           string `json:"fee"`
+	Memo          string `json:"memo,omitempty"`
+}
+
+// unsignedTx is the unsigned transaction of the construction API, hex encoded amino json
+type unsignedTx struct {
+	Tx            auth.StdTx `json:"tx"`
+	ChainID       string     `json:"chain_id"`
+	AccountNumber uint64     `json:"account_number"`
+	Sequence      uint64     `json:"sequence"`
+}
+
+func (u unsignedTx) signBytes() []byte {
+	return auth.StdSignBytes(u.ChainID, u.AccountNumber, u.Sequence, u.Tx.Fee, u.Tx.Msgs, u.Tx.Memo)
+}
+
+func (s *Server) constructionDerive(body []byte) (interface{}, error) {
+	var req struct {
+		networkRequest
+		PublicKey PublicKey `json:"public_key"`
+	}
+	if err := s.decode(body, &req, &req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	pubKey, err := parsePublicKey(req.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	addr := sdk.AccAddress(pubKey.Address()).String()
+	return map[string]interface{}{"address": addr, "account_identifier": AccountIdentifier{Address: addr}}, nil
+}
+
+func (s *Server) constructionPreprocess(body []byte) (interface{}, error) {
+	var req struct {
+		networkRequest
+		Operations []Operation `json:"operations"`
+		Metadata   struct {
+			Memo string `json:"memo"`
+		} `json:"metadata"`
+	}
+	if err := s.decode(body, &req, &req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	msg, err := msgFromOperations(req.Operations)
+	if err != nil {
+		return nil, ErrInvalidOperations.withDetails(err)
+	}
+	sender := AccountIdentifier{Address: msg.FromAddress.String()}
+	return map[string]interface{}{
+		"options":              map[string]string{"sender": sender.Address, "memo": req.Metadata.Memo},
+		"required_public_keys": []AccountIdentifier{sender},
+	}, nil
+}
+
+func (s *Server) constructionMetadata(body []byte) (interface{}, error) {
+	var req struct {
+		networkRequest
+		Options struct {
+			Sender string `json:"sender"`
+			Memo   string `json:"memo"`
+		} `json:"options"`
+	}
+	if err := s.decode(body, &req, &req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	sender, err := sdk.AccAddressFromBech32(req.Options.Sender)
+	if err != nil {
+		return nil, ErrInvalidRequest.withDetails(err)
+	}
+	acc, err := auth.NewAccountRetriever(s.cliCtx).GetAccount(sender)
+	if err != nil {
+		return nil, err
+	}
+	// the suggested fee pays the gas at the base fee of the fee market, there is none while it is disabled
+	gasPrice, err := feemarketcli.QueryAutoGasPrice(s.cliCtx)
+	if err != nil {
+		return nil, err
+	}
+	fee := sdk.NewCoins(sdk.NewCoin(gasPrice.Denom, gasPrice.Amount.MulInt64(int64(s.gas)).Ceil().TruncateInt()))
+	suggested := make([]Amount, len(fee))
+	for i, c := range fee {
+		suggested[i] = Amount{Value: c.Amount.String(), Currency: s.currency(c.Denom)}
+	}
+	return map[string]interface{}{
+		"metadata": txMetadata{
+			ChainID:       s.network.Network,
+			AccountNumber: acc.GetAccountNumber(),
+			Sequence:      acc.GetSequence(),
+			Gas:           s.gas,
+			Fee:           fee.String(),
+			Memo:          req.Options.Memo,
+		},
+		"suggested_fee": suggested,
+	}, nil
+}
+
+func (s *Server) constructionPayloads(body []byte) (interface{}, error) {
+	var req struct {
+		networkRequest
+		Operations []Operation `json:"operations"`
+		Metadata   txMetadata  `json:"metadata"`
+	}
+	if err := s.decode(body, &req, &req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	msg, err := msgFromOperations(req.Operations)
+	if err != nil {
+		return nil, ErrInvalidOperations.withDetails(err)
+	}
+	fee, err := sdk.ParseCoins(req.Metadata.Fee)
+	if err != nil {
+		return nil, ErrInvalidRequest.withDetails(err)
+	}
+	u := unsignedTx{
+		Tx:            auth.NewStdTx([]sdk.Msg{msg}, auth.NewStdFee(req.Metadata.Gas, fee), nil, req.Metadata.Memo),
+		ChainID:       req.Metadata.ChainID,
+		AccountNumber: req.Metadata.AccountNumber,
+		Sequence:      req.Metadata.Sequence,
+	}
+	bz, err := s.cdc.MarshalJSON(u)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(u.signBytes())
+	return map[string]interface{}{
+		"unsigned_transaction": hex.EncodeToString(bz),
+		"payloads": []SigningPayload{{
+			AccountIdentifier: &AccountIdentifier{Address: msg.FromAddress.String()},
+			HexBytes:          hex.EncodeToString(hash[:]),
+			SignatureType:     SignatureEcdsa,
+		}},
+	}, nil
+}
+
+func (s *Server) constructionCombine(body []byte) (interface{}, error) {
+	var req struct {
+		networkRequest
+		UnsignedTransaction string      `json:"unsigned_transaction"`
+		Signatures          []Signature `json:"signatures"`
+	}
+	if err := s.decode(body, &req, &req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	u, err := s.decodeUnsignedTx(req.UnsignedTransaction)
+	if err != nil {
+		return nil, err
+	}
+	signers := u.Tx.GetSigners()
+	if len(req.Signatures) != len(signers) {
+		return nil, ErrInvalidSignature.withDetails(fmt.Errorf("expected %d signatures", len(signers)))
+	}
+	signBytes := u.signBytes()
+	sigs := make([]auth.StdSignature, len(signers))
+	for i, sig := range req.Signatures {
+		pubKey, err := parsePublicKey(sig.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		sigBz, err := hex.DecodeString(sig.HexBytes)
+		if err != nil {
+			return nil, ErrInvalidSignature.withDetails(err)
+		}
+		if !sdk.AccAddress(pubKey.Address()).Equals(signers[i]) || !pubKey.VerifyBytes(signBytes, sigBz) {
+			return nil, ErrInvalidSignature.withDetails(fmt.Errorf("signature %d is not made by %s", i, signers[i]))
+		}
+		sigs[i] = auth.StdSignature{PubKey: pubKey, Signature: sigBz}
+	}
+	tx := u.Tx
+	tx.Signatures = sigs
+	bz, err := auth.DefaultTxEncoder(s.cdc)(tx)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"signed_transaction": hex.EncodeToString(bz)}, nil
+}
+
+func (s *Server) constructionParse(body []byte) (interface{}, error) {
+	var req struct {
+		networkRequest
+		Signed      bool   `json:"signed"`
+		Transaction string `json:"transaction"`
+	}
+	if err := s.decode(body, &req, &req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	var tx auth.StdTx
+	if req.Signed {
+		signed, err := s.decodeSignedTx(req.Transaction)
+		if err != nil {
+			return nil, err
+		}
+		tx = signed
+	} else {
+		u, err := s.decodeUnsignedTx(req.Transaction)
+		if err != nil {
+			return nil, err
+		}
+		tx = u.Tx
+	}
+	signers := []AccountIdentifier{}
+	if req.Signed {
+		for _, signer := range tx.GetSigners() {
+			signers = append(signers, AccountIdentifier{Address: signer.String()})
+		}
+	}
+	return map[string]interface{}{
+		"operations":                 msgOperations(tx.GetMsgs(), "", s.currency),
+		"account_identifier_signers": signers,
+	}, nil
+}
+
+func (s *Server) constructionHash(body []byte) (interface{}, error) {
+	var req struct {
+		networkRequest
+		SignedTransaction string `json:"signed_transaction"`
+	}
+	if err := s.decode(body, &req, &req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	bz, err := hex.DecodeString(req.SignedTransaction)
+	if err != nil {
+		return nil, ErrInvalidTransaction.withDetails(err)
+	}
+	return map[string]interface{}{"transaction_identifier": TransactionIdentifier{Hash: txHash(bz)}}, nil
+}
+
+func (s *Server) constructionSubmit(body []byte) (interface{}, error) {
+	var req struct {
+		networkRequest
+		SignedTransaction string `json:"signed_transaction"`
+	}
+	if err := s.decode(body, &req, &req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	bz, err := hex.DecodeString(req.SignedTransaction)
+	if err != nil {
+		return nil, ErrInvalidTransaction.withDetails(err)
+	}
+	res, err := s.cliCtx.BroadcastTxSync(bz)
+	if err != nil {
+		return nil, err
+	}
+	if res.Code != 0 {
+		return nil, ErrSubmitFailed.withDetails(fmt.Errorf("%s", res.RawLog))
+	}
+	return map[string]interface{}{"transaction_identifier": TransactionIdentifier{Hash: res.TxHash}}, nil
+}
+
+func (s *Server) decodeUnsignedTx(txHex string) (unsignedTx, error) {
+	var u unsignedTx
+	bz, err := hex.DecodeString(txHex)
+	if err != nil {
+		return u, ErrInvalidTransaction.withDetails(err)
+	}
+	if err := s.cdc.UnmarshalJSON(bz, &u); err != nil {
+		return u, ErrInvalidTransaction.withDetails(err)
+	}
+	return u, nil
+}
+
+func (s *Server) decodeSignedTx(txHex string) (auth.StdTx, error) {
+	bz, err := hex.DecodeString(txHex)
+	if err != nil {
+		return auth.StdTx{}, ErrInvalidTransaction.withDetails(err)
+	}
+	tx, err := s.txDecoder(bz)
+	if err != nil {
+		return auth.StdTx{}, ErrInvalidTransaction.withDetails(err)
+	}
+	stdTx, ok := tx.(auth.StdTx)
+	if !ok {
+		return auth.StdTx{}, ErrInvalidTransaction.withDetails(fmt.Errorf("no std tx"))
+	}
+	return stdTx, nil
+}
+
+// parsePublicKey returns the compressed secp256k1 key, the only kind of account key
+func parsePublicKey(key PublicKey) (secp256k1.PubKeySecp256k1, error) {
+	var pubKey secp256k1.PubKeySecp256k1
+	if key.CurveType != CurveSecp256k1 {
+		return pubKey, ErrInvalidPublicKey.withDetails(fmt.Errorf("unsupported curve %s", key.CurveType))
+	}
+	bz, err := hex.DecodeString(key.HexBytes)
+	if err != nil {
+		return pubKey, ErrInvalidPublicKey.withDetails(err)
+	}
+	if len(bz) != secp256k1.PubKeySecp256k1Size {
+		return pubKey, ErrInvalidPublicKey.withDetails(fmt.Errorf("expected %d bytes", secp256k1.PubKeySecp256k1Size))
+	}
+	copy(pubKey[:], bz)
+	return pubKey, nil
+}
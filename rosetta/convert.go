@@ -0,0 +1,121 @@
+package rosetta
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// currencyFunc returns the currency of a denom
+type currencyFunc func(denom string) Currency
+
+// transferOperations returns a debit of the sender and a credit of the recipient for every coin of the transfer
+// events. The bank module emits them for all balance changes between accounts, also those of the fee deduction and
+// of contracts, so they cover the balance changes of successful txs and of the begin and end blocker.
+func transferOperations(events []abci.Event, currency currencyFunc) []Operation {
+	var ops []Operation
+	for _, e := range events {
+		if e.Type != bank.EventTypeTransfer {
+			continue
+		}
+		var sender, recipient, amount string
+		for _, attr := range e.Attributes {
+			switch string(attr.Key) {
+			case bank.AttributeKeySender:
+				sender = string(attr.Value)
+			case bank.AttributeKeyRecipient:
+				recipient = string(attr.Value)
+			case sdk.AttributeKeyAmount:
+				amount = string(attr.Value)
+			}
+		}
+		coins, err := sdk.ParseCoins(amount)
+		if err != nil || sender == "" || recipient == "" {
+			continue
+		}
+		ops = append(ops, transferPair(int64(len(ops)), sender, recipient, coins, StatusSuccess, currency)...)
+	}
+	return ops
+}
+
+// msgOperations returns the transfers of the bank send msgs of a tx with the status, other msgs have none
+func msgOperations(msgs []sdk.Msg, status string, currency currencyFunc) []Operation {
+	var ops []Operation
+	for _, msg := range msgs {
+		if send, ok := msg.(bank.MsgSend); ok {
+			ops = append(ops, transferPair(int64(len(ops)), send.FromAddress.String(), send.ToAddress.String(), send.Amount, status, currency)...)
+		}
+	}
+	return ops
+}
+
+func transferPair(index int64, sender, recipient string, coins sdk.Coins, status string, currency currencyFunc) []Operation {
+	ops := make([]Operation, 0, 2*len(coins))
+	for _, c := range coins {
+		debit := OperationIdentifier{Index: index}
+		ops = append(ops,
+			Operation{
+				OperationIdentifier: debit,
+				Type:                OperationTransfer,
+				Status:              status,
+				Account:             &AccountIdentifier{Address: sender},
+				Amount:              &Amount{Value: c.Amount.Neg().String(), Currency: currency(c.Denom)},
+			},
+			Operation{
+				OperationIdentifier: OperationIdentifier{Index: index + 1},
+				RelatedOperations:   []OperationIdentifier{debit},
+				Type:                OperationTransfer,
+				Status:              status,
+				Account:             &AccountIdentifier{Address: recipient},
+				Amount:              &Amount{Value: c.Amount.String(), Currency: currency(c.Denom)},
+			},
+		)
+		index += 2
+	}
+	return ops
+}
+
+// msgFromOperations returns the bank send msg of the transfer operations of the construction API: debits of a
+// single sender and credits of the same amounts to a single recipient. The currency symbols are the denoms.
+func msgFromOperations(ops []Operation) (bank.MsgSend, error) {
+	var (
+		from, to      string
+		debit, credit sdk.Coins
+	)
+	for _, op := range ops {
+		if op.Type != OperationTransfer || op.Account == nil || op.Amount == nil {
+			return bank.MsgSend{}, fmt.Errorf("operation %d is no transfer", op.OperationIdentifier.Index)
+		}
+		amount, ok := sdk.NewIntFromString(op.Amount.Value)
+		if !ok || amount.IsZero() {
+			return bank.MsgSend{}, fmt.Errorf("invalid amount of operation %d: %s", op.OperationIdentifier.Index, op.Amount.Value)
+		}
+		if err := sdk.ValidateDenom(op.Amount.Currency.Symbol); err != nil {
+			return bank.MsgSend{}, err
+		}
+		addr, side := &to, &credit
+		if amount.IsNegative() {
+			addr, side, amount = &from, &debit, amount.Neg()
+		}
+		if *addr != "" && *addr != op.Account.Address {
+			return bank.MsgSend{}, fmt.Errorf("transfers must have a single sender and recipient")
+		}
+		*addr = op.Account.Address
+		*side = side.Add(sdk.NewCoin(op.Amount.Currency.Symbol, amount))
+	}
+	if from == "" || to == "" || debit.String() != credit.String() {
+		return bank.MsgSend{}, fmt.Errorf("debits and credits must match")
+	}
+	fromAddr, err := sdk.AccAddressFromBech32(from)
+	if err != nil {
+		return bank.MsgSend{}, err
+	}
+	toAddr, err := sdk.AccAddressFromBech32(to)
+	if err != nil {
+		return bank.MsgSend{}, err
+	}
+	msg := bank.NewMsgSend(fromAddr, toAddr, debit)
+	return msg, msg.ValidateBasic()
+}
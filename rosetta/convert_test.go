@@ -0,0 +1,101 @@
+package rosetta
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/kv"
+)
+
+var (
+	alice = sdk.AccAddress([]byte("alice_______________"))
+	bob   = sdk.AccAddress([]byte("bob_________________"))
+)
+
+func fetCurrency(denom string) Currency { return Currency{Symbol: denom, Decimals: 18} }
+
+func transferEvent(sender, recipient sdk.AccAddress, amount string) abci.Event {
+	return abci.Event{Type: bank.EventTypeTransfer, Attributes: []kv.Pair{
+		{Key: []byte(bank.AttributeKeyRecipient), Value: []byte(recipient.String())},
+		{Key: []byte(bank.AttributeKeySender), Value: []byte(sender.String())},
+		{Key: []byte(sdk.AttributeKeyAmount), Value: []byte(amount)},
+	}}
+}
+
+func TestTransferOperations(t *testing.T) {
+	events := []abci.Event{
+		transferEvent(alice, bob, "10afet,5ustake"),
+		{Type: sdk.EventTypeMessage, Attributes: []kv.Pair{{Key: []byte(sdk.AttributeKeySender), Value: []byte(alice.String())}}},
+		transferEvent(bob, alice, "3afet"),
+		// the transfer events of multi sends have no sender
+		{Type: bank.EventTypeTransfer, Attributes: []kv.Pair{{Key: []byte(sdk.AttributeKeyAmount), Value: []byte("1afet")}}},
+	}
+	ops := transferOperations(events, fetCurrency)
+	require.Len(t, ops, 6)
+	for i, op := range ops {
+		assert.Equal(t, int64(i), op.OperationIdentifier.Index)
+		assert.Equal(t, StatusSuccess, op.Status)
+	}
+	assert.Equal(t, Operation{
+		OperationIdentifier: OperationIdentifier{Index: 3},
+		RelatedOperations:   []OperationIdentifier{{Index: 2}},
+		Type:                OperationTransfer,
+		Status:              StatusSuccess,
+		Account:             &AccountIdentifier{Address: bob.String()},
+		Amount:              &Amount{Value: "5", Currency: Currency{Symbol: "ustake", Decimals: 18}},
+	}, ops[3])
+	assert.Equal(t, "-3", ops[4].Amount.Value)
+	assert.Equal(t, bob.String(), ops[4].Account.Address)
+}
+
+func TestMsgFromOperations(t *testing.T) {
+	send := bank.NewMsgSend(alice, bob, sdk.NewCoins(sdk.NewInt64Coin("afet", 10), sdk.NewInt64Coin("ustake", 5)))
+	ops := msgOperations([]sdk.Msg{send}, "", fetCurrency)
+	require.Len(t, ops, 4)
+
+	specs := map[string]struct {
+		mutate func([]Operation) []Operation
+		expErr bool
+	}{
+		"round trip": {mutate: func(ops []Operation) []Operation { return ops }},
+		"unbalanced": {mutate: func(ops []Operation) []Operation { return ops[:3] }, expErr: true},
+		"other sender": {
+			mutate: func(ops []Operation) []Operation {
+				ops[2].Account = &AccountIdentifier{Address: bob.String()}
+				return ops
+			},
+			expErr: true,
+		},
+		"zero amount": {
+			mutate: func(ops []Operation) []Operation {
+				ops[0].Amount = &Amount{Value: "0", Currency: fetCurrency("afet")}
+				return ops
+			},
+			expErr: true,
+		},
+		"no transfer": {
+			mutate: func(ops []Operation) []Operation {
+				ops[0].Type = "Stake"
+				return ops
+			},
+			expErr: true,
+		},
+		"no operations": {mutate: func([]Operation) []Operation { return nil }, expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			src := spec.mutate(msgOperations([]sdk.Msg{send}, "", fetCurrency))
+			got, err := msgFromOperations(src)
+			if spec.expErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, send, got)
+		})
+	}
+}
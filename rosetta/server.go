@@ -0,0 +1,359 @@
+package rosetta
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	denomcli "github.com/fetchai/fetchd/x/denom/client/cli"
+)
+
+// Server serves the Rosetta Data and Construction APIs of the chain of the node of the CLI context. The currencies
+// are the base denoms with the decimals of their display unit; the construction API builds bank sends only.
+type Server struct {
+	cdc       *codec.Codec
+	cliCtx    context.CLIContext
+	network   NetworkIdentifier
+	gas       uint64
+	txDecoder sdk.TxDecoder
+
+	mtx      sync.Mutex
+	decimals map[string]int32
+}
+
+// NewServer returns a Server of the chain id, the construction API sets the gas limit of the txs to gas
+func NewServer(cdc *codec.Codec, cliCtx context.CLIContext, chainID string, gas uint64) *Server {
+	return &Server{
+		cdc:       cdc,
+		cliCtx:    cliCtx,
+		network:   NetworkIdentifier{Blockchain: Blockchain, Network: chainID},
+		gas:       gas,
+		txDecoder: auth.DefaultTxDecoder(cdc),
+	}
+}
+
+// Handler returns the http handler of all endpoints
+func (s *Server) Handler() http.Handler {
+	endpoints := map[string]func([]byte) (interface{}, error){
+		"/network/list":            s.networkList,
+		"/network/options":         s.networkOptions,
+		"/network/status":          s.networkStatus,
+		"/account/balance":         s.accountBalance,
+		"/block":                   s.block,
+		"/block/transaction":       s.blockTransaction,
+		"/mempool":                 s.mempool,
+		"/mempool/transaction":     s.mempoolTransaction,
+		"/construction/derive":     s.constructionDerive,
+		"/construction/preprocess": s.constructionPreprocess,
+		"/construction/metadata":   s.constructionMetadata,
+		"/construction/payloads":   s.constructionPayloads,
+		"/construction/combine":    s.constructionCombine,
+		"/construction/parse":      s.constructionParse,
+		"/construction/hash":       s.constructionHash,
+		"/construction/submit":     s.constructionSubmit,
+	}
+	mux := http.NewServeMux()
+	for path, fn := range endpoints {
+		fn := fn
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost {
+				http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+				return
+			}
+			body, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				writeJSON(w, http.StatusInternalServerError, ErrInvalidRequest.withDetails(err))
+				return
+			}
+			res, err := fn(body)
+			if err != nil {
+				rosettaErr, ok := err.(Error)
+				if !ok {
+					rosettaErr = ErrNodeUnavailable.withDetails(err)
+				}
+				writeJSON(w, http.StatusInternalServerError, rosettaErr)
+				return
+			}
+			writeJSON(w, http.StatusOK, res)
+		})
+	}
+	return mux
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// networkRequest is embedded by all requests but /network/list
+type networkRequest struct {
+	NetworkIdentifier NetworkIdentifier `json:"network_identifier"`
+}
+
+// decode unmarshals the request and checks that it is for the network of the server
+func (s *Server) decode(body []byte, req interface{}, network *NetworkIdentifier) error {
+	if err := json.Unmarshal(body, req); err != nil {
+		return ErrInvalidRequest.withDetails(err)
+	}
+	if network != nil && *network != s.network {
+		return ErrInvalidNetwork.withDetails(fmt.Errorf("%s/%s", network.Blockchain, network.Network))
+	}
+	return nil
+}
+
+func (s *Server) networkList(body []byte) (interface{}, error) {
+	return map[string]interface{}{"network_identifiers": []NetworkIdentifier{s.network}}, nil
+}
+
+func (s *Server) networkOptions(body []byte) (interface{}, error) {
+	var req networkRequest
+	if err := s.decode(body, &req, &req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	status, err := s.cliCtx.GetNode().Status()
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"version": map[string]string{
+			"rosetta_version": Version,
+			"node_version":    status.NodeInfo.Version,
+		},
+		"allow": map[string]interface{}{
+			"operation_statuses": []map[string]interface{}{
+				{"status": StatusSuccess, "successful": true},
+				{"status": StatusFailure, "successful": false},
+			},
+			"operation_types":           []string{OperationTransfer},
+			"errors":                    allErrors,
+			"historical_balance_lookup": true,
+		},
+	}, nil
+}
+
+func (s *Server) networkStatus(body []byte) (interface{}, error) {
+	var req networkRequest
+	if err := s.decode(body, &req, &req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	node := s.cliCtx.GetNode()
+	status, err := node.Status()
+	if err != nil {
+		return nil, err
+	}
+	info := status.SyncInfo
+	oldest := BlockIdentifier{Index: info.EarliestBlockHeight, Hash: info.EarliestBlockHash.String()}
+	// pruned nodes do not have the genesis block anymore
+	genesis := oldest
+	height := int64(1)
+	if block, err := node.Block(&height); err == nil {
+		genesis = BlockIdentifier{Index: height, Hash: block.BlockID.Hash.String()}
+	}
+	netInfo, err := node.NetInfo()
+	if err != nil {
+		return nil, err
+	}
+	peers := make([]Peer, len(netInfo.Peers))
+	for i, p := range netInfo.Peers {
+		peers[i] = Peer{PeerID: string(p.NodeInfo.ID())}
+	}
+	return map[string]interface{}{
+		"current_block_identifier": BlockIdentifier{Index: info.LatestBlockHeight, Hash: info.LatestBlockHash.String()},
+		"current_block_timestamp":  info.LatestBlockTime.UnixNano() / 1e6,
+		"genesis_block_identifier": genesis,
+		"oldest_block_identifier":  oldest,
+		"peers":                    peers,
+	}, nil
+}
+
+func (s *Server) accountBalance(body []byte) (interface{}, error) {
+	var req struct {
+		networkRequest
+		AccountIdentifier AccountIdentifier       `json:"account_identifier"`
+		BlockIdentifier   *PartialBlockIdentifier `json:"block_identifier"`
+	}
+	if err := s.decode(body, &req, &req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	addr, err := sdk.AccAddressFromBech32(req.AccountIdentifier.Address)
+	if err != nil {
+		return nil, ErrInvalidRequest.withDetails(err)
+	}
+	cliCtx := s.cliCtx
+	if req.BlockIdentifier != nil {
+		if req.BlockIdentifier.Index == nil {
+			return nil, ErrUnsupported.withDetails(fmt.Errorf("balance lookups by block hash"))
+		}
+		cliCtx = cliCtx.WithHeight(*req.BlockIdentifier.Index)
+	}
+	bz, err := s.cdc.MarshalJSON(bank.NewQueryBalanceParams(addr))
+	if err != nil {
+		return nil, err
+	}
+	res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", bank.QuerierRoute, bank.QueryBalance), bz)
+	if err != nil {
+		return nil, err
+	}
+	var coins sdk.Coins
+	if err := s.cdc.UnmarshalJSON(res, &coins); err != nil {
+		return nil, err
+	}
+	block, err := s.cliCtx.GetNode().Block(&height)
+	if err != nil {
+		return nil, err
+	}
+	balances := make([]Amount, len(coins))
+	for i, c := range coins {
+		balances[i] = Amount{Value: c.Amount.String(), Currency: s.currency(c.Denom)}
+	}
+	return map[string]interface{}{
+		"block_identifier": BlockIdentifier{Index: height, Hash: block.BlockID.Hash.String()},
+		"balances":         balances,
+	}, nil
+}
+
+func (s *Server) block(body []byte) (interface{}, error) {
+	var req struct {
+		networkRequest
+		BlockIdentifier PartialBlockIdentifier `json:"block_identifier"`
+	}
+	if err := s.decode(body, &req, &req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	block, err := s.getBlock(req.BlockIdentifier)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"block": block}, nil
+}
+
+func (s *Server) blockTransaction(body []byte) (interface{}, error) {
+	var req struct {
+		networkRequest
+		BlockIdentifier       BlockIdentifier       `json:"block_identifier"`
+		TransactionIdentifier TransactionIdentifier `json:"transaction_identifier"`
+	}
+	if err := s.decode(body, &req, &req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	index := req.BlockIdentifier.Index
+	block, err := s.getBlock(PartialBlockIdentifier{Index: &index})
+	if err != nil {
+		return nil, err
+	}
+	for _, tx := range block.Transactions {
+		if tx.TransactionIdentifier == req.TransactionIdentifier {
+			return map[string]interface{}{"transaction": tx}, nil
+		}
+	}
+	return nil, ErrInvalidTransaction.withDetails(fmt.Errorf("%s not in block %d", req.TransactionIdentifier.Hash, index))
+}
+
+// getBlock returns the block with its txs and the balance changes of its begin and end blocker as txs of the block
+// hash with a /begin_block and /end_block suffix. Tendermint v0.33 has no lookup of blocks by hash.
+func (s *Server) getBlock(id PartialBlockIdentifier) (*Block, error) {
+	if id.Index == nil && id.Hash != nil {
+		return nil, ErrUnsupported.withDetails(fmt.Errorf("block lookups by hash"))
+	}
+	node := s.cliCtx.GetNode()
+	res, err := node.Block(id.Index)
+	if err != nil {
+		return nil, ErrBlockNotFound.withDetails(err)
+	}
+	height := res.Block.Height
+	results, err := node.BlockResults(&height)
+	if err != nil {
+		return nil, err
+	}
+	block := &Block{
+		BlockIdentifier:       BlockIdentifier{Index: height, Hash: res.BlockID.Hash.String()},
+		ParentBlockIdentifier: BlockIdentifier{Index: height - 1, Hash: res.Block.LastBlockID.Hash.String()},
+		Timestamp:             res.Block.Time.UnixNano() / 1e6,
+		Transactions:          []Transaction{},
+	}
+	if height == 1 {
+		block.ParentBlockIdentifier = block.BlockIdentifier
+	}
+	if ops := transferOperations(results.BeginBlockEvents, s.currency); len(ops) != 0 {
+		block.Transactions = append(block.Transactions, blockTx(block.BlockIdentifier.Hash+"/begin_block", ops))
+	}
+	for i, txBz := range res.Block.Txs {
+		result := results.TxsResults[i]
+		var ops []Operation
+		if result.IsOK() {
+			ops = transferOperations(result.Events, s.currency)
+		} else if tx, err := s.txDecoder(txBz); err == nil {
+			// the msgs of failed txs did not change any balance
+			ops = msgOperations(tx.GetMsgs(), StatusFailure, s.currency)
+		}
+		block.Transactions = append(block.Transactions, blockTx(txHash(txBz), ops))
+	}
+	if ops := transferOperations(results.EndBlockEvents, s.currency); len(ops) != 0 {
+		block.Transactions = append(block.Transactions, blockTx(block.BlockIdentifier.Hash+"/end_block", ops))
+	}
+	return block, nil
+}
+
+func blockTx(hash string, ops []Operation) Transaction {
+	if ops == nil {
+		ops = []Operation{}
+	}
+	return Transaction{TransactionIdentifier: TransactionIdentifier{Hash: hash}, Operations: ops}
+}
+
+func txHash(txBz []byte) string {
+	return strings.ToUpper(hex.EncodeToString(tmtypes.Tx(txBz).Hash()))
+}
+
+func (s *Server) mempool(body []byte) (interface{}, error) {
+	var req networkRequest
+	if err := s.decode(body, &req, &req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	limit := 100
+	res, err := s.cliCtx.GetNode().UnconfirmedTxs(limit)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]TransactionIdentifier, len(res.Txs))
+	for i, tx := range res.Txs {
+		ids[i] = TransactionIdentifier{Hash: txHash(tx)}
+	}
+	return map[string]interface{}{"transaction_identifiers": ids}, nil
+}
+
+func (s *Server) mempoolTransaction(body []byte) (interface{}, error) {
+	return nil, ErrUnsupported.withDetails(fmt.Errorf("mempool transaction lookups"))
+}
+
+// currency returns the currency of the denom with the exponent of the display unit of its metadata as decimals,
+// 0 for denoms without metadata
+func (s *Server) currency(denom string) Currency {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.decimals == nil {
+		metadata, err := denomcli.QueryAllDenomMetadata(s.cliCtx)
+		if err != nil {
+			return Currency{Symbol: denom}
+		}
+		s.decimals = make(map[string]int32, len(metadata))
+		for _, m := range metadata {
+			if unit, ok := m.Unit(m.Display); ok {
+				s.decimals[m.Base] = int32(unit.Exponent)
+			}
+		}
+	}
+	return Currency{Symbol: denom, Decimals: s.decimals[denom]}
+}
@@ -0,0 +1,145 @@
+package rosetta
+
+// The types of the Rosetta API (https://www.rosetta-api.org/docs/api_reference.html) served by the Server, only
+// the fields it uses
+
+// Version is the Rosetta API version implemented by the Server
+const Version = "1.4.10"
+
+// Blockchain is the name of the blockchain in the network identifiers
+const Blockchain = "fetchai"
+
+// Operation types and statuses
+const (
+	OperationTransfer = "Transfer"
+
+	StatusSuccess = "Success"
+	StatusFailure = "Failure"
+)
+
+// CurveType and SignatureType of the account keys
+const (
+	CurveSecp256k1 = "secp256k1"
+	SignatureEcdsa = "ecdsa"
+)
+
+type NetworkIdentifier struct {
+	Blockchain string `json:"blockchain"`
+	Network    string `json:"network"`
+}
+
+type BlockIdentifier struct {
+	Index int64  `json:"index"`
+	Hash  string `json:"hash"`
+}
+
+type PartialBlockIdentifier struct {
+	Index *int64  `json:"index,omitempty"`
+	Hash  *string `json:"hash,omitempty"`
+}
+
+type AccountIdentifier struct {
+	Address string `json:"address"`
+}
+
+type Currency struct {
+	Symbol   string `json:"symbol"`
+	Decimals int32  `json:"decimals"`
+}
+
+type Amount struct {
+	Value    string   `json:"value"`
+	Currency Currency `json:"currency"`
+}
+
+type OperationIdentifier struct {
+	Index int64 `json:"index"`
+}
+
+type Operation struct {
+	OperationIdentifier OperationIdentifier   `json:"operation_identifier"`
+	RelatedOperations   []OperationIdentifier `json:"related_operations,omitempty"`
+	Type                string                `json:"type"`
+	Status              string                `json:"status,omitempty"`
+	Account             *AccountIdentifier    `json:"account,omitempty"`
+	Amount              *Amount               `json:"amount,omitempty"`
+}
+
+type TransactionIdentifier struct {
+	Hash string `json:"hash"`
+}
+
+type Transaction struct {
+	TransactionIdentifier TransactionIdentifier `json:"transaction_identifier"`
+	Operations            []Operation           `json:"operations"`
+}
+
+type Block struct {
+	BlockIdentifier       BlockIdentifier `json:"block_identifier"`
+	ParentBlockIdentifier BlockIdentifier `json:"parent_block_identifier"`
+	// Timestamp is in milliseconds since the unix epoch
+	Timestamp    int64         `json:"timestamp"`
+	Transactions []Transaction `json:"transactions"`
+}
+
+type Peer struct {
+	PeerID string `json:"peer_id"`
+}
+
+type PublicKey struct {
+	HexBytes  string `json:"hex_bytes"`
+	CurveType string `json:"curve_type"`
+}
+
+type SigningPayload struct {
+	AccountIdentifier *AccountIdentifier `json:"account_identifier,omitempty"`
+	HexBytes          string             `json:"hex_bytes"`
+	SignatureType     string             `json:"signature_type,omitempty"`
+}
+
+type Signature struct {
+	SigningPayload SigningPayload `json:"signing_payload"`
+	PublicKey      PublicKey      `json:"public_key"`
+	SignatureType  string         `json:"signature_type"`
+	HexBytes       string         `json:"hex_bytes"`
+}
+
+// Error is returned with status 500 by all endpoints
+type Error struct {
+	Code      int32  `json:"code"`
+	Message   string `json:"message"`
+	Retriable bool   `json:"retriable"`
+	Details   string `json:"details,omitempty"`
+}
+
+func (e Error) Error() string {
+	if e.Details == "" {
+		return e.Message
+	}
+	return e.Message + ": " + e.Details
+}
+
+// withDetails returns a copy of the error with the cause as details
+func (e Error) withDetails(cause error) Error {
+	e.Details = cause.Error()
+	return e
+}
+
+// Errors are all errors the Server returns, listed by /network/options
+var (
+	ErrInvalidRequest     = Error{Code: 1, Message: "invalid request"}
+	ErrInvalidNetwork     = Error{Code: 2, Message: "unknown network"}
+	ErrNodeUnavailable    = Error{Code: 3, Message: "node unavailable", Retriable: true}
+	ErrBlockNotFound      = Error{Code: 4, Message: "block not found"}
+	ErrInvalidOperations  = Error{Code: 5, Message: "invalid operations"}
+	ErrInvalidTransaction = Error{Code: 6, Message: "invalid transaction"}
+	ErrInvalidPublicKey   = Error{Code: 7, Message: "invalid public key"}
+	ErrInvalidSignature   = Error{Code: 8, Message: "invalid signature"}
+	ErrSubmitFailed       = Error{Code: 9, Message: "transaction rejected"}
+	ErrUnsupported        = Error{Code: 10, Message: "unsupported"}
+)
+
+var allErrors = []Error{
+	ErrInvalidRequest, ErrInvalidNetwork, ErrNodeUnavailable, ErrBlockNotFound, ErrInvalidOperations,
+	ErrInvalidTransaction, ErrInvalidPublicKey, ErrInvalidSignature, ErrSubmitFailed, ErrUnsupported,
+}
@@ -0,0 +1,92 @@
+// Package testutil sets up the in-memory chain state and the mocked keepers the keeper tests of the modules share.
+package testutil
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// NewContext returns a context with the header on a fresh in-memory multi store with the stores of the keys and the
+// params stores mounted, and the params keeper on them. Transient store keys are mounted as transient stores.
+func NewContext(t *testing.T, cdc *codec.Codec, header abci.Header, keys ...sdk.StoreKey) (sdk.Context, params.Keeper) {
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	for _, key := range append(keys, keyParams, tkeyParams) {
+		if _, ok := key.(*sdk.TransientStoreKey); ok {
+			ms.MountStoreWithDB(key, sdk.StoreTypeTransient, db)
+		} else {
+			ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+		}
+	}
+	require.NoError(t, ms.LoadLatestVersion())
+	ctx := sdk.NewContext(ms, header, false, log.NewNopLogger())
+	return ctx, params.NewKeeper(cdc, keyParams, tkeyParams)
+}
+
+// SupplyKeeper moves, mints and burns coins of accounts and module accounts. The balances are keyed by the address
+// string of the accounts, module accounts by ModuleKey.
+type SupplyKeeper struct {
+	Balances map[string]sdk.Coins
+	// Burned are the coins burned by all BurnCoins calls
+	Burned sdk.Coins
+}
+
+// NewSupplyKeeper returns a SupplyKeeper with the balances
+func NewSupplyKeeper(balances map[string]sdk.Coins) *SupplyKeeper {
+	return &SupplyKeeper{Balances: balances}
+}
+
+// ModuleKey returns the key of the balance of the module account with the name
+func ModuleKey(name string) string {
+	return supply.NewModuleAddress(name).String()
+}
+
+func (m *SupplyKeeper) GetModuleAddress(name string) sdk.AccAddress {
+	return supply.NewModuleAddress(name)
+}
+
+func (m *SupplyKeeper) SendCoinsFromAccountToModule(_ sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return m.Send(senderAddr.String(), ModuleKey(recipientModule), amt)
+}
+
+func (m *SupplyKeeper) SendCoinsFromModuleToAccount(_ sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return m.Send(ModuleKey(senderModule), recipientAddr.String(), amt)
+}
+
+func (m *SupplyKeeper) MintCoins(_ sdk.Context, name string, amt sdk.Coins) error {
+	m.Balances[ModuleKey(name)] = m.Balances[ModuleKey(name)].Add(amt...)
+	return nil
+}
+
+func (m *SupplyKeeper) BurnCoins(_ sdk.Context, name string, amt sdk.Coins) error {
+	balance, hasNeg := m.Balances[ModuleKey(name)].SafeSub(amt)
+	if hasNeg {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	m.Balances[ModuleKey(name)] = balance
+	m.Burned = m.Burned.Add(amt...)
+	return nil
+}
+
+// Send moves the amount between the balances of the keys, for the mocks of keepers that move coins as well
+func (m *SupplyKeeper) Send(from, to string, amt sdk.Coins) error {
+	balance, hasNeg := m.Balances[from].SafeSub(amt)
+	if hasNeg {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	m.Balances[from] = balance
+	m.Balances[to] = m.Balances[to].Add(amt...)
+	return nil
+}
@@ -0,0 +1,57 @@
+package airdrop
+
+import (
+	"github.com/fetchai/fetchd/x/airdrop/internal/keeper"
+	"github.com/fetchai/fetchd/x/airdrop/internal/types"
+)
+
+const (
+	ModuleName                  = types.ModuleName
+	StoreKey                    = types.StoreKey
+	QuerierRoute                = types.QuerierRoute
+	RouterKey                   = types.RouterKey
+	ProposalTypeRegisterAirdrop = types.ProposalTypeRegisterAirdrop
+	MaxProofLength              = types.MaxProofLength
+	AttributeKeyAirdropID       = types.AttributeKeyAirdropID
+	AttributeKeyRecipient       = types.AttributeKeyRecipient
+	AttributeKeyEndHeight       = types.AttributeKeyEndHeight
+	AttributeKeyClawback        = types.AttributeKeyClawback
+	EventTypeRegister           = types.EventTypeRegister
+	EventTypeClaim              = types.EventTypeClaim
+	EventTypeEnd                = types.EventTypeEnd
+	QueryAirdrops               = keeper.QueryAirdrops
+	QueryAirdrop                = keeper.QueryAirdrop
+	QueryClaimed                = keeper.QueryClaimed
+)
+
+var (
+	// functions aliases
+	RegisterCodec       = types.RegisterCodec
+	ValidateGenesis     = types.ValidateGenesis
+	DefaultGenesisState = types.DefaultGenesisState
+	LeafHash            = types.LeafHash
+	VerifyProof         = types.VerifyProof
+	BuildTree           = types.BuildTree
+	NewKeeper           = keeper.NewKeeper
+	NewQuerier          = keeper.NewQuerier
+	NewProposalHandler  = keeper.NewProposalHandler
+	InitGenesis         = keeper.InitGenesis
+	ExportGenesis       = keeper.ExportGenesis
+
+	// variable aliases
+	ModuleCdc          = types.ModuleCdc
+	ErrAirdropNotFound = types.ErrAirdropNotFound
+	ErrInvalidProof    = types.ErrInvalidProof
+	ErrAlreadyClaimed  = types.ErrAlreadyClaimed
+	ErrEnded           = types.ErrEnded
+	ErrInvalidAirdrop  = types.ErrInvalidAirdrop
+)
+
+type (
+	Keeper                  = keeper.Keeper
+	GenesisState            = types.GenesisState
+	Airdrop                 = types.Airdrop
+	Claim                   = types.Claim
+	RegisterAirdropProposal = types.RegisterAirdropProposal
+	MsgClaimAirdrop         = types.MsgClaimAirdrop
+)
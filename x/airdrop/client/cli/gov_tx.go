@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"bufio"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/fetchai/fetchd/x/airdrop/internal/types"
+	wasmUtils "github.com/fetchai/fetchd/x/wasm/client/utils"
+)
+
+// ProposalRegisterAirdropCmd submits a proposal to register an airdrop
+func ProposalRegisterAirdropCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-airdrop [merkle_root] [amount] [claim_blocks]",
+		Short: "Submit a proposal to register an airdrop funded from the community pool",
+		Long: `Submit a proposal to register an airdrop of the hex merkle root and total amount printed by "tx airdrop
+build-tree". When the proposal passes, the amount is moved from the community pool to the airdrop, and the recipients
+can claim for the claim blocks. The unclaimed amount is returned to the community pool after that.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			root, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("merkle root: %s", err)
+			}
+			amount, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+			claimBlocks, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("claim blocks: %s", err)
+			}
+
+			content := types.RegisterAirdropProposal{
+				Title:       viper.GetString(cli.FlagTitle),
+				Description: viper.GetString(cli.FlagDescription),
+				MerkleRoot:  root,
+				Amount:      amount,
+				ClaimBlocks: claimBlocks,
+			}
+
+			deposit, err := sdk.ParseCoins(viper.GetString(cli.FlagDeposit))
+			if err != nil {
+				return err
+			}
+
+			msg := govtypes.NewMsgSubmitProposal(content, deposit, cliCtx.GetFromAddress())
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	// proposal flags
+	cmd.Flags().String(cli.FlagTitle, "", "Title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "Description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "", "Deposit of proposal")
+	wasmUtils.AddBroadcastFlags(cmd)
+	return cmd
+}
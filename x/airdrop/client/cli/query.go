@@ -0,0 +1,114 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/fetchai/fetchd/x/airdrop/internal/keeper"
+	"github.com/fetchai/fetchd/x/airdrop/internal/types"
+)
+
+// GetQueryCmd returns the query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the airdrops",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(flags.GetCommands(
+		GetCmdQueryAirdrop(cdc),
+		GetCmdQueryAirdrops(cdc),
+		GetCmdQueryClaimed(cdc),
+	)...)
+	return queryCmd
+}
+
+// GetCmdQueryAirdrop shows an airdrop
+func GetCmdQueryAirdrop(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "airdrop [airdrop_id]",
+		Short: "Show the merkle root, the amount, the claimed amount and the end height of an airdrop",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("airdrop id: %s", err)
+			}
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%d", types.QuerierRoute, keeper.QueryAirdrop, id))
+			if err != nil {
+				return err
+			}
+			var airdrop *types.Airdrop
+			if err := json.Unmarshal(res, &airdrop); err != nil {
+				return err
+			}
+			if airdrop == nil {
+				return sdkerrors.Wrapf(types.ErrAirdropNotFound, "%d", id)
+			}
+			return cliCtx.PrintOutput(airdrop)
+		},
+	}
+}
+
+// GetCmdQueryAirdrops lists the airdrops
+func GetCmdQueryAirdrops(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "airdrops",
+		Short: "List the open and ended airdrops",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, keeper.QueryAirdrops))
+			if err != nil {
+				return err
+			}
+			var airdrops []types.Airdrop
+			if err := json.Unmarshal(res, &airdrops); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(airdrops)
+		},
+	}
+}
+
+// GetCmdQueryClaimed shows whether an account claimed its amount of an airdrop
+func GetCmdQueryClaimed(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "claimed [airdrop_id] [address]",
+		Short: "Show whether the account claimed its amount of an open airdrop",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("airdrop id: %s", err)
+			}
+			addr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%d/%s", types.QuerierRoute, keeper.QueryClaimed, id, addr))
+			if err != nil {
+				return err
+			}
+			var claimed bool
+			if err := json.Unmarshal(res, &claimed); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(claimed)
+		},
+	}
+}
@@ -0,0 +1,164 @@
+package cli
+
+import (
+	"bufio"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	"github.com/fetchai/fetchd/x/airdrop/internal/types"
+	wasmUtils "github.com/fetchai/fetchd/x/wasm/client/utils"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Airdrop transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	txCmd.AddCommand(wasmUtils.AddBroadcastFlags(flags.PostCommands(
+		ClaimAirdropCmd(cdc),
+	)...)...)
+	txCmd.AddCommand(BuildTreeCmd())
+	return txCmd
+}
+
+// ClaimAirdropCmd claims the amount of the --from account in an airdrop
+func ClaimAirdropCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "claim [airdrop_id] [amount] [proof]",
+		Short: "Claim the amount of the --from account in an airdrop with its merkle proof",
+		Long: `Claim the amount of the --from account in an open airdrop. The proof is the comma separated list of hex
+hashes of the account printed by "build-tree", empty for an airdrop of a single recipient.`,
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("airdrop id: %s", err)
+			}
+			amount, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+			var proof []tmbytes.HexBytes
+			if len(args) == 3 && args[2] != "" {
+				for _, s := range strings.Split(args[2], ",") {
+					hash, err := hex.DecodeString(s)
+					if err != nil {
+						return fmt.Errorf("proof: %s", err)
+					}
+					proof = append(proof, hash)
+				}
+			}
+			msg := types.MsgClaimAirdrop{Sender: cliCtx.GetFromAddress(), AirdropID: id, Amount: amount, Proof: proof}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// recipient is an entry of the recipients file of build-tree
+type recipient struct {
+	Address string `json:"address"`
+	Amount  string `json:"amount"`
+}
+
+// recipientProof is the claim of a recipient printed by build-tree
+type recipientProof struct {
+	Address sdk.AccAddress     `json:"address"`
+	Amount  sdk.Coins          `json:"amount"`
+	Proof   []tmbytes.HexBytes `json:"proof"`
+}
+
+// tree is the merkle tree printed by build-tree
+type tree struct {
+	MerkleRoot tmbytes.HexBytes `json:"merkle_root"`
+	Total      sdk.Coins        `json:"total"`
+	Recipients []recipientProof `json:"recipients"`
+}
+
+// BuildTreeCmd builds the merkle tree of an airdrop offline
+func BuildTreeCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "build-tree [recipients_file]",
+		Short: "Build the merkle root and the proofs of an airdrop to the recipients of a json file, no tx is sent",
+		Long: `Build the merkle tree of an airdrop to the recipients of the json file, e.g.
+[{"address":"fetch1...","amount":"100afet"},{"address":"fetch1...","amount":"50afet"}]. Each address must be listed
+once. The printed merkle root and total are the arguments of the "register-airdrop" proposal, the proof of each
+recipient is the argument of its "claim" tx.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var recipients []recipient
+			if err := json.Unmarshal(bz, &recipients); err != nil {
+				return err
+			}
+			if len(recipients) == 0 {
+				return fmt.Errorf("no recipients")
+			}
+			out := tree{Total: sdk.NewCoins(), Recipients: make([]recipientProof, len(recipients))}
+			leaves := make([][]byte, len(recipients))
+			seen := make(map[string]bool, len(recipients))
+			for i, r := range recipients {
+				addr, err := sdk.AccAddressFromBech32(r.Address)
+				if err != nil {
+					return fmt.Errorf("recipient %d: %s", i, err)
+				}
+				if seen[addr.String()] {
+					return fmt.Errorf("duplicate recipient %s", addr)
+				}
+				seen[addr.String()] = true
+				amount, err := sdk.ParseCoins(r.Amount)
+				if err != nil {
+					return fmt.Errorf("amount of %s: %s", addr, err)
+				}
+				if amount.Empty() {
+					return fmt.Errorf("empty amount of %s", addr)
+				}
+				leaves[i] = types.LeafHash(addr, amount)
+				out.Total = out.Total.Add(amount...)
+				out.Recipients[i] = recipientProof{Address: addr, Amount: amount}
+			}
+			root, proofs := types.BuildTree(leaves)
+			out.MerkleRoot = root
+			for i, proof := range proofs {
+				out.Recipients[i].Proof = make([]tmbytes.HexBytes, len(proof))
+				for j, p := range proof {
+					out.Recipients[i].Proof[j] = p
+				}
+			}
+			bz, err = json.MarshalIndent(out, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+			return nil
+		},
+	}
+}
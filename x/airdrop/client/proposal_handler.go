@@ -0,0 +1,12 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	"github.com/fetchai/fetchd/x/airdrop/client/cli"
+	"github.com/fetchai/fetchd/x/airdrop/client/rest"
+)
+
+// ProposalHandlers define the airdrop cli proposal types and rest handler.
+var ProposalHandlers = []govclient.ProposalHandler{
+	govclient.NewProposalHandler(cli.ProposalRegisterAirdropCmd, rest.RegisterAirdropProposalHandler),
+}
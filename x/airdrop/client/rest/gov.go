@@ -0,0 +1,58 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	"github.com/fetchai/fetchd/x/airdrop/internal/types"
+)
+
+type RegisterAirdropJsonReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+
+	Proposer sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit  sdk.Coins      `json:"deposit" yaml:"deposit"`
+
+	MerkleRoot  tmbytes.HexBytes `json:"merkle_root" yaml:"merkle_root"`
+	Amount      sdk.Coins        `json:"amount" yaml:"amount"`
+	ClaimBlocks int64            `json:"claim_blocks" yaml:"claim_blocks"`
+}
+
+func RegisterAirdropProposalHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "register_airdrop",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req RegisterAirdropJsonReq
+			if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+				return
+			}
+			content := types.RegisterAirdropProposal{
+				Title:       req.Title,
+				Description: req.Description,
+				MerkleRoot:  req.MerkleRoot,
+				Amount:      req.Amount,
+				ClaimBlocks: req.ClaimBlocks,
+			}
+			msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+			if err := msg.ValidateBasic(); err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			baseReq := req.BaseReq.Sanitize()
+			if !baseReq.ValidateBasic(w) {
+				return
+			}
+			utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+		},
+	}
+}
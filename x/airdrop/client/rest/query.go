@@ -0,0 +1,71 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/fetchai/fetchd/x/airdrop/internal/keeper"
+	"github.com/fetchai/fetchd/x/airdrop/internal/types"
+)
+
+func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/airdrop/airdrops", queryAirdropsHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/airdrop/airdrops/{id}", queryAirdropHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/airdrop/airdrops/{id}/claimed/{address}", queryClaimedHandlerFn(cliCtx)).Methods("GET")
+}
+
+func queryAirdropsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query(w, r, cliCtx, keeper.QueryAirdrops)
+	}
+}
+
+func queryAirdropHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		query(w, r, cliCtx, fmt.Sprintf("%s/%d", keeper.QueryAirdrop, id))
+	}
+}
+
+func queryClaimedHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		addr, err := sdk.AccAddressFromBech32(mux.Vars(r)["address"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		query(w, r, cliCtx, fmt.Sprintf("%s/%d/%s", keeper.QueryClaimed, id, addr))
+	}
+}
+
+func query(w http.ResponseWriter, r *http.Request, cliCtx context.CLIContext, path string) {
+	cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+	if !ok {
+		return
+	}
+
+	res, height, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, path))
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	cliCtx = cliCtx.WithHeight(height)
+	rest.PostProcessResponse(w, cliCtx, json.RawMessage(res))
+}
@@ -0,0 +1,44 @@
+package airdrop
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewHandler returns a handler for "airdrop" type messages.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case MsgClaimAirdrop:
+			return handleClaimAirdrop(ctx, k, &msg)
+		default:
+			errMsg := fmt.Sprintf("unrecognized airdrop message type: %T", msg)
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+		}
+	}
+}
+
+func handleClaimAirdrop(ctx sdk.Context, k Keeper, msg *MsgClaimAirdrop) (*sdk.Result, error) {
+	proof := make([][]byte, len(msg.Proof))
+	for i, p := range msg.Proof {
+		proof[i] = p
+	}
+	if err := k.Claim(ctx, msg.Sender, msg.AirdropID, msg.Amount, proof); err != nil {
+		return nil, err
+	}
+	events := ctx.EventManager().Events()
+	ourEvent := sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		sdk.NewAttribute(AttributeKeyAirdropID, strconv.FormatUint(msg.AirdropID, 10)),
+	)
+	return &sdk.Result{
+		Events: append(events, ourEvent),
+	}, nil
+}
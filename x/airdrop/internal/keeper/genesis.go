@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fetchai/fetchd/x/airdrop/internal/types"
+)
+
+// InitGenesis stores the last airdrop id, the airdrops and the claims of the genesis. The open airdrops are queued to
+// end.
+func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
+	keeper.setLastAirdropID(ctx, data.LastAirdropID)
+	store := ctx.KVStore(keeper.storeKey)
+	for _, a := range data.Airdrops {
+		keeper.setAirdrop(ctx, a)
+		if !a.Ended {
+			store.Set(types.GetEndQueueKey(a.EndHeight, a.ID), []byte{})
+		}
+	}
+	for _, c := range data.Claims {
+		store.Set(types.GetClaimKey(c.AirdropID, c.Recipient), []byte{})
+	}
+}
+
+// ExportGenesis returns the last airdrop id, the airdrops and the claims of the open airdrops as genesis state
+func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
+	data := types.GenesisState{LastAirdropID: keeper.GetLastAirdropID(ctx)}
+	keeper.IterateAirdrops(ctx, func(a types.Airdrop) bool {
+		data.Airdrops = append(data.Airdrops, a)
+		return false
+	})
+	keeper.IterateClaims(ctx, func(c types.Claim) bool {
+		data.Claims = append(data.Claims, c)
+		return false
+	})
+	return data
+}
@@ -0,0 +1,204 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/fetchai/fetchd/x/airdrop/internal/types"
+)
+
+// Keeper keeps the airdrops and the recipients that claimed them. The module account holds the unclaimed amounts of
+// the open airdrops.
+type Keeper struct {
+	storeKey     sdk.StoreKey
+	cdc          *codec.Codec
+	supplyKeeper types.SupplyKeeper
+	distrKeeper  types.DistributionKeeper
+}
+
+// NewKeeper creates a new airdrop Keeper instance
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, supplyKeeper types.SupplyKeeper, distrKeeper types.DistributionKeeper) Keeper {
+	return Keeper{
+		storeKey:     storeKey,
+		cdc:          cdc,
+		supplyKeeper: supplyKeeper,
+		distrKeeper:  distrKeeper,
+	}
+}
+
+// Register registers an airdrop of the merkle root, funded with the amount from the community pool, that can be
+// claimed for claim blocks
+func (k Keeper) Register(ctx sdk.Context, root []byte, amount sdk.Coins, claimBlocks int64) (types.Airdrop, error) {
+	if err := k.distrKeeper.DistributeFromFeePool(ctx, amount, k.supplyKeeper.GetModuleAddress(types.ModuleName)); err != nil {
+		return types.Airdrop{}, err
+	}
+	airdrop := types.Airdrop{
+		ID:         k.GetLastAirdropID(ctx) + 1,
+		MerkleRoot: root,
+		Amount:     amount,
+		Claimed:    sdk.NewCoins(),
+		EndHeight:  ctx.BlockHeight() + claimBlocks,
+	}
+	k.setLastAirdropID(ctx, airdrop.ID)
+	k.setAirdrop(ctx, airdrop)
+	ctx.KVStore(k.storeKey).Set(types.GetEndQueueKey(airdrop.EndHeight, airdrop.ID), []byte{})
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRegister,
+		sdk.NewAttribute(types.AttributeKeyAirdropID, strconv.FormatUint(airdrop.ID, 10)),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		sdk.NewAttribute(types.AttributeKeyEndHeight, strconv.FormatInt(airdrop.EndHeight, 10)),
+	))
+	return airdrop, nil
+}
+
+// Claim pays the amount of the leaf of the recipient in the airdrop to the recipient. The leaf must be in the tree of
+// the merkle root and each recipient claims once.
+func (k Keeper) Claim(ctx sdk.Context, recipient sdk.AccAddress, id uint64, amount sdk.Coins, proof [][]byte) error {
+	airdrop := k.GetAirdrop(ctx, id)
+	if airdrop == nil {
+		return sdkerrors.Wrapf(types.ErrAirdropNotFound, "%d", id)
+	}
+	if airdrop.Ended || ctx.BlockHeight() >= airdrop.EndHeight {
+		return sdkerrors.Wrapf(types.ErrEnded, "at height %d", airdrop.EndHeight)
+	}
+	if k.HasClaimed(ctx, id, recipient) {
+		return sdkerrors.Wrap(types.ErrAlreadyClaimed, recipient.String())
+	}
+	if !types.VerifyProof(airdrop.MerkleRoot, types.LeafHash(recipient, amount), proof) {
+		return sdkerrors.Wrapf(types.ErrInvalidProof, "%s of %s", amount, recipient)
+	}
+	claimed := airdrop.Claimed.Add(amount...)
+	if !airdrop.Amount.IsAllGTE(claimed) {
+		// the tree of the proposal exceeds its funding
+		return sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, "airdrop exhausted")
+	}
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, amount); err != nil {
+		return err
+	}
+	airdrop.Claimed = claimed
+	k.setAirdrop(ctx, *airdrop)
+	ctx.KVStore(k.storeKey).Set(types.GetClaimKey(id, recipient), []byte{})
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeClaim,
+		sdk.NewAttribute(types.AttributeKeyAirdropID, strconv.FormatUint(id, 10)),
+		sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+	))
+	return nil
+}
+
+// EndBlocker ends the airdrops whose end height is at or before the block height
+func (k Keeper) EndBlocker(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.EndQueuePrefix)
+	iter := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight()+1)))
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		store.Delete(key)
+		// the key is the end height followed by the airdrop id
+		airdrop := k.GetAirdrop(ctx, binary.BigEndian.Uint64(key[8:]))
+		if airdrop == nil || airdrop.Ended {
+			continue
+		}
+		if err := k.end(ctx, *airdrop); err != nil {
+			// the module account holds the unclaimed amounts of all open airdrops
+			panic(err)
+		}
+	}
+}
+
+// end returns the unclaimed amount of the airdrop to the community pool and deletes its claims. The ended airdrop is
+// kept for the clients that query it.
+func (k Keeper) end(ctx sdk.Context, airdrop types.Airdrop) error {
+	clawback := airdrop.Unclaimed()
+	if !clawback.IsZero() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, clawback, k.supplyKeeper.GetModuleAddress(types.ModuleName)); err != nil {
+			return err
+		}
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetClaimsPrefix(airdrop.ID))
+	iter := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	airdrop.Ended = true
+	k.setAirdrop(ctx, airdrop)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeEnd,
+		sdk.NewAttribute(types.AttributeKeyAirdropID, strconv.FormatUint(airdrop.ID, 10)),
+		sdk.NewAttribute(types.AttributeKeyClawback, clawback.String()),
+	))
+	return nil
+}
+
+// GetLastAirdropID returns the id of the last registered airdrop, 0 when none was registered
+func (k Keeper) GetLastAirdropID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.LastAirdropIDKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setLastAirdropID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.LastAirdropIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetAirdrop returns the airdrop, nil when there is none
+func (k Keeper) GetAirdrop(ctx sdk.Context, id uint64) *types.Airdrop {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetAirdropKey(id))
+	if bz == nil {
+		return nil
+	}
+	var airdrop types.Airdrop
+	k.cdc.MustUnmarshalBinaryBare(bz, &airdrop)
+	return &airdrop
+}
+
+func (k Keeper) setAirdrop(ctx sdk.Context, airdrop types.Airdrop) {
+	ctx.KVStore(k.storeKey).Set(types.GetAirdropKey(airdrop.ID), k.cdc.MustMarshalBinaryBare(airdrop))
+}
+
+// HasClaimed returns true when the recipient claimed its amount of the open airdrop
+func (k Keeper) HasClaimed(ctx sdk.Context, id uint64, recipient sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetClaimKey(id, recipient))
+}
+
+// IterateAirdrops calls cb for all airdrops, including the ended ones, until cb returns true
+func (k Keeper) IterateAirdrops(ctx sdk.Context, cb func(types.Airdrop) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.AirdropPrefix).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var airdrop types.Airdrop
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &airdrop)
+		if cb(airdrop) {
+			return
+		}
+	}
+}
+
+// IterateClaims calls cb for the claims of all open airdrops, until cb returns true
+func (k Keeper) IterateClaims(ctx sdk.Context, cb func(types.Claim) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClaimPrefix).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		// the key is the airdrop id followed by the recipient
+		claim := types.Claim{AirdropID: binary.BigEndian.Uint64(iter.Key()[:8]), Recipient: sdk.AccAddress(iter.Key()[8:])}
+		if cb(claim) {
+			return
+		}
+	}
+}
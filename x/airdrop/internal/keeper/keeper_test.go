@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/fetchai/fetchd/testutil"
+	"github.com/fetchai/fetchd/x/airdrop/internal/types"
+)
+
+var (
+	alice = sdk.AccAddress([]byte("alice_______________"))
+	bob   = sdk.AccAddress([]byte("bob_________________"))
+	carol = sdk.AccAddress([]byte("carol_______________"))
+)
+
+func fet(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("afet", amount)) }
+
+func setupKeeper(t *testing.T) (sdk.Context, Keeper, *testutil.SupplyKeeper, *mockDistrKeeper) {
+	cdc := codec.New()
+	types.RegisterCodec(cdc)
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	ctx, _ := testutil.NewContext(t, cdc, abci.Header{Height: 10}, key)
+	sk := testutil.NewSupplyKeeper(map[string]sdk.Coins{})
+	dk := &mockDistrKeeper{sk: sk, pool: fet(1000)}
+	return ctx, NewKeeper(cdc, key, sk, dk), sk, dk
+}
+
+// tree returns the merkle root of the airdrop of 100afet to alice and 50afet to bob, and their proofs
+func tree() ([]byte, [][][]byte) {
+	return types.BuildTree([][]byte{types.LeafHash(alice, fet(100)), types.LeafHash(bob, fet(50))})
+}
+
+func TestClaim(t *testing.T) {
+	root, proofs := tree()
+	specs := map[string]struct {
+		recipient  sdk.AccAddress
+		amount     sdk.Coins
+		proof      [][]byte
+		height     int64
+		claimTwice bool
+		expErr     *sdkerrors.Error
+	}{
+		"alice":           {recipient: alice, amount: fet(100), proof: proofs[0]},
+		"bob":             {recipient: bob, amount: fet(50), proof: proofs[1]},
+		"last block":      {recipient: alice, amount: fet(100), proof: proofs[0], height: 109},
+		"other amount":    {recipient: alice, amount: fet(150), proof: proofs[0], expErr: types.ErrInvalidProof},
+		"other proof":     {recipient: alice, amount: fet(100), proof: proofs[1], expErr: types.ErrInvalidProof},
+		"not a recipient": {recipient: carol, amount: fet(100), proof: proofs[0], expErr: types.ErrInvalidProof},
+		"twice":           {recipient: alice, amount: fet(100), proof: proofs[0], claimTwice: true, expErr: types.ErrAlreadyClaimed},
+		"ended":           {recipient: alice, amount: fet(100), proof: proofs[0], height: 110, expErr: types.ErrEnded},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, k, sk, _ := setupKeeper(t)
+			airdrop, err := k.Register(ctx, root, fet(150), 100)
+			require.NoError(t, err)
+			assert.Equal(t, int64(110), airdrop.EndHeight)
+			if spec.height != 0 {
+				ctx = ctx.WithBlockHeight(spec.height)
+			}
+			if spec.claimTwice {
+				require.NoError(t, k.Claim(ctx, spec.recipient, airdrop.ID, spec.amount, spec.proof))
+			}
+			err = k.Claim(ctx, spec.recipient, airdrop.ID, spec.amount, spec.proof)
+			if spec.expErr != nil {
+				require.True(t, spec.expErr.Is(err), "got %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.amount.String(), sk.Balances[spec.recipient.String()].String())
+			assert.Equal(t, spec.amount.String(), k.GetAirdrop(ctx, airdrop.ID).Claimed.String())
+			assert.True(t, k.HasClaimed(ctx, airdrop.ID, spec.recipient))
+		})
+	}
+}
+
+func TestRegisterExceedsPool(t *testing.T) {
+	ctx, k, _, _ := setupKeeper(t)
+	root, _ := tree()
+	_, err := k.Register(ctx, root, fet(1001), 100)
+	require.Error(t, err)
+	assert.Nil(t, k.GetAirdrop(ctx, 1))
+}
+
+func TestEndBlocker(t *testing.T) {
+	ctx, k, sk, dk := setupKeeper(t)
+	root, proofs := tree()
+	first, err := k.Register(ctx, root, fet(150), 100)
+	require.NoError(t, err)
+	second, err := k.Register(ctx.WithBlockHeight(20), root, fet(150), 100)
+	require.NoError(t, err)
+	assert.Equal(t, fet(700).String(), dk.pool.String())
+	require.NoError(t, k.Claim(ctx, alice, first.ID, fet(100), proofs[0]))
+
+	k.EndBlocker(ctx.WithBlockHeight(109))
+	assert.False(t, k.GetAirdrop(ctx, first.ID).Ended)
+
+	// bob's unclaimed amount of the first airdrop is clawed back
+	k.EndBlocker(ctx.WithBlockHeight(110))
+	ended := k.GetAirdrop(ctx, first.ID)
+	assert.True(t, ended.Ended)
+	assert.Equal(t, fet(100).String(), ended.Claimed.String())
+	assert.Equal(t, fet(750).String(), dk.pool.String())
+	assert.Equal(t, fet(150).String(), sk.Balances[testutil.ModuleKey(types.ModuleName)].String())
+	assert.False(t, k.HasClaimed(ctx, first.ID, alice))
+	assert.False(t, k.GetAirdrop(ctx, second.ID).Ended)
+
+	// both recipients can still claim the second airdrop
+	require.NoError(t, k.Claim(ctx.WithBlockHeight(110), alice, second.ID, fet(100), proofs[0]))
+	require.NoError(t, k.Claim(ctx.WithBlockHeight(110), bob, second.ID, fet(50), proofs[1]))
+	k.EndBlocker(ctx.WithBlockHeight(120))
+	assert.Equal(t, fet(750).String(), dk.pool.String())
+	assert.True(t, sk.Balances[testutil.ModuleKey(types.ModuleName)].IsZero())
+}
+
+func TestGenesis(t *testing.T) {
+	ctx, k, _, _ := setupKeeper(t)
+	root, proofs := tree()
+	first, err := k.Register(ctx, root, fet(150), 10)
+	require.NoError(t, err)
+	second, err := k.Register(ctx, root, fet(150), 100)
+	require.NoError(t, err)
+	require.NoError(t, k.Claim(ctx, alice, second.ID, fet(100), proofs[0]))
+	k.EndBlocker(ctx.WithBlockHeight(20))
+	exp := ExportGenesis(ctx, k)
+	assert.Equal(t, uint64(2), exp.LastAirdropID)
+	assert.Len(t, exp.Airdrops, 2)
+	assert.Equal(t, []types.Claim{{AirdropID: second.ID, Recipient: alice}}, exp.Claims)
+	require.NoError(t, types.ValidateGenesis(exp))
+
+	// the module account of the new chain holds the unclaimed amount of the open airdrop
+	newCtx, newK, sk, dk := setupKeeper(t)
+	sk.Balances[testutil.ModuleKey(types.ModuleName)] = fet(50)
+	InitGenesis(newCtx, newK, exp)
+	assert.Equal(t, exp, ExportGenesis(newCtx, newK))
+	assert.True(t, newK.GetAirdrop(newCtx, first.ID).Ended)
+	assert.True(t, types.ErrAlreadyClaimed.Is(newK.Claim(newCtx, alice, second.ID, fet(100), proofs[0])))
+
+	// the second airdrop is queued to end again
+	newK.EndBlocker(newCtx.WithBlockHeight(110))
+	assert.True(t, newK.GetAirdrop(newCtx, second.ID).Ended)
+	assert.Equal(t, fet(1050).String(), dk.pool.String())
+}
+
+type mockDistrKeeper struct {
+	sk   *testutil.SupplyKeeper
+	pool sdk.Coins
+}
+
+func (m *mockDistrKeeper) DistributeFromFeePool(_ sdk.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) error {
+	pool, hasNeg := m.pool.SafeSub(amount)
+	if hasNeg {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	m.pool = pool
+	m.sk.Balances[receiveAddr.String()] = m.sk.Balances[receiveAddr.String()].Add(amount...)
+	return nil
+}
+
+func (m *mockDistrKeeper) FundCommunityPool(_ sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	balance, hasNeg := m.sk.Balances[sender.String()].SafeSub(amount)
+	if hasNeg {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	m.sk.Balances[sender.String()] = balance
+	m.pool = m.pool.Add(amount...)
+	return nil
+}
@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/fetchai/fetchd/x/airdrop/internal/types"
+)
+
+// NewProposalHandler creates a new governance Handler for airdrop proposals
+func NewProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case types.RegisterAirdropProposal:
+			if err := c.ValidateBasic(); err != nil {
+				return err
+			}
+			_, err := k.Register(ctx, c.MerkleRoot, c.Amount, c.ClaimBlocks)
+			return err
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized airdrop proposal content type: %T", c)
+		}
+	}
+}
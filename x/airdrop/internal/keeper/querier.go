@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"encoding/json"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/fetchai/fetchd/x/airdrop/internal/types"
+)
+
+const (
+	// QueryAirdrops returns all airdrops
+	QueryAirdrops = "airdrops"
+	// QueryAirdrop returns an airdrop, path: id
+	QueryAirdrop = "airdrop"
+	// QueryClaimed returns whether a recipient claimed an open airdrop, path: id/address
+	QueryClaimed = "claimed"
+)
+
+// NewQuerier creates a new querier
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		switch path[0] {
+		case QueryAirdrops:
+			airdrops := []types.Airdrop{}
+			keeper.IterateAirdrops(ctx, func(a types.Airdrop) bool {
+				airdrops = append(airdrops, a)
+				return false
+			})
+			return marshal(airdrops)
+		case QueryAirdrop:
+			if len(path) < 2 {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "airdrop id required")
+			}
+			id, err := strconv.ParseUint(path[1], 10, 64)
+			if err != nil {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "airdrop id")
+			}
+			airdrop := keeper.GetAirdrop(ctx, id)
+			if airdrop == nil {
+				return []byte("null"), nil
+			}
+			return marshal(airdrop)
+		case QueryClaimed:
+			if len(path) < 3 {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "airdrop id and address required")
+			}
+			id, err := strconv.ParseUint(path[1], 10, 64)
+			if err != nil {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "airdrop id")
+			}
+			addr, err := sdk.AccAddressFromBech32(path[2])
+			if err != nil {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+			}
+			return marshal(keeper.HasClaimed(ctx, id, addr))
+		default:
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown data query endpoint")
+		}
+	}
+}
+
+func marshal(v interface{}) ([]byte, error) {
+	bz, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"crypto/sha256"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+)
+
+// Airdrop pays the amounts of the leaves of the merkle root to their recipients until the end height, when the
+// unclaimed amount is returned to the community pool
+type Airdrop struct {
+	ID         uint64           `json:"id" yaml:"id"`
+	MerkleRoot tmbytes.HexBytes `json:"merkle_root" yaml:"merkle_root"`
+	// Amount is the funding of the airdrop, the sum of the amounts of the leaves
+	Amount    sdk.Coins `json:"amount" yaml:"amount"`
+	Claimed   sdk.Coins `json:"claimed" yaml:"claimed"`
+	EndHeight int64     `json:"end_height" yaml:"end_height"`
+	// Ended is set when the unclaimed amount is returned to the community pool at the end height
+	Ended bool `json:"ended" yaml:"ended"`
+}
+
+// Validate validates the airdrop
+func (a Airdrop) Validate() error {
+	if a.ID == 0 {
+		return sdkerrors.Wrap(ErrInvalidAirdrop, "id must be positive")
+	}
+	if err := ValidateMerkleRoot(a.MerkleRoot); err != nil {
+		return err
+	}
+	if !a.Amount.IsValid() || a.Amount.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "amount")
+	}
+	if !a.Claimed.IsValid() || !a.Amount.IsAllGTE(a.Claimed) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "claimed exceeds amount")
+	}
+	if a.EndHeight <= 0 {
+		return sdkerrors.Wrap(ErrInvalidAirdrop, "end height must be positive")
+	}
+	return nil
+}
+
+// Unclaimed returns the amount of the airdrop that is not claimed
+func (a Airdrop) Unclaimed() sdk.Coins {
+	return a.Amount.Sub(a.Claimed)
+}
+
+// ValidateMerkleRoot validates a sha256 merkle root
+func ValidateMerkleRoot(root []byte) error {
+	if len(root) != sha256.Size {
+		return sdkerrors.Wrapf(ErrInvalidAirdrop, "merkle root must be %d bytes", sha256.Size)
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package types
+
+import (
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Codes for airdrop errors
+var (
+	DefaultCodespace = ModuleName
+
+	// ErrAirdropNotFound error for an airdrop that does not exist
+	ErrAirdropNotFound = sdkErrors.Register(DefaultCodespace, 1, "airdrop not found")
+	// ErrInvalidProof error for an amount that is not a leaf of the merkle root of the airdrop
+	ErrInvalidProof = sdkErrors.Register(DefaultCodespace, 2, "invalid merkle proof")
+	// ErrAlreadyClaimed error for a second claim of a recipient
+	ErrAlreadyClaimed = sdkErrors.Register(DefaultCodespace, 3, "already claimed")
+	// ErrEnded error for a claim of an airdrop that ended
+	ErrEnded = sdkErrors.Register(DefaultCodespace, 4, "airdrop ended")
+	// ErrInvalidAirdrop error for an invalid airdrop proposal
+	ErrInvalidAirdrop = sdkErrors.Register(DefaultCodespace, 5, "invalid airdrop")
+)
@@ -0,0 +1,57 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Claim records that the recipient claimed its amount of the airdrop
+type Claim struct {
+	AirdropID uint64         `json:"airdrop_id" yaml:"airdrop_id"`
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+}
+
+// GenesisState is the genesis state of the airdrop module
+type GenesisState struct {
+	LastAirdropID uint64    `json:"last_airdrop_id"`
+	Airdrops      []Airdrop `json:"airdrops,omitempty"`
+	Claims        []Claim   `json:"claims,omitempty"`
+}
+
+// DefaultGenesisState returns the genesis state without airdrops
+func DefaultGenesisState() GenesisState {
+	return GenesisState{}
+}
+
+// ValidateGenesis performs basic validation of the genesis state. The ids of the airdrops must not exceed the last
+// airdrop id and the claims must be of open airdrops.
+func ValidateGenesis(data GenesisState) error {
+	open := make(map[uint64]bool, len(data.Airdrops))
+	for _, a := range data.Airdrops {
+		if err := a.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "airdrop %d", a.ID)
+		}
+		if a.ID > data.LastAirdropID {
+			return sdkerrors.Wrapf(ErrInvalidAirdrop, "airdrop %d after last airdrop id", a.ID)
+		}
+		if _, exists := open[a.ID]; exists {
+			return sdkerrors.Wrapf(ErrInvalidAirdrop, "duplicate airdrop %d", a.ID)
+		}
+		open[a.ID] = !a.Ended
+	}
+	claims := make(map[string]struct{}, len(data.Claims))
+	for _, c := range data.Claims {
+		if err := sdk.VerifyAddressFormat(c.Recipient); err != nil {
+			return sdkerrors.Wrapf(err, "claim of airdrop %d", c.AirdropID)
+		}
+		if !open[c.AirdropID] {
+			return sdkerrors.Wrapf(ErrAirdropNotFound, "claim of %s in airdrop %d", c.Recipient, c.AirdropID)
+		}
+		key := string(GetClaimKey(c.AirdropID, c.Recipient))
+		if _, exists := claims[key]; exists {
+			return sdkerrors.Wrapf(ErrAlreadyClaimed, "duplicate claim of %s in airdrop %d", c.Recipient, c.AirdropID)
+		}
+		claims[key] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the airdrop module
+	ModuleName = "airdrop"
+
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for the airdrop module
+	QuerierRoute = ModuleName
+
+	// RouterKey is the msg router key for the airdrop module
+	RouterKey = ModuleName
+)
+
+const ( // event attributes
+	AttributeKeyAirdropID = "airdrop_id"
+	AttributeKeyRecipient = "recipient"
+	AttributeKeyEndHeight = "end_height"
+	AttributeKeyClawback  = "clawback"
+)
+
+const (
+	// EventTypeRegister is emitted when an airdrop is registered by a proposal
+	EventTypeRegister = "register_airdrop"
+	// EventTypeClaim is emitted when a recipient claims an airdrop
+	EventTypeClaim = "claim_airdrop"
+	// EventTypeEnd is emitted when an airdrop ends, with the unclaimed amount returned to the community pool
+	EventTypeEnd = "end_airdrop"
+)
+
+// nolint
+var (
+	AirdropPrefix    = []byte{0x01}
+	LastAirdropIDKey = []byte{0x02}
+	ClaimPrefix      = []byte{0x03}
+	EndQueuePrefix   = []byte{0x04}
+)
+
+// GetAirdropKey returns the store key of the airdrop
+func GetAirdropKey(id uint64) []byte {
+	return append(AirdropPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetClaimsPrefix returns the store key prefix of the claims of the airdrop
+func GetClaimsPrefix(id uint64) []byte {
+	return append(ClaimPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetClaimKey returns the store key of the claim of the recipient in the airdrop
+func GetClaimKey(id uint64, recipient sdk.AccAddress) []byte {
+	return append(GetClaimsPrefix(id), recipient...)
+}
+
+// GetEndQueueKey returns the store key of the airdrop in the queue of the airdrops ending at the height
+func GetEndQueueKey(height int64, id uint64) []byte {
+	return append(append(EndQueuePrefix, sdk.Uint64ToBigEndian(uint64(height))...), sdk.Uint64ToBigEndian(id)...)
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// LeafHash returns the merkle leaf of the amount airdropped to the recipient, the sha256 hash of
+// "<recipient>/<amount>", e.g. "fetch1.../100afet"
+func LeafHash(recipient sdk.AccAddress, amount sdk.Coins) []byte {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s/%s", recipient, amount)))
+	return hash[:]
+}
+
+// hashPair returns the sha256 hash of the pair of nodes, sorted so that proofs need no left or right flags
+func hashPair(a, b []byte) []byte {
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+	hash := sha256.Sum256(append(append([]byte{}, a...), b...))
+	return hash[:]
+}
+
+// VerifyProof returns true when the leaf is in the tree of the merkle root
+func VerifyProof(root, leaf []byte, proof [][]byte) bool {
+	hash := leaf
+	for _, p := range proof {
+		hash = hashPair(hash, p)
+	}
+	return bytes.Equal(hash, root)
+}
+
+// BuildTree returns the merkle root of the leaves and the proof of each leaf. The nodes of each level are hashed in
+// pairs, an odd last node is moved up to the next level as it is.
+func BuildTree(leaves [][]byte) ([]byte, [][][]byte) {
+	if len(leaves) == 0 {
+		return nil, nil
+	}
+	proofs := make([][][]byte, len(leaves))
+	// positions holds the index of the node of each leaf in the current level
+	positions := make([]int, len(leaves))
+	for i := range positions {
+		positions[i] = i
+	}
+	level := leaves
+	for len(level) > 1 {
+		next := make([][]byte, 0, (len(level)+1)/2)
+		for i := 0; i < len(level); i += 2 {
+			if i+1 == len(level) {
+				next = append(next, level[i])
+				continue
+			}
+			next = append(next, hashPair(level[i], level[i+1]))
+		}
+		for leaf, pos := range positions {
+			if sibling := pos ^ 1; sibling < len(level) {
+				proofs[leaf] = append(proofs[leaf], level[sibling])
+			}
+			positions[leaf] = pos / 2
+		}
+		level = next
+	}
+	return level[0], proofs
+}
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildTree(t *testing.T) {
+	for _, n := range []int{1, 2, 3, 4, 5, 8, 13} {
+		t.Run(fmt.Sprintf("%d leaves", n), func(t *testing.T) {
+			var leaves [][]byte
+			for i := 0; i < n; i++ {
+				recipient := sdk.AccAddress([]byte(fmt.Sprintf("recipient_%010d", i)))
+				leaves = append(leaves, LeafHash(recipient, sdk.NewCoins(sdk.NewInt64Coin("afet", int64(i+1)))))
+			}
+			root, proofs := BuildTree(leaves)
+			require.Len(t, proofs, n)
+			for i, leaf := range leaves {
+				assert.True(t, VerifyProof(root, leaf, proofs[i]), "leaf %d", i)
+				if n > 1 {
+					assert.False(t, VerifyProof(root, leaves[(i+1)%n], proofs[i]), "leaf %d with proof of %d", (i+1)%n, i)
+				}
+			}
+			if n == 1 {
+				assert.Equal(t, leaves[0], root)
+			}
+		})
+	}
+	root, proofs := BuildTree(nil)
+	assert.Nil(t, root)
+	assert.Nil(t, proofs)
+}
+
+func TestValidateGenesis(t *testing.T) {
+	recipient := sdk.AccAddress([]byte("recipient___________"))
+	airdrop := Airdrop{
+		ID:         1,
+		MerkleRoot: LeafHash(recipient, sdk.NewCoins(sdk.NewInt64Coin("afet", 100))),
+		Amount:     sdk.NewCoins(sdk.NewInt64Coin("afet", 100)),
+		EndHeight:  100,
+	}
+	specs := map[string]struct {
+		mutate func(*GenesisState)
+		expErr bool
+	}{
+		"valid":             {mutate: func(*GenesisState) {}},
+		"id after last id":  {mutate: func(g *GenesisState) { g.LastAirdropID = 0 }, expErr: true},
+		"duplicate airdrop": {mutate: func(g *GenesisState) { g.Airdrops = append(g.Airdrops, airdrop) }, expErr: true},
+		"duplicate claim":   {mutate: func(g *GenesisState) { g.Claims = append(g.Claims, g.Claims[0]) }, expErr: true},
+		"claim of ended":    {mutate: func(g *GenesisState) { g.Airdrops[0].Ended = true }, expErr: true},
+		"short merkle root": {mutate: func(g *GenesisState) { g.Airdrops[0].MerkleRoot = airdrop.MerkleRoot[:16] }, expErr: true},
+		"claimed exceeds": {mutate: func(g *GenesisState) {
+			g.Airdrops[0].Claimed = sdk.NewCoins(sdk.NewInt64Coin("afet", 101))
+		}, expErr: true},
+		"ended without claims": {mutate: func(g *GenesisState) {
+			g.Airdrops[0].Ended = true
+			g.Claims = nil
+		}},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			g := GenesisState{LastAirdropID: 1, Airdrops: []Airdrop{airdrop}, Claims: []Claim{{AirdropID: 1, Recipient: recipient}}}
+			spec.mutate(&g)
+			err := ValidateGenesis(g)
+			if spec.expErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
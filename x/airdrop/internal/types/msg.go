@@ -0,0 +1,54 @@
+package types
+
+import (
+	"crypto/sha256"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+)
+
+// MaxProofLength is the max number of hashes of a merkle proof, enough for trees of 2^32 recipients
+const MaxProofLength = 32
+
+// MsgClaimAirdrop claims the amount of the leaf of the sender in the airdrop
+type MsgClaimAirdrop struct {
+	Sender    sdk.AccAddress     `json:"sender" yaml:"sender"`
+	AirdropID uint64             `json:"airdrop_id" yaml:"airdrop_id"`
+	Amount    sdk.Coins          `json:"amount" yaml:"amount"`
+	Proof     []tmbytes.HexBytes `json:"proof" yaml:"proof"`
+}
+
+func (msg MsgClaimAirdrop) Route() string {
+	return RouterKey
+}
+
+func (msg MsgClaimAirdrop) Type() string {
+	return "claim-airdrop"
+}
+
+func (msg MsgClaimAirdrop) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if !msg.Amount.IsValid() || msg.Amount.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+	if len(msg.Proof) > MaxProofLength {
+		return sdkerrors.Wrapf(ErrInvalidProof, "exceeds %d hashes", MaxProofLength)
+	}
+	for _, p := range msg.Proof {
+		if len(p) != sha256.Size {
+			return sdkerrors.Wrapf(ErrInvalidProof, "hashes must be %d bytes", sha256.Size)
+		}
+	}
+	return nil
+}
+
+func (msg MsgClaimAirdrop) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgClaimAirdrop) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+)
+
+const ProposalTypeRegisterAirdrop = "RegisterAirdrop"
+
+func init() { // register new content types with the sdk
+	govtypes.RegisterProposalType(ProposalTypeRegisterAirdrop)
+	govtypes.RegisterProposalTypeCodec(RegisterAirdropProposal{}, "airdrop/RegisterAirdropProposal")
+}
+
+// RegisterAirdropProposal gov proposal content type to register an airdrop of the merkle root, funded with the amount
+// from the community pool. The recipients claim for claim blocks, then the unclaimed amount is returned to the pool.
+type RegisterAirdropProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+
+	MerkleRoot  tmbytes.HexBytes `json:"merkle_root" yaml:"merkle_root"`
+	Amount      sdk.Coins        `json:"amount" yaml:"amount"`
+	ClaimBlocks int64            `json:"claim_blocks" yaml:"claim_blocks"`
+}
+
+// GetTitle returns the title of the proposal
+func (p RegisterAirdropProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal
+func (p RegisterAirdropProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of the proposal
+func (p RegisterAirdropProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type
+func (p RegisterAirdropProposal) ProposalType() string { return ProposalTypeRegisterAirdrop }
+
+// ValidateBasic validates the proposal
+func (p RegisterAirdropProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if err := ValidateMerkleRoot(p.MerkleRoot); err != nil {
+		return err
+	}
+	if !p.Amount.IsValid() || p.Amount.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, p.Amount.String())
+	}
+	if p.ClaimBlocks <= 0 {
+		return sdkerrors.Wrap(ErrInvalidAirdrop, "claim blocks must be positive")
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p RegisterAirdropProposal) String() string {
+	return fmt.Sprintf(`Register Airdrop Proposal:
+  Title:        %s
+  Description:  %s
+  Merkle Root:  %s
+  Amount:       %s
+  Claim Blocks: %d`, p.Title, p.Description, p.MerkleRoot, p.Amount, p.ClaimBlocks)
+}
@@ -0,0 +1,52 @@
+package almanac
+
+import (
+	"github.com/fetchai/fetchd/x/almanac/internal/keeper"
+	"github.com/fetchai/fetchd/x/almanac/internal/types"
+)
+
+const (
+	ModuleName               = types.ModuleName
+	StoreKey                 = types.StoreKey
+	QuerierRoute             = types.QuerierRoute
+	RouterKey                = types.RouterKey
+	DefaultParamspace        = types.DefaultParamspace
+	AttributeKeyAgent        = types.AttributeKeyAgent
+	AttributeKeyExpiryHeight = types.AttributeKeyExpiryHeight
+	EventTypeRegister        = types.EventTypeRegister
+	EventTypeUnregister      = types.EventTypeUnregister
+	EventTypeExpire          = types.EventTypeExpire
+	MaxEndpoints             = types.MaxEndpoints
+	MaxNames                 = types.MaxNames
+	QueryParams              = keeper.QueryParams
+	QueryRecord              = keeper.QueryRecord
+	QueryProtocol            = keeper.QueryProtocol
+	QueryService             = keeper.QueryService
+	DefaultSearchLimit       = keeper.DefaultSearchLimit
+)
+
+var (
+	// functions aliases
+	RegisterCodec       = types.RegisterCodec
+	ValidateGenesis     = types.ValidateGenesis
+	DefaultGenesisState = types.DefaultGenesisState
+	DefaultParams       = types.DefaultParams
+	NewKeeper           = keeper.NewKeeper
+	NewQuerier          = keeper.NewQuerier
+	InitGenesis         = keeper.InitGenesis
+	ExportGenesis       = keeper.ExportGenesis
+
+	// variable aliases
+	ModuleCdc        = types.ModuleCdc
+	ErrInvalidRecord = types.ErrInvalidRecord
+	ErrNotRegistered = types.ErrNotRegistered
+)
+
+type (
+	Keeper        = keeper.Keeper
+	GenesisState  = types.GenesisState
+	Params        = types.Params
+	Record        = types.Record
+	MsgRegister   = types.MsgRegister
+	MsgUnregister = types.MsgUnregister
+)
@@ -0,0 +1,104 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fetchai/fetchd/x/almanac/internal/keeper"
+	"github.com/fetchai/fetchd/x/almanac/internal/types"
+)
+
+const flagLimit = "limit"
+
+// GetQueryCmd returns the query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the agent almanac",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(flags.GetCommands(
+		GetCmdQueryParams(cdc),
+		GetCmdQueryRecord(cdc),
+		GetCmdQuerySearch(cdc, keeper.QueryProtocol, "Search the agents that speak a protocol"),
+		GetCmdQuerySearch(cdc, keeper.QueryService, "Search the agents that offer a service"),
+	)...)
+	return queryCmd
+}
+
+// GetCmdQueryParams shows the almanac params
+func GetCmdQueryParams(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "Show the registration fee and the expiry blocks of the records",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var params types.Params
+			return queryAndPrint(cdc, keeper.QueryParams, &params)
+		},
+	}
+}
+
+// GetCmdQueryRecord shows the record of an agent
+func GetCmdQueryRecord(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "record [agent]",
+		Short: "Show the endpoints, protocols, services and expiry height of an agent",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return fmt.Errorf("agent: %s", err)
+			}
+			var record *types.Record
+			if err := query(cdc, fmt.Sprintf("%s/%s", keeper.QueryRecord, args[0]), &record); err != nil {
+				return err
+			}
+			if record == nil {
+				return types.ErrNotRegistered
+			}
+			return context.NewCLIContext().WithCodec(cdc).PrintOutput(record)
+		},
+	}
+}
+
+// GetCmdQuerySearch lists the records of the protocol or service index
+func GetCmdQuerySearch(cdc *codec.Codec, index, short string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("%s [name]", index),
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var records []types.Record
+			return queryAndPrint(cdc, fmt.Sprintf("%s/%d/%s", index, viper.GetInt(flagLimit), args[0]), &records)
+		},
+	}
+	cmd.Flags().Int(flagLimit, keeper.DefaultSearchLimit, "Max number of records")
+	return cmd
+}
+
+func query(cdc *codec.Codec, path string, v interface{}) error {
+	cliCtx := context.NewCLIContext().WithCodec(cdc)
+	res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, path))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(res, v)
+}
+
+func queryAndPrint(cdc *codec.Codec, path string, v interface{}) error {
+	if err := query(cdc, path, v); err != nil {
+		return err
+	}
+	return context.NewCLIContext().WithCodec(cdc).PrintOutput(v)
+}
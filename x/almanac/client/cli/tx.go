@@ -0,0 +1,94 @@
+package cli
+
+import (
+	"bufio"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	"github.com/fetchai/fetchd/x/almanac/internal/types"
+	wasmUtils "github.com/fetchai/fetchd/x/wasm/client/utils"
+)
+
+const (
+	flagEndpoint = "endpoint"
+	flagProtocol = "protocol"
+	flagService  = "service"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Agent almanac transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	txCmd.AddCommand(wasmUtils.AddBroadcastFlags(flags.PostCommands(
+		RegisterCmd(cdc),
+		UnregisterCmd(cdc),
+	)...)...)
+	return txCmd
+}
+
+// RegisterCmd registers or renews the record of the agent of the --from account
+func RegisterCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register",
+		Short: "Register the --from agent with its endpoints, protocols and services in the almanac",
+		Long: `Register the agent of the --from account with the --endpoint urls it receives messages at, the --protocol
+names it speaks and the --service names it offers, so that other agents find it. A registration replaces the earlier
+record of the agent and renews it for the expiry blocks param. Every registration pays the registration fee param to
+the community pool.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			msg := types.MsgRegister{
+				Agent:     cliCtx.GetFromAddress(),
+				Endpoints: viper.GetStringSlice(flagEndpoint),
+				Protocols: viper.GetStringSlice(flagProtocol),
+				Services:  viper.GetStringSlice(flagService),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().StringSlice(flagEndpoint, nil, "Url the agent receives messages at, in order of preference, repeatable")
+	cmd.Flags().StringSlice(flagProtocol, nil, "Protocol the agent speaks, repeatable")
+	cmd.Flags().StringSlice(flagService, nil, "Service the agent offers, repeatable")
+	return cmd
+}
+
+// UnregisterCmd removes the record of the agent of the --from account
+func UnregisterCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "unregister",
+		Short: "Remove the record of the --from agent from the almanac before it expires",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			msg := types.MsgUnregister{Agent: cliCtx.GetFromAddress()}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -0,0 +1,72 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/fetchai/fetchd/x/almanac/internal/keeper"
+	"github.com/fetchai/fetchd/x/almanac/internal/types"
+)
+
+func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/almanac/params", queryHandlerFn(cliCtx, keeper.QueryParams)).Methods("GET")
+	r.HandleFunc("/almanac/records/{agent}", queryRecordHandlerFn(cliCtx)).Methods("GET")
+	// protocol and service names may contain a /
+	r.HandleFunc("/almanac/protocols/{name:.+}", querySearchHandlerFn(cliCtx, keeper.QueryProtocol)).Methods("GET")
+	r.HandleFunc("/almanac/services/{name:.+}", querySearchHandlerFn(cliCtx, keeper.QueryService)).Methods("GET")
+}
+
+func queryHandlerFn(cliCtx context.CLIContext, path string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query(w, r, cliCtx, path)
+	}
+}
+
+func queryRecordHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		agent, err := sdk.AccAddressFromBech32(mux.Vars(r)["agent"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		query(w, r, cliCtx, fmt.Sprintf("%s/%s", keeper.QueryRecord, agent))
+	}
+}
+
+// querySearchHandlerFn lists the records of the index with up to the optional limit query param
+func querySearchHandlerFn(cliCtx context.CLIContext, index string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		limit := keeper.DefaultSearchLimit
+		if v := r.FormValue("limit"); v != "" {
+			var err error
+			if limit, err = strconv.Atoi(v); err != nil || limit <= 0 {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, "invalid limit")
+				return
+			}
+		}
+		query(w, r, cliCtx, fmt.Sprintf("%s/%d/%s", index, limit, mux.Vars(r)["name"]))
+	}
+}
+
+func query(w http.ResponseWriter, r *http.Request, cliCtx context.CLIContext, path string) {
+	cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+	if !ok {
+		return
+	}
+
+	res, height, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, path))
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	cliCtx = cliCtx.WithHeight(height)
+	rest.PostProcessResponse(w, cliCtx, json.RawMessage(res))
+}
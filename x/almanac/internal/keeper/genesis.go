@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fetchai/fetchd/x/almanac/internal/types"
+)
+
+// InitGenesis stores the params and the agent records of the genesis
+func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
+	keeper.setParams(ctx, data.Params)
+	for _, r := range data.Records {
+		keeper.setRecord(ctx, r)
+	}
+}
+
+// ExportGenesis returns the params and the agent records as genesis state
+func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
+	data := types.GenesisState{Params: keeper.GetParams(ctx)}
+	keeper.IterateRecords(ctx, func(r types.Record) bool {
+		data.Records = append(data.Records, r)
+		return false
+	})
+	return data
+}
@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/params"
+
+	"github.com/fetchai/fetchd/x/almanac/internal/types"
+)
+
+// Keeper keeps the records of the registered agents, indexed by protocol, service and expiry height
+type Keeper struct {
+	storeKey    sdk.StoreKey
+	cdc         *codec.Codec
+	paramSpace  params.Subspace
+	distrKeeper types.DistributionKeeper
+}
+
+// NewKeeper creates a new almanac Keeper instance
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, paramSpace params.Subspace, distrKeeper types.DistributionKeeper) Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+	return Keeper{
+		storeKey:    storeKey,
+		cdc:         cdc,
+		paramSpace:  paramSpace,
+		distrKeeper: distrKeeper,
+	}
+}
+
+// GetParams returns the total set of almanac parameters.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	var params types.Params
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+func (k Keeper) setParams(ctx sdk.Context, ps types.Params) {
+	k.paramSpace.SetParamSet(ctx, &ps)
+}
+
+// Register stores the record of the agent, replacing an earlier one, and sets it to expire after the expiry blocks.
+// The agent pays the registration fee to the community pool. It returns the stored record.
+func (k Keeper) Register(ctx sdk.Context, msg types.MsgRegister) (types.Record, error) {
+	params := k.GetParams(ctx)
+	if !params.RegistrationFee.IsZero() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, params.RegistrationFee, msg.Agent); err != nil {
+			return types.Record{}, sdkerrors.Wrap(err, "registration fee")
+		}
+	}
+	if old := k.GetRecord(ctx, msg.Agent); old != nil {
+		k.deleteRecord(ctx, *old)
+	}
+	record := msg.Record(ctx.BlockHeight() + params.ExpiryBlocks)
+	k.setRecord(ctx, record)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRegister,
+		sdk.NewAttribute(types.AttributeKeyAgent, record.Agent.String()),
+		sdk.NewAttribute(types.AttributeKeyExpiryHeight, strconv.FormatInt(record.ExpiryHeight, 10)),
+	))
+	return record, nil
+}
+
+// Unregister removes the record of the agent
+func (k Keeper) Unregister(ctx sdk.Context, agent sdk.AccAddress) error {
+	record := k.GetRecord(ctx, agent)
+	if record == nil {
+		return sdkerrors.Wrap(types.ErrNotRegistered, agent.String())
+	}
+	k.deleteRecord(ctx, *record)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUnregister,
+		sdk.NewAttribute(types.AttributeKeyAgent, agent.String()),
+	))
+	return nil
+}
+
+// EndBlocker removes the records that expire at or before the block height
+func (k Keeper) EndBlocker(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ExpiryQueuePrefix)
+	iter := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight()+1)))
+	var expired []sdk.AccAddress
+	for ; iter.Valid(); iter.Next() {
+		// the key is the expiry height followed by the agent
+		expired = append(expired, sdk.AccAddress(iter.Key()[8:]))
+	}
+	iter.Close()
+	for _, agent := range expired {
+		if record := k.GetRecord(ctx, agent); record != nil {
+			k.deleteRecord(ctx, *record)
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeExpire,
+			sdk.NewAttribute(types.AttributeKeyAgent, agent.String()),
+		))
+	}
+}
+
+// GetRecord returns the record of the agent, nil when it is not registered
+func (k Keeper) GetRecord(ctx sdk.Context, agent sdk.AccAddress) *types.Record {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetRecordKey(agent))
+	if bz == nil {
+		return nil
+	}
+	var record types.Record
+	k.cdc.MustUnmarshalBinaryBare(bz, &record)
+	return &record
+}
+
+// setRecord stores the record with its index and expiry queue entries
+func (k Keeper) setRecord(ctx sdk.Context, record types.Record) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetRecordKey(record.Agent), k.cdc.MustMarshalBinaryBare(record))
+	for _, p := range record.Protocols {
+		store.Set(types.GetProtocolIndexKey(p, record.Agent), []byte{})
+	}
+	for _, s := range record.Services {
+		store.Set(types.GetServiceIndexKey(s, record.Agent), []byte{})
+	}
+	store.Set(types.GetExpiryQueueKey(record.ExpiryHeight, record.Agent), []byte{})
+}
+
+// deleteRecord deletes the record with its index and expiry queue entries
+func (k Keeper) deleteRecord(ctx sdk.Context, record types.Record) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetRecordKey(record.Agent))
+	for _, p := range record.Protocols {
+		store.Delete(types.GetProtocolIndexKey(p, record.Agent))
+	}
+	for _, s := range record.Services {
+		store.Delete(types.GetServiceIndexKey(s, record.Agent))
+	}
+	store.Delete(types.GetExpiryQueueKey(record.ExpiryHeight, record.Agent))
+}
+
+// IterateRecords calls cb for the records of all agents in agent address order, until cb returns true
+func (k Keeper) IterateRecords(ctx sdk.Context, cb func(types.Record) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.RecordPrefix).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var record types.Record
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &record)
+		if cb(record) {
+			return
+		}
+	}
+}
+
+// IterateProtocolRecords calls cb for the records of the agents that speak the protocol in agent address order,
+// until cb returns true
+func (k Keeper) IterateProtocolRecords(ctx sdk.Context, protocol string, cb func(types.Record) bool) {
+	k.iterateIndex(ctx, types.GetProtocolIndexPrefix(protocol), cb)
+}
+
+// IterateServiceRecords calls cb for the records of the agents that offer the service in agent address order, until
+// cb returns true
+func (k Keeper) IterateServiceRecords(ctx sdk.Context, service string, cb func(types.Record) bool) {
+	k.iterateIndex(ctx, types.GetServiceIndexPrefix(service), cb)
+}
+
+func (k Keeper) iterateIndex(ctx sdk.Context, indexPrefix []byte, cb func(types.Record) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), indexPrefix).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		record := k.GetRecord(ctx, sdk.AccAddress(iter.Key()))
+		if record != nil && cb(*record) {
+			return
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/fetchai/fetchd/testutil"
+	"github.com/fetchai/fetchd/x/almanac/internal/types"
+)
+
+var (
+	agent1 = sdk.AccAddress([]byte("agent1______________"))
+	agent2 = sdk.AccAddress([]byte("agent2______________"))
+)
+
+func fet(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("afet", amount)) }
+
+func setupKeeper(t *testing.T) (sdk.Context, Keeper, *mockDistrKeeper) {
+	cdc := codec.New()
+	types.RegisterCodec(cdc)
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	ctx, paramsKeeper := testutil.NewContext(t, cdc, abci.Header{Height: 10}, key)
+	dk := &mockDistrKeeper{balances: map[string]sdk.Coins{agent1.String(): fet(100), agent2.String(): fet(100)}}
+	k := NewKeeper(cdc, key, paramsKeeper.Subspace(types.DefaultParamspace), dk)
+	InitGenesis(ctx, k, types.GenesisState{Params: types.Params{RegistrationFee: fet(40), ExpiryBlocks: 100}})
+	return ctx, k, dk
+}
+
+func register(agent sdk.AccAddress, protocols, services []string) types.MsgRegister {
+	return types.MsgRegister{Agent: agent, Endpoints: []string{"https://agent.example.com/submit"}, Protocols: protocols, Services: services}
+}
+
+func TestRegister(t *testing.T) {
+	ctx, k, dk := setupKeeper(t)
+	record, err := k.Register(ctx, register(agent1, []string{"chat/v1", "proto:1"}, []string{"weather"}))
+	require.NoError(t, err)
+	assert.Equal(t, int64(110), record.ExpiryHeight)
+	assert.Equal(t, &record, k.GetRecord(ctx, agent1))
+	assert.Equal(t, fet(60).String(), dk.balances[agent1.String()].String())
+	assert.Equal(t, fet(40).String(), dk.balances["community_pool"].String())
+
+	// the renewal replaces the protocols and services and extends the expiry
+	ctx = ctx.WithBlockHeight(50)
+	renewed, err := k.Register(ctx, register(agent1, []string{"chat/v2"}, nil))
+	require.NoError(t, err)
+	assert.Equal(t, int64(150), renewed.ExpiryHeight)
+	assert.Equal(t, fet(20).String(), dk.balances[agent1.String()].String())
+	assert.Empty(t, querySearch(t, ctx, k, QueryProtocol, "chat/v1"))
+	assert.Equal(t, []types.Record{renewed}, querySearch(t, ctx, k, QueryProtocol, "chat/v2"))
+	assert.Empty(t, querySearch(t, ctx, k, QueryService, "weather"))
+
+	// the fee exceeds the balance
+	_, err = k.Register(ctx, register(agent1, nil, nil))
+	require.Error(t, err)
+	assert.Equal(t, &renewed, k.GetRecord(ctx, agent1))
+
+	require.NoError(t, k.Unregister(ctx, agent1))
+	assert.Nil(t, k.GetRecord(ctx, agent1))
+	assert.Empty(t, querySearch(t, ctx, k, QueryProtocol, "chat/v2"))
+	assert.True(t, types.ErrNotRegistered.Is(k.Unregister(ctx, agent1)))
+}
+
+func TestEndBlockerExpiry(t *testing.T) {
+	ctx, k, _ := setupKeeper(t)
+	_, err := k.Register(ctx, register(agent1, []string{"chat/v1"}, nil))
+	require.NoError(t, err)
+	_, err = k.Register(ctx.WithBlockHeight(20), register(agent2, []string{"chat/v1"}, nil))
+	require.NoError(t, err)
+
+	k.EndBlocker(ctx.WithBlockHeight(109))
+	assert.Len(t, querySearch(t, ctx, k, QueryProtocol, "chat/v1"), 2)
+
+	k.EndBlocker(ctx.WithBlockHeight(110))
+	assert.Nil(t, k.GetRecord(ctx, agent1))
+	assert.NotNil(t, k.GetRecord(ctx, agent2))
+	assert.Equal(t, []types.Record{*k.GetRecord(ctx, agent2)}, querySearch(t, ctx, k, QueryProtocol, "chat/v1"))
+	assert.Len(t, ExportGenesis(ctx, k).Records, 1)
+}
+
+func TestQuerySearch(t *testing.T) {
+	ctx, k, _ := setupKeeper(t)
+	r1, err := k.Register(ctx, register(agent1, []string{"chat/v1"}, []string{"weather"}))
+	require.NoError(t, err)
+	r2, err := k.Register(ctx, register(agent2, []string{"chat/v1", "chat"}, nil))
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		path   []string
+		exp    []types.Record
+		expErr bool
+	}{
+		"protocol":          {path: []string{QueryProtocol, "10", "chat", "v1"}, exp: []types.Record{r1, r2}},
+		"protocol prefix":   {path: []string{QueryProtocol, "10", "chat"}, exp: []types.Record{r2}},
+		"limit":             {path: []string{QueryProtocol, "1", "chat", "v1"}, exp: []types.Record{r1}},
+		"service":           {path: []string{QueryService, "10", "weather"}, exp: []types.Record{r1}},
+		"unknown service":   {path: []string{QueryService, "10", "flights"}, exp: []types.Record{}},
+		"no name":           {path: []string{QueryService, "10"}, expErr: true},
+		"invalid limit":     {path: []string{QueryService, "0", "weather"}, expErr: true},
+		"limit not numeric": {path: []string{QueryService, "weather"}, expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			res, err := NewQuerier(k)(ctx, spec.path, abci.RequestQuery{})
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			var records []types.Record
+			require.NoError(t, json.Unmarshal(res, &records))
+			assert.Equal(t, spec.exp, records)
+		})
+	}
+}
+
+func querySearch(t *testing.T, ctx sdk.Context, k Keeper, index, name string) []types.Record {
+	res, err := NewQuerier(k)(ctx, []string{index, "100", name}, abci.RequestQuery{})
+	require.NoError(t, err)
+	var records []types.Record
+	require.NoError(t, json.Unmarshal(res, &records))
+	return records
+}
+
+type mockDistrKeeper struct {
+	balances map[string]sdk.Coins
+}
+
+func (m *mockDistrKeeper) FundCommunityPool(_ sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	balance, hasNeg := m.balances[sender.String()].SafeSub(amount)
+	if hasNeg {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	m.balances[sender.String()] = balance
+	m.balances["community_pool"] = m.balances["community_pool"].Add(amount...)
+	return nil
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/fetchai/fetchd/x/almanac/internal/types"
+)
+
+const (
+	// QueryParams returns the almanac params
+	QueryParams = "params"
+	// QueryRecord returns the record of an agent, path: agent address
+	QueryRecord = "record"
+	// QueryProtocol lists the records of the agents that speak a protocol, path: limit, protocol
+	QueryProtocol = "protocol"
+	// QueryService lists the records of the agents that offer a service, path: limit, service
+	QueryService = "service"
+)
+
+// DefaultSearchLimit is the max number of records returned by a search without limit
+const DefaultSearchLimit = 100
+
+// NewQuerier creates a new querier
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		switch path[0] {
+		case QueryParams:
+			return marshal(keeper.GetParams(ctx))
+		case QueryRecord:
+			if len(path) < 2 {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "agent required")
+			}
+			agent, err := sdk.AccAddressFromBech32(path[1])
+			if err != nil {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+			}
+			record := keeper.GetRecord(ctx, agent)
+			if record == nil {
+				return []byte("null"), nil
+			}
+			return marshal(record)
+		case QueryProtocol:
+			return search(ctx, path[1:], keeper.IterateProtocolRecords)
+		case QueryService:
+			return search(ctx, path[1:], keeper.IterateServiceRecords)
+		default:
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown data query endpoint")
+		}
+	}
+}
+
+// search lists up to limit records of the index of the name, path: limit, name. The name follows the limit, since
+// names may contain a /.
+func search(ctx sdk.Context, path []string, iterate func(sdk.Context, string, func(types.Record) bool)) ([]byte, error) {
+	if len(path) < 2 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "limit and name required")
+	}
+	limit, err := strconv.Atoi(path[0])
+	if err != nil || limit <= 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "limit must be positive")
+	}
+	res := []types.Record{}
+	iterate(ctx, strings.Join(path[1:], "/"), func(r types.Record) bool {
+		res = append(res, r)
+		return len(res) == limit
+	})
+	return marshal(res)
+}
+
+func marshal(v interface{}) ([]byte, error) {
+	bz, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"net/url"
+	"regexp"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// MaxEndpoints is the max number of endpoints of a record
+	MaxEndpoints = 10
+	// MaxNames is the max number of protocols and of services of a record
+	MaxNames = 32
+	// MaxEndpointLength is the max length of an endpoint url
+	MaxEndpointLength = 256
+)
+
+// reName matches protocol and service names, e.g. protocol digests like proto:a03398ea... or names like weather/v1
+var reName = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9:._/-]{0,127}$`)
+
+// Record is the registration of an agent in the almanac, by which other agents discover it. The agent is the account
+// address of the agent key.
+type Record struct {
+	Agent sdk.AccAddress `json:"agent" yaml:"agent"`
+	// Endpoints are the urls the agent receives messages at, in order of preference
+	Endpoints []string `json:"endpoints" yaml:"endpoints"`
+	// Protocols are the message protocols the agent speaks
+	Protocols []string `json:"protocols,omitempty" yaml:"protocols"`
+	// Services are the names of the services the agent offers
+	Services []string `json:"services,omitempty" yaml:"services"`
+	// ExpiryHeight is the height the record is removed at, unless it is renewed before
+	ExpiryHeight int64 `json:"expiry_height" yaml:"expiry_height"`
+}
+
+// ValidateBasic validates the record contents, but not its expiry height
+func (r Record) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(r.Agent); err != nil {
+		return sdkerrors.Wrap(err, "agent")
+	}
+	if len(r.Endpoints) == 0 || len(r.Endpoints) > MaxEndpoints {
+		return sdkerrors.Wrapf(ErrInvalidRecord, "must have 1 to %d endpoints", MaxEndpoints)
+	}
+	for _, e := range r.Endpoints {
+		if err := validateEndpoint(e); err != nil {
+			return err
+		}
+	}
+	if err := validateNames(r.Protocols); err != nil {
+		return sdkerrors.Wrap(err, "protocols")
+	}
+	if err := validateNames(r.Services); err != nil {
+		return sdkerrors.Wrap(err, "services")
+	}
+	return nil
+}
+
+func validateEndpoint(endpoint string) error {
+	if len(endpoint) > MaxEndpointLength {
+		return sdkerrors.Wrapf(ErrInvalidRecord, "endpoint longer than %d", MaxEndpointLength)
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return sdkerrors.Wrapf(ErrInvalidRecord, "endpoint must be an absolute url: %q", endpoint)
+	}
+	return nil
+}
+
+func validateNames(names []string) error {
+	if len(names) > MaxNames {
+		return sdkerrors.Wrapf(ErrInvalidRecord, "more than %d", MaxNames)
+	}
+	seen := make(map[string]struct{}, len(names))
+	for _, n := range names {
+		if !reName.MatchString(n) {
+			return sdkerrors.Wrapf(ErrInvalidRecord, "invalid name %q", n)
+		}
+		if _, exists := seen[n]; exists {
+			return sdkerrors.Wrapf(ErrInvalidRecord, "duplicate %s", n)
+		}
+		seen[n] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordValidateBasic(t *testing.T) {
+	agent := sdk.AccAddress([]byte("agent_______________"))
+	specs := map[string]struct {
+		src    Record
+		expErr bool
+	}{
+		"minimal": {src: Record{Agent: agent, Endpoints: []string{"https://agent.example.com/submit"}}},
+		"all": {src: Record{
+			Agent:     agent,
+			Endpoints: []string{"https://agent.example.com/submit", "http://10.0.0.1:8000"},
+			Protocols: []string{"proto:a03398ea81d7aaaf67e72940937676eae0d019f8e1d8b5efbadfef9fd2e98bb2", "chat/v1"},
+			Services:  []string{"weather", "flight.booking"},
+		}},
+		"no agent":           {src: Record{Endpoints: []string{"https://agent.example.com"}}, expErr: true},
+		"no endpoint":        {src: Record{Agent: agent}, expErr: true},
+		"relative endpoint":  {src: Record{Agent: agent, Endpoints: []string{"/submit"}}, expErr: true},
+		"endpoint too long":  {src: Record{Agent: agent, Endpoints: []string{"https://a.io/" + strings.Repeat("a", MaxEndpointLength)}}, expErr: true},
+		"too many endpoints": {src: Record{Agent: agent, Endpoints: make([]string, MaxEndpoints+1)}, expErr: true},
+		"invalid protocol": {
+			src:    Record{Agent: agent, Endpoints: []string{"https://agent.example.com"}, Protocols: []string{"chat v1"}},
+			expErr: true,
+		},
+		"duplicate service": {
+			src:    Record{Agent: agent, Endpoints: []string{"https://agent.example.com"}, Services: []string{"weather", "weather"}},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Codes for almanac errors
+var (
+	DefaultCodespace = ModuleName
+
+	// ErrInvalidRecord error for an invalid agent record
+	ErrInvalidRecord = sdkErrors.Register(DefaultCodespace, 1, "invalid record")
+	// ErrNotRegistered error for an agent without record
+	ErrNotRegistered = sdkErrors.Register(DefaultCodespace, 2, "agent not registered")
+)
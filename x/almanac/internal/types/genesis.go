@@ -0,0 +1,37 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GenesisState is the genesis state of the almanac module
+type GenesisState struct {
+	Params  Params   `json:"params"`
+	Records []Record `json:"records,omitempty"`
+}
+
+// DefaultGenesisState returns the genesis state of an empty almanac
+func DefaultGenesisState() GenesisState {
+	return GenesisState{Params: DefaultParams()}
+}
+
+// ValidateGenesis performs basic validation of the genesis state
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "params")
+	}
+	seen := make(map[string]struct{}, len(data.Records))
+	for _, r := range data.Records {
+		if err := r.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "record of %s", r.Agent)
+		}
+		if r.ExpiryHeight <= 0 {
+			return sdkerrors.Wrapf(ErrInvalidRecord, "expiry height of %s", r.Agent)
+		}
+		if _, exists := seen[string(r.Agent)]; exists {
+			return sdkerrors.Wrapf(ErrInvalidRecord, "duplicate record of %s", r.Agent)
+		}
+		seen[string(r.Agent)] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the almanac module
+	ModuleName = "almanac"
+
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for the almanac module
+	QuerierRoute = ModuleName
+
+	// RouterKey is the msg router key for the almanac module
+	RouterKey = ModuleName
+)
+
+const ( // event attributes
+	AttributeKeyAgent        = "agent"
+	AttributeKeyExpiryHeight = "expiry_height"
+)
+
+const (
+	// EventTypeRegister is emitted when an agent registers or renews its record
+	EventTypeRegister = "register_agent"
+	// EventTypeUnregister is emitted when an agent removes its record
+	EventTypeUnregister = "unregister_agent"
+	// EventTypeExpire is emitted when the record of an agent expires
+	EventTypeExpire = "expire_agent"
+)
+
+// nolint
+var (
+	RecordPrefix        = []byte{0x01}
+	ProtocolIndexPrefix = []byte{0x02}
+	ServiceIndexPrefix  = []byte{0x03}
+	ExpiryQueuePrefix   = []byte{0x04}
+)
+
+// GetRecordKey returns the store key of the record of the agent
+func GetRecordKey(agent sdk.AccAddress) []byte {
+	return append(RecordPrefix, agent...)
+}
+
+// GetProtocolIndexPrefix returns the store key prefix of the agents of the protocol, the protocol name is length
+// prefixed so that it is not a prefix of longer names
+func GetProtocolIndexPrefix(protocol string) []byte {
+	return append(append(ProtocolIndexPrefix, byte(len(protocol))), protocol...)
+}
+
+// GetProtocolIndexKey returns the store key of the agent in the index of the protocol
+func GetProtocolIndexKey(protocol string, agent sdk.AccAddress) []byte {
+	return append(GetProtocolIndexPrefix(protocol), agent...)
+}
+
+// GetServiceIndexPrefix returns the store key prefix of the agents of the service, like GetProtocolIndexPrefix
+func GetServiceIndexPrefix(service string) []byte {
+	return append(append(ServiceIndexPrefix, byte(len(service))), service...)
+}
+
+// GetServiceIndexKey returns the store key of the agent in the index of the service
+func GetServiceIndexKey(service string, agent sdk.AccAddress) []byte {
+	return append(GetServiceIndexPrefix(service), agent...)
+}
+
+// GetExpiryQueueKey returns the store key of the agent in the queue of the records expiring at the height
+func GetExpiryQueueKey(height int64, agent sdk.AccAddress) []byte {
+	return append(append(ExpiryQueuePrefix, sdk.Uint64ToBigEndian(uint64(height))...), agent...)
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MsgRegister registers the agent with its endpoints, protocols and services, or renews and replaces its record. The
+// registration fee is paid by the agent.
+type MsgRegister struct {
+	Agent     sdk.AccAddress `json:"agent" yaml:"agent"`
+	Endpoints []string       `json:"endpoints" yaml:"endpoints"`
+	Protocols []string       `json:"protocols,omitempty" yaml:"protocols"`
+	Services  []string       `json:"services,omitempty" yaml:"services"`
+}
+
+func (msg MsgRegister) Route() string {
+	return RouterKey
+}
+
+func (msg MsgRegister) Type() string {
+	return "register"
+}
+
+func (msg MsgRegister) ValidateBasic() error {
+	return msg.Record(0).ValidateBasic()
+}
+
+// Record returns the record of the registration, which expires at the height
+func (msg MsgRegister) Record(expiryHeight int64) Record {
+	return Record{
+		Agent:        msg.Agent,
+		Endpoints:    msg.Endpoints,
+		Protocols:    msg.Protocols,
+		Services:     msg.Services,
+		ExpiryHeight: expiryHeight,
+	}
+}
+
+func (msg MsgRegister) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgRegister) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Agent}
+}
+
+// MsgUnregister removes the record of the agent before it expires, the fee is not refunded
+type MsgUnregister struct {
+	Agent sdk.AccAddress `json:"agent" yaml:"agent"`
+}
+
+func (msg MsgUnregister) Route() string {
+	return RouterKey
+}
+
+func (msg MsgUnregister) Type() string {
+	return "unregister"
+}
+
+func (msg MsgUnregister) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Agent); err != nil {
+		return sdkerrors.Wrap(err, "agent")
+	}
+	return nil
+}
+
+func (msg MsgUnregister) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgUnregister) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Agent}
+}
@@ -0,0 +1,84 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// DefaultParamspace for params keeper
+	DefaultParamspace = ModuleName
+)
+
+var ParamStoreKeyRegistrationFee = []byte("registrationFee")
+var ParamStoreKeyExpiryBlocks = []byte("expiryBlocks")
+
+// Params defines the set of almanac parameters. They are changed by param change proposals.
+type Params struct {
+	// RegistrationFee is paid to the community pool for every registration or renewal of a record, optional
+	RegistrationFee sdk.Coins `json:"registration_fee,omitempty" yaml:"registration_fee"`
+	// ExpiryBlocks is the number of blocks after which a record that was not renewed expires
+	ExpiryBlocks int64 `json:"expiry_blocks" yaml:"expiry_blocks"`
+}
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns default almanac parameters, the records expire after about a week of 5s blocks
+func DefaultParams() Params {
+	return Params{
+		ExpiryBlocks: 120960,
+	}
+}
+
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(ParamStoreKeyRegistrationFee, &p.RegistrationFee, validateRegistrationFee),
+		params.NewParamSetPair(ParamStoreKeyExpiryBlocks, &p.ExpiryBlocks, validateExpiryBlocks),
+	}
+}
+
+// ValidateBasic performs basic validation on almanac parameters.
+func (p Params) ValidateBasic() error {
+	if err := validateRegistrationFee(p.RegistrationFee); err != nil {
+		return sdkerrors.Wrap(err, "registration fee")
+	}
+	if err := validateExpiryBlocks(p.ExpiryBlocks); err != nil {
+		return sdkerrors.Wrap(err, "expiry blocks")
+	}
+	return nil
+}
+
+func validateRegistrationFee(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if !v.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, v.String())
+	}
+	return nil
+}
+
+func validateExpiryBlocks(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v <= 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package aname
+
+import (
+	"github.com/fetchai/fetchd/x/aname/internal/keeper"
+	"github.com/fetchai/fetchd/x/aname/internal/types"
+)
+
+const (
+	ModuleName               = types.ModuleName
+	StoreKey                 = types.StoreKey
+	QuerierRoute             = types.QuerierRoute
+	RouterKey                = types.RouterKey
+	DefaultParamspace        = types.DefaultParamspace
+	AttributeKeyName         = types.AttributeKeyName
+	AttributeKeyOwner        = types.AttributeKeyOwner
+	AttributeKeyExpiryHeight = types.AttributeKeyExpiryHeight
+	EventTypeRegister        = types.EventTypeRegister
+	EventTypeRenew           = types.EventTypeRenew
+	EventTypeTransfer        = types.EventTypeTransfer
+	QueryParams              = keeper.QueryParams
+	QueryResolve             = keeper.QueryResolve
+	WasmQueryRoute           = keeper.WasmQueryRoute
+)
+
+var (
+	// functions aliases
+	RegisterCodec       = types.RegisterCodec
+	ValidateGenesis     = types.ValidateGenesis
+	DefaultGenesisState = types.DefaultGenesisState
+	DefaultParams       = types.DefaultParams
+	ValidateName        = types.ValidateName
+	NewKeeper           = keeper.NewKeeper
+	NewQuerier          = keeper.NewQuerier
+	NewWasmQuerier      = keeper.NewWasmQuerier
+	InitGenesis         = keeper.InitGenesis
+	ExportGenesis       = keeper.ExportGenesis
+
+	// variable aliases
+	ModuleCdc       = types.ModuleCdc
+	ErrInvalidName  = types.ErrInvalidName
+	ErrNameTaken    = types.ErrNameTaken
+	ErrNameNotFound = types.ErrNameNotFound
+	ErrNotOwner     = types.ErrNotOwner
+)
+
+type (
+	Keeper           = keeper.Keeper
+	WasmQuery        = keeper.WasmQuery
+	GenesisState     = types.GenesisState
+	Params           = types.Params
+	NameRecord       = types.NameRecord
+	MsgRegisterName  = types.MsgRegisterName
+	MsgRenewName     = types.MsgRenewName
+	MsgTransferName  = types.MsgTransferName
+	MsgSetNameTarget = types.MsgSetNameTarget
+)
@@ -0,0 +1,81 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/fetchai/fetchd/x/aname/internal/keeper"
+	"github.com/fetchai/fetchd/x/aname/internal/types"
+)
+
+// GetQueryCmd returns the query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the name service",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(flags.GetCommands(
+		GetCmdQueryParams(cdc),
+		GetCmdQueryResolve(cdc),
+	)...)
+	return queryCmd
+}
+
+// GetCmdQueryParams shows the aname params
+func GetCmdQueryParams(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "Show the top level domains, the registration fee and the registration period",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, keeper.QueryParams))
+			if err != nil {
+				return err
+			}
+			var params types.Params
+			if err := json.Unmarshal(res, &params); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
+
+// GetCmdQueryResolve resolves a name
+func GetCmdQueryResolve(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "resolve [name]",
+		Short: "Show the owner, the address, the public key and the expiry of a name",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := types.ValidateName(args[0]); err != nil {
+				return err
+			}
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, keeper.QueryResolve, args[0]))
+			if err != nil {
+				return err
+			}
+			var record *types.NameRecord
+			if err := json.Unmarshal(res, &record); err != nil {
+				return err
+			}
+			if record == nil {
+				return sdkerrors.Wrap(types.ErrNameNotFound, args[0])
+			}
+			return cliCtx.PrintOutput(record)
+		},
+	}
+}
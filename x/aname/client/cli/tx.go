@@ -0,0 +1,167 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	"github.com/fetchai/fetchd/x/aname/internal/types"
+	wasmUtils "github.com/fetchai/fetchd/x/wasm/client/utils"
+)
+
+const (
+	flagAddress = "address"
+	flagPubKey  = "pubkey"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Name service transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	txCmd.AddCommand(wasmUtils.AddBroadcastFlags(flags.PostCommands(
+		RegisterNameCmd(cdc),
+		RenewNameCmd(cdc),
+		TransferNameCmd(cdc),
+		SetNameTargetCmd(cdc),
+	)...)...)
+	return txCmd
+}
+
+// RegisterNameCmd registers a domain or a subname for the --from account
+func RegisterNameCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register [name]",
+		Short: "Register a domain like alice.fet or a subname of an own domain like bot.alice.fet",
+		Long: `Register the name for the --from account. Domains under a top level domain cost the registration fee param and
+expire after the registration period param unless they are renewed. Subnames are created by the owner of their parent
+for free and expire with their domain. The name resolves to the --address, the owner by default, and the optional
+--pubkey.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			address, err := addressFlag()
+			if err != nil {
+				return err
+			}
+			msg := types.MsgRegisterName{
+				Owner:   cliCtx.GetFromAddress(),
+				Name:    args[0],
+				Address: address,
+				PubKey:  viper.GetString(flagPubKey),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(flagAddress, "", "Address the name resolves to, defaults to the owner")
+	cmd.Flags().String(flagPubKey, "", "Bech32 account public key the name resolves to")
+	return cmd
+}
+
+// RenewNameCmd renews a domain
+func RenewNameCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "renew [domain]",
+		Short: "Extend the registration of a domain by the registration period, paid by the --from account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			msg := types.MsgRenewName{Sender: cliCtx.GetFromAddress(), Name: args[0]}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// TransferNameCmd transfers a name of the --from account
+func TransferNameCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "transfer [name] [new_owner]",
+		Short: "Transfer a name of the --from account to a new owner",
+		Long:  "Transfer the name to the new owner. The address it resolves to and the owners of its subnames are unchanged.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			newOwner, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return fmt.Errorf("new owner: %s", err)
+			}
+			msg := types.MsgTransferName{Owner: cliCtx.GetFromAddress(), Name: args[0], NewOwner: newOwner}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// SetNameTargetCmd sets what a name of the --from account resolves to
+func SetNameTargetCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-target [name] [address]",
+		Short: "Set the address and the optional --pubkey a name of the --from account resolves to",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			address, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return fmt.Errorf("address: %s", err)
+			}
+			msg := types.MsgSetNameTarget{
+				Owner:   cliCtx.GetFromAddress(),
+				Name:    args[0],
+				Address: address,
+				PubKey:  viper.GetString(flagPubKey),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(flagPubKey, "", "Bech32 account public key the name resolves to")
+	return cmd
+}
+
+func addressFlag() (sdk.AccAddress, error) {
+	v := viper.GetString(flagAddress)
+	if v == "" {
+		return nil, nil
+	}
+	address, err := sdk.AccAddressFromBech32(v)
+	if err != nil {
+		return nil, fmt.Errorf("address: %s", err)
+	}
+	return address, nil
+}
@@ -0,0 +1,52 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/fetchai/fetchd/x/aname/internal/keeper"
+	"github.com/fetchai/fetchd/x/aname/internal/types"
+)
+
+func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/aname/params", queryHandlerFn(cliCtx, keeper.QueryParams)).Methods("GET")
+	r.HandleFunc("/aname/names/{name}", queryResolveHandlerFn(cliCtx)).Methods("GET")
+}
+
+func queryHandlerFn(cliCtx context.CLIContext, path string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query(w, r, cliCtx, path)
+	}
+}
+
+func queryResolveHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name := mux.Vars(r)["name"]
+		if err := types.ValidateName(name); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		query(w, r, cliCtx, fmt.Sprintf("%s/%s", keeper.QueryResolve, name))
+	}
+}
+
+func query(w http.ResponseWriter, r *http.Request, cliCtx context.CLIContext, path string) {
+	cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+	if !ok {
+		return
+	}
+
+	res, height, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, path))
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	cliCtx = cliCtx.WithHeight(height)
+	rest.PostProcessResponse(w, cliCtx, json.RawMessage(res))
+}
@@ -0,0 +1,70 @@
+package aname
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewHandler returns a handler for "aname" type messages.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case MsgRegisterName:
+			return handleRegisterName(ctx, k, &msg)
+		case MsgRenewName:
+			return handleRenewName(ctx, k, &msg)
+		case MsgTransferName:
+			return handleTransferName(ctx, k, &msg)
+		case MsgSetNameTarget:
+			return handleSetNameTarget(ctx, k, &msg)
+		default:
+			errMsg := fmt.Sprintf("unrecognized aname message type: %T", msg)
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+		}
+	}
+}
+
+func handleRegisterName(ctx sdk.Context, k Keeper, msg *MsgRegisterName) (*sdk.Result, error) {
+	if _, err := k.Register(ctx, *msg); err != nil {
+		return nil, err
+	}
+	return result(ctx, msg.Owner, msg.Name), nil
+}
+
+func handleRenewName(ctx sdk.Context, k Keeper, msg *MsgRenewName) (*sdk.Result, error) {
+	if _, err := k.Renew(ctx, msg.Sender, msg.Name); err != nil {
+		return nil, err
+	}
+	return result(ctx, msg.Sender, msg.Name), nil
+}
+
+func handleTransferName(ctx sdk.Context, k Keeper, msg *MsgTransferName) (*sdk.Result, error) {
+	if err := k.Transfer(ctx, msg.Owner, msg.Name, msg.NewOwner); err != nil {
+		return nil, err
+	}
+	return result(ctx, msg.Owner, msg.Name), nil
+}
+
+func handleSetNameTarget(ctx sdk.Context, k Keeper, msg *MsgSetNameTarget) (*sdk.Result, error) {
+	if err := k.SetTarget(ctx, msg.Owner, msg.Name, msg.Address, msg.PubKey); err != nil {
+		return nil, err
+	}
+	return result(ctx, msg.Owner, msg.Name), nil
+}
+
+func result(ctx sdk.Context, sender sdk.AccAddress, name string) *sdk.Result {
+	events := ctx.EventManager().Events()
+	ourEvent := sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(AttributeKeyName, name),
+	)
+	return &sdk.Result{
+		Events: append(events, ourEvent),
+	}
+}
@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fetchai/fetchd/x/aname/internal/types"
+)
+
+// InitGenesis stores the params and the names of the genesis
+func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
+	keeper.setParams(ctx, data.Params)
+	for _, r := range data.Names {
+		keeper.setName(ctx, r)
+	}
+}
+
+// ExportGenesis returns the params and the stored names as genesis state, the expired domains are kept until they
+// are registered again
+func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
+	data := types.GenesisState{Params: keeper.GetParams(ctx)}
+	keeper.IterateNames(ctx, func(r types.NameRecord) bool {
+		data.Names = append(data.Names, r)
+		return false
+	})
+	return data
+}
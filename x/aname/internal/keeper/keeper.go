@@ -0,0 +1,217 @@
+package keeper
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/params"
+
+	"github.com/fetchai/fetchd/x/aname/internal/types"
+)
+
+// Keeper keeps the registered names. Domains like alice.fet are registered under the top level domains for a fee
+// and expire, their subnames like bot.alice.fet are created by the domain owner and expire with the domain.
+type Keeper struct {
+	storeKey    sdk.StoreKey
+	cdc         *codec.Codec
+	paramSpace  params.Subspace
+	distrKeeper types.DistributionKeeper
+}
+
+// NewKeeper creates a new aname Keeper instance
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, paramSpace params.Subspace, distrKeeper types.DistributionKeeper) Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+	return Keeper{
+		storeKey:    storeKey,
+		cdc:         cdc,
+		paramSpace:  paramSpace,
+		distrKeeper: distrKeeper,
+	}
+}
+
+// GetParams returns the total set of aname parameters.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	var params types.Params
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+func (k Keeper) setParams(ctx sdk.Context, ps types.Params) {
+	k.paramSpace.SetParamSet(ctx, &ps)
+}
+
+// Register registers the name for the owner. A domain must be free or expired and is paid for by the owner, the
+// subnames of its earlier registration are deleted. A subname must be free and its parent must be owned by the owner.
+func (k Keeper) Register(ctx sdk.Context, msg types.MsgRegisterName) (types.NameRecord, error) {
+	if k.Resolve(ctx, msg.Name) != nil {
+		return types.NameRecord{}, sdkerrors.Wrap(types.ErrNameTaken, msg.Name)
+	}
+	record := types.NameRecord{Name: msg.Name, Owner: msg.Owner, Address: msg.Address, PubKey: msg.PubKey}
+	if record.Address.Empty() {
+		record.Address = msg.Owner
+	}
+	parent := types.ParentName(msg.Name)
+	if types.IsDomain(msg.Name) {
+		params := k.GetParams(ctx)
+		if !params.IsTopLevelDomain(parent) {
+			return types.NameRecord{}, sdkerrors.Wrapf(types.ErrInvalidName, "unknown top level domain %s", parent)
+		}
+		if err := k.payFee(ctx, msg.Owner, params); err != nil {
+			return types.NameRecord{}, err
+		}
+		k.deleteSubnames(ctx, msg.Name)
+		record.ExpiryHeight = ctx.BlockHeight() + params.RegistrationPeriod
+	} else {
+		parentRecord := k.Resolve(ctx, parent)
+		if parentRecord == nil {
+			return types.NameRecord{}, sdkerrors.Wrap(types.ErrNameNotFound, parent)
+		}
+		if !parentRecord.Owner.Equals(msg.Owner) {
+			return types.NameRecord{}, sdkerrors.Wrap(types.ErrNotOwner, parent)
+		}
+	}
+	k.setName(ctx, record)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRegister,
+		sdk.NewAttribute(types.AttributeKeyName, record.Name),
+		sdk.NewAttribute(types.AttributeKeyOwner, record.Owner.String()),
+		sdk.NewAttribute(types.AttributeKeyExpiryHeight, strconv.FormatInt(record.ExpiryHeight, 10)),
+	))
+	return record, nil
+}
+
+// Renew extends the registration of the active domain by the registration period, paid for by the sender
+func (k Keeper) Renew(ctx sdk.Context, sender sdk.AccAddress, name string) (types.NameRecord, error) {
+	record := k.Resolve(ctx, name)
+	if record == nil {
+		return types.NameRecord{}, sdkerrors.Wrap(types.ErrNameNotFound, name)
+	}
+	if !types.IsDomain(name) {
+		return types.NameRecord{}, sdkerrors.Wrap(types.ErrInvalidName, "only domains are renewed")
+	}
+	params := k.GetParams(ctx)
+	if err := k.payFee(ctx, sender, params); err != nil {
+		return types.NameRecord{}, err
+	}
+	record.ExpiryHeight += params.RegistrationPeriod
+	k.setName(ctx, *record)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRenew,
+		sdk.NewAttribute(types.AttributeKeyName, record.Name),
+		sdk.NewAttribute(types.AttributeKeyExpiryHeight, strconv.FormatInt(record.ExpiryHeight, 10)),
+	))
+	return *record, nil
+}
+
+// Transfer transfers the active name of the owner to the new owner. The subnames keep their owners.
+func (k Keeper) Transfer(ctx sdk.Context, owner sdk.AccAddress, name string, newOwner sdk.AccAddress) error {
+	record, err := k.ownedName(ctx, owner, name)
+	if err != nil {
+		return err
+	}
+	record.Owner = newOwner
+	k.setName(ctx, *record)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeTransfer,
+		sdk.NewAttribute(types.AttributeKeyName, record.Name),
+		sdk.NewAttribute(types.AttributeKeyOwner, newOwner.String()),
+	))
+	return nil
+}
+
+// SetTarget sets the address and the optional public key the active name of the owner resolves to
+func (k Keeper) SetTarget(ctx sdk.Context, owner sdk.AccAddress, name string, address sdk.AccAddress, pubKey string) error {
+	record, err := k.ownedName(ctx, owner, name)
+	if err != nil {
+		return err
+	}
+	record.Address = address
+	record.PubKey = pubKey
+	k.setName(ctx, *record)
+	return nil
+}
+
+// Resolve returns the record of the name, nil when it is not registered or its domain expired
+func (k Keeper) Resolve(ctx sdk.Context, name string) *types.NameRecord {
+	record := k.GetName(ctx, name)
+	if record == nil {
+		return nil
+	}
+	domain := record
+	if !types.IsDomain(name) {
+		labels := strings.Split(name, ".")
+		if domain = k.GetName(ctx, strings.Join(labels[len(labels)-2:], ".")); domain == nil {
+			return nil
+		}
+	}
+	if ctx.BlockHeight() >= domain.ExpiryHeight {
+		return nil
+	}
+	return record
+}
+
+func (k Keeper) ownedName(ctx sdk.Context, owner sdk.AccAddress, name string) (*types.NameRecord, error) {
+	record := k.Resolve(ctx, name)
+	if record == nil {
+		return nil, sdkerrors.Wrap(types.ErrNameNotFound, name)
+	}
+	if !record.Owner.Equals(owner) {
+		return nil, sdkerrors.Wrap(types.ErrNotOwner, name)
+	}
+	return record, nil
+}
+
+func (k Keeper) payFee(ctx sdk.Context, sender sdk.AccAddress, params types.Params) error {
+	if params.RegistrationFee.IsZero() {
+		return nil
+	}
+	return sdkerrors.Wrap(k.distrKeeper.FundCommunityPool(ctx, params.RegistrationFee, sender), "registration fee")
+}
+
+// GetName returns the stored record of the name, also when its domain expired, nil when there is none
+func (k Keeper) GetName(ctx sdk.Context, name string) *types.NameRecord {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetNameKey(name))
+	if bz == nil {
+		return nil
+	}
+	var record types.NameRecord
+	k.cdc.MustUnmarshalBinaryBare(bz, &record)
+	return &record
+}
+
+func (k Keeper) setName(ctx sdk.Context, record types.NameRecord) {
+	ctx.KVStore(k.storeKey).Set(types.GetNameKey(record.Name), k.cdc.MustMarshalBinaryBare(record))
+}
+
+func (k Keeper) deleteSubnames(ctx sdk.Context, name string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetSubnamesPrefix(name))
+	iter := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// IterateNames calls cb for all stored records, including the expired ones, until cb returns true. The subnames of a
+// domain follow the domain.
+func (k Keeper) IterateNames(ctx sdk.Context, cb func(types.NameRecord) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.NamePrefix).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var record types.NameRecord
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &record)
+		if cb(record) {
+			return
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/fetchai/fetchd/testutil"
+	"github.com/fetchai/fetchd/x/aname/internal/types"
+)
+
+var (
+	alice = sdk.AccAddress([]byte("alice_______________"))
+	bob   = sdk.AccAddress([]byte("bob_________________"))
+)
+
+func fet(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("afet", amount)) }
+
+func setupKeeper(t *testing.T) (sdk.Context, Keeper, *mockDistrKeeper) {
+	cdc := codec.New()
+	types.RegisterCodec(cdc)
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	ctx, paramsKeeper := testutil.NewContext(t, cdc, abci.Header{Height: 10}, key)
+	dk := &mockDistrKeeper{balances: map[string]sdk.Coins{alice.String(): fet(100), bob.String(): fet(100)}}
+	k := NewKeeper(cdc, key, paramsKeeper.Subspace(types.DefaultParamspace), dk)
+	p := types.Params{TopLevelDomains: []string{"fet"}, RegistrationFee: fet(10), RegistrationPeriod: 100}
+	InitGenesis(ctx, k, types.GenesisState{Params: p})
+	return ctx, k, dk
+}
+
+func register(owner sdk.AccAddress, name string) types.MsgRegisterName {
+	return types.MsgRegisterName{Owner: owner, Name: name}
+}
+
+func TestRegister(t *testing.T) {
+	specs := map[string]struct {
+		setup      []types.MsgRegisterName
+		src        types.MsgRegisterName
+		exp        types.NameRecord
+		expBalance sdk.Coins
+		expErr     *sdkerrors.Error
+	}{
+		"domain": {
+			src:        register(alice, "alice.fet"),
+			exp:        types.NameRecord{Name: "alice.fet", Owner: alice, Address: alice, ExpiryHeight: 110},
+			expBalance: fet(90),
+		},
+		"domain with target": {
+			src:        types.MsgRegisterName{Owner: alice, Name: "alice.fet", Address: bob},
+			exp:        types.NameRecord{Name: "alice.fet", Owner: alice, Address: bob, ExpiryHeight: 110},
+			expBalance: fet(90),
+		},
+		"subname": {
+			setup:      []types.MsgRegisterName{register(alice, "alice.fet")},
+			src:        register(alice, "bot.alice.fet"),
+			exp:        types.NameRecord{Name: "bot.alice.fet", Owner: alice, Address: alice},
+			expBalance: fet(90),
+		},
+		"unknown top level domain": {src: register(alice, "alice.eth"), expErr: types.ErrInvalidName},
+		"taken":                    {setup: []types.MsgRegisterName{register(bob, "alice.fet")}, src: register(alice, "alice.fet"), expErr: types.ErrNameTaken},
+		"subname of other owner": {
+			setup:  []types.MsgRegisterName{register(bob, "bob.fet")},
+			src:    register(alice, "bot.bob.fet"),
+			expErr: types.ErrNotOwner,
+		},
+		"subname without domain": {src: register(alice, "bot.alice.fet"), expErr: types.ErrNameNotFound},
+		"taken subname": {
+			setup:  []types.MsgRegisterName{register(alice, "alice.fet"), register(alice, "bot.alice.fet")},
+			src:    register(alice, "bot.alice.fet"),
+			expErr: types.ErrNameTaken,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, k, dk := setupKeeper(t)
+			for _, m := range spec.setup {
+				_, err := k.Register(ctx, m)
+				require.NoError(t, err)
+			}
+			record, err := k.Register(ctx, spec.src)
+			if spec.expErr != nil {
+				require.True(t, spec.expErr.Is(err), "got %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.exp, record)
+			assert.Equal(t, &spec.exp, k.Resolve(ctx, spec.exp.Name))
+			assert.Equal(t, spec.expBalance.String(), dk.balances[alice.String()].String())
+		})
+	}
+}
+
+func TestExpiry(t *testing.T) {
+	ctx, k, dk := setupKeeper(t)
+	_, err := k.Register(ctx, register(alice, "alice.fet"))
+	require.NoError(t, err)
+	_, err = k.Register(ctx, register(alice, "bot.alice.fet"))
+	require.NoError(t, err)
+
+	// anyone can renew a domain
+	renewed, err := k.Renew(ctx.WithBlockHeight(100), bob, "alice.fet")
+	require.NoError(t, err)
+	assert.Equal(t, int64(210), renewed.ExpiryHeight)
+	assert.Equal(t, fet(90).String(), dk.balances[bob.String()].String())
+	_, err = k.Renew(ctx, alice, "bot.alice.fet")
+	assert.True(t, types.ErrInvalidName.Is(err))
+
+	ctx = ctx.WithBlockHeight(209)
+	assert.NotNil(t, k.Resolve(ctx, "bot.alice.fet"))
+	ctx = ctx.WithBlockHeight(210)
+	assert.Nil(t, k.Resolve(ctx, "alice.fet"))
+	assert.Nil(t, k.Resolve(ctx, "bot.alice.fet"))
+	_, err = k.Renew(ctx, alice, "alice.fet")
+	assert.True(t, types.ErrNameNotFound.Is(err))
+
+	// the new owner of the expired domain gets none of the old subnames
+	_, err = k.Register(ctx, register(bob, "alice.fet"))
+	require.NoError(t, err)
+	assert.Nil(t, k.GetName(ctx, "bot.alice.fet"))
+	assert.Equal(t, []types.NameRecord{{Name: "alice.fet", Owner: bob, Address: bob, ExpiryHeight: 310}}, ExportGenesis(ctx, k).Names)
+}
+
+func TestTransferAndSetTarget(t *testing.T) {
+	ctx, k, _ := setupKeeper(t)
+	_, err := k.Register(ctx, register(alice, "alice.fet"))
+	require.NoError(t, err)
+
+	pubKey, err := sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	assert.True(t, types.ErrNotOwner.Is(k.SetTarget(ctx, bob, "alice.fet", bob, "")))
+	require.NoError(t, k.SetTarget(ctx, alice, "alice.fet", bob, pubKey))
+	assert.Equal(t, &types.NameRecord{Name: "alice.fet", Owner: alice, Address: bob, PubKey: pubKey, ExpiryHeight: 110}, k.Resolve(ctx, "alice.fet"))
+
+	assert.True(t, types.ErrNotOwner.Is(k.Transfer(ctx, bob, "alice.fet", bob)))
+	require.NoError(t, k.Transfer(ctx, alice, "alice.fet", bob))
+	assert.Equal(t, bob, k.Resolve(ctx, "alice.fet").Owner)
+	assert.True(t, types.ErrNotOwner.Is(k.Transfer(ctx, alice, "alice.fet", alice)))
+	assert.True(t, types.ErrNameNotFound.Is(k.Transfer(ctx, alice, "other.fet", alice)))
+
+	res, err := NewWasmQuerier(k)(ctx, []byte(`{"resolve":{"name":"alice.fet"}}`))
+	require.NoError(t, err)
+	assert.Contains(t, string(res), `"owner":"`+bob.String()+`"`)
+	_, err = NewWasmQuerier(k)(ctx, []byte(`{"resolve":{"name":"other.fet"}}`))
+	assert.True(t, types.ErrNameNotFound.Is(err))
+}
+
+type mockDistrKeeper struct {
+	balances map[string]sdk.Coins
+}
+
+func (m *mockDistrKeeper) FundCommunityPool(_ sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	balance, hasNeg := m.balances[sender.String()].SafeSub(amount)
+	if hasNeg {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	m.balances[sender.String()] = balance
+	m.balances["community_pool"] = m.balances["community_pool"].Add(amount...)
+	return nil
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+const (
+	// QueryParams returns the aname params
+	QueryParams = "params"
+	// QueryResolve returns the record of an active name, path: name
+	QueryResolve = "resolve"
+)
+
+// NewQuerier creates a new querier
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		switch path[0] {
+		case QueryParams:
+			return marshal(keeper.GetParams(ctx))
+		case QueryResolve:
+			if len(path) < 2 {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "name required")
+			}
+			record := keeper.Resolve(ctx, path[1])
+			if record == nil {
+				return []byte("null"), nil
+			}
+			return marshal(record)
+		default:
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown data query endpoint")
+		}
+	}
+}
+
+func marshal(v interface{}) ([]byte, error) {
+	bz, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
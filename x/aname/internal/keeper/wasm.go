@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/fetchai/fetchd/x/aname/internal/types"
+)
+
+// WasmQueryRoute is the custom query route of contracts for the name resolution
+const WasmQueryRoute = types.ModuleName
+
+// WasmQuery is the custom query of contracts, `{"aname":{"resolve":{"name":"alice.fet"}}}`
+type WasmQuery struct {
+	Resolve *WasmResolveQuery `json:"resolve,omitempty"`
+}
+
+// WasmResolveQuery resolves the name
+type WasmResolveQuery struct {
+	Name string `json:"name"`
+}
+
+// NewWasmQuerier returns the custom querier of contracts for the name resolution, to be registered with
+// WasmQueryRoute. The resolve query returns the name record json and fails for names that are not active.
+func NewWasmQuerier(keeper Keeper) func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var query WasmQuery
+		if err := json.Unmarshal(request, &query); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+		if query.Resolve == nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown aname query")
+		}
+		record := keeper.Resolve(ctx, query.Resolve.Name)
+		if record == nil {
+			return nil, sdkerrors.Wrap(types.ErrNameNotFound, query.Resolve.Name)
+		}
+		return json.Marshal(record)
+	}
+}
@@ -0,0 +1,23 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers the msg types of the aname module
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgRegisterName{}, "aname/MsgRegisterName", nil)
+	cdc.RegisterConcrete(MsgRenewName{}, "aname/MsgRenewName", nil)
+	cdc.RegisterConcrete(MsgTransferName{}, "aname/MsgTransferName", nil)
+	cdc.RegisterConcrete(MsgSetNameTarget{}, "aname/MsgSetNameTarget", nil)
+}
+
+// ModuleCdc generic sealed codec to be used throughout module
+var ModuleCdc *codec.Codec
+
+func init() {
+	cdc := codec.New()
+	RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	ModuleCdc = cdc.Seal()
+}
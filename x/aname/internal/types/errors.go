@@ -0,0 +1,19 @@
+package types
+
+import (
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Codes for aname errors
+var (
+	DefaultCodespace = ModuleName
+
+	// ErrInvalidName error for an invalid name
+	ErrInvalidName = sdkErrors.Register(DefaultCodespace, 1, "invalid name")
+	// ErrNameTaken error for the registration of an active name
+	ErrNameTaken = sdkErrors.Register(DefaultCodespace, 2, "name taken")
+	// ErrNameNotFound error for a name that is not registered or expired
+	ErrNameNotFound = sdkErrors.Register(DefaultCodespace, 3, "name not found")
+	// ErrNotOwner error for a change of a name by an account that does not own it
+	ErrNotOwner = sdkErrors.Register(DefaultCodespace, 4, "not the name owner")
+)
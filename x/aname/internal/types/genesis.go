@@ -0,0 +1,45 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GenesisState is the genesis state of the aname module
+type GenesisState struct {
+	Params Params       `json:"params"`
+	Names  []NameRecord `json:"names,omitempty"`
+}
+
+// DefaultGenesisState returns the genesis state without names
+func DefaultGenesisState() GenesisState {
+	return GenesisState{Params: DefaultParams()}
+}
+
+// ValidateGenesis performs basic validation of the genesis state. Every subname must have its domain in the genesis.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "params")
+	}
+	names := make(map[string]struct{}, len(data.Names))
+	for _, r := range data.Names {
+		if err := r.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "name %s", r.Name)
+		}
+		if _, exists := names[r.Name]; exists {
+			return sdkerrors.Wrapf(ErrInvalidName, "duplicate name %s", r.Name)
+		}
+		names[r.Name] = struct{}{}
+		if IsDomain(r.Name) != (r.ExpiryHeight > 0) {
+			return sdkerrors.Wrapf(ErrInvalidName, "domains need an expiry height, subnames none: %s", r.Name)
+		}
+	}
+	for _, r := range data.Names {
+		if IsDomain(r.Name) {
+			continue
+		}
+		if _, exists := names[ParentName(r.Name)]; !exists {
+			return sdkerrors.Wrapf(ErrInvalidName, "parent of %s not in genesis", r.Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"strings"
+)
+
+const (
+	// ModuleName is the name of the aname module
+	ModuleName = "aname"
+
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for the aname module
+	QuerierRoute = ModuleName
+
+	// RouterKey is the msg router key for the aname module
+	RouterKey = ModuleName
+)
+
+const ( // event attributes
+	AttributeKeyName         = "name"
+	AttributeKeyOwner        = "owner"
+	AttributeKeyExpiryHeight = "expiry_height"
+)
+
+const (
+	// EventTypeRegister is emitted when a name is registered
+	EventTypeRegister = "register_name"
+	// EventTypeRenew is emitted when a domain is renewed
+	EventTypeRenew = "renew_name"
+	// EventTypeTransfer is emitted when a name is transferred
+	EventTypeTransfer = "transfer_name"
+)
+
+// nolint
+var (
+	NamePrefix = []byte{0x01}
+)
+
+// GetNameKey returns the store key of the name. The labels are stored in reverse order, so that the subnames of a name
+// share the prefix of GetSubnamesPrefix.
+func GetNameKey(name string) []byte {
+	return append(NamePrefix, reverseLabels(name)...)
+}
+
+// GetSubnamesPrefix returns the store key prefix of all subnames of the name
+func GetSubnamesPrefix(name string) []byte {
+	return append(GetNameKey(name), '.')
+}
+
+func reverseLabels(name string) string {
+	labels := strings.Split(name, ".")
+	for i, j := 0, len(labels)-1; i < j; i, j = i+1, j-1 {
+		labels[i], labels[j] = labels[j], labels[i]
+	}
+	return strings.Join(labels, ".")
+}
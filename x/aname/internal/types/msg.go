@@ -0,0 +1,147 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MsgRegisterName registers a domain under a top level domain for a fee, or a subname of a domain of the owner. An
+// empty address resolves the name to the owner.
+type MsgRegisterName struct {
+	Owner   sdk.AccAddress `json:"owner" yaml:"owner"`
+	Name    string         `json:"name" yaml:"name"`
+	Address sdk.AccAddress `json:"address,omitempty" yaml:"address"`
+	PubKey  string         `json:"pub_key,omitempty" yaml:"pub_key"`
+}
+
+func (msg MsgRegisterName) Route() string {
+	return RouterKey
+}
+
+func (msg MsgRegisterName) Type() string {
+	return "register-name"
+}
+
+func (msg MsgRegisterName) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Owner); err != nil {
+		return sdkerrors.Wrap(err, "owner")
+	}
+	if err := ValidateName(msg.Name); err != nil {
+		return err
+	}
+	address := msg.Address
+	if address.Empty() {
+		address = msg.Owner
+	}
+	return ValidateTarget(address, msg.PubKey)
+}
+
+func (msg MsgRegisterName) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgRegisterName) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgRenewName extends the registration of a domain by the registration period for a fee, any account can renew it
+type MsgRenewName struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	Name   string         `json:"name" yaml:"name"`
+}
+
+func (msg MsgRenewName) Route() string {
+	return RouterKey
+}
+
+func (msg MsgRenewName) Type() string {
+	return "renew-name"
+}
+
+func (msg MsgRenewName) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if err := ValidateName(msg.Name); err != nil {
+		return err
+	}
+	if !IsDomain(msg.Name) {
+		return sdkerrors.Wrap(ErrInvalidName, "only domains are renewed, subnames expire with their domain")
+	}
+	return nil
+}
+
+func (msg MsgRenewName) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgRenewName) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgTransferName transfers the ownership of a name, the address it resolves to is unchanged
+type MsgTransferName struct {
+	Owner    sdk.AccAddress `json:"owner" yaml:"owner"`
+	Name     string         `json:"name" yaml:"name"`
+	NewOwner sdk.AccAddress `json:"new_owner" yaml:"new_owner"`
+}
+
+func (msg MsgTransferName) Route() string {
+	return RouterKey
+}
+
+func (msg MsgTransferName) Type() string {
+	return "transfer-name"
+}
+
+func (msg MsgTransferName) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Owner); err != nil {
+		return sdkerrors.Wrap(err, "owner")
+	}
+	if err := sdk.VerifyAddressFormat(msg.NewOwner); err != nil {
+		return sdkerrors.Wrap(err, "new owner")
+	}
+	return ValidateName(msg.Name)
+}
+
+func (msg MsgTransferName) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgTransferName) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgSetNameTarget sets the address and the optional public key a name resolves to
+type MsgSetNameTarget struct {
+	Owner   sdk.AccAddress `json:"owner" yaml:"owner"`
+	Name    string         `json:"name" yaml:"name"`
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	PubKey  string         `json:"pub_key,omitempty" yaml:"pub_key"`
+}
+
+func (msg MsgSetNameTarget) Route() string {
+	return RouterKey
+}
+
+func (msg MsgSetNameTarget) Type() string {
+	return "set-name-target"
+}
+
+func (msg MsgSetNameTarget) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Owner); err != nil {
+		return sdkerrors.Wrap(err, "owner")
+	}
+	if err := ValidateName(msg.Name); err != nil {
+		return err
+	}
+	return ValidateTarget(msg.Address, msg.PubKey)
+}
+
+func (msg MsgSetNameTarget) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgSetNameTarget) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"regexp"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxNameLength is the max length of a name, as for DNS names
+const MaxNameLength = 253
+
+// reLabel matches the labels of names, lower case DNS labels
+var reLabel = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// ValidateName validates a name of at least two labels, e.g. alice.fet or bot.alice.fet
+func ValidateName(name string) error {
+	if len(name) > MaxNameLength {
+		return sdkerrors.Wrapf(ErrInvalidName, "longer than %d", MaxNameLength)
+	}
+	labels := strings.Split(name, ".")
+	if len(labels) < 2 {
+		return sdkerrors.Wrapf(ErrInvalidName, "%q has no top level domain", name)
+	}
+	for _, l := range labels {
+		if !reLabel.MatchString(l) {
+			return sdkerrors.Wrapf(ErrInvalidName, "invalid label %q of %q", l, name)
+		}
+	}
+	return nil
+}
+
+// ParentName returns the name the name is registered under, the top level domain for domains like alice.fet
+func ParentName(name string) string {
+	return name[strings.Index(name, ".")+1:]
+}
+
+// IsDomain returns true when the name is registered directly under a top level domain, like alice.fet. Domains are
+// registered for a fee and expire, their subnames are created by the domain owner and expire with the domain.
+func IsDomain(name string) bool {
+	return strings.Count(name, ".") == 1
+}
+
+// NameRecord is a registered name with the address and the optional public key it resolves to
+type NameRecord struct {
+	Name  string         `json:"name" yaml:"name"`
+	Owner sdk.AccAddress `json:"owner" yaml:"owner"`
+	// Address is the account or contract the name resolves to
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	// PubKey is the bech32 account public key the name resolves to, e.g. of an agent, optional
+	PubKey string `json:"pub_key,omitempty" yaml:"pub_key"`
+	// ExpiryHeight is the height the domain expires at, subnames have none and expire with their domain
+	ExpiryHeight int64 `json:"expiry_height,omitempty" yaml:"expiry_height"`
+}
+
+// Validate validates the record, but not its expiry height
+func (r NameRecord) Validate() error {
+	if err := ValidateName(r.Name); err != nil {
+		return err
+	}
+	if err := sdk.VerifyAddressFormat(r.Owner); err != nil {
+		return sdkerrors.Wrap(err, "owner")
+	}
+	return ValidateTarget(r.Address, r.PubKey)
+}
+
+// ValidateTarget validates the address and the optional public key a name resolves to
+func ValidateTarget(address sdk.AccAddress, pubKey string) error {
+	if err := sdk.VerifyAddressFormat(address); err != nil {
+		return sdkerrors.Wrap(err, "address")
+	}
+	if pubKey != "" {
+		if _, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeAccPub, pubKey); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateName(t *testing.T) {
+	specs := map[string]struct {
+		src    string
+		expErr bool
+	}{
+		"domain":            {src: "alice.fet"},
+		"subname":           {src: "bot-1.alice.fet"},
+		"digits":            {src: "42.fet"},
+		"no top level":      {src: "alice", expErr: true},
+		"empty label":       {src: "alice..fet", expErr: true},
+		"trailing dot":      {src: "alice.fet.", expErr: true},
+		"upper case":        {src: "Alice.fet", expErr: true},
+		"leading hyphen":    {src: "-alice.fet", expErr: true},
+		"label too long":    {src: strings.Repeat("a", 64) + ".fet", expErr: true},
+		"name too long":     {src: strings.Repeat(strings.Repeat("a", 63)+".", 4) + "fet", expErr: true},
+		"invalid character": {src: "alice_bot.fet", expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := ValidateName(spec.src)
+			if spec.expErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestNameKeys(t *testing.T) {
+	assert.Equal(t, append(NamePrefix, "fet.alice.bot"...), GetNameKey("bot.alice.fet"))
+	assert.Equal(t, append(NamePrefix, "fet.alice."...), GetSubnamesPrefix("alice.fet"))
+	assert.Equal(t, "alice.fet", ParentName("bot.alice.fet"))
+	assert.Equal(t, "fet", ParentName("alice.fet"))
+	assert.True(t, IsDomain("alice.fet"))
+	assert.False(t, IsDomain("bot.alice.fet"))
+}
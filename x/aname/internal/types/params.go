@@ -0,0 +1,120 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// DefaultParamspace for params keeper
+	DefaultParamspace = ModuleName
+)
+
+var ParamStoreKeyTopLevelDomains = []byte("topLevelDomains")
+var ParamStoreKeyRegistrationFee = []byte("registrationFee")
+var ParamStoreKeyRegistrationPeriod = []byte("registrationPeriod")
+
+// Params defines the set of aname parameters. They are changed by param change proposals.
+type Params struct {
+	// TopLevelDomains are the labels anyone can register names under, e.g. alice.fet under fet
+	TopLevelDomains []string `json:"top_level_domains" yaml:"top_level_domains"`
+	// RegistrationFee is paid to the community pool for a registration or renewal of a domain, optional
+	RegistrationFee sdk.Coins `json:"registration_fee,omitempty" yaml:"registration_fee"`
+	// RegistrationPeriod is the number of blocks a registration or renewal of a domain lasts
+	RegistrationPeriod int64 `json:"registration_period" yaml:"registration_period"`
+}
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns default aname parameters, the domains are registered for about a year of 5s blocks
+func DefaultParams() Params {
+	return Params{
+		TopLevelDomains:    []string{"fet"},
+		RegistrationPeriod: 6307200,
+	}
+}
+
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(ParamStoreKeyTopLevelDomains, &p.TopLevelDomains, validateTopLevelDomains),
+		params.NewParamSetPair(ParamStoreKeyRegistrationFee, &p.RegistrationFee, validateRegistrationFee),
+		params.NewParamSetPair(ParamStoreKeyRegistrationPeriod, &p.RegistrationPeriod, validateRegistrationPeriod),
+	}
+}
+
+// ValidateBasic performs basic validation on aname parameters.
+func (p Params) ValidateBasic() error {
+	if err := validateTopLevelDomains(p.TopLevelDomains); err != nil {
+		return sdkerrors.Wrap(err, "top level domains")
+	}
+	if err := validateRegistrationFee(p.RegistrationFee); err != nil {
+		return sdkerrors.Wrap(err, "registration fee")
+	}
+	if err := validateRegistrationPeriod(p.RegistrationPeriod); err != nil {
+		return sdkerrors.Wrap(err, "registration period")
+	}
+	return nil
+}
+
+// IsTopLevelDomain returns true when names can be registered under the label
+func (p Params) IsTopLevelDomain(label string) bool {
+	for _, d := range p.TopLevelDomains {
+		if d == label {
+			return true
+		}
+	}
+	return false
+}
+
+func validateTopLevelDomains(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]struct{}, len(v))
+	for _, d := range v {
+		if !reLabel.MatchString(d) {
+			return sdkerrors.Wrapf(ErrInvalidName, "invalid label %q", d)
+		}
+		if _, exists := seen[d]; exists {
+			return sdkerrors.Wrapf(ErrInvalidName, "duplicate %s", d)
+		}
+		seen[d] = struct{}{}
+	}
+	return nil
+}
+
+func validateRegistrationFee(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if !v.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, v.String())
+	}
+	return nil
+}
+
+func validateRegistrationPeriod(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v <= 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "must be positive")
+	}
+	return nil
+}
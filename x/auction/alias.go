@@ -0,0 +1,65 @@
+package auction
+
+import (
+	"github.com/fetchai/fetchd/x/auction/internal/keeper"
+	"github.com/fetchai/fetchd/x/auction/internal/types"
+)
+
+const (
+	ModuleName            = types.ModuleName
+	StoreKey              = types.StoreKey
+	QuerierRoute          = types.QuerierRoute
+	RouterKey             = types.RouterKey
+	DefaultParamspace     = types.DefaultParamspace
+	MaxSaltLength         = types.MaxSaltLength
+	MaxDescriptionLength  = types.MaxDescriptionLength
+	AttributeKeyAuctionID = types.AttributeKeyAuctionID
+	AttributeKeyBidder    = types.AttributeKeyBidder
+	AttributeKeyWinner    = types.AttributeKeyWinner
+	AttributeKeySlashed   = types.AttributeKeySlashed
+	EventTypeCreate       = types.EventTypeCreate
+	EventTypeCommit       = types.EventTypeCommit
+	EventTypeReveal       = types.EventTypeReveal
+	EventTypeSettle       = types.EventTypeSettle
+	QueryParams           = keeper.QueryParams
+	QueryAuction          = keeper.QueryAuction
+	QueryBids             = keeper.QueryBids
+	WasmMsgRoute          = keeper.WasmMsgRoute
+	WasmQueryRoute        = keeper.WasmQueryRoute
+	WasmMsgGasLimit       = keeper.WasmMsgGasLimit
+)
+
+var (
+	// functions aliases
+	RegisterCodec       = types.RegisterCodec
+	ValidateGenesis     = types.ValidateGenesis
+	DefaultGenesisState = types.DefaultGenesisState
+	DefaultParams       = types.DefaultParams
+	Commitment          = types.Commitment
+	NewKeeper           = keeper.NewKeeper
+	NewQuerier          = keeper.NewQuerier
+	NewWasmEncoder      = keeper.NewWasmEncoder
+	NewWasmQuerier      = keeper.NewWasmQuerier
+	InitGenesis         = keeper.InitGenesis
+	ExportGenesis       = keeper.ExportGenesis
+
+	// variable aliases
+	ModuleCdc            = types.ModuleCdc
+	ErrAuctionNotFound   = types.ErrAuctionNotFound
+	ErrWrongPhase        = types.ErrWrongPhase
+	ErrInvalidCommitment = types.ErrInvalidCommitment
+	ErrBidNotFound       = types.ErrBidNotFound
+	ErrInvalidBid        = types.ErrInvalidBid
+	ErrInvalidPeriod     = types.ErrInvalidPeriod
+)
+
+type (
+	Keeper           = keeper.Keeper
+	GenesisState     = types.GenesisState
+	Params           = types.Params
+	Auction          = types.Auction
+	Bid              = types.Bid
+	MsgCreateAuction = types.MsgCreateAuction
+	MsgCommitBid     = types.MsgCommitBid
+	MsgRevealBid     = types.MsgRevealBid
+)
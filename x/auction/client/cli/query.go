@@ -0,0 +1,109 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/fetchai/fetchd/x/auction/internal/keeper"
+	"github.com/fetchai/fetchd/x/auction/internal/types"
+)
+
+// GetQueryCmd returns the query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the sealed-bid auctions",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(flags.GetCommands(
+		GetCmdQueryParams(cdc),
+		GetCmdQueryAuction(cdc),
+		GetCmdQueryBids(cdc),
+	)...)
+	return queryCmd
+}
+
+// GetCmdQueryParams shows the auction params
+func GetCmdQueryParams(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "Show the min and max period of the commit and reveal phases",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, keeper.QueryParams))
+			if err != nil {
+				return err
+			}
+			var params types.Params
+			if err := json.Unmarshal(res, &params); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
+
+// GetCmdQueryAuction shows an auction
+func GetCmdQueryAuction(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "auction [auction_id]",
+		Short: "Show the owner, the phases and, once settled, the winner of an auction",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("auction id: %s", err)
+			}
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%d", types.QuerierRoute, keeper.QueryAuction, id))
+			if err != nil {
+				return err
+			}
+			var auction *types.Auction
+			if err := json.Unmarshal(res, &auction); err != nil {
+				return err
+			}
+			if auction == nil {
+				return sdkerrors.Wrapf(types.ErrAuctionNotFound, "%d", id)
+			}
+			return cliCtx.PrintOutput(auction)
+		},
+	}
+}
+
+// GetCmdQueryBids lists the bids of an auction
+func GetCmdQueryBids(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "bids [auction_id]",
+		Short: "List the committed and revealed bids of an unsettled auction",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("auction id: %s", err)
+			}
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%d", types.QuerierRoute, keeper.QueryBids, id))
+			if err != nil {
+				return err
+			}
+			var bids []types.Bid
+			if err := json.Unmarshal(res, &bids); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(bids)
+		},
+	}
+}
@@ -0,0 +1,158 @@
+package cli
+
+import (
+	"bufio"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	"github.com/fetchai/fetchd/x/auction/internal/types"
+	wasmUtils "github.com/fetchai/fetchd/x/wasm/client/utils"
+)
+
+const flagDescription = "description"
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Sealed-bid auction transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	txCmd.AddCommand(wasmUtils.AddBroadcastFlags(flags.PostCommands(
+		CreateAuctionCmd(cdc),
+		CommitBidCmd(cdc),
+		RevealBidCmd(cdc),
+	)...)...)
+	return txCmd
+}
+
+// CreateAuctionCmd creates an auction of the --from account
+func CreateAuctionCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create [min_bid] [deposit] [commit_blocks] [reveal_blocks]",
+		Short: "Create a sealed-bid auction of the --from account",
+		Long: `Create an auction with bids in the denom of the min bid. Bidders commit to their bids with the deposit for
+commit blocks and reveal them in the following reveal blocks. The highest revealed bid and the deposits of the bids
+that are not revealed are paid to the --from account. The id of the auction is in the create_auction event. The
+periods must be within the min and max period params.`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			minBid, err := sdk.ParseCoin(args[0])
+			if err != nil {
+				return fmt.Errorf("min bid: %s", err)
+			}
+			deposit, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return fmt.Errorf("deposit: %s", err)
+			}
+			commitBlocks, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("commit blocks: %s", err)
+			}
+			revealBlocks, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return fmt.Errorf("reveal blocks: %s", err)
+			}
+			msg := types.MsgCreateAuction{
+				Owner:        cliCtx.GetFromAddress(),
+				Description:  viper.GetString(flagDescription),
+				MinBid:       minBid,
+				Deposit:      deposit,
+				CommitBlocks: commitBlocks,
+				RevealBlocks: revealBlocks,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(flagDescription, "", "Description of the auctioned item")
+	return cmd
+}
+
+// CommitBidCmd commits the --from account to a bid
+func CommitBidCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "commit [auction_id] [amount] [salt_hex]",
+		Short: "Commit the --from account to a bid in an auction, paying the deposit of the auction",
+		Long: `Commit to the bid with its hash, the amount and the salt are not sent. The salt must be random, e.g. from
+"openssl rand -hex 32", and kept with the amount for the reveal: the deposit is lost when the bid is not revealed.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			id, amount, salt, err := parseBid(args)
+			if err != nil {
+				return err
+			}
+			bidder := cliCtx.GetFromAddress()
+			msg := types.MsgCommitBid{Bidder: bidder, AuctionID: id, Commitment: types.Commitment(id, bidder, amount, salt)}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// RevealBidCmd reveals the bid of the --from account
+func RevealBidCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "reveal [auction_id] [amount] [salt_hex]",
+		Short: "Reveal the committed bid of the --from account in an auction, paying the amount and refunding the deposit",
+		Long:  "Reveal the bid after the commit phase. The amount is refunded when the bid does not win.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			id, amount, salt, err := parseBid(args)
+			if err != nil {
+				return err
+			}
+			msg := types.MsgRevealBid{Bidder: cliCtx.GetFromAddress(), AuctionID: id, Amount: amount, Salt: salt}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func parseBid(args []string) (uint64, sdk.Coin, []byte, error) {
+	id, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return 0, sdk.Coin{}, nil, fmt.Errorf("auction id: %s", err)
+	}
+	amount, err := sdk.ParseCoin(args[1])
+	if err != nil {
+		return 0, sdk.Coin{}, nil, fmt.Errorf("amount: %s", err)
+	}
+	salt, err := hex.DecodeString(args[2])
+	if err != nil {
+		return 0, sdk.Coin{}, nil, fmt.Errorf("salt: %s", err)
+	}
+	return id, amount, salt, nil
+}
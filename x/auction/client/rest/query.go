@@ -0,0 +1,54 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/fetchai/fetchd/x/auction/internal/keeper"
+	"github.com/fetchai/fetchd/x/auction/internal/types"
+)
+
+func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/auction/params", queryHandlerFn(cliCtx, keeper.QueryParams)).Methods("GET")
+	r.HandleFunc("/auction/auctions/{id}", queryAuctionHandlerFn(cliCtx, keeper.QueryAuction)).Methods("GET")
+	r.HandleFunc("/auction/auctions/{id}/bids", queryAuctionHandlerFn(cliCtx, keeper.QueryBids)).Methods("GET")
+}
+
+func queryHandlerFn(cliCtx context.CLIContext, path string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query(w, r, cliCtx, path)
+	}
+}
+
+func queryAuctionHandlerFn(cliCtx context.CLIContext, path string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		query(w, r, cliCtx, fmt.Sprintf("%s/%d", path, id))
+	}
+}
+
+func query(w http.ResponseWriter, r *http.Request, cliCtx context.CLIContext, path string) {
+	cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+	if !ok {
+		return
+	}
+
+	res, height, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, path))
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	cliCtx = cliCtx.WithHeight(height)
+	rest.PostProcessResponse(w, cliCtx, json.RawMessage(res))
+}
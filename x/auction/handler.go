@@ -0,0 +1,63 @@
+package auction
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewHandler returns a handler for "auction" type messages.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case MsgCreateAuction:
+			return handleCreateAuction(ctx, k, &msg)
+		case MsgCommitBid:
+			return handleCommitBid(ctx, k, &msg)
+		case MsgRevealBid:
+			return handleRevealBid(ctx, k, &msg)
+		default:
+			errMsg := fmt.Sprintf("unrecognized auction message type: %T", msg)
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+		}
+	}
+}
+
+func handleCreateAuction(ctx sdk.Context, k Keeper, msg *MsgCreateAuction) (*sdk.Result, error) {
+	auction, err := k.Create(ctx, *msg)
+	if err != nil {
+		return nil, err
+	}
+	return result(ctx, msg.Owner, auction.ID), nil
+}
+
+func handleCommitBid(ctx sdk.Context, k Keeper, msg *MsgCommitBid) (*sdk.Result, error) {
+	if err := k.Commit(ctx, msg.Bidder, msg.AuctionID, msg.Commitment); err != nil {
+		return nil, err
+	}
+	return result(ctx, msg.Bidder, msg.AuctionID), nil
+}
+
+func handleRevealBid(ctx sdk.Context, k Keeper, msg *MsgRevealBid) (*sdk.Result, error) {
+	if err := k.Reveal(ctx, msg.Bidder, msg.AuctionID, msg.Amount, msg.Salt); err != nil {
+		return nil, err
+	}
+	return result(ctx, msg.Bidder, msg.AuctionID), nil
+}
+
+func result(ctx sdk.Context, sender sdk.AccAddress, id uint64) *sdk.Result {
+	events := ctx.EventManager().Events()
+	ourEvent := sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(AttributeKeyAuctionID, strconv.FormatUint(id, 10)),
+	)
+	return &sdk.Result{
+		Events: append(events, ourEvent),
+	}
+}
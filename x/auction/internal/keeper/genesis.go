@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fetchai/fetchd/x/auction/internal/types"
+)
+
+// InitGenesis stores the params, the last auction id, the auctions and the bids of the genesis. The unsettled auctions
+// are queued for settlement.
+func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
+	keeper.setParams(ctx, data.Params)
+	keeper.setLastAuctionID(ctx, data.LastAuctionID)
+	store := ctx.KVStore(keeper.storeKey)
+	for _, a := range data.Auctions {
+		keeper.setAuction(ctx, a)
+		if !a.Settled {
+			store.Set(types.GetSettleQueueKey(a.RevealEndHeight, a.ID), []byte{})
+		}
+	}
+	for _, b := range data.Bids {
+		keeper.setBid(ctx, b)
+	}
+}
+
+// ExportGenesis returns the params, the last auction id, the auctions and the bids of the unsettled auctions as
+// genesis state
+func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
+	data := types.GenesisState{Params: keeper.GetParams(ctx), LastAuctionID: keeper.GetLastAuctionID(ctx)}
+	keeper.IterateAuctions(ctx, func(a types.Auction) bool {
+		data.Auctions = append(data.Auctions, a)
+		if !a.Settled {
+			data.Bids = append(data.Bids, keeper.GetBids(ctx, a.ID)...)
+		}
+		return false
+	})
+	return data
+}
@@ -0,0 +1,293 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/params"
+
+	"github.com/fetchai/fetchd/x/auction/internal/types"
+)
+
+// Keeper keeps the sealed-bid auctions and their bids. The deposits of the committed bids and the amounts of the
+// revealed bids are held by the module account until the auction is settled.
+type Keeper struct {
+	storeKey     sdk.StoreKey
+	cdc          *codec.Codec
+	paramSpace   params.Subspace
+	supplyKeeper types.SupplyKeeper
+}
+
+// NewKeeper creates a new auction Keeper instance
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, paramSpace params.Subspace, supplyKeeper types.SupplyKeeper) Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+	return Keeper{
+		storeKey:     storeKey,
+		cdc:          cdc,
+		paramSpace:   paramSpace,
+		supplyKeeper: supplyKeeper,
+	}
+}
+
+// GetParams returns the total set of auction parameters.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	var params types.Params
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+func (k Keeper) setParams(ctx sdk.Context, ps types.Params) {
+	k.paramSpace.SetParamSet(ctx, &ps)
+}
+
+// Create creates an auction of the owner and returns it. The commit and the reveal phase must be within the bounds of
+// the params.
+func (k Keeper) Create(ctx sdk.Context, msg types.MsgCreateAuction) (types.Auction, error) {
+	params := k.GetParams(ctx)
+	for _, blocks := range []int64{msg.CommitBlocks, msg.RevealBlocks} {
+		if blocks < params.MinPeriod || blocks > params.MaxPeriod {
+			return types.Auction{}, sdkerrors.Wrapf(types.ErrInvalidPeriod, "must be %d to %d blocks", params.MinPeriod, params.MaxPeriod)
+		}
+	}
+	auction := types.Auction{
+		ID:              k.GetLastAuctionID(ctx) + 1,
+		Owner:           msg.Owner,
+		Description:     msg.Description,
+		MinBid:          msg.MinBid,
+		Deposit:         msg.Deposit,
+		CommitEndHeight: ctx.BlockHeight() + msg.CommitBlocks,
+		RevealEndHeight: ctx.BlockHeight() + msg.CommitBlocks + msg.RevealBlocks,
+	}
+	k.setLastAuctionID(ctx, auction.ID)
+	k.setAuction(ctx, auction)
+	ctx.KVStore(k.storeKey).Set(types.GetSettleQueueKey(auction.RevealEndHeight, auction.ID), []byte{})
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCreate,
+		sdk.NewAttribute(types.AttributeKeyAuctionID, strconv.FormatUint(auction.ID, 10)),
+		sdk.NewAttribute(sdk.AttributeKeySender, auction.Owner.String()),
+	))
+	return auction, nil
+}
+
+// Commit stores the commitment of the bidder in the auction during the commit phase. The deposit of the auction is
+// paid into the module account with the first commitment, a new commitment replaces the previous one.
+func (k Keeper) Commit(ctx sdk.Context, bidder sdk.AccAddress, id uint64, commitment []byte) error {
+	auction := k.GetAuction(ctx, id)
+	if auction == nil {
+		return sdkerrors.Wrapf(types.ErrAuctionNotFound, "%d", id)
+	}
+	if auction.Settled || ctx.BlockHeight() >= auction.CommitEndHeight {
+		return sdkerrors.Wrapf(types.ErrWrongPhase, "commit phase ended at height %d", auction.CommitEndHeight)
+	}
+	bid := k.GetBid(ctx, id, bidder)
+	if bid == nil {
+		if !auction.Deposit.IsZero() {
+			if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, bidder, types.ModuleName, auction.Deposit); err != nil {
+				return err
+			}
+		}
+		bid = &types.Bid{AuctionID: id, Bidder: bidder, Deposit: auction.Deposit}
+	}
+	bid.Commitment = commitment
+	k.setBid(ctx, *bid)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCommit,
+		sdk.NewAttribute(types.AttributeKeyAuctionID, strconv.FormatUint(id, 10)),
+		sdk.NewAttribute(types.AttributeKeyBidder, bidder.String()),
+	))
+	return nil
+}
+
+// Reveal opens the commitment of the bidder during the reveal phase. The amount is paid into the module account and
+// the deposit is refunded. The amount must be of the denom of the min bid and not below it.
+func (k Keeper) Reveal(ctx sdk.Context, bidder sdk.AccAddress, id uint64, amount sdk.Coin, salt []byte) error {
+	auction := k.GetAuction(ctx, id)
+	if auction == nil {
+		return sdkerrors.Wrapf(types.ErrAuctionNotFound, "%d", id)
+	}
+	if auction.Settled || ctx.BlockHeight() < auction.CommitEndHeight || ctx.BlockHeight() >= auction.RevealEndHeight {
+		return sdkerrors.Wrapf(types.ErrWrongPhase, "reveal phase is from height %d to %d", auction.CommitEndHeight, auction.RevealEndHeight)
+	}
+	bid := k.GetBid(ctx, id, bidder)
+	if bid == nil {
+		return sdkerrors.Wrap(types.ErrBidNotFound, bidder.String())
+	}
+	if bid.Revealed() {
+		return sdkerrors.Wrap(types.ErrWrongPhase, "already revealed")
+	}
+	if !bid.Opens(amount, salt) {
+		return sdkerrors.Wrap(types.ErrInvalidCommitment, "amount and salt do not match")
+	}
+	if amount.Denom != auction.MinBid.Denom || amount.IsLT(auction.MinBid) {
+		return sdkerrors.Wrapf(types.ErrInvalidBid, "must be at least %s", auction.MinBid)
+	}
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, bidder, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+	if !bid.Deposit.IsZero() {
+		if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bidder, bid.Deposit); err != nil {
+			return err
+		}
+	}
+	bid.Amount = &amount
+	bid.RevealHeight = ctx.BlockHeight()
+	k.setBid(ctx, *bid)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeReveal,
+		sdk.NewAttribute(types.AttributeKeyAuctionID, strconv.FormatUint(id, 10)),
+		sdk.NewAttribute(types.AttributeKeyBidder, bidder.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+	))
+	return nil
+}
+
+// EndBlocker settles the auctions whose reveal phase ends at or before the block height
+func (k Keeper) EndBlocker(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SettleQueuePrefix)
+	iter := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight()+1)))
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		store.Delete(key)
+		// the key is the reveal end height followed by the auction id
+		auction := k.GetAuction(ctx, binary.BigEndian.Uint64(key[8:]))
+		if auction == nil || auction.Settled {
+			continue
+		}
+		if err := k.settle(ctx, *auction); err != nil {
+			// the module account holds the deposits and the revealed bids of all auctions
+			panic(err)
+		}
+	}
+}
+
+// settle pays the highest revealed bid and the deposits of the bids that were not revealed to the owner, refunds the
+// other revealed bids and deletes the bids. Of equal bids the one revealed first wins. The settled auction is kept
+// with the winner for the contracts and clients that query the result.
+func (k Keeper) settle(ctx sdk.Context, auction types.Auction) error {
+	bids := k.GetBids(ctx, auction.ID)
+	var winner *types.Bid
+	slashed := sdk.NewCoins()
+	for i, b := range bids {
+		switch {
+		case !b.Revealed():
+			slashed = slashed.Add(b.Deposit...)
+		case winner == nil || winner.Amount.IsLT(*b.Amount) || (winner.Amount.IsEqual(*b.Amount) && b.RevealHeight < winner.RevealHeight):
+			winner = &bids[i]
+		}
+	}
+	for _, b := range bids {
+		k.deleteBid(ctx, b)
+		if !b.Revealed() || b.Bidder.Equals(winner.Bidder) {
+			continue
+		}
+		if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, b.Bidder, sdk.NewCoins(*b.Amount)); err != nil {
+			return err
+		}
+	}
+	pay := slashed
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyAuctionID, strconv.FormatUint(auction.ID, 10)),
+		sdk.NewAttribute(types.AttributeKeySlashed, slashed.String()),
+	}
+	if winner != nil {
+		pay = pay.Add(*winner.Amount)
+		auction.Winner = winner.Bidder
+		auction.WinningBid = winner.Amount
+		attrs = append(attrs,
+			sdk.NewAttribute(types.AttributeKeyWinner, winner.Bidder.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, winner.Amount.String()),
+		)
+	}
+	if !pay.IsZero() {
+		if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, auction.Owner, pay); err != nil {
+			return err
+		}
+	}
+	auction.Settled = true
+	k.setAuction(ctx, auction)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeSettle, attrs...))
+	return nil
+}
+
+// GetLastAuctionID returns the id of the last created auction, 0 when none was created
+func (k Keeper) GetLastAuctionID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.LastAuctionIDKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setLastAuctionID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.LastAuctionIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetAuction returns the auction, nil when there is none
+func (k Keeper) GetAuction(ctx sdk.Context, id uint64) *types.Auction {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetAuctionKey(id))
+	if bz == nil {
+		return nil
+	}
+	var auction types.Auction
+	k.cdc.MustUnmarshalBinaryBare(bz, &auction)
+	return &auction
+}
+
+func (k Keeper) setAuction(ctx sdk.Context, auction types.Auction) {
+	ctx.KVStore(k.storeKey).Set(types.GetAuctionKey(auction.ID), k.cdc.MustMarshalBinaryBare(auction))
+}
+
+// GetBid returns the bid of the bidder in the auction, nil when there is none
+func (k Keeper) GetBid(ctx sdk.Context, id uint64, bidder sdk.AccAddress) *types.Bid {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetBidKey(id, bidder))
+	if bz == nil {
+		return nil
+	}
+	var bid types.Bid
+	k.cdc.MustUnmarshalBinaryBare(bz, &bid)
+	return &bid
+}
+
+func (k Keeper) setBid(ctx sdk.Context, bid types.Bid) {
+	ctx.KVStore(k.storeKey).Set(types.GetBidKey(bid.AuctionID, bid.Bidder), k.cdc.MustMarshalBinaryBare(bid))
+}
+
+func (k Keeper) deleteBid(ctx sdk.Context, bid types.Bid) {
+	ctx.KVStore(k.storeKey).Delete(types.GetBidKey(bid.AuctionID, bid.Bidder))
+}
+
+// GetBids returns the bids of the unsettled auction, ordered by bidder
+func (k Keeper) GetBids(ctx sdk.Context, id uint64) []types.Bid {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetBidsPrefix(id)).Iterator(nil, nil)
+	defer iter.Close()
+	bids := []types.Bid{}
+	for ; iter.Valid(); iter.Next() {
+		var bid types.Bid
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &bid)
+		bids = append(bids, bid)
+	}
+	return bids
+}
+
+// IterateAuctions calls cb for all auctions, including the settled ones, until cb returns true
+func (k Keeper) IterateAuctions(ctx sdk.Context, cb func(types.Auction) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionPrefix).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var auction types.Auction
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &auction)
+		if cb(auction) {
+			return
+		}
+	}
+}
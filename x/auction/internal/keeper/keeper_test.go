@@ -0,0 +1,208 @@
+package keeper
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/fetchai/fetchd/testutil"
+	"github.com/fetchai/fetchd/x/auction/internal/types"
+)
+
+var (
+	owner = sdk.AccAddress([]byte("owner_______________"))
+	alice = sdk.AccAddress([]byte("alice_______________"))
+	bob   = sdk.AccAddress([]byte("bob_________________"))
+	carol = sdk.AccAddress([]byte("carol_______________"))
+)
+
+func fet(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("afet", amount)) }
+
+func setupKeeper(t *testing.T) (sdk.Context, Keeper, *testutil.SupplyKeeper) {
+	cdc := codec.New()
+	types.RegisterCodec(cdc)
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	ctx, paramsKeeper := testutil.NewContext(t, cdc, abci.Header{Height: 10}, key)
+	sk := testutil.NewSupplyKeeper(map[string]sdk.Coins{alice.String(): fet(100), bob.String(): fet(100), carol.String(): fet(100)})
+	k := NewKeeper(cdc, key, paramsKeeper.Subspace(types.DefaultParamspace), sk)
+	InitGenesis(ctx, k, types.GenesisState{Params: types.Params{MinPeriod: 10, MaxPeriod: 100}})
+	return ctx, k, sk
+}
+
+// createAuction creates an auction with a min bid of 10afet and a deposit of 5afet, committing until height 20 and
+// revealing until height 30
+func createAuction(t *testing.T, ctx sdk.Context, k Keeper) types.Auction {
+	auction, err := k.Create(ctx, types.MsgCreateAuction{
+		Owner:        owner,
+		MinBid:       sdk.NewInt64Coin("afet", 10),
+		Deposit:      fet(5),
+		CommitBlocks: 10,
+		RevealBlocks: 10,
+	})
+	require.NoError(t, err)
+	return auction
+}
+
+func commitment(bidder sdk.AccAddress, amount int64) []byte {
+	return types.Commitment(1, bidder, sdk.NewInt64Coin("afet", amount), []byte("salt"))
+}
+
+func TestCreate(t *testing.T) {
+	ctx, k, _ := setupKeeper(t)
+	auction := createAuction(t, ctx, k)
+	assert.Equal(t, types.Auction{ID: 1, Owner: owner, MinBid: sdk.NewInt64Coin("afet", 10), Deposit: fet(5), CommitEndHeight: 20, RevealEndHeight: 30}, auction)
+	assert.Equal(t, &auction, k.GetAuction(ctx, 1))
+
+	_, err := k.Create(ctx, types.MsgCreateAuction{Owner: owner, MinBid: sdk.NewInt64Coin("afet", 1), CommitBlocks: 9, RevealBlocks: 10})
+	assert.True(t, types.ErrInvalidPeriod.Is(err))
+	_, err = k.Create(ctx, types.MsgCreateAuction{Owner: owner, MinBid: sdk.NewInt64Coin("afet", 1), CommitBlocks: 10, RevealBlocks: 101})
+	assert.True(t, types.ErrInvalidPeriod.Is(err))
+}
+
+func TestReveal(t *testing.T) {
+	specs := map[string]struct {
+		height     int64
+		bidder     sdk.AccAddress
+		amount     sdk.Coin
+		salt       string
+		expErr     *sdkerrors.Error
+		expBalance sdk.Coins
+	}{
+		"reveal":           {height: 20, bidder: alice, amount: sdk.NewInt64Coin("afet", 40), salt: "salt", expBalance: fet(60)},
+		"last block":       {height: 29, bidder: alice, amount: sdk.NewInt64Coin("afet", 40), salt: "salt", expBalance: fet(60)},
+		"commit phase":     {height: 19, bidder: alice, amount: sdk.NewInt64Coin("afet", 40), salt: "salt", expErr: types.ErrWrongPhase},
+		"after reveal end": {height: 30, bidder: alice, amount: sdk.NewInt64Coin("afet", 40), salt: "salt", expErr: types.ErrWrongPhase},
+		"other amount":     {height: 20, bidder: alice, amount: sdk.NewInt64Coin("afet", 41), salt: "salt", expErr: types.ErrInvalidCommitment},
+		"other salt":       {height: 20, bidder: alice, amount: sdk.NewInt64Coin("afet", 40), salt: "other", expErr: types.ErrInvalidCommitment},
+		"no commitment":    {height: 20, bidder: bob, amount: sdk.NewInt64Coin("afet", 40), salt: "salt", expErr: types.ErrBidNotFound},
+		"below min bid":    {height: 20, bidder: carol, amount: sdk.NewInt64Coin("afet", 9), salt: "salt", expErr: types.ErrInvalidBid},
+		"other denom":      {height: 20, bidder: carol, amount: sdk.NewInt64Coin("ustake", 40), salt: "salt", expErr: types.ErrInvalidBid},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, k, sk := setupKeeper(t)
+			createAuction(t, ctx, k)
+			require.NoError(t, k.Commit(ctx, alice, 1, commitment(alice, 40)))
+			require.NoError(t, k.Commit(ctx, carol, 1, types.Commitment(1, carol, spec.amount, []byte("salt"))))
+
+			err := k.Reveal(ctx.WithBlockHeight(spec.height), spec.bidder, 1, spec.amount, []byte(spec.salt))
+			if spec.expErr != nil {
+				require.True(t, spec.expErr.Is(err), "got %v", err)
+				assert.False(t, k.GetBid(ctx, 1, alice).Revealed())
+				return
+			}
+			require.NoError(t, err)
+			bid := k.GetBid(ctx, 1, alice)
+			assert.Equal(t, &spec.amount, bid.Amount)
+			assert.Equal(t, spec.height, bid.RevealHeight)
+			// the bid is escrowed and the deposit refunded
+			assert.Equal(t, spec.expBalance.String(), sk.Balances[alice.String()].String())
+			err = k.Reveal(ctx.WithBlockHeight(spec.height), alice, 1, spec.amount, []byte(spec.salt))
+			assert.True(t, types.ErrWrongPhase.Is(err))
+		})
+	}
+}
+
+func TestSettle(t *testing.T) {
+	ctx, k, sk := setupKeeper(t)
+	createAuction(t, ctx, k)
+	require.NoError(t, k.Commit(ctx, alice, 1, commitment(alice, 30)))
+	require.NoError(t, k.Commit(ctx, bob, 1, commitment(bob, 50)))
+	require.NoError(t, k.Commit(ctx, carol, 1, commitment(carol, 70)))
+	// a new commitment replaces the previous one without a new deposit
+	require.NoError(t, k.Commit(ctx, alice, 1, commitment(alice, 40)))
+	assert.Equal(t, fet(95).String(), sk.Balances[alice.String()].String())
+	assert.Equal(t, fet(15).String(), sk.Balances[testutil.ModuleKey(types.ModuleName)].String())
+	assert.True(t, types.ErrWrongPhase.Is(k.Commit(ctx.WithBlockHeight(20), alice, 1, commitment(alice, 40))))
+
+	require.NoError(t, k.Reveal(ctx.WithBlockHeight(20), alice, 1, sdk.NewInt64Coin("afet", 40), []byte("salt")))
+	require.NoError(t, k.Reveal(ctx.WithBlockHeight(21), bob, 1, sdk.NewInt64Coin("afet", 50), []byte("salt")))
+	assert.Len(t, ExportGenesis(ctx, k).Bids, 3)
+
+	k.EndBlocker(ctx.WithBlockHeight(29))
+	assert.False(t, k.GetAuction(ctx, 1).Settled)
+	k.EndBlocker(ctx.WithBlockHeight(30))
+	winningBid := sdk.NewInt64Coin("afet", 50)
+	assert.Equal(t, &types.Auction{
+		ID:              1,
+		Owner:           owner,
+		MinBid:          sdk.NewInt64Coin("afet", 10),
+		Deposit:         fet(5),
+		CommitEndHeight: 20,
+		RevealEndHeight: 30,
+		Settled:         true,
+		Winner:          bob,
+		WinningBid:      &winningBid,
+	}, k.GetAuction(ctx, 1))
+	// the owner gets the winning bid and the deposit of carol, who did not reveal
+	assert.Equal(t, fet(55).String(), sk.Balances[owner.String()].String())
+	assert.Equal(t, fet(100).String(), sk.Balances[alice.String()].String())
+	assert.Equal(t, fet(50).String(), sk.Balances[bob.String()].String())
+	assert.Equal(t, fet(95).String(), sk.Balances[carol.String()].String())
+	assert.True(t, sk.Balances[testutil.ModuleKey(types.ModuleName)].IsZero())
+	assert.Empty(t, k.GetBids(ctx, 1))
+	assert.Empty(t, ExportGenesis(ctx, k).Bids)
+}
+
+func TestSettleEqualBids(t *testing.T) {
+	ctx, k, sk := setupKeeper(t)
+	createAuction(t, ctx, k)
+	require.NoError(t, k.Commit(ctx, alice, 1, commitment(alice, 40)))
+	require.NoError(t, k.Commit(ctx, bob, 1, commitment(bob, 40)))
+	require.NoError(t, k.Reveal(ctx.WithBlockHeight(21), alice, 1, sdk.NewInt64Coin("afet", 40), []byte("salt")))
+	require.NoError(t, k.Reveal(ctx.WithBlockHeight(20), bob, 1, sdk.NewInt64Coin("afet", 40), []byte("salt")))
+	k.EndBlocker(ctx.WithBlockHeight(30))
+	assert.Equal(t, bob, k.GetAuction(ctx, 1).Winner)
+	assert.Equal(t, fet(100).String(), sk.Balances[alice.String()].String())
+
+	// an auction without revealed bids has no winner
+	ctx = ctx.WithBlockHeight(30)
+	createAuction(t, ctx, k)
+	k.EndBlocker(ctx.WithBlockHeight(50))
+	auction := k.GetAuction(ctx, 2)
+	assert.True(t, auction.Settled)
+	assert.Empty(t, auction.Winner)
+	assert.Nil(t, auction.WinningBid)
+}
+
+func TestWasm(t *testing.T) {
+	ctx, k, sk := setupKeeper(t)
+	contract := sdk.AccAddress([]byte("contract____________"))
+	msgs, err := NewWasmEncoder()(contract, json.RawMessage(`{"create":{"min_bid":{"denom":"afet","amount":"10"},"deposit":[{"denom":"afet","amount":"5"}],"commit_blocks":10,"reveal_blocks":10}}`))
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+	create, ok := msgs[0].(types.MsgCreateAuction)
+	require.True(t, ok)
+	assert.Equal(t, contract, create.Owner)
+	require.NoError(t, create.ValidateBasic())
+	_, err = k.Create(ctx, create)
+	require.NoError(t, err)
+
+	sk.Balances[contract.String()] = fet(100)
+	commit, err := json.Marshal(WasmMsg{Commit: &WasmCommitMsg{AuctionID: 1, Commitment: types.Commitment(1, contract, sdk.NewInt64Coin("afet", 40), []byte{0x1})}})
+	require.NoError(t, err)
+	msgs, err = NewWasmEncoder()(contract, commit)
+	require.NoError(t, err)
+	require.NoError(t, k.Commit(ctx, contract, 1, msgs[0].(types.MsgCommitBid).Commitment))
+	msgs, err = NewWasmEncoder()(contract, json.RawMessage(`{"reveal":{"auction_id":1,"amount":{"denom":"afet","amount":"40"},"salt":"01"}}`))
+	require.NoError(t, err)
+	reveal := msgs[0].(types.MsgRevealBid)
+	assert.Equal(t, types.MsgRevealBid{Bidder: contract, AuctionID: 1, Amount: sdk.NewInt64Coin("afet", 40), Salt: []byte{0x1}}, reveal)
+	_, err = NewWasmEncoder()(contract, json.RawMessage(`{"other":{}}`))
+	assert.True(t, sdkerrors.ErrUnknownRequest.Is(err))
+
+	res, err := NewWasmQuerier(k)(ctx, []byte(`{"bid":{"id":1,"bidder":"`+contract.String()+`"}}`))
+	require.NoError(t, err)
+	assert.Contains(t, string(res), `"bidder":"`+contract.String()+`"`)
+	res, err = NewWasmQuerier(k)(ctx, []byte(`{"auction":{"id":1}}`))
+	require.NoError(t, err)
+	assert.Contains(t, string(res), `"owner":"`+contract.String()+`"`)
+	_, err = NewWasmQuerier(k)(ctx, []byte(`{"auction":{"id":2}}`))
+	assert.True(t, types.ErrAuctionNotFound.Is(err))
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"encoding/json"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+const (
+	// QueryParams returns the auction params
+	QueryParams = "params"
+	// QueryAuction returns an auction, path: id
+	QueryAuction = "auction"
+	// QueryBids returns the bids of an unsettled auction, path: id
+	QueryBids = "bids"
+)
+
+// NewQuerier creates a new querier
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		switch path[0] {
+		case QueryParams:
+			return marshal(keeper.GetParams(ctx))
+		case QueryAuction, QueryBids:
+			if len(path) < 2 {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "auction id required")
+			}
+			id, err := strconv.ParseUint(path[1], 10, 64)
+			if err != nil {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "auction id")
+			}
+			if path[0] == QueryBids {
+				return marshal(keeper.GetBids(ctx, id))
+			}
+			auction := keeper.GetAuction(ctx, id)
+			if auction == nil {
+				return []byte("null"), nil
+			}
+			return marshal(auction)
+		default:
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown data query endpoint")
+		}
+	}
+}
+
+func marshal(v interface{}) ([]byte, error) {
+	bz, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	"github.com/fetchai/fetchd/x/auction/internal/types"
+)
+
+const (
+	// WasmMsgRoute is the custom msg route of contracts for the auctions
+	WasmMsgRoute = types.ModuleName
+	// WasmQueryRoute is the custom query route of contracts for the auctions
+	WasmQueryRoute = types.ModuleName
+	// WasmMsgGasLimit is the gas limit of a custom auction msg of a contract
+	WasmMsgGasLimit = 200000
+)
+
+// WasmMsg is the custom msg of contracts, e.g. `{"auction":{"commit":{"auction_id":1,"commitment":"..."}}}`. The
+// contract is the owner of the auctions it creates and the bidder of the bids it commits and reveals.
+type WasmMsg struct {
+	Create *WasmCreateMsg `json:"create,omitempty"`
+	Commit *WasmCommitMsg `json:"commit,omitempty"`
+	Reveal *WasmRevealMsg `json:"reveal,omitempty"`
+}
+
+// WasmCreateMsg creates an auction, see MsgCreateAuction
+type WasmCreateMsg struct {
+	Description  string    `json:"description,omitempty"`
+	MinBid       sdk.Coin  `json:"min_bid"`
+	Deposit      sdk.Coins `json:"deposit"`
+	CommitBlocks int64     `json:"commit_blocks"`
+	RevealBlocks int64     `json:"reveal_blocks"`
+}
+
+// WasmCommitMsg commits to a bid with the hex commitment
+type WasmCommitMsg struct {
+	AuctionID  uint64           `json:"auction_id"`
+	Commitment tmbytes.HexBytes `json:"commitment"`
+}
+
+// WasmRevealMsg reveals a bid with the hex salt
+type WasmRevealMsg struct {
+	AuctionID uint64           `json:"auction_id"`
+	Amount    sdk.Coin         `json:"amount"`
+	Salt      tmbytes.HexBytes `json:"salt"`
+}
+
+// NewWasmEncoder returns the custom msg encoder of contracts for the auctions, to be registered with WasmMsgRoute
+func NewWasmEncoder() func(sender sdk.AccAddress, msg json.RawMessage) ([]sdk.Msg, error) {
+	return func(sender sdk.AccAddress, msg json.RawMessage) ([]sdk.Msg, error) {
+		var wasmMsg WasmMsg
+		if err := json.Unmarshal(msg, &wasmMsg); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+		switch {
+		case wasmMsg.Create != nil:
+			m := wasmMsg.Create
+			return []sdk.Msg{types.MsgCreateAuction{
+				Owner:        sender,
+				Description:  m.Description,
+				MinBid:       m.MinBid,
+				Deposit:      m.Deposit,
+				CommitBlocks: m.CommitBlocks,
+				RevealBlocks: m.RevealBlocks,
+			}}, nil
+		case wasmMsg.Commit != nil:
+			m := wasmMsg.Commit
+			return []sdk.Msg{types.MsgCommitBid{Bidder: sender, AuctionID: m.AuctionID, Commitment: m.Commitment}}, nil
+		case wasmMsg.Reveal != nil:
+			m := wasmMsg.Reveal
+			return []sdk.Msg{types.MsgRevealBid{Bidder: sender, AuctionID: m.AuctionID, Amount: m.Amount, Salt: m.Salt}}, nil
+		default:
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown auction msg")
+		}
+	}
+}
+
+// WasmQuery is the custom query of contracts, `{"auction":{"auction":{"id":1}}}`
+type WasmQuery struct {
+	Auction *WasmAuctionQuery `json:"auction,omitempty"`
+	Bid     *WasmBidQuery     `json:"bid,omitempty"`
+}
+
+// WasmAuctionQuery returns the auction, with the winner once it is settled
+type WasmAuctionQuery struct {
+	ID uint64 `json:"id"`
+}
+
+// WasmBidQuery returns the bid of the bidder in an unsettled auction
+type WasmBidQuery struct {
+	ID     uint64 `json:"id"`
+	Bidder string `json:"bidder"`
+}
+
+// NewWasmQuerier returns the custom querier of contracts for the auctions, to be registered with WasmQueryRoute. The
+// queries return the auction or bid json and fail for auctions and bids that do not exist.
+func NewWasmQuerier(keeper Keeper) func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var query WasmQuery
+		if err := json.Unmarshal(request, &query); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+		switch {
+		case query.Auction != nil:
+			auction := keeper.GetAuction(ctx, query.Auction.ID)
+			if auction == nil {
+				return nil, sdkerrors.Wrapf(types.ErrAuctionNotFound, "%d", query.Auction.ID)
+			}
+			return json.Marshal(auction)
+		case query.Bid != nil:
+			bidder, err := sdk.AccAddressFromBech32(query.Bid.Bidder)
+			if err != nil {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+			}
+			bid := keeper.GetBid(ctx, query.Bid.ID, bidder)
+			if bid == nil {
+				return nil, sdkerrors.Wrap(types.ErrBidNotFound, query.Bid.Bidder)
+			}
+			return json.Marshal(bid)
+		default:
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown auction query")
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+)
+
+// MaxSaltLength is the max length of the salt of a commitment
+const MaxSaltLength = 64
+
+// Auction is a sealed-bid auction. Bidders commit to the hash of their bid before the commit end height, with the
+// deposit of the auction, and reveal the bid before the reveal end height. The bids are not known to anyone during the
+// commit phase, so they can not be front run. The highest revealed bid is paid to the owner when the auction is
+// settled at the reveal end height, the other revealed bids are refunded and the deposits of the bidders that did not
+// reveal are paid to the owner.
+type Auction struct {
+	ID    uint64         `json:"id" yaml:"id"`
+	Owner sdk.AccAddress `json:"owner" yaml:"owner"`
+	// Description describes the auctioned item, e.g. for the contract that created the auction
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	// MinBid is the min bid, its denom is the denom of the bids
+	MinBid          sdk.Coin  `json:"min_bid" yaml:"min_bid"`
+	Deposit         sdk.Coins `json:"deposit" yaml:"deposit"`
+	CommitEndHeight int64     `json:"commit_end_height" yaml:"commit_end_height"`
+	RevealEndHeight int64     `json:"reveal_end_height" yaml:"reveal_end_height"`
+	Settled         bool      `json:"settled" yaml:"settled"`
+	// Winner and WinningBid are set when the auction is settled with a revealed bid
+	Winner     sdk.AccAddress `json:"winner,omitempty" yaml:"winner,omitempty"`
+	WinningBid *sdk.Coin      `json:"winning_bid,omitempty" yaml:"winning_bid,omitempty"`
+}
+
+// Validate validates the auction
+func (a Auction) Validate() error {
+	if a.ID == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "id must be positive")
+	}
+	if err := sdk.VerifyAddressFormat(a.Owner); err != nil {
+		return sdkerrors.Wrap(err, "owner")
+	}
+	if !a.MinBid.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "min bid")
+	}
+	if !a.Deposit.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "deposit")
+	}
+	if a.CommitEndHeight <= 0 || a.RevealEndHeight <= a.CommitEndHeight {
+		return sdkerrors.Wrap(ErrInvalidPeriod, "reveal end height must be after commit end height")
+	}
+	if a.Winner != nil && a.WinningBid == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "winner without winning bid")
+	}
+	return nil
+}
+
+// Bid is the commitment of a bidder to a bid. The amount is escrowed and the deposit refunded when it is revealed.
+type Bid struct {
+	AuctionID  uint64           `json:"auction_id" yaml:"auction_id"`
+	Bidder     sdk.AccAddress   `json:"bidder" yaml:"bidder"`
+	Commitment tmbytes.HexBytes `json:"commitment" yaml:"commitment"`
+	Deposit    sdk.Coins        `json:"deposit" yaml:"deposit"`
+	// Amount and RevealHeight are set when the bid is revealed
+	Amount       *sdk.Coin `json:"amount,omitempty" yaml:"amount,omitempty"`
+	RevealHeight int64     `json:"reveal_height,omitempty" yaml:"reveal_height,omitempty"`
+}
+
+// Validate validates the bid
+func (b Bid) Validate() error {
+	if err := sdk.VerifyAddressFormat(b.Bidder); err != nil {
+		return sdkerrors.Wrap(err, "bidder")
+	}
+	if err := ValidateCommitment(b.Commitment); err != nil {
+		return err
+	}
+	if !b.Deposit.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "deposit")
+	}
+	if b.Amount != nil && !b.Amount.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "amount")
+	}
+	return nil
+}
+
+// Revealed returns true when the bid is revealed
+func (b Bid) Revealed() bool {
+	return b.Amount != nil
+}
+
+// Opens returns true when the amount and the salt are the bid of the commitment
+func (b Bid) Opens(amount sdk.Coin, salt []byte) bool {
+	return bytes.Equal(Commitment(b.AuctionID, b.Bidder, amount, salt), b.Commitment)
+}
+
+// Commitment returns the commitment of the bidder to the bid amount in the auction: the sha256 hash of
+// "<auction id>/<bidder>/<amount>/<hex salt>", e.g. "1/fetch1.../100afet/0a1b". The bidder is part of the hash so that
+// the commitment of another bidder can not be copied. The salt must be random and kept secret until the reveal.
+func Commitment(auctionID uint64, bidder sdk.AccAddress, amount sdk.Coin, salt []byte) []byte {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%d/%s/%s/%s", auctionID, bidder, amount, hex.EncodeToString(salt))))
+	return hash[:]
+}
+
+// ValidateCommitment validates a sha256 commitment
+func ValidateCommitment(commitment []byte) error {
+	if len(commitment) != sha256.Size {
+		return sdkerrors.Wrapf(ErrInvalidCommitment, "must be %d bytes", sha256.Size)
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers the msg types of the auction module
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgCreateAuction{}, "auction/MsgCreateAuction", nil)
+	cdc.RegisterConcrete(MsgCommitBid{}, "auction/MsgCommitBid", nil)
+	cdc.RegisterConcrete(MsgRevealBid{}, "auction/MsgRevealBid", nil)
+}
+
+// ModuleCdc generic sealed codec to be used throughout module
+var ModuleCdc *codec.Codec
+
+func init() {
+	cdc := codec.New()
+	RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	ModuleCdc = cdc.Seal()
+}
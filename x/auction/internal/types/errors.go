@@ -0,0 +1,23 @@
+package types
+
+import (
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Codes for auction errors
+var (
+	DefaultCodespace = ModuleName
+
+	// ErrAuctionNotFound error for an auction that does not exist
+	ErrAuctionNotFound = sdkErrors.Register(DefaultCodespace, 1, "auction not found")
+	// ErrWrongPhase error for a commit after the commit phase or a reveal outside of the reveal phase
+	ErrWrongPhase = sdkErrors.Register(DefaultCodespace, 2, "wrong auction phase")
+	// ErrInvalidCommitment error for a reveal that does not match the commitment of the bidder
+	ErrInvalidCommitment = sdkErrors.Register(DefaultCodespace, 3, "invalid commitment")
+	// ErrBidNotFound error for a reveal without commitment
+	ErrBidNotFound = sdkErrors.Register(DefaultCodespace, 4, "bid not found")
+	// ErrInvalidBid error for a bid of another denom or below the min bid
+	ErrInvalidBid = sdkErrors.Register(DefaultCodespace, 5, "invalid bid")
+	// ErrInvalidPeriod error for a commit or reveal period out of the bounds of the params
+	ErrInvalidPeriod = sdkErrors.Register(DefaultCodespace, 6, "invalid period")
+)
@@ -0,0 +1,54 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GenesisState is the genesis state of the auction module
+type GenesisState struct {
+	Params        Params    `json:"params"`
+	LastAuctionID uint64    `json:"last_auction_id"`
+	Auctions      []Auction `json:"auctions,omitempty"`
+	Bids          []Bid     `json:"bids,omitempty"`
+}
+
+// DefaultGenesisState returns the genesis state without auctions
+func DefaultGenesisState() GenesisState {
+	return GenesisState{Params: DefaultParams()}
+}
+
+// ValidateGenesis performs basic validation of the genesis state. The ids of the auctions must not exceed the last
+// auction id and the bids must be of unsettled auctions.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "params")
+	}
+	open := make(map[uint64]bool, len(data.Auctions))
+	for _, a := range data.Auctions {
+		if err := a.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "auction %d", a.ID)
+		}
+		if a.ID > data.LastAuctionID {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "auction %d after last auction id", a.ID)
+		}
+		if _, exists := open[a.ID]; exists {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate auction %d", a.ID)
+		}
+		open[a.ID] = !a.Settled
+	}
+	bids := make(map[string]struct{}, len(data.Bids))
+	for _, b := range data.Bids {
+		if err := b.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "bid of %s in auction %d", b.Bidder, b.AuctionID)
+		}
+		if !open[b.AuctionID] {
+			return sdkerrors.Wrapf(ErrAuctionNotFound, "bid of %s in auction %d", b.Bidder, b.AuctionID)
+		}
+		key := string(GetBidKey(b.AuctionID, b.Bidder))
+		if _, exists := bids[key]; exists {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate bid of %s in auction %d", b.Bidder, b.AuctionID)
+		}
+		bids[key] = struct{}{}
+	}
+	return nil
+}
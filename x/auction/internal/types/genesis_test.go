@@ -0,0 +1,61 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	bidder := sdk.AccAddress([]byte("bidder______________"))
+	auction := Auction{
+		ID:              1,
+		Owner:           sdk.AccAddress([]byte("owner_______________")),
+		MinBid:          sdk.NewInt64Coin("afet", 10),
+		Deposit:         sdk.NewCoins(sdk.NewInt64Coin("afet", 5)),
+		CommitEndHeight: 100,
+		RevealEndHeight: 200,
+	}
+	bid := Bid{
+		AuctionID:  1,
+		Bidder:     bidder,
+		Commitment: Commitment(1, bidder, sdk.NewInt64Coin("afet", 20), []byte("salt")),
+		Deposit:    auction.Deposit,
+	}
+	specs := map[string]struct {
+		mutate func(*GenesisState)
+		expErr bool
+	}{
+		"valid":             {mutate: func(*GenesisState) {}},
+		"id after last id":  {mutate: func(g *GenesisState) { g.LastAuctionID = 0 }, expErr: true},
+		"duplicate auction": {mutate: func(g *GenesisState) { g.Auctions = append(g.Auctions, auction) }, expErr: true},
+		"duplicate bid":     {mutate: func(g *GenesisState) { g.Bids = append(g.Bids, bid) }, expErr: true},
+		"bid of settled":    {mutate: func(g *GenesisState) { g.Auctions[0].Settled = true }, expErr: true},
+		"bid of unknown":    {mutate: func(g *GenesisState) { g.Bids[0].AuctionID = 2 }, expErr: true},
+		"short commitment":  {mutate: func(g *GenesisState) { g.Bids[0].Commitment = bid.Commitment[:16] }, expErr: true},
+		"reveal before end": {mutate: func(g *GenesisState) { g.Auctions[0].RevealEndHeight = 100 }, expErr: true},
+		"min exceeds max":   {mutate: func(g *GenesisState) { g.Params.MinPeriod = g.Params.MaxPeriod + 1 }, expErr: true},
+		"settled, no bids": {mutate: func(g *GenesisState) {
+			g.Auctions[0].Settled = true
+			g.Bids = nil
+		}},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			g := GenesisState{Params: DefaultParams(), LastAuctionID: 1, Auctions: []Auction{auction}, Bids: []Bid{bid}}
+			spec.mutate(&g)
+			err := ValidateGenesis(g)
+			if spec.expErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+	assert.True(t, bid.Opens(sdk.NewInt64Coin("afet", 20), []byte("salt")))
+	assert.False(t, bid.Opens(sdk.NewInt64Coin("afet", 21), []byte("salt")))
+	assert.False(t, bid.Opens(sdk.NewInt64Coin("afet", 20), []byte("other")))
+	other := Bid{AuctionID: 1, Bidder: sdk.AccAddress([]byte("other_______________")), Commitment: bid.Commitment}
+	assert.False(t, other.Opens(sdk.NewInt64Coin("afet", 20), []byte("salt")))
+}
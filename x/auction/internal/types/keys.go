@@ -0,0 +1,65 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the auction module
+	ModuleName = "auction"
+
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for the auction module
+	QuerierRoute = ModuleName
+
+	// RouterKey is the msg router key for the auction module
+	RouterKey = ModuleName
+)
+
+const ( // event attributes
+	AttributeKeyAuctionID = "auction_id"
+	AttributeKeyBidder    = "bidder"
+	AttributeKeyWinner    = "winner"
+	AttributeKeySlashed   = "slashed"
+)
+
+const (
+	// EventTypeCreate is emitted when an auction is created
+	EventTypeCreate = "create_auction"
+	// EventTypeCommit is emitted when a bid is committed
+	EventTypeCommit = "commit_bid"
+	// EventTypeReveal is emitted when a bid is revealed, with its amount
+	EventTypeReveal = "reveal_bid"
+	// EventTypeSettle is emitted when an auction is settled, with the winner and the winning bid if any
+	EventTypeSettle = "settle_auction"
+)
+
+// nolint
+var (
+	AuctionPrefix     = []byte{0x01}
+	LastAuctionIDKey  = []byte{0x02}
+	BidPrefix         = []byte{0x03}
+	SettleQueuePrefix = []byte{0x04}
+)
+
+// GetAuctionKey returns the store key of the auction
+func GetAuctionKey(id uint64) []byte {
+	return append(AuctionPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetBidsPrefix returns the store key prefix of the bids of the auction
+func GetBidsPrefix(id uint64) []byte {
+	return append(BidPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetBidKey returns the store key of the bid of the bidder in the auction
+func GetBidKey(id uint64, bidder sdk.AccAddress) []byte {
+	return append(GetBidsPrefix(id), bidder...)
+}
+
+// GetSettleQueueKey returns the store key of the auction in the queue of the auctions settled at the height
+func GetSettleQueueKey(height int64, id uint64) []byte {
+	return append(append(SettleQueuePrefix, sdk.Uint64ToBigEndian(uint64(height))...), sdk.Uint64ToBigEndian(id)...)
+}
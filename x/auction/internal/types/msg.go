@@ -0,0 +1,124 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+)
+
+// MaxDescriptionLength is the max length of the description of an auction
+const MaxDescriptionLength = 256
+
+// MsgCreateAuction creates an auction of the owner with a commit phase of commit blocks followed by a reveal phase of
+// reveal blocks
+type MsgCreateAuction struct {
+	Owner        sdk.AccAddress `json:"owner" yaml:"owner"`
+	Description  string         `json:"description,omitempty" yaml:"description,omitempty"`
+	MinBid       sdk.Coin       `json:"min_bid" yaml:"min_bid"`
+	Deposit      sdk.Coins      `json:"deposit" yaml:"deposit"`
+	CommitBlocks int64          `json:"commit_blocks" yaml:"commit_blocks"`
+	RevealBlocks int64          `json:"reveal_blocks" yaml:"reveal_blocks"`
+}
+
+func (msg MsgCreateAuction) Route() string {
+	return RouterKey
+}
+
+func (msg MsgCreateAuction) Type() string {
+	return "create-auction"
+}
+
+func (msg MsgCreateAuction) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Owner); err != nil {
+		return sdkerrors.Wrap(err, "owner")
+	}
+	if len(msg.Description) > MaxDescriptionLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "description exceeds %d characters", MaxDescriptionLength)
+	}
+	if !msg.MinBid.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "min bid")
+	}
+	if !msg.Deposit.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "deposit")
+	}
+	if msg.CommitBlocks <= 0 || msg.RevealBlocks <= 0 {
+		return sdkerrors.Wrap(ErrInvalidPeriod, "must be positive")
+	}
+	return nil
+}
+
+func (msg MsgCreateAuction) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgCreateAuction) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgCommitBid commits the bidder to a bid in the auction with the deposit of the auction. A new commitment of the
+// bidder replaces the previous one.
+type MsgCommitBid struct {
+	Bidder     sdk.AccAddress   `json:"bidder" yaml:"bidder"`
+	AuctionID  uint64           `json:"auction_id" yaml:"auction_id"`
+	Commitment tmbytes.HexBytes `json:"commitment" yaml:"commitment"`
+}
+
+func (msg MsgCommitBid) Route() string {
+	return RouterKey
+}
+
+func (msg MsgCommitBid) Type() string {
+	return "commit-bid"
+}
+
+func (msg MsgCommitBid) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Bidder); err != nil {
+		return sdkerrors.Wrap(err, "bidder")
+	}
+	return ValidateCommitment(msg.Commitment)
+}
+
+func (msg MsgCommitBid) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgCommitBid) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Bidder}
+}
+
+// MsgRevealBid reveals the amount and the salt of the commitment of the bidder
+type MsgRevealBid struct {
+	Bidder    sdk.AccAddress   `json:"bidder" yaml:"bidder"`
+	AuctionID uint64           `json:"auction_id" yaml:"auction_id"`
+	Amount    sdk.Coin         `json:"amount" yaml:"amount"`
+	Salt      tmbytes.HexBytes `json:"salt" yaml:"salt"`
+}
+
+func (msg MsgRevealBid) Route() string {
+	return RouterKey
+}
+
+func (msg MsgRevealBid) Type() string {
+	return "reveal-bid"
+}
+
+func (msg MsgRevealBid) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Bidder); err != nil {
+		return sdkerrors.Wrap(err, "bidder")
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "amount")
+	}
+	if len(msg.Salt) > MaxSaltLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "salt exceeds %d bytes", MaxSaltLength)
+	}
+	return nil
+}
+
+func (msg MsgRevealBid) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgRevealBid) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Bidder}
+}
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// DefaultParamspace for params keeper
+	DefaultParamspace = ModuleName
+)
+
+var ParamStoreKeyMinPeriod = []byte("minPeriod")
+var ParamStoreKeyMaxPeriod = []byte("maxPeriod")
+
+// Params defines the set of auction parameters. They are changed by param change proposals.
+type Params struct {
+	// MinPeriod is the min number of blocks of the commit and of the reveal phase, long enough for the bidders to
+	// get their txs in
+	MinPeriod int64 `json:"min_period" yaml:"min_period"`
+	// MaxPeriod is the max number of blocks of the commit and of the reveal phase
+	MaxPeriod int64 `json:"max_period" yaml:"max_period"`
+}
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns default auction parameters, the phases are between about 5 minutes and a week of 5s blocks
+func DefaultParams() Params {
+	return Params{
+		MinPeriod: 60,
+		MaxPeriod: 120960,
+	}
+}
+
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(ParamStoreKeyMinPeriod, &p.MinPeriod, validatePeriod),
+		params.NewParamSetPair(ParamStoreKeyMaxPeriod, &p.MaxPeriod, validatePeriod),
+	}
+}
+
+// ValidateBasic performs basic validation on auction parameters.
+func (p Params) ValidateBasic() error {
+	if err := validatePeriod(p.MinPeriod); err != nil {
+		return sdkerrors.Wrap(err, "min period")
+	}
+	if err := validatePeriod(p.MaxPeriod); err != nil {
+		return sdkerrors.Wrap(err, "max period")
+	}
+	if p.MinPeriod > p.MaxPeriod {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "min period exceeds max period")
+	}
+	return nil
+}
+
+func validatePeriod(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v <= 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package beacon
+
+import (
+	"github.com/fetchai/fetchd/x/beacon/internal/keeper"
+	"github.com/fetchai/fetchd/x/beacon/internal/types"
+)
+
+const (
+	ModuleName             = types.ModuleName
+	StoreKey               = types.StoreKey
+	QuerierRoute           = types.QuerierRoute
+	DefaultParamspace      = types.DefaultParamspace
+	AttributeKeyRound      = types.AttributeKeyRound
+	AttributeKeyRandomness = types.AttributeKeyRandomness
+	EventTypeRandomness    = types.EventTypeRandomness
+	QueryParams            = keeper.QueryParams
+	QueryLatest            = keeper.QueryLatest
+	QueryRandomness        = keeper.QueryRandomness
+	WasmQueryRoute         = keeper.WasmQueryRoute
+)
+
+var (
+	// functions aliases
+	RegisterCodec       = types.RegisterCodec
+	ValidateGenesis     = types.ValidateGenesis
+	DefaultGenesisState = types.DefaultGenesisState
+	DefaultParams       = types.DefaultParams
+	NewRandomness       = types.NewRandomness
+	NewKeeper           = keeper.NewKeeper
+	NewQuerier          = keeper.NewQuerier
+	NewWasmQuerier      = keeper.NewWasmQuerier
+	InitGenesis         = keeper.InitGenesis
+	ExportGenesis       = keeper.ExportGenesis
+
+	// variable aliases
+	ModuleCdc       = types.ModuleCdc
+	ErrNoRandomness = types.ErrNoRandomness
+)
+
+type (
+	Keeper       = keeper.Keeper
+	WasmQuery    = keeper.WasmQuery
+	GenesisState = types.GenesisState
+	Params       = types.Params
+	Randomness   = types.Randomness
+)
@@ -0,0 +1,88 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/fetchai/fetchd/x/beacon/internal/keeper"
+	"github.com/fetchai/fetchd/x/beacon/internal/types"
+)
+
+// GetQueryCmd returns the query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the random beacon",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(flags.GetCommands(
+		GetCmdQueryParams(cdc),
+		GetCmdQueryRandomness(cdc),
+	)...)
+	return queryCmd
+}
+
+// GetCmdQueryParams shows the beacon params
+func GetCmdQueryParams(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "Show the beacon params",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, keeper.QueryParams))
+			if err != nil {
+				return err
+			}
+			var params types.Params
+			if err := json.Unmarshal(res, &params); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
+
+// GetCmdQueryRandomness shows the randomness of a block
+func GetCmdQueryRandomness(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "randomness [height]",
+		Short: "Show the randomness of a block, of the latest block without height",
+		Long: `Show the randomness of the block height with the beacon round and the threshold group signature it is the
+hash of. Without height the randomness of the last block that had one is shown.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path := keeper.QueryLatest
+			if len(args) != 0 {
+				height, err := strconv.ParseInt(args[0], 10, 64)
+				if err != nil {
+					return fmt.Errorf("height: %s", err)
+				}
+				path = fmt.Sprintf("%s/%d", keeper.QueryRandomness, height)
+			}
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, path))
+			if err != nil {
+				return err
+			}
+			var randomness *types.Randomness
+			if err := json.Unmarshal(res, &randomness); err != nil {
+				return err
+			}
+			if randomness == nil {
+				return types.ErrNoRandomness
+			}
+			return cliCtx.PrintOutput(randomness)
+		},
+	}
+}
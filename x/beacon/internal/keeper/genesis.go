@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fetchai/fetchd/x/beacon/internal/types"
+)
+
+// InitGenesis stores the params and the randomness history of the genesis
+func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
+	keeper.setParams(ctx, data.Params)
+	for _, r := range data.Randomness {
+		keeper.setRandomness(ctx, r)
+	}
+}
+
+// ExportGenesis returns the params and the randomness history as genesis state
+func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
+	data := types.GenesisState{Params: keeper.GetParams(ctx)}
+	keeper.IterateRandomness(ctx, func(r types.Randomness) bool {
+		data.Randomness = append(data.Randomness, r)
+		return false
+	})
+	return data
+}
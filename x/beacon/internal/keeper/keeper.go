@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+
+	"github.com/fetchai/fetchd/x/beacon/internal/types"
+)
+
+// Keeper keeps the randomness of the recent blocks, taken from the entropy of the block headers
+type Keeper struct {
+	storeKey   sdk.StoreKey
+	cdc        *codec.Codec
+	paramSpace params.Subspace
+}
+
+// NewKeeper creates a new beacon Keeper instance
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, paramSpace params.Subspace) Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+	return Keeper{
+		storeKey:   storeKey,
+		cdc:        cdc,
+		paramSpace: paramSpace,
+	}
+}
+
+// GetParams returns the total set of beacon parameters.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	var params types.Params
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+func (k Keeper) setParams(ctx sdk.Context, ps types.Params) {
+	k.paramSpace.SetParamSet(ctx, &ps)
+}
+
+// BeginBlocker stores the randomness of the entropy of the block and prunes the randomness that left the history.
+// Blocks without group signature, e.g. while the validators run the key generation of a new aeon, have no randomness.
+func (k Keeper) BeginBlocker(ctx sdk.Context) {
+	entropy := ctx.BlockHeader().Entropy
+	if len(entropy.GroupSignature) == 0 {
+		return
+	}
+	randomness := types.NewRandomness(ctx.BlockHeight(), entropy.Round, entropy.GroupSignature)
+	k.setRandomness(ctx, randomness)
+	if pruned := ctx.BlockHeight() - int64(k.GetParams(ctx).HistoryLength); pruned > 0 {
+		k.pruneRandomness(ctx, pruned)
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRandomness,
+		sdk.NewAttribute(types.AttributeKeyRound, strconv.FormatInt(randomness.Round, 10)),
+		sdk.NewAttribute(types.AttributeKeyRandomness, randomness.Value.String()),
+	))
+}
+
+// GetRandomness returns the randomness of the block height, nil when the block had none or it was pruned
+func (k Keeper) GetRandomness(ctx sdk.Context, height int64) *types.Randomness {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetRandomnessKey(height))
+	if bz == nil {
+		return nil
+	}
+	var randomness types.Randomness
+	k.cdc.MustUnmarshalBinaryBare(bz, &randomness)
+	return &randomness
+}
+
+// GetLatestRandomness returns the randomness of the last block that had one, nil when there is none
+func (k Keeper) GetLatestRandomness(ctx sdk.Context) *types.Randomness {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.RandomnessPrefix).ReverseIterator(nil, nil)
+	defer iter.Close()
+	if !iter.Valid() {
+		return nil
+	}
+	var randomness types.Randomness
+	k.cdc.MustUnmarshalBinaryBare(iter.Value(), &randomness)
+	return &randomness
+}
+
+func (k Keeper) setRandomness(ctx sdk.Context, randomness types.Randomness) {
+	ctx.KVStore(k.storeKey).Set(types.GetRandomnessKey(randomness.Height), k.cdc.MustMarshalBinaryBare(randomness))
+}
+
+// pruneRandomness deletes the randomness of the heights up to and including height
+func (k Keeper) pruneRandomness(ctx sdk.Context, height int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RandomnessPrefix)
+	iter := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(height+1)))
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// IterateRandomness calls cb for the randomness of the recent blocks in height order, until cb returns true
+func (k Keeper) IterateRandomness(ctx sdk.Context, cb func(types.Randomness) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.RandomnessPrefix).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var randomness types.Randomness
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &randomness)
+		if cb(randomness) {
+			return
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/fetchai/fetchd/testutil"
+	"github.com/fetchai/fetchd/x/beacon/internal/types"
+)
+
+func setupKeeper(t *testing.T) (sdk.Context, Keeper) {
+	cdc := codec.New()
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	ctx, paramsKeeper := testutil.NewContext(t, cdc, abci.Header{}, key)
+	k := NewKeeper(cdc, key, paramsKeeper.Subspace(types.DefaultParamspace))
+	InitGenesis(ctx, k, types.GenesisState{Params: types.Params{HistoryLength: 3}})
+	return ctx, k
+}
+
+func beginBlock(ctx sdk.Context, k Keeper, height int64, groupSignature []byte) {
+	header := abci.Header{Height: height, Entropy: abci.BlockEntropy{GroupSignature: groupSignature, Round: height + 100}}
+	k.BeginBlocker(ctx.WithBlockHeader(header).WithEventManager(sdk.NewEventManager()))
+}
+
+func TestBeginBlocker(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	assert.Nil(t, k.GetLatestRandomness(ctx))
+
+	for height := int64(1); height <= 5; height++ {
+		beginBlock(ctx, k, height, []byte(fmt.Sprintf("sig %d", height)))
+	}
+	// a block of the key generation of a new aeon
+	beginBlock(ctx, k, 6, nil)
+
+	exp := []types.Randomness{
+		types.NewRandomness(3, 103, []byte("sig 3")),
+		types.NewRandomness(4, 104, []byte("sig 4")),
+		types.NewRandomness(5, 105, []byte("sig 5")),
+	}
+	assert.Equal(t, exp, ExportGenesis(ctx, k).Randomness)
+	assert.Equal(t, &exp[2], k.GetLatestRandomness(ctx))
+	assert.Equal(t, &exp[1], k.GetRandomness(ctx, 4))
+	assert.Nil(t, k.GetRandomness(ctx, 2))
+	assert.Nil(t, k.GetRandomness(ctx, 6))
+
+	beginBlock(ctx, k, 7, []byte("sig 7"))
+	assert.Equal(t, []types.Randomness{exp[2], types.NewRandomness(7, 107, []byte("sig 7"))}, ExportGenesis(ctx, k).Randomness)
+}
+
+func TestWasmQuerier(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	q := NewWasmQuerier(k)
+	_, err := q(ctx, []byte(`{"latest":{}}`))
+	assert.True(t, types.ErrNoRandomness.Is(err))
+
+	beginBlock(ctx, k, 1, []byte("sig 1"))
+	beginBlock(ctx, k, 2, []byte("sig 2"))
+	exp := types.NewRandomness(1, 101, []byte("sig 1"))
+
+	specs := map[string]struct {
+		src    string
+		exp    *types.Randomness
+		expErr bool
+	}{
+		"latest":          {src: `{"latest":{}}`, exp: k.GetRandomness(ctx, 2)},
+		"height":          {src: `{"randomness":{"height":1}}`, exp: &exp},
+		"future height":   {src: `{"randomness":{"height":3}}`, expErr: true},
+		"unknown query":   {src: `{"other":{}}`, expErr: true},
+		"invalid request": {src: `[]`, expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			res, err := q(ctx, []byte(spec.src))
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			var randomness types.Randomness
+			require.NoError(t, json.Unmarshal(res, &randomness))
+			assert.Equal(t, *spec.exp, randomness)
+		})
+	}
+}
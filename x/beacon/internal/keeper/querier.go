@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"encoding/json"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+const (
+	// QueryParams returns the beacon params
+	QueryParams = "params"
+	// QueryLatest returns the randomness of the last block that had one
+	QueryLatest = "latest"
+	// QueryRandomness returns the randomness of a block, path: height
+	QueryRandomness = "randomness"
+)
+
+// NewQuerier creates a new querier
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		switch path[0] {
+		case QueryParams:
+			return marshal(keeper.GetParams(ctx))
+		case QueryLatest:
+			randomness := keeper.GetLatestRandomness(ctx)
+			if randomness == nil {
+				return []byte("null"), nil
+			}
+			return marshal(randomness)
+		case QueryRandomness:
+			if len(path) < 2 {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "height required")
+			}
+			height, err := strconv.ParseInt(path[1], 10, 64)
+			if err != nil {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "height: %s", err)
+			}
+			randomness := keeper.GetRandomness(ctx, height)
+			if randomness == nil {
+				return []byte("null"), nil
+			}
+			return marshal(randomness)
+		default:
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown data query endpoint")
+		}
+	}
+}
+
+func marshal(v interface{}) ([]byte, error) {
+	bz, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
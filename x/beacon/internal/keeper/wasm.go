@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/fetchai/fetchd/x/beacon/internal/types"
+)
+
+// WasmQueryRoute is the custom query route of contracts for the block randomness
+const WasmQueryRoute = types.ModuleName
+
+// WasmQuery is the custom query of contracts, e.g. `{"beacon":{"randomness":{"height":1234}}}`
+type WasmQuery struct {
+	Latest     *struct{}            `json:"latest,omitempty"`
+	Randomness *WasmRandomnessQuery `json:"randomness,omitempty"`
+}
+
+// WasmRandomnessQuery returns the randomness of the block height
+type WasmRandomnessQuery struct {
+	Height int64 `json:"height"`
+}
+
+// NewWasmQuerier returns the custom querier of contracts for the block randomness, to be registered with
+// WasmQueryRoute. Both queries return the randomness json and fail for heights without randomness. The randomness of
+// the current block is known to the proposer before the txs are ordered, so lotteries should commit to a later height
+// and read its randomness once it is reached.
+func NewWasmQuerier(keeper Keeper) func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var query WasmQuery
+		if err := json.Unmarshal(request, &query); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+		var randomness *types.Randomness
+		switch {
+		case query.Latest != nil:
+			randomness = keeper.GetLatestRandomness(ctx)
+		case query.Randomness != nil:
+			randomness = keeper.GetRandomness(ctx, query.Randomness.Height)
+		default:
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown beacon query")
+		}
+		if randomness == nil {
+			return nil, types.ErrNoRandomness
+		}
+		return json.Marshal(randomness)
+	}
+}
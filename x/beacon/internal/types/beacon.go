@@ -0,0 +1,26 @@
+package types
+
+import (
+	"crypto/sha256"
+
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+)
+
+// Randomness is the randomness of a block. The consensus produces the entropy of each block as the threshold BLS
+// signature of the validator group over the entropy of the previous round, which no minority of the validators can
+// predict or bias, and which is verified with the group public key of the aeon before the block is committed.
+type Randomness struct {
+	Height int64 `json:"height"`
+	// Round is the beacon round of the entropy
+	Round int64 `json:"round"`
+	// Value is the sha256 hash of the group signature, to be used as 32 random bytes
+	Value tmbytes.HexBytes `json:"value"`
+	// GroupSignature is the threshold signature of the round, which clients can verify with the group public key
+	GroupSignature tmbytes.HexBytes `json:"group_signature"`
+}
+
+// NewRandomness returns the randomness of the group signature of the beacon round at the height
+func NewRandomness(height, round int64, groupSignature []byte) Randomness {
+	value := sha256.Sum256(groupSignature)
+	return Randomness{Height: height, Round: round, Value: value[:], GroupSignature: groupSignature}
+}
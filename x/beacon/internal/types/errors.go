@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Codes for beacon errors
+var (
+	DefaultCodespace = ModuleName
+
+	// ErrNoRandomness error for a height without stored randomness, it is in the future, pruned or had no entropy
+	ErrNoRandomness = sdkErrors.Register(DefaultCodespace, 1, "no randomness")
+)
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GenesisState is the genesis state of the beacon module
+type GenesisState struct {
+	Params Params `json:"params"`
+	// Randomness is the randomness of the recent blocks, in height order
+	Randomness []Randomness `json:"randomness,omitempty"`
+}
+
+// DefaultGenesisState returns the genesis state of a beacon without history
+func DefaultGenesisState() GenesisState {
+	return GenesisState{Params: DefaultParams()}
+}
+
+// ValidateGenesis performs basic validation of the genesis state
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "params")
+	}
+	var lastHeight int64
+	for _, r := range data.Randomness {
+		if r.Height <= lastHeight {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "randomness height %d not ascending", r.Height)
+		}
+		lastHeight = r.Height
+		if value := sha256.Sum256(r.GroupSignature); !bytes.Equal(value[:], r.Value) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "randomness value of height %d", r.Height)
+		}
+	}
+	return nil
+}
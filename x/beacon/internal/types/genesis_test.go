@@ -0,0 +1,39 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	specs := map[string]struct {
+		src    GenesisState
+		expErr bool
+	}{
+		"default": {src: DefaultGenesisState()},
+		"history": {src: GenesisState{
+			Params:     DefaultParams(),
+			Randomness: []Randomness{NewRandomness(1, 7, []byte("sig 7")), NewRandomness(3, 8, []byte("sig 8"))},
+		}},
+		"zero history length": {src: GenesisState{}, expErr: true},
+		"heights not ascending": {src: GenesisState{
+			Params:     DefaultParams(),
+			Randomness: []Randomness{NewRandomness(3, 7, []byte("sig 7")), NewRandomness(3, 8, []byte("sig 8"))},
+		}, expErr: true},
+		"value of other signature": {src: GenesisState{
+			Params:     DefaultParams(),
+			Randomness: []Randomness{{Height: 1, Value: NewRandomness(1, 7, []byte("sig 7")).Value, GroupSignature: []byte("sig 8")}},
+		}, expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := ValidateGenesis(spec.src)
+			if spec.expErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the beacon module
+	ModuleName = "beacon"
+
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for the beacon module
+	QuerierRoute = ModuleName
+)
+
+const ( // event attributes
+	AttributeKeyRound      = "round"
+	AttributeKeyRandomness = "randomness"
+)
+
+const (
+	// EventTypeRandomness is emitted when the randomness of a block is stored
+	EventTypeRandomness = "randomness"
+)
+
+// nolint
+var (
+	RandomnessPrefix = []byte{0x01}
+)
+
+// GetRandomnessKey returns the store key of the randomness of the block height
+func GetRandomnessKey(height int64) []byte {
+	return append(RandomnessPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
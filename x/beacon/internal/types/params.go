@@ -0,0 +1,62 @@
+package types
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// DefaultParamspace for params keeper
+	DefaultParamspace = ModuleName
+)
+
+var ParamStoreKeyHistoryLength = []byte("historyLength")
+
+// Params defines the set of beacon parameters.
+type Params struct {
+	// HistoryLength is the number of recent blocks the randomness is kept for
+	HistoryLength uint64 `json:"history_length" yaml:"history_length"`
+}
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns default beacon parameters
+func DefaultParams() Params {
+	return Params{
+		HistoryLength: 10000,
+	}
+}
+
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(ParamStoreKeyHistoryLength, &p.HistoryLength, validateHistoryLength),
+	}
+}
+
+// ValidateBasic performs basic validation on beacon parameters.
+func (p Params) ValidateBasic() error {
+	return sdkerrors.Wrap(validateHistoryLength(p.HistoryLength), "history length")
+}
+
+func validateHistoryLength(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "must be positive")
+	}
+	return nil
+}
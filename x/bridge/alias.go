@@ -0,0 +1,75 @@
+package bridge
+
+import (
+	"github.com/fetchai/fetchd/x/bridge/internal/keeper"
+	"github.com/fetchai/fetchd/x/bridge/internal/types"
+)
+
+const (
+	ModuleName             = types.ModuleName
+	StoreKey               = types.StoreKey
+	QuerierRoute           = types.QuerierRoute
+	RouterKey              = types.RouterKey
+	DefaultParamspace      = types.DefaultParamspace
+	AttributeKeyRelayer    = types.AttributeKeyRelayer
+	AttributeKeyEventNonce = types.AttributeKeyEventNonce
+	AttributeKeyOutgoingID = types.AttributeKeyOutgoingID
+	AttributeKeyBatchID    = types.AttributeKeyBatchID
+	EventTypeMint          = types.EventTypeMint
+	EventTypeBurn          = types.EventTypeBurn
+	EventTypeSlash         = types.EventTypeSlash
+	EventTypeCancelBurn    = types.EventTypeCancelBurn
+	EventTypeBatch         = types.EventTypeBatch
+	EventTypeBatchExecuted = types.EventTypeBatchExecuted
+	QueryParams            = keeper.QueryParams
+	QueryStatus            = keeper.QueryStatus
+	QueryRelayers          = keeper.QueryRelayers
+	QueryAttestations      = keeper.QueryAttestations
+	QueryOutgoingTransfers = keeper.QueryOutgoingTransfers
+	QueryPendingBatches    = keeper.QueryPendingBatches
+)
+
+var (
+	// functions aliases
+	RegisterCodec       = types.RegisterCodec
+	ValidateGenesis     = types.ValidateGenesis
+	DefaultGenesisState = types.DefaultGenesisState
+	DefaultParams       = types.DefaultParams
+	ValidateEthAddress  = types.ValidateEthAddress
+	NewKeeper           = keeper.NewKeeper
+	NewQuerier          = keeper.NewQuerier
+	InitGenesis         = keeper.InitGenesis
+	ExportGenesis       = keeper.ExportGenesis
+
+	// variable aliases
+	ModuleCdc            = types.ModuleCdc
+	ErrInvalidClaim      = types.ErrInvalidClaim
+	ErrInvalidEthAddress = types.ErrInvalidEthAddress
+	ErrDuplicateVote     = types.ErrDuplicateVote
+	ErrInsufficientBond  = types.ErrInsufficientBond
+	ErrBridgeDisabled    = types.ErrBridgeDisabled
+	ErrInvalidBatch      = types.ErrInvalidBatch
+)
+
+type (
+	Keeper              = keeper.Keeper
+	RelayerInfo         = keeper.RelayerInfo
+	PendingBatch        = keeper.PendingBatch
+	GenesisState        = types.GenesisState
+	Params              = types.Params
+	Status              = types.Status
+	Claim               = types.Claim
+	LockClaim           = types.LockClaim
+	BatchExecutedClaim  = types.BatchExecutedClaim
+	Attestation         = types.Attestation
+	Relayer             = types.Relayer
+	OutgoingTransfer    = types.OutgoingTransfer
+	OutgoingBatch       = types.OutgoingBatch
+	MsgAttestLock       = types.MsgAttestLock
+	MsgAttestBatch      = types.MsgAttestBatch
+	MsgBurnForEth       = types.MsgBurnForEth
+	MsgCancelBurnForEth = types.MsgCancelBurnForEth
+	MsgRequestBatch     = types.MsgRequestBatch
+	MsgBondRelayer      = types.MsgBondRelayer
+	MsgUnbondRelayer    = types.MsgUnbondRelayer
+)
@@ -0,0 +1,153 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/fetchai/fetchd/x/bridge/internal/keeper"
+	"github.com/fetchai/fetchd/x/bridge/internal/types"
+)
+
+const (
+	flagAfter = "after"
+	flagLimit = "limit"
+)
+
+// GetQueryCmd returns the query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the Ethereum bridge",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(flags.GetCommands(
+		GetCmdQueryParams(cdc),
+		GetCmdQueryStatus(cdc),
+		GetCmdQueryRelayers(cdc),
+		GetCmdQueryAttestations(cdc),
+		GetCmdQueryOutgoingTransfers(cdc),
+		GetCmdQueryPendingBatches(cdc),
+	)...)
+	return queryCmd
+}
+
+// GetCmdQueryParams shows the bridge params
+func GetCmdQueryParams(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "Show the relayer set, threshold and the other bridge params",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var params types.Params
+			return queryAndPrint(cdc, keeper.QueryParams, &params)
+		},
+	}
+}
+
+// GetCmdQueryStatus shows the bridge status
+func GetCmdQueryStatus(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "status",
+		Short: "Show the last minted event nonce, the last outgoing transfer and the bridged totals",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var status types.Status
+			return queryAndPrint(cdc, keeper.QueryStatus, &status)
+		},
+	}
+}
+
+// GetCmdQueryRelayers lists the relayers with their bonds
+func GetCmdQueryRelayers(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "relayers",
+		Short: "List the relayers with their bonds",
+		Long:  "List the relayer set and the bonded accounts that are not in the relayer set, which can withdraw their bond",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var relayers []keeper.RelayerInfo
+			return queryAndPrint(cdc, keeper.QueryRelayers, &relayers)
+		},
+	}
+}
+
+// GetCmdQueryAttestations lists the attestations of an event nonce
+func GetCmdQueryAttestations(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "attestations [event_nonce]",
+		Short: "List the claims of an event nonce with the relayers that attested them",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if _, err := strconv.ParseUint(args[0], 10, 64); err != nil {
+				return fmt.Errorf("event nonce: %s", err)
+			}
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			route := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, keeper.QueryAttestations, args[0])
+			res, _, err := cliCtx.Query(route)
+			if err != nil {
+				return err
+			}
+			// the claims are amino interfaces
+			var attestations []types.Attestation
+			if err := cdc.UnmarshalJSON(res, &attestations); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(attestations)
+		},
+	}
+}
+
+// GetCmdQueryOutgoingTransfers lists the outgoing transfers for the relayers
+func GetCmdQueryOutgoingTransfers(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "outgoing-transfers",
+		Short: "List the burns to be unlocked on Ethereum in id order",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path := fmt.Sprintf("%s/%d/%d", keeper.QueryOutgoingTransfers, viper.GetUint64(flagAfter), viper.GetUint(flagLimit))
+			var transfers []types.OutgoingTransfer
+			return queryAndPrint(cdc, path, &transfers)
+		},
+	}
+	cmd.Flags().Uint64(flagAfter, 0, "List the transfers with an id above")
+	cmd.Flags().Uint(flagLimit, keeper.DefaultOutgoingTransfersLimit, "Max number of transfers")
+	return cmd
+}
+
+// GetCmdQueryPendingBatches lists the pending outgoing batches for the relayers
+func GetCmdQueryPendingBatches(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "pending-batches",
+		Short: "List the outgoing batches to be submitted to Ethereum, highest total fee first",
+		Long: `List the pending outgoing batches with their transfers, highest total fee first. The bridge contract only
+executes a batch with an id above the last executed batch, the transfers of skipped batches return to the pool.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var batches []keeper.PendingBatch
+			return queryAndPrint(cdc, keeper.QueryPendingBatches, &batches)
+		},
+	}
+}
+
+func queryAndPrint(cdc *codec.Codec, path string, v interface{}) error {
+	cliCtx := context.NewCLIContext().WithCodec(cdc)
+	res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, path))
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(res, v); err != nil {
+		return err
+	}
+	return cliCtx.PrintOutput(v)
+}
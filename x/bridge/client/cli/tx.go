@@ -0,0 +1,262 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	"github.com/fetchai/fetchd/x/bridge/internal/types"
+	wasmUtils "github.com/fetchai/fetchd/x/wasm/client/utils"
+)
+
+const flagBridgeFee = "bridge-fee"
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Ethereum bridge transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	txCmd.AddCommand(wasmUtils.AddBroadcastFlags(flags.PostCommands(
+		AttestLockCmd(cdc),
+		AttestBatchCmd(cdc),
+		BurnForEthCmd(cdc),
+		CancelBurnForEthCmd(cdc),
+		RequestBatchCmd(cdc),
+		BondRelayerCmd(cdc),
+		UnbondRelayerCmd(cdc),
+	)...)...)
+	return txCmd
+}
+
+// AttestLockCmd submits the vote of a relayer for a lock event of the Ethereum bridge contract
+func AttestLockCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "attest-lock [event_nonce] [eth_tx_hash] [eth_sender] [recipient] [amount]",
+		Short: "Attest a lock event of the Ethereum bridge contract as relayer",
+		Long: `Attest the lock of amount base units by the Ethereum sender to the recipient, as observed in the event with
+the event nonce of the bridge contract. The coins are minted when the threshold of relayers attested the same lock.
+Relayers that attest a lock that conflicts with the minted lock of the nonce are slashed.`,
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			nonce, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("event nonce: %s", err)
+			}
+			recipient, err := sdk.AccAddressFromBech32(args[3])
+			if err != nil {
+				return fmt.Errorf("recipient: %s", err)
+			}
+			amount, ok := sdk.NewIntFromString(args[4])
+			if !ok {
+				return fmt.Errorf("invalid amount: %s", args[4])
+			}
+			msg := types.MsgAttestLock{
+				Relayer: cliCtx.GetFromAddress(),
+				Claim: types.LockClaim{
+					EventNonce: nonce,
+					EthTxHash:  args[1],
+					EthSender:  args[2],
+					Recipient:  recipient,
+					Amount:     amount,
+				},
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// AttestBatchCmd submits the vote of a relayer for the execution of an outgoing batch by the Ethereum bridge contract
+func AttestBatchCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "attest-batch [event_nonce] [eth_tx_hash] [batch_id]",
+		Short: "Attest the execution of an outgoing batch by the Ethereum bridge contract as relayer",
+		Long: `Attest that the bridge contract executed the outgoing batch, as observed in the event with the event nonce of
+the contract. The transfers of the batch are removed when the threshold of relayers attested it, the transfers of the
+pending batches with lower ids return to the pool.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			nonce, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("event nonce: %s", err)
+			}
+			batchID, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("batch id: %s", err)
+			}
+			msg := types.MsgAttestBatch{
+				Relayer: cliCtx.GetFromAddress(),
+				Claim: types.BatchExecutedClaim{
+					EventNonce: nonce,
+					EthTxHash:  args[1],
+					BatchID:    batchID,
+				},
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// BurnForEthCmd burns coins to be unlocked on Ethereum
+func BurnForEthCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn-for-eth [eth_recipient] [amount]",
+		Short: "Burn coins to be unlocked to an Ethereum address",
+		Long: `Burn amount base units of the bridge denom of the --from account. The relayers unlock the same amount of
+ERC-20 tokens to the Ethereum recipient by the bridge contract, in a batch of transfers. The --bridge-fee is burned
+with the amount and paid to the relayer that submits the batch, batches of transfers with higher fees are relayed
+first.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			amount, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid amount: %s", args[1])
+			}
+			bridgeFee, ok := sdk.NewIntFromString(viper.GetString(flagBridgeFee))
+			if !ok {
+				return fmt.Errorf("invalid bridge fee: %s", viper.GetString(flagBridgeFee))
+			}
+			msg := types.MsgBurnForEth{
+				Sender:       cliCtx.GetFromAddress(),
+				EthRecipient: args[0],
+				Amount:       amount,
+				BridgeFee:    bridgeFee,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(flagBridgeFee, "0", "Fee in base units for the relayer of the batch")
+	return cmd
+}
+
+// CancelBurnForEthCmd cancels a pooled outgoing transfer
+func CancelBurnForEthCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel-burn-for-eth [transfer_id]",
+		Short: "Cancel an outgoing transfer that is not in a batch yet",
+		Long: `Cancel an outgoing transfer of the --from account that waits in the pool, the amount and the bridge fee are
+returned. Send it again with a higher --bridge-fee to have it relayed sooner.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("transfer id: %s", err)
+			}
+			msg := types.MsgCancelBurnForEth{Sender: cliCtx.GetFromAddress(), TransferID: id}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// RequestBatchCmd builds an outgoing batch of the pooled transfers with the highest fees
+func RequestBatchCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "request-batch",
+		Short: "Build an outgoing batch of the pooled transfers with the highest bridge fees",
+		Long: `Build a batch of up to max batch size pooled outgoing transfers, highest bridge fee first. The total fee of the
+batch must exceed the fees of all pending batches.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			msg := types.MsgRequestBatch{Sender: cliCtx.GetFromAddress()}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// BondRelayerCmd adds coins to the bond of a relayer
+func BondRelayerCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "bond [amount]",
+		Short: "Add coins to your relayer bond",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			amount, err := sdk.ParseCoins(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.MsgBondRelayer{Relayer: cliCtx.GetFromAddress(), Amount: amount}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// UnbondRelayerCmd returns coins of the bond of a relayer
+func UnbondRelayerCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "unbond [amount]",
+		Short: "Withdraw coins of your relayer bond",
+		Long:  "Withdraw coins of your relayer bond, relayers in the relayer set must keep the min relayer bond",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			amount, err := sdk.ParseCoins(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.MsgUnbondRelayer{Relayer: cliCtx.GetFromAddress(), Amount: amount}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -0,0 +1,80 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/fetchai/fetchd/x/bridge/internal/keeper"
+	"github.com/fetchai/fetchd/x/bridge/internal/types"
+)
+
+func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/bridge/params", queryHandlerFn(cliCtx, keeper.QueryParams)).Methods("GET")
+	r.HandleFunc("/bridge/status", queryHandlerFn(cliCtx, keeper.QueryStatus)).Methods("GET")
+	r.HandleFunc("/bridge/relayers", queryHandlerFn(cliCtx, keeper.QueryRelayers)).Methods("GET")
+	r.HandleFunc("/bridge/attestations/{nonce}", queryAttestationsHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/bridge/outgoing_transfers", queryOutgoingTransfersHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/bridge/pending_batches", queryHandlerFn(cliCtx, keeper.QueryPendingBatches)).Methods("GET")
+}
+
+func queryHandlerFn(cliCtx context.CLIContext, path string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query(w, r, cliCtx, path)
+	}
+}
+
+func queryAttestationsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		nonce, err := strconv.ParseUint(mux.Vars(r)["nonce"], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		query(w, r, cliCtx, fmt.Sprintf("%s/%d", keeper.QueryAttestations, nonce))
+	}
+}
+
+// queryOutgoingTransfersHandlerFn lists the outgoing transfers with an id above the optional after query param
+func queryOutgoingTransfersHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var after uint64
+		if v := r.FormValue("after"); v != "" {
+			var err error
+			if after, err = strconv.ParseUint(v, 10, 64); err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+		limit := keeper.DefaultOutgoingTransfersLimit
+		if v := r.FormValue("limit"); v != "" {
+			var err error
+			if limit, err = strconv.Atoi(v); err != nil || limit <= 0 {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, "invalid limit")
+				return
+			}
+		}
+		query(w, r, cliCtx, fmt.Sprintf("%s/%d/%d", keeper.QueryOutgoingTransfers, after, limit))
+	}
+}
+
+func query(w http.ResponseWriter, r *http.Request, cliCtx context.CLIContext, path string) {
+	cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+	if !ok {
+		return
+	}
+
+	res, height, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, path))
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	cliCtx = cliCtx.WithHeight(height)
+	rest.PostProcessResponse(w, cliCtx, json.RawMessage(res))
+}
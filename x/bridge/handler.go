@@ -0,0 +1,147 @@
+package bridge
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewHandler returns a handler for "bridge" type messages.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case MsgAttestLock:
+			return handleAttestLock(ctx, k, &msg)
+		case MsgAttestBatch:
+			return handleAttestBatch(ctx, k, &msg)
+		case MsgBurnForEth:
+			return handleBurnForEth(ctx, k, &msg)
+		case MsgCancelBurnForEth:
+			return handleCancelBurnForEth(ctx, k, &msg)
+		case MsgRequestBatch:
+			return handleRequestBatch(ctx, k, &msg)
+		case MsgBondRelayer:
+			return handleBondRelayer(ctx, k, &msg)
+		case MsgUnbondRelayer:
+			return handleUnbondRelayer(ctx, k, &msg)
+		default:
+			errMsg := fmt.Sprintf("unrecognized bridge message type: %T", msg)
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+		}
+	}
+}
+
+func handleAttestLock(ctx sdk.Context, k Keeper, msg *MsgAttestLock) (*sdk.Result, error) {
+	if err := k.Attest(ctx, msg.Relayer, msg.Claim); err != nil {
+		return nil, err
+	}
+	events := ctx.EventManager().Events()
+	ourEvent := sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Relayer.String()),
+		sdk.NewAttribute(AttributeKeyEventNonce, strconv.FormatUint(msg.Claim.EventNonce, 10)),
+	)
+	return &sdk.Result{
+		Events: append(events, ourEvent),
+	}, nil
+}
+
+func handleAttestBatch(ctx sdk.Context, k Keeper, msg *MsgAttestBatch) (*sdk.Result, error) {
+	if err := k.Attest(ctx, msg.Relayer, msg.Claim); err != nil {
+		return nil, err
+	}
+	events := ctx.EventManager().Events()
+	ourEvent := sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Relayer.String()),
+		sdk.NewAttribute(AttributeKeyEventNonce, strconv.FormatUint(msg.Claim.EventNonce, 10)),
+	)
+	return &sdk.Result{
+		Events: append(events, ourEvent),
+	}, nil
+}
+
+func handleBurnForEth(ctx sdk.Context, k Keeper, msg *MsgBurnForEth) (*sdk.Result, error) {
+	id, err := k.BurnForEth(ctx, msg.Sender, msg.EthRecipient, msg.Amount, msg.BridgeFee)
+	if err != nil {
+		return nil, err
+	}
+	events := ctx.EventManager().Events()
+	ourEvent := sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		sdk.NewAttribute(AttributeKeyOutgoingID, strconv.FormatUint(id, 10)),
+	)
+	return &sdk.Result{
+		Data:   sdk.Uint64ToBigEndian(id),
+		Events: append(events, ourEvent),
+	}, nil
+}
+
+func handleCancelBurnForEth(ctx sdk.Context, k Keeper, msg *MsgCancelBurnForEth) (*sdk.Result, error) {
+	if err := k.CancelBurnForEth(ctx, msg.Sender, msg.TransferID); err != nil {
+		return nil, err
+	}
+	events := ctx.EventManager().Events()
+	ourEvent := sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		sdk.NewAttribute(AttributeKeyOutgoingID, strconv.FormatUint(msg.TransferID, 10)),
+	)
+	return &sdk.Result{
+		Events: append(events, ourEvent),
+	}, nil
+}
+
+func handleRequestBatch(ctx sdk.Context, k Keeper, msg *MsgRequestBatch) (*sdk.Result, error) {
+	id, err := k.RequestBatch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	events := ctx.EventManager().Events()
+	ourEvent := sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		sdk.NewAttribute(AttributeKeyBatchID, strconv.FormatUint(id, 10)),
+	)
+	return &sdk.Result{
+		Data:   sdk.Uint64ToBigEndian(id),
+		Events: append(events, ourEvent),
+	}, nil
+}
+
+func handleBondRelayer(ctx sdk.Context, k Keeper, msg *MsgBondRelayer) (*sdk.Result, error) {
+	if err := k.Bond(ctx, msg.Relayer, msg.Amount); err != nil {
+		return nil, err
+	}
+	return bondResult(ctx, msg.Relayer, msg.Amount), nil
+}
+
+func handleUnbondRelayer(ctx sdk.Context, k Keeper, msg *MsgUnbondRelayer) (*sdk.Result, error) {
+	if err := k.Unbond(ctx, msg.Relayer, msg.Amount); err != nil {
+		return nil, err
+	}
+	return bondResult(ctx, msg.Relayer, msg.Amount), nil
+}
+
+func bondResult(ctx sdk.Context, relayer sdk.AccAddress, amount sdk.Coins) *sdk.Result {
+	events := ctx.EventManager().Events()
+	ourEvent := sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, relayer.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+	)
+	return &sdk.Result{
+		Events: append(events, ourEvent),
+	}
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fetchai/fetchd/x/bridge/internal/types"
+)
+
+// InitGenesis stores the params and the state of the bridge of the genesis
+func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
+	keeper.setParams(ctx, data.Params)
+	keeper.setStatus(ctx, data.Status)
+	for _, r := range data.Relayers {
+		keeper.setRelayer(ctx, r)
+	}
+	for _, a := range data.Attestations {
+		keeper.setAttestation(ctx, a)
+	}
+	for _, t := range data.OutgoingTransfers {
+		keeper.setOutgoingTransfer(ctx, t)
+	}
+	for _, b := range data.OutgoingBatches {
+		keeper.setOutgoingBatch(ctx, b)
+	}
+}
+
+// ExportGenesis returns the params and the state of the bridge as genesis state
+func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
+	data := types.GenesisState{
+		Params: keeper.GetParams(ctx),
+		Status: keeper.GetStatus(ctx),
+	}
+	keeper.IterateRelayers(ctx, func(r types.Relayer) bool {
+		data.Relayers = append(data.Relayers, r)
+		return false
+	})
+	keeper.iterateAttestations(ctx, types.AttestationPrefix, func(a types.Attestation) bool {
+		data.Attestations = append(data.Attestations, a)
+		return false
+	})
+	keeper.IterateOutgoingTransfers(ctx, 0, func(t types.OutgoingTransfer) bool {
+		data.OutgoingTransfers = append(data.OutgoingTransfers, t)
+		return false
+	})
+	keeper.IterateOutgoingBatches(ctx, func(b types.OutgoingBatch) bool {
+		data.OutgoingBatches = append(data.OutgoingBatches, b)
+		return false
+	})
+	return data
+}
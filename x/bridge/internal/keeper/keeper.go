@@ -0,0 +1,533 @@
+package keeper
+
+import (
+	"bytes"
+	"sort"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/params"
+
+	"github.com/fetchai/fetchd/x/bridge/internal/types"
+)
+
+// Keeper processes the attestations of the relayers about lock events of the Ethereum bridge contract and mints
+// and burns the bridged coins
+type Keeper struct {
+	storeKey     sdk.StoreKey
+	cdc          *codec.Codec
+	paramSpace   params.Subspace
+	supplyKeeper types.SupplyKeeper
+	distrKeeper  types.DistributionKeeper
+}
+
+// NewKeeper creates a new bridge Keeper instance
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, paramSpace params.Subspace, supplyKeeper types.SupplyKeeper,
+	distrKeeper types.DistributionKeeper) Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+	return Keeper{
+		storeKey:     storeKey,
+		cdc:          cdc,
+		paramSpace:   paramSpace,
+		supplyKeeper: supplyKeeper,
+		distrKeeper:  distrKeeper,
+	}
+}
+
+// GetParams returns the total set of bridge parameters.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	var params types.Params
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+func (k Keeper) setParams(ctx sdk.Context, ps types.Params) {
+	k.paramSpace.SetParamSet(ctx, &ps)
+}
+
+// Attest adds the vote of the relayer for the claim. Claims are executed in event nonce order when the relayers in
+// the relayer set that attested them reach the threshold. A relayer that attested a claim that conflicts with the
+// executed claim of the nonce is slashed.
+func (k Keeper) Attest(ctx sdk.Context, relayer sdk.AccAddress, claim types.Claim) error {
+	params := k.GetParams(ctx)
+	if !params.IsRelayer(relayer) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not a relayer", relayer)
+	}
+	if bond := k.GetRelayer(ctx, relayer).Bond; !bond.IsAllGTE(params.MinRelayerBond) {
+		return sdkerrors.Wrapf(types.ErrInsufficientBond, "bond %s below %s", bond, params.MinRelayerBond)
+	}
+	nonce, hash := claim.GetEventNonce(), claim.Hash()
+	status := k.GetStatus(ctx)
+	if nonce <= status.LastEventNonce {
+		executed := k.getExecutedAttestation(ctx, nonce)
+		if executed == nil {
+			return sdkerrors.Wrapf(types.ErrInvalidClaim, "event nonce %d was processed", nonce)
+		}
+		if !bytes.Equal(executed.Claim.Hash(), hash) {
+			// the slash is the result of the tx, so it does not fail
+			k.Slash(ctx, relayer, params.SlashFraction, nonce)
+			return nil
+		}
+		if !executed.HasVoted(relayer) {
+			executed.Votes = append(executed.Votes, relayer)
+			k.setAttestation(ctx, *executed)
+		}
+		return nil
+	}
+
+	var err error
+	k.IterateAttestations(ctx, nonce, func(a types.Attestation) bool {
+		if a.HasVoted(relayer) {
+			err = sdkerrors.Wrapf(types.ErrDuplicateVote, "event nonce %d", nonce)
+			return true
+		}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	attestation := k.GetAttestation(ctx, nonce, hash)
+	if attestation == nil {
+		attestation = &types.Attestation{Claim: claim}
+	}
+	attestation.Votes = append(attestation.Votes, relayer)
+	k.setAttestation(ctx, *attestation)
+	return k.executeAttestations(ctx, params)
+}
+
+// executeAttestations executes the claims of the next event nonces while they have enough votes
+func (k Keeper) executeAttestations(ctx sdk.Context, params types.Params) error {
+	for {
+		status := k.GetStatus(ctx)
+		nonce := status.LastEventNonce + 1
+		var next *types.Attestation
+		k.IterateAttestations(ctx, nonce, func(a types.Attestation) bool {
+			if countVotes(a, params) >= params.Threshold {
+				next = &a
+				return true
+			}
+			return false
+		})
+		if next == nil {
+			return nil
+		}
+		switch claim := next.Claim.(type) {
+		case types.LockClaim:
+			if err := k.mint(ctx, params, claim); err != nil {
+				return err
+			}
+			status.TotalMinted = status.TotalMinted.Add(claim.Amount)
+		case types.BatchExecutedClaim:
+			k.executeBatch(ctx, claim)
+		}
+		next.Executed = true
+		k.setAttestation(ctx, *next)
+
+		// the relayers of the other claims of the nonce attested an event that did not happen
+		var conflicting []types.Attestation
+		k.IterateAttestations(ctx, nonce, func(a types.Attestation) bool {
+			if !a.Executed {
+				conflicting = append(conflicting, a)
+			}
+			return false
+		})
+		for _, a := range conflicting {
+			for _, v := range a.Votes {
+				k.Slash(ctx, v, params.SlashFraction, nonce)
+			}
+			ctx.KVStore(k.storeKey).Delete(types.GetAttestationKey(nonce, a.Claim.Hash()))
+		}
+
+		status.LastEventNonce = nonce
+		k.setStatus(ctx, status)
+	}
+}
+
+func (k Keeper) mint(ctx sdk.Context, params types.Params, claim types.LockClaim) error {
+	amount := sdk.NewCoins(sdk.NewCoin(params.Denom, claim.Amount))
+	if err := k.supplyKeeper.MintCoins(ctx, types.ModuleName, amount); err != nil {
+		return err
+	}
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, claim.Recipient, amount); err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeMint,
+		sdk.NewAttribute(types.AttributeKeyEventNonce, strconv.FormatUint(claim.EventNonce, 10)),
+		sdk.NewAttribute(types.AttributeKeyEthTxHash, claim.EthTxHash),
+		sdk.NewAttribute(types.AttributeKeyRecipient, claim.Recipient.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+	))
+	return nil
+}
+
+// countVotes returns the number of votes of relayers that are still in the relayer set
+func countVotes(a types.Attestation, params types.Params) uint64 {
+	var n uint64
+	for _, v := range a.Votes {
+		if params.IsRelayer(v) {
+			n++
+		}
+	}
+	return n
+}
+
+// Slash moves the fraction of the bond of the relayer to the community pool
+func (k Keeper) Slash(ctx sdk.Context, addr sdk.AccAddress, fraction sdk.Dec, nonce uint64) {
+	relayer := k.GetRelayer(ctx, addr)
+	amount, _ := sdk.NewDecCoinsFromCoins(relayer.Bond...).MulDec(fraction).TruncateDecimal()
+	if !amount.IsZero() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, amount, k.supplyKeeper.GetModuleAddress(types.ModuleName)); err != nil {
+			// the bonds are held by the module account
+			panic(err)
+		}
+		relayer.Bond = relayer.Bond.Sub(amount)
+		k.setRelayer(ctx, relayer)
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSlash,
+		sdk.NewAttribute(types.AttributeKeyRelayer, addr.String()),
+		sdk.NewAttribute(types.AttributeKeyEventNonce, strconv.FormatUint(nonce, 10)),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+	))
+}
+
+// BurnForEth burns the amount and the bridge fee of the sender and adds the outgoing transfer to the Ethereum
+// recipient to the pool
+func (k Keeper) BurnForEth(ctx sdk.Context, sender sdk.AccAddress, ethRecipient string, amount, bridgeFee sdk.Int) (uint64, error) {
+	params := k.GetParams(ctx)
+	if len(params.Relayers) == 0 {
+		return 0, types.ErrBridgeDisabled
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(params.Denom, amount.Add(bridgeFee)))
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, coins); err != nil {
+		return 0, err
+	}
+	if err := k.supplyKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return 0, err
+	}
+	status := k.GetStatus(ctx)
+	status.LastOutgoingID++
+	status.TotalBurned = status.TotalBurned.Add(amount).Add(bridgeFee)
+	k.setStatus(ctx, status)
+	k.setOutgoingTransfer(ctx, types.OutgoingTransfer{
+		ID:           status.LastOutgoingID,
+		Sender:       sender,
+		EthRecipient: ethRecipient,
+		Amount:       amount,
+		BridgeFee:    bridgeFee,
+		Height:       ctx.BlockHeight(),
+	})
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBurn,
+		sdk.NewAttribute(types.AttributeKeyOutgoingID, strconv.FormatUint(status.LastOutgoingID, 10)),
+		sdk.NewAttribute(types.AttributeKeyEthRecipient, ethRecipient),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		sdk.NewAttribute(types.AttributeKeyBridgeFee, bridgeFee.String()),
+	))
+	return status.LastOutgoingID, nil
+}
+
+// CancelBurnForEth removes the outgoing transfer of the sender from the pool and mints back its amount and bridge
+// fee. Transfers of a batch can not be cancelled.
+func (k Keeper) CancelBurnForEth(ctx sdk.Context, sender sdk.AccAddress, id uint64) error {
+	transfer := k.GetOutgoingTransfer(ctx, id)
+	if transfer == nil || !transfer.Sender.Equals(sender) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "no outgoing transfer %d of %s", id, sender)
+	}
+	if transfer.BatchID != 0 {
+		return sdkerrors.Wrapf(types.ErrInvalidBatch, "outgoing transfer %d is in batch %d", id, transfer.BatchID)
+	}
+	refund := transfer.Amount.Add(transfer.BridgeFee)
+	coins := sdk.NewCoins(sdk.NewCoin(k.GetParams(ctx).Denom, refund))
+	if err := k.supplyKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, coins); err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Delete(types.GetOutgoingTransferKey(id))
+	status := k.GetStatus(ctx)
+	status.TotalBurned = status.TotalBurned.Sub(refund)
+	k.setStatus(ctx, status)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCancelBurn,
+		sdk.NewAttribute(types.AttributeKeyOutgoingID, strconv.FormatUint(id, 10)),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+	))
+	return nil
+}
+
+// RequestBatch builds a batch of up to max batch size pooled outgoing transfers with the highest bridge fees. The
+// batch must have higher total fees than the pending batches, since the bridge contract only executes batches with
+// an id above the last executed one and relayers submit the most profitable batch.
+func (k Keeper) RequestBatch(ctx sdk.Context) (uint64, error) {
+	params := k.GetParams(ctx)
+	var pool []types.OutgoingTransfer
+	k.IterateOutgoingTransfers(ctx, 0, func(t types.OutgoingTransfer) bool {
+		if t.BatchID == 0 {
+			pool = append(pool, t)
+		}
+		return false
+	})
+	if len(pool) == 0 {
+		return 0, sdkerrors.Wrap(types.ErrInvalidBatch, "no pooled outgoing transfers")
+	}
+	// highest fee first, the oldest of equal fees first
+	sort.SliceStable(pool, func(i, j int) bool {
+		return pool[i].BridgeFee.GT(pool[j].BridgeFee)
+	})
+	if uint64(len(pool)) > params.MaxBatchSize {
+		pool = pool[:params.MaxBatchSize]
+	}
+	totalFee := sdk.ZeroInt()
+	for _, t := range pool {
+		totalFee = totalFee.Add(t.BridgeFee)
+	}
+	var err error
+	k.IterateOutgoingBatches(ctx, func(b types.OutgoingBatch) bool {
+		if !totalFee.GT(b.TotalFee) {
+			err = sdkerrors.Wrapf(types.ErrInvalidBatch, "fees %s do not exceed the fees of pending batch %d", totalFee, b.ID)
+			return true
+		}
+		return false
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	status := k.GetStatus(ctx)
+	status.LastBatchID++
+	k.setStatus(ctx, status)
+	batch := types.OutgoingBatch{ID: status.LastBatchID, TotalFee: totalFee, Height: ctx.BlockHeight()}
+	for _, t := range pool {
+		t.BatchID = batch.ID
+		k.setOutgoingTransfer(ctx, t)
+		batch.TransferIDs = append(batch.TransferIDs, t.ID)
+	}
+	k.setOutgoingBatch(ctx, batch)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBatch,
+		sdk.NewAttribute(types.AttributeKeyBatchID, strconv.FormatUint(batch.ID, 10)),
+		sdk.NewAttribute(types.AttributeKeyBridgeFee, totalFee.String()),
+	))
+	return batch.ID, nil
+}
+
+// executeBatch removes the executed batch with its transfers and returns the transfers of the pending batches with
+// lower ids, which the bridge contract does not execute anymore, to the pool
+func (k Keeper) executeBatch(ctx sdk.Context, claim types.BatchExecutedClaim) {
+	var batches []types.OutgoingBatch
+	k.IterateOutgoingBatches(ctx, func(b types.OutgoingBatch) bool {
+		if b.ID > claim.BatchID {
+			return true
+		}
+		batches = append(batches, b)
+		return false
+	})
+	store := ctx.KVStore(k.storeKey)
+	for _, b := range batches {
+		for _, id := range b.TransferIDs {
+			if b.ID == claim.BatchID {
+				store.Delete(types.GetOutgoingTransferKey(id))
+				continue
+			}
+			if t := k.GetOutgoingTransfer(ctx, id); t != nil {
+				t.BatchID = 0
+				k.setOutgoingTransfer(ctx, *t)
+			}
+		}
+		store.Delete(types.GetOutgoingBatchKey(b.ID))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBatchExecuted,
+		sdk.NewAttribute(types.AttributeKeyEventNonce, strconv.FormatUint(claim.EventNonce, 10)),
+		sdk.NewAttribute(types.AttributeKeyEthTxHash, claim.EthTxHash),
+		sdk.NewAttribute(types.AttributeKeyBatchID, strconv.FormatUint(claim.BatchID, 10)),
+	))
+}
+
+// Bond adds the amount to the bond of the relayer
+func (k Keeper) Bond(ctx sdk.Context, addr sdk.AccAddress, amount sdk.Coins) error {
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, addr, types.ModuleName, amount); err != nil {
+		return err
+	}
+	relayer := k.GetRelayer(ctx, addr)
+	relayer.Bond = relayer.Bond.Add(amount...)
+	k.setRelayer(ctx, relayer)
+	return nil
+}
+
+// Unbond returns the amount of the bond. Relayers in the relayer set must keep the min relayer bond.
+func (k Keeper) Unbond(ctx sdk.Context, addr sdk.AccAddress, amount sdk.Coins) error {
+	relayer := k.GetRelayer(ctx, addr)
+	rest, hasNeg := relayer.Bond.SafeSub(amount)
+	if hasNeg {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "bond %s", relayer.Bond)
+	}
+	params := k.GetParams(ctx)
+	if params.IsRelayer(addr) && !rest.IsAllGTE(params.MinRelayerBond) {
+		return sdkerrors.Wrapf(types.ErrInsufficientBond, "relayers must keep %s", params.MinRelayerBond)
+	}
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, amount); err != nil {
+		return err
+	}
+	relayer.Bond = rest
+	k.setRelayer(ctx, relayer)
+	return nil
+}
+
+// GetStatus returns the state of the bridge
+func (k Keeper) GetStatus(ctx sdk.Context) types.Status {
+	bz := ctx.KVStore(k.storeKey).Get(types.StatusKey)
+	if bz == nil {
+		return types.DefaultStatus()
+	}
+	var status types.Status
+	k.cdc.MustUnmarshalBinaryBare(bz, &status)
+	return status
+}
+
+func (k Keeper) setStatus(ctx sdk.Context, status types.Status) {
+	ctx.KVStore(k.storeKey).Set(types.StatusKey, k.cdc.MustMarshalBinaryBare(status))
+}
+
+// GetRelayer returns the bond of the relayer, which is empty when none was bonded
+func (k Keeper) GetRelayer(ctx sdk.Context, addr sdk.AccAddress) types.Relayer {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetRelayerKey(addr))
+	if bz == nil {
+		return types.Relayer{Address: addr, Bond: sdk.NewCoins()}
+	}
+	var relayer types.Relayer
+	k.cdc.MustUnmarshalBinaryBare(bz, &relayer)
+	return relayer
+}
+
+func (k Keeper) setRelayer(ctx sdk.Context, relayer types.Relayer) {
+	store := ctx.KVStore(k.storeKey)
+	if relayer.Bond.IsZero() {
+		store.Delete(types.GetRelayerKey(relayer.Address))
+		return
+	}
+	store.Set(types.GetRelayerKey(relayer.Address), k.cdc.MustMarshalBinaryBare(relayer))
+}
+
+// IterateRelayers calls cb for all bonded relayers, until cb returns true
+func (k Keeper) IterateRelayers(ctx sdk.Context, cb func(types.Relayer) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.RelayerPrefix).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var relayer types.Relayer
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &relayer)
+		if cb(relayer) {
+			return
+		}
+	}
+}
+
+// GetAttestation returns the attestation of the claim with the hash for the nonce, nil when there is none
+func (k Keeper) GetAttestation(ctx sdk.Context, nonce uint64, claimHash []byte) *types.Attestation {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetAttestationKey(nonce, claimHash))
+	if bz == nil {
+		return nil
+	}
+	var attestation types.Attestation
+	k.cdc.MustUnmarshalBinaryBare(bz, &attestation)
+	return &attestation
+}
+
+func (k Keeper) getExecutedAttestation(ctx sdk.Context, nonce uint64) *types.Attestation {
+	var res *types.Attestation
+	k.IterateAttestations(ctx, nonce, func(a types.Attestation) bool {
+		if a.Executed {
+			res = &a
+			return true
+		}
+		return false
+	})
+	return res
+}
+
+func (k Keeper) setAttestation(ctx sdk.Context, a types.Attestation) {
+	ctx.KVStore(k.storeKey).Set(types.GetAttestationKey(a.Claim.GetEventNonce(), a.Claim.Hash()), k.cdc.MustMarshalBinaryBare(a))
+}
+
+// IterateAttestations calls cb for the attestations of the claims of the event nonce, until cb returns true
+func (k Keeper) IterateAttestations(ctx sdk.Context, nonce uint64, cb func(types.Attestation) bool) {
+	k.iterateAttestations(ctx, types.GetAttestationPrefix(nonce), cb)
+}
+
+func (k Keeper) iterateAttestations(ctx sdk.Context, prefixKey []byte, cb func(types.Attestation) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), prefixKey).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var attestation types.Attestation
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &attestation)
+		if cb(attestation) {
+			return
+		}
+	}
+}
+
+// GetOutgoingTransfer returns the outgoing transfer with the id, nil when there is none
+func (k Keeper) GetOutgoingTransfer(ctx sdk.Context, id uint64) *types.OutgoingTransfer {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetOutgoingTransferKey(id))
+	if bz == nil {
+		return nil
+	}
+	var transfer types.OutgoingTransfer
+	k.cdc.MustUnmarshalBinaryBare(bz, &transfer)
+	return &transfer
+}
+
+func (k Keeper) setOutgoingTransfer(ctx sdk.Context, transfer types.OutgoingTransfer) {
+	ctx.KVStore(k.storeKey).Set(types.GetOutgoingTransferKey(transfer.ID), k.cdc.MustMarshalBinaryBare(transfer))
+}
+
+// IterateOutgoingTransfers calls cb for the outgoing transfers with an id above after in id order, until cb
+// returns true
+func (k Keeper) IterateOutgoingTransfers(ctx sdk.Context, after uint64, cb func(types.OutgoingTransfer) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.OutgoingTransferPrefix).
+		Iterator(sdk.Uint64ToBigEndian(after+1), nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var transfer types.OutgoingTransfer
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &transfer)
+		if cb(transfer) {
+			return
+		}
+	}
+}
+
+// GetOutgoingBatch returns the pending outgoing batch with the id, nil when there is none
+func (k Keeper) GetOutgoingBatch(ctx sdk.Context, id uint64) *types.OutgoingBatch {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetOutgoingBatchKey(id))
+	if bz == nil {
+		return nil
+	}
+	var batch types.OutgoingBatch
+	k.cdc.MustUnmarshalBinaryBare(bz, &batch)
+	return &batch
+}
+
+func (k Keeper) setOutgoingBatch(ctx sdk.Context, batch types.OutgoingBatch) {
+	ctx.KVStore(k.storeKey).Set(types.GetOutgoingBatchKey(batch.ID), k.cdc.MustMarshalBinaryBare(batch))
+}
+
+// IterateOutgoingBatches calls cb for the pending outgoing batches in id order, until cb returns true
+func (k Keeper) IterateOutgoingBatches(ctx sdk.Context, cb func(types.OutgoingBatch) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.OutgoingBatchPrefix).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var batch types.OutgoingBatch
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &batch)
+		if cb(batch) {
+			return
+		}
+	}
+}
@@ -0,0 +1,309 @@
+package keeper
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/fetchai/fetchd/testutil"
+	"github.com/fetchai/fetchd/x/bridge/internal/types"
+)
+
+var (
+	relayer1  = sdk.AccAddress([]byte("relayer1____________"))
+	relayer2  = sdk.AccAddress([]byte("relayer2____________"))
+	relayer3  = sdk.AccAddress([]byte("relayer3____________"))
+	recipient = sdk.AccAddress([]byte("recipient___________"))
+)
+
+func fet(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("afet", amount)) }
+
+func setupKeeper(t *testing.T) (sdk.Context, Keeper, *testutil.SupplyKeeper) {
+	cdc := codec.New()
+	types.RegisterCodec(cdc)
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	ctx, paramsKeeper := testutil.NewContext(t, cdc, abci.Header{Height: 10}, key)
+	sk := testutil.NewSupplyKeeper(map[string]sdk.Coins{})
+	k := NewKeeper(cdc, key, paramsKeeper.Subspace(types.DefaultParamspace), sk, mockDistrKeeper{sk})
+
+	p := types.DefaultParams()
+	p.Relayers = []sdk.AccAddress{relayer1, relayer2, relayer3}
+	p.Threshold = 2
+	p.MinRelayerBond = fet(100)
+	InitGenesis(ctx, k, types.GenesisState{Params: p, Status: types.DefaultStatus()})
+	for _, r := range p.Relayers {
+		sk.Balances[r.String()] = fet(1000)
+		require.NoError(t, k.Bond(ctx, r, fet(100)))
+	}
+	return ctx, k, sk
+}
+
+func claim(nonce uint64, amount int64) types.LockClaim {
+	return types.LockClaim{
+		EventNonce: nonce,
+		EthTxHash:  "0x" + strings.Repeat("ab", 32),
+		EthSender:  "0x" + strings.Repeat("12", 20),
+		Recipient:  recipient,
+		Amount:     sdk.NewInt(amount),
+	}
+}
+
+func TestAttest(t *testing.T) {
+	type vote struct {
+		relayer sdk.AccAddress
+		claim   types.LockClaim
+	}
+	specs := map[string]struct {
+		votes      []vote
+		expErr     *sdkerrors.Error
+		expMinted  int64
+		expNonce   uint64
+		expSlashed []sdk.AccAddress
+	}{
+		"below threshold": {
+			votes: []vote{{relayer1, claim(1, 10)}},
+		},
+		"threshold": {
+			votes:     []vote{{relayer1, claim(1, 10)}, {relayer2, claim(1, 10)}},
+			expMinted: 10, expNonce: 1,
+		},
+		"late vote": {
+			votes:     []vote{{relayer1, claim(1, 10)}, {relayer2, claim(1, 10)}, {relayer3, claim(1, 10)}},
+			expMinted: 10, expNonce: 1,
+		},
+		"in nonce order": {
+			votes: []vote{
+				{relayer1, claim(2, 20)}, {relayer2, claim(2, 20)},
+				{relayer1, claim(1, 10)}, {relayer2, claim(1, 10)},
+			},
+			expMinted: 30, expNonce: 2,
+		},
+		"gap": {
+			votes:    []vote{{relayer1, claim(2, 20)}, {relayer2, claim(2, 20)}},
+			expNonce: 0,
+		},
+		"conflicting claim": {
+			votes:     []vote{{relayer1, claim(1, 99)}, {relayer2, claim(1, 10)}, {relayer3, claim(1, 10)}},
+			expMinted: 10, expNonce: 1, expSlashed: []sdk.AccAddress{relayer1},
+		},
+		"late conflicting claim": {
+			votes:     []vote{{relayer1, claim(1, 10)}, {relayer2, claim(1, 10)}, {relayer3, claim(1, 99)}},
+			expMinted: 10, expNonce: 1, expSlashed: []sdk.AccAddress{relayer3},
+		},
+		"duplicate vote": {
+			votes:  []vote{{relayer1, claim(1, 10)}, {relayer1, claim(1, 11)}},
+			expErr: types.ErrDuplicateVote,
+		},
+		"not a relayer": {
+			votes:  []vote{{recipient, claim(1, 10)}},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, k, sk := setupKeeper(t)
+			var err error
+			for _, v := range spec.votes {
+				if err = k.Attest(ctx, v.relayer, v.claim); err != nil {
+					break
+				}
+			}
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(err), "got %+v", err)
+				return
+			}
+			require.NoError(t, err)
+			status := k.GetStatus(ctx)
+			assert.Equal(t, spec.expNonce, status.LastEventNonce)
+			assert.Equal(t, sdk.NewInt(spec.expMinted).String(), status.TotalMinted.String())
+			assert.Equal(t, fet(spec.expMinted).String(), sk.Balances[recipient.String()].String())
+			for _, r := range []sdk.AccAddress{relayer1, relayer2, relayer3} {
+				expBond := fet(100)
+				for _, s := range spec.expSlashed {
+					if s.Equals(r) {
+						expBond = fet(90)
+					}
+				}
+				assert.Equal(t, expBond.String(), k.GetRelayer(ctx, r).Bond.String(), r.String())
+			}
+			assert.Equal(t, fet(10*int64(len(spec.expSlashed))).String(), sk.Balances["community_pool"].String())
+		})
+	}
+}
+
+func TestAttestInsufficientBond(t *testing.T) {
+	ctx, k, _ := setupKeeper(t)
+	p := k.GetParams(ctx)
+	p.MinRelayerBond = fet(101)
+	k.setParams(ctx, p)
+
+	err := k.Attest(ctx, relayer1, claim(1, 10))
+	assert.True(t, types.ErrInsufficientBond.Is(err))
+
+	require.NoError(t, k.Bond(ctx, relayer1, fet(1)))
+	assert.NoError(t, k.Attest(ctx, relayer1, claim(1, 10)))
+}
+
+func TestBurnForEth(t *testing.T) {
+	ctx, k, sk := setupKeeper(t)
+	sk.Balances[recipient.String()] = fet(100)
+	ethRecipient := "0x" + strings.Repeat("34", 20)
+
+	id, err := k.BurnForEth(ctx, recipient, ethRecipient, sdk.NewInt(40), sdk.ZeroInt())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), id)
+	id, err = k.BurnForEth(ctx, recipient, ethRecipient, sdk.NewInt(55), sdk.NewInt(5))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), id)
+	_, err = k.BurnForEth(ctx, recipient, ethRecipient, sdk.NewInt(1), sdk.ZeroInt())
+	require.Error(t, err)
+
+	assert.True(t, sk.Balances[recipient.String()].IsZero())
+	assert.Equal(t, "100", k.GetStatus(ctx).TotalBurned.String())
+	assert.Equal(t, &types.OutgoingTransfer{
+		ID: 2, Sender: recipient, EthRecipient: ethRecipient, Amount: sdk.NewInt(55), BridgeFee: sdk.NewInt(5), Height: 10,
+	}, k.GetOutgoingTransfer(ctx, 2))
+	var ids []uint64
+	k.IterateOutgoingTransfers(ctx, 1, func(t types.OutgoingTransfer) bool {
+		ids = append(ids, t.ID)
+		return false
+	})
+	assert.Equal(t, []uint64{2}, ids)
+
+	p := k.GetParams(ctx)
+	p.Relayers = nil
+	k.setParams(ctx, p)
+	_, err = k.BurnForEth(ctx, relayer1, ethRecipient, sdk.NewInt(1), sdk.ZeroInt())
+	assert.True(t, types.ErrBridgeDisabled.Is(err))
+}
+
+func TestOutgoingBatches(t *testing.T) {
+	ctx, k, sk := setupKeeper(t)
+	sk.Balances[recipient.String()] = fet(1000)
+	ethRecipient := "0x" + strings.Repeat("34", 20)
+	p := k.GetParams(ctx)
+	p.MaxBatchSize = 2
+	k.setParams(ctx, p)
+	burn := func(fee int64) {
+		_, err := k.BurnForEth(ctx, recipient, ethRecipient, sdk.NewInt(10), sdk.NewInt(fee))
+		require.NoError(t, err)
+	}
+	batchIDs := func() map[uint64]uint64 {
+		res := make(map[uint64]uint64)
+		k.IterateOutgoingTransfers(ctx, 0, func(t types.OutgoingTransfer) bool {
+			res[t.ID] = t.BatchID
+			return false
+		})
+		return res
+	}
+
+	_, err := k.RequestBatch(ctx)
+	assert.True(t, types.ErrInvalidBatch.Is(err), "empty pool")
+
+	// highest fees first, the oldest of equal fees first
+	burn(1)
+	burn(3)
+	burn(1)
+	id, err := k.RequestBatch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, &types.OutgoingBatch{ID: 1, TransferIDs: []uint64{2, 1}, TotalFee: sdk.NewInt(4), Height: 10}, k.GetOutgoingBatch(ctx, id))
+	assert.Equal(t, map[uint64]uint64{1: 1, 2: 1, 3: 0}, batchIDs())
+
+	// batched transfers can not be cancelled
+	assert.Error(t, k.CancelBurnForEth(ctx, recipient, 1))
+	assert.Error(t, k.CancelBurnForEth(ctx, relayer1, 3))
+
+	// a batch must be more profitable than the pending batches
+	_, err = k.RequestBatch(ctx)
+	assert.True(t, types.ErrInvalidBatch.Is(err))
+	burn(4)
+	id, err = k.RequestBatch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), id)
+	assert.Equal(t, map[uint64]uint64{1: 1, 2: 1, 3: 2, 4: 2}, batchIDs())
+
+	// executing batch 2 removes its transfers and returns the transfers of batch 1 to the pool
+	require.NoError(t, k.Attest(ctx, relayer1, types.BatchExecutedClaim{EventNonce: 1, EthTxHash: claim(1, 1).EthTxHash, BatchID: 2}))
+	require.NoError(t, k.Attest(ctx, relayer2, types.BatchExecutedClaim{EventNonce: 1, EthTxHash: claim(1, 1).EthTxHash, BatchID: 2}))
+	assert.Equal(t, map[uint64]uint64{1: 0, 2: 0}, batchIDs())
+	assert.Nil(t, k.GetOutgoingBatch(ctx, 1))
+	assert.Nil(t, k.GetOutgoingBatch(ctx, 2))
+
+	// pooled transfers are refunded with their fee
+	balance := sk.Balances[recipient.String()]
+	require.NoError(t, k.CancelBurnForEth(ctx, recipient, 2))
+	assert.Equal(t, balance.Add(fet(13)...).String(), sk.Balances[recipient.String()].String())
+	assert.Equal(t, map[uint64]uint64{1: 0}, batchIDs())
+	assert.Equal(t, "36", k.GetStatus(ctx).TotalBurned.String())
+}
+
+func TestUnbond(t *testing.T) {
+	specs := map[string]struct {
+		relayer sdk.AccAddress
+		bond    sdk.Coins
+		amount  sdk.Coins
+		expBond sdk.Coins
+		expErr  bool
+	}{
+		"surplus":         {relayer: relayer1, bond: fet(50), amount: fet(50), expBond: fet(100)},
+		"below min bond":  {relayer: relayer1, bond: fet(50), amount: fet(51), expErr: true},
+		"exceeds bond":    {relayer: recipient, bond: fet(50), amount: fet(51), expErr: true},
+		"left relayers":   {relayer: recipient, bond: fet(50), amount: fet(50), expBond: sdk.NewCoins()},
+		"unbonded denoms": {relayer: relayer1, amount: sdk.NewCoins(sdk.NewInt64Coin("other", 1)), expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, k, sk := setupKeeper(t)
+			sk.Balances[recipient.String()] = fet(1000)
+			if !spec.bond.Empty() {
+				require.NoError(t, k.Bond(ctx, spec.relayer, spec.bond))
+			}
+			err := k.Unbond(ctx, spec.relayer, spec.amount)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.expBond.String(), k.GetRelayer(ctx, spec.relayer).Bond.String())
+		})
+	}
+}
+
+func TestExportGenesis(t *testing.T) {
+	ctx, k, sk := setupKeeper(t)
+	require.NoError(t, k.Attest(ctx, relayer1, claim(1, 10)))
+	require.NoError(t, k.Attest(ctx, relayer2, claim(1, 10)))
+	require.NoError(t, k.Attest(ctx, relayer1, claim(2, 20)))
+	sk.Balances[recipient.String()] = fet(100)
+	for i := 0; i < 2; i++ {
+		_, err := k.BurnForEth(ctx, recipient, "0x"+strings.Repeat("34", 20), sdk.NewInt(10), sdk.NewInt(1))
+		require.NoError(t, err)
+	}
+	_, err := k.RequestBatch(ctx)
+	require.NoError(t, err)
+
+	exported := ExportGenesis(ctx, k)
+	require.NoError(t, types.ValidateGenesis(exported))
+	assert.Len(t, exported.Relayers, 3)
+	assert.Len(t, exported.Attestations, 2)
+	assert.Len(t, exported.OutgoingTransfers, 2)
+	assert.Len(t, exported.OutgoingBatches, 1)
+
+	ctx2, k2, _ := setupKeeper(t)
+	InitGenesis(ctx2, k2, exported)
+	assert.Equal(t, exported, ExportGenesis(ctx2, k2))
+}
+
+type mockDistrKeeper struct {
+	sk *testutil.SupplyKeeper
+}
+
+func (m mockDistrKeeper) FundCommunityPool(_ sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	return m.sk.Send(sender.String(), "community_pool", amount)
+}
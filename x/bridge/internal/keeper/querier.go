@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/fetchai/fetchd/x/bridge/internal/types"
+)
+
+const (
+	// QueryParams returns the bridge params
+	QueryParams = "params"
+	// QueryStatus returns the bridge status
+	QueryStatus = "status"
+	// QueryRelayers lists the relayer set with the bonds and the bonded accounts that left it
+	QueryRelayers = "relayers"
+	// QueryAttestations lists the attestations of an event nonce, path: nonce
+	QueryAttestations = "attestations"
+	// QueryOutgoingTransfers lists the outgoing transfers in id order, path: after id, optional limit
+	QueryOutgoingTransfers = "outgoing-transfers"
+	// QueryPendingBatches lists the pending outgoing batches with their transfers, highest total fee first
+	QueryPendingBatches = "pending-batches"
+)
+
+// DefaultOutgoingTransfersLimit is the max number of outgoing transfers returned when no limit is given
+const DefaultOutgoingTransfersLimit = 100
+
+// RelayerInfo is a relayer with its bond
+type RelayerInfo struct {
+	types.Relayer
+	// Active is set for relayers in the relayer set
+	Active bool `json:"active"`
+}
+
+// PendingBatch is a pending outgoing batch with its transfers
+type PendingBatch struct {
+	types.OutgoingBatch
+	Transfers []types.OutgoingTransfer `json:"transfers"`
+}
+
+// NewQuerier creates a new querier
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		switch path[0] {
+		case QueryParams:
+			return marshal(keeper.GetParams(ctx))
+		case QueryStatus:
+			return marshal(keeper.GetStatus(ctx))
+		case QueryRelayers:
+			return queryRelayers(ctx, keeper)
+		case QueryAttestations:
+			if len(path) < 2 {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "event nonce required")
+			}
+			nonce, err := strconv.ParseUint(path[1], 10, 64)
+			if err != nil {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "event nonce")
+			}
+			return queryAttestations(ctx, nonce, keeper)
+		case QueryOutgoingTransfers:
+			if len(path) < 2 {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "after id required")
+			}
+			after, err := strconv.ParseUint(path[1], 10, 64)
+			if err != nil {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "after id")
+			}
+			limit := DefaultOutgoingTransfersLimit
+			if len(path) > 2 {
+				if limit, err = strconv.Atoi(path[2]); err != nil || limit <= 0 {
+					return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "limit")
+				}
+			}
+			return queryOutgoingTransfers(ctx, after, limit, keeper)
+		case QueryPendingBatches:
+			return queryPendingBatches(ctx, keeper)
+		default:
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown data query endpoint")
+		}
+	}
+}
+
+func queryRelayers(ctx sdk.Context, keeper Keeper) ([]byte, error) {
+	params := keeper.GetParams(ctx)
+	res := make([]RelayerInfo, 0, len(params.Relayers))
+	for _, addr := range params.Relayers {
+		res = append(res, RelayerInfo{Relayer: keeper.GetRelayer(ctx, addr), Active: true})
+	}
+	keeper.IterateRelayers(ctx, func(r types.Relayer) bool {
+		if !params.IsRelayer(r.Address) {
+			res = append(res, RelayerInfo{Relayer: r})
+		}
+		return false
+	})
+	return marshal(res)
+}
+
+func queryAttestations(ctx sdk.Context, nonce uint64, keeper Keeper) ([]byte, error) {
+	res := []types.Attestation{}
+	keeper.IterateAttestations(ctx, nonce, func(a types.Attestation) bool {
+		res = append(res, a)
+		return false
+	})
+	// the claims are amino interfaces
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryPendingBatches(ctx sdk.Context, keeper Keeper) ([]byte, error) {
+	res := []PendingBatch{}
+	keeper.IterateOutgoingBatches(ctx, func(b types.OutgoingBatch) bool {
+		batch := PendingBatch{OutgoingBatch: b, Transfers: make([]types.OutgoingTransfer, 0, len(b.TransferIDs))}
+		for _, id := range b.TransferIDs {
+			if t := keeper.GetOutgoingTransfer(ctx, id); t != nil {
+				batch.Transfers = append(batch.Transfers, *t)
+			}
+		}
+		res = append(res, batch)
+		return false
+	})
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].TotalFee.GT(res[j].TotalFee)
+	})
+	return marshal(res)
+}
+
+func queryOutgoingTransfers(ctx sdk.Context, after uint64, limit int, keeper Keeper) ([]byte, error) {
+	res := []types.OutgoingTransfer{}
+	keeper.IterateOutgoingTransfers(ctx, after, func(t types.OutgoingTransfer) bool {
+		res = append(res, t)
+		return len(res) >= limit
+	})
+	return marshal(res)
+}
+
+func marshal(v interface{}) ([]byte, error) {
+	bz, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
@@ -0,0 +1,199 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Claim is the claim of a relayer about an event of the Ethereum bridge contract. The contract increases a single
+// event nonce for all its events, so claims are executed strictly in nonce order.
+type Claim interface {
+	GetEventNonce() uint64
+	ValidateBasic() error
+	// Hash identifies the claim, relayers attest the same claim when the hashes are equal
+	Hash() []byte
+}
+
+var (
+	_ Claim = LockClaim{}
+	_ Claim = BatchExecutedClaim{}
+)
+
+// LockClaim is the claim of a relayer about a lock event of the Ethereum bridge contract, the amount is minted to
+// the recipient
+type LockClaim struct {
+	EventNonce uint64 `json:"event_nonce" yaml:"event_nonce"`
+	// EthTxHash is the 0x prefixed hash of the Ethereum tx of the lock
+	EthTxHash string `json:"eth_tx_hash" yaml:"eth_tx_hash"`
+	// EthSender is the 0x prefixed Ethereum address that locked the tokens
+	EthSender string         `json:"eth_sender" yaml:"eth_sender"`
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	// Amount is the locked amount in base units, which are minted 1:1
+	Amount sdk.Int `json:"amount" yaml:"amount"`
+}
+
+// GetEventNonce returns the event nonce of the lock
+func (c LockClaim) GetEventNonce() uint64 {
+	return c.EventNonce
+}
+
+// ValidateBasic performs basic validation of the claim
+func (c LockClaim) ValidateBasic() error {
+	if c.EventNonce == 0 {
+		return sdkerrors.Wrap(ErrInvalidClaim, "event nonce must be positive")
+	}
+	if err := validateHex(c.EthTxHash, 32); err != nil {
+		return sdkerrors.Wrap(ErrInvalidClaim, "eth tx hash")
+	}
+	if err := ValidateEthAddress(c.EthSender); err != nil {
+		return sdkerrors.Wrap(err, "eth sender")
+	}
+	if err := sdk.VerifyAddressFormat(c.Recipient); err != nil {
+		return sdkerrors.Wrap(err, "recipient")
+	}
+	if c.Amount.IsNil() || !c.Amount.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidClaim, "amount must be positive")
+	}
+	return nil
+}
+
+// Hash returns the hash of the claim
+func (c LockClaim) Hash() []byte {
+	return claimHash(c)
+}
+
+// BatchExecutedClaim is the claim of a relayer that the bridge contract executed an outgoing batch. The contract
+// only executes batches with an id above the last executed one, so the pending batches with lower ids are released.
+type BatchExecutedClaim struct {
+	EventNonce uint64 `json:"event_nonce" yaml:"event_nonce"`
+	// EthTxHash is the 0x prefixed hash of the Ethereum tx of the batch execution
+	EthTxHash string `json:"eth_tx_hash" yaml:"eth_tx_hash"`
+	BatchID   uint64 `json:"batch_id" yaml:"batch_id"`
+}
+
+// GetEventNonce returns the event nonce of the batch execution
+func (c BatchExecutedClaim) GetEventNonce() uint64 {
+	return c.EventNonce
+}
+
+// ValidateBasic performs basic validation of the claim
+func (c BatchExecutedClaim) ValidateBasic() error {
+	if c.EventNonce == 0 {
+		return sdkerrors.Wrap(ErrInvalidClaim, "event nonce must be positive")
+	}
+	if err := validateHex(c.EthTxHash, 32); err != nil {
+		return sdkerrors.Wrap(ErrInvalidClaim, "eth tx hash")
+	}
+	if c.BatchID == 0 {
+		return sdkerrors.Wrap(ErrInvalidClaim, "batch id must be positive")
+	}
+	return nil
+}
+
+// Hash returns the hash of the claim
+func (c BatchExecutedClaim) Hash() []byte {
+	return claimHash(c)
+}
+
+// claimHash hashes the amino json of the claim, which contains its type, so that claims of different types never
+// have the same hash
+func claimHash(c Claim) []byte {
+	h := sha256.Sum256(sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(c)))
+	return h[:]
+}
+
+// Attestation is a claim with the relayers that attested it
+type Attestation struct {
+	Claim Claim            `json:"claim" yaml:"claim"`
+	Votes []sdk.AccAddress `json:"votes" yaml:"votes"`
+	// Executed is set when the claim was executed
+	Executed bool `json:"executed,omitempty" yaml:"executed"`
+}
+
+// HasVoted returns if the relayer attested the claim
+func (a Attestation) HasVoted(relayer sdk.AccAddress) bool {
+	for _, v := range a.Votes {
+		if v.Equals(relayer) {
+			return true
+		}
+	}
+	return false
+}
+
+// Relayer is the bond of a relayer, which is slashed to the community pool for misbehavior
+type Relayer struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	Bond    sdk.Coins      `json:"bond" yaml:"bond"`
+}
+
+// OutgoingTransfer is a burn of native coins to be unlocked by the bridge contract on Ethereum. It waits in the
+// pool until it is added to a batch, the batch with the highest fees is most profitable for the relayers.
+type OutgoingTransfer struct {
+	ID           uint64         `json:"id" yaml:"id"`
+	Sender       sdk.AccAddress `json:"sender" yaml:"sender"`
+	EthRecipient string         `json:"eth_recipient" yaml:"eth_recipient"`
+	Amount       sdk.Int        `json:"amount" yaml:"amount"`
+	// BridgeFee is paid on Ethereum to the relayer that submits the batch of the transfer
+	BridgeFee sdk.Int `json:"bridge_fee" yaml:"bridge_fee"`
+	Height    int64   `json:"height" yaml:"height"`
+	// BatchID is the batch of the transfer, 0 while it is in the pool
+	BatchID uint64 `json:"batch_id,omitempty" yaml:"batch_id"`
+}
+
+// OutgoingBatch is a batch of outgoing transfers that relayers submit to the bridge contract in a single Ethereum tx
+type OutgoingBatch struct {
+	ID          uint64   `json:"id" yaml:"id"`
+	TransferIDs []uint64 `json:"transfer_ids" yaml:"transfer_ids"`
+	// TotalFee is the sum of the bridge fees of the transfers
+	TotalFee sdk.Int `json:"total_fee" yaml:"total_fee"`
+	Height   int64   `json:"height" yaml:"height"`
+}
+
+// Status is the state of the bridge
+type Status struct {
+	// LastEventNonce is the nonce of the last minted lock event
+	LastEventNonce uint64 `json:"last_event_nonce" yaml:"last_event_nonce"`
+	// LastOutgoingID is the id of the last outgoing transfer
+	LastOutgoingID uint64 `json:"last_outgoing_id" yaml:"last_outgoing_id"`
+	// LastBatchID is the id of the last outgoing batch
+	LastBatchID uint64  `json:"last_batch_id" yaml:"last_batch_id"`
+	TotalMinted sdk.Int `json:"total_minted" yaml:"total_minted"`
+	// TotalBurned includes the bridge fees
+	TotalBurned sdk.Int `json:"total_burned" yaml:"total_burned"`
+}
+
+// DefaultStatus returns the status of a new bridge
+func DefaultStatus() Status {
+	return Status{TotalMinted: sdk.ZeroInt(), TotalBurned: sdk.ZeroInt()}
+}
+
+// ValidateBasic performs basic validation of the status
+func (s Status) ValidateBasic() error {
+	if s.TotalMinted.IsNil() || s.TotalMinted.IsNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "total minted")
+	}
+	if s.TotalBurned.IsNil() || s.TotalBurned.IsNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "total burned")
+	}
+	return nil
+}
+
+// ValidateEthAddress validates a 0x prefixed hex Ethereum address
+func ValidateEthAddress(addr string) error {
+	if err := validateHex(addr, 20); err != nil {
+		return sdkerrors.Wrap(ErrInvalidEthAddress, addr)
+	}
+	return nil
+}
+
+func validateHex(s string, size int) error {
+	if !strings.HasPrefix(s, "0x") || len(s) != 2+2*size {
+		return sdkerrors.ErrInvalidRequest
+	}
+	_, err := hex.DecodeString(s[2:])
+	return err
+}
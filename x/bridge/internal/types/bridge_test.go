@@ -0,0 +1,125 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func validClaim() LockClaim {
+	return LockClaim{
+		EventNonce: 1,
+		EthTxHash:  "0x" + strings.Repeat("ab", 32),
+		EthSender:  "0x" + strings.Repeat("12", 20),
+		Recipient:  sdk.AccAddress(make([]byte, sdk.AddrLen)),
+		Amount:     sdk.NewInt(100),
+	}
+}
+
+func TestLockClaimValidateBasic(t *testing.T) {
+	specs := map[string]struct {
+		mutate func(*LockClaim)
+		expErr bool
+	}{
+		"valid":              {mutate: func(*LockClaim) {}},
+		"zero nonce":         {mutate: func(c *LockClaim) { c.EventNonce = 0 }, expErr: true},
+		"short tx hash":      {mutate: func(c *LockClaim) { c.EthTxHash = c.EthTxHash[:64] }, expErr: true},
+		"tx hash no prefix":  {mutate: func(c *LockClaim) { c.EthTxHash = "00" + c.EthTxHash[2:] }, expErr: true},
+		"invalid eth sender": {mutate: func(c *LockClaim) { c.EthSender = "0x" + strings.Repeat("zz", 20) }, expErr: true},
+		"no recipient":       {mutate: func(c *LockClaim) { c.Recipient = nil }, expErr: true},
+		"zero amount":        {mutate: func(c *LockClaim) { c.Amount = sdk.ZeroInt() }, expErr: true},
+		"nil amount":         {mutate: func(c *LockClaim) { c.Amount = sdk.Int{} }, expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			c := validClaim()
+			spec.mutate(&c)
+			err := c.ValidateBasic()
+			if spec.expErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestClaimHash(t *testing.T) {
+	other := validClaim()
+	other.Amount = sdk.NewInt(101)
+	assert.Equal(t, validClaim().Hash(), validClaim().Hash())
+	assert.NotEqual(t, validClaim().Hash(), other.Hash())
+
+	batch := BatchExecutedClaim{EventNonce: 1, EthTxHash: validClaim().EthTxHash, BatchID: 1}
+	assert.NoError(t, batch.ValidateBasic())
+	assert.NotEqual(t, validClaim().Hash(), batch.Hash())
+}
+
+func TestParamsValidateBasic(t *testing.T) {
+	relayer := sdk.AccAddress(make([]byte, sdk.AddrLen))
+	specs := map[string]struct {
+		mutate func(*Params)
+		expErr bool
+	}{
+		"default":             {mutate: func(*Params) {}},
+		"relayers":            {mutate: func(p *Params) { p.Relayers = []sdk.AccAddress{relayer} }},
+		"duplicate relayer":   {mutate: func(p *Params) { p.Relayers = []sdk.AccAddress{relayer, relayer} }, expErr: true},
+		"zero threshold":      {mutate: func(p *Params) { p.Threshold = 0 }, expErr: true},
+		"invalid denom":       {mutate: func(p *Params) { p.Denom = "F" }, expErr: true},
+		"invalid bond":        {mutate: func(p *Params) { p.MinRelayerBond = sdk.Coins{{Denom: "afet", Amount: sdk.ZeroInt()}} }, expErr: true},
+		"slash fraction one":  {mutate: func(p *Params) { p.SlashFraction = sdk.OneDec() }},
+		"slash fraction > 1":  {mutate: func(p *Params) { p.SlashFraction = sdk.NewDec(2) }, expErr: true},
+		"negative slash":      {mutate: func(p *Params) { p.SlashFraction = sdk.NewDec(-1) }, expErr: true},
+		"nil slash fraction":  {mutate: func(p *Params) { p.SlashFraction = sdk.Dec{} }, expErr: true},
+		"min relayer bond":    {mutate: func(p *Params) { p.MinRelayerBond = sdk.NewCoins(sdk.NewInt64Coin("afet", 1)) }},
+		"threshold > relayer": {mutate: func(p *Params) { p.Threshold = 2 }},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			p := DefaultParams()
+			spec.mutate(&p)
+			err := p.ValidateBasic()
+			if spec.expErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgBurnForEthValidateBasic(t *testing.T) {
+	specs := map[string]struct {
+		mutate func(*MsgBurnForEth)
+		expErr bool
+	}{
+		"valid":          {mutate: func(*MsgBurnForEth) {}},
+		"no sender":      {mutate: func(m *MsgBurnForEth) { m.Sender = nil }, expErr: true},
+		"eth recipient":  {mutate: func(m *MsgBurnForEth) { m.EthRecipient = "0x12" }, expErr: true},
+		"zero amount":    {mutate: func(m *MsgBurnForEth) { m.Amount = sdk.ZeroInt() }, expErr: true},
+		"bridge fee":     {mutate: func(m *MsgBurnForEth) { m.BridgeFee = sdk.NewInt(5) }},
+		"negative fee":   {mutate: func(m *MsgBurnForEth) { m.BridgeFee = sdk.NewInt(-1) }, expErr: true},
+		"nil bridge fee": {mutate: func(m *MsgBurnForEth) { m.BridgeFee = sdk.Int{} }, expErr: true},
+		"negative":       {mutate: func(m *MsgBurnForEth) { m.Amount = sdk.NewInt(-1) }, expErr: true},
+		"no eth address": {mutate: func(m *MsgBurnForEth) { m.EthRecipient = "" }, expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			m := MsgBurnForEth{
+				Sender:       sdk.AccAddress(make([]byte, sdk.AddrLen)),
+				EthRecipient: "0x" + strings.Repeat("12", 20),
+				Amount:       sdk.NewInt(1),
+				BridgeFee:    sdk.ZeroInt(),
+			}
+			spec.mutate(&m)
+			err := m.ValidateBasic()
+			if spec.expErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers the msg and claim types of the bridge module
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterInterface((*Claim)(nil), nil)
+	cdc.RegisterConcrete(LockClaim{}, "bridge/LockClaim", nil)
+	cdc.RegisterConcrete(BatchExecutedClaim{}, "bridge/BatchExecutedClaim", nil)
+
+	cdc.RegisterConcrete(MsgAttestLock{}, "bridge/MsgAttestLock", nil)
+	cdc.RegisterConcrete(MsgAttestBatch{}, "bridge/MsgAttestBatch", nil)
+	cdc.RegisterConcrete(MsgBurnForEth{}, "bridge/MsgBurnForEth", nil)
+	cdc.RegisterConcrete(MsgCancelBurnForEth{}, "bridge/MsgCancelBurnForEth", nil)
+	cdc.RegisterConcrete(MsgRequestBatch{}, "bridge/MsgRequestBatch", nil)
+	cdc.RegisterConcrete(MsgBondRelayer{}, "bridge/MsgBondRelayer", nil)
+	cdc.RegisterConcrete(MsgUnbondRelayer{}, "bridge/MsgUnbondRelayer", nil)
+}
+
+// ModuleCdc generic sealed codec to be used throughout module
+var ModuleCdc *codec.Codec
+
+func init() {
+	cdc := codec.New()
+	RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	ModuleCdc = cdc.Seal()
+}
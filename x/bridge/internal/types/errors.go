@@ -0,0 +1,28 @@
+package types
+
+import (
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Codes for bridge errors
+var (
+	DefaultCodespace = ModuleName
+
+	// ErrInvalidClaim error for a lock claim that does not describe an Ethereum lock event
+	ErrInvalidClaim = sdkErrors.Register(DefaultCodespace, 1, "invalid claim")
+
+	// ErrInvalidEthAddress error for an invalid Ethereum address
+	ErrInvalidEthAddress = sdkErrors.Register(DefaultCodespace, 2, "invalid ethereum address")
+
+	// ErrDuplicateVote error for a relayer that attested a claim for the event nonce already
+	ErrDuplicateVote = sdkErrors.Register(DefaultCodespace, 3, "duplicate vote")
+
+	// ErrInsufficientBond error for a relayer with a bond below the min relayer bond
+	ErrInsufficientBond = sdkErrors.Register(DefaultCodespace, 4, "insufficient relayer bond")
+
+	// ErrBridgeDisabled error for transfers while the bridge has no relayers
+	ErrBridgeDisabled = sdkErrors.Register(DefaultCodespace, 5, "bridge disabled")
+
+	// ErrInvalidBatch error for a batch request that does not improve on the pending batches
+	ErrInvalidBatch = sdkErrors.Register(DefaultCodespace, 6, "invalid batch")
+)
@@ -0,0 +1,96 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GenesisState is the genesis state of the bridge module
+type GenesisState struct {
+	Params            Params             `json:"params"`
+	Status            Status             `json:"status"`
+	Relayers          []Relayer          `json:"relayers,omitempty"`
+	Attestations      []Attestation      `json:"attestations,omitempty"`
+	OutgoingTransfers []OutgoingTransfer `json:"outgoing_transfers,omitempty"`
+	OutgoingBatches   []OutgoingBatch    `json:"outgoing_batches,omitempty"`
+}
+
+// DefaultGenesisState returns the genesis state of a disabled bridge
+func DefaultGenesisState() GenesisState {
+	return GenesisState{Params: DefaultParams(), Status: DefaultStatus()}
+}
+
+// ValidateGenesis performs basic validation of the genesis state
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "params")
+	}
+	if err := data.Status.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "status")
+	}
+	relayers := make(map[string]struct{}, len(data.Relayers))
+	for _, r := range data.Relayers {
+		if err := sdk.VerifyAddressFormat(r.Address); err != nil {
+			return sdkerrors.Wrap(err, "relayer")
+		}
+		if !r.Bond.IsValid() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "bond of relayer %s", r.Address)
+		}
+		if _, exists := relayers[string(r.Address)]; exists {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "duplicate relayer %s", r.Address)
+		}
+		relayers[string(r.Address)] = struct{}{}
+	}
+	claims := make(map[string]struct{}, len(data.Attestations))
+	for _, a := range data.Attestations {
+		if a.Claim == nil {
+			return sdkerrors.Wrap(ErrInvalidClaim, "attestation without claim")
+		}
+		nonce := a.Claim.GetEventNonce()
+		if err := a.Claim.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "attestation of nonce %d", nonce)
+		}
+		key := string(GetAttestationKey(nonce, a.Claim.Hash()))
+		if _, exists := claims[key]; exists {
+			return sdkerrors.Wrapf(ErrInvalidClaim, "duplicate attestation of nonce %d", nonce)
+		}
+		claims[key] = struct{}{}
+		if a.Executed != (nonce <= data.Status.LastEventNonce) {
+			return sdkerrors.Wrapf(ErrInvalidClaim, "execution of nonce %d does not match the last event nonce", nonce)
+		}
+	}
+	batched := make(map[uint64]uint64)
+	for i, b := range data.OutgoingBatches {
+		if b.ID == 0 || b.ID > data.Status.LastBatchID || (i != 0 && b.ID <= data.OutgoingBatches[i-1].ID) {
+			return sdkerrors.Wrapf(ErrInvalidBatch, "outgoing batch id %d", b.ID)
+		}
+		if len(b.TransferIDs) == 0 || b.TotalFee.IsNil() || b.TotalFee.IsNegative() {
+			return sdkerrors.Wrapf(ErrInvalidBatch, "outgoing batch %d", b.ID)
+		}
+		for _, id := range b.TransferIDs {
+			batched[id] = b.ID
+		}
+	}
+	for i, t := range data.OutgoingTransfers {
+		if t.ID == 0 || t.ID > data.Status.LastOutgoingID || (i != 0 && t.ID <= data.OutgoingTransfers[i-1].ID) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "outgoing transfer id %d", t.ID)
+		}
+		if err := sdk.VerifyAddressFormat(t.Sender); err != nil {
+			return sdkerrors.Wrapf(err, "sender of outgoing transfer %d", t.ID)
+		}
+		if err := ValidateEthAddress(t.EthRecipient); err != nil {
+			return sdkerrors.Wrapf(err, "outgoing transfer %d", t.ID)
+		}
+		if t.Amount.IsNil() || !t.Amount.IsPositive() || t.BridgeFee.IsNil() || t.BridgeFee.IsNegative() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "outgoing transfer %d", t.ID)
+		}
+		if batched[t.ID] != t.BatchID {
+			return sdkerrors.Wrapf(ErrInvalidBatch, "batch of outgoing transfer %d", t.ID)
+		}
+		delete(batched, t.ID)
+	}
+	if len(batched) != 0 {
+		return sdkerrors.Wrap(ErrInvalidBatch, "batch of unknown outgoing transfer")
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the bridge module
+	ModuleName = "bridge"
+
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for the bridge module
+	QuerierRoute = ModuleName
+
+	// RouterKey is the msg router key for the bridge module
+	RouterKey = ModuleName
+)
+
+const ( // event attributes
+	AttributeKeyRelayer      = "relayer"
+	AttributeKeyEventNonce   = "event_nonce"
+	AttributeKeyEthTxHash    = "eth_tx_hash"
+	AttributeKeyRecipient    = "recipient"
+	AttributeKeyEthRecipient = "eth_recipient"
+	AttributeKeyOutgoingID   = "outgoing_id"
+	AttributeKeyBatchID      = "batch_id"
+	AttributeKeyBridgeFee    = "bridge_fee"
+)
+
+const (
+	// EventTypeMint is emitted when an attested lock on Ethereum is minted
+	EventTypeMint = "bridge_mint"
+	// EventTypeBurn is emitted when coins are burned to be unlocked on Ethereum
+	EventTypeBurn = "bridge_burn"
+	// EventTypeCancelBurn is emitted when a pooled outgoing transfer is cancelled and refunded
+	EventTypeCancelBurn = "bridge_cancel_burn"
+	// EventTypeBatch is emitted when an outgoing batch is built
+	EventTypeBatch = "bridge_batch"
+	// EventTypeBatchExecuted is emitted when the execution of an outgoing batch on Ethereum is attested
+	EventTypeBatchExecuted = "bridge_batch_executed"
+	// EventTypeSlash is emitted when a relayer is slashed for attesting a conflicting claim
+	EventTypeSlash = "bridge_slash"
+)
+
+// nolint
+var (
+	AttestationPrefix      = []byte{0x01}
+	RelayerPrefix          = []byte{0x02}
+	OutgoingTransferPrefix = []byte{0x03}
+	StatusKey              = []byte{0x04}
+	OutgoingBatchPrefix    = []byte{0x05}
+)
+
+// GetAttestationPrefix returns the store prefix of the attestations of claims of the event nonce
+func GetAttestationPrefix(nonce uint64) []byte {
+	return append(AttestationPrefix, sdk.Uint64ToBigEndian(nonce)...)
+}
+
+// GetAttestationKey returns the store key of the attestation of the claim with the hash
+func GetAttestationKey(nonce uint64, claimHash []byte) []byte {
+	return append(GetAttestationPrefix(nonce), claimHash...)
+}
+
+// GetRelayerKey returns the store key of the relayer
+func GetRelayerKey(addr sdk.AccAddress) []byte {
+	return append(RelayerPrefix, addr...)
+}
+
+// GetOutgoingTransferKey returns the store key of the outgoing transfer
+func GetOutgoingTransferKey(id uint64) []byte {
+	return append(OutgoingTransferPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetOutgoingBatchKey returns the store key of the outgoing batch
+func GetOutgoingBatchKey(id uint64) []byte {
+	return append(OutgoingBatchPrefix, sdk.Uint64ToBigEndian(id)...)
+}
@@ -0,0 +1,231 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MsgAttestLock is the vote of a relayer for the claim of an Ethereum lock event
+type MsgAttestLock struct {
+	Relayer sdk.AccAddress `json:"relayer" yaml:"relayer"`
+	Claim   LockClaim      `json:"claim" yaml:"claim"`
+}
+
+func (msg MsgAttestLock) Route() string {
+	return RouterKey
+}
+
+func (msg MsgAttestLock) Type() string {
+	return "attest-lock"
+}
+
+func (msg MsgAttestLock) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Relayer); err != nil {
+		return sdkerrors.Wrap(err, "relayer")
+	}
+	return msg.Claim.ValidateBasic()
+}
+
+func (msg MsgAttestLock) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgAttestLock) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Relayer}
+}
+
+// MsgAttestBatch is the vote of a relayer for the claim of an executed outgoing batch
+type MsgAttestBatch struct {
+	Relayer sdk.AccAddress     `json:"relayer" yaml:"relayer"`
+	Claim   BatchExecutedClaim `json:"claim" yaml:"claim"`
+}
+
+func (msg MsgAttestBatch) Route() string {
+	return RouterKey
+}
+
+func (msg MsgAttestBatch) Type() string {
+	return "attest-batch"
+}
+
+func (msg MsgAttestBatch) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Relayer); err != nil {
+		return sdkerrors.Wrap(err, "relayer")
+	}
+	return msg.Claim.ValidateBasic()
+}
+
+func (msg MsgAttestBatch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgAttestBatch) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Relayer}
+}
+
+// MsgBurnForEth burns native coins of the sender to be unlocked to the recipient on Ethereum. The bridge fee is
+// burned with the amount and paid on Ethereum to the relayer that submits the batch of the transfer.
+type MsgBurnForEth struct {
+	Sender       sdk.AccAddress `json:"sender" yaml:"sender"`
+	EthRecipient string         `json:"eth_recipient" yaml:"eth_recipient"`
+	// Amount is in base units of the bridge denom
+	Amount sdk.Int `json:"amount" yaml:"amount"`
+	// BridgeFee is in base units of the bridge denom, optional
+	BridgeFee sdk.Int `json:"bridge_fee" yaml:"bridge_fee"`
+}
+
+func (msg MsgBurnForEth) Route() string {
+	return RouterKey
+}
+
+func (msg MsgBurnForEth) Type() string {
+	return "burn-for-eth"
+}
+
+func (msg MsgBurnForEth) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if err := ValidateEthAddress(msg.EthRecipient); err != nil {
+		return sdkerrors.Wrap(err, "eth recipient")
+	}
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "amount must be positive")
+	}
+	if msg.BridgeFee.IsNil() || msg.BridgeFee.IsNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "bridge fee must not be negative")
+	}
+	return nil
+}
+
+func (msg MsgBurnForEth) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgBurnForEth) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgCancelBurnForEth cancels an outgoing transfer of the sender that was not added to a batch yet, the amount and
+// the bridge fee are returned. The transfer can be sent again with a higher bridge fee.
+type MsgCancelBurnForEth struct {
+	Sender     sdk.AccAddress `json:"sender" yaml:"sender"`
+	TransferID uint64         `json:"transfer_id" yaml:"transfer_id"`
+}
+
+func (msg MsgCancelBurnForEth) Route() string {
+	return RouterKey
+}
+
+func (msg MsgCancelBurnForEth) Type() string {
+	return "cancel-burn-for-eth"
+}
+
+func (msg MsgCancelBurnForEth) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if msg.TransferID == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "transfer id must be positive")
+	}
+	return nil
+}
+
+func (msg MsgCancelBurnForEth) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgCancelBurnForEth) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgRequestBatch builds a batch of the pooled outgoing transfers with the highest bridge fees
+type MsgRequestBatch struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+}
+
+func (msg MsgRequestBatch) Route() string {
+	return RouterKey
+}
+
+func (msg MsgRequestBatch) Type() string {
+	return "request-batch"
+}
+
+func (msg MsgRequestBatch) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	return nil
+}
+
+func (msg MsgRequestBatch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgRequestBatch) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgBondRelayer adds coins to the bond of a relayer
+type MsgBondRelayer struct {
+	Relayer sdk.AccAddress `json:"relayer" yaml:"relayer"`
+	Amount  sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+func (msg MsgBondRelayer) Route() string {
+	return RouterKey
+}
+
+func (msg MsgBondRelayer) Type() string {
+	return "bond-relayer"
+}
+
+func (msg MsgBondRelayer) ValidateBasic() error {
+	return validateBondMsg(msg.Relayer, msg.Amount)
+}
+
+func (msg MsgBondRelayer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgBondRelayer) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Relayer}
+}
+
+// MsgUnbondRelayer returns coins of the bond of a relayer. Relayers in the relayer set can only withdraw the surplus
+// above the min relayer bond.
+type MsgUnbondRelayer struct {
+	Relayer sdk.AccAddress `json:"relayer" yaml:"relayer"`
+	Amount  sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+func (msg MsgUnbondRelayer) Route() string {
+	return RouterKey
+}
+
+func (msg MsgUnbondRelayer) Type() string {
+	return "unbond-relayer"
+}
+
+func (msg MsgUnbondRelayer) ValidateBasic() error {
+	return validateBondMsg(msg.Relayer, msg.Amount)
+}
+
+func (msg MsgUnbondRelayer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgUnbondRelayer) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Relayer}
+}
+
+func validateBondMsg(relayer sdk.AccAddress, amount sdk.Coins) error {
+	if err := sdk.VerifyAddressFormat(relayer); err != nil {
+		return sdkerrors.Wrap(err, "relayer")
+	}
+	if !amount.IsValid() || amount.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "amount")
+	}
+	return nil
+}
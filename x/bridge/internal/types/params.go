@@ -0,0 +1,174 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// DefaultParamspace for params keeper
+	DefaultParamspace = ModuleName
+)
+
+var ParamStoreKeyRelayers = []byte("relayers")
+var ParamStoreKeyThreshold = []byte("threshold")
+var ParamStoreKeyDenom = []byte("denom")
+var ParamStoreKeyMinRelayerBond = []byte("minRelayerBond")
+var ParamStoreKeySlashFraction = []byte("slashFraction")
+var ParamStoreKeyMaxBatchSize = []byte("maxBatchSize")
+
+// Params defines the set of bridge parameters. They are changed by param change proposals.
+type Params struct {
+	// Relayers are the accounts that attest the lock events of the Ethereum bridge contract, none disables the bridge
+	Relayers []sdk.AccAddress `json:"relayers,omitempty" yaml:"relayers"`
+	// Threshold is the number of relayers that must attest the same claim before it is minted
+	Threshold uint64 `json:"threshold" yaml:"threshold"`
+	// Denom is the native denom of the bridged ERC-20 tokens, minted 1:1 for the locked base units
+	Denom string `json:"denom" yaml:"denom"`
+	// MinRelayerBond is the bond a relayer must hold in the bridge to attest claims, optional
+	MinRelayerBond sdk.Coins `json:"min_relayer_bond,omitempty" yaml:"min_relayer_bond"`
+	// SlashFraction is the share of the bond a relayer loses to the community pool for a conflicting claim
+	SlashFraction sdk.Dec `json:"slash_fraction" yaml:"slash_fraction"`
+	// MaxBatchSize is the max number of outgoing transfers of a batch, which bounds the gas of its Ethereum tx
+	MaxBatchSize uint64 `json:"max_batch_size" yaml:"max_batch_size"`
+}
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns default bridge parameters, without relayers the bridge is disabled
+func DefaultParams() Params {
+	return Params{
+		Threshold:     1,
+		Denom:         "afet",
+		SlashFraction: sdk.NewDecWithPrec(1, 1),
+		MaxBatchSize:  100,
+	}
+}
+
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(ParamStoreKeyRelayers, &p.Relayers, validateRelayers),
+		params.NewParamSetPair(ParamStoreKeyThreshold, &p.Threshold, validateThreshold),
+		params.NewParamSetPair(ParamStoreKeyDenom, &p.Denom, validateDenom),
+		params.NewParamSetPair(ParamStoreKeyMinRelayerBond, &p.MinRelayerBond, validateMinRelayerBond),
+		params.NewParamSetPair(ParamStoreKeySlashFraction, &p.SlashFraction, validateSlashFraction),
+		params.NewParamSetPair(ParamStoreKeyMaxBatchSize, &p.MaxBatchSize, validateMaxBatchSize),
+	}
+}
+
+// ValidateBasic performs basic validation on bridge parameters. A threshold above the number of relayers is
+// accepted, so that relayers and threshold can be changed by separate proposals, but halts the minting.
+func (p Params) ValidateBasic() error {
+	if err := validateRelayers(p.Relayers); err != nil {
+		return sdkerrors.Wrap(err, "relayers")
+	}
+	if err := validateThreshold(p.Threshold); err != nil {
+		return sdkerrors.Wrap(err, "threshold")
+	}
+	if err := validateDenom(p.Denom); err != nil {
+		return sdkerrors.Wrap(err, "denom")
+	}
+	if err := validateMinRelayerBond(p.MinRelayerBond); err != nil {
+		return sdkerrors.Wrap(err, "min relayer bond")
+	}
+	if err := validateSlashFraction(p.SlashFraction); err != nil {
+		return sdkerrors.Wrap(err, "slash fraction")
+	}
+	if err := validateMaxBatchSize(p.MaxBatchSize); err != nil {
+		return sdkerrors.Wrap(err, "max batch size")
+	}
+	return nil
+}
+
+// IsRelayer returns if the address is in the relayer set
+func (p Params) IsRelayer(addr sdk.AccAddress) bool {
+	for _, r := range p.Relayers {
+		if r.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func validateRelayers(i interface{}) error {
+	v, ok := i.([]sdk.AccAddress)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]struct{}, len(v))
+	for _, a := range v {
+		if err := sdk.VerifyAddressFormat(a); err != nil {
+			return err
+		}
+		if _, exists := seen[string(a)]; exists {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "duplicate relayer %s", a)
+		}
+		seen[string(a)] = struct{}{}
+	}
+	return nil
+}
+
+func validateThreshold(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "must be positive")
+	}
+	return nil
+}
+
+func validateMaxBatchSize(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "must be positive")
+	}
+	return nil
+}
+
+func validateDenom(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return sdk.ValidateDenom(v)
+}
+
+func validateMinRelayerBond(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if !v.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, v.String())
+	}
+	return nil
+}
+
+func validateSlashFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "must be in [0, 1]")
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package denom
+
+import (
+	"github.com/fetchai/fetchd/x/denom/internal/keeper"
+	"github.com/fetchai/fetchd/x/denom/internal/types"
+)
+
+const (
+	ModuleName                   = types.ModuleName
+	StoreKey                     = types.StoreKey
+	QuerierRoute                 = types.QuerierRoute
+	RouterKey                    = types.RouterKey
+	ProposalTypeSetDenomMetadata = types.ProposalTypeSetDenomMetadata
+	QueryDenomMetadata           = keeper.QueryDenomMetadata
+	QueryAllDenomMetadata        = keeper.QueryAllDenomMetadata
+)
+
+var (
+	// functions aliases
+	RegisterCodec      = types.RegisterCodec
+	ValidateGenesis    = types.ValidateGenesis
+	ParseCoins         = types.ParseCoins
+	DisplayCoins       = types.DisplayCoins
+	NewKeeper          = keeper.NewKeeper
+	NewQuerier         = keeper.NewQuerier
+	NewProposalHandler = keeper.NewProposalHandler
+	InitGenesis        = keeper.InitGenesis
+	ExportGenesis      = keeper.ExportGenesis
+
+	// variable aliases
+	ModuleCdc          = types.ModuleCdc
+	ErrInvalidMetadata = types.ErrInvalidMetadata
+	ErrDuplicateDenom  = types.ErrDuplicateDenom
+)
+
+type (
+	Keeper                   = keeper.Keeper
+	GenesisState             = types.GenesisState
+	Metadata                 = types.Metadata
+	DenomUnit                = types.DenomUnit
+	SetDenomMetadataProposal = types.SetDenomMetadataProposal
+	MsgBurn                  = types.MsgBurn
+)
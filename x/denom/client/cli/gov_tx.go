@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"bufio"
+	"io/ioutil"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/fetchai/fetchd/x/denom/internal/types"
+	wasmUtils "github.com/fetchai/fetchd/x/wasm/client/utils"
+)
+
+// ProposalSetDenomMetadataCmd submits a proposal to set the metadata of a denom
+func ProposalSetDenomMetadataCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-metadata [metadata_file]",
+		Short: "Submit a proposal to set the metadata of a denom",
+		Long: `Submit a proposal to set or replace the metadata of the base denom of the json file, e.g.
+{"description":"Fetch.ai token","base":"afet","display":"fet","denom_units":[{"denom":"afet","exponent":0},
+{"denom":"fet","exponent":18}]}. The units must start with the base denom and must not be units of other denoms.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var metadata types.Metadata
+			if err := cdc.UnmarshalJSON(bz, &metadata); err != nil {
+				return err
+			}
+
+			content := types.SetDenomMetadataProposal{
+				Title:       viper.GetString(cli.FlagTitle),
+				Description: viper.GetString(cli.FlagDescription),
+				Metadata:    metadata,
+			}
+
+			deposit, err := sdk.ParseCoins(viper.GetString(cli.FlagDeposit))
+			if err != nil {
+				return err
+			}
+
+			msg := govtypes.NewMsgSubmitProposal(content, deposit, cliCtx.GetFromAddress())
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	// proposal flags
+	cmd.Flags().String(cli.FlagTitle, "", "Title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "Description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "", "Deposit of proposal")
+	wasmUtils.AddBroadcastFlags(cmd)
+	return cmd
+}
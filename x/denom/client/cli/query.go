@@ -0,0 +1,119 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+
+	"github.com/fetchai/fetchd/x/denom/internal/keeper"
+	"github.com/fetchai/fetchd/x/denom/internal/types"
+)
+
+// GetQueryCmd returns the query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the denom metadata",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(flags.GetCommands(
+		GetCmdQueryDenomMetadata(cdc),
+		GetCmdQueryAllDenomMetadata(cdc),
+		GetCmdQueryBalance(cdc),
+	)...)
+	return queryCmd
+}
+
+// GetCmdQueryDenomMetadata shows the metadata of a denom
+func GetCmdQueryDenomMetadata(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "metadata [denom]",
+		Short: "Show the metadata of a denom",
+		Long:  "Show the units, display unit and description of a denom, by its base denom or any of its units",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			route := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, keeper.QueryDenomMetadata, args[0])
+			res, _, err := cliCtx.Query(route)
+			if err != nil {
+				return err
+			}
+			var metadata *types.Metadata
+			if err := json.Unmarshal(res, &metadata); err != nil {
+				return err
+			}
+			if metadata == nil {
+				return fmt.Errorf("no metadata for denom %s", args[0])
+			}
+			return cliCtx.PrintOutput(metadata)
+		},
+	}
+}
+
+// GetCmdQueryAllDenomMetadata lists the metadata of all denoms
+func GetCmdQueryAllDenomMetadata(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list-metadata",
+		Short: "List the metadata of all denoms",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			metadata, err := QueryAllDenomMetadata(cliCtx)
+			if err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(metadata)
+		},
+	}
+}
+
+// GetCmdQueryBalance shows the coins of an account in the display units of their denoms
+func GetCmdQueryBalance(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "balance [address]",
+		Short: "Show the coins of an account in display units",
+		Long: `Show the coins of an account in the display units of the denom metadata, e.g. 1.5fet instead of
+1500000000000000000afet. Coins of denoms without metadata are shown in their denom.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			acc, err := auth.NewAccountRetriever(cliCtx).GetAccount(addr)
+			if err != nil {
+				return err
+			}
+			metadata, err := QueryAllDenomMetadata(cliCtx)
+			if err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(types.DisplayCoins(acc.GetCoins(), metadata))
+		},
+	}
+}
+
+// QueryAllDenomMetadata returns the metadata of all denoms, to convert amounts with ParseCoins and DisplayCoins
+func QueryAllDenomMetadata(cliCtx context.CLIContext) ([]types.Metadata, error) {
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, keeper.QueryAllDenomMetadata)
+	res, _, err := cliCtx.Query(route)
+	if err != nil {
+		return nil, err
+	}
+	var metadata []types.Metadata
+	if err := json.Unmarshal(res, &metadata); err != nil {
+		return nil, err
+	}
+	return metadata, nil
+}
@@ -0,0 +1,12 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	"github.com/fetchai/fetchd/x/denom/client/cli"
+	"github.com/fetchai/fetchd/x/denom/client/rest"
+)
+
+// ProposalHandlers define the denom cli proposal types and rest handler.
+var ProposalHandlers = []govclient.ProposalHandler{
+	govclient.NewProposalHandler(cli.ProposalSetDenomMetadataCmd, rest.SetDenomMetadataProposalHandler),
+}
@@ -0,0 +1,53 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+
+	"github.com/fetchai/fetchd/x/denom/internal/types"
+)
+
+type SetDenomMetadataJsonReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+
+	Proposer sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit  sdk.Coins      `json:"deposit" yaml:"deposit"`
+
+	Metadata types.Metadata `json:"metadata" yaml:"metadata"`
+}
+
+func SetDenomMetadataProposalHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "set_denom_metadata",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req SetDenomMetadataJsonReq
+			if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+				return
+			}
+			content := types.SetDenomMetadataProposal{
+				Title:       req.Title,
+				Description: req.Description,
+				Metadata:    req.Metadata,
+			}
+			msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+			if err := msg.ValidateBasic(); err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			baseReq := req.BaseReq.Sanitize()
+			if !baseReq.ValidateBasic(w) {
+				return
+			}
+			utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+		},
+	}
+}
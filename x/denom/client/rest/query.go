@@ -0,0 +1,62 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/fetchai/fetchd/x/denom/internal/keeper"
+	"github.com/fetchai/fetchd/x/denom/internal/types"
+)
+
+func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/bank/denoms_metadata", queryAllDenomMetadataHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/bank/denoms_metadata/{denom}", queryDenomMetadataHandlerFn(cliCtx)).Methods("GET")
+}
+
+func queryAllDenomMetadataHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, keeper.QueryAllDenomMetadata)
+		res, height, err := cliCtx.Query(route)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, json.RawMessage(res))
+	}
+}
+
+// queryDenomMetadataHandlerFn returns the metadata of a denom by its base denom or any of its units
+func queryDenomMetadataHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		denom := mux.Vars(r)["denom"]
+		route := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, keeper.QueryDenomMetadata, denom)
+		res, height, err := cliCtx.Query(route)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		if string(res) == "null" {
+			rest.WriteErrorResponse(w, http.StatusNotFound, fmt.Sprintf("no metadata for denom %s", denom))
+			return
+		}
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, json.RawMessage(res))
+	}
+}
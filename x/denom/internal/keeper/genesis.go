@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fetchai/fetchd/x/denom/internal/types"
+)
+
+// InitGenesis stores the denom metadata of the genesis
+func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
+	for _, m := range data.DenomMetadata {
+		keeper.storeDenomMetadata(ctx, m)
+	}
+}
+
+// ExportGenesis returns the denom metadata as genesis state
+func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
+	return types.GenesisState{DenomMetadata: keeper.GetAllDenomMetadata(ctx)}
+}
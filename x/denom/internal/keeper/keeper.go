@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/fetchai/fetchd/x/denom/internal/types"
+)
+
+// Keeper stores the denom metadata, keyed by base denom, and burns coins
+type Keeper struct {
+	storeKey     sdk.StoreKey
+	cdc          *codec.Codec
+	supplyKeeper types.SupplyKeeper
+}
+
+// NewKeeper creates a new denom Keeper instance
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, supplyKeeper types.SupplyKeeper) Keeper {
+	return Keeper{storeKey: storeKey, cdc: cdc, supplyKeeper: supplyKeeper}
+}
+
+// Burn burns the coins of the address, only its spendable coins can be burned
+func (k Keeper) Burn(ctx sdk.Context, addr sdk.AccAddress, amount sdk.Coins) error {
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, addr, types.ModuleName, amount); err != nil {
+		return err
+	}
+	return k.supplyKeeper.BurnCoins(ctx, types.ModuleName, amount)
+}
+
+// SetDenomMetadata sets or replaces the metadata of its base denom. The units must not be units of other denoms.
+func (k Keeper) SetDenomMetadata(ctx sdk.Context, metadata types.Metadata) error {
+	if err := metadata.Validate(); err != nil {
+		return err
+	}
+	var err error
+	k.IterateDenomMetadata(ctx, func(m types.Metadata) bool {
+		if m.Base == metadata.Base {
+			return false
+		}
+		for _, u := range metadata.Units() {
+			if _, ok := m.Unit(u); ok {
+				err = sdkerrors.Wrapf(types.ErrDuplicateDenom, "%s is a unit of %s", u, m.Base)
+				return true
+			}
+		}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	k.storeDenomMetadata(ctx, metadata)
+	return nil
+}
+
+// GetDenomMetadata returns the metadata of the base denom, nil when none was set
+func (k Keeper) GetDenomMetadata(ctx sdk.Context, base string) *types.Metadata {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetDenomMetadataKey(base))
+	if bz == nil {
+		return nil
+	}
+	var metadata types.Metadata
+	k.cdc.MustUnmarshalBinaryBare(bz, &metadata)
+	return &metadata
+}
+
+// FindDenomMetadata returns the metadata that has the denom as base denom, unit or alias, nil when there is none
+func (k Keeper) FindDenomMetadata(ctx sdk.Context, denom string) *types.Metadata {
+	if m := k.GetDenomMetadata(ctx, denom); m != nil {
+		return m
+	}
+	var res *types.Metadata
+	k.IterateDenomMetadata(ctx, func(m types.Metadata) bool {
+		if _, ok := m.Unit(denom); ok {
+			res = &m
+			return true
+		}
+		return false
+	})
+	return res
+}
+
+// IterateDenomMetadata calls cb with the metadata of all denoms in the order of their base denom until cb returns true
+func (k Keeper) IterateDenomMetadata(ctx sdk.Context, cb func(types.Metadata) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomMetadataPrefix).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var metadata types.Metadata
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &metadata)
+		if cb(metadata) {
+			return
+		}
+	}
+}
+
+// GetAllDenomMetadata returns the metadata of all denoms in the order of their base denom
+func (k Keeper) GetAllDenomMetadata(ctx sdk.Context) []types.Metadata {
+	var res []types.Metadata
+	k.IterateDenomMetadata(ctx, func(m types.Metadata) bool {
+		res = append(res, m)
+		return false
+	})
+	return res
+}
+
+func (k Keeper) storeDenomMetadata(ctx sdk.Context, metadata types.Metadata) {
+	ctx.KVStore(k.storeKey).Set(types.GetDenomMetadataKey(metadata.Base), k.cdc.MustMarshalBinaryBare(metadata))
+}
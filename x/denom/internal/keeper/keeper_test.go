@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/fetchai/fetchd/testutil"
+	"github.com/fetchai/fetchd/x/denom/internal/types"
+)
+
+func setupKeeper(t *testing.T) (sdk.Context, Keeper) {
+	cdc := codec.New()
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	ctx, _ := testutil.NewContext(t, cdc, abci.Header{}, key)
+	return ctx, NewKeeper(cdc, key, nil)
+}
+
+func metadata(base, display string, exponent uint32) types.Metadata {
+	return types.Metadata{
+		DenomUnits: []types.DenomUnit{{Denom: base}, {Denom: display, Exponent: exponent}},
+		Base:       base,
+		Display:    display,
+	}
+}
+
+func TestSetDenomMetadata(t *testing.T) {
+	specs := map[string]struct {
+		src    types.Metadata
+		expErr bool
+	}{
+		"new denom":          {src: metadata("ustake", "stake", 6)},
+		"replace":            {src: metadata("afet", "fet", 9)},
+		"unit of other":      {src: metadata("ufet", "fet", 6), expErr: true},
+		"base unit of other": {src: metadata("ustake", "afet", 6), expErr: true},
+		"invalid":            {src: types.Metadata{Base: "ustake"}, expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, k := setupKeeper(t)
+			fet := metadata("afet", "fet", 18)
+			require.NoError(t, k.SetDenomMetadata(ctx, fet))
+
+			err := k.SetDenomMetadata(ctx, spec.src)
+			if spec.expErr {
+				require.Error(t, err)
+				assert.Equal(t, &fet, k.GetDenomMetadata(ctx, "afet"))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, &spec.src, k.GetDenomMetadata(ctx, spec.src.Base))
+		})
+	}
+}
+
+func TestFindDenomMetadata(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	fet := metadata("afet", "fet", 18)
+	stake := metadata("ustake", "stake", 6)
+	InitGenesis(ctx, k, types.GenesisState{DenomMetadata: []types.Metadata{stake, fet}})
+
+	assert.Equal(t, &fet, k.FindDenomMetadata(ctx, "afet"))
+	assert.Equal(t, &fet, k.FindDenomMetadata(ctx, "fet"))
+	assert.Equal(t, &stake, k.FindDenomMetadata(ctx, "stake"))
+	assert.Nil(t, k.FindDenomMetadata(ctx, "other"))
+	assert.Equal(t, types.GenesisState{DenomMetadata: []types.Metadata{fet, stake}}, ExportGenesis(ctx, k))
+}
+
+func TestBurn(t *testing.T) {
+	var (
+		addr = sdk.AccAddress([]byte("burner______________"))
+		fet  = func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("afet", amount)) }
+	)
+	specs := map[string]struct {
+		amount     sdk.Coins
+		expBalance sdk.Coins
+		expBurned  sdk.Coins
+		expErr     bool
+	}{
+		"some":            {amount: fet(40), expBalance: fet(60), expBurned: fet(40)},
+		"all":             {amount: fet(100), expBalance: sdk.NewCoins(), expBurned: fet(100)},
+		"exceeds balance": {amount: fet(101), expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			sk := testutil.NewSupplyKeeper(map[string]sdk.Coins{addr.String(): fet(100)})
+			k := NewKeeper(codec.New(), nil, sk)
+			err := k.Burn(sdk.Context{}, addr, spec.amount)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.expBalance.String(), sk.Balances[addr.String()].String())
+			assert.True(t, sk.Balances[testutil.ModuleKey(types.ModuleName)].IsZero())
+			assert.Equal(t, spec.expBurned.String(), sk.Burned.String())
+		})
+	}
+}
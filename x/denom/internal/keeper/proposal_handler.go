@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/fetchai/fetchd/x/denom/internal/types"
+)
+
+// NewProposalHandler creates a new governance Handler for denom proposals
+func NewProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case types.SetDenomMetadataProposal:
+			return handleSetDenomMetadataProposal(ctx, k, c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized denom proposal content type: %T", c)
+		}
+	}
+}
+
+func handleSetDenomMetadataProposal(ctx sdk.Context, k Keeper, p types.SetDenomMetadataProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+	if err := k.SetDenomMetadata(ctx, p.Metadata); err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetDenomMetadata,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyDenom, p.Metadata.Base),
+	))
+	return nil
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/fetchai/fetchd/x/denom/internal/types"
+)
+
+const (
+	// QueryDenomMetadata returns the metadata of a denom, path: base denom, unit or alias
+	QueryDenomMetadata = "denom-metadata"
+	// QueryAllDenomMetadata lists the metadata of all denoms
+	QueryAllDenomMetadata = "all-denom-metadata"
+)
+
+// NewQuerier creates a new querier
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		switch path[0] {
+		case QueryDenomMetadata:
+			if len(path) < 2 {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "denom required")
+			}
+			return queryDenomMetadata(ctx, path[1], keeper)
+		case QueryAllDenomMetadata:
+			return queryAllDenomMetadata(ctx, keeper)
+		default:
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown data query endpoint")
+		}
+	}
+}
+
+func queryDenomMetadata(ctx sdk.Context, denom string, keeper Keeper) ([]byte, error) {
+	metadata := keeper.FindDenomMetadata(ctx, denom)
+	if metadata == nil {
+		return []byte("null"), nil
+	}
+	return marshal(metadata)
+}
+
+func queryAllDenomMetadata(ctx sdk.Context, keeper Keeper) ([]byte, error) {
+	metadata := keeper.GetAllDenomMetadata(ctx)
+	if metadata == nil {
+		metadata = []types.Metadata{}
+	}
+	return marshal(metadata)
+}
+
+func marshal(v interface{}) ([]byte, error) {
+	bz, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
@@ -0,0 +1,22 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers the msg and proposal types of the denom module
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgBurn{}, "denom/MsgBurn", nil)
+
+	cdc.RegisterConcrete(SetDenomMetadataProposal{}, "denom/SetDenomMetadataProposal", nil)
+}
+
+// ModuleCdc generic sealed codec to be used throughout module
+var ModuleCdc *codec.Codec
+
+func init() {
+	cdc := codec.New()
+	RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	ModuleCdc = cdc.Seal()
+}
@@ -0,0 +1,16 @@
+package types
+
+import (
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Codes for denom errors
+var (
+	DefaultCodespace = ModuleName
+
+	// ErrInvalidMetadata error for denom metadata that does not describe the units of a denom
+	ErrInvalidMetadata = sdkErrors.Register(DefaultCodespace, 1, "invalid denom metadata")
+
+	// ErrDuplicateDenom error for a denom unit that is used by the metadata of another denom
+	ErrDuplicateDenom = sdkErrors.Register(DefaultCodespace, 2, "duplicate denom")
+)
@@ -0,0 +1,27 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GenesisState is the genesis state of the denom module
+type GenesisState struct {
+	DenomMetadata []Metadata `json:"denom_metadata,omitempty"`
+}
+
+// ValidateGenesis performs basic validation of the genesis state. The units of all denoms must be unique.
+func ValidateGenesis(data GenesisState) error {
+	units := make(map[string]string)
+	for _, m := range data.DenomMetadata {
+		if err := m.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "denom %s", m.Base)
+		}
+		for _, u := range m.Units() {
+			if base, exists := units[u]; exists {
+				return sdkerrors.Wrapf(ErrDuplicateDenom, "%s of %s and %s", u, base, m.Base)
+			}
+			units[u] = m.Base
+		}
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package types
+
+const (
+	// ModuleName is the name of the denom module
+	ModuleName = "denom"
+
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for the denom module
+	QuerierRoute = ModuleName
+
+	// RouterKey is the msg and proposal router key for the denom module
+	RouterKey = ModuleName
+)
+
+const ( // event attributes
+	AttributeKeyDenom = "denom"
+)
+
+const EventTypeSetDenomMetadata = "set_denom_metadata"
+
+// DenomMetadataPrefix is the store prefix of the metadata, keyed by base denom
+var DenomMetadataPrefix = []byte{0x01}
+
+// GetDenomMetadataKey returns the store key of the metadata of the base denom
+func GetDenomMetadataKey(base string) []byte {
+	return append(DenomMetadataPrefix, []byte(base)...)
+}
@@ -0,0 +1,176 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DenomUnit is a unit of a denom, one unit is 10^exponent coins of the base denom
+type DenomUnit struct {
+	Denom    string   `json:"denom" yaml:"denom"`
+	Exponent uint32   `json:"exponent" yaml:"exponent"`
+	Aliases  []string `json:"aliases,omitempty" yaml:"aliases"`
+}
+
+// Metadata describes the units of a base denom for wallets and clients, e.g. that 1 fet is 10^18 afet. It has the
+// layout of the bank denom metadata of later sdk versions.
+type Metadata struct {
+	Description string `json:"description" yaml:"description"`
+	// DenomUnits are sorted by exponent, the first one is the base denom with exponent 0
+	DenomUnits []DenomUnit `json:"denom_units" yaml:"denom_units"`
+	// Base is the denom of the coins on chain
+	Base string `json:"base" yaml:"base"`
+	// Display is the unit amounts are shown in
+	Display string `json:"display" yaml:"display"`
+}
+
+// Validate checks that the units start with the base denom, have increasing exponents of at most sdk.Precision, so
+// that amounts convert exactly to sdk.Dec, and that the display unit is one of them
+func (m Metadata) Validate() error {
+	if err := sdk.ValidateDenom(m.Base); err != nil {
+		return sdkerrors.Wrap(ErrInvalidMetadata, err.Error())
+	}
+	if len(m.DenomUnits) == 0 || m.DenomUnits[0].Denom != m.Base || m.DenomUnits[0].Exponent != 0 {
+		return sdkerrors.Wrap(ErrInvalidMetadata, "first unit must be the base denom with exponent 0")
+	}
+	seen := make(map[string]struct{})
+	for i, u := range m.DenomUnits {
+		if i != 0 && u.Exponent <= m.DenomUnits[i-1].Exponent {
+			return sdkerrors.Wrapf(ErrInvalidMetadata, "exponent of %s must be bigger than of the unit before", u.Denom)
+		}
+		if u.Exponent > sdk.Precision {
+			return sdkerrors.Wrapf(ErrInvalidMetadata, "exponent of %s must not exceed %d", u.Denom, sdk.Precision)
+		}
+		for _, d := range append([]string{u.Denom}, u.Aliases...) {
+			if err := sdk.ValidateDenom(d); err != nil {
+				return sdkerrors.Wrap(ErrInvalidMetadata, err.Error())
+			}
+			if _, exists := seen[d]; exists {
+				return sdkerrors.Wrapf(ErrDuplicateDenom, "%s", d)
+			}
+			seen[d] = struct{}{}
+		}
+	}
+	if _, ok := m.Unit(m.Display); !ok || m.Display == "" {
+		return sdkerrors.Wrapf(ErrInvalidMetadata, "display %q is not a unit", m.Display)
+	}
+	return nil
+}
+
+// Unit returns the unit with the denom or alias
+func (m Metadata) Unit(denom string) (DenomUnit, bool) {
+	for _, u := range m.DenomUnits {
+		if u.Denom == denom {
+			return u, true
+		}
+		for _, a := range u.Aliases {
+			if a == denom {
+				return u, true
+			}
+		}
+	}
+	return DenomUnit{}, false
+}
+
+// Units returns the denoms and aliases of all units
+func (m Metadata) Units() []string {
+	var res []string
+	for _, u := range m.DenomUnits {
+		res = append(res, u.Denom)
+		res = append(res, u.Aliases...)
+	}
+	return res
+}
+
+// Decimals returns the exponent of the display unit
+func (m Metadata) Decimals() uint32 {
+	u, _ := m.Unit(m.Display)
+	return u.Exponent
+}
+
+// ToDisplay returns the coin of the base denom in the display unit
+func (m Metadata) ToDisplay(coin sdk.Coin) sdk.DecCoin {
+	return sdk.NewDecCoinFromDec(m.Display, sdk.NewDecFromInt(coin.Amount).QuoInt(pow10(m.Decimals())))
+}
+
+// ToBase returns the amount of the unit with the denom or alias in the base denom. The amount must be a whole number
+// of base coins.
+func (m Metadata) ToBase(coin sdk.DecCoin) (sdk.Coin, error) {
+	u, ok := m.Unit(coin.Denom)
+	if !ok {
+		return sdk.Coin{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "%s is no unit of %s", coin.Denom, m.Base)
+	}
+	amount := coin.Amount.MulInt(pow10(u.Exponent))
+	if !amount.TruncateDec().Equal(amount) {
+		return sdk.Coin{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "%s has more than %d decimals", coin, u.Exponent)
+	}
+	return sdk.NewCoin(m.Base, amount.TruncateInt()), nil
+}
+
+// String implements the Stringer interface.
+func (m Metadata) String() string {
+	units := make([]string, len(m.DenomUnits))
+	for i, u := range m.DenomUnits {
+		units[i] = fmt.Sprintf("%s (10^%d %s)", u.Denom, u.Exponent, m.Base)
+	}
+	return fmt.Sprintf(`Denom Metadata:
+  Base:        %s
+  Display:     %s
+  Units:       %s
+  Description: %s
+`, m.Base, m.Display, strings.Join(units, ", "), m.Description)
+}
+
+// ParseCoins parses coins like "1.5fet,100afet" whose denoms can be units of the metadata. The amounts of units are
+// converted to their base denom, coins of other denoms must be whole numbers.
+func ParseCoins(s string, metadata []Metadata) (sdk.Coins, error) {
+	decCoins, err := sdk.ParseDecCoins(s)
+	if err != nil {
+		return nil, err
+	}
+	res := sdk.NewCoins()
+	for _, c := range decCoins {
+		coin, err := toBase(c, metadata)
+		if err != nil {
+			return nil, err
+		}
+		res = res.Add(coin)
+	}
+	return res, nil
+}
+
+func toBase(coin sdk.DecCoin, metadata []Metadata) (sdk.Coin, error) {
+	for _, m := range metadata {
+		if _, ok := m.Unit(coin.Denom); ok {
+			return m.ToBase(coin)
+		}
+	}
+	if !coin.Amount.TruncateDec().Equal(coin.Amount) {
+		return sdk.Coin{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "%s has no denom metadata and must be a whole number", coin)
+	}
+	return sdk.NewCoin(coin.Denom, coin.Amount.TruncateInt()), nil
+}
+
+// DisplayCoins returns the coins in the display units of their metadata, coins of denoms without metadata are
+// returned as they are
+func DisplayCoins(coins sdk.Coins, metadata []Metadata) sdk.DecCoins {
+	res := make(sdk.DecCoins, 0, len(coins))
+	for _, c := range coins {
+		d := sdk.NewDecCoinFromCoin(c)
+		for _, m := range metadata {
+			if m.Base == c.Denom {
+				d = m.ToDisplay(c)
+				break
+			}
+		}
+		res = append(res, d)
+	}
+	return res.Sort()
+}
+
+func pow10(exponent uint32) sdk.Int {
+	return sdk.NewIntWithDecimal(1, int(exponent))
+}
@@ -0,0 +1,127 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func fetMetadata() Metadata {
+	return Metadata{
+		Description: "The native token of the Fetch.ai network",
+		DenomUnits: []DenomUnit{
+			{Denom: "afet", Exponent: 0, Aliases: []string{"attofet"}},
+			{Denom: "nanofet", Exponent: 9},
+			{Denom: "fet", Exponent: 18},
+		},
+		Base:    "afet",
+		Display: "fet",
+	}
+}
+
+func TestMetadataValidate(t *testing.T) {
+	specs := map[string]struct {
+		mutate func(*Metadata)
+		expErr bool
+	}{
+		"valid":            {mutate: func(*Metadata) {}},
+		"base only":        {mutate: func(m *Metadata) { m.DenomUnits = m.DenomUnits[:1]; m.Display = "afet" }},
+		"display alias":    {mutate: func(m *Metadata) { m.Display = "attofet" }},
+		"invalid base":     {mutate: func(m *Metadata) { m.Base = "A" }, expErr: true},
+		"no units":         {mutate: func(m *Metadata) { m.DenomUnits = nil }, expErr: true},
+		"other first":      {mutate: func(m *Metadata) { m.DenomUnits = m.DenomUnits[1:] }, expErr: true},
+		"base exponent":    {mutate: func(m *Metadata) { m.DenomUnits[0].Exponent = 1 }, expErr: true},
+		"same exponent":    {mutate: func(m *Metadata) { m.DenomUnits[2].Exponent = 9 }, expErr: true},
+		"exponent too big": {mutate: func(m *Metadata) { m.DenomUnits[2].Exponent = 19 }, expErr: true},
+		"duplicate unit":   {mutate: func(m *Metadata) { m.DenomUnits[1].Denom = "fet" }, expErr: true},
+		"duplicate alias":  {mutate: func(m *Metadata) { m.DenomUnits[2].Aliases = []string{"attofet"} }, expErr: true},
+		"invalid alias":    {mutate: func(m *Metadata) { m.DenomUnits[2].Aliases = []string{"F"} }, expErr: true},
+		"no display":       {mutate: func(m *Metadata) { m.Display = "" }, expErr: true},
+		"unknown display":  {mutate: func(m *Metadata) { m.Display = "kfet" }, expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			m := fetMetadata()
+			spec.mutate(&m)
+			err := m.Validate()
+			if spec.expErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestParseCoins(t *testing.T) {
+	metadata := []Metadata{fetMetadata()}
+	specs := map[string]struct {
+		src    string
+		exp    sdk.Coins
+		expErr bool
+	}{
+		"display unit":          {src: "1.5fet", exp: sdk.NewCoins(sdk.NewCoin("afet", sdk.NewIntWithDecimal(15, 17)))},
+		"base":                  {src: "100afet", exp: sdk.NewCoins(sdk.NewInt64Coin("afet", 100))},
+		"alias":                 {src: "100attofet", exp: sdk.NewCoins(sdk.NewInt64Coin("afet", 100))},
+		"units added":           {src: "1nanofet,100afet", exp: sdk.NewCoins(sdk.NewInt64Coin("afet", 1000000100))},
+		"other denom":           {src: "1fet,10stake", exp: sdk.NewCoins(sdk.NewCoin("afet", sdk.NewIntWithDecimal(1, 18)), sdk.NewInt64Coin("stake", 10))},
+		"fraction of base coin": {src: "0.5nanofet,0.1afet", expErr: true},
+		"fraction of base unit": {src: "0.0000000001nanofet", expErr: true},
+		"fraction of other":     {src: "1.5stake", expErr: true},
+		"invalid":               {src: "fet", expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			coins, err := ParseCoins(spec.src, metadata)
+			if spec.expErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.exp, coins)
+		})
+	}
+}
+
+func TestDisplayCoins(t *testing.T) {
+	coins := sdk.NewCoins(sdk.NewCoin("afet", sdk.NewIntWithDecimal(25, 17)), sdk.NewInt64Coin("stake", 10))
+	exp := sdk.DecCoins{
+		sdk.NewDecCoinFromDec("fet", sdk.NewDecWithPrec(25, 1)),
+		sdk.NewInt64DecCoin("stake", 10),
+	}
+	assert.Equal(t, exp, DisplayCoins(coins, []Metadata{fetMetadata()}))
+}
+
+func TestValidateGenesis(t *testing.T) {
+	other := Metadata{
+		DenomUnits: []DenomUnit{{Denom: "ustake"}, {Denom: "stake", Exponent: 6}},
+		Base:       "ustake",
+		Display:    "stake",
+	}
+	specs := map[string]struct {
+		src    GenesisState
+		expErr bool
+	}{
+		"empty":     {src: GenesisState{}},
+		"valid":     {src: GenesisState{DenomMetadata: []Metadata{fetMetadata(), other}}},
+		"invalid":   {src: GenesisState{DenomMetadata: []Metadata{{Base: "afet"}}}, expErr: true},
+		"duplicate": {src: GenesisState{DenomMetadata: []Metadata{fetMetadata(), fetMetadata()}}, expErr: true},
+		"shared unit": {src: GenesisState{DenomMetadata: []Metadata{fetMetadata(), {
+			DenomUnits: []DenomUnit{{Denom: "ufet"}, {Denom: "fet", Exponent: 6}},
+			Base:       "ufet",
+			Display:    "fet",
+		}}}, expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := ValidateGenesis(spec.src)
+			if spec.expErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
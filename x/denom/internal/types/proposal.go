@@ -0,0 +1,50 @@
+package types
+
+import (
+	"fmt"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const ProposalTypeSetDenomMetadata = "SetDenomMetadata"
+
+func init() { // register new content types with the sdk
+	govtypes.RegisterProposalType(ProposalTypeSetDenomMetadata)
+	govtypes.RegisterProposalTypeCodec(SetDenomMetadataProposal{}, "denom/SetDenomMetadataProposal")
+}
+
+// SetDenomMetadataProposal gov proposal content type to set or replace the metadata of a base denom
+type SetDenomMetadataProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+
+	Metadata Metadata `json:"metadata" yaml:"metadata"`
+}
+
+// GetTitle returns the title of the proposal
+func (p SetDenomMetadataProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal
+func (p SetDenomMetadataProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of the proposal
+func (p SetDenomMetadataProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type
+func (p SetDenomMetadataProposal) ProposalType() string { return ProposalTypeSetDenomMetadata }
+
+// ValidateBasic validates the proposal
+func (p SetDenomMetadataProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	return p.Metadata.Validate()
+}
+
+// String implements the Stringer interface.
+func (p SetDenomMetadataProposal) String() string {
+	return fmt.Sprintf(`Set Denom Metadata Proposal:
+  Title:       %s
+  Description: %s
+  %s`, p.Title, p.Description, p.Metadata)
+}
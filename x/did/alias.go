@@ -0,0 +1,63 @@
+package did
+
+import (
+	"github.com/fetchai/fetchd/x/did/internal/keeper"
+	"github.com/fetchai/fetchd/x/did/internal/types"
+)
+
+const (
+	ModuleName              = types.ModuleName
+	StoreKey                = types.StoreKey
+	QuerierRoute            = types.QuerierRoute
+	RouterKey               = types.RouterKey
+	DIDMethodPrefix         = types.DIDMethodPrefix
+	AttributeKeyDID         = types.AttributeKeyDID
+	AttributeKeyIssuer      = types.AttributeKeyIssuer
+	AttributeKeyRegistry    = types.AttributeKeyRegistry
+	AttributeKeyHash        = types.AttributeKeyHash
+	EventTypeCreateDID      = types.EventTypeCreateDID
+	EventTypeUpdateDID      = types.EventTypeUpdateDID
+	EventTypeDeactivateDID  = types.EventTypeDeactivateDID
+	EventTypeAnchorRegistry = types.EventTypeAnchorRegistry
+	QueryResolve            = keeper.QueryResolve
+	QueryRegistry           = keeper.QueryRegistry
+	QueryRegistries         = keeper.QueryRegistries
+	WasmQueryRoute          = keeper.WasmQueryRoute
+)
+
+var (
+	// functions aliases
+	RegisterCodec   = types.RegisterCodec
+	ValidateGenesis = types.ValidateGenesis
+	ValidateDID     = types.ValidateDID
+	NewKeeper       = keeper.NewKeeper
+	NewQuerier      = keeper.NewQuerier
+	NewWasmQuerier  = keeper.NewWasmQuerier
+	InitGenesis     = keeper.InitGenesis
+	ExportGenesis   = keeper.ExportGenesis
+
+	// variable aliases
+	ModuleCdc          = types.ModuleCdc
+	ErrInvalidDID      = types.ErrInvalidDID
+	ErrDIDExists       = types.ErrDIDExists
+	ErrDIDNotFound     = types.ErrDIDNotFound
+	ErrNotController   = types.ErrNotController
+	ErrDeactivated     = types.ErrDeactivated
+	ErrInvalidRegistry = types.ErrInvalidRegistry
+)
+
+type (
+	Keeper                      = keeper.Keeper
+	WasmQuery                   = keeper.WasmQuery
+	GenesisState                = types.GenesisState
+	DIDDocument                 = types.DIDDocument
+	VerificationMethod          = types.VerificationMethod
+	Service                     = types.Service
+	DIDRecord                   = types.DIDRecord
+	DIDMetadata                 = types.DIDMetadata
+	RevocationRegistry          = types.RevocationRegistry
+	MsgCreateDID                = types.MsgCreateDID
+	MsgUpdateDID                = types.MsgUpdateDID
+	MsgDeactivateDID            = types.MsgDeactivateDID
+	MsgAnchorRevocationRegistry = types.MsgAnchorRevocationRegistry
+)
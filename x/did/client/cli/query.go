@@ -0,0 +1,112 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/fetchai/fetchd/x/did/internal/keeper"
+	"github.com/fetchai/fetchd/x/did/internal/types"
+)
+
+// GetQueryCmd returns the query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the DIDs",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(flags.GetCommands(
+		GetCmdQueryResolve(cdc),
+		GetCmdQueryRegistry(cdc),
+		GetCmdQueryRegistries(cdc),
+	)...)
+	return queryCmd
+}
+
+// GetCmdQueryResolve resolves a DID
+func GetCmdQueryResolve(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "resolve [did]",
+		Short: "Show the document of a DID with the heights it was created and updated at and if it is deactivated",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := types.ValidateDID(args[0]); err != nil {
+				return err
+			}
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, keeper.QueryResolve, args[0]))
+			if err != nil {
+				return err
+			}
+			var record *types.DIDRecord
+			if err := json.Unmarshal(res, &record); err != nil {
+				return err
+			}
+			if record == nil {
+				return sdkerrors.Wrap(types.ErrDIDNotFound, args[0])
+			}
+			return cliCtx.PrintOutput(record)
+		},
+	}
+}
+
+// GetCmdQueryRegistry shows a revocation registry
+func GetCmdQueryRegistry(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "registry [issuer_did] [name]",
+		Short: "Show the anchored hash and the uri of a revocation registry of an issuer",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := types.ValidateDID(args[0]); err != nil {
+				return err
+			}
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%s/%s", types.QuerierRoute, keeper.QueryRegistry, args[0], args[1]))
+			if err != nil {
+				return err
+			}
+			var registry *types.RevocationRegistry
+			if err := json.Unmarshal(res, &registry); err != nil {
+				return err
+			}
+			if registry == nil {
+				return sdkerrors.Wrapf(types.ErrInvalidRegistry, "no registry %s of %s", args[1], args[0])
+			}
+			return cliCtx.PrintOutput(registry)
+		},
+	}
+}
+
+// GetCmdQueryRegistries lists the revocation registries of an issuer
+func GetCmdQueryRegistries(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "registries [issuer_did]",
+		Short: "List the revocation registries of an issuer",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := types.ValidateDID(args[0]); err != nil {
+				return err
+			}
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, keeper.QueryRegistries, args[0]))
+			if err != nil {
+				return err
+			}
+			var registries []types.RevocationRegistry
+			if err := json.Unmarshal(res, &registries); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(registries)
+		},
+	}
+}
@@ -0,0 +1,163 @@
+package cli
+
+import (
+	"bufio"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	"github.com/fetchai/fetchd/x/did/internal/types"
+	wasmUtils "github.com/fetchai/fetchd/x/wasm/client/utils"
+)
+
+const flagURI = "uri"
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "DID transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	txCmd.AddCommand(wasmUtils.AddBroadcastFlags(flags.PostCommands(
+		CreateDIDCmd(cdc),
+		UpdateDIDCmd(cdc),
+		DeactivateDIDCmd(cdc),
+		AnchorRegistryCmd(cdc),
+	)...)...)
+	return txCmd
+}
+
+// CreateDIDCmd creates a DID from a document file
+func CreateDIDCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "create [document_json_file]",
+		Short: "Create a DID with the document of the json file",
+		Long: `Create the DID of the document json file, e.g. did:fetch:alice. The --from account must be the account of
+an authentication key of the document or of one of its controller DIDs. Keys are bech32 account public keys in the
+pub_key field of the verification methods.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			doc, err := readDocument(cdc, args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.MsgCreateDID{Signer: cliCtx.GetFromAddress(), Document: doc}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// UpdateDIDCmd replaces the document of a DID
+func UpdateDIDCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "update [document_json_file]",
+		Short: "Replace the document of a DID with the document of the json file",
+		Long: `Replace the document of the DID of the document json file. The --from account must control the current
+document, the new document may hand the control over to other keys or controllers.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			doc, err := readDocument(cdc, args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.MsgUpdateDID{Signer: cliCtx.GetFromAddress(), Document: doc}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// DeactivateDIDCmd deactivates a DID
+func DeactivateDIDCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "deactivate [did]",
+		Short: "Deactivate a DID controlled by the --from account for good",
+		Long:  "Deactivate the DID. It still resolves with its last document, but cannot be updated or created again.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			msg := types.MsgDeactivateDID{Signer: cliCtx.GetFromAddress(), DID: args[0]}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// AnchorRegistryCmd anchors the hash of a revocation registry of an issuer DID
+func AnchorRegistryCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "anchor-registry [issuer_did] [name] [sha256_hex]",
+		Short: "Anchor the sha256 hash of a credential revocation registry of a DID controlled by the --from account",
+		Long: `Create or update the revocation registry of the issuer DID with the hex encoded sha256 hash of the
+registry, e.g. of a status list credential published at the --uri. Verifiers compare the hash of the fetched registry
+with the anchored hash.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			hash, err := hex.DecodeString(args[2])
+			if err != nil {
+				return fmt.Errorf("hash: %s", err)
+			}
+			msg := types.MsgAnchorRevocationRegistry{
+				Signer: cliCtx.GetFromAddress(),
+				Issuer: args[0],
+				Name:   args[1],
+				Hash:   hash,
+				URI:    viper.GetString(flagURI),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(flagURI, "", "URI the revocation registry is published at")
+	return cmd
+}
+
+func readDocument(cdc *codec.Codec, file string) (types.DIDDocument, error) {
+	var doc types.DIDDocument
+	bz, err := ioutil.ReadFile(file)
+	if err != nil {
+		return doc, err
+	}
+	if err := cdc.UnmarshalJSON(bz, &doc); err != nil {
+		return doc, fmt.Errorf("document: %s", err)
+	}
+	return doc, nil
+}
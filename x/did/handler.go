@@ -0,0 +1,70 @@
+package did
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewHandler returns a handler for "did" type messages.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case MsgCreateDID:
+			return handleCreateDID(ctx, k, &msg)
+		case MsgUpdateDID:
+			return handleUpdateDID(ctx, k, &msg)
+		case MsgDeactivateDID:
+			return handleDeactivateDID(ctx, k, &msg)
+		case MsgAnchorRevocationRegistry:
+			return handleAnchorRevocationRegistry(ctx, k, &msg)
+		default:
+			errMsg := fmt.Sprintf("unrecognized did message type: %T", msg)
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+		}
+	}
+}
+
+func handleCreateDID(ctx sdk.Context, k Keeper, msg *MsgCreateDID) (*sdk.Result, error) {
+	if err := k.Create(ctx, msg.Signer, msg.Document); err != nil {
+		return nil, err
+	}
+	return result(ctx, msg.Signer, msg.Document.ID), nil
+}
+
+func handleUpdateDID(ctx sdk.Context, k Keeper, msg *MsgUpdateDID) (*sdk.Result, error) {
+	if err := k.Update(ctx, msg.Signer, msg.Document); err != nil {
+		return nil, err
+	}
+	return result(ctx, msg.Signer, msg.Document.ID), nil
+}
+
+func handleDeactivateDID(ctx sdk.Context, k Keeper, msg *MsgDeactivateDID) (*sdk.Result, error) {
+	if err := k.Deactivate(ctx, msg.Signer, msg.DID); err != nil {
+		return nil, err
+	}
+	return result(ctx, msg.Signer, msg.DID), nil
+}
+
+func handleAnchorRevocationRegistry(ctx sdk.Context, k Keeper, msg *MsgAnchorRevocationRegistry) (*sdk.Result, error) {
+	if _, err := k.AnchorRegistry(ctx, *msg); err != nil {
+		return nil, err
+	}
+	return result(ctx, msg.Signer, msg.Issuer), nil
+}
+
+func result(ctx sdk.Context, sender sdk.AccAddress, did string) *sdk.Result {
+	events := ctx.EventManager().Events()
+	ourEvent := sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(AttributeKeyDID, did),
+	)
+	return &sdk.Result{
+		Events: append(events, ourEvent),
+	}
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fetchai/fetchd/x/did/internal/types"
+)
+
+// InitGenesis stores the DIDs and the revocation registries of the genesis
+func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
+	for _, r := range data.DIDs {
+		keeper.setDID(ctx, r)
+	}
+	for _, r := range data.Registries {
+		keeper.setRegistry(ctx, r)
+	}
+}
+
+// ExportGenesis returns the DIDs, including the deactivated ones, and the revocation registries as genesis state
+func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
+	var data types.GenesisState
+	keeper.IterateDIDs(ctx, func(r types.DIDRecord) bool {
+		data.DIDs = append(data.DIDs, r)
+		return false
+	})
+	keeper.IterateRegistries(ctx, func(r types.RevocationRegistry) bool {
+		data.Registries = append(data.Registries, r)
+		return false
+	})
+	return data
+}
@@ -0,0 +1,204 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/fetchai/fetchd/x/did/internal/types"
+)
+
+// Keeper keeps the DID documents and the anchored revocation registries of their credentials. A DID is controlled by
+// the accounts of its authentication keys and of the authentication keys of its controller DIDs.
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      *codec.Codec
+}
+
+// NewKeeper creates a new did Keeper instance
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey) Keeper {
+	return Keeper{
+		storeKey: storeKey,
+		cdc:      cdc,
+	}
+}
+
+// Create creates the DID of the document, which the signer must control. A DID is never created again, also when it
+// is deactivated.
+func (k Keeper) Create(ctx sdk.Context, signer sdk.AccAddress, doc types.DIDDocument) error {
+	if k.GetDID(ctx, doc.ID) != nil {
+		return sdkerrors.Wrap(types.ErrDIDExists, doc.ID)
+	}
+	if !k.controls(ctx, signer, doc) {
+		return sdkerrors.Wrap(types.ErrNotController, doc.ID)
+	}
+	k.setDID(ctx, types.DIDRecord{
+		Document: doc,
+		Metadata: types.DIDMetadata{Created: ctx.BlockHeight(), Updated: ctx.BlockHeight()},
+	})
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCreateDID,
+		sdk.NewAttribute(types.AttributeKeyDID, doc.ID),
+	))
+	return nil
+}
+
+// Update replaces the document of the active DID, the signer must control the current document. The new document
+// may hand the control over to other keys.
+func (k Keeper) Update(ctx sdk.Context, signer sdk.AccAddress, doc types.DIDDocument) error {
+	record, err := k.controlledDID(ctx, signer, doc.ID)
+	if err != nil {
+		return err
+	}
+	record.Document = doc
+	record.Metadata.Updated = ctx.BlockHeight()
+	k.setDID(ctx, *record)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateDID,
+		sdk.NewAttribute(types.AttributeKeyDID, doc.ID),
+	))
+	return nil
+}
+
+// Deactivate deactivates the active DID for good, the signer must control its document. The deactivated DID still
+// resolves with its last document.
+func (k Keeper) Deactivate(ctx sdk.Context, signer sdk.AccAddress, did string) error {
+	record, err := k.controlledDID(ctx, signer, did)
+	if err != nil {
+		return err
+	}
+	record.Metadata.Deactivated = true
+	record.Metadata.Updated = ctx.BlockHeight()
+	k.setDID(ctx, *record)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDeactivateDID,
+		sdk.NewAttribute(types.AttributeKeyDID, did),
+	))
+	return nil
+}
+
+// AnchorRegistry creates or updates the revocation registry of the active issuer DID, the signer must control the
+// issuer document
+func (k Keeper) AnchorRegistry(ctx sdk.Context, msg types.MsgAnchorRevocationRegistry) (types.RevocationRegistry, error) {
+	if _, err := k.controlledDID(ctx, msg.Signer, msg.Issuer); err != nil {
+		return types.RevocationRegistry{}, err
+	}
+	registry := types.RevocationRegistry{
+		Issuer:  msg.Issuer,
+		Name:    msg.Name,
+		Hash:    msg.Hash,
+		URI:     msg.URI,
+		Updated: ctx.BlockHeight(),
+	}
+	k.setRegistry(ctx, registry)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeAnchorRegistry,
+		sdk.NewAttribute(types.AttributeKeyIssuer, registry.Issuer),
+		sdk.NewAttribute(types.AttributeKeyRegistry, registry.Name),
+		sdk.NewAttribute(types.AttributeKeyHash, registry.Hash.String()),
+	))
+	return registry, nil
+}
+
+func (k Keeper) controlledDID(ctx sdk.Context, signer sdk.AccAddress, did string) (*types.DIDRecord, error) {
+	record := k.GetDID(ctx, did)
+	if record == nil {
+		return nil, sdkerrors.Wrap(types.ErrDIDNotFound, did)
+	}
+	if record.Metadata.Deactivated {
+		return nil, sdkerrors.Wrap(types.ErrDeactivated, did)
+	}
+	if !k.controls(ctx, signer, record.Document) {
+		return nil, sdkerrors.Wrap(types.ErrNotController, did)
+	}
+	return record, nil
+}
+
+// controls returns true when the account is an authentication key of the document or of one of its active
+// controllers. The controllers of controllers are not followed.
+func (k Keeper) controls(ctx sdk.Context, addr sdk.AccAddress, doc types.DIDDocument) bool {
+	if doc.IsAuthenticatedBy(addr) {
+		return true
+	}
+	for _, c := range doc.Controller {
+		if c == doc.ID {
+			continue
+		}
+		controller := k.GetDID(ctx, c)
+		if controller != nil && !controller.Metadata.Deactivated && controller.Document.IsAuthenticatedBy(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetDID returns the record of the DID, also when it is deactivated, nil when there is none
+func (k Keeper) GetDID(ctx sdk.Context, did string) *types.DIDRecord {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetDIDKey(did))
+	if bz == nil {
+		return nil
+	}
+	var record types.DIDRecord
+	k.cdc.MustUnmarshalBinaryBare(bz, &record)
+	return &record
+}
+
+func (k Keeper) setDID(ctx sdk.Context, record types.DIDRecord) {
+	ctx.KVStore(k.storeKey).Set(types.GetDIDKey(record.Document.ID), k.cdc.MustMarshalBinaryBare(record))
+}
+
+// IterateDIDs calls cb for all DID records, including the deactivated ones, until cb returns true
+func (k Keeper) IterateDIDs(ctx sdk.Context, cb func(types.DIDRecord) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.DIDPrefix).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var record types.DIDRecord
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &record)
+		if cb(record) {
+			return
+		}
+	}
+}
+
+// GetRegistry returns the revocation registry of the issuer, nil when there is none
+func (k Keeper) GetRegistry(ctx sdk.Context, issuer, name string) *types.RevocationRegistry {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetRegistryKey(issuer, name))
+	if bz == nil {
+		return nil
+	}
+	var registry types.RevocationRegistry
+	k.cdc.MustUnmarshalBinaryBare(bz, &registry)
+	return &registry
+}
+
+func (k Keeper) setRegistry(ctx sdk.Context, registry types.RevocationRegistry) {
+	ctx.KVStore(k.storeKey).Set(types.GetRegistryKey(registry.Issuer, registry.Name), k.cdc.MustMarshalBinaryBare(registry))
+}
+
+// GetIssuerRegistries returns the revocation registries of the issuer ordered by name
+func (k Keeper) GetIssuerRegistries(ctx sdk.Context, issuer string) []types.RevocationRegistry {
+	registries := []types.RevocationRegistry{}
+	k.iterateRegistries(ctx, types.GetIssuerRegistriesPrefix(issuer), func(r types.RevocationRegistry) bool {
+		registries = append(registries, r)
+		return false
+	})
+	return registries
+}
+
+// IterateRegistries calls cb for the revocation registries of all issuers until cb returns true
+func (k Keeper) IterateRegistries(ctx sdk.Context, cb func(types.RevocationRegistry) bool) {
+	k.iterateRegistries(ctx, types.RegistryPrefix, cb)
+}
+
+func (k Keeper) iterateRegistries(ctx sdk.Context, keyPrefix []byte, cb func(types.RevocationRegistry) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var registry types.RevocationRegistry
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &registry)
+		if cb(registry) {
+			return
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/fetchai/fetchd/testutil"
+	"github.com/fetchai/fetchd/x/did/internal/types"
+)
+
+func setupKeeper(t *testing.T) (sdk.Context, Keeper) {
+	cdc := codec.New()
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	ctx, _ := testutil.NewContext(t, cdc, abci.Header{Height: 10}, key)
+	return ctx, NewKeeper(cdc, key)
+}
+
+// document returns a document of the DID authenticated by the key, controlled by the controllers
+func document(t *testing.T, did string, key crypto.PubKey, controllers ...string) types.DIDDocument {
+	pubKey, err := sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, key)
+	require.NoError(t, err)
+	return types.DIDDocument{
+		ID:                 did,
+		Controller:         controllers,
+		VerificationMethod: []types.VerificationMethod{{ID: did + "#key-1", Type: "Secp256k1VerificationKey2018", Controller: did, PubKey: pubKey}},
+		Authentication:     []string{did + "#key-1"},
+	}
+}
+
+func TestCreateUpdateDeactivate(t *testing.T) {
+	var (
+		aliceKey = secp256k1.GenPrivKey().PubKey()
+		bobKey   = secp256k1.GenPrivKey().PubKey()
+		alice    = sdk.AccAddress(aliceKey.Address())
+		bob      = sdk.AccAddress(bobKey.Address())
+	)
+	specs := map[string]struct {
+		do     func(sdk.Context, Keeper) error
+		expErr *sdkerrors.Error
+	}{
+		"create by authentication key": {
+			do: func(ctx sdk.Context, k Keeper) error {
+				return k.Create(ctx, bob, document(t, "did:fetch:bob", bobKey))
+			},
+		},
+		"create by other key": {
+			do: func(ctx sdk.Context, k Keeper) error {
+				return k.Create(ctx, alice, document(t, "did:fetch:bob", bobKey))
+			},
+			expErr: types.ErrNotController,
+		},
+		"create by controller": {
+			do: func(ctx sdk.Context, k Keeper) error {
+				return k.Create(ctx, alice, document(t, "did:fetch:bot", bobKey, "did:fetch:alice"))
+			},
+		},
+		"create existing": {
+			do: func(ctx sdk.Context, k Keeper) error {
+				return k.Create(ctx, alice, document(t, "did:fetch:alice", aliceKey))
+			},
+			expErr: types.ErrDIDExists,
+		},
+		"update to other key": {
+			do: func(ctx sdk.Context, k Keeper) error {
+				if err := k.Update(ctx, alice, document(t, "did:fetch:alice", bobKey)); err != nil {
+					return err
+				}
+				return k.Update(ctx, alice, document(t, "did:fetch:alice", aliceKey))
+			},
+			expErr: types.ErrNotController,
+		},
+		"update unknown": {
+			do: func(ctx sdk.Context, k Keeper) error {
+				return k.Update(ctx, bob, document(t, "did:fetch:bob", bobKey))
+			},
+			expErr: types.ErrDIDNotFound,
+		},
+		"update by former controller": {
+			do: func(ctx sdk.Context, k Keeper) error {
+				if err := k.Create(ctx, alice, document(t, "did:fetch:bot", bobKey, "did:fetch:alice")); err != nil {
+					return err
+				}
+				if err := k.Deactivate(ctx, alice, "did:fetch:alice"); err != nil {
+					return err
+				}
+				return k.Update(ctx, alice, document(t, "did:fetch:bot", aliceKey))
+			},
+			expErr: types.ErrNotController,
+		},
+		"update deactivated": {
+			do: func(ctx sdk.Context, k Keeper) error {
+				if err := k.Deactivate(ctx, alice, "did:fetch:alice"); err != nil {
+					return err
+				}
+				return k.Update(ctx, alice, document(t, "did:fetch:alice", aliceKey))
+			},
+			expErr: types.ErrDeactivated,
+		},
+		"create deactivated": {
+			do: func(ctx sdk.Context, k Keeper) error {
+				if err := k.Deactivate(ctx, alice, "did:fetch:alice"); err != nil {
+					return err
+				}
+				return k.Create(ctx, alice, document(t, "did:fetch:alice", aliceKey))
+			},
+			expErr: types.ErrDIDExists,
+		},
+		"deactivate by other key": {
+			do: func(ctx sdk.Context, k Keeper) error {
+				return k.Deactivate(ctx, bob, "did:fetch:alice")
+			},
+			expErr: types.ErrNotController,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, k := setupKeeper(t)
+			require.NoError(t, k.Create(ctx.WithBlockHeight(5), alice, document(t, "did:fetch:alice", aliceKey)))
+
+			err := spec.do(ctx, k)
+			if spec.expErr != nil {
+				require.True(t, spec.expErr.Is(err), "got %v", err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestResolve(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	key := secp256k1.GenPrivKey().PubKey()
+	signer := sdk.AccAddress(key.Address())
+	doc := document(t, "did:fetch:alice", key)
+	require.NoError(t, k.Create(ctx.WithBlockHeight(5), signer, doc))
+	assert.Nil(t, k.GetDID(ctx, "did:fetch:bob"))
+
+	doc.Service = []types.Service{{ID: "did:fetch:alice#agent", Type: "Agent", ServiceEndpoint: "https://agent.example.com"}}
+	require.NoError(t, k.Update(ctx, signer, doc))
+	exp := types.DIDRecord{Document: doc, Metadata: types.DIDMetadata{Created: 5, Updated: 10}}
+	assert.Equal(t, &exp, k.GetDID(ctx, "did:fetch:alice"))
+
+	require.NoError(t, k.Deactivate(ctx.WithBlockHeight(12), signer, "did:fetch:alice"))
+	exp.Metadata = types.DIDMetadata{Created: 5, Updated: 12, Deactivated: true}
+	assert.Equal(t, &exp, k.GetDID(ctx, "did:fetch:alice"))
+	assert.Equal(t, types.GenesisState{DIDs: []types.DIDRecord{exp}}, ExportGenesis(ctx, k))
+}
+
+func TestAnchorRegistry(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	key := secp256k1.GenPrivKey().PubKey()
+	signer := sdk.AccAddress(key.Address())
+	require.NoError(t, k.Create(ctx, signer, document(t, "did:fetch:alice", key)))
+	require.NoError(t, k.Create(ctx, signer, document(t, "did:fetch:alice2", key)))
+	anchor := func(issuer, name, list string) types.MsgAnchorRevocationRegistry {
+		hash := sha256.Sum256([]byte(list))
+		return types.MsgAnchorRevocationRegistry{Signer: signer, Issuer: issuer, Name: name, Hash: hash[:], URI: "https://example.com/" + name}
+	}
+
+	_, err := k.AnchorRegistry(ctx, anchor("did:fetch:alice", "2024", "v1"))
+	require.NoError(t, err)
+	_, err = k.AnchorRegistry(ctx, anchor("did:fetch:alice2", "2024", "other"))
+	require.NoError(t, err)
+	updated, err := k.AnchorRegistry(ctx.WithBlockHeight(11), anchor("did:fetch:alice", "2024", "v2"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(11), updated.Updated)
+	assert.Equal(t, &updated, k.GetRegistry(ctx, "did:fetch:alice", "2024"))
+	assert.Equal(t, []types.RevocationRegistry{updated}, k.GetIssuerRegistries(ctx, "did:fetch:alice"))
+	assert.Nil(t, k.GetRegistry(ctx, "did:fetch:alice", "2025"))
+
+	_, err = k.AnchorRegistry(ctx, anchor("did:fetch:bob", "2024", "v1"))
+	assert.True(t, types.ErrDIDNotFound.Is(err), "got %v", err)
+	other := anchor("did:fetch:alice", "2024", "v3")
+	other.Signer = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	_, err = k.AnchorRegistry(ctx, other)
+	assert.True(t, types.ErrNotController.Is(err), "got %v", err)
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+const (
+	// QueryResolve returns the document and the metadata of a DID, path: did
+	QueryResolve = "resolve"
+	// QueryRegistry returns a revocation registry, path: issuer, name
+	QueryRegistry = "registry"
+	// QueryRegistries returns the revocation registries of an issuer, path: issuer
+	QueryRegistries = "registries"
+)
+
+// NewQuerier creates a new querier
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		switch path[0] {
+		case QueryResolve:
+			if len(path) < 2 {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "DID required")
+			}
+			record := keeper.GetDID(ctx, path[1])
+			if record == nil {
+				return []byte("null"), nil
+			}
+			return marshal(record)
+		case QueryRegistry:
+			if len(path) < 3 {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "issuer and name required")
+			}
+			registry := keeper.GetRegistry(ctx, path[1], path[2])
+			if registry == nil {
+				return []byte("null"), nil
+			}
+			return marshal(registry)
+		case QueryRegistries:
+			if len(path) < 2 {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "issuer required")
+			}
+			return marshal(keeper.GetIssuerRegistries(ctx, path[1]))
+		default:
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown data query endpoint")
+		}
+	}
+}
+
+func marshal(v interface{}) ([]byte, error) {
+	bz, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
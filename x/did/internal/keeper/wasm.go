@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/fetchai/fetchd/x/did/internal/types"
+)
+
+// WasmQueryRoute is the custom query route of contracts for the DID resolution
+const WasmQueryRoute = types.ModuleName
+
+// WasmQuery is the custom query of contracts, `{"did":{"resolve":{"did":"did:fetch:alice"}}}` or
+// `{"did":{"revocation_registry":{"issuer":"did:fetch:alice","name":"2024"}}}`
+type WasmQuery struct {
+	Resolve            *WasmResolveQuery  `json:"resolve,omitempty"`
+	RevocationRegistry *WasmRegistryQuery `json:"revocation_registry,omitempty"`
+}
+
+// WasmResolveQuery resolves the DID
+type WasmResolveQuery struct {
+	DID string `json:"did"`
+}
+
+// WasmRegistryQuery returns the revocation registry of the issuer
+type WasmRegistryQuery struct {
+	Issuer string `json:"issuer"`
+	Name   string `json:"name"`
+}
+
+// NewWasmQuerier returns the custom querier of contracts for the DID resolution, to be registered with
+// WasmQueryRoute. The queries return the DID record or the revocation registry json and fail when there is none.
+func NewWasmQuerier(keeper Keeper) func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var query WasmQuery
+		if err := json.Unmarshal(request, &query); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+		switch {
+		case query.Resolve != nil:
+			record := keeper.GetDID(ctx, query.Resolve.DID)
+			if record == nil {
+				return nil, sdkerrors.Wrap(types.ErrDIDNotFound, query.Resolve.DID)
+			}
+			return json.Marshal(record)
+		case query.RevocationRegistry != nil:
+			registry := keeper.GetRegistry(ctx, query.RevocationRegistry.Issuer, query.RevocationRegistry.Name)
+			if registry == nil {
+				return nil, sdkerrors.Wrapf(types.ErrInvalidRegistry, "no registry %s of %s", query.RevocationRegistry.Name, query.RevocationRegistry.Issuer)
+			}
+			return json.Marshal(registry)
+		default:
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown did query")
+		}
+	}
+}
@@ -0,0 +1,23 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers the msg types of the did module
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgCreateDID{}, "did/MsgCreateDID", nil)
+	cdc.RegisterConcrete(MsgUpdateDID{}, "did/MsgUpdateDID", nil)
+	cdc.RegisterConcrete(MsgDeactivateDID{}, "did/MsgDeactivateDID", nil)
+	cdc.RegisterConcrete(MsgAnchorRevocationRegistry{}, "did/MsgAnchorRevocationRegistry", nil)
+}
+
+// ModuleCdc generic sealed codec to be used throughout module
+var ModuleCdc *codec.Codec
+
+func init() {
+	cdc := codec.New()
+	RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	ModuleCdc = cdc.Seal()
+}
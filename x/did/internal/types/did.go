@@ -0,0 +1,167 @@
+package types
+
+import (
+	"regexp"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// DIDMethodPrefix is the prefix of the DIDs of the fetch DID method
+	DIDMethodPrefix = "did:fetch:"
+	// MaxDIDLength is the max length of a DID
+	MaxDIDLength = 128
+)
+
+var (
+	// reDID matches the DIDs of the fetch method, the method specific id uses the charset of the DID syntax
+	reDID = regexp.MustCompile(`^did:fetch:[a-zA-Z0-9._-]+(:[a-zA-Z0-9._-]+)*$`)
+	// reFragment matches the fragments of the DID URLs of verification methods and services
+	reFragment = regexp.MustCompile(`^[a-zA-Z0-9._-]+$`)
+)
+
+// ValidateDID validates a DID of the fetch method, e.g. did:fetch:alice
+func ValidateDID(did string) error {
+	if len(did) > MaxDIDLength {
+		return sdkerrors.Wrapf(ErrInvalidDID, "longer than %d", MaxDIDLength)
+	}
+	if !reDID.MatchString(did) {
+		return sdkerrors.Wrapf(ErrInvalidDID, "%q is no %s DID", did, strings.TrimSuffix(DIDMethodPrefix, ":"))
+	}
+	return nil
+}
+
+// DIDDocument is the document a DID resolves to, a subset of the W3C DID core data model. The DID is controlled by
+// the accounts of its authentication keys and of the authentication keys of its controllers.
+type DIDDocument struct {
+	ID string `json:"id" yaml:"id"`
+	// Controller are the DIDs whose authentication keys control the DID besides its own
+	Controller         []string             `json:"controller,omitempty" yaml:"controller"`
+	VerificationMethod []VerificationMethod `json:"verification_method,omitempty" yaml:"verification_method"`
+	// Authentication are the ids of the verification methods that authenticate as the DID
+	Authentication []string  `json:"authentication,omitempty" yaml:"authentication"`
+	Service        []Service `json:"service,omitempty" yaml:"service"`
+}
+
+// VerificationMethod is a public key of a DID, e.g. to verify the credentials the DID issues
+type VerificationMethod struct {
+	// ID is the DID URL of the method, the DID with a fragment like did:fetch:alice#key-1
+	ID         string `json:"id" yaml:"id"`
+	Type       string `json:"type" yaml:"type"`
+	Controller string `json:"controller" yaml:"controller"`
+	// PubKey is the bech32 encoded account public key, Authentication keys control the DID with the account of the key
+	PubKey string `json:"pub_key" yaml:"pub_key"`
+}
+
+// Service is an endpoint of a DID, e.g. of an agent
+type Service struct {
+	// ID is the DID URL of the service, the DID with a fragment like did:fetch:alice#agent
+	ID              string `json:"id" yaml:"id"`
+	Type            string `json:"type" yaml:"type"`
+	ServiceEndpoint string `json:"service_endpoint" yaml:"service_endpoint"`
+}
+
+// Validate validates the document. It must be controllable, by an own authentication key or by a controller.
+func (d DIDDocument) Validate() error {
+	if err := ValidateDID(d.ID); err != nil {
+		return err
+	}
+	for _, c := range d.Controller {
+		if err := ValidateDID(c); err != nil {
+			return sdkerrors.Wrap(err, "controller")
+		}
+	}
+	methods := make(map[string]struct{}, len(d.VerificationMethod))
+	for _, m := range d.VerificationMethod {
+		if err := d.validateURL(m.ID); err != nil {
+			return sdkerrors.Wrap(err, "verification method")
+		}
+		if _, exists := methods[m.ID]; exists {
+			return sdkerrors.Wrapf(ErrInvalidDID, "duplicate verification method %s", m.ID)
+		}
+		methods[m.ID] = struct{}{}
+		if strings.TrimSpace(m.Type) == "" {
+			return sdkerrors.Wrapf(ErrInvalidDID, "verification method %s without type", m.ID)
+		}
+		if err := ValidateDID(m.Controller); err != nil {
+			return sdkerrors.Wrapf(err, "controller of verification method %s", m.ID)
+		}
+		if _, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeAccPub, m.PubKey); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "verification method %s: %s", m.ID, err)
+		}
+	}
+	for _, a := range d.Authentication {
+		if _, exists := methods[a]; !exists {
+			return sdkerrors.Wrapf(ErrInvalidDID, "unknown authentication method %s", a)
+		}
+	}
+	if len(d.Authentication) == 0 && len(d.Controller) == 0 {
+		return sdkerrors.Wrap(ErrInvalidDID, "neither authentication methods nor controllers")
+	}
+	services := make(map[string]struct{}, len(d.Service))
+	for _, s := range d.Service {
+		if err := d.validateURL(s.ID); err != nil {
+			return sdkerrors.Wrap(err, "service")
+		}
+		if _, exists := services[s.ID]; exists {
+			return sdkerrors.Wrapf(ErrInvalidDID, "duplicate service %s", s.ID)
+		}
+		services[s.ID] = struct{}{}
+		if strings.TrimSpace(s.Type) == "" || strings.TrimSpace(s.ServiceEndpoint) == "" {
+			return sdkerrors.Wrapf(ErrInvalidDID, "service %s without type or endpoint", s.ID)
+		}
+	}
+	return nil
+}
+
+// validateURL validates a DID URL of the document, the DID with a fragment
+func (d DIDDocument) validateURL(url string) error {
+	if !strings.HasPrefix(url, d.ID+"#") || !reFragment.MatchString(url[len(d.ID)+1:]) {
+		return sdkerrors.Wrapf(ErrInvalidDID, "%q is no DID URL of %s with a fragment", url, d.ID)
+	}
+	return nil
+}
+
+// AuthenticationAddresses returns the accounts of the authentication keys of the document
+func (d DIDDocument) AuthenticationAddresses() []sdk.AccAddress {
+	methods := make(map[string]string, len(d.VerificationMethod))
+	for _, m := range d.VerificationMethod {
+		methods[m.ID] = m.PubKey
+	}
+	addrs := make([]sdk.AccAddress, 0, len(d.Authentication))
+	for _, a := range d.Authentication {
+		pubKey, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeAccPub, methods[a])
+		if err != nil {
+			// validated documents have only valid keys
+			continue
+		}
+		addrs = append(addrs, sdk.AccAddress(pubKey.Address()))
+	}
+	return addrs
+}
+
+// IsAuthenticatedBy returns true when the account is the account of an authentication key of the document
+func (d DIDDocument) IsAuthenticatedBy(addr sdk.AccAddress) bool {
+	for _, a := range d.AuthenticationAddresses() {
+		if a.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// DIDRecord is a stored DID document with its resolution metadata
+type DIDRecord struct {
+	Document DIDDocument `json:"document" yaml:"document"`
+	Metadata DIDMetadata `json:"metadata" yaml:"metadata"`
+}
+
+// DIDMetadata are the heights a DID document was created and last updated at, and whether it is deactivated.
+// Deactivated DIDs still resolve, but cannot be changed or created again.
+type DIDMetadata struct {
+	Created     int64 `json:"created" yaml:"created"`
+	Updated     int64 `json:"updated" yaml:"updated"`
+	Deactivated bool  `json:"deactivated,omitempty" yaml:"deactivated"`
+}
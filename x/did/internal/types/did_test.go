@@ -0,0 +1,85 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+func TestValidateDID(t *testing.T) {
+	specs := map[string]struct {
+		src    string
+		expErr bool
+	}{
+		"simple":         {src: "did:fetch:alice"},
+		"colon segments": {src: "did:fetch:agents:bot-1.v2_a"},
+		"other method":   {src: "did:web:alice", expErr: true},
+		"empty id":       {src: "did:fetch:", expErr: true},
+		"empty segment":  {src: "did:fetch:alice::bot", expErr: true},
+		"fragment":       {src: "did:fetch:alice#key-1", expErr: true},
+		"too long":       {src: "did:fetch:" + strings.Repeat("a", MaxDIDLength), expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := ValidateDID(spec.src)
+			if spec.expErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateDocument(t *testing.T) {
+	pubKey, err := sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		mutate func(*DIDDocument)
+		expErr bool
+	}{
+		"valid":                   {mutate: func(*DIDDocument) {}},
+		"controller only":         {mutate: func(d *DIDDocument) { d.Authentication, d.Controller = nil, []string{"did:fetch:bob"} }},
+		"uncontrollable":          {mutate: func(d *DIDDocument) { d.Authentication = nil }, expErr: true},
+		"invalid id":              {mutate: func(d *DIDDocument) { d.ID = "did:fetch" }, expErr: true},
+		"invalid controller":      {mutate: func(d *DIDDocument) { d.Controller = []string{"bob"} }, expErr: true},
+		"method of other DID":     {mutate: func(d *DIDDocument) { d.VerificationMethod[0].ID = "did:fetch:bob#key-1" }, expErr: true},
+		"method without fragment": {mutate: func(d *DIDDocument) { d.VerificationMethod[0].ID = "did:fetch:alice#" }, expErr: true},
+		"duplicate method": {
+			mutate: func(d *DIDDocument) { d.VerificationMethod = append(d.VerificationMethod, d.VerificationMethod[0]) },
+			expErr: true,
+		},
+		"method without type":      {mutate: func(d *DIDDocument) { d.VerificationMethod[0].Type = " " }, expErr: true},
+		"invalid pub key":          {mutate: func(d *DIDDocument) { d.VerificationMethod[0].PubKey = "invalid" }, expErr: true},
+		"unknown authentication":   {mutate: func(d *DIDDocument) { d.Authentication = []string{"did:fetch:alice#key-2"} }, expErr: true},
+		"service without endpoint": {mutate: func(d *DIDDocument) { d.Service[0].ServiceEndpoint = "" }, expErr: true},
+		"duplicate service": {
+			mutate: func(d *DIDDocument) { d.Service = append(d.Service, d.Service[0]) },
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			doc := DIDDocument{
+				ID: "did:fetch:alice",
+				VerificationMethod: []VerificationMethod{
+					{ID: "did:fetch:alice#key-1", Type: "Secp256k1VerificationKey2018", Controller: "did:fetch:alice", PubKey: pubKey},
+				},
+				Authentication: []string{"did:fetch:alice#key-1"},
+				Service:        []Service{{ID: "did:fetch:alice#agent", Type: "Agent", ServiceEndpoint: "https://agent.example.com"}},
+			}
+			spec.mutate(&doc)
+			err := doc.Validate()
+			if spec.expErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package types
+
+import (
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Codes for did errors
+var (
+	DefaultCodespace = ModuleName
+
+	// ErrInvalidDID error for an invalid DID or DID document
+	ErrInvalidDID = sdkErrors.Register(DefaultCodespace, 1, "invalid DID")
+	// ErrDIDExists error for the creation of an existing DID
+	ErrDIDExists = sdkErrors.Register(DefaultCodespace, 2, "DID exists")
+	// ErrDIDNotFound error for a DID that is not created
+	ErrDIDNotFound = sdkErrors.Register(DefaultCodespace, 3, "DID not found")
+	// ErrNotController error for a change of a DID by an account that is no authentication key of its controllers
+	ErrNotController = sdkErrors.Register(DefaultCodespace, 4, "not a controller of the DID")
+	// ErrDeactivated error for a change of a deactivated DID
+	ErrDeactivated = sdkErrors.Register(DefaultCodespace, 5, "DID deactivated")
+	// ErrInvalidRegistry error for an invalid revocation registry
+	ErrInvalidRegistry = sdkErrors.Register(DefaultCodespace, 6, "invalid revocation registry")
+)
@@ -0,0 +1,41 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GenesisState is the genesis state of the did module
+type GenesisState struct {
+	DIDs       []DIDRecord          `json:"dids,omitempty"`
+	Registries []RevocationRegistry `json:"revocation_registries,omitempty"`
+}
+
+// ValidateGenesis performs basic validation of the genesis state. The issuer of every revocation registry must be in
+// the genesis.
+func ValidateGenesis(data GenesisState) error {
+	dids := make(map[string]struct{}, len(data.DIDs))
+	for _, r := range data.DIDs {
+		if err := r.Document.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "DID %s", r.Document.ID)
+		}
+		if _, exists := dids[r.Document.ID]; exists {
+			return sdkerrors.Wrapf(ErrInvalidDID, "duplicate DID %s", r.Document.ID)
+		}
+		dids[r.Document.ID] = struct{}{}
+	}
+	registries := make(map[string]struct{}, len(data.Registries))
+	for _, r := range data.Registries {
+		if err := r.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "revocation registry %s of %s", r.Name, r.Issuer)
+		}
+		if _, exists := dids[r.Issuer]; !exists {
+			return sdkerrors.Wrapf(ErrInvalidRegistry, "issuer %s of %s not in genesis", r.Issuer, r.Name)
+		}
+		key := string(GetRegistryKey(r.Issuer, r.Name))
+		if _, exists := registries[key]; exists {
+			return sdkerrors.Wrapf(ErrInvalidRegistry, "duplicate revocation registry %s of %s", r.Name, r.Issuer)
+		}
+		registries[key] = struct{}{}
+	}
+	return nil
+}
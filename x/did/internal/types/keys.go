@@ -0,0 +1,57 @@
+package types
+
+const (
+	// ModuleName is the name of the did module
+	ModuleName = "did"
+
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for the did module
+	QuerierRoute = ModuleName
+
+	// RouterKey is the msg router key for the did module
+	RouterKey = ModuleName
+)
+
+const ( // event attributes
+	AttributeKeyDID      = "did"
+	AttributeKeyIssuer   = "issuer"
+	AttributeKeyRegistry = "registry"
+	AttributeKeyHash     = "hash"
+)
+
+const (
+	// EventTypeCreateDID is emitted when a DID is created
+	EventTypeCreateDID = "create_did"
+	// EventTypeUpdateDID is emitted when a DID document is replaced
+	EventTypeUpdateDID = "update_did"
+	// EventTypeDeactivateDID is emitted when a DID is deactivated
+	EventTypeDeactivateDID = "deactivate_did"
+	// EventTypeAnchorRegistry is emitted when the hash of a revocation registry is anchored
+	EventTypeAnchorRegistry = "anchor_revocation_registry"
+)
+
+// nolint
+var (
+	DIDPrefix      = []byte{0x01}
+	RegistryPrefix = []byte{0x02}
+)
+
+// GetDIDKey returns the store key of the DID
+func GetDIDKey(did string) []byte {
+	return append(DIDPrefix, []byte(did)...)
+}
+
+// GetRegistryKey returns the store key of the revocation registry of the issuer
+func GetRegistryKey(issuer, name string) []byte {
+	return append(GetIssuerRegistriesPrefix(issuer), []byte(name)...)
+}
+
+// GetIssuerRegistriesPrefix returns the store key prefix of all revocation registries of the issuer. The issuer is
+// length prefixed, so that the prefix of an issuer is not the prefix of another issuer.
+func GetIssuerRegistriesPrefix(issuer string) []byte {
+	key := append([]byte{}, RegistryPrefix...)
+	key = append(key, byte(len(issuer)))
+	return append(key, []byte(issuer)...)
+}
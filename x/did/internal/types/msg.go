@@ -0,0 +1,130 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+)
+
+// MsgCreateDID creates a DID with its document. The signer must control the document.
+type MsgCreateDID struct {
+	Signer   sdk.AccAddress `json:"signer" yaml:"signer"`
+	Document DIDDocument    `json:"document" yaml:"document"`
+}
+
+func (msg MsgCreateDID) Route() string {
+	return RouterKey
+}
+
+func (msg MsgCreateDID) Type() string {
+	return "create-did"
+}
+
+func (msg MsgCreateDID) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Signer); err != nil {
+		return sdkerrors.Wrap(err, "signer")
+	}
+	return msg.Document.Validate()
+}
+
+func (msg MsgCreateDID) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgCreateDID) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// MsgUpdateDID replaces the document of a DID, the signer must control the current document
+type MsgUpdateDID struct {
+	Signer   sdk.AccAddress `json:"signer" yaml:"signer"`
+	Document DIDDocument    `json:"document" yaml:"document"`
+}
+
+func (msg MsgUpdateDID) Route() string {
+	return RouterKey
+}
+
+func (msg MsgUpdateDID) Type() string {
+	return "update-did"
+}
+
+func (msg MsgUpdateDID) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Signer); err != nil {
+		return sdkerrors.Wrap(err, "signer")
+	}
+	return msg.Document.Validate()
+}
+
+func (msg MsgUpdateDID) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgUpdateDID) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// MsgDeactivateDID deactivates a DID for good, the signer must control its document
+type MsgDeactivateDID struct {
+	Signer sdk.AccAddress `json:"signer" yaml:"signer"`
+	DID    string         `json:"did" yaml:"did"`
+}
+
+func (msg MsgDeactivateDID) Route() string {
+	return RouterKey
+}
+
+func (msg MsgDeactivateDID) Type() string {
+	return "deactivate-did"
+}
+
+func (msg MsgDeactivateDID) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Signer); err != nil {
+		return sdkerrors.Wrap(err, "signer")
+	}
+	return ValidateDID(msg.DID)
+}
+
+func (msg MsgDeactivateDID) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgDeactivateDID) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// MsgAnchorRevocationRegistry creates or updates the hash of a revocation registry of the issuer DID, the signer
+// must control the issuer document
+type MsgAnchorRevocationRegistry struct {
+	Signer sdk.AccAddress   `json:"signer" yaml:"signer"`
+	Issuer string           `json:"issuer" yaml:"issuer"`
+	Name   string           `json:"name" yaml:"name"`
+	Hash   tmbytes.HexBytes `json:"hash" yaml:"hash"`
+	URI    string           `json:"uri,omitempty" yaml:"uri"`
+}
+
+func (msg MsgAnchorRevocationRegistry) Route() string {
+	return RouterKey
+}
+
+func (msg MsgAnchorRevocationRegistry) Type() string {
+	return "anchor-revocation-registry"
+}
+
+func (msg MsgAnchorRevocationRegistry) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Signer); err != nil {
+		return sdkerrors.Wrap(err, "signer")
+	}
+	if err := ValidateDID(msg.Issuer); err != nil {
+		return sdkerrors.Wrap(err, "issuer")
+	}
+	return ValidateRegistry(msg.Name, msg.Hash)
+}
+
+func (msg MsgAnchorRevocationRegistry) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgAnchorRevocationRegistry) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
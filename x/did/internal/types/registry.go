@@ -0,0 +1,42 @@
+package types
+
+import (
+	"crypto/sha256"
+	"regexp"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+)
+
+// reRegistryName matches the names of revocation registries, unique per issuer
+var reRegistryName = regexp.MustCompile(`^[a-zA-Z0-9._-]{1,64}$`)
+
+// RevocationRegistry anchors a credential revocation registry of an issuer, e.g. a status list credential that
+// is published at the URI. Verifiers compare the sha256 hash of the fetched registry with the anchored hash.
+type RevocationRegistry struct {
+	Issuer string           `json:"issuer" yaml:"issuer"`
+	Name   string           `json:"name" yaml:"name"`
+	Hash   tmbytes.HexBytes `json:"hash" yaml:"hash"`
+	URI    string           `json:"uri,omitempty" yaml:"uri"`
+	// Updated is the height the hash was last anchored at
+	Updated int64 `json:"updated" yaml:"updated"`
+}
+
+// Validate validates the revocation registry
+func (r RevocationRegistry) Validate() error {
+	if err := ValidateDID(r.Issuer); err != nil {
+		return sdkerrors.Wrap(err, "issuer")
+	}
+	return ValidateRegistry(r.Name, r.Hash)
+}
+
+// ValidateRegistry validates the name and the hash of a revocation registry
+func ValidateRegistry(name string, hash []byte) error {
+	if !reRegistryName.MatchString(name) {
+		return sdkerrors.Wrapf(ErrInvalidRegistry, "invalid name %q", name)
+	}
+	if len(hash) != sha256.Size {
+		return sdkerrors.Wrapf(ErrInvalidRegistry, "hash must be %d bytes", sha256.Size)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package feemarket
+
+import (
+	"github.com/fetchai/fetchd/x/feemarket/internal/keeper"
+	"github.com/fetchai/fetchd/x/feemarket/internal/types"
+)
+
+const (
+	ModuleName           = types.ModuleName
+	StoreKey             = types.StoreKey
+	TStoreKey            = types.TStoreKey
+	QuerierRoute         = types.QuerierRoute
+	DefaultParamspace    = types.DefaultParamspace
+	AttributeKeyBaseFee  = types.AttributeKeyBaseFee
+	AttributeKeyGasUsed  = types.AttributeKeyGasUsed
+	EventTypeBaseFee     = types.EventTypeBaseFee
+	AttributeKeyFeePayer = types.AttributeKeyFeePayer
+	EventTypeGasRefund   = types.EventTypeGasRefund
+	QueryParams          = keeper.QueryParams
+	QueryBaseFee         = keeper.QueryBaseFee
+)
+
+var (
+	// functions aliases
+	RegisterCodec       = types.RegisterCodec
+	ValidateGenesis     = types.ValidateGenesis
+	DefaultGenesisState = types.DefaultGenesisState
+	DefaultParams       = types.DefaultParams
+	NextBaseFee         = types.NextBaseFee
+	RequiredFee         = types.RequiredFee
+	RefundAmount        = types.RefundAmount
+	NewKeeper           = keeper.NewKeeper
+	NewQuerier          = keeper.NewQuerier
+	InitGenesis         = keeper.InitGenesis
+	ExportGenesis       = keeper.ExportGenesis
+
+	// variable aliases
+	ModuleCdc = types.ModuleCdc
+)
+
+type (
+	Keeper       = keeper.Keeper
+	GenesisState = types.GenesisState
+	Params       = types.Params
+	FeeDenom     = types.FeeDenom
+	GasRefund    = types.GasRefund
+)
@@ -0,0 +1,105 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fetchai/fetchd/x/feemarket/internal/keeper"
+	"github.com/fetchai/fetchd/x/feemarket/internal/types"
+)
+
+// GetQueryCmd returns the query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the fee market",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(flags.GetCommands(
+		GetCmdQueryParams(cdc),
+		GetCmdQueryBaseFee(cdc),
+	)...)
+	return queryCmd
+}
+
+// GetCmdQueryParams shows the feemarket params
+func GetCmdQueryParams(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "Show the denom, the min base fee, the target block gas and the max change rate of the base fee",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			params, err := queryParams(cliCtx)
+			if err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
+
+// GetCmdQueryBaseFee shows the base fee
+func GetCmdQueryBaseFee(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "base-fee",
+		Short: "Show the base fee per gas unit, the min gas price of the txs of the next block",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			baseFee, err := queryBaseFee(cliCtx)
+			if err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(baseFee)
+		},
+	}
+}
+
+// QueryAutoGasPrice returns the gas price for --gas-prices auto: the base fee raised by the max change rate, so that
+// the tx is still accepted when the base fee grows before it is included. It is zero while the fee market is disabled.
+func QueryAutoGasPrice(cliCtx context.CLIContext) (sdk.DecCoin, error) {
+	params, err := queryParams(cliCtx)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+	baseFee, err := queryBaseFee(cliCtx)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+	return sdk.NewDecCoinFromDec(baseFee.Denom, baseFee.Amount.Mul(sdk.OneDec().Add(params.MaxChangeRate))), nil
+}
+
+func queryParams(cliCtx context.CLIContext) (types.Params, error) {
+	res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, keeper.QueryParams))
+	if err != nil {
+		return types.Params{}, err
+	}
+	var params types.Params
+	if err := json.Unmarshal(res, &params); err != nil {
+		return types.Params{}, err
+	}
+	return params, nil
+}
+
+func queryBaseFee(cliCtx context.CLIContext) (sdk.DecCoin, error) {
+	res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, keeper.QueryBaseFee))
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+	var baseFee sdk.DecCoin
+	if err := json.Unmarshal(res, &baseFee); err != nil {
+		return sdk.DecCoin{}, err
+	}
+	return baseFee, nil
+}
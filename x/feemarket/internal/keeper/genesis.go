@@ -0,0 +1,20 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fetchai/fetchd/x/feemarket/internal/types"
+)
+
+// InitGenesis stores the params and the base fee of the genesis and records the prices of the fee denoms by their
+// pools, so the liquidity genesis has to be initialized first
+func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
+	keeper.setParams(ctx, data.Params)
+	keeper.setBaseFee(ctx, data.BaseFee)
+	keeper.setPrices(ctx)
+}
+
+// ExportGenesis returns the params and the base fee as genesis state
+func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
+	return types.GenesisState{Params: keeper.GetParams(ctx), BaseFee: keeper.GetBaseFee(ctx)}
+}
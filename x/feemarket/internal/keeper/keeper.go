@@ -0,0 +1,191 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/params"
+
+	"github.com/fetchai/fetchd/x/feemarket/internal/types"
+)
+
+// Keeper keeps the network-wide base fee per gas unit, which follows the gas used by the blocks
+type Keeper struct {
+	storeKey   sdk.StoreKey
+	tStoreKey  sdk.StoreKey
+	cdc        *codec.Codec
+	paramSpace params.Subspace
+
+	liquidityKeeper types.LiquidityKeeper
+	supplyKeeper    types.SupplyKeeper
+}
+
+// NewKeeper creates a new feemarket Keeper instance
+func NewKeeper(cdc *codec.Codec, storeKey, tStoreKey sdk.StoreKey, paramSpace params.Subspace, liquidityKeeper types.LiquidityKeeper, supplyKeeper types.SupplyKeeper) Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+	return Keeper{
+		storeKey:   storeKey,
+		tStoreKey:  tStoreKey,
+		cdc:        cdc,
+		paramSpace: paramSpace,
+
+		liquidityKeeper: liquidityKeeper,
+		supplyKeeper:    supplyKeeper,
+	}
+}
+
+// GetParams returns the total set of feemarket parameters.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	var params types.Params
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+func (k Keeper) setParams(ctx sdk.Context, ps types.Params) {
+	k.paramSpace.SetParamSet(ctx, &ps)
+}
+
+// GetBaseFee returns the base fee per gas unit of the current block, 0 when the fee market is disabled
+func (k Keeper) GetBaseFee(ctx sdk.Context) sdk.Dec {
+	bz := ctx.KVStore(k.storeKey).Get(types.BaseFeeKey)
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+	var baseFee sdk.Dec
+	k.cdc.MustUnmarshalBinaryBare(bz, &baseFee)
+	return baseFee
+}
+
+func (k Keeper) setBaseFee(ctx sdk.Context, baseFee sdk.Dec) {
+	ctx.KVStore(k.storeKey).Set(types.BaseFeeKey, k.cdc.MustMarshalBinaryBare(baseFee))
+}
+
+// GetGasPrice returns the base fee as gas price in the denom of the params
+func (k Keeper) GetGasPrice(ctx sdk.Context) sdk.DecCoin {
+	return sdk.NewDecCoinFromDec(k.GetParams(ctx).Denom, k.GetBaseFee(ctx))
+}
+
+// RequiredFee returns the min fee of a tx with the gas limit at the base fee, nothing when the fee market is disabled
+func (k Keeper) RequiredFee(ctx sdk.Context, gas uint64) sdk.Coins {
+	return types.RequiredFee(k.GetBaseFee(ctx), k.GetParams(ctx).Denom, gas)
+}
+
+// FeeValue returns the value of the fee in the denom of the params: its amount of the denom plus its amounts of the
+// other fee denoms at the prices of their pools recorded at the end of the previous block, so swaps within the block
+// can not raise it. Other denoms and fee denoms without a price add nothing.
+func (k Keeper) FeeValue(ctx sdk.Context, fee sdk.Coins) sdk.Int {
+	params := k.GetParams(ctx)
+	value := fee.AmountOf(params.Denom)
+	for _, c := range fee {
+		if _, ok := params.FeeDenom(c.Denom); !ok {
+			continue
+		}
+		price, ok := k.GetPrice(ctx, c.Denom)
+		if !ok {
+			continue
+		}
+		value = value.Add(price.MulInt(c.Amount).TruncateInt())
+	}
+	return value
+}
+
+// GetPrice returns the price of the fee denom in the denom of the params recorded by setPrices, false if none
+func (k Keeper) GetPrice(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetPriceKey(denom))
+	if bz == nil {
+		return sdk.ZeroDec(), false
+	}
+	var price sdk.Dec
+	k.cdc.MustUnmarshalBinaryBare(bz, &price)
+	return price, true
+}
+
+// setPrices records the prices of the fee denoms by the current reserves of their pools. The prices of fee denoms
+// whose pools hold less than the min pool reserve and of denoms that are no fee denoms anymore are removed.
+func (k Keeper) setPrices(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PriceKeyPrefix)
+	iter := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	params := k.GetParams(ctx)
+	for _, d := range params.FeeDenoms {
+		if price, ok := types.PoolPrice(k.liquidityKeeper.GetPoolReserves(ctx, d.PoolID), d.Denom, params); ok {
+			ctx.KVStore(k.storeKey).Set(types.GetPriceKey(d.Denom), k.cdc.MustMarshalBinaryBare(price))
+		}
+	}
+}
+
+// SetPaidFee records the fee the fee payer paid for the tx with the hash, after any subsidy and without the developer
+// fees that left the fee collector. The record lives in the transient store for the block in delivery, the gas refund
+// of the tx is a share of it.
+func (k Keeper) SetPaidFee(ctx sdk.Context, txHash []byte, fee sdk.Coins) {
+	ctx.TransientStore(k.tStoreKey).Set(types.GetPaidFeeKey(txHash), k.cdc.MustMarshalBinaryBare(fee))
+}
+
+// GetPaidFee returns the fee recorded by SetPaidFee for the tx with the hash in the current block, nothing if none
+func (k Keeper) GetPaidFee(ctx sdk.Context, txHash []byte) sdk.Coins {
+	bz := ctx.TransientStore(k.tStoreKey).Get(types.GetPaidFeeKey(txHash))
+	if bz == nil {
+		return nil
+	}
+	var fee sdk.Coins
+	k.cdc.MustUnmarshalBinaryBare(bz, &fee)
+	return fee
+}
+
+// RefundGas pays the refunds for the unused gas of the txs of the block out of the fee collector. A refund the fee
+// collector can not cover is skipped.
+func (k Keeper) RefundGas(ctx sdk.Context, refunds []types.GasRefund) {
+	params := k.GetParams(ctx)
+	if !params.GasRefundRate.IsPositive() {
+		return
+	}
+	for _, r := range refunds {
+		amount := types.RefundAmount(r, params)
+		if amount.IsZero() {
+			continue
+		}
+		if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, auth.FeeCollectorName, r.FeePayer, amount); err != nil {
+			continue
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeGasRefund,
+			sdk.NewAttribute(types.AttributeKeyFeePayer, r.FeePayer.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		))
+	}
+}
+
+// EndBlocker records the prices of the fee denoms for the next block and sets its base fee from the gas used by the
+// block
+func (k Keeper) EndBlocker(ctx sdk.Context) {
+	k.setPrices(ctx)
+
+	var gasUsed uint64
+	if meter := ctx.BlockGasMeter(); meter != nil {
+		gasUsed = meter.GasConsumed()
+	}
+	baseFee := k.GetBaseFee(ctx)
+	next := types.NextBaseFee(baseFee, gasUsed, k.GetParams(ctx))
+	if next.Equal(baseFee) {
+		return
+	}
+	k.setBaseFee(ctx, next)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBaseFee,
+		sdk.NewAttribute(types.AttributeKeyBaseFee, next.String()),
+		sdk.NewAttribute(types.AttributeKeyGasUsed, strconv.FormatUint(gasUsed, 10)),
+	))
+}
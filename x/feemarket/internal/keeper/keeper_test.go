@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/fetchai/fetchd/testutil"
+	"github.com/fetchai/fetchd/x/feemarket/internal/types"
+)
+
+func setupKeeper(t *testing.T, data types.GenesisState) (sdk.Context, Keeper) {
+	ctx, k, _ := setupKeeperWithMocks(t, data, mockLiquidityKeeper{})
+	return ctx, k
+}
+
+func setupKeeperWithMocks(t *testing.T, data types.GenesisState, pools mockLiquidityKeeper) (sdk.Context, Keeper, *testutil.SupplyKeeper) {
+	cdc := codec.New()
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	tkey := sdk.NewTransientStoreKey(types.TStoreKey)
+	ctx, paramsKeeper := testutil.NewContext(t, cdc, abci.Header{Height: 10}, key, tkey)
+	sk := testutil.NewSupplyKeeper(map[string]sdk.Coins{testutil.ModuleKey(auth.FeeCollectorName): sdk.NewCoins(sdk.NewInt64Coin("afet", 1000))})
+	k := NewKeeper(cdc, key, tkey, paramsKeeper.Subspace(types.DefaultParamspace), pools, sk)
+	InitGenesis(ctx, k, data)
+	return ctx, k, sk
+}
+
+func genesis(minBaseFee, baseFee sdk.Dec) types.GenesisState {
+	p := types.DefaultParams()
+	p.MinBaseFee, p.TargetBlockGas = minBaseFee, 1000
+	return types.GenesisState{Params: p, BaseFee: baseFee}
+}
+
+func TestEndBlocker(t *testing.T) {
+	specs := map[string]struct {
+		genesis  types.GenesisState
+		gasUsed  uint64
+		exp      sdk.Dec
+		expEvent bool
+	}{
+		"full block":      {genesis: genesis(sdk.NewDec(10), sdk.NewDec(100)), gasUsed: 2000, exp: sdk.NewDecWithPrec(1125, 1), expEvent: true},
+		"empty block":     {genesis: genesis(sdk.NewDec(10), sdk.NewDec(100)), exp: sdk.NewDecWithPrec(875, 1), expEvent: true},
+		"at target":       {genesis: genesis(sdk.NewDec(10), sdk.NewDec(100)), gasUsed: 1000, exp: sdk.NewDec(100)},
+		"raised min":      {genesis: genesis(sdk.NewDec(10), sdk.ZeroDec()), gasUsed: 1000, exp: sdk.NewDec(10), expEvent: true},
+		"at min":          {genesis: genesis(sdk.NewDec(10), sdk.NewDec(10)), exp: sdk.NewDec(10)},
+		"disabled market": {genesis: types.DefaultGenesisState(), gasUsed: 100000000, exp: sdk.ZeroDec()},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, k := setupKeeper(t, spec.genesis)
+			meter := sdk.NewInfiniteGasMeter()
+			meter.ConsumeGas(spec.gasUsed, "test")
+			ctx = ctx.WithBlockGasMeter(meter).WithEventManager(sdk.NewEventManager())
+
+			k.EndBlocker(ctx)
+			assert.Equal(t, spec.exp.String(), k.GetBaseFee(ctx).String())
+			assert.Equal(t, spec.expEvent, len(ctx.EventManager().Events()) == 1)
+			assert.Equal(t, spec.exp.String(), ExportGenesis(ctx, k).BaseFee.String())
+		})
+	}
+}
+
+func TestRequiredFeeAndQuerier(t *testing.T) {
+	ctx, k := setupKeeper(t, genesis(sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(25, 3)))
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("afet", 5000)), k.RequiredFee(ctx, 200000))
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("afet", 1)), k.RequiredFee(ctx, 1))
+
+	res, err := NewQuerier(k)(ctx, []string{QueryBaseFee}, abci.RequestQuery{})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"denom":"afet","amount":"0.025000000000000000"}`, string(res))
+	_, err = NewQuerier(k)(ctx, []string{"other"}, abci.RequestQuery{})
+	assert.Error(t, err)
+
+	ctx, k = setupKeeper(t, types.DefaultGenesisState())
+	assert.True(t, k.RequiredFee(ctx, 200000).IsZero())
+}
+
+func TestFeeValue(t *testing.T) {
+	data := genesis(sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(1, 2))
+	data.Params.FeeDenoms = []types.FeeDenom{{Denom: "uusdc", PoolID: 1}, {Denom: "ueth", PoolID: 2}, {Denom: "uatom", PoolID: 3}, {Denom: "uosmo", PoolID: 4}}
+	data.Params.MinPoolReserve = sdk.NewInt(1000)
+	coins := func(s string) sdk.Coins {
+		c, err := sdk.ParseCoins(s)
+		require.NoError(t, err)
+		return c
+	}
+	pools := mockLiquidityKeeper{
+		1: coins("2000afet,1000uusdc"),
+		2: coins("1000ueth,1000ustake"),
+		3: coins("500afet,1000uatom"),
+	}
+	ctx, k, _ := setupKeeperWithMocks(t, data, pools)
+	specs := map[string]struct {
+		fee sdk.Coins
+		exp int64
+	}{
+		"denom":               {fee: coins("100afet"), exp: 100},
+		"fee denom":           {fee: coins("100uusdc"), exp: 200},
+		"denom and fee denom": {fee: coins("100afet,100uusdc"), exp: 300},
+		"not allowed":         {fee: coins("100afet,100ustake"), exp: 100},
+		"pool of other pair":  {fee: coins("100ueth"), exp: 0},
+		"below min reserve":   {fee: coins("100uatom"), exp: 0},
+		"no pool":             {fee: coins("100uosmo"), exp: 0},
+		"no fee":              {exp: 0},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			assert.Equal(t, sdk.NewInt(spec.exp).String(), k.FeeValue(ctx, spec.fee).String())
+		})
+	}
+
+	// a swap within the block does not change the value until the end of the block
+	pools[1] = coins("4000afet,500uusdc")
+	assert.Equal(t, "200", k.FeeValue(ctx, coins("100uusdc")).String())
+	k.EndBlocker(ctx)
+	assert.Equal(t, "800", k.FeeValue(ctx, coins("100uusdc")).String())
+
+	// the price is removed when the pool drops below the min reserve
+	pools[1] = coins("999afet,500uusdc")
+	k.EndBlocker(ctx)
+	assert.True(t, k.FeeValue(ctx, coins("100uusdc")).IsZero())
+}
+
+func TestRefundGas(t *testing.T) {
+	var (
+		alice = sdk.AccAddress([]byte("alice_______________"))
+		bob   = sdk.AccAddress([]byte("bob_________________"))
+		afet  = func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("afet", amount)) }
+	)
+	data := genesis(sdk.ZeroDec(), sdk.ZeroDec())
+	data.Params.GasRefundThreshold = sdk.NewDecWithPrec(1, 1)
+	data.Params.GasRefundRate = sdk.NewDecWithPrec(5, 1)
+	ctx, k, sk := setupKeeperWithMocks(t, data, mockLiquidityKeeper{})
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+	k.RefundGas(ctx, []types.GasRefund{
+		// 60 of the 100 gas unused, 50 beyond the threshold of which half is refunded
+		{FeePayer: alice, Fee: afet(1000), GasWanted: 100, GasUsed: 40},
+		// exceeds the balance of the fee collector
+		{FeePayer: bob, Fee: afet(100000), GasWanted: 100, GasUsed: 0},
+		// within the threshold
+		{FeePayer: bob, Fee: afet(1000), GasWanted: 100, GasUsed: 95},
+	})
+	assert.Equal(t, afet(250).String(), sk.Balances[alice.String()].String())
+	assert.True(t, sk.Balances[bob.String()].IsZero())
+	assert.Equal(t, afet(750).String(), sk.Balances[testutil.ModuleKey(auth.FeeCollectorName)].String())
+	assert.Len(t, ctx.EventManager().Events(), 1)
+
+	// disabled by the default params
+	ctx, k, sk = setupKeeperWithMocks(t, types.DefaultGenesisState(), mockLiquidityKeeper{})
+	k.RefundGas(ctx, []types.GasRefund{{FeePayer: alice, Fee: afet(1000), GasWanted: 100, GasUsed: 0}})
+	assert.True(t, sk.Balances[alice.String()].IsZero())
+}
+
+func TestPaidFee(t *testing.T) {
+	ctx, k := setupKeeper(t, types.DefaultGenesisState())
+	fee := sdk.NewCoins(sdk.NewInt64Coin("afet", 600))
+
+	k.SetPaidFee(ctx, []byte("tx1"), fee)
+	assert.Equal(t, fee.String(), k.GetPaidFee(ctx, []byte("tx1")).String())
+	assert.Nil(t, k.GetPaidFee(ctx, []byte("tx2")))
+}
+
+// mockLiquidityKeeper returns the reserves of the pools by id
+type mockLiquidityKeeper map[uint64]sdk.Coins
+
+func (m mockLiquidityKeeper) GetPoolReserves(_ sdk.Context, id uint64) sdk.Coins {
+	return m[id]
+}
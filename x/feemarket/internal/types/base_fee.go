@@ -0,0 +1,73 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NextBaseFee returns the base fee of the block after a block that used the gas, like EIP-1559: it grows with the
+// gas used above the target block gas and shrinks with the gas below, by at most the max change rate, and never
+// drops below the min base fee
+func NextBaseFee(baseFee sdk.Dec, gasUsed uint64, params Params) sdk.Dec {
+	target := params.TargetBlockGas
+	if gasUsed > 2*target {
+		gasUsed = 2 * target
+	}
+	var next sdk.Dec
+	if gasUsed >= target {
+		delta := sdk.NewDecFromInt(sdk.NewIntFromUint64(gasUsed - target)).QuoInt(sdk.NewIntFromUint64(target))
+		next = baseFee.Add(baseFee.Mul(params.MaxChangeRate).Mul(delta))
+	} else {
+		delta := sdk.NewDecFromInt(sdk.NewIntFromUint64(target - gasUsed)).QuoInt(sdk.NewIntFromUint64(target))
+		next = baseFee.Sub(baseFee.Mul(params.MaxChangeRate).Mul(delta))
+	}
+	if next.LT(params.MinBaseFee) {
+		return params.MinBaseFee
+	}
+	return next
+}
+
+// RequiredFee returns the fee of the gas limit at the base fee, rounded up
+func RequiredFee(baseFee sdk.Dec, denom string, gas uint64) sdk.Coins {
+	amount := baseFee.MulInt(sdk.NewIntFromUint64(gas)).Ceil().TruncateInt()
+	return sdk.NewCoins(sdk.NewCoin(denom, amount))
+}
+
+// PoolPrice returns the price of the fee denom in the denom of the params by the reserves of its pool, false when the
+// pool holds none of the fee denom or less than the min pool reserve of the denom
+func PoolPrice(reserves sdk.Coins, feeDenom string, params Params) (sdk.Dec, bool) {
+	reserve, feeReserve := reserves.AmountOf(params.Denom), reserves.AmountOf(feeDenom)
+	if !reserve.IsPositive() || reserve.LT(params.MinPoolReserve) || !feeReserve.IsPositive() {
+		return sdk.ZeroDec(), false
+	}
+	return reserve.ToDec().QuoInt(feeReserve), true
+}
+
+// GasRefund is the fee a fee payer paid for a tx and the gas it used of its gas limit
+type GasRefund struct {
+	FeePayer  sdk.AccAddress
+	Fee       sdk.Coins
+	GasWanted uint64
+	GasUsed   uint64
+}
+
+// RefundAmount returns the share of the fee that is refunded for the unused gas beyond the threshold share of the
+// gas limit, rounded down
+func RefundAmount(r GasRefund, params Params) sdk.Coins {
+	if r.GasWanted == 0 || r.GasUsed >= r.GasWanted || !params.GasRefundRate.IsPositive() {
+		return nil
+	}
+	wanted := sdk.NewDecFromInt(sdk.NewIntFromUint64(r.GasWanted))
+	unused := sdk.NewDecFromInt(sdk.NewIntFromUint64(r.GasWanted - r.GasUsed))
+	refundGas := unused.Sub(wanted.Mul(params.GasRefundThreshold))
+	if !refundGas.IsPositive() {
+		return nil
+	}
+	share := refundGas.Mul(params.GasRefundRate).Quo(wanted)
+	var refund sdk.Coins
+	for _, c := range r.Fee {
+		if amount := share.MulInt(c.Amount).TruncateInt(); amount.IsPositive() {
+			refund = append(refund, sdk.NewCoin(c.Denom, amount))
+		}
+	}
+	return refund
+}
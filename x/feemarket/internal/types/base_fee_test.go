@@ -0,0 +1,140 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNextBaseFee(t *testing.T) {
+	params := Params{Denom: "afet", MinBaseFee: sdk.NewDec(10), TargetBlockGas: 1000, MaxChangeRate: sdk.NewDecWithPrec(125, 3)}
+	specs := map[string]struct {
+		baseFee sdk.Dec
+		gasUsed uint64
+		exp     sdk.Dec
+	}{
+		"at target":              {baseFee: sdk.NewDec(100), gasUsed: 1000, exp: sdk.NewDec(100)},
+		"full block":             {baseFee: sdk.NewDec(100), gasUsed: 2000, exp: sdk.NewDecWithPrec(1125, 1)},
+		"exceeds twice target":   {baseFee: sdk.NewDec(100), gasUsed: 5000, exp: sdk.NewDecWithPrec(1125, 1)},
+		"half above target":      {baseFee: sdk.NewDec(100), gasUsed: 1500, exp: sdk.NewDecWithPrec(10625, 2)},
+		"empty block":            {baseFee: sdk.NewDec(100), gasUsed: 0, exp: sdk.NewDecWithPrec(875, 1)},
+		"down to min":            {baseFee: sdk.NewDec(11), gasUsed: 0, exp: sdk.NewDec(10)},
+		"below min":              {baseFee: sdk.ZeroDec(), gasUsed: 1000, exp: sdk.NewDec(10)},
+		"above min after change": {baseFee: sdk.NewDec(12), gasUsed: 0, exp: sdk.NewDecWithPrec(105, 1)},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			assert.Equal(t, spec.exp.String(), NextBaseFee(spec.baseFee, spec.gasUsed, params).String())
+		})
+	}
+	// a base fee of 0 never grows without a min base fee
+	params.MinBaseFee = sdk.ZeroDec()
+	assert.True(t, NextBaseFee(sdk.ZeroDec(), 2000, params).IsZero())
+}
+
+func TestRequiredFee(t *testing.T) {
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("afet", 2000)), RequiredFee(sdk.NewDecWithPrec(1, 2), "afet", 200000))
+	// rounded up
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("afet", 2)), RequiredFee(sdk.NewDecWithPrec(1, 2), "afet", 101))
+	assert.True(t, RequiredFee(sdk.ZeroDec(), "afet", 200000).IsZero())
+}
+
+func TestPoolPrice(t *testing.T) {
+	params := DefaultParams()
+	params.MinPoolReserve = sdk.NewInt(1000)
+	reserves := func(afet int64, other string, amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin("afet", afet), sdk.NewInt64Coin(other, amount))
+	}
+	specs := map[string]struct {
+		reserves sdk.Coins
+		exp      sdk.Dec
+		expOK    bool
+	}{
+		"price":             {reserves: reserves(2000, "uusdc", 1000), exp: sdk.NewDec(2), expOK: true},
+		"fraction":          {reserves: reserves(1000, "uusdc", 4000), exp: sdk.NewDecWithPrec(25, 2), expOK: true},
+		"at min reserve":    {reserves: reserves(1000, "uusdc", 1000), exp: sdk.OneDec(), expOK: true},
+		"below min reserve": {reserves: reserves(999, "uusdc", 1000)},
+		"other pair":        {reserves: reserves(1000, "ueth", 1000)},
+		"no pool":           {},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			price, ok := PoolPrice(spec.reserves, "uusdc", params)
+			assert.Equal(t, spec.expOK, ok)
+			if spec.expOK {
+				assert.Equal(t, spec.exp.String(), price.String())
+			}
+		})
+	}
+}
+
+func TestRefundAmount(t *testing.T) {
+	params := DefaultParams()
+	params.GasRefundRate = sdk.NewDecWithPrec(5, 1)
+	fee := sdk.NewCoins(sdk.NewInt64Coin("afet", 1000), sdk.NewInt64Coin("uusdc", 3))
+	specs := map[string]struct {
+		gasWanted, gasUsed uint64
+		exp                sdk.Coins
+	}{
+		"half unused":      {gasWanted: 1000, gasUsed: 500, exp: sdk.NewCoins(sdk.NewInt64Coin("afet", 200))},
+		"all unused":       {gasWanted: 1000, gasUsed: 0, exp: sdk.NewCoins(sdk.NewInt64Coin("afet", 450), sdk.NewInt64Coin("uusdc", 1))},
+		"within threshold": {gasWanted: 1000, gasUsed: 900},
+		"all used":         {gasWanted: 1000, gasUsed: 1000},
+		"no gas limit":     {},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got := RefundAmount(GasRefund{Fee: fee, GasWanted: spec.gasWanted, GasUsed: spec.gasUsed}, params)
+			assert.Equal(t, spec.exp.String(), got.String())
+		})
+	}
+	params.GasRefundRate = sdk.ZeroDec()
+	assert.True(t, RefundAmount(GasRefund{Fee: fee, GasWanted: 1000}, params).IsZero())
+}
+
+func TestValidateGenesis(t *testing.T) {
+	specs := map[string]struct {
+		mutate func(*GenesisState)
+		expErr bool
+	}{
+		"default":            {mutate: func(*GenesisState) {}},
+		"base fee":           {mutate: func(g *GenesisState) { g.BaseFee = sdk.NewDecWithPrec(5, 1) }},
+		"negative base fee":  {mutate: func(g *GenesisState) { g.BaseFee = sdk.NewDec(-1) }, expErr: true},
+		"negative min":       {mutate: func(g *GenesisState) { g.Params.MinBaseFee = sdk.NewDec(-1) }, expErr: true},
+		"zero target":        {mutate: func(g *GenesisState) { g.Params.TargetBlockGas = 0 }, expErr: true},
+		"zero change rate":   {mutate: func(g *GenesisState) { g.Params.MaxChangeRate = sdk.ZeroDec() }, expErr: true},
+		"change rate of one": {mutate: func(g *GenesisState) { g.Params.MaxChangeRate = sdk.OneDec() }, expErr: true},
+		"invalid denom":      {mutate: func(g *GenesisState) { g.Params.Denom = "A" }, expErr: true},
+		"nil base fee":       {mutate: func(g *GenesisState) { g.BaseFee = sdk.Dec{} }, expErr: true},
+		"fee denom":          {mutate: func(g *GenesisState) { g.Params.FeeDenoms = []FeeDenom{{Denom: "uusdc", PoolID: 1}} }},
+		"fee denom of denom": {mutate: func(g *GenesisState) { g.Params.FeeDenoms = []FeeDenom{{Denom: "afet", PoolID: 1}} }, expErr: true},
+		"fee denom no pool":  {mutate: func(g *GenesisState) { g.Params.FeeDenoms = []FeeDenom{{Denom: "uusdc"}} }, expErr: true},
+		"duplicate fee denom": {
+			mutate: func(g *GenesisState) {
+				g.Params.FeeDenoms = []FeeDenom{{Denom: "uusdc", PoolID: 1}, {Denom: "uusdc", PoolID: 2}}
+			},
+			expErr: true,
+		},
+		"refund rate":         {mutate: func(g *GenesisState) { g.Params.GasRefundRate = sdk.OneDec() }},
+		"refund rate above 1": {mutate: func(g *GenesisState) { g.Params.GasRefundRate = sdk.NewDec(2) }, expErr: true},
+		"negative threshold":  {mutate: func(g *GenesisState) { g.Params.GasRefundThreshold = sdk.NewDec(-1) }, expErr: true},
+		"no min pool reserve": {mutate: func(g *GenesisState) { g.Params.MinPoolReserve = sdk.ZeroInt() }},
+		"negative min pool reserve": {
+			mutate: func(g *GenesisState) { g.Params.MinPoolReserve = sdk.NewInt(-1) },
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			g := DefaultGenesisState()
+			spec.mutate(&g)
+			err := ValidateGenesis(g)
+			if spec.expErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// LiquidityKeeper prices the fees in the other fee denoms by the reserves of the liquidity pools
+type LiquidityKeeper interface {
+	GetPoolReserves(ctx sdk.Context, id uint64) sdk.Coins
+}
+
+// SupplyKeeper pays the gas refunds out of the fee collector
+type SupplyKeeper interface {
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
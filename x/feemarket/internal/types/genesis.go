@@ -0,0 +1,29 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GenesisState is the genesis state of the feemarket module
+type GenesisState struct {
+	Params Params `json:"params"`
+	// BaseFee is the base fee per gas unit of the first block
+	BaseFee sdk.Dec `json:"base_fee"`
+}
+
+// DefaultGenesisState returns the genesis state of a disabled fee market
+func DefaultGenesisState() GenesisState {
+	return GenesisState{Params: DefaultParams(), BaseFee: sdk.ZeroDec()}
+}
+
+// ValidateGenesis performs basic validation of the genesis state
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "params")
+	}
+	if data.BaseFee.IsNil() || data.BaseFee.IsNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "base fee must not be negative")
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package types
+
+const (
+	// ModuleName is the name of the feemarket module
+	ModuleName = "feemarket"
+
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+
+	// TStoreKey is the string transient store representation
+	TStoreKey = "transient_" + ModuleName
+
+	// QuerierRoute is the querier route for the feemarket module
+	QuerierRoute = ModuleName
+)
+
+const ( // event attributes
+	AttributeKeyBaseFee  = "base_fee"
+	AttributeKeyGasUsed  = "gas_used"
+	AttributeKeyFeePayer = "fee_payer"
+)
+
+const (
+	// EventTypeBaseFee is emitted when the base fee changes at the end of a block
+	EventTypeBaseFee = "base_fee"
+	// EventTypeGasRefund is emitted when a share of the fee of a tx is refunded for its unused gas
+	EventTypeGasRefund = "gas_refund"
+)
+
+// nolint
+var (
+	BaseFeeKey       = []byte{0x01}
+	PaidFeeKeyPrefix = []byte{0x02}
+	PriceKeyPrefix   = []byte{0x03}
+)
+
+// GetPaidFeeKey returns the transient store key of the fee paid by the tx with the hash
+func GetPaidFeeKey(txHash []byte) []byte {
+	return append(PaidFeeKeyPrefix, txHash...)
+}
+
+// GetPriceKey returns the store key of the price of the fee denom
+func GetPriceKey(denom string) []byte {
+	return append(PriceKeyPrefix, []byte(denom)...)
+}
@@ -0,0 +1,220 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// DefaultParamspace for params keeper
+	DefaultParamspace = ModuleName
+)
+
+var ParamStoreKeyDenom = []byte("denom")
+var ParamStoreKeyMinBaseFee = []byte("minBaseFee")
+var ParamStoreKeyTargetBlockGas = []byte("targetBlockGas")
+var ParamStoreKeyMaxChangeRate = []byte("maxChangeRate")
+var ParamStoreKeyFeeDenoms = []byte("feeDenoms")
+var ParamStoreKeyGasRefundThreshold = []byte("gasRefundThreshold")
+var ParamStoreKeyGasRefundRate = []byte("gasRefundRate")
+var ParamStoreKeyMinPoolReserve = []byte("minPoolReserve")
+
+// FeeDenom is a denom fees can be paid in besides the denom of the params. A fee in it is worth its amount at the
+// price of the liquidity pool of the pair with the denom of the params at the end of the previous block.
+type FeeDenom struct {
+	Denom  string `json:"denom" yaml:"denom"`
+	PoolID uint64 `json:"pool_id" yaml:"pool_id"`
+}
+
+// Params defines the set of feemarket parameters. They are changed by param change proposals.
+type Params struct {
+	// Denom is the denom the base fee is paid in
+	Denom string `json:"denom" yaml:"denom"`
+	// MinBaseFee is the lowest base fee per gas unit, the fee market is disabled while it and the base fee are 0
+	MinBaseFee sdk.Dec `json:"min_base_fee" yaml:"min_base_fee"`
+	// TargetBlockGas is the gas used by a block that keeps the base fee, blocks using more raise it and blocks using
+	// less lower it
+	TargetBlockGas uint64 `json:"target_block_gas" yaml:"target_block_gas"`
+	// MaxChangeRate is the max change of the base fee from one block to the next, reached by blocks using none or
+	// twice the target block gas
+	MaxChangeRate sdk.Dec `json:"max_change_rate" yaml:"max_change_rate"`
+	// FeeDenoms are the other denoms the base fee can be paid in
+	FeeDenoms []FeeDenom `json:"fee_denoms" yaml:"fee_denoms"`
+	// GasRefundThreshold is the share of the gas limit of a tx that is never refunded when it is unused
+	GasRefundThreshold sdk.Dec `json:"gas_refund_threshold" yaml:"gas_refund_threshold"`
+	// GasRefundRate is the share of the unused gas beyond the threshold whose fee is refunded to the fee payer,
+	// 0 disables the refunds
+	GasRefundRate sdk.Dec `json:"gas_refund_rate" yaml:"gas_refund_rate"`
+	// MinPoolReserve is the reserve of the denom the pool of a fee denom must hold for fees in it to be worth anything
+	MinPoolReserve sdk.Int `json:"min_pool_reserve" yaml:"min_pool_reserve"`
+}
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns default feemarket parameters, the fee market is disabled by a min base fee of 0 and the base
+// fee changes by at most 12.5% per block like in EIP-1559. No other fee denoms are allowed, their pools must hold
+// 1000fet, and unused gas is not refunded.
+func DefaultParams() Params {
+	return Params{
+		Denom:              "afet",
+		MinBaseFee:         sdk.ZeroDec(),
+		TargetBlockGas:     10000000,
+		MaxChangeRate:      sdk.NewDecWithPrec(125, 3),
+		FeeDenoms:          []FeeDenom{},
+		GasRefundThreshold: sdk.NewDecWithPrec(1, 1),
+		GasRefundRate:      sdk.ZeroDec(),
+		MinPoolReserve:     sdk.NewIntWithDecimal(1000, 18),
+	}
+}
+
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(ParamStoreKeyDenom, &p.Denom, validateDenom),
+		params.NewParamSetPair(ParamStoreKeyMinBaseFee, &p.MinBaseFee, validateMinBaseFee),
+		params.NewParamSetPair(ParamStoreKeyTargetBlockGas, &p.TargetBlockGas, validateTargetBlockGas),
+		params.NewParamSetPair(ParamStoreKeyMaxChangeRate, &p.MaxChangeRate, validateMaxChangeRate),
+		params.NewParamSetPair(ParamStoreKeyFeeDenoms, &p.FeeDenoms, validateFeeDenoms),
+		params.NewParamSetPair(ParamStoreKeyGasRefundThreshold, &p.GasRefundThreshold, validateShare),
+		params.NewParamSetPair(ParamStoreKeyGasRefundRate, &p.GasRefundRate, validateShare),
+		params.NewParamSetPair(ParamStoreKeyMinPoolReserve, &p.MinPoolReserve, validateMinPoolReserve),
+	}
+}
+
+// ValidateBasic performs basic validation on feemarket parameters.
+func (p Params) ValidateBasic() error {
+	if err := validateDenom(p.Denom); err != nil {
+		return sdkerrors.Wrap(err, "denom")
+	}
+	if err := validateMinBaseFee(p.MinBaseFee); err != nil {
+		return sdkerrors.Wrap(err, "min base fee")
+	}
+	if err := validateTargetBlockGas(p.TargetBlockGas); err != nil {
+		return sdkerrors.Wrap(err, "target block gas")
+	}
+	if err := validateMaxChangeRate(p.MaxChangeRate); err != nil {
+		return sdkerrors.Wrap(err, "max change rate")
+	}
+	if err := validateFeeDenoms(p.FeeDenoms); err != nil {
+		return sdkerrors.Wrap(err, "fee denoms")
+	}
+	if _, ok := p.FeeDenom(p.Denom); ok {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "fee denoms must not contain the denom")
+	}
+	if err := validateShare(p.GasRefundThreshold); err != nil {
+		return sdkerrors.Wrap(err, "gas refund threshold")
+	}
+	if err := validateShare(p.GasRefundRate); err != nil {
+		return sdkerrors.Wrap(err, "gas refund rate")
+	}
+	if err := validateMinPoolReserve(p.MinPoolReserve); err != nil {
+		return sdkerrors.Wrap(err, "min pool reserve")
+	}
+	return nil
+}
+
+// FeeDenom returns the allowed fee denom of the denom
+func (p Params) FeeDenom(denom string) (FeeDenom, bool) {
+	for _, d := range p.FeeDenoms {
+		if d.Denom == denom {
+			return d, true
+		}
+	}
+	return FeeDenom{}, false
+}
+
+func validateDenom(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return sdk.ValidateDenom(v)
+}
+
+func validateMinBaseFee(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "must not be negative")
+	}
+	return nil
+}
+
+func validateTargetBlockGas(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "must be positive")
+	}
+	return nil
+}
+
+func validateMaxChangeRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || !v.IsPositive() || v.GTE(sdk.OneDec()) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "must be in (0, 1)")
+	}
+	return nil
+}
+
+func validateFeeDenoms(i interface{}) error {
+	v, ok := i.([]FeeDenom)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool, len(v))
+	for _, d := range v {
+		if err := sdk.ValidateDenom(d.Denom); err != nil {
+			return err
+		}
+		if d.PoolID == 0 {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "no pool for %s", d.Denom)
+		}
+		if seen[d.Denom] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate %s", d.Denom)
+		}
+		seen[d.Denom] = true
+	}
+	return nil
+}
+
+func validateShare(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "must be in [0, 1]")
+	}
+	return nil
+}
+
+func validateMinPoolReserve(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "must not be negative")
+	}
+	return nil
+}
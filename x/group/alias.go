@@ -0,0 +1,89 @@
+package group
+
+import (
+	"github.com/fetchai/fetchd/x/group/internal/keeper"
+	"github.com/fetchai/fetchd/x/group/internal/types"
+)
+
+const (
+	ModuleName                = types.ModuleName
+	StoreKey                  = types.StoreKey
+	QuerierRoute              = types.QuerierRoute
+	RouterKey                 = types.RouterKey
+	MaxMetadataLength         = types.MaxMetadataLength
+	MaxProposalMsgs           = types.MaxProposalMsgs
+	OptionYes                 = types.OptionYes
+	OptionNo                  = types.OptionNo
+	OptionAbstain             = types.OptionAbstain
+	AttributeKeyGroupID       = types.AttributeKeyGroupID
+	AttributeKeyPolicyAddress = types.AttributeKeyPolicyAddress
+	AttributeKeyProposalID    = types.AttributeKeyProposalID
+	AttributeKeyVoter         = types.AttributeKeyVoter
+	AttributeKeyOption        = types.AttributeKeyOption
+	EventTypeCreateGroup      = types.EventTypeCreateGroup
+	EventTypeUpdateGroup      = types.EventTypeUpdateGroup
+	EventTypeCreatePolicy     = types.EventTypeCreatePolicy
+	EventTypeUpdatePolicy     = types.EventTypeUpdatePolicy
+	EventTypeSubmitProposal   = types.EventTypeSubmitProposal
+	EventTypeVote             = types.EventTypeVote
+	EventTypeExec             = types.EventTypeExec
+	QueryGroup                = keeper.QueryGroup
+	QueryGroupsOfMember       = keeper.QueryGroupsOfMember
+	QueryPolicy               = keeper.QueryPolicy
+	QueryPoliciesOfGroup      = keeper.QueryPoliciesOfGroup
+	QueryProposal             = keeper.QueryProposal
+	QueryProposalsOfPolicy    = keeper.QueryProposalsOfPolicy
+	QueryVotes                = keeper.QueryVotes
+)
+
+var (
+	// functions aliases
+	RegisterCodec        = types.RegisterCodec
+	ValidateGenesis      = types.ValidateGenesis
+	DefaultGenesisState  = types.DefaultGenesisState
+	NewThresholdPolicy   = types.NewThresholdPolicy
+	NewPercentagePolicy  = types.NewPercentagePolicy
+	PolicyAddress        = types.PolicyAddress
+	ValidateProposalMsgs = types.ValidateProposalMsgs
+	NewKeeper            = keeper.NewKeeper
+	NewQuerier           = keeper.NewQuerier
+	InitGenesis          = keeper.InitGenesis
+	ExportGenesis        = keeper.ExportGenesis
+
+	// variable aliases
+	ModuleCdc           = types.ModuleCdc
+	ErrGroupNotFound    = types.ErrGroupNotFound
+	ErrPolicyNotFound   = types.ErrPolicyNotFound
+	ErrProposalNotFound = types.ErrProposalNotFound
+	ErrNotAdmin         = types.ErrNotAdmin
+	ErrNotMember        = types.ErrNotMember
+	ErrInvalidMembers   = types.ErrInvalidMembers
+	ErrInvalidPolicy    = types.ErrInvalidPolicy
+	ErrVotingEnded      = types.ErrVotingEnded
+	ErrAlreadyVoted     = types.ErrAlreadyVoted
+	ErrNotAccepted      = types.ErrNotAccepted
+	ErrAborted          = types.ErrAborted
+	ErrExecuted         = types.ErrExecuted
+	ErrInvalidProposal  = types.ErrInvalidProposal
+)
+
+type (
+	Keeper                = keeper.Keeper
+	GenesisState          = types.GenesisState
+	Member                = types.Member
+	Group                 = types.Group
+	DecisionPolicy        = types.DecisionPolicy
+	GroupPolicy           = types.GroupPolicy
+	VoteOption            = types.VoteOption
+	Tally                 = types.Tally
+	Proposal              = types.Proposal
+	Vote                  = types.Vote
+	MsgCreateGroup        = types.MsgCreateGroup
+	MsgUpdateGroupMembers = types.MsgUpdateGroupMembers
+	MsgUpdateGroupAdmin   = types.MsgUpdateGroupAdmin
+	MsgCreateGroupPolicy  = types.MsgCreateGroupPolicy
+	MsgUpdateGroupPolicy  = types.MsgUpdateGroupPolicy
+	MsgSubmitProposal     = types.MsgSubmitProposal
+	MsgVote               = types.MsgVote
+	MsgExec               = types.MsgExec
+)
@@ -0,0 +1,224 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/fetchai/fetchd/x/group/internal/keeper"
+	"github.com/fetchai/fetchd/x/group/internal/types"
+)
+
+// GetQueryCmd returns the query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the groups",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(flags.GetCommands(
+		GetCmdQueryGroup(cdc),
+		GetCmdQueryGroupsOfMember(cdc),
+		GetCmdQueryPolicy(cdc),
+		GetCmdQueryPoliciesOfGroup(cdc),
+		GetCmdQueryProposal(cdc),
+		GetCmdQueryProposalsOfPolicy(cdc),
+		GetCmdQueryVotes(cdc),
+	)...)
+	return queryCmd
+}
+
+// GetCmdQueryGroup shows a group
+func GetCmdQueryGroup(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "group [group_id]",
+		Short: "Show the admin and the weighted members of a group",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("group id: %s", err)
+			}
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%d", types.QuerierRoute, keeper.QueryGroup, id))
+			if err != nil {
+				return err
+			}
+			var group *types.Group
+			if err := json.Unmarshal(res, &group); err != nil {
+				return err
+			}
+			if group == nil {
+				return sdkerrors.Wrapf(types.ErrGroupNotFound, "%d", id)
+			}
+			return cliCtx.PrintOutput(group)
+		},
+	}
+}
+
+// GetCmdQueryGroupsOfMember lists the groups of a member
+func GetCmdQueryGroupsOfMember(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "groups-of-member [address]",
+		Short: "List the groups an account is a member of",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, keeper.QueryGroupsOfMember, addr))
+			if err != nil {
+				return err
+			}
+			var groups []types.Group
+			if err := json.Unmarshal(res, &groups); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(groups)
+		},
+	}
+}
+
+// GetCmdQueryPolicy shows a group policy
+func GetCmdQueryPolicy(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "policy [policy_address]",
+		Short: "Show the group, the admin and the decision policy of a group policy account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, keeper.QueryPolicy, addr))
+			if err != nil {
+				return err
+			}
+			var policy *types.GroupPolicy
+			if err := json.Unmarshal(res, &policy); err != nil {
+				return err
+			}
+			if policy == nil {
+				return sdkerrors.Wrap(types.ErrPolicyNotFound, addr.String())
+			}
+			return cliCtx.PrintOutput(policy)
+		},
+	}
+}
+
+// GetCmdQueryPoliciesOfGroup lists the policies of a group
+func GetCmdQueryPoliciesOfGroup(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "policies-of-group [group_id]",
+		Short: "List the policy accounts of a group",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("group id: %s", err)
+			}
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%d", types.QuerierRoute, keeper.QueryPoliciesOfGroup, id))
+			if err != nil {
+				return err
+			}
+			var policies []types.GroupPolicy
+			if err := json.Unmarshal(res, &policies); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(policies)
+		},
+	}
+}
+
+// GetCmdQueryProposal shows a proposal
+func GetCmdQueryProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "proposal [proposal_id]",
+		Short: "Show the msgs, the tally and the voting end height of a proposal",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal id: %s", err)
+			}
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%d", types.QuerierRoute, keeper.QueryProposal, id))
+			if err != nil {
+				return err
+			}
+			var proposal *types.Proposal
+			if err := cdc.UnmarshalJSON(res, &proposal); err != nil {
+				return err
+			}
+			if proposal == nil {
+				return sdkerrors.Wrapf(types.ErrProposalNotFound, "%d", id)
+			}
+			return cliCtx.PrintOutput(proposal)
+		},
+	}
+}
+
+// GetCmdQueryProposalsOfPolicy lists the proposals to a group policy
+func GetCmdQueryProposalsOfPolicy(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "proposals-of-policy [policy_address]",
+		Short: "List the proposals to a group policy account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, keeper.QueryProposalsOfPolicy, addr))
+			if err != nil {
+				return err
+			}
+			var proposals []types.Proposal
+			if err := cdc.UnmarshalJSON(res, &proposals); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(proposals)
+		},
+	}
+}
+
+// GetCmdQueryVotes lists the votes on a proposal
+func GetCmdQueryVotes(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "votes [proposal_id]",
+		Short: "List the votes of the members on a proposal",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal id: %s", err)
+			}
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%d", types.QuerierRoute, keeper.QueryVotes, id))
+			if err != nil {
+				return err
+			}
+			var votes []types.Vote
+			if err := json.Unmarshal(res, &votes); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(votes)
+		},
+	}
+}
@@ -0,0 +1,351 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	"github.com/fetchai/fetchd/x/group/internal/types"
+	wasmUtils "github.com/fetchai/fetchd/x/wasm/client/utils"
+)
+
+const (
+	flagMetadata     = "metadata"
+	flagThreshold    = "threshold"
+	flagPercentage   = "percentage"
+	flagVotingPeriod = "voting-period"
+	flagNewAdmin     = "new-admin"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Group transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	txCmd.AddCommand(wasmUtils.AddBroadcastFlags(flags.PostCommands(
+		CreateGroupCmd(cdc),
+		UpdateGroupMembersCmd(cdc),
+		UpdateGroupAdminCmd(cdc),
+		CreateGroupPolicyCmd(cdc),
+		UpdateGroupPolicyCmd(cdc),
+		SubmitProposalCmd(cdc),
+		VoteCmd(cdc),
+		ExecCmd(cdc),
+	)...)...)
+	return txCmd
+}
+
+// CreateGroupCmd creates a group administrated by the --from account
+func CreateGroupCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-group [members_file]",
+		Short: "Create a group of the members of a json file administrated by the --from account",
+		Long: `Create a group of the weighted members of the json file, e.g.
+[{"address":"fetch1...","weight":"1"},{"address":"fetch1...","weight":"2","metadata":"treasurer"}]. The id of the group
+is in the create_group event.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			members, err := readMembers(cdc, args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.MsgCreateGroup{Admin: cliCtx.GetFromAddress(), Members: members, Metadata: viper.GetString(flagMetadata)}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(flagMetadata, "", "Metadata of the group")
+	return cmd
+}
+
+// UpdateGroupMembersCmd updates the members of a group administrated by the --from account
+func UpdateGroupMembersCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "update-group-members [group_id] [members_file]",
+		Short: "Add, update or remove members of a group administrated by the --from account",
+		Long: `Add or update the members of the json file, like for "create-group", members with a weight of "0" are
+removed. The open proposals of the group are aborted.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("group id: %s", err)
+			}
+			members, err := readMembers(cdc, args[1])
+			if err != nil {
+				return err
+			}
+			msg := types.MsgUpdateGroupMembers{Admin: cliCtx.GetFromAddress(), GroupID: id, MemberUpdates: members}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// UpdateGroupAdminCmd transfers the administration of a group administrated by the --from account
+func UpdateGroupAdminCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "update-group-admin [group_id] [new_admin]",
+		Short: "Transfer the administration of a group administrated by the --from account",
+		Long: `Transfer the administration of the group to the new admin. With a policy of the group as admin, the
+members decide on the changes of the group with proposals.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("group id: %s", err)
+			}
+			newAdmin, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return fmt.Errorf("new admin: %s", err)
+			}
+			msg := types.MsgUpdateGroupAdmin{Admin: cliCtx.GetFromAddress(), GroupID: id, NewAdmin: newAdmin}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// CreateGroupPolicyCmd creates a policy account of a group administrated by the --from account
+func CreateGroupPolicyCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-group-policy [group_id]",
+		Short: "Create a policy account of a group administrated by the --from account",
+		Long: `Create an account of the group that executes the proposals its members accept with yes votes of the
+--threshold weight or of the --percentage of the total weight within the --voting-period blocks. The address of the
+account is in the create_group_policy event.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("group id: %s", err)
+			}
+			policy, err := decisionPolicyFromFlags()
+			if err != nil {
+				return err
+			}
+			msg := types.MsgCreateGroupPolicy{
+				Admin:          cliCtx.GetFromAddress(),
+				GroupID:        id,
+				Metadata:       viper.GetString(flagMetadata),
+				DecisionPolicy: policy,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	addDecisionPolicyFlags(cmd)
+	cmd.Flags().String(flagMetadata, "", "Metadata of the group policy")
+	return cmd
+}
+
+// UpdateGroupPolicyCmd updates a group policy administrated by the --from account
+func UpdateGroupPolicyCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-policy [policy_address]",
+		Short: "Replace the decision policy of a group policy administrated by the --from account",
+		Long: `Replace the decision policy of the group policy, like for "create-group-policy", and transfer its
+administration to the --new-admin if set. The open proposals of the group policy are aborted.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("policy address: %s", err)
+			}
+			policy, err := decisionPolicyFromFlags()
+			if err != nil {
+				return err
+			}
+			var newAdmin sdk.AccAddress
+			if s := viper.GetString(flagNewAdmin); s != "" {
+				if newAdmin, err = sdk.AccAddressFromBech32(s); err != nil {
+					return fmt.Errorf("new admin: %s", err)
+				}
+			}
+			msg := types.MsgUpdateGroupPolicy{Admin: cliCtx.GetFromAddress(), Address: addr, DecisionPolicy: policy, NewAdmin: newAdmin}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	addDecisionPolicyFlags(cmd)
+	cmd.Flags().String(flagNewAdmin, "", "Bech32 address of the new admin of the group policy")
+	return cmd
+}
+
+// SubmitProposalCmd submits a proposal of the --from member to a group policy
+func SubmitProposalCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-proposal [policy_address] [msgs_file]",
+		Short: "Propose msgs of a group policy account to the members of its group",
+		Long: `Propose the msgs of the json file, signed by the group policy account, e.g.
+[{"type":"cosmos-sdk/MsgSend","value":{"from_address":"<policy_address>","to_address":"fetch1...",
+"amount":[{"denom":"afet","amount":"100"}]}}]. Any msg signed by the policy account can be proposed, including
+wasm/MsgExecuteContract, e.g. the msgs of the tx printed by a tx command with --generate-only --from <policy_address>.
+The id of the proposal is in the submit_group_proposal event.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("policy address: %s", err)
+			}
+			bz, err := ioutil.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+			var msgs []sdk.Msg
+			if err := cdc.UnmarshalJSON(bz, &msgs); err != nil {
+				return err
+			}
+			msg := types.MsgSubmitProposal{
+				Proposer:      cliCtx.GetFromAddress(),
+				PolicyAddress: addr,
+				Msgs:          msgs,
+				Metadata:      viper.GetString(flagMetadata),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(flagMetadata, "", "Metadata of the proposal")
+	return cmd
+}
+
+// VoteCmd votes with the weight of the --from member on a proposal
+func VoteCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote [proposal_id] [yes|no|abstain]",
+		Short: "Vote with the weight of the --from member on a proposal",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal id: %s", err)
+			}
+			msg := types.MsgVote{
+				Voter:      cliCtx.GetFromAddress(),
+				ProposalID: id,
+				Option:     types.VoteOption(args[1]),
+				Metadata:   viper.GetString(flagMetadata),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(flagMetadata, "", "Metadata of the vote")
+	return cmd
+}
+
+// ExecCmd executes the msgs of an accepted proposal
+func ExecCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "exec [proposal_id]",
+		Short: "Execute the msgs of an accepted proposal, any account can execute it",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal id: %s", err)
+			}
+			msg := types.MsgExec{Signer: cliCtx.GetFromAddress(), ProposalID: id}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func readMembers(cdc *codec.Codec, file string) ([]types.Member, error) {
+	bz, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var members []types.Member
+	if err := cdc.UnmarshalJSON(bz, &members); err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+func addDecisionPolicyFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint64(flagThreshold, 0, "Weight of the yes votes that accepts a proposal")
+	cmd.Flags().String(flagPercentage, "", "Share of the total weight of the yes votes that accepts a proposal, e.g. 0.5")
+	cmd.Flags().Int64(flagVotingPeriod, 0, "Number of blocks the members can vote on a proposal")
+}
+
+func decisionPolicyFromFlags() (types.DecisionPolicy, error) {
+	votingPeriod := viper.GetInt64(flagVotingPeriod)
+	if s := viper.GetString(flagPercentage); s != "" {
+		percentage, err := sdk.NewDecFromStr(s)
+		if err != nil {
+			return types.DecisionPolicy{}, fmt.Errorf("percentage: %s", err)
+		}
+		if viper.GetUint64(flagThreshold) != 0 {
+			return types.DecisionPolicy{}, fmt.Errorf("either --%s or --%s", flagThreshold, flagPercentage)
+		}
+		return types.NewPercentagePolicy(percentage, votingPeriod), nil
+	}
+	return types.NewThresholdPolicy(viper.GetUint64(flagThreshold), votingPeriod), nil
+}
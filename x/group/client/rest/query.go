@@ -0,0 +1,64 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/fetchai/fetchd/x/group/internal/keeper"
+	"github.com/fetchai/fetchd/x/group/internal/types"
+)
+
+func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/group/groups/{id}", queryByIDHandlerFn(cliCtx, keeper.QueryGroup)).Methods("GET")
+	r.HandleFunc("/group/groups/{id}/policies", queryByIDHandlerFn(cliCtx, keeper.QueryPoliciesOfGroup)).Methods("GET")
+	r.HandleFunc("/group/members/{address}/groups", queryByAddressHandlerFn(cliCtx, keeper.QueryGroupsOfMember)).Methods("GET")
+	r.HandleFunc("/group/policies/{address}", queryByAddressHandlerFn(cliCtx, keeper.QueryPolicy)).Methods("GET")
+	r.HandleFunc("/group/policies/{address}/proposals", queryByAddressHandlerFn(cliCtx, keeper.QueryProposalsOfPolicy)).Methods("GET")
+	r.HandleFunc("/group/proposals/{id}", queryByIDHandlerFn(cliCtx, keeper.QueryProposal)).Methods("GET")
+	r.HandleFunc("/group/proposals/{id}/votes", queryByIDHandlerFn(cliCtx, keeper.QueryVotes)).Methods("GET")
+}
+
+func queryByIDHandlerFn(cliCtx context.CLIContext, path string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		query(w, r, cliCtx, fmt.Sprintf("%s/%d", path, id))
+	}
+}
+
+func queryByAddressHandlerFn(cliCtx context.CLIContext, path string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		addr, err := sdk.AccAddressFromBech32(mux.Vars(r)["address"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		query(w, r, cliCtx, fmt.Sprintf("%s/%s", path, addr))
+	}
+}
+
+func query(w http.ResponseWriter, r *http.Request, cliCtx context.CLIContext, path string) {
+	cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+	if !ok {
+		return
+	}
+
+	res, height, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, path))
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	cliCtx = cliCtx.WithHeight(height)
+	rest.PostProcessResponse(w, cliCtx, json.RawMessage(res))
+}
@@ -0,0 +1,73 @@
+package group
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewHandler returns a handler for "group" type messages.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case MsgCreateGroup:
+			if _, err := k.CreateGroup(ctx, msg.Admin, msg.Members, msg.Metadata); err != nil {
+				return nil, err
+			}
+			return result(ctx, msg.Admin), nil
+		case MsgUpdateGroupMembers:
+			if err := k.UpdateGroupMembers(ctx, msg.Admin, msg.GroupID, msg.MemberUpdates); err != nil {
+				return nil, err
+			}
+			return result(ctx, msg.Admin), nil
+		case MsgUpdateGroupAdmin:
+			if err := k.UpdateGroupAdmin(ctx, msg.Admin, msg.GroupID, msg.NewAdmin); err != nil {
+				return nil, err
+			}
+			return result(ctx, msg.Admin), nil
+		case MsgCreateGroupPolicy:
+			if _, err := k.CreateGroupPolicy(ctx, msg.Admin, msg.GroupID, msg.Metadata, msg.DecisionPolicy); err != nil {
+				return nil, err
+			}
+			return result(ctx, msg.Admin), nil
+		case MsgUpdateGroupPolicy:
+			if err := k.UpdateGroupPolicy(ctx, msg.Admin, msg.Address, msg.DecisionPolicy, msg.NewAdmin); err != nil {
+				return nil, err
+			}
+			return result(ctx, msg.Admin), nil
+		case MsgSubmitProposal:
+			if _, err := k.SubmitProposal(ctx, msg.Proposer, msg.PolicyAddress, msg.Msgs, msg.Metadata); err != nil {
+				return nil, err
+			}
+			return result(ctx, msg.Proposer), nil
+		case MsgVote:
+			if err := k.Vote(ctx, msg.Voter, msg.ProposalID, msg.Option, msg.Metadata); err != nil {
+				return nil, err
+			}
+			return result(ctx, msg.Voter), nil
+		case MsgExec:
+			if err := k.Exec(ctx, msg.ProposalID); err != nil {
+				return nil, err
+			}
+			return result(ctx, msg.Signer), nil
+		default:
+			errMsg := fmt.Sprintf("unrecognized group message type: %T", msg)
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+		}
+	}
+}
+
+func result(ctx sdk.Context, sender sdk.AccAddress) *sdk.Result {
+	events := ctx.EventManager().Events()
+	ourEvent := sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+	)
+	return &sdk.Result{
+		Events: append(events, ourEvent),
+	}
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fetchai/fetchd/x/group/internal/types"
+)
+
+// InitGenesis stores the last ids, the groups and the policies of the genesis
+func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
+	keeper.setLastID(ctx, types.LastGroupIDKey, data.LastGroupID)
+	keeper.setLastID(ctx, types.LastPolicyIDKey, data.LastPolicyID)
+	keeper.setLastID(ctx, types.LastProposalIDKey, data.LastProposalID)
+	store := ctx.KVStore(keeper.storeKey)
+	for _, g := range data.Groups {
+		keeper.setGroup(ctx, g)
+		for _, m := range g.Members {
+			store.Set(types.GetMemberIndexKey(m.Address, g.ID), []byte{})
+		}
+	}
+	for _, p := range data.Policies {
+		keeper.setPolicy(ctx, p)
+		store.Set(types.GetGroupPolicyKey(p.GroupID, p.Address), []byte{})
+	}
+}
+
+// ExportGenesis returns the last ids, the groups and the policies as genesis state. The last proposal id is kept so
+// that the ids of the dropped proposals are not reused.
+func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
+	data := types.GenesisState{
+		LastGroupID:    keeper.getLastID(ctx, types.LastGroupIDKey),
+		LastPolicyID:   keeper.getLastID(ctx, types.LastPolicyIDKey),
+		LastProposalID: keeper.getLastID(ctx, types.LastProposalIDKey),
+	}
+	keeper.IterateGroups(ctx, func(g types.Group) bool {
+		data.Groups = append(data.Groups, g)
+		return false
+	})
+	keeper.IteratePolicies(ctx, func(p types.GroupPolicy) bool {
+		data.Policies = append(data.Policies, p)
+		return false
+	})
+	return data
+}
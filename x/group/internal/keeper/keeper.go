@@ -0,0 +1,453 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/fetchai/fetchd/x/group/internal/types"
+)
+
+// Keeper keeps the groups, their policy accounts and the proposals to them. The accepted proposals are executed with
+// the router of the app, like the msgs of a tx signed by the policy account.
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      *codec.Codec
+	router   sdk.Router
+}
+
+// NewKeeper creates a new group Keeper instance
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, router sdk.Router) Keeper {
+	return Keeper{
+		storeKey: storeKey,
+		cdc:      cdc,
+		router:   router,
+	}
+}
+
+// CreateGroup creates a group of the members administrated by the admin and returns its id
+func (k Keeper) CreateGroup(ctx sdk.Context, admin sdk.AccAddress, members []types.Member, metadata string) (uint64, error) {
+	group := types.Group{
+		ID:       k.getLastID(ctx, types.LastGroupIDKey) + 1,
+		Admin:    admin,
+		Metadata: metadata,
+		Members:  members,
+	}
+	if err := group.Validate(); err != nil {
+		return 0, err
+	}
+	k.setLastID(ctx, types.LastGroupIDKey, group.ID)
+	k.setGroup(ctx, group)
+	store := ctx.KVStore(k.storeKey)
+	for _, m := range members {
+		store.Set(types.GetMemberIndexKey(m.Address, group.ID), []byte{})
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCreateGroup,
+		sdk.NewAttribute(types.AttributeKeyGroupID, strconv.FormatUint(group.ID, 10)),
+	))
+	return group.ID, nil
+}
+
+// UpdateGroupMembers adds or updates the members of the updates, updates with a weight of 0 remove members. The
+// open proposals of the group are aborted.
+func (k Keeper) UpdateGroupMembers(ctx sdk.Context, admin sdk.AccAddress, id uint64, updates []types.Member) error {
+	group, err := k.adminGroup(ctx, admin, id)
+	if err != nil {
+		return err
+	}
+	for _, u := range updates {
+		i := memberIndex(group.Members, u.Address)
+		switch {
+		case u.Weight == 0 && i < 0:
+			return sdkerrors.Wrapf(types.ErrNotMember, "remove %s", u.Address)
+		case u.Weight == 0:
+			group.Members = append(group.Members[:i], group.Members[i+1:]...)
+		case i < 0:
+			group.Members = append(group.Members, u)
+		default:
+			group.Members[i] = u
+		}
+	}
+	if len(group.Members) == 0 {
+		return sdkerrors.Wrap(types.ErrInvalidMembers, "removes all members")
+	}
+	store := ctx.KVStore(k.storeKey)
+	for _, u := range updates {
+		if u.Weight == 0 {
+			store.Delete(types.GetMemberIndexKey(u.Address, id))
+		} else {
+			store.Set(types.GetMemberIndexKey(u.Address, id), []byte{})
+		}
+	}
+	group.Version++
+	k.setGroup(ctx, group)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateGroup,
+		sdk.NewAttribute(types.AttributeKeyGroupID, strconv.FormatUint(id, 10)),
+	))
+	return nil
+}
+
+// UpdateGroupAdmin transfers the administration of the group to the new admin
+func (k Keeper) UpdateGroupAdmin(ctx sdk.Context, admin sdk.AccAddress, id uint64, newAdmin sdk.AccAddress) error {
+	group, err := k.adminGroup(ctx, admin, id)
+	if err != nil {
+		return err
+	}
+	group.Admin = newAdmin
+	k.setGroup(ctx, group)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateGroup,
+		sdk.NewAttribute(types.AttributeKeyGroupID, strconv.FormatUint(id, 10)),
+	))
+	return nil
+}
+
+// CreateGroupPolicy creates a policy account of the group with the decision policy, administrated by the admin of the
+// group, and returns its address
+func (k Keeper) CreateGroupPolicy(ctx sdk.Context, admin sdk.AccAddress, groupID uint64, metadata string, decisionPolicy types.DecisionPolicy) (sdk.AccAddress, error) {
+	if _, err := k.adminGroup(ctx, admin, groupID); err != nil {
+		return nil, err
+	}
+	id := k.getLastID(ctx, types.LastPolicyIDKey) + 1
+	policy := types.GroupPolicy{
+		Address:        types.PolicyAddress(id),
+		GroupID:        groupID,
+		Admin:          admin,
+		Metadata:       metadata,
+		DecisionPolicy: decisionPolicy,
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+	k.setLastID(ctx, types.LastPolicyIDKey, id)
+	k.setPolicy(ctx, policy)
+	ctx.KVStore(k.storeKey).Set(types.GetGroupPolicyKey(groupID, policy.Address), []byte{})
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCreatePolicy,
+		sdk.NewAttribute(types.AttributeKeyGroupID, strconv.FormatUint(groupID, 10)),
+		sdk.NewAttribute(types.AttributeKeyPolicyAddress, policy.Address.String()),
+	))
+	return policy.Address, nil
+}
+
+// UpdateGroupPolicy replaces the decision policy of the group policy and transfers its administration to the new
+// admin when it is not empty. The open proposals of the group policy are aborted.
+func (k Keeper) UpdateGroupPolicy(ctx sdk.Context, admin, addr sdk.AccAddress, decisionPolicy types.DecisionPolicy, newAdmin sdk.AccAddress) error {
+	policy := k.GetPolicy(ctx, addr)
+	if policy == nil {
+		return sdkerrors.Wrap(types.ErrPolicyNotFound, addr.String())
+	}
+	if !policy.Admin.Equals(admin) {
+		return sdkerrors.Wrap(types.ErrNotAdmin, admin.String())
+	}
+	if err := decisionPolicy.Validate(); err != nil {
+		return err
+	}
+	policy.DecisionPolicy = decisionPolicy
+	if !newAdmin.Empty() {
+		policy.Admin = newAdmin
+	}
+	policy.Version++
+	k.setPolicy(ctx, *policy)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdatePolicy,
+		sdk.NewAttribute(types.AttributeKeyPolicyAddress, addr.String()),
+	))
+	return nil
+}
+
+// SubmitProposal submits a proposal of the msgs to the group policy and returns its id. The proposer must be a member
+// of the group.
+func (k Keeper) SubmitProposal(ctx sdk.Context, proposer, policyAddr sdk.AccAddress, msgs []sdk.Msg, metadata string) (uint64, error) {
+	policy := k.GetPolicy(ctx, policyAddr)
+	if policy == nil {
+		return 0, sdkerrors.Wrap(types.ErrPolicyNotFound, policyAddr.String())
+	}
+	group := k.GetGroup(ctx, policy.GroupID)
+	if group == nil {
+		return 0, sdkerrors.Wrapf(types.ErrGroupNotFound, "%d", policy.GroupID)
+	}
+	if group.Weight(proposer) == 0 {
+		return 0, sdkerrors.Wrap(types.ErrNotMember, proposer.String())
+	}
+	if err := types.ValidateProposalMsgs(policyAddr, msgs); err != nil {
+		return 0, err
+	}
+	proposal := types.Proposal{
+		ID:              k.nextID(ctx, types.LastProposalIDKey),
+		PolicyAddress:   policyAddr,
+		Proposer:        proposer,
+		Metadata:        metadata,
+		Msgs:            msgs,
+		SubmitHeight:    ctx.BlockHeight(),
+		VotingEndHeight: ctx.BlockHeight() + policy.DecisionPolicy.VotingPeriod,
+		GroupVersion:    group.Version,
+		PolicyVersion:   policy.Version,
+	}
+	k.setProposal(ctx, proposal)
+	ctx.KVStore(k.storeKey).Set(types.GetPolicyProposalKey(policyAddr, proposal.ID), []byte{})
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSubmitProposal,
+		sdk.NewAttribute(types.AttributeKeyProposalID, strconv.FormatUint(proposal.ID, 10)),
+		sdk.NewAttribute(types.AttributeKeyPolicyAddress, policyAddr.String()),
+	))
+	return proposal.ID, nil
+}
+
+// Vote votes with the weight of the member on the proposal until the end of its voting period. Votes are final.
+func (k Keeper) Vote(ctx sdk.Context, voter sdk.AccAddress, id uint64, option types.VoteOption, metadata string) error {
+	proposal, group, _, err := k.openProposal(ctx, id)
+	if err != nil {
+		return err
+	}
+	if ctx.BlockHeight() >= proposal.VotingEndHeight {
+		return sdkerrors.Wrapf(types.ErrVotingEnded, "at height %d", proposal.VotingEndHeight)
+	}
+	weight := group.Weight(voter)
+	if weight == 0 {
+		return sdkerrors.Wrap(types.ErrNotMember, voter.String())
+	}
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(types.GetVoteKey(id, voter)) {
+		return sdkerrors.Wrap(types.ErrAlreadyVoted, voter.String())
+	}
+	vote := types.Vote{ProposalID: id, Voter: voter, Option: option, Weight: weight, Metadata: metadata}
+	store.Set(types.GetVoteKey(id, voter), k.cdc.MustMarshalBinaryBare(vote))
+	proposal.Tally = proposal.Tally.Add(option, weight)
+	k.setProposal(ctx, proposal)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeVote,
+		sdk.NewAttribute(types.AttributeKeyProposalID, strconv.FormatUint(id, 10)),
+		sdk.NewAttribute(types.AttributeKeyVoter, voter.String()),
+		sdk.NewAttribute(types.AttributeKeyOption, string(option)),
+	))
+	return nil
+}
+
+// Exec executes the msgs of the proposal once its decision policy accepts it. The msgs are executed in order as
+// signed by the group policy account, the execution fails when one of them fails.
+func (k Keeper) Exec(ctx sdk.Context, id uint64) error {
+	proposal, group, policy, err := k.openProposal(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !policy.DecisionPolicy.Accepts(proposal.Tally, group.TotalWeight()) {
+		return sdkerrors.Wrapf(types.ErrNotAccepted, "%d", id)
+	}
+	// the proposal is marked executed first so that its msgs cannot execute it again
+	proposal.Executed = true
+	k.setProposal(ctx, proposal)
+	for i, msg := range proposal.Msgs {
+		handler := k.router.Route(ctx, msg.Route())
+		if handler == nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "msg %d route %s", i, msg.Route())
+		}
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "msg %d", i)
+		}
+		ctx.EventManager().EmitEvents(res.Events)
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeExec,
+		sdk.NewAttribute(types.AttributeKeyProposalID, strconv.FormatUint(id, 10)),
+		sdk.NewAttribute(types.AttributeKeyPolicyAddress, proposal.PolicyAddress.String()),
+	))
+	return nil
+}
+
+// openProposal returns the proposal that is not executed with its group and policy. The proposal is aborted when
+// the members of the group or the decision policy changed since it was submitted.
+func (k Keeper) openProposal(ctx sdk.Context, id uint64) (proposal types.Proposal, group types.Group, policy types.GroupPolicy, err error) {
+	p := k.GetProposal(ctx, id)
+	if p == nil {
+		return proposal, group, policy, sdkerrors.Wrapf(types.ErrProposalNotFound, "%d", id)
+	}
+	if p.Executed {
+		return proposal, group, policy, sdkerrors.Wrapf(types.ErrExecuted, "%d", id)
+	}
+	gp := k.GetPolicy(ctx, p.PolicyAddress)
+	if gp == nil {
+		return proposal, group, policy, sdkerrors.Wrap(types.ErrPolicyNotFound, p.PolicyAddress.String())
+	}
+	g := k.GetGroup(ctx, gp.GroupID)
+	if g == nil {
+		return proposal, group, policy, sdkerrors.Wrapf(types.ErrGroupNotFound, "%d", gp.GroupID)
+	}
+	if g.Version != p.GroupVersion {
+		return proposal, group, policy, sdkerrors.Wrap(types.ErrAborted, "group members changed")
+	}
+	if gp.Version != p.PolicyVersion {
+		return proposal, group, policy, sdkerrors.Wrap(types.ErrAborted, "decision policy changed")
+	}
+	return *p, *g, *gp, nil
+}
+
+// adminGroup returns the group of the admin
+func (k Keeper) adminGroup(ctx sdk.Context, admin sdk.AccAddress, id uint64) (types.Group, error) {
+	group := k.GetGroup(ctx, id)
+	if group == nil {
+		return types.Group{}, sdkerrors.Wrapf(types.ErrGroupNotFound, "%d", id)
+	}
+	if !group.Admin.Equals(admin) {
+		return types.Group{}, sdkerrors.Wrap(types.ErrNotAdmin, admin.String())
+	}
+	return *group, nil
+}
+
+func memberIndex(members []types.Member, addr sdk.AccAddress) int {
+	for i, m := range members {
+		if m.Address.Equals(addr) {
+			return i
+		}
+	}
+	return -1
+}
+
+// nextID increases the last id of the key and returns it
+func (k Keeper) nextID(ctx sdk.Context, key []byte) uint64 {
+	id := k.getLastID(ctx, key) + 1
+	k.setLastID(ctx, key, id)
+	return id
+}
+
+func (k Keeper) getLastID(ctx sdk.Context, key []byte) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setLastID(ctx sdk.Context, key []byte, id uint64) {
+	ctx.KVStore(k.storeKey).Set(key, sdk.Uint64ToBigEndian(id))
+}
+
+// GetGroup returns the group, nil when there is none
+func (k Keeper) GetGroup(ctx sdk.Context, id uint64) *types.Group {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetGroupKey(id))
+	if bz == nil {
+		return nil
+	}
+	var group types.Group
+	k.cdc.MustUnmarshalBinaryBare(bz, &group)
+	return &group
+}
+
+func (k Keeper) setGroup(ctx sdk.Context, group types.Group) {
+	ctx.KVStore(k.storeKey).Set(types.GetGroupKey(group.ID), k.cdc.MustMarshalBinaryBare(group))
+}
+
+// GetPolicy returns the group policy of the account, nil when the account is not a group policy
+func (k Keeper) GetPolicy(ctx sdk.Context, addr sdk.AccAddress) *types.GroupPolicy {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetPolicyKey(addr))
+	if bz == nil {
+		return nil
+	}
+	var policy types.GroupPolicy
+	k.cdc.MustUnmarshalBinaryBare(bz, &policy)
+	return &policy
+}
+
+func (k Keeper) setPolicy(ctx sdk.Context, policy types.GroupPolicy) {
+	ctx.KVStore(k.storeKey).Set(types.GetPolicyKey(policy.Address), k.cdc.MustMarshalBinaryBare(policy))
+}
+
+// GetProposal returns the proposal, nil when there is none
+func (k Keeper) GetProposal(ctx sdk.Context, id uint64) *types.Proposal {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetProposalKey(id))
+	if bz == nil {
+		return nil
+	}
+	var proposal types.Proposal
+	k.cdc.MustUnmarshalBinaryBare(bz, &proposal)
+	return &proposal
+}
+
+func (k Keeper) setProposal(ctx sdk.Context, proposal types.Proposal) {
+	ctx.KVStore(k.storeKey).Set(types.GetProposalKey(proposal.ID), k.cdc.MustMarshalBinaryBare(proposal))
+}
+
+// GetGroupsOfMember returns the groups the account is a member of
+func (k Keeper) GetGroupsOfMember(ctx sdk.Context, addr sdk.AccAddress) []types.Group {
+	var groups []types.Group
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetMemberIndexPrefix(addr)).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if group := k.GetGroup(ctx, binary.BigEndian.Uint64(iter.Key())); group != nil {
+			groups = append(groups, *group)
+		}
+	}
+	return groups
+}
+
+// GetPoliciesOfGroup returns the policies of the group
+func (k Keeper) GetPoliciesOfGroup(ctx sdk.Context, groupID uint64) []types.GroupPolicy {
+	var policies []types.GroupPolicy
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetGroupPoliciesPrefix(groupID)).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if policy := k.GetPolicy(ctx, iter.Key()); policy != nil {
+			policies = append(policies, *policy)
+		}
+	}
+	return policies
+}
+
+// GetProposalsOfPolicy returns the proposals to the group policy
+func (k Keeper) GetProposalsOfPolicy(ctx sdk.Context, addr sdk.AccAddress) []types.Proposal {
+	var proposals []types.Proposal
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetPolicyProposalsPrefix(addr)).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if proposal := k.GetProposal(ctx, binary.BigEndian.Uint64(iter.Key())); proposal != nil {
+			proposals = append(proposals, *proposal)
+		}
+	}
+	return proposals
+}
+
+// GetVotes returns the votes on the proposal
+func (k Keeper) GetVotes(ctx sdk.Context, proposalID uint64) []types.Vote {
+	var votes []types.Vote
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetVotesPrefix(proposalID)).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var vote types.Vote
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &vote)
+		votes = append(votes, vote)
+	}
+	return votes
+}
+
+// IterateGroups calls cb for all groups until cb returns true
+func (k Keeper) IterateGroups(ctx sdk.Context, cb func(types.Group) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.GroupPrefix).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var group types.Group
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &group)
+		if cb(group) {
+			return
+		}
+	}
+}
+
+// IteratePolicies calls cb for all group policies until cb returns true
+func (k Keeper) IteratePolicies(ctx sdk.Context, cb func(types.GroupPolicy) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.PolicyPrefix).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var policy types.GroupPolicy
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &policy)
+		if cb(policy) {
+			return
+		}
+	}
+}
@@ -0,0 +1,250 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/fetchai/fetchd/testutil"
+	"github.com/fetchai/fetchd/x/group/internal/types"
+)
+
+var (
+	alice = sdk.AccAddress([]byte("alice_______________"))
+	bob   = sdk.AccAddress([]byte("bob_________________"))
+	carol = sdk.AccAddress([]byte("carol_______________"))
+)
+
+func fet(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("afet", amount)) }
+
+func setupKeeper(t *testing.T) (sdk.Context, Keeper, *mockBank) {
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+	bank.RegisterCodec(cdc)
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	ctx, _ := testutil.NewContext(t, cdc, abci.Header{Height: 10}, key)
+	b := &mockBank{balances: map[string]sdk.Coins{types.PolicyAddress(1).String(): fet(100)}}
+	router := mockRouter{bank.RouterKey: b.handle}
+	return ctx, NewKeeper(cdc, key, router), b
+}
+
+// setupGroup creates a group of alice with weight 1, bob with weight 2 and carol with weight 1, and a policy that
+// accepts proposals with yes votes of weight 3 in 10 blocks
+func setupGroup(t *testing.T, ctx sdk.Context, k Keeper) (uint64, sdk.AccAddress) {
+	members := []types.Member{{Address: alice, Weight: 1}, {Address: bob, Weight: 2}, {Address: carol, Weight: 1}}
+	id, err := k.CreateGroup(ctx, alice, members, "")
+	require.NoError(t, err)
+	addr, err := k.CreateGroupPolicy(ctx, alice, id, "treasury", types.NewThresholdPolicy(3, 10))
+	require.NoError(t, err)
+	require.Equal(t, types.PolicyAddress(1), addr)
+	return id, addr
+}
+
+func TestUpdateGroupMembers(t *testing.T) {
+	specs := map[string]struct {
+		admin      sdk.AccAddress
+		updates    []types.Member
+		expMembers []types.Member
+		expErr     *sdkerrors.Error
+	}{
+		"add": {
+			admin:      alice,
+			updates:    []types.Member{{Address: carol, Weight: 3}},
+			expMembers: []types.Member{{Address: alice, Weight: 1}, {Address: bob, Weight: 2}, {Address: carol, Weight: 3}},
+		},
+		"update": {
+			admin:      alice,
+			updates:    []types.Member{{Address: bob, Weight: 5, Metadata: "treasurer"}},
+			expMembers: []types.Member{{Address: alice, Weight: 1}, {Address: bob, Weight: 5, Metadata: "treasurer"}},
+		},
+		"remove": {
+			admin:      alice,
+			updates:    []types.Member{{Address: alice}},
+			expMembers: []types.Member{{Address: bob, Weight: 2}},
+		},
+		"remove non member": {admin: alice, updates: []types.Member{{Address: carol}}, expErr: types.ErrNotMember},
+		"remove all":        {admin: alice, updates: []types.Member{{Address: alice}, {Address: bob}}, expErr: types.ErrInvalidMembers},
+		"not admin":         {admin: bob, updates: []types.Member{{Address: bob, Weight: 5}}, expErr: types.ErrNotAdmin},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, k, _ := setupKeeper(t)
+			id, err := k.CreateGroup(ctx, alice, []types.Member{{Address: alice, Weight: 1}, {Address: bob, Weight: 2}}, "")
+			require.NoError(t, err)
+			err = k.UpdateGroupMembers(ctx, spec.admin, id, spec.updates)
+			if spec.expErr != nil {
+				require.True(t, spec.expErr.Is(err), "got %v", err)
+				assert.Equal(t, uint64(0), k.GetGroup(ctx, id).Version)
+				return
+			}
+			require.NoError(t, err)
+			group := k.GetGroup(ctx, id)
+			assert.Equal(t, spec.expMembers, group.Members)
+			assert.Equal(t, uint64(1), group.Version)
+			for _, m := range []sdk.AccAddress{alice, bob, carol} {
+				assert.Equal(t, group.Weight(m) != 0, len(k.GetGroupsOfMember(ctx, m)) == 1, m.String())
+			}
+		})
+	}
+}
+
+func TestExec(t *testing.T) {
+	type vote struct {
+		voter  sdk.AccAddress
+		option types.VoteOption
+	}
+	specs := map[string]struct {
+		votes      []vote
+		execHeight int64
+		update     func(sdk.Context, Keeper, uint64, sdk.AccAddress) error
+		expErr     *sdkerrors.Error
+	}{
+		"accepted":           {votes: []vote{{alice, types.OptionYes}, {bob, types.OptionYes}}},
+		"accepted after end": {votes: []vote{{alice, types.OptionYes}, {bob, types.OptionYes}}, execHeight: 30},
+		"not accepted":       {votes: []vote{{bob, types.OptionYes}, {carol, types.OptionNo}}, expErr: types.ErrNotAccepted},
+		"abstain is not yes": {votes: []vote{{bob, types.OptionYes}, {alice, types.OptionAbstain}}, expErr: types.ErrNotAccepted},
+		"no votes":           {expErr: types.ErrNotAccepted},
+		"members changed": {
+			votes: []vote{{alice, types.OptionYes}, {bob, types.OptionYes}},
+			update: func(ctx sdk.Context, k Keeper, id uint64, _ sdk.AccAddress) error {
+				return k.UpdateGroupMembers(ctx, alice, id, []types.Member{{Address: carol}})
+			},
+			expErr: types.ErrAborted,
+		},
+		"decision policy changed": {
+			votes: []vote{{alice, types.OptionYes}, {bob, types.OptionYes}},
+			update: func(ctx sdk.Context, k Keeper, _ uint64, addr sdk.AccAddress) error {
+				return k.UpdateGroupPolicy(ctx, alice, addr, types.NewThresholdPolicy(1, 10), nil)
+			},
+			expErr: types.ErrAborted,
+		},
+		"admin changed": {
+			votes: []vote{{alice, types.OptionYes}, {bob, types.OptionYes}},
+			update: func(ctx sdk.Context, k Keeper, id uint64, addr sdk.AccAddress) error {
+				return k.UpdateGroupAdmin(ctx, alice, id, addr)
+			},
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, k, b := setupKeeper(t)
+			groupID, addr := setupGroup(t, ctx, k)
+			id, err := k.SubmitProposal(ctx, carol, addr, []sdk.Msg{bank.NewMsgSend(addr, carol, fet(10))}, "")
+			require.NoError(t, err)
+			assert.Equal(t, int64(20), k.GetProposal(ctx, id).VotingEndHeight)
+			for _, v := range spec.votes {
+				require.NoError(t, k.Vote(ctx, v.voter, id, v.option, ""))
+			}
+			if spec.update != nil {
+				require.NoError(t, spec.update(ctx, k, groupID, addr))
+			}
+			if spec.execHeight != 0 {
+				ctx = ctx.WithBlockHeight(spec.execHeight)
+			}
+			err = k.Exec(ctx, id)
+			if spec.expErr != nil {
+				require.True(t, spec.expErr.Is(err), "got %v", err)
+				assert.Equal(t, fet(100).String(), b.balances[addr.String()].String())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, fet(90).String(), b.balances[addr.String()].String())
+			assert.Equal(t, fet(10).String(), b.balances[carol.String()].String())
+			assert.True(t, k.GetProposal(ctx, id).Executed)
+			assert.True(t, types.ErrExecuted.Is(k.Exec(ctx, id)))
+		})
+	}
+}
+
+func TestFailedExec(t *testing.T) {
+	ctx, k, _ := setupKeeper(t)
+	_, addr := setupGroup(t, ctx, k)
+	id, err := k.SubmitProposal(ctx, alice, addr, []sdk.Msg{bank.NewMsgSend(addr, carol, fet(1000))}, "")
+	require.NoError(t, err)
+	require.NoError(t, k.Vote(ctx, bob, id, types.OptionYes, ""))
+	require.NoError(t, k.Vote(ctx, carol, id, types.OptionYes, ""))
+	assert.True(t, sdkerrors.ErrInsufficientFunds.Is(k.Exec(ctx, id)))
+}
+
+func TestSubmitAndVote(t *testing.T) {
+	ctx, k, _ := setupKeeper(t)
+	_, addr := setupGroup(t, ctx, k)
+	send := bank.NewMsgSend(addr, carol, fet(10))
+
+	_, err := k.SubmitProposal(ctx, sdk.AccAddress([]byte("other_______________")), addr, []sdk.Msg{send}, "")
+	assert.True(t, types.ErrNotMember.Is(err))
+	_, err = k.SubmitProposal(ctx, alice, carol, []sdk.Msg{send}, "")
+	assert.True(t, types.ErrPolicyNotFound.Is(err))
+	_, err = k.SubmitProposal(ctx, alice, addr, []sdk.Msg{bank.NewMsgSend(alice, carol, fet(10))}, "")
+	assert.True(t, types.ErrInvalidProposal.Is(err))
+
+	id, err := k.SubmitProposal(ctx, alice, addr, []sdk.Msg{send}, "pay carol")
+	require.NoError(t, err)
+	require.NoError(t, k.Vote(ctx, bob, id, types.OptionNo, "too much"))
+	assert.True(t, types.ErrAlreadyVoted.Is(k.Vote(ctx, bob, id, types.OptionYes, "")))
+	assert.True(t, types.ErrNotMember.Is(k.Vote(ctx, addr, id, types.OptionYes, "")))
+	assert.True(t, types.ErrProposalNotFound.Is(k.Vote(ctx, alice, id+1, types.OptionYes, "")))
+	assert.True(t, types.ErrVotingEnded.Is(k.Vote(ctx.WithBlockHeight(20), alice, id, types.OptionYes, "")))
+	require.NoError(t, k.Vote(ctx.WithBlockHeight(19), alice, id, types.OptionYes, ""))
+
+	assert.Equal(t, types.Tally{Yes: 1, No: 2}, k.GetProposal(ctx, id).Tally)
+	assert.Equal(t, []types.Vote{
+		{ProposalID: id, Voter: alice, Option: types.OptionYes, Weight: 1},
+		{ProposalID: id, Voter: bob, Option: types.OptionNo, Weight: 2, Metadata: "too much"},
+	}, k.GetVotes(ctx, id))
+	proposals := k.GetProposalsOfPolicy(ctx, addr)
+	require.Len(t, proposals, 1)
+	assert.Equal(t, []sdk.Msg{send}, proposals[0].Msgs)
+}
+
+func TestGenesis(t *testing.T) {
+	ctx, k, _ := setupKeeper(t)
+	groupID, addr := setupGroup(t, ctx, k)
+	_, err := k.SubmitProposal(ctx, alice, addr, []sdk.Msg{bank.NewMsgSend(addr, carol, fet(10))}, "")
+	require.NoError(t, err)
+	exp := ExportGenesis(ctx, k)
+	assert.Equal(t, uint64(1), exp.LastProposalID)
+	require.NoError(t, types.ValidateGenesis(exp))
+
+	newCtx, newK, _ := setupKeeper(t)
+	InitGenesis(newCtx, newK, exp)
+	assert.Equal(t, exp, ExportGenesis(newCtx, newK))
+	assert.Len(t, newK.GetGroupsOfMember(newCtx, bob), 1)
+	assert.Len(t, newK.GetPoliciesOfGroup(newCtx, groupID), 1)
+	id, err := newK.SubmitProposal(newCtx, alice, addr, []sdk.Msg{bank.NewMsgSend(addr, carol, fet(10))}, "")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), id)
+}
+
+type mockRouter map[string]sdk.Handler
+
+func (m mockRouter) AddRoute(path string, h sdk.Handler) sdk.Router {
+	m[path] = h
+	return m
+}
+
+func (m mockRouter) Route(_ sdk.Context, path string) sdk.Handler {
+	return m[path]
+}
+
+type mockBank struct {
+	balances map[string]sdk.Coins
+}
+
+func (m *mockBank) handle(_ sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+	send := msg.(bank.MsgSend)
+	balance, hasNeg := m.balances[send.FromAddress.String()].SafeSub(send.Amount)
+	if hasNeg {
+		return nil, sdkerrors.ErrInsufficientFunds
+	}
+	m.balances[send.FromAddress.String()] = balance
+	m.balances[send.ToAddress.String()] = m.balances[send.ToAddress.String()].Add(send.Amount...)
+	return &sdk.Result{}, nil
+}
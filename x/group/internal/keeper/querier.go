@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+const (
+	// QueryGroup returns a group, path: id
+	QueryGroup = "group"
+	// QueryGroupsOfMember returns the groups of a member, path: address
+	QueryGroupsOfMember = "groups-of-member"
+	// QueryPolicy returns a group policy, path: address
+	QueryPolicy = "policy"
+	// QueryPoliciesOfGroup returns the policies of a group, path: id
+	QueryPoliciesOfGroup = "policies-of-group"
+	// QueryProposal returns a proposal, path: id
+	QueryProposal = "proposal"
+	// QueryProposalsOfPolicy returns the proposals to a group policy, path: address
+	QueryProposalsOfPolicy = "proposals-of-policy"
+	// QueryVotes returns the votes on a proposal, path: id
+	QueryVotes = "votes"
+)
+
+// NewQuerier creates a new querier. The proposals are returned as amino json of the codec of the app, which knows
+// the msgs of all modules.
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		if len(path) < 2 {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown data query endpoint")
+		}
+		switch path[0] {
+		case QueryGroup:
+			id, err := parseID(path[1])
+			if err != nil {
+				return nil, err
+			}
+			group := keeper.GetGroup(ctx, id)
+			if group == nil {
+				return []byte("null"), nil
+			}
+			return marshal(group)
+		case QueryGroupsOfMember:
+			addr, err := sdk.AccAddressFromBech32(path[1])
+			if err != nil {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+			}
+			return marshal(keeper.GetGroupsOfMember(ctx, addr))
+		case QueryPolicy:
+			addr, err := sdk.AccAddressFromBech32(path[1])
+			if err != nil {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+			}
+			policy := keeper.GetPolicy(ctx, addr)
+			if policy == nil {
+				return []byte("null"), nil
+			}
+			return marshal(policy)
+		case QueryPoliciesOfGroup:
+			id, err := parseID(path[1])
+			if err != nil {
+				return nil, err
+			}
+			return marshal(keeper.GetPoliciesOfGroup(ctx, id))
+		case QueryProposal:
+			id, err := parseID(path[1])
+			if err != nil {
+				return nil, err
+			}
+			proposal := keeper.GetProposal(ctx, id)
+			if proposal == nil {
+				return []byte("null"), nil
+			}
+			return codec.MarshalJSONIndent(keeper.cdc, proposal)
+		case QueryProposalsOfPolicy:
+			addr, err := sdk.AccAddressFromBech32(path[1])
+			if err != nil {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+			}
+			return codec.MarshalJSONIndent(keeper.cdc, keeper.GetProposalsOfPolicy(ctx, addr))
+		case QueryVotes:
+			id, err := parseID(path[1])
+			if err != nil {
+				return nil, err
+			}
+			return marshal(keeper.GetVotes(ctx, id))
+		default:
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown data query endpoint")
+		}
+	}
+}
+
+func parseID(s string) (uint64, error) {
+	id, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "id")
+	}
+	return id, nil
+}
+
+func marshal(v interface{}) ([]byte, error) {
+	bz, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// msgSubmitProposalType is the amino type of MsgSubmitProposal
+const msgSubmitProposalType = "group/MsgSubmitProposal"
+
+// RegisterCodec registers the msg types of the group module
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgCreateGroup{}, "group/MsgCreateGroup", nil)
+	cdc.RegisterConcrete(MsgUpdateGroupMembers{}, "group/MsgUpdateGroupMembers", nil)
+	cdc.RegisterConcrete(MsgUpdateGroupAdmin{}, "group/MsgUpdateGroupAdmin", nil)
+	cdc.RegisterConcrete(MsgCreateGroupPolicy{}, "group/MsgCreateGroupPolicy", nil)
+	cdc.RegisterConcrete(MsgUpdateGroupPolicy{}, "group/MsgUpdateGroupPolicy", nil)
+	cdc.RegisterConcrete(MsgSubmitProposal{}, msgSubmitProposalType, nil)
+	cdc.RegisterConcrete(MsgVote{}, "group/MsgVote", nil)
+	cdc.RegisterConcrete(MsgExec{}, "group/MsgExec", nil)
+}
+
+// ModuleCdc generic sealed codec to be used throughout module. It does not know the msgs of other modules, the
+// proposals are encoded with the codec of the app.
+var ModuleCdc *codec.Codec
+
+func init() {
+	cdc := codec.New()
+	RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	ModuleCdc = cdc.Seal()
+}
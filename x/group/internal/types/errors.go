@@ -0,0 +1,37 @@
+package types
+
+import (
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Codes for group errors
+var (
+	DefaultCodespace = ModuleName
+
+	// ErrGroupNotFound error for a group that does not exist
+	ErrGroupNotFound = sdkErrors.Register(DefaultCodespace, 1, "group not found")
+	// ErrPolicyNotFound error for an account that is not a group policy
+	ErrPolicyNotFound = sdkErrors.Register(DefaultCodespace, 2, "group policy not found")
+	// ErrProposalNotFound error for a proposal that does not exist
+	ErrProposalNotFound = sdkErrors.Register(DefaultCodespace, 3, "proposal not found")
+	// ErrNotAdmin error for an update of a group or a group policy by an account that is not its admin
+	ErrNotAdmin = sdkErrors.Register(DefaultCodespace, 4, "not the admin")
+	// ErrNotMember error for a proposal or a vote of an account that is not a member of the group
+	ErrNotMember = sdkErrors.Register(DefaultCodespace, 5, "not a group member")
+	// ErrInvalidMembers error for a group without members, with duplicate members or members without weight
+	ErrInvalidMembers = sdkErrors.Register(DefaultCodespace, 6, "invalid members")
+	// ErrInvalidPolicy error for an invalid decision policy
+	ErrInvalidPolicy = sdkErrors.Register(DefaultCodespace, 7, "invalid decision policy")
+	// ErrVotingEnded error for a vote after the voting period of the proposal
+	ErrVotingEnded = sdkErrors.Register(DefaultCodespace, 8, "voting ended")
+	// ErrAlreadyVoted error for a second vote of a member on a proposal
+	ErrAlreadyVoted = sdkErrors.Register(DefaultCodespace, 9, "already voted")
+	// ErrNotAccepted error for the execution of a proposal that the decision policy does not accept
+	ErrNotAccepted = sdkErrors.Register(DefaultCodespace, 10, "proposal not accepted")
+	// ErrAborted error for a vote or an execution of a proposal whose group members or decision policy changed
+	ErrAborted = sdkErrors.Register(DefaultCodespace, 11, "proposal aborted")
+	// ErrExecuted error for the second execution of a proposal
+	ErrExecuted = sdkErrors.Register(DefaultCodespace, 12, "proposal already executed")
+	// ErrInvalidProposal error for a proposal with invalid msgs
+	ErrInvalidProposal = sdkErrors.Register(DefaultCodespace, 13, "invalid proposal")
+)
@@ -0,0 +1,55 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GenesisState is the genesis state of the group module. The proposals are not exported, the open proposals are
+// aborted by an export like by a change of their group.
+type GenesisState struct {
+	LastGroupID    uint64        `json:"last_group_id"`
+	LastPolicyID   uint64        `json:"last_policy_id"`
+	LastProposalID uint64        `json:"last_proposal_id"`
+	Groups         []Group       `json:"groups,omitempty"`
+	Policies       []GroupPolicy `json:"policies,omitempty"`
+}
+
+// DefaultGenesisState returns the genesis state without groups
+func DefaultGenesisState() GenesisState {
+	return GenesisState{}
+}
+
+// ValidateGenesis performs basic validation of the genesis state. The ids of the groups must not exceed the last
+// group id and the policies must be of groups of the genesis.
+func ValidateGenesis(data GenesisState) error {
+	groups := make(map[uint64]struct{}, len(data.Groups))
+	for _, g := range data.Groups {
+		if err := g.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "group %d", g.ID)
+		}
+		if g.ID > data.LastGroupID {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "group %d after last group id", g.ID)
+		}
+		if _, exists := groups[g.ID]; exists {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate group %d", g.ID)
+		}
+		groups[g.ID] = struct{}{}
+	}
+	if uint64(len(data.Policies)) > data.LastPolicyID {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "policies exceed last policy id")
+	}
+	policies := make(map[string]struct{}, len(data.Policies))
+	for _, p := range data.Policies {
+		if err := p.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "policy %s", p.Address)
+		}
+		if _, exists := groups[p.GroupID]; !exists {
+			return sdkerrors.Wrapf(ErrGroupNotFound, "policy %s of group %d", p.Address, p.GroupID)
+		}
+		if _, exists := policies[p.Address.String()]; exists {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate policy %s", p.Address)
+		}
+		policies[p.Address.String()] = struct{}{}
+	}
+	return nil
+}
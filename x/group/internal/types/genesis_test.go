@@ -0,0 +1,88 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	alice := sdk.AccAddress([]byte("alice_______________"))
+	bob := sdk.AccAddress([]byte("bob_________________"))
+	specs := map[string]struct {
+		mutate func(*GenesisState)
+		expErr bool
+	}{
+		"valid":                 {mutate: func(*GenesisState) {}},
+		"group after last id":   {mutate: func(g *GenesisState) { g.LastGroupID = 0 }, expErr: true},
+		"duplicate group":       {mutate: func(g *GenesisState) { g.Groups = append(g.Groups, g.Groups[0]) }, expErr: true},
+		"no members":            {mutate: func(g *GenesisState) { g.Groups[0].Members = nil }, expErr: true},
+		"member without weight": {mutate: func(g *GenesisState) { g.Groups[0].Members[1].Weight = 0 }, expErr: true},
+		"duplicate member": {
+			mutate: func(g *GenesisState) { g.Groups[0].Members[1].Address = alice },
+			expErr: true,
+		},
+		"policy after last id":  {mutate: func(g *GenesisState) { g.LastPolicyID = 0 }, expErr: true},
+		"policy of other group": {mutate: func(g *GenesisState) { g.Policies[0].GroupID = 2 }, expErr: true},
+		"policy without period": {mutate: func(g *GenesisState) { g.Policies[0].DecisionPolicy.VotingPeriod = 0 }, expErr: true},
+		"percentage and threshold": {
+			mutate: func(g *GenesisState) { g.Policies[0].DecisionPolicy.Percentage = sdk.NewDecWithPrec(5, 1) },
+			expErr: true,
+		},
+		"percentage exceeds one": {
+			mutate: func(g *GenesisState) { g.Policies[0].DecisionPolicy = NewPercentagePolicy(sdk.NewDec(2), 10) },
+			expErr: true,
+		},
+		"percentage policy": {mutate: func(g *GenesisState) {
+			g.Policies[0].DecisionPolicy = NewPercentagePolicy(sdk.NewDecWithPrec(5, 1), 10)
+		}},
+		"no policies": {mutate: func(g *GenesisState) { g.Policies = nil }},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			g := GenesisState{
+				LastGroupID:  1,
+				LastPolicyID: 1,
+				Groups: []Group{{
+					ID:      1,
+					Admin:   alice,
+					Members: []Member{{Address: alice, Weight: 1}, {Address: bob, Weight: 2}},
+				}},
+				Policies: []GroupPolicy{{
+					Address:        PolicyAddress(1),
+					GroupID:        1,
+					Admin:          alice,
+					DecisionPolicy: NewThresholdPolicy(2, 10),
+				}},
+			}
+			spec.mutate(&g)
+			err := ValidateGenesis(g)
+			if spec.expErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestDecisionPolicyAccepts(t *testing.T) {
+	specs := map[string]struct {
+		policy DecisionPolicy
+		tally  Tally
+		exp    bool
+	}{
+		"threshold reached":          {policy: NewThresholdPolicy(2, 10), tally: Tally{Yes: 2}, exp: true},
+		"threshold not reached":      {policy: NewThresholdPolicy(2, 10), tally: Tally{Yes: 1, No: 2}},
+		"threshold capped at total":  {policy: NewThresholdPolicy(10, 10), tally: Tally{Yes: 3}, exp: true},
+		"percentage reached":         {policy: NewPercentagePolicy(sdk.NewDecWithPrec(5, 1), 10), tally: Tally{Yes: 2}, exp: true},
+		"percentage not reached":     {policy: NewPercentagePolicy(sdk.NewDecWithPrec(5, 1), 10), tally: Tally{Yes: 1, Abstain: 2}},
+		"abstain counts for nothing": {policy: NewThresholdPolicy(1, 10), tally: Tally{No: 1, Abstain: 2}},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			assert.Equal(t, spec.exp, spec.policy.Accepts(spec.tally, 3))
+		})
+	}
+}
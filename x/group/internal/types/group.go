@@ -0,0 +1,99 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// MaxMetadataLength is the max length of the metadata of groups, members, policies, proposals and votes
+const MaxMetadataLength = 255
+
+// Member is a member of a group, the weight is its share of the votes
+type Member struct {
+	Address  sdk.AccAddress `json:"address" yaml:"address"`
+	Weight   uint64         `json:"weight" yaml:"weight"`
+	Metadata string         `json:"metadata,omitempty" yaml:"metadata"`
+}
+
+// Group is a set of weighted members administrated by the admin
+type Group struct {
+	ID       uint64         `json:"id" yaml:"id"`
+	Admin    sdk.AccAddress `json:"admin" yaml:"admin"`
+	Metadata string         `json:"metadata,omitempty" yaml:"metadata"`
+	Members  []Member       `json:"members" yaml:"members"`
+	// Version is increased with each update of the members, which aborts the proposals of the older versions
+	Version uint64 `json:"version" yaml:"version"`
+}
+
+// Validate validates the group
+func (g Group) Validate() error {
+	if g.ID == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "id must be positive")
+	}
+	if err := sdk.VerifyAddressFormat(g.Admin); err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+	if err := ValidateMetadata(g.Metadata); err != nil {
+		return err
+	}
+	if len(g.Members) == 0 {
+		return sdkerrors.Wrap(ErrInvalidMembers, "empty")
+	}
+	return ValidateMembers(g.Members)
+}
+
+// TotalWeight returns the sum of the weights of the members
+func (g Group) TotalWeight() uint64 {
+	var total uint64
+	for _, m := range g.Members {
+		total += m.Weight
+	}
+	return total
+}
+
+// Weight returns the weight of the member, 0 when the address is not a member
+func (g Group) Weight(addr sdk.AccAddress) uint64 {
+	for _, m := range g.Members {
+		if m.Address.Equals(addr) {
+			return m.Weight
+		}
+	}
+	return 0
+}
+
+// ValidateMembers validates that the members are unique and have a weight
+func ValidateMembers(members []Member) error {
+	seen := make(map[string]struct{}, len(members))
+	for _, m := range members {
+		if err := sdk.VerifyAddressFormat(m.Address); err != nil {
+			return sdkerrors.Wrap(err, "member")
+		}
+		if m.Weight == 0 {
+			return sdkerrors.Wrapf(ErrInvalidMembers, "%s without weight", m.Address)
+		}
+		if err := ValidateMetadata(m.Metadata); err != nil {
+			return sdkerrors.Wrapf(err, "member %s", m.Address)
+		}
+		if _, exists := seen[m.Address.String()]; exists {
+			return sdkerrors.Wrapf(ErrInvalidMembers, "duplicate %s", m.Address)
+		}
+		seen[m.Address.String()] = struct{}{}
+	}
+	return nil
+}
+
+// ValidateMetadata validates the length of metadata
+func ValidateMetadata(metadata string) error {
+	if len(metadata) > MaxMetadataLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "metadata exceeds %d bytes", MaxMetadataLength)
+	}
+	return nil
+}
+
+// PolicyAddress returns the account address of the group policy, derived from its id so that no key controls it
+func PolicyAddress(id uint64) sdk.AccAddress {
+	return sdk.AccAddress(crypto.AddressHash([]byte(fmt.Sprintf("%s/policy/%d", ModuleName, id))))
+}
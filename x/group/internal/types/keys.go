@@ -0,0 +1,115 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the group module
+	ModuleName = "group"
+
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for the group module
+	QuerierRoute = ModuleName
+
+	// RouterKey is the msg router key for the group module
+	RouterKey = ModuleName
+)
+
+const ( // event attributes
+	AttributeKeyGroupID       = "group_id"
+	AttributeKeyPolicyAddress = "policy_address"
+	AttributeKeyProposalID    = "proposal_id"
+	AttributeKeyVoter         = "voter"
+	AttributeKeyOption        = "option"
+)
+
+const (
+	// EventTypeCreateGroup is emitted when a group is created
+	EventTypeCreateGroup = "create_group"
+	// EventTypeUpdateGroup is emitted when the members or the admin of a group are updated
+	EventTypeUpdateGroup = "update_group"
+	// EventTypeCreatePolicy is emitted when a group policy account is created
+	EventTypeCreatePolicy = "create_group_policy"
+	// EventTypeUpdatePolicy is emitted when the decision policy or the admin of a group policy are updated
+	EventTypeUpdatePolicy = "update_group_policy"
+	// EventTypeSubmitProposal is emitted when a proposal is submitted to a group policy
+	EventTypeSubmitProposal = "submit_group_proposal"
+	// EventTypeVote is emitted when a member votes on a proposal
+	EventTypeVote = "group_vote"
+	// EventTypeExec is emitted when the msgs of an accepted proposal are executed
+	EventTypeExec = "exec_group_proposal"
+)
+
+// nolint
+var (
+	GroupPrefix          = []byte{0x01}
+	LastGroupIDKey       = []byte{0x02}
+	MemberIndexPrefix    = []byte{0x03}
+	PolicyPrefix         = []byte{0x04}
+	LastPolicyIDKey      = []byte{0x05}
+	GroupPolicyPrefix    = []byte{0x06}
+	ProposalPrefix       = []byte{0x07}
+	LastProposalIDKey    = []byte{0x08}
+	PolicyProposalPrefix = []byte{0x09}
+	VotePrefix           = []byte{0x0a}
+)
+
+// GetGroupKey returns the store key of the group
+func GetGroupKey(id uint64) []byte {
+	return append(GroupPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetMemberIndexPrefix returns the store key prefix of the groups of the member. The addresses are length prefixed so
+// that an address is not a prefix of a longer one.
+func GetMemberIndexPrefix(addr sdk.AccAddress) []byte {
+	return append(append(MemberIndexPrefix, byte(len(addr))), addr...)
+}
+
+// GetMemberIndexKey returns the store key of the group in the index of the member
+func GetMemberIndexKey(addr sdk.AccAddress, groupID uint64) []byte {
+	return append(GetMemberIndexPrefix(addr), sdk.Uint64ToBigEndian(groupID)...)
+}
+
+// GetPolicyKey returns the store key of the group policy of the account address
+func GetPolicyKey(addr sdk.AccAddress) []byte {
+	return append(PolicyPrefix, addr...)
+}
+
+// GetGroupPoliciesPrefix returns the store key prefix of the policies of the group
+func GetGroupPoliciesPrefix(groupID uint64) []byte {
+	return append(GroupPolicyPrefix, sdk.Uint64ToBigEndian(groupID)...)
+}
+
+// GetGroupPolicyKey returns the store key of the policy in the index of the group
+func GetGroupPolicyKey(groupID uint64, addr sdk.AccAddress) []byte {
+	return append(GetGroupPoliciesPrefix(groupID), addr...)
+}
+
+// GetProposalKey returns the store key of the proposal
+func GetProposalKey(id uint64) []byte {
+	return append(ProposalPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetPolicyProposalsPrefix returns the store key prefix of the proposals of the group policy. The addresses are length
+// prefixed so that an address is not a prefix of a longer one.
+func GetPolicyProposalsPrefix(addr sdk.AccAddress) []byte {
+	return append(append(PolicyProposalPrefix, byte(len(addr))), addr...)
+}
+
+// GetPolicyProposalKey returns the store key of the proposal in the index of the group policy
+func GetPolicyProposalKey(addr sdk.AccAddress, proposalID uint64) []byte {
+	return append(GetPolicyProposalsPrefix(addr), sdk.Uint64ToBigEndian(proposalID)...)
+}
+
+// GetVotesPrefix returns the store key prefix of the votes on the proposal
+func GetVotesPrefix(proposalID uint64) []byte {
+	return append(VotePrefix, sdk.Uint64ToBigEndian(proposalID)...)
+}
+
+// GetVoteKey returns the store key of the vote of the voter on the proposal
+func GetVoteKey(proposalID uint64, voter sdk.AccAddress) []byte {
+	return append(GetVotesPrefix(proposalID), voter...)
+}
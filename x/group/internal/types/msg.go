@@ -0,0 +1,320 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MsgCreateGroup creates a group of the members administrated by the admin
+type MsgCreateGroup struct {
+	Admin    sdk.AccAddress `json:"admin" yaml:"admin"`
+	Members  []Member       `json:"members" yaml:"members"`
+	Metadata string         `json:"metadata,omitempty" yaml:"metadata"`
+}
+
+func (msg MsgCreateGroup) Route() string {
+	return RouterKey
+}
+
+func (msg MsgCreateGroup) Type() string {
+	return "create-group"
+}
+
+func (msg MsgCreateGroup) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Admin); err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+	if len(msg.Members) == 0 {
+		return sdkerrors.Wrap(ErrInvalidMembers, "empty")
+	}
+	if err := ValidateMembers(msg.Members); err != nil {
+		return err
+	}
+	return ValidateMetadata(msg.Metadata)
+}
+
+func (msg MsgCreateGroup) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgCreateGroup) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
+
+// MsgUpdateGroupMembers adds, updates or, with a weight of 0, removes members of a group
+type MsgUpdateGroupMembers struct {
+	Admin         sdk.AccAddress `json:"admin" yaml:"admin"`
+	GroupID       uint64         `json:"group_id" yaml:"group_id"`
+	MemberUpdates []Member       `json:"member_updates" yaml:"member_updates"`
+}
+
+func (msg MsgUpdateGroupMembers) Route() string {
+	return RouterKey
+}
+
+func (msg MsgUpdateGroupMembers) Type() string {
+	return "update-group-members"
+}
+
+func (msg MsgUpdateGroupMembers) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Admin); err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+	if len(msg.MemberUpdates) == 0 {
+		return sdkerrors.Wrap(ErrInvalidMembers, "no updates")
+	}
+	seen := make(map[string]struct{}, len(msg.MemberUpdates))
+	for _, m := range msg.MemberUpdates {
+		if err := sdk.VerifyAddressFormat(m.Address); err != nil {
+			return sdkerrors.Wrap(err, "member")
+		}
+		if err := ValidateMetadata(m.Metadata); err != nil {
+			return sdkerrors.Wrapf(err, "member %s", m.Address)
+		}
+		if _, exists := seen[m.Address.String()]; exists {
+			return sdkerrors.Wrapf(ErrInvalidMembers, "duplicate %s", m.Address)
+		}
+		seen[m.Address.String()] = struct{}{}
+	}
+	return nil
+}
+
+func (msg MsgUpdateGroupMembers) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgUpdateGroupMembers) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
+
+// MsgUpdateGroupAdmin transfers the administration of a group to the new admin, e.g. to a policy of the group
+type MsgUpdateGroupAdmin struct {
+	Admin    sdk.AccAddress `json:"admin" yaml:"admin"`
+	GroupID  uint64         `json:"group_id" yaml:"group_id"`
+	NewAdmin sdk.AccAddress `json:"new_admin" yaml:"new_admin"`
+}
+
+func (msg MsgUpdateGroupAdmin) Route() string {
+	return RouterKey
+}
+
+func (msg MsgUpdateGroupAdmin) Type() string {
+	return "update-group-admin"
+}
+
+func (msg MsgUpdateGroupAdmin) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Admin); err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+	if err := sdk.VerifyAddressFormat(msg.NewAdmin); err != nil {
+		return sdkerrors.Wrap(err, "new admin")
+	}
+	return nil
+}
+
+func (msg MsgUpdateGroupAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgUpdateGroupAdmin) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
+
+// MsgCreateGroupPolicy creates a group policy account with the decision policy, administrated by the admin of the
+// group
+type MsgCreateGroupPolicy struct {
+	Admin          sdk.AccAddress `json:"admin" yaml:"admin"`
+	GroupID        uint64         `json:"group_id" yaml:"group_id"`
+	Metadata       string         `json:"metadata,omitempty" yaml:"metadata"`
+	DecisionPolicy DecisionPolicy `json:"decision_policy" yaml:"decision_policy"`
+}
+
+func (msg MsgCreateGroupPolicy) Route() string {
+	return RouterKey
+}
+
+func (msg MsgCreateGroupPolicy) Type() string {
+	return "create-group-policy"
+}
+
+func (msg MsgCreateGroupPolicy) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Admin); err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+	if err := ValidateMetadata(msg.Metadata); err != nil {
+		return err
+	}
+	return msg.DecisionPolicy.Validate()
+}
+
+func (msg MsgCreateGroupPolicy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgCreateGroupPolicy) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
+
+// MsgUpdateGroupPolicy replaces the decision policy of a group policy and optionally transfers its administration
+type MsgUpdateGroupPolicy struct {
+	Admin          sdk.AccAddress `json:"admin" yaml:"admin"`
+	Address        sdk.AccAddress `json:"address" yaml:"address"`
+	DecisionPolicy DecisionPolicy `json:"decision_policy" yaml:"decision_policy"`
+	// NewAdmin is the new admin of the group policy, the admin is kept when empty
+	NewAdmin sdk.AccAddress `json:"new_admin,omitempty" yaml:"new_admin"`
+}
+
+func (msg MsgUpdateGroupPolicy) Route() string {
+	return RouterKey
+}
+
+func (msg MsgUpdateGroupPolicy) Type() string {
+	return "update-group-policy"
+}
+
+func (msg MsgUpdateGroupPolicy) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Admin); err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+	if err := sdk.VerifyAddressFormat(msg.Address); err != nil {
+		return sdkerrors.Wrap(err, "address")
+	}
+	if !msg.NewAdmin.Empty() {
+		if err := sdk.VerifyAddressFormat(msg.NewAdmin); err != nil {
+			return sdkerrors.Wrap(err, "new admin")
+		}
+	}
+	return msg.DecisionPolicy.Validate()
+}
+
+func (msg MsgUpdateGroupPolicy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgUpdateGroupPolicy) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
+
+// MsgSubmitProposal proposes msgs signed by a group policy account to the members of its group
+type MsgSubmitProposal struct {
+	Proposer      sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	PolicyAddress sdk.AccAddress `json:"policy_address" yaml:"policy_address"`
+	Msgs          []sdk.Msg      `json:"msgs" yaml:"msgs"`
+	Metadata      string         `json:"metadata,omitempty" yaml:"metadata"`
+}
+
+func (msg MsgSubmitProposal) Route() string {
+	return RouterKey
+}
+
+func (msg MsgSubmitProposal) Type() string {
+	return "submit-proposal"
+}
+
+func (msg MsgSubmitProposal) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Proposer); err != nil {
+		return sdkerrors.Wrap(err, "proposer")
+	}
+	if err := sdk.VerifyAddressFormat(msg.PolicyAddress); err != nil {
+		return sdkerrors.Wrap(err, "policy address")
+	}
+	if err := ValidateMetadata(msg.Metadata); err != nil {
+		return err
+	}
+	return ValidateProposalMsgs(msg.PolicyAddress, msg.Msgs)
+}
+
+// submitProposalSignDoc is the amino json of MsgSubmitProposal with the sign bytes of the proposed msgs
+type submitProposalSignDoc struct {
+	Proposer      sdk.AccAddress    `json:"proposer"`
+	PolicyAddress sdk.AccAddress    `json:"policy_address"`
+	Msgs          []json.RawMessage `json:"msgs"`
+	Metadata      string            `json:"metadata,omitempty"`
+}
+
+// GetSignBytes returns the msg as amino json with sorted keys. The proposed msgs are msgs of any module, which the
+// codec of this module does not know, so they are included with their own sign bytes.
+func (msg MsgSubmitProposal) GetSignBytes() []byte {
+	doc := submitProposalSignDoc{Proposer: msg.Proposer, PolicyAddress: msg.PolicyAddress, Metadata: msg.Metadata}
+	for _, m := range msg.Msgs {
+		doc.Msgs = append(doc.Msgs, m.GetSignBytes())
+	}
+	bz, err := json.Marshal(struct {
+		Type  string                `json:"type"`
+		Value submitProposalSignDoc `json:"value"`
+	}{Type: msgSubmitProposalType, Value: doc})
+	if err != nil {
+		panic(fmt.Sprintf("marshal submit proposal: %s", err))
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgSubmitProposal) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Proposer}
+}
+
+// MsgVote votes with the weight of a member of the group on a proposal
+type MsgVote struct {
+	Voter      sdk.AccAddress `json:"voter" yaml:"voter"`
+	ProposalID uint64         `json:"proposal_id" yaml:"proposal_id"`
+	Option     VoteOption     `json:"option" yaml:"option"`
+	Metadata   string         `json:"metadata,omitempty" yaml:"metadata"`
+}
+
+func (msg MsgVote) Route() string {
+	return RouterKey
+}
+
+func (msg MsgVote) Type() string {
+	return "vote"
+}
+
+func (msg MsgVote) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Voter); err != nil {
+		return sdkerrors.Wrap(err, "voter")
+	}
+	if err := msg.Option.Validate(); err != nil {
+		return err
+	}
+	return ValidateMetadata(msg.Metadata)
+}
+
+func (msg MsgVote) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgVote) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Voter}
+}
+
+// MsgExec executes the msgs of an accepted proposal, any account can execute it
+type MsgExec struct {
+	Signer     sdk.AccAddress `json:"signer" yaml:"signer"`
+	ProposalID uint64         `json:"proposal_id" yaml:"proposal_id"`
+}
+
+func (msg MsgExec) Route() string {
+	return RouterKey
+}
+
+func (msg MsgExec) Type() string {
+	return "exec"
+}
+
+func (msg MsgExec) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Signer); err != nil {
+		return sdkerrors.Wrap(err, "signer")
+	}
+	return nil
+}
+
+func (msg MsgExec) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgExec) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
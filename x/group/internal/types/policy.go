@@ -0,0 +1,90 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DecisionPolicy decides when the members of a group accept a proposal. Either the threshold or the percentage is set.
+type DecisionPolicy struct {
+	// Threshold is the sum of the weights of the yes votes that accepts a proposal, capped at the total weight
+	Threshold uint64 `json:"threshold,omitempty" yaml:"threshold"`
+	// Percentage is the share of the total weight of the yes votes that accepts a proposal
+	Percentage sdk.Dec `json:"percentage,omitempty" yaml:"percentage"`
+	// VotingPeriod is the number of blocks the members can vote on a proposal
+	VotingPeriod int64 `json:"voting_period" yaml:"voting_period"`
+}
+
+// NewThresholdPolicy returns a decision policy that accepts proposals with yes votes of the threshold weight
+func NewThresholdPolicy(threshold uint64, votingPeriod int64) DecisionPolicy {
+	return DecisionPolicy{Threshold: threshold, Percentage: sdk.ZeroDec(), VotingPeriod: votingPeriod}
+}
+
+// NewPercentagePolicy returns a decision policy that accepts proposals with yes votes of the percentage of the total
+// weight
+func NewPercentagePolicy(percentage sdk.Dec, votingPeriod int64) DecisionPolicy {
+	return DecisionPolicy{Percentage: percentage, VotingPeriod: votingPeriod}
+}
+
+// hasPercentage returns true when the percentage is set
+func (p DecisionPolicy) hasPercentage() bool {
+	return !p.Percentage.IsNil() && !p.Percentage.IsZero()
+}
+
+// Validate validates the decision policy
+func (p DecisionPolicy) Validate() error {
+	if p.hasPercentage() == (p.Threshold != 0) {
+		return sdkerrors.Wrap(ErrInvalidPolicy, "either threshold or percentage required")
+	}
+	if p.hasPercentage() && (p.Percentage.IsNegative() || p.Percentage.GT(sdk.OneDec())) {
+		return sdkerrors.Wrap(ErrInvalidPolicy, "percentage must be in (0, 1]")
+	}
+	if p.VotingPeriod <= 0 {
+		return sdkerrors.Wrap(ErrInvalidPolicy, "voting period must be positive")
+	}
+	return nil
+}
+
+// Accepts returns true when the yes votes of the tally accept a proposal to a group of the total weight. The votes are
+// final, so a proposal accepted before the end of the voting period stays accepted.
+func (p DecisionPolicy) Accepts(tally Tally, totalWeight uint64) bool {
+	if totalWeight == 0 {
+		return false
+	}
+	if p.hasPercentage() {
+		return sdk.NewDecFromInt(sdk.NewIntFromUint64(tally.Yes)).GTE(p.Percentage.MulInt(sdk.NewIntFromUint64(totalWeight)))
+	}
+	threshold := p.Threshold
+	if threshold > totalWeight {
+		threshold = totalWeight
+	}
+	return tally.Yes >= threshold
+}
+
+// GroupPolicy is an account of a group that executes the proposals its decision policy accepts
+type GroupPolicy struct {
+	Address        sdk.AccAddress `json:"address" yaml:"address"`
+	GroupID        uint64         `json:"group_id" yaml:"group_id"`
+	Admin          sdk.AccAddress `json:"admin" yaml:"admin"`
+	Metadata       string         `json:"metadata,omitempty" yaml:"metadata"`
+	DecisionPolicy DecisionPolicy `json:"decision_policy" yaml:"decision_policy"`
+	// Version is increased with each update of the decision policy, which aborts the proposals of the older versions
+	Version uint64 `json:"version" yaml:"version"`
+}
+
+// Validate validates the group policy
+func (p GroupPolicy) Validate() error {
+	if err := sdk.VerifyAddressFormat(p.Address); err != nil {
+		return sdkerrors.Wrap(err, "address")
+	}
+	if p.GroupID == 0 {
+		return sdkerrors.Wrap(ErrGroupNotFound, "group id must be positive")
+	}
+	if err := sdk.VerifyAddressFormat(p.Admin); err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+	if err := ValidateMetadata(p.Metadata); err != nil {
+		return err
+	}
+	return p.DecisionPolicy.Validate()
+}
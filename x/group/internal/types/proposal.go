@@ -0,0 +1,94 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxProposalMsgs is the max number of msgs of a proposal
+const MaxProposalMsgs = 16
+
+// VoteOption is the option of a vote on a proposal
+type VoteOption string
+
+// nolint
+const (
+	OptionYes     VoteOption = "yes"
+	OptionNo      VoteOption = "no"
+	OptionAbstain VoteOption = "abstain"
+)
+
+// Validate validates the vote option
+func (o VoteOption) Validate() error {
+	switch o {
+	case OptionYes, OptionNo, OptionAbstain:
+		return nil
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "vote option %q", o)
+	}
+}
+
+// Tally is the sum of the weights of the votes of each option on a proposal
+type Tally struct {
+	Yes     uint64 `json:"yes" yaml:"yes"`
+	No      uint64 `json:"no" yaml:"no"`
+	Abstain uint64 `json:"abstain" yaml:"abstain"`
+}
+
+// Add returns the tally with the weight of a vote of the option added
+func (t Tally) Add(option VoteOption, weight uint64) Tally {
+	switch option {
+	case OptionYes:
+		t.Yes += weight
+	case OptionNo:
+		t.No += weight
+	case OptionAbstain:
+		t.Abstain += weight
+	}
+	return t
+}
+
+// Proposal proposes msgs that the group policy account executes when its decision policy accepts them. A change of
+// the members of the group or of the decision policy aborts the proposal.
+type Proposal struct {
+	ID              uint64         `json:"id" yaml:"id"`
+	PolicyAddress   sdk.AccAddress `json:"policy_address" yaml:"policy_address"`
+	Proposer        sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Metadata        string         `json:"metadata,omitempty" yaml:"metadata"`
+	Msgs            []sdk.Msg      `json:"msgs" yaml:"msgs"`
+	SubmitHeight    int64          `json:"submit_height" yaml:"submit_height"`
+	VotingEndHeight int64          `json:"voting_end_height" yaml:"voting_end_height"`
+	GroupVersion    uint64         `json:"group_version" yaml:"group_version"`
+	PolicyVersion   uint64         `json:"policy_version" yaml:"policy_version"`
+	Tally           Tally          `json:"tally" yaml:"tally"`
+	Executed        bool           `json:"executed" yaml:"executed"`
+}
+
+// Vote is the vote of a member on a proposal, with the weight of the member when it voted
+type Vote struct {
+	ProposalID uint64         `json:"proposal_id" yaml:"proposal_id"`
+	Voter      sdk.AccAddress `json:"voter" yaml:"voter"`
+	Option     VoteOption     `json:"option" yaml:"option"`
+	Weight     uint64         `json:"weight" yaml:"weight"`
+	Metadata   string         `json:"metadata,omitempty" yaml:"metadata"`
+}
+
+// ValidateProposalMsgs validates the msgs of a proposal, which must be signed by the group policy account only
+func ValidateProposalMsgs(policy sdk.AccAddress, msgs []sdk.Msg) error {
+	if len(msgs) == 0 {
+		return sdkerrors.Wrap(ErrInvalidProposal, "no msgs")
+	}
+	if len(msgs) > MaxProposalMsgs {
+		return sdkerrors.Wrapf(ErrInvalidProposal, "exceeds %d msgs", MaxProposalMsgs)
+	}
+	for i, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "msg %d", i)
+		}
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(policy) {
+			return sdkerrors.Wrapf(ErrInvalidProposal, "msg %d must be signed by the group policy only", i)
+		}
+	}
+	return nil
+}
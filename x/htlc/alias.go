@@ -0,0 +1,57 @@
+package htlc
+
+import (
+	"github.com/fetchai/fetchd/x/htlc/internal/keeper"
+	"github.com/fetchai/fetchd/x/htlc/internal/types"
+)
+
+const (
+	ModuleName               = types.ModuleName
+	StoreKey                 = types.StoreKey
+	QuerierRoute             = types.QuerierRoute
+	RouterKey                = types.RouterKey
+	DefaultParamspace        = types.DefaultParamspace
+	MaxPreimageLength        = types.MaxPreimageLength
+	AttributeKeySwapID       = types.AttributeKeySwapID
+	AttributeKeySender       = types.AttributeKeySender
+	AttributeKeyRecipient    = types.AttributeKeyRecipient
+	AttributeKeyHashLock     = types.AttributeKeyHashLock
+	AttributeKeyPreimage     = types.AttributeKeyPreimage
+	AttributeKeyExpiryHeight = types.AttributeKeyExpiryHeight
+	EventTypeCreate          = types.EventTypeCreate
+	EventTypeClaim           = types.EventTypeClaim
+	EventTypeRefund          = types.EventTypeRefund
+	QueryParams              = keeper.QueryParams
+	QuerySwap                = keeper.QuerySwap
+	QuerySwaps               = keeper.QuerySwaps
+)
+
+var (
+	// functions aliases
+	RegisterCodec       = types.RegisterCodec
+	ValidateGenesis     = types.ValidateGenesis
+	DefaultGenesisState = types.DefaultGenesisState
+	DefaultParams       = types.DefaultParams
+	NewKeeper           = keeper.NewKeeper
+	NewQuerier          = keeper.NewQuerier
+	InitGenesis         = keeper.InitGenesis
+	ExportGenesis       = keeper.ExportGenesis
+
+	// variable aliases
+	ModuleCdc          = types.ModuleCdc
+	ErrSwapNotFound    = types.ErrSwapNotFound
+	ErrInvalidPreimage = types.ErrInvalidPreimage
+	ErrExpired         = types.ErrExpired
+	ErrNotExpired      = types.ErrNotExpired
+	ErrInvalidTimeLock = types.ErrInvalidTimeLock
+)
+
+type (
+	Keeper        = keeper.Keeper
+	GenesisState  = types.GenesisState
+	Params        = types.Params
+	Swap          = types.Swap
+	MsgCreateSwap = types.MsgCreateSwap
+	MsgClaimSwap  = types.MsgClaimSwap
+	MsgRefundSwap = types.MsgRefundSwap
+)
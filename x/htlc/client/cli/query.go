@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/fetchai/fetchd/x/htlc/internal/keeper"
+	"github.com/fetchai/fetchd/x/htlc/internal/types"
+)
+
+// GetQueryCmd returns the query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the hash time locked swaps",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(flags.GetCommands(
+		GetCmdQueryParams(cdc),
+		GetCmdQuerySwap(cdc),
+		GetCmdQuerySwaps(cdc),
+	)...)
+	return queryCmd
+}
+
+// GetCmdQueryParams shows the htlc params
+func GetCmdQueryParams(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "Show the min and max time lock",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, keeper.QueryParams))
+			if err != nil {
+				return err
+			}
+			var params types.Params
+			if err := json.Unmarshal(res, &params); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
+
+// GetCmdQuerySwap shows a swap
+func GetCmdQuerySwap(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "swap [swap_id]",
+		Short: "Show the parties, the amount, the hash lock and the expiry height of an open swap",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("swap id: %s", err)
+			}
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%d", types.QuerierRoute, keeper.QuerySwap, id))
+			if err != nil {
+				return err
+			}
+			var swap *types.Swap
+			if err := json.Unmarshal(res, &swap); err != nil {
+				return err
+			}
+			if swap == nil {
+				return sdkerrors.Wrapf(types.ErrSwapNotFound, "%d", id)
+			}
+			return cliCtx.PrintOutput(swap)
+		},
+	}
+}
+
+// GetCmdQuerySwaps lists the swaps of an account
+func GetCmdQuerySwaps(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "swaps [address]",
+		Short: "List the open swaps an account is the sender or the recipient of",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, keeper.QuerySwaps, addr))
+			if err != nil {
+				return err
+			}
+			var swaps []types.Swap
+			if err := json.Unmarshal(res, &swaps); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(swaps)
+		},
+	}
+}
@@ -0,0 +1,137 @@
+package cli
+
+import (
+	"bufio"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	"github.com/fetchai/fetchd/x/htlc/internal/types"
+	wasmUtils "github.com/fetchai/fetchd/x/wasm/client/utils"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Hash time locked swap transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	txCmd.AddCommand(wasmUtils.AddBroadcastFlags(flags.PostCommands(
+		CreateSwapCmd(cdc),
+		ClaimSwapCmd(cdc),
+		RefundSwapCmd(cdc),
+	)...)...)
+	return txCmd
+}
+
+// CreateSwapCmd locks an amount of the --from account in a swap
+func CreateSwapCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "create [recipient] [amount] [hash_lock_hex] [time_lock_blocks]",
+		Short: "Lock an amount of the --from account for the recipient with the sha256 hash lock",
+		Long: `Lock the amount for the recipient, who is paid when the preimage of the hex encoded sha256 hash lock is revealed
+within the time lock. After it the amount can be refunded to the --from account. The id of the swap is in the
+create_swap event. The time lock must be within the min and max time lock params.`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			recipient, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("recipient: %s", err)
+			}
+			amount, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+			hashLock, err := hex.DecodeString(args[2])
+			if err != nil {
+				return fmt.Errorf("hash lock: %s", err)
+			}
+			timeLock, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return fmt.Errorf("time lock: %s", err)
+			}
+			msg := types.MsgCreateSwap{
+				Sender:    cliCtx.GetFromAddress(),
+				Recipient: recipient,
+				Amount:    amount,
+				HashLock:  hashLock,
+				TimeLock:  timeLock,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// ClaimSwapCmd claims a swap for its recipient
+func ClaimSwapCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "claim [swap_id] [preimage_hex]",
+		Short: "Pay a swap to its recipient with the hex encoded preimage of its hash lock",
+		Long: `Pay the swap to its recipient before it expires. Any account can claim a swap, the preimage is revealed in the
+claim_swap event for the counterparty.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("swap id: %s", err)
+			}
+			preimage, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("preimage: %s", err)
+			}
+			msg := types.MsgClaimSwap{Sender: cliCtx.GetFromAddress(), SwapID: id, Preimage: preimage}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// RefundSwapCmd refunds an expired swap to its sender
+func RefundSwapCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "refund [swap_id]",
+		Short: "Refund an expired swap to its sender, any account can refund",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("swap id: %s", err)
+			}
+			msg := types.MsgRefundSwap{Sender: cliCtx.GetFromAddress(), SwapID: id}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
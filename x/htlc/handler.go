@@ -0,0 +1,63 @@
+package htlc
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewHandler returns a handler for "htlc" type messages.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case MsgCreateSwap:
+			return handleCreateSwap(ctx, k, &msg)
+		case MsgClaimSwap:
+			return handleClaimSwap(ctx, k, &msg)
+		case MsgRefundSwap:
+			return handleRefundSwap(ctx, k, &msg)
+		default:
+			errMsg := fmt.Sprintf("unrecognized htlc message type: %T", msg)
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+		}
+	}
+}
+
+func handleCreateSwap(ctx sdk.Context, k Keeper, msg *MsgCreateSwap) (*sdk.Result, error) {
+	swap, err := k.Create(ctx, *msg)
+	if err != nil {
+		return nil, err
+	}
+	return result(ctx, msg.Sender, swap.ID), nil
+}
+
+func handleClaimSwap(ctx sdk.Context, k Keeper, msg *MsgClaimSwap) (*sdk.Result, error) {
+	if err := k.Claim(ctx, msg.SwapID, msg.Preimage); err != nil {
+		return nil, err
+	}
+	return result(ctx, msg.Sender, msg.SwapID), nil
+}
+
+func handleRefundSwap(ctx sdk.Context, k Keeper, msg *MsgRefundSwap) (*sdk.Result, error) {
+	if err := k.Refund(ctx, msg.SwapID); err != nil {
+		return nil, err
+	}
+	return result(ctx, msg.Sender, msg.SwapID), nil
+}
+
+func result(ctx sdk.Context, sender sdk.AccAddress, id uint64) *sdk.Result {
+	events := ctx.EventManager().Events()
+	ourEvent := sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(AttributeKeySwapID, strconv.FormatUint(id, 10)),
+	)
+	return &sdk.Result{
+		Events: append(events, ourEvent),
+	}
+}
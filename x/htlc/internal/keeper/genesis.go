@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fetchai/fetchd/x/htlc/internal/types"
+)
+
+// InitGenesis stores the params, the last swap id and the open swaps of the genesis
+func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
+	keeper.setParams(ctx, data.Params)
+	keeper.setLastSwapID(ctx, data.LastSwapID)
+	for _, s := range data.Swaps {
+		keeper.setSwap(ctx, s)
+	}
+}
+
+// ExportGenesis returns the params, the last swap id and the open swaps as genesis state
+func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
+	data := types.GenesisState{Params: keeper.GetParams(ctx), LastSwapID: keeper.GetLastSwapID(ctx)}
+	keeper.IterateSwaps(ctx, func(s types.Swap) bool {
+		data.Swaps = append(data.Swaps, s)
+		return false
+	})
+	return data
+}
@@ -0,0 +1,192 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	"github.com/fetchai/fetchd/x/htlc/internal/types"
+)
+
+// Keeper keeps the open hash time locked swaps. Their amounts are held by the module account until they are claimed
+// or refunded.
+type Keeper struct {
+	storeKey     sdk.StoreKey
+	cdc          *codec.Codec
+	paramSpace   params.Subspace
+	supplyKeeper types.SupplyKeeper
+}
+
+// NewKeeper creates a new htlc Keeper instance
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, paramSpace params.Subspace, supplyKeeper types.SupplyKeeper) Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+	return Keeper{
+		storeKey:     storeKey,
+		cdc:          cdc,
+		paramSpace:   paramSpace,
+		supplyKeeper: supplyKeeper,
+	}
+}
+
+// GetParams returns the total set of htlc parameters.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	var params types.Params
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+func (k Keeper) setParams(ctx sdk.Context, ps types.Params) {
+	k.paramSpace.SetParamSet(ctx, &ps)
+}
+
+// Create locks the amount of the sender in a swap to the recipient and returns it. The time lock must be within the
+// bounds of the params.
+func (k Keeper) Create(ctx sdk.Context, msg types.MsgCreateSwap) (types.Swap, error) {
+	params := k.GetParams(ctx)
+	if msg.TimeLock < params.MinTimeLock || msg.TimeLock > params.MaxTimeLock {
+		return types.Swap{}, sdkerrors.Wrapf(types.ErrInvalidTimeLock, "must be %d to %d blocks", params.MinTimeLock, params.MaxTimeLock)
+	}
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, msg.Sender, types.ModuleName, msg.Amount); err != nil {
+		return types.Swap{}, err
+	}
+	swap := types.Swap{
+		ID:           k.GetLastSwapID(ctx) + 1,
+		Sender:       msg.Sender,
+		Recipient:    msg.Recipient,
+		Amount:       msg.Amount,
+		HashLock:     msg.HashLock,
+		ExpiryHeight: ctx.BlockHeight() + msg.TimeLock,
+	}
+	k.setLastSwapID(ctx, swap.ID)
+	k.setSwap(ctx, swap)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCreate,
+		sdk.NewAttribute(types.AttributeKeySwapID, strconv.FormatUint(swap.ID, 10)),
+		sdk.NewAttribute(types.AttributeKeySender, swap.Sender.String()),
+		sdk.NewAttribute(types.AttributeKeyRecipient, swap.Recipient.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, swap.Amount.String()),
+		sdk.NewAttribute(types.AttributeKeyHashLock, swap.HashLock.String()),
+		sdk.NewAttribute(types.AttributeKeyExpiryHeight, strconv.FormatInt(swap.ExpiryHeight, 10)),
+	))
+	return swap, nil
+}
+
+// Claim pays the amount of the swap to its recipient when the preimage unlocks it before the expiry height. The
+// preimage is emitted for the counterparty of the swap.
+func (k Keeper) Claim(ctx sdk.Context, id uint64, preimage []byte) error {
+	swap := k.GetSwap(ctx, id)
+	if swap == nil {
+		return sdkerrors.Wrapf(types.ErrSwapNotFound, "%d", id)
+	}
+	if ctx.BlockHeight() >= swap.ExpiryHeight {
+		return sdkerrors.Wrapf(types.ErrExpired, "at height %d", swap.ExpiryHeight)
+	}
+	if !swap.Unlocks(preimage) {
+		return types.ErrInvalidPreimage
+	}
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, swap.Recipient, swap.Amount); err != nil {
+		return err
+	}
+	k.deleteSwap(ctx, *swap)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeClaim,
+		sdk.NewAttribute(types.AttributeKeySwapID, strconv.FormatUint(id, 10)),
+		sdk.NewAttribute(types.AttributeKeyRecipient, swap.Recipient.String()),
+		sdk.NewAttribute(types.AttributeKeyHashLock, swap.HashLock.String()),
+		sdk.NewAttribute(types.AttributeKeyPreimage, tmbytes.HexBytes(preimage).String()),
+	))
+	return nil
+}
+
+// Refund refunds the amount of the swap to its sender at or after the expiry height
+func (k Keeper) Refund(ctx sdk.Context, id uint64) error {
+	swap := k.GetSwap(ctx, id)
+	if swap == nil {
+		return sdkerrors.Wrapf(types.ErrSwapNotFound, "%d", id)
+	}
+	if ctx.BlockHeight() < swap.ExpiryHeight {
+		return sdkerrors.Wrapf(types.ErrNotExpired, "until height %d", swap.ExpiryHeight)
+	}
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, swap.Sender, swap.Amount); err != nil {
+		return err
+	}
+	k.deleteSwap(ctx, *swap)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRefund,
+		sdk.NewAttribute(types.AttributeKeySwapID, strconv.FormatUint(id, 10)),
+		sdk.NewAttribute(types.AttributeKeySender, swap.Sender.String()),
+	))
+	return nil
+}
+
+// GetLastSwapID returns the id of the last created swap, 0 when none was created
+func (k Keeper) GetLastSwapID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.LastSwapIDKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setLastSwapID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.LastSwapIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetSwap returns the open swap, nil when there is none
+func (k Keeper) GetSwap(ctx sdk.Context, id uint64) *types.Swap {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetSwapKey(id))
+	if bz == nil {
+		return nil
+	}
+	var swap types.Swap
+	k.cdc.MustUnmarshalBinaryBare(bz, &swap)
+	return &swap
+}
+
+func (k Keeper) setSwap(ctx sdk.Context, swap types.Swap) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetSwapKey(swap.ID), k.cdc.MustMarshalBinaryBare(swap))
+	store.Set(types.GetPartyIndexKey(swap.Sender, swap.ID), []byte{})
+	store.Set(types.GetPartyIndexKey(swap.Recipient, swap.ID), []byte{})
+}
+
+func (k Keeper) deleteSwap(ctx sdk.Context, swap types.Swap) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetSwapKey(swap.ID))
+	store.Delete(types.GetPartyIndexKey(swap.Sender, swap.ID))
+	store.Delete(types.GetPartyIndexKey(swap.Recipient, swap.ID))
+}
+
+// GetPartySwaps returns the open swaps the account is the sender or the recipient of, ordered by id
+func (k Keeper) GetPartySwaps(ctx sdk.Context, addr sdk.AccAddress) []types.Swap {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetPartyIndexPrefix(addr)).Iterator(nil, nil)
+	defer iter.Close()
+	swaps := []types.Swap{}
+	for ; iter.Valid(); iter.Next() {
+		if swap := k.GetSwap(ctx, binary.BigEndian.Uint64(iter.Key())); swap != nil {
+			swaps = append(swaps, *swap)
+		}
+	}
+	return swaps
+}
+
+// IterateSwaps calls cb for all open swaps, including the expired ones that are not refunded, until cb returns true
+func (k Keeper) IterateSwaps(ctx sdk.Context, cb func(types.Swap) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.SwapPrefix).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var swap types.Swap
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &swap)
+		if cb(swap) {
+			return
+		}
+	}
+}
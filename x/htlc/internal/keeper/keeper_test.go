@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/fetchai/fetchd/testutil"
+	"github.com/fetchai/fetchd/x/htlc/internal/types"
+)
+
+var (
+	sender    = sdk.AccAddress([]byte("sender______________"))
+	recipient = sdk.AccAddress([]byte("recipient___________"))
+	preimage  = []byte("secret")
+	hashLock  = sha256.Sum256(preimage)
+)
+
+func fet(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("afet", amount)) }
+
+func setupKeeper(t *testing.T) (sdk.Context, Keeper, *testutil.SupplyKeeper) {
+	cdc := codec.New()
+	types.RegisterCodec(cdc)
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	ctx, paramsKeeper := testutil.NewContext(t, cdc, abci.Header{Height: 10}, key)
+	sk := testutil.NewSupplyKeeper(map[string]sdk.Coins{sender.String(): fet(100)})
+	k := NewKeeper(cdc, key, paramsKeeper.Subspace(types.DefaultParamspace), sk)
+	InitGenesis(ctx, k, types.GenesisState{Params: types.Params{MinTimeLock: 10, MaxTimeLock: 100}})
+	return ctx, k, sk
+}
+
+func create(timeLock int64) types.MsgCreateSwap {
+	return types.MsgCreateSwap{Sender: sender, Recipient: recipient, Amount: fet(60), HashLock: hashLock[:], TimeLock: timeLock}
+}
+
+func TestCreate(t *testing.T) {
+	specs := map[string]struct {
+		src    types.MsgCreateSwap
+		expErr *sdkerrors.Error
+	}{
+		"min time lock":       {src: create(10)},
+		"max time lock":       {src: create(100)},
+		"time lock too short": {src: create(9), expErr: types.ErrInvalidTimeLock},
+		"time lock too long":  {src: create(101), expErr: types.ErrInvalidTimeLock},
+		"insufficient funds": {
+			src:    types.MsgCreateSwap{Sender: sender, Recipient: recipient, Amount: fet(101), HashLock: hashLock[:], TimeLock: 10},
+			expErr: sdkerrors.ErrInsufficientFunds,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, k, sk := setupKeeper(t)
+			swap, err := k.Create(ctx, spec.src)
+			if spec.expErr != nil {
+				require.True(t, spec.expErr.Is(err), "got %v", err)
+				assert.Nil(t, k.GetSwap(ctx, 1))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, uint64(1), swap.ID)
+			assert.Equal(t, 10+spec.src.TimeLock, swap.ExpiryHeight)
+			assert.Equal(t, &swap, k.GetSwap(ctx, 1))
+			assert.Equal(t, []types.Swap{swap}, k.GetPartySwaps(ctx, sender))
+			assert.Equal(t, []types.Swap{swap}, k.GetPartySwaps(ctx, recipient))
+			assert.Equal(t, fet(60).String(), sk.Balances[testutil.ModuleKey(types.ModuleName)].String())
+		})
+	}
+}
+
+func TestClaimAndRefund(t *testing.T) {
+	specs := map[string]struct {
+		do           func(sdk.Context, Keeper) error
+		expErr       *sdkerrors.Error
+		expRecipient sdk.Coins
+		expSender    sdk.Coins
+	}{
+		"claim": {
+			do:           func(ctx sdk.Context, k Keeper) error { return k.Claim(ctx.WithBlockHeight(29), 1, preimage) },
+			expRecipient: fet(60),
+			expSender:    fet(40),
+		},
+		"claim with other preimage": {
+			do:     func(ctx sdk.Context, k Keeper) error { return k.Claim(ctx, 1, []byte("other")) },
+			expErr: types.ErrInvalidPreimage,
+		},
+		"claim expired": {
+			do:     func(ctx sdk.Context, k Keeper) error { return k.Claim(ctx.WithBlockHeight(30), 1, preimage) },
+			expErr: types.ErrExpired,
+		},
+		"claim twice": {
+			do: func(ctx sdk.Context, k Keeper) error {
+				require.NoError(t, k.Claim(ctx, 1, preimage))
+				return k.Claim(ctx, 1, preimage)
+			},
+			expErr: types.ErrSwapNotFound,
+		},
+		"refund expired": {
+			do:        func(ctx sdk.Context, k Keeper) error { return k.Refund(ctx.WithBlockHeight(30), 1) },
+			expSender: fet(100),
+		},
+		"refund not expired": {
+			do:     func(ctx sdk.Context, k Keeper) error { return k.Refund(ctx.WithBlockHeight(29), 1) },
+			expErr: types.ErrNotExpired,
+		},
+		"refund claimed": {
+			do: func(ctx sdk.Context, k Keeper) error {
+				require.NoError(t, k.Claim(ctx, 1, preimage))
+				return k.Refund(ctx.WithBlockHeight(30), 1)
+			},
+			expErr: types.ErrSwapNotFound,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, k, sk := setupKeeper(t)
+			_, err := k.Create(ctx, create(20))
+			require.NoError(t, err)
+
+			err = spec.do(ctx, k)
+			if spec.expErr != nil {
+				require.True(t, spec.expErr.Is(err), "got %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Nil(t, k.GetSwap(ctx, 1))
+			assert.Empty(t, k.GetPartySwaps(ctx, sender))
+			assert.Equal(t, spec.expRecipient.String(), sk.Balances[recipient.String()].String())
+			assert.Equal(t, spec.expSender.String(), sk.Balances[sender.String()].String())
+			assert.True(t, sk.Balances[testutil.ModuleKey(types.ModuleName)].IsZero())
+		})
+	}
+}
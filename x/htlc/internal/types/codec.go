@@ -0,0 +1,22 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers the msg types of the htlc module
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgCreateSwap{}, "htlc/MsgCreateSwap", nil)
+	cdc.RegisterConcrete(MsgClaimSwap{}, "htlc/MsgClaimSwap", nil)
+	cdc.RegisterConcrete(MsgRefundSwap{}, "htlc/MsgRefundSwap", nil)
+}
+
+// ModuleCdc generic sealed codec to be used throughout module
+var ModuleCdc *codec.Codec
+
+func init() {
+	cdc := codec.New()
+	RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	ModuleCdc = cdc.Seal()
+}
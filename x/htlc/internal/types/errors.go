@@ -0,0 +1,21 @@
+package types
+
+import (
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Codes for htlc errors
+var (
+	DefaultCodespace = ModuleName
+
+	// ErrSwapNotFound error for a swap that is not open
+	ErrSwapNotFound = sdkErrors.Register(DefaultCodespace, 1, "swap not found")
+	// ErrInvalidPreimage error for a preimage that does not match the hash lock of the swap
+	ErrInvalidPreimage = sdkErrors.Register(DefaultCodespace, 2, "invalid preimage")
+	// ErrExpired error for a claim of an expired swap
+	ErrExpired = sdkErrors.Register(DefaultCodespace, 3, "swap expired")
+	// ErrNotExpired error for a refund of a swap that did not expire
+	ErrNotExpired = sdkErrors.Register(DefaultCodespace, 4, "swap not expired")
+	// ErrInvalidTimeLock error for a time lock out of the bounds of the params
+	ErrInvalidTimeLock = sdkErrors.Register(DefaultCodespace, 5, "invalid time lock")
+)
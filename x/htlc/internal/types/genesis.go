@@ -0,0 +1,39 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GenesisState is the genesis state of the htlc module
+type GenesisState struct {
+	Params     Params `json:"params"`
+	LastSwapID uint64 `json:"last_swap_id"`
+	Swaps      []Swap `json:"swaps,omitempty"`
+}
+
+// DefaultGenesisState returns the genesis state without swaps
+func DefaultGenesisState() GenesisState {
+	return GenesisState{Params: DefaultParams()}
+}
+
+// ValidateGenesis performs basic validation of the genesis state. The ids of the swaps must not exceed the last swap
+// id.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "params")
+	}
+	ids := make(map[uint64]struct{}, len(data.Swaps))
+	for _, s := range data.Swaps {
+		if err := s.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "swap %d", s.ID)
+		}
+		if s.ID > data.LastSwapID {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "swap %d after last swap id", s.ID)
+		}
+		if _, exists := ids[s.ID]; exists {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate swap %d", s.ID)
+		}
+		ids[s.ID] = struct{}{}
+	}
+	return nil
+}
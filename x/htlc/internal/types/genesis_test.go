@@ -0,0 +1,48 @@
+package types
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	hash := sha256.Sum256([]byte("secret"))
+	swap := Swap{
+		ID:           1,
+		Sender:       sdk.AccAddress([]byte("sender______________")),
+		Recipient:    sdk.AccAddress([]byte("recipient___________")),
+		Amount:       sdk.NewCoins(sdk.NewInt64Coin("afet", 100)),
+		HashLock:     hash[:],
+		ExpiryHeight: 100,
+	}
+	specs := map[string]struct {
+		mutate func(*GenesisState)
+		expErr bool
+	}{
+		"valid":            {mutate: func(*GenesisState) {}},
+		"id after last id": {mutate: func(g *GenesisState) { g.LastSwapID = 0 }, expErr: true},
+		"duplicate id":     {mutate: func(g *GenesisState) { g.Swaps = append(g.Swaps, swap) }, expErr: true},
+		"short hash lock":  {mutate: func(g *GenesisState) { g.Swaps[0].HashLock = hash[:16] }, expErr: true},
+		"no amount":        {mutate: func(g *GenesisState) { g.Swaps[0].Amount = nil }, expErr: true},
+		"min exceeds max":  {mutate: func(g *GenesisState) { g.Params.MinTimeLock = g.Params.MaxTimeLock + 1 }, expErr: true},
+		"no expiry height": {mutate: func(g *GenesisState) { g.Swaps[0].ExpiryHeight = 0 }, expErr: true},
+		"no swaps":         {mutate: func(g *GenesisState) { g.Swaps = nil }},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			g := GenesisState{Params: DefaultParams(), LastSwapID: 1, Swaps: []Swap{swap}}
+			spec.mutate(&g)
+			err := ValidateGenesis(g)
+			if spec.expErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+	assert.True(t, swap.Unlocks([]byte("secret")))
+	assert.False(t, swap.Unlocks([]byte("other")))
+}
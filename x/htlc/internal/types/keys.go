@@ -0,0 +1,60 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the htlc module
+	ModuleName = "htlc"
+
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for the htlc module
+	QuerierRoute = ModuleName
+
+	// RouterKey is the msg router key for the htlc module
+	RouterKey = ModuleName
+)
+
+const ( // event attributes
+	AttributeKeySwapID       = "swap_id"
+	AttributeKeySender       = "sender"
+	AttributeKeyRecipient    = "recipient"
+	AttributeKeyHashLock     = "hash_lock"
+	AttributeKeyPreimage     = "preimage"
+	AttributeKeyExpiryHeight = "expiry_height"
+)
+
+const (
+	// EventTypeCreate is emitted when a swap is created
+	EventTypeCreate = "create_swap"
+	// EventTypeClaim is emitted when a swap is claimed, with the preimage for the counterparty of the swap
+	EventTypeClaim = "claim_swap"
+	// EventTypeRefund is emitted when an expired swap is refunded to its sender
+	EventTypeRefund = "refund_swap"
+)
+
+// nolint
+var (
+	SwapPrefix       = []byte{0x01}
+	LastSwapIDKey    = []byte{0x02}
+	PartyIndexPrefix = []byte{0x03}
+)
+
+// GetSwapKey returns the store key of the swap
+func GetSwapKey(id uint64) []byte {
+	return append(SwapPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetPartyIndexPrefix returns the store key prefix of the swaps the account is the sender or the recipient of. The
+// addresses are length prefixed so that an address is not a prefix of a longer one.
+func GetPartyIndexPrefix(addr sdk.AccAddress) []byte {
+	return append(append(PartyIndexPrefix, byte(len(addr))), addr...)
+}
+
+// GetPartyIndexKey returns the store key of the swap in the index of the account
+func GetPartyIndexKey(addr sdk.AccAddress, id uint64) []byte {
+	return append(GetPartyIndexPrefix(addr), sdk.Uint64ToBigEndian(id)...)
+}
@@ -0,0 +1,111 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+)
+
+// MsgCreateSwap locks the amount of the sender for the recipient with the hash lock for time lock blocks
+type MsgCreateSwap struct {
+	Sender    sdk.AccAddress   `json:"sender" yaml:"sender"`
+	Recipient sdk.AccAddress   `json:"recipient" yaml:"recipient"`
+	Amount    sdk.Coins        `json:"amount" yaml:"amount"`
+	HashLock  tmbytes.HexBytes `json:"hash_lock" yaml:"hash_lock"`
+	// TimeLock is the number of blocks until the swap expires and can be refunded
+	TimeLock int64 `json:"time_lock" yaml:"time_lock"`
+}
+
+func (msg MsgCreateSwap) Route() string {
+	return RouterKey
+}
+
+func (msg MsgCreateSwap) Type() string {
+	return "create-swap"
+}
+
+func (msg MsgCreateSwap) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if err := sdk.VerifyAddressFormat(msg.Recipient); err != nil {
+		return sdkerrors.Wrap(err, "recipient")
+	}
+	if !msg.Amount.IsValid() || msg.Amount.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+	if err := ValidateHashLock(msg.HashLock); err != nil {
+		return err
+	}
+	if msg.TimeLock <= 0 {
+		return sdkerrors.Wrap(ErrInvalidTimeLock, "must be positive")
+	}
+	return nil
+}
+
+func (msg MsgCreateSwap) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgCreateSwap) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgClaimSwap pays the amount of the swap to its recipient with the preimage of the hash lock, any account can claim
+type MsgClaimSwap struct {
+	Sender   sdk.AccAddress   `json:"sender" yaml:"sender"`
+	SwapID   uint64           `json:"swap_id" yaml:"swap_id"`
+	Preimage tmbytes.HexBytes `json:"preimage" yaml:"preimage"`
+}
+
+func (msg MsgClaimSwap) Route() string {
+	return RouterKey
+}
+
+func (msg MsgClaimSwap) Type() string {
+	return "claim-swap"
+}
+
+func (msg MsgClaimSwap) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if len(msg.Preimage) == 0 || len(msg.Preimage) > MaxPreimageLength {
+		return sdkerrors.Wrapf(ErrInvalidPreimage, "must be 1 to %d bytes", MaxPreimageLength)
+	}
+	return nil
+}
+
+func (msg MsgClaimSwap) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgClaimSwap) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgRefundSwap refunds the amount of an expired swap to its sender, any account can refund
+type MsgRefundSwap struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	SwapID uint64         `json:"swap_id" yaml:"swap_id"`
+}
+
+func (msg MsgRefundSwap) Route() string {
+	return RouterKey
+}
+
+func (msg MsgRefundSwap) Type() string {
+	return "refund-swap"
+}
+
+func (msg MsgRefundSwap) ValidateBasic() error {
+	return sdkerrors.Wrap(sdk.VerifyAddressFormat(msg.Sender), "sender")
+}
+
+func (msg MsgRefundSwap) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgRefundSwap) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// DefaultParamspace for params keeper
+	DefaultParamspace = ModuleName
+)
+
+var ParamStoreKeyMinTimeLock = []byte("minTimeLock")
+var ParamStoreKeyMaxTimeLock = []byte("maxTimeLock")
+
+// Params defines the set of htlc parameters. They are changed by param change proposals.
+type Params struct {
+	// MinTimeLock is the min number of blocks until a swap expires, long enough for the counterparty to claim
+	MinTimeLock int64 `json:"min_time_lock" yaml:"min_time_lock"`
+	// MaxTimeLock is the max number of blocks until a swap expires
+	MaxTimeLock int64 `json:"max_time_lock" yaml:"max_time_lock"`
+}
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns default htlc parameters, the time locks are between about 5 minutes and a week of 5s blocks
+func DefaultParams() Params {
+	return Params{
+		MinTimeLock: 60,
+		MaxTimeLock: 120960,
+	}
+}
+
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(ParamStoreKeyMinTimeLock, &p.MinTimeLock, validateTimeLock),
+		params.NewParamSetPair(ParamStoreKeyMaxTimeLock, &p.MaxTimeLock, validateTimeLock),
+	}
+}
+
+// ValidateBasic performs basic validation on htlc parameters.
+func (p Params) ValidateBasic() error {
+	if err := validateTimeLock(p.MinTimeLock); err != nil {
+		return sdkerrors.Wrap(err, "min time lock")
+	}
+	if err := validateTimeLock(p.MaxTimeLock); err != nil {
+		return sdkerrors.Wrap(err, "max time lock")
+	}
+	if p.MinTimeLock > p.MaxTimeLock {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "min time lock exceeds max time lock")
+	}
+	return nil
+}
+
+func validateTimeLock(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v <= 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "must be positive")
+	}
+	return nil
+}
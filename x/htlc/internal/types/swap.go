@@ -0,0 +1,63 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+)
+
+// MaxPreimageLength is the max length of the preimage of a hash lock
+const MaxPreimageLength = 64
+
+// Swap is a hash time locked transfer. The amount is paid to the recipient when the preimage of the hash lock is
+// revealed before the expiry height, and refunded to the sender after it. The counterparty of an atomic swap locks
+// its side, e.g. on another chain, with the same hash lock and learns the preimage from the claim.
+type Swap struct {
+	ID        uint64         `json:"id" yaml:"id"`
+	Sender    sdk.AccAddress `json:"sender" yaml:"sender"`
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	Amount    sdk.Coins      `json:"amount" yaml:"amount"`
+	// HashLock is the sha256 hash of the preimage
+	HashLock     tmbytes.HexBytes `json:"hash_lock" yaml:"hash_lock"`
+	ExpiryHeight int64            `json:"expiry_height" yaml:"expiry_height"`
+}
+
+// Validate validates the swap
+func (s Swap) Validate() error {
+	if s.ID == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "id must be positive")
+	}
+	if err := sdk.VerifyAddressFormat(s.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if err := sdk.VerifyAddressFormat(s.Recipient); err != nil {
+		return sdkerrors.Wrap(err, "recipient")
+	}
+	if !s.Amount.IsValid() || s.Amount.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, s.Amount.String())
+	}
+	if err := ValidateHashLock(s.HashLock); err != nil {
+		return err
+	}
+	if s.ExpiryHeight <= 0 {
+		return sdkerrors.Wrap(ErrInvalidTimeLock, "expiry height must be positive")
+	}
+	return nil
+}
+
+// ValidateHashLock validates a sha256 hash lock
+func ValidateHashLock(hashLock []byte) error {
+	if len(hashLock) != sha256.Size {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "hash lock must be %d bytes", sha256.Size)
+	}
+	return nil
+}
+
+// Unlocks returns true when the sha256 hash of the preimage is the hash lock of the swap
+func (s Swap) Unlocks(preimage []byte) bool {
+	hash := sha256.Sum256(preimage)
+	return bytes.Equal(hash[:], s.HashLock)
+}
@@ -0,0 +1,62 @@
+package liquidity
+
+import (
+	"github.com/fetchai/fetchd/x/liquidity/internal/keeper"
+	"github.com/fetchai/fetchd/x/liquidity/internal/types"
+)
+
+const (
+	ModuleName               = types.ModuleName
+	StoreKey                 = types.StoreKey
+	QuerierRoute             = types.QuerierRoute
+	RouterKey                = types.RouterKey
+	DefaultParamspace        = types.DefaultParamspace
+	AttributeKeyPoolID       = types.AttributeKeyPoolID
+	AttributeKeyShares       = types.AttributeKeyShares
+	AttributeKeyOffer        = types.AttributeKeyOffer
+	AttributeKeyReceive      = types.AttributeKeyReceive
+	EventTypeCreatePool      = types.EventTypeCreatePool
+	EventTypeAddLiquidity    = types.EventTypeAddLiquidity
+	EventTypeRemoveLiquidity = types.EventTypeRemoveLiquidity
+	EventTypeSwap            = types.EventTypeSwap
+	QueryParams              = keeper.QueryParams
+	QueryPools               = keeper.QueryPools
+	QueryPool                = keeper.QueryPool
+	QuerySimulateSwap        = keeper.QuerySimulateSwap
+	WasmQueryRoute           = keeper.WasmQueryRoute
+)
+
+var (
+	// functions aliases
+	RegisterCodec       = types.RegisterCodec
+	ValidateGenesis     = types.ValidateGenesis
+	DefaultGenesisState = types.DefaultGenesisState
+	DefaultParams       = types.DefaultParams
+	NewPool             = types.NewPool
+	ShareDenom          = types.ShareDenom
+	NewKeeper           = keeper.NewKeeper
+	NewQuerier          = keeper.NewQuerier
+	NewWasmQuerier      = keeper.NewWasmQuerier
+	InitGenesis         = keeper.InitGenesis
+	ExportGenesis       = keeper.ExportGenesis
+
+	// variable aliases
+	ModuleCdc                = types.ModuleCdc
+	MinimumLiquidity         = types.MinimumLiquidity
+	ErrPoolNotFound          = types.ErrPoolNotFound
+	ErrPoolExists            = types.ErrPoolExists
+	ErrInvalidDeposit        = types.ErrInvalidDeposit
+	ErrSlippage              = types.ErrSlippage
+	ErrInsufficientLiquidity = types.ErrInsufficientLiquidity
+)
+
+type (
+	Keeper             = keeper.Keeper
+	GenesisState       = types.GenesisState
+	Params             = types.Params
+	Pool               = types.Pool
+	MsgCreatePool      = types.MsgCreatePool
+	MsgAddLiquidity    = types.MsgAddLiquidity
+	MsgRemoveLiquidity = types.MsgRemoveLiquidity
+	MsgSwap            = types.MsgSwap
+)
@@ -0,0 +1,136 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/fetchai/fetchd/x/liquidity/internal/keeper"
+	"github.com/fetchai/fetchd/x/liquidity/internal/types"
+)
+
+// GetQueryCmd returns the query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the liquidity pools",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(flags.GetCommands(
+		GetCmdQueryParams(cdc),
+		GetCmdQueryPools(cdc),
+		GetCmdQueryPool(cdc),
+		GetCmdQuerySimulateSwap(cdc),
+	)...)
+	return queryCmd
+}
+
+// GetCmdQueryParams shows the liquidity params
+func GetCmdQueryParams(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "Show the swap fee of the pools",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, keeper.QueryParams))
+			if err != nil {
+				return err
+			}
+			var params types.Params
+			if err := json.Unmarshal(res, &params); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
+
+// GetCmdQueryPools lists the pools
+func GetCmdQueryPools(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "pools",
+		Short: "List the pools with their reserves and shares",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, keeper.QueryPools))
+			if err != nil {
+				return err
+			}
+			var pools []types.Pool
+			if err := json.Unmarshal(res, &pools); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(pools)
+		},
+	}
+}
+
+// GetCmdQueryPool shows a pool
+func GetCmdQueryPool(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "pool [pool_id]",
+		Short: "Show the reserves and the shares of a pool",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("pool id: %s", err)
+			}
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%d", types.QuerierRoute, keeper.QueryPool, id))
+			if err != nil {
+				return err
+			}
+			var pool *types.Pool
+			if err := json.Unmarshal(res, &pool); err != nil {
+				return err
+			}
+			if pool == nil {
+				return sdkerrors.Wrapf(types.ErrPoolNotFound, "%d", id)
+			}
+			return cliCtx.PrintOutput(pool)
+		},
+	}
+}
+
+// GetCmdQuerySimulateSwap shows the coin received for an offer to a pool
+func GetCmdQuerySimulateSwap(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "simulate-swap [pool_id] [offer]",
+		Short: "Show the coin received for the offer to a pool at the current reserves, with the swap fee",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("pool id: %s", err)
+			}
+			offer, err := sdk.ParseCoin(args[1])
+			if err != nil {
+				return fmt.Errorf("offer: %s", err)
+			}
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%d/%s", types.QuerierRoute, keeper.QuerySimulateSwap, id, offer))
+			if err != nil {
+				return err
+			}
+			var receive sdk.Coin
+			if err := json.Unmarshal(res, &receive); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(receive)
+		},
+	}
+}
@@ -0,0 +1,189 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	"github.com/fetchai/fetchd/x/liquidity/internal/types"
+	wasmUtils "github.com/fetchai/fetchd/x/wasm/client/utils"
+)
+
+const (
+	flagMinShares  = "min-shares"
+	flagMinAmounts = "min-amounts"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Liquidity pool transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	txCmd.AddCommand(wasmUtils.AddBroadcastFlags(flags.PostCommands(
+		CreatePoolCmd(cdc),
+		AddLiquidityCmd(cdc),
+		RemoveLiquidityCmd(cdc),
+		SwapCmd(cdc),
+	)...)...)
+	return txCmd
+}
+
+// CreatePoolCmd creates the pool of a pair of denoms with the deposit of the --from account
+func CreatePoolCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "create-pool [deposit]",
+		Short: "Create the pool of the two denoms of the deposit of the --from account",
+		Long: `Create the constant product pool of the two denoms of the deposit, e.g. 1000000afet,4000000ustake, which
+sets the initial price. There is at most one pool of a pair of denoms. The --from account receives the shares of the
+pool, without the minimum liquidity that is locked in the pool forever. The id of the pool is in the create_pool event.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			deposit, err := sdk.ParseCoins(args[0])
+			if err != nil {
+				return fmt.Errorf("deposit: %s", err)
+			}
+			msg := types.MsgCreatePool{Creator: cliCtx.GetFromAddress(), Deposit: deposit}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// AddLiquidityCmd deposits to a pool for shares
+func AddLiquidityCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-liquidity [pool_id] [max_deposit]",
+		Short: "Deposit at most the max deposit to a pool in the ratio of its reserves for shares",
+		Long: `Deposit to the pool in the ratio of its reserves, taking at most the max deposit of both denoms of the
+pool. The deposit is rounded up and the shares rounded down in favor of the pool. The tx fails when the --from account
+would receive less than the --min-shares.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("pool id: %s", err)
+			}
+			maxDeposit, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return fmt.Errorf("max deposit: %s", err)
+			}
+			minShares, ok := sdk.NewIntFromString(viper.GetString(flagMinShares))
+			if !ok {
+				return fmt.Errorf("min shares: %s is not an integer", viper.GetString(flagMinShares))
+			}
+			msg := types.MsgAddLiquidity{
+				Sender:     cliCtx.GetFromAddress(),
+				PoolID:     id,
+				MaxDeposit: maxDeposit,
+				MinShares:  minShares,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(flagMinShares, "0", "Min shares to receive for the deposit")
+	return cmd
+}
+
+// RemoveLiquidityCmd redeems shares of a pool for their part of its reserves
+func RemoveLiquidityCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-liquidity [pool_id] [shares]",
+		Short: "Redeem shares of a pool for their part of its reserves",
+		Long: `Burn the shares of the --from account and receive their part of the reserves of the pool, rounded down in
+favor of the pool. The tx fails when the --from account would receive less than the --min-amounts.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("pool id: %s", err)
+			}
+			shares, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("shares: %s is not an integer", args[1])
+			}
+			minAmounts, err := sdk.ParseCoins(viper.GetString(flagMinAmounts))
+			if err != nil {
+				return fmt.Errorf("min amounts: %s", err)
+			}
+			msg := types.MsgRemoveLiquidity{
+				Sender:     cliCtx.GetFromAddress(),
+				PoolID:     id,
+				Shares:     shares,
+				MinAmounts: minAmounts,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(flagMinAmounts, "", "Min amounts of the reserves to receive for the shares")
+	return cmd
+}
+
+// SwapCmd swaps an offer in a pool
+func SwapCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "swap [pool_id] [offer] [min_receive]",
+		Short: "Swap the offer in a pool for at least the min receive of the other denom of the pool",
+		Long: `Swap the offer in the pool for the other denom of the pool at the current reserves, with the swap fee of
+the params paid to the liquidity providers. The tx fails when the --from account would receive less than the min
+receive, e.g. 39000ustake. See "query liquidity simulate-swap" for the coin received at the current reserves.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("pool id: %s", err)
+			}
+			offer, err := sdk.ParseCoin(args[1])
+			if err != nil {
+				return fmt.Errorf("offer: %s", err)
+			}
+			minReceive, err := sdk.ParseCoin(args[2])
+			if err != nil {
+				return fmt.Errorf("min receive: %s", err)
+			}
+			msg := types.MsgSwap{Sender: cliCtx.GetFromAddress(), PoolID: id, Offer: offer, MinReceive: minReceive}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
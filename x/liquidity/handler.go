@@ -0,0 +1,72 @@
+package liquidity
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewHandler returns a handler for "liquidity" type messages.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case MsgCreatePool:
+			return handleCreatePool(ctx, k, &msg)
+		case MsgAddLiquidity:
+			return handleAddLiquidity(ctx, k, &msg)
+		case MsgRemoveLiquidity:
+			return handleRemoveLiquidity(ctx, k, &msg)
+		case MsgSwap:
+			return handleSwap(ctx, k, &msg)
+		default:
+			errMsg := fmt.Sprintf("unrecognized liquidity message type: %T", msg)
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+		}
+	}
+}
+
+func handleCreatePool(ctx sdk.Context, k Keeper, msg *MsgCreatePool) (*sdk.Result, error) {
+	pool, err := k.CreatePool(ctx, msg.Creator, msg.Deposit)
+	if err != nil {
+		return nil, err
+	}
+	return result(ctx, msg.Creator, pool.ID), nil
+}
+
+func handleAddLiquidity(ctx sdk.Context, k Keeper, msg *MsgAddLiquidity) (*sdk.Result, error) {
+	if _, err := k.AddLiquidity(ctx, msg.Sender, msg.PoolID, msg.MaxDeposit, msg.MinShares); err != nil {
+		return nil, err
+	}
+	return result(ctx, msg.Sender, msg.PoolID), nil
+}
+
+func handleRemoveLiquidity(ctx sdk.Context, k Keeper, msg *MsgRemoveLiquidity) (*sdk.Result, error) {
+	if _, err := k.RemoveLiquidity(ctx, msg.Sender, msg.PoolID, msg.Shares, msg.MinAmounts); err != nil {
+		return nil, err
+	}
+	return result(ctx, msg.Sender, msg.PoolID), nil
+}
+
+func handleSwap(ctx sdk.Context, k Keeper, msg *MsgSwap) (*sdk.Result, error) {
+	if _, err := k.Swap(ctx, msg.Sender, msg.PoolID, msg.Offer, msg.MinReceive); err != nil {
+		return nil, err
+	}
+	return result(ctx, msg.Sender, msg.PoolID), nil
+}
+
+func result(ctx sdk.Context, sender sdk.AccAddress, id uint64) *sdk.Result {
+	events := ctx.EventManager().Events()
+	ourEvent := sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(AttributeKeyPoolID, strconv.FormatUint(id, 10)),
+	)
+	return &sdk.Result{
+		Events: append(events, ourEvent),
+	}
+}
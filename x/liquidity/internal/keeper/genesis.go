@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fetchai/fetchd/x/liquidity/internal/types"
+)
+
+// InitGenesis stores the params, the last pool id and the pools of the genesis with the index of their pairs. The
+// reserves of the pools must be held by the module account.
+func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
+	keeper.setParams(ctx, data.Params)
+	keeper.setLastPoolID(ctx, data.LastPoolID)
+	store := ctx.KVStore(keeper.storeKey)
+	for _, p := range data.Pools {
+		keeper.setPool(ctx, p)
+		store.Set(types.GetPairKey(p.ReserveA.Denom, p.ReserveB.Denom), sdk.Uint64ToBigEndian(p.ID))
+	}
+}
+
+// ExportGenesis returns the params, the last pool id and the pools as genesis state
+func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
+	data := types.GenesisState{Params: keeper.GetParams(ctx), LastPoolID: keeper.GetLastPoolID(ctx)}
+	keeper.IteratePools(ctx, func(p types.Pool) bool {
+		data.Pools = append(data.Pools, p)
+		return false
+	})
+	return data
+}
@@ -0,0 +1,280 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/params"
+
+	"github.com/fetchai/fetchd/x/liquidity/internal/types"
+)
+
+// Keeper keeps the constant product pools. The reserves of the pools are held by the module account, which mints and
+// burns the shares of the liquidity providers.
+type Keeper struct {
+	storeKey     sdk.StoreKey
+	cdc          *codec.Codec
+	paramSpace   params.Subspace
+	supplyKeeper types.SupplyKeeper
+}
+
+// NewKeeper creates a new liquidity Keeper instance
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, paramSpace params.Subspace, supplyKeeper types.SupplyKeeper) Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+	return Keeper{
+		storeKey:     storeKey,
+		cdc:          cdc,
+		paramSpace:   paramSpace,
+		supplyKeeper: supplyKeeper,
+	}
+}
+
+// GetParams returns the total set of liquidity parameters.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	var params types.Params
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+func (k Keeper) setParams(ctx sdk.Context, ps types.Params) {
+	k.paramSpace.SetParamSet(ctx, &ps)
+}
+
+// CreatePool creates the pool of the two denoms of the deposit and returns it. There is at most one pool of a pair of
+// denoms. The creator receives the shares of the deposit without the minimum liquidity, which is never minted.
+func (k Keeper) CreatePool(ctx sdk.Context, creator sdk.AccAddress, deposit sdk.Coins) (types.Pool, error) {
+	pool, err := types.NewPool(k.GetLastPoolID(ctx)+1, deposit)
+	if err != nil {
+		return types.Pool{}, err
+	}
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(types.GetPairKey(pool.ReserveA.Denom, pool.ReserveB.Denom)) {
+		return types.Pool{}, sdkerrors.Wrapf(types.ErrPoolExists, "of %s and %s", pool.ReserveA.Denom, pool.ReserveB.Denom)
+	}
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, creator, types.ModuleName, deposit); err != nil {
+		return types.Pool{}, err
+	}
+	shares := pool.TotalShares.Sub(types.MinimumLiquidity)
+	if err := k.mintShares(ctx, creator, pool.ShareDenom, shares); err != nil {
+		return types.Pool{}, err
+	}
+	k.setLastPoolID(ctx, pool.ID)
+	k.setPool(ctx, pool)
+	store.Set(types.GetPairKey(pool.ReserveA.Denom, pool.ReserveB.Denom), sdk.Uint64ToBigEndian(pool.ID))
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCreatePool,
+		sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(pool.ID, 10)),
+		sdk.NewAttribute(sdk.AttributeKeySender, creator.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, deposit.String()),
+		sdk.NewAttribute(types.AttributeKeyShares, shares.String()),
+	))
+	return pool, nil
+}
+
+// AddLiquidity deposits at most the max deposit to the pool in the ratio of its reserves and returns the shares the
+// sender receives, which must be at least the min shares
+func (k Keeper) AddLiquidity(ctx sdk.Context, sender sdk.AccAddress, id uint64, maxDeposit sdk.Coins, minShares sdk.Int) (sdk.Int, error) {
+	pool := k.GetPool(ctx, id)
+	if pool == nil {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrPoolNotFound, "%d", id)
+	}
+	shares, deposit, err := pool.Deposit(maxDeposit)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	if shares.LT(minShares) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrSlippage, "%s shares below min %s", shares, minShares)
+	}
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, deposit); err != nil {
+		return sdk.Int{}, err
+	}
+	if err := k.mintShares(ctx, sender, pool.ShareDenom, shares); err != nil {
+		return sdk.Int{}, err
+	}
+	pool.ReserveA = pool.ReserveA.Add(sdk.NewCoin(pool.ReserveA.Denom, deposit.AmountOf(pool.ReserveA.Denom)))
+	pool.ReserveB = pool.ReserveB.Add(sdk.NewCoin(pool.ReserveB.Denom, deposit.AmountOf(pool.ReserveB.Denom)))
+	pool.TotalShares = pool.TotalShares.Add(shares)
+	k.setPool(ctx, *pool)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeAddLiquidity,
+		sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(id, 10)),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, deposit.String()),
+		sdk.NewAttribute(types.AttributeKeyShares, shares.String()),
+	))
+	return shares, nil
+}
+
+// RemoveLiquidity burns the shares of the sender and returns their part of the reserves of the pool, which must be at
+// least the min amounts
+func (k Keeper) RemoveLiquidity(ctx sdk.Context, sender sdk.AccAddress, id uint64, shares sdk.Int, minAmounts sdk.Coins) (sdk.Coins, error) {
+	pool := k.GetPool(ctx, id)
+	if pool == nil {
+		return nil, sdkerrors.Wrapf(types.ErrPoolNotFound, "%d", id)
+	}
+	if shares.GT(pool.TotalShares.Sub(types.MinimumLiquidity)) {
+		return nil, sdkerrors.Wrapf(types.ErrInsufficientLiquidity, "%s exceeds the shares of pool %d", shares, id)
+	}
+	withdrawal := pool.Withdrawal(shares)
+	if !withdrawal.IsAllGTE(minAmounts) {
+		return nil, sdkerrors.Wrapf(types.ErrSlippage, "%s below min %s", withdrawal, minAmounts)
+	}
+	if err := k.burnShares(ctx, sender, pool.ShareDenom, shares); err != nil {
+		return nil, err
+	}
+	if !withdrawal.IsZero() {
+		if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, withdrawal); err != nil {
+			return nil, err
+		}
+	}
+	pool.ReserveA = pool.ReserveA.Sub(sdk.NewCoin(pool.ReserveA.Denom, withdrawal.AmountOf(pool.ReserveA.Denom)))
+	pool.ReserveB = pool.ReserveB.Sub(sdk.NewCoin(pool.ReserveB.Denom, withdrawal.AmountOf(pool.ReserveB.Denom)))
+	pool.TotalShares = pool.TotalShares.Sub(shares)
+	k.setPool(ctx, *pool)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRemoveLiquidity,
+		sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(id, 10)),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, withdrawal.String()),
+		sdk.NewAttribute(types.AttributeKeyShares, shares.String()),
+	))
+	return withdrawal, nil
+}
+
+// Swap swaps the offer of the sender in the pool with the swap fee of the params and returns the received coin, which
+// must be at least the min receive. The fee stays in the reserves of the pool.
+func (k Keeper) Swap(ctx sdk.Context, sender sdk.AccAddress, id uint64, offer, minReceive sdk.Coin) (sdk.Coin, error) {
+	pool := k.GetPool(ctx, id)
+	if pool == nil {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrPoolNotFound, "%d", id)
+	}
+	receive, err := k.simulateSwap(ctx, *pool, offer)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if receive.Denom != minReceive.Denom {
+		return sdk.Coin{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "pool %d swaps %s for %s", id, offer.Denom, receive.Denom)
+	}
+	if receive.IsLT(minReceive) {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrSlippage, "%s below min %s", receive, minReceive)
+	}
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(offer)); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.NewCoins(receive)); err != nil {
+		return sdk.Coin{}, err
+	}
+	k.setPool(ctx, pool.Swap(offer, receive))
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSwap,
+		sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(id, 10)),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyOffer, offer.String()),
+		sdk.NewAttribute(types.AttributeKeyReceive, receive.String()),
+	))
+	return receive, nil
+}
+
+// SimulateSwap returns the coin received for the offer to the pool without swapping it
+func (k Keeper) SimulateSwap(ctx sdk.Context, id uint64, offer sdk.Coin) (sdk.Coin, error) {
+	pool := k.GetPool(ctx, id)
+	if pool == nil {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrPoolNotFound, "%d", id)
+	}
+	return k.simulateSwap(ctx, *pool, offer)
+}
+
+func (k Keeper) simulateSwap(ctx sdk.Context, pool types.Pool, offer sdk.Coin) (sdk.Coin, error) {
+	receive, err := pool.SwapOut(offer, k.GetParams(ctx).SwapFee)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if !receive.IsPositive() {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInsufficientLiquidity, "%s receives nothing", offer)
+	}
+	return receive, nil
+}
+
+func (k Keeper) mintShares(ctx sdk.Context, recipient sdk.AccAddress, denom string, shares sdk.Int) error {
+	coins := sdk.NewCoins(sdk.NewCoin(denom, shares))
+	if err := k.supplyKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+	return k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coins)
+}
+
+func (k Keeper) burnShares(ctx sdk.Context, owner sdk.AccAddress, denom string, shares sdk.Int) error {
+	coins := sdk.NewCoins(sdk.NewCoin(denom, shares))
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, coins); err != nil {
+		return err
+	}
+	return k.supplyKeeper.BurnCoins(ctx, types.ModuleName, coins)
+}
+
+// GetLastPoolID returns the id of the last created pool, 0 when none was created
+func (k Keeper) GetLastPoolID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.LastPoolIDKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setLastPoolID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.LastPoolIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetPool returns the pool, nil when there is none
+func (k Keeper) GetPool(ctx sdk.Context, id uint64) *types.Pool {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetPoolKey(id))
+	if bz == nil {
+		return nil
+	}
+	var pool types.Pool
+	k.cdc.MustUnmarshalBinaryBare(bz, &pool)
+	return &pool
+}
+
+// GetPoolReserves returns the reserves of the pool, nothing when there is none
+func (k Keeper) GetPoolReserves(ctx sdk.Context, id uint64) sdk.Coins {
+	pool := k.GetPool(ctx, id)
+	if pool == nil {
+		return nil
+	}
+	return sdk.NewCoins(pool.ReserveA, pool.ReserveB)
+}
+
+// GetPoolOfPair returns the pool of the two denoms in any order, nil when there is none
+func (k Keeper) GetPoolOfPair(ctx sdk.Context, denomA, denomB string) *types.Pool {
+	if denomA > denomB {
+		denomA, denomB = denomB, denomA
+	}
+	bz := ctx.KVStore(k.storeKey).Get(types.GetPairKey(denomA, denomB))
+	if bz == nil {
+		return nil
+	}
+	return k.GetPool(ctx, binary.BigEndian.Uint64(bz))
+}
+
+func (k Keeper) setPool(ctx sdk.Context, pool types.Pool) {
+	ctx.KVStore(k.storeKey).Set(types.GetPoolKey(pool.ID), k.cdc.MustMarshalBinaryBare(pool))
+}
+
+// IteratePools calls cb for all pools, ordered by id, until cb returns true
+func (k Keeper) IteratePools(ctx sdk.Context, cb func(types.Pool) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.PoolPrefix).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var pool types.Pool
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &pool)
+		if cb(pool) {
+			return
+		}
+	}
+}
@@ -0,0 +1,195 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/fetchai/fetchd/testutil"
+	"github.com/fetchai/fetchd/x/liquidity/internal/types"
+)
+
+var (
+	alice = sdk.AccAddress([]byte("alice_______________"))
+	bob   = sdk.AccAddress([]byte("bob_________________"))
+)
+
+func coins(afet, ustake int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin("afet", afet), sdk.NewInt64Coin("ustake", ustake))
+}
+
+func shares(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("lpool1", amount)) }
+
+func setupKeeper(t *testing.T) (sdk.Context, Keeper, *testutil.SupplyKeeper) {
+	cdc := codec.New()
+	types.RegisterCodec(cdc)
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	ctx, paramsKeeper := testutil.NewContext(t, cdc, abci.Header{Height: 10}, key)
+	sk := testutil.NewSupplyKeeper(map[string]sdk.Coins{
+		alice.String(): coins(10000000, 40000000),
+		bob.String():   coins(10000000, 40000000),
+	})
+	k := NewKeeper(cdc, key, paramsKeeper.Subspace(types.DefaultParamspace), sk)
+	InitGenesis(ctx, k, types.DefaultGenesisState())
+	return ctx, k, sk
+}
+
+// createPool creates pool 1 of alice with reserves of 1000000afet and 4000000ustake and 2000000 shares
+func createPool(t *testing.T, ctx sdk.Context, k Keeper) types.Pool {
+	pool, err := k.CreatePool(ctx, alice, coins(1000000, 4000000))
+	require.NoError(t, err)
+	return pool
+}
+
+func TestCreatePool(t *testing.T) {
+	specs := map[string]struct {
+		deposit sdk.Coins
+		setup   bool
+		expErr  *sdkerrors.Error
+	}{
+		"first":              {deposit: coins(1000000, 4000000)},
+		"existing pair":      {deposit: coins(1000, 4000000), setup: true, expErr: types.ErrPoolExists},
+		"too small":          {deposit: coins(1000, 1000), expErr: types.ErrInvalidDeposit},
+		"insufficient funds": {deposit: coins(20000000, 4000000), expErr: sdkerrors.ErrInsufficientFunds},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, k, sk := setupKeeper(t)
+			if spec.setup {
+				createPool(t, ctx, k)
+			}
+			pool, err := k.CreatePool(ctx, bob, spec.deposit)
+			if spec.expErr != nil {
+				require.True(t, spec.expErr.Is(err), "got %v", err)
+				return
+			}
+			require.NoError(t, err)
+			exp := types.Pool{
+				ID:          1,
+				ReserveA:    sdk.NewInt64Coin("afet", 1000000),
+				ReserveB:    sdk.NewInt64Coin("ustake", 4000000),
+				ShareDenom:  "lpool1",
+				TotalShares: sdk.NewInt(2000000),
+			}
+			assert.Equal(t, exp, pool)
+			assert.Equal(t, &exp, k.GetPool(ctx, 1))
+			assert.Equal(t, &exp, k.GetPoolOfPair(ctx, "ustake", "afet"))
+			assert.Equal(t, coins(1000000, 4000000), k.GetPoolReserves(ctx, 1))
+			assert.Nil(t, k.GetPoolReserves(ctx, 2))
+			assert.Equal(t, coins(9000000, 36000000).Add(shares(1999000)...).String(), sk.Balances[bob.String()].String())
+			assert.Equal(t, coins(1000000, 4000000).String(), sk.Balances[testutil.ModuleKey(types.ModuleName)].String())
+		})
+	}
+}
+
+func TestAddAndRemoveLiquidity(t *testing.T) {
+	ctx, k, sk := setupKeeper(t)
+	createPool(t, ctx, k)
+
+	_, err := k.AddLiquidity(ctx, bob, 1, coins(100, 1000), sdk.NewInt(201))
+	assert.True(t, types.ErrSlippage.Is(err))
+	_, err = k.AddLiquidity(ctx, bob, 2, coins(100, 1000), sdk.ZeroInt())
+	assert.True(t, types.ErrPoolNotFound.Is(err))
+	minted, err := k.AddLiquidity(ctx, bob, 1, coins(100, 1000), sdk.NewInt(200))
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewInt(200), minted)
+	assert.Equal(t, coins(9999900, 39999600).Add(shares(200)...).String(), sk.Balances[bob.String()].String())
+	assert.Equal(t, sdk.NewInt(2000200), k.GetPool(ctx, 1).TotalShares)
+
+	specs := map[string]struct {
+		sender      sdk.AccAddress
+		shares      int64
+		minAmounts  sdk.Coins
+		expWithdraw sdk.Coins
+		expErr      *sdkerrors.Error
+	}{
+		"all of bob":            {sender: bob, shares: 200, minAmounts: coins(100, 400), expWithdraw: coins(100, 400)},
+		"below min amounts":     {sender: bob, shares: 200, minAmounts: coins(101, 400), expErr: types.ErrSlippage},
+		"exceeds shares of bob": {sender: bob, shares: 201, expErr: sdkerrors.ErrInsufficientFunds},
+		"minimum liquidity":     {sender: alice, shares: 1999201, expErr: types.ErrInsufficientLiquidity},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, k, _ := setupKeeper(t)
+			createPool(t, ctx, k)
+			_, err := k.AddLiquidity(ctx, bob, 1, coins(100, 400), sdk.NewInt(200))
+			require.NoError(t, err)
+
+			withdrawal, err := k.RemoveLiquidity(ctx, spec.sender, 1, sdk.NewInt(spec.shares), spec.minAmounts)
+			if spec.expErr != nil {
+				require.True(t, spec.expErr.Is(err), "got %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.expWithdraw, withdrawal)
+			assert.Equal(t, sdk.NewInt(2000200-spec.shares), k.GetPool(ctx, 1).TotalShares)
+		})
+	}
+}
+
+func TestSwap(t *testing.T) {
+	specs := map[string]struct {
+		offer      sdk.Coin
+		minReceive sdk.Coin
+		poolID     uint64
+		exp        sdk.Coin
+		expErr     *sdkerrors.Error
+	}{
+		"afet for ustake":     {offer: sdk.NewInt64Coin("afet", 10000), minReceive: sdk.NewInt64Coin("ustake", 39486), exp: sdk.NewInt64Coin("ustake", 39486)},
+		"ustake for afet":     {offer: sdk.NewInt64Coin("ustake", 40000), minReceive: sdk.NewInt64Coin("afet", 0), exp: sdk.NewInt64Coin("afet", 9871)},
+		"below min receive":   {offer: sdk.NewInt64Coin("afet", 10000), minReceive: sdk.NewInt64Coin("ustake", 39487), expErr: types.ErrSlippage},
+		"other receive denom": {offer: sdk.NewInt64Coin("afet", 10000), minReceive: sdk.NewInt64Coin("ufet", 1), expErr: sdkerrors.ErrInvalidCoins},
+		"other offer denom":   {offer: sdk.NewInt64Coin("ufet", 10000), minReceive: sdk.NewInt64Coin("afet", 1), expErr: types.ErrInvalidDeposit},
+		"receives nothing":    {offer: sdk.NewInt64Coin("ustake", 1), minReceive: sdk.NewInt64Coin("afet", 0), expErr: types.ErrInsufficientLiquidity},
+		"unknown pool":        {offer: sdk.NewInt64Coin("afet", 10000), minReceive: sdk.NewInt64Coin("ustake", 1), poolID: 2, expErr: types.ErrPoolNotFound},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, k, sk := setupKeeper(t)
+			createPool(t, ctx, k)
+			id := spec.poolID
+			if id == 0 {
+				id = 1
+			}
+			receive, err := k.Swap(ctx, bob, id, spec.offer, spec.minReceive)
+			if spec.expErr != nil {
+				require.True(t, spec.expErr.Is(err), "got %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.exp, receive)
+			assert.Equal(t, coins(1000000, 4000000).Add(spec.offer).Sub(sdk.NewCoins(receive)).String(), k.GetPool(ctx, 1).Reserves().String())
+			assert.Equal(t, coins(10000000, 40000000).Sub(sdk.NewCoins(spec.offer)).Add(receive).String(), sk.Balances[bob.String()].String())
+		})
+	}
+}
+
+func TestGenesisAndWasmQuerier(t *testing.T) {
+	ctx, k, _ := setupKeeper(t)
+	pool := createPool(t, ctx, k)
+
+	exported := ExportGenesis(ctx, k)
+	assert.Equal(t, types.GenesisState{Params: types.DefaultParams(), LastPoolID: 1, Pools: []types.Pool{pool}}, exported)
+	ctx, k, _ = setupKeeper(t)
+	InitGenesis(ctx, k, exported)
+	assert.Equal(t, &pool, k.GetPoolOfPair(ctx, "afet", "ustake"))
+	_, err := k.CreatePool(ctx, bob, coins(1000000, 1000000))
+	assert.True(t, types.ErrPoolExists.Is(err))
+
+	res, err := NewWasmQuerier(k)(ctx, []byte(`{"price":{"pool_id":1,"base_denom":"afet"}}`))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"base_denom":"afet","quote_denom":"ustake","price":"4.000000000000000000"}`, string(res))
+	res, err = NewWasmQuerier(k)(ctx, []byte(`{"simulate_swap":{"pool_id":1,"offer":{"denom":"afet","amount":"10000"}}}`))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"denom":"ustake","amount":"39486"}`, string(res))
+	res, err = NewWasmQuerier(k)(ctx, []byte(`{"pool":{"id":1}}`))
+	require.NoError(t, err)
+	assert.Contains(t, string(res), `"share_denom":"lpool1"`)
+	_, err = NewWasmQuerier(k)(ctx, []byte(`{"price":{"pool_id":2,"base_denom":"afet"}}`))
+	assert.True(t, types.ErrPoolNotFound.Is(err))
+}
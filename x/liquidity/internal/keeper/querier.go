@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"encoding/json"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/fetchai/fetchd/x/liquidity/internal/types"
+)
+
+const (
+	// QueryParams returns the liquidity params
+	QueryParams = "params"
+	// QueryPools returns all pools
+	QueryPools = "pools"
+	// QueryPool returns a pool, path: id
+	QueryPool = "pool"
+	// QuerySimulateSwap returns the coin received for an offer to a pool, path: id, offer
+	QuerySimulateSwap = "simulate-swap"
+)
+
+// NewQuerier creates a new querier
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		switch path[0] {
+		case QueryParams:
+			return marshal(keeper.GetParams(ctx))
+		case QueryPools:
+			pools := []types.Pool{}
+			keeper.IteratePools(ctx, func(p types.Pool) bool {
+				pools = append(pools, p)
+				return false
+			})
+			return marshal(pools)
+		case QueryPool, QuerySimulateSwap:
+			if len(path) < 2 {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "pool id required")
+			}
+			id, err := strconv.ParseUint(path[1], 10, 64)
+			if err != nil {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pool id")
+			}
+			if path[0] == QuerySimulateSwap {
+				if len(path) < 3 {
+					return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "offer required")
+				}
+				offer, err := sdk.ParseCoin(path[2])
+				if err != nil {
+					return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+				}
+				receive, err := keeper.SimulateSwap(ctx, id, offer)
+				if err != nil {
+					return nil, err
+				}
+				return marshal(receive)
+			}
+			pool := keeper.GetPool(ctx, id)
+			if pool == nil {
+				return []byte("null"), nil
+			}
+			return marshal(pool)
+		default:
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown data query endpoint")
+		}
+	}
+}
+
+func marshal(v interface{}) ([]byte, error) {
+	bz, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
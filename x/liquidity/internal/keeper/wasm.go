@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/fetchai/fetchd/x/liquidity/internal/types"
+)
+
+// WasmQueryRoute is the custom query route of contracts for the pools
+const WasmQueryRoute = types.ModuleName
+
+// WasmQuery is the custom query of contracts, e.g. `{"liquidity":{"price":{"pool_id":1,"base_denom":"afet"}}}`
+type WasmQuery struct {
+	Pool         *WasmPoolQuery         `json:"pool,omitempty"`
+	Price        *WasmPriceQuery        `json:"price,omitempty"`
+	SimulateSwap *WasmSimulateSwapQuery `json:"simulate_swap,omitempty"`
+}
+
+// WasmPoolQuery returns the pool with its reserves
+type WasmPoolQuery struct {
+	ID uint64 `json:"id"`
+}
+
+// WasmPriceQuery returns the spot price of the base denom in the other denom of the pool
+type WasmPriceQuery struct {
+	PoolID    uint64 `json:"pool_id"`
+	BaseDenom string `json:"base_denom"`
+}
+
+// WasmSimulateSwapQuery returns the coin received for the offer to the pool, with the swap fee
+type WasmSimulateSwapQuery struct {
+	PoolID uint64   `json:"pool_id"`
+	Offer  sdk.Coin `json:"offer"`
+}
+
+// WasmPriceResponse is the response to the price query
+type WasmPriceResponse struct {
+	BaseDenom  string  `json:"base_denom"`
+	QuoteDenom string  `json:"quote_denom"`
+	Price      sdk.Dec `json:"price"`
+}
+
+// NewWasmQuerier returns the custom querier of contracts for the pools, to be registered with WasmQueryRoute. The
+// queries fail for pools that do not exist.
+func NewWasmQuerier(keeper Keeper) func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var query WasmQuery
+		if err := json.Unmarshal(request, &query); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+		switch {
+		case query.Pool != nil:
+			pool := keeper.GetPool(ctx, query.Pool.ID)
+			if pool == nil {
+				return nil, sdkerrors.Wrapf(types.ErrPoolNotFound, "%d", query.Pool.ID)
+			}
+			return json.Marshal(pool)
+		case query.Price != nil:
+			pool := keeper.GetPool(ctx, query.Price.PoolID)
+			if pool == nil {
+				return nil, sdkerrors.Wrapf(types.ErrPoolNotFound, "%d", query.Price.PoolID)
+			}
+			price, err := pool.Price(query.Price.BaseDenom)
+			if err != nil {
+				return nil, err
+			}
+			quote := pool.ReserveA.Denom
+			if quote == query.Price.BaseDenom {
+				quote = pool.ReserveB.Denom
+			}
+			return json.Marshal(WasmPriceResponse{BaseDenom: query.Price.BaseDenom, QuoteDenom: quote, Price: price})
+		case query.SimulateSwap != nil:
+			receive, err := keeper.SimulateSwap(ctx, query.SimulateSwap.PoolID, query.SimulateSwap.Offer)
+			if err != nil {
+				return nil, err
+			}
+			return json.Marshal(receive)
+		default:
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown liquidity query")
+		}
+	}
+}
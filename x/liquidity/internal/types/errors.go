@@ -0,0 +1,21 @@
+package types
+
+import (
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Codes for liquidity errors
+var (
+	DefaultCodespace = ModuleName
+
+	// ErrPoolNotFound error for a pool that does not exist
+	ErrPoolNotFound = sdkErrors.Register(DefaultCodespace, 1, "pool not found")
+	// ErrPoolExists error for a second pool of a pair of denoms
+	ErrPoolExists = sdkErrors.Register(DefaultCodespace, 2, "pool exists")
+	// ErrInvalidDeposit error for a deposit that is not of the two denoms of the pool or too small for shares
+	ErrInvalidDeposit = sdkErrors.Register(DefaultCodespace, 3, "invalid deposit")
+	// ErrSlippage error for a swap or a deposit or withdrawal that gets less than the min amount
+	ErrSlippage = sdkErrors.Register(DefaultCodespace, 4, "exceeds slippage")
+	// ErrInsufficientLiquidity error for a swap too small to receive any of the reserve of the pool
+	ErrInsufficientLiquidity = sdkErrors.Register(DefaultCodespace, 5, "insufficient liquidity")
+)
@@ -0,0 +1,45 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GenesisState is the genesis state of the liquidity module
+type GenesisState struct {
+	Params     Params `json:"params"`
+	LastPoolID uint64 `json:"last_pool_id"`
+	Pools      []Pool `json:"pools,omitempty"`
+}
+
+// DefaultGenesisState returns the genesis state without pools
+func DefaultGenesisState() GenesisState {
+	return GenesisState{Params: DefaultParams()}
+}
+
+// ValidateGenesis performs basic validation of the genesis state. The ids of the pools must not exceed the last pool
+// id and there must be at most one pool of a pair of denoms.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "params")
+	}
+	ids := make(map[uint64]struct{}, len(data.Pools))
+	pairs := make(map[string]struct{}, len(data.Pools))
+	for _, p := range data.Pools {
+		if err := p.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "pool %d", p.ID)
+		}
+		if p.ID > data.LastPoolID {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "pool %d after last pool id", p.ID)
+		}
+		if _, exists := ids[p.ID]; exists {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate pool %d", p.ID)
+		}
+		ids[p.ID] = struct{}{}
+		pair := string(GetPairKey(p.ReserveA.Denom, p.ReserveB.Denom))
+		if _, exists := pairs[pair]; exists {
+			return sdkerrors.Wrapf(ErrPoolExists, "pool %d", p.ID)
+		}
+		pairs[pair] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the liquidity module
+	ModuleName = "liquidity"
+
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for the liquidity module
+	QuerierRoute = ModuleName
+
+	// RouterKey is the msg router key for the liquidity module
+	RouterKey = ModuleName
+)
+
+const ( // event attributes
+	AttributeKeyPoolID  = "pool_id"
+	AttributeKeyShares  = "shares"
+	AttributeKeyOffer   = "offer"
+	AttributeKeyReceive = "receive"
+)
+
+const (
+	// EventTypeCreatePool is emitted when a pool is created
+	EventTypeCreatePool = "create_pool"
+	// EventTypeAddLiquidity is emitted when liquidity is deposited to a pool for shares
+	EventTypeAddLiquidity = "add_liquidity"
+	// EventTypeRemoveLiquidity is emitted when shares are redeemed for their part of the reserves of a pool
+	EventTypeRemoveLiquidity = "remove_liquidity"
+	// EventTypeSwap is emitted when a coin is swapped in a pool
+	EventTypeSwap = "swap"
+)
+
+// nolint
+var (
+	PoolPrefix    = []byte{0x01}
+	LastPoolIDKey = []byte{0x02}
+	PairPrefix    = []byte{0x03}
+)
+
+// GetPoolKey returns the store key of the pool
+func GetPoolKey(id uint64) []byte {
+	return append(PoolPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetPairKey returns the store key of the id of the pool of the sorted denoms
+func GetPairKey(denomA, denomB string) []byte {
+	return append(PairPrefix, []byte(denomA+"/"+denomB)...)
+}
@@ -0,0 +1,166 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MsgCreatePool creates the pool of the two denoms of the deposit. The creator receives the shares of the deposit
+// without the minimum liquidity.
+type MsgCreatePool struct {
+	Creator sdk.AccAddress `json:"creator" yaml:"creator"`
+	Deposit sdk.Coins      `json:"deposit" yaml:"deposit"`
+}
+
+func (msg MsgCreatePool) Route() string {
+	return RouterKey
+}
+
+func (msg MsgCreatePool) Type() string {
+	return "create-pool"
+}
+
+func (msg MsgCreatePool) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Creator); err != nil {
+		return sdkerrors.Wrap(err, "creator")
+	}
+	return validateDeposit(msg.Deposit)
+}
+
+func (msg MsgCreatePool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgCreatePool) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Creator}
+}
+
+// MsgAddLiquidity deposits at most the max deposit to the pool in the ratio of its reserves for at least the min
+// shares
+type MsgAddLiquidity struct {
+	Sender     sdk.AccAddress `json:"sender" yaml:"sender"`
+	PoolID     uint64         `json:"pool_id" yaml:"pool_id"`
+	MaxDeposit sdk.Coins      `json:"max_deposit" yaml:"max_deposit"`
+	MinShares  sdk.Int        `json:"min_shares" yaml:"min_shares"`
+}
+
+func (msg MsgAddLiquidity) Route() string {
+	return RouterKey
+}
+
+func (msg MsgAddLiquidity) Type() string {
+	return "add-liquidity"
+}
+
+func (msg MsgAddLiquidity) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if msg.PoolID == 0 {
+		return sdkerrors.Wrap(ErrPoolNotFound, "pool id must be positive")
+	}
+	if msg.MinShares.IsNil() || msg.MinShares.IsNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "min shares must not be negative")
+	}
+	return validateDeposit(msg.MaxDeposit)
+}
+
+func (msg MsgAddLiquidity) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgAddLiquidity) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgRemoveLiquidity redeems the shares of the sender for their part of the reserves of the pool, which must be at
+// least the min amounts
+type MsgRemoveLiquidity struct {
+	Sender     sdk.AccAddress `json:"sender" yaml:"sender"`
+	PoolID     uint64         `json:"pool_id" yaml:"pool_id"`
+	Shares     sdk.Int        `json:"shares" yaml:"shares"`
+	MinAmounts sdk.Coins      `json:"min_amounts,omitempty" yaml:"min_amounts,omitempty"`
+}
+
+func (msg MsgRemoveLiquidity) Route() string {
+	return RouterKey
+}
+
+func (msg MsgRemoveLiquidity) Type() string {
+	return "remove-liquidity"
+}
+
+func (msg MsgRemoveLiquidity) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if msg.PoolID == 0 {
+		return sdkerrors.Wrap(ErrPoolNotFound, "pool id must be positive")
+	}
+	if msg.Shares.IsNil() || !msg.Shares.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "shares must be positive")
+	}
+	if !msg.MinAmounts.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "min amounts")
+	}
+	return nil
+}
+
+func (msg MsgRemoveLiquidity) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgRemoveLiquidity) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgSwap swaps the offer in the pool for at least the min receive of the other denom of the pool
+type MsgSwap struct {
+	Sender     sdk.AccAddress `json:"sender" yaml:"sender"`
+	PoolID     uint64         `json:"pool_id" yaml:"pool_id"`
+	Offer      sdk.Coin       `json:"offer" yaml:"offer"`
+	MinReceive sdk.Coin       `json:"min_receive" yaml:"min_receive"`
+}
+
+func (msg MsgSwap) Route() string {
+	return RouterKey
+}
+
+func (msg MsgSwap) Type() string {
+	return "swap"
+}
+
+func (msg MsgSwap) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if msg.PoolID == 0 {
+		return sdkerrors.Wrap(ErrPoolNotFound, "pool id must be positive")
+	}
+	if !msg.Offer.IsValid() || !msg.Offer.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "offer must be positive")
+	}
+	if !msg.MinReceive.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "min receive")
+	}
+	if msg.Offer.Denom == msg.MinReceive.Denom {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "offer and min receive must be of different denoms")
+	}
+	return nil
+}
+
+func (msg MsgSwap) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgSwap) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// validateDeposit validates that the deposit is of two positive coins
+func validateDeposit(deposit sdk.Coins) error {
+	if !deposit.IsValid() || len(deposit) != 2 {
+		return sdkerrors.Wrapf(ErrInvalidDeposit, "%s must be of two denoms", deposit)
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// DefaultParamspace for params keeper
+	DefaultParamspace = ModuleName
+)
+
+var ParamStoreKeySwapFee = []byte("swapFee")
+
+// Params defines the set of liquidity parameters. They are changed by param change proposals.
+type Params struct {
+	// SwapFee is the share of the offered coin of a swap that is kept by the pool for its liquidity providers
+	SwapFee sdk.Dec `json:"swap_fee" yaml:"swap_fee"`
+}
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns default liquidity parameters, a swap fee of 0.3%
+func DefaultParams() Params {
+	return Params{
+		SwapFee: sdk.NewDecWithPrec(3, 3),
+	}
+}
+
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(ParamStoreKeySwapFee, &p.SwapFee, validateSwapFee),
+	}
+}
+
+// ValidateBasic performs basic validation on liquidity parameters.
+func (p Params) ValidateBasic() error {
+	if err := validateSwapFee(p.SwapFee); err != nil {
+		return sdkerrors.Wrap(err, "swap fee")
+	}
+	return nil
+}
+
+func validateSwapFee(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GTE(sdk.OneDec()) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "must be in [0, 1)")
+	}
+	return nil
+}
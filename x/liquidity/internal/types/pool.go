@@ -0,0 +1,146 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MinimumLiquidity is the number of the initial shares of a pool that are never minted, so that the reserves of a
+// pool are never drained and a share cannot become too expensive to mint
+var MinimumLiquidity = sdk.NewInt(1000)
+
+// Pool is a constant product pool of the reserves of two denoms, sorted by denom. The liquidity providers own the
+// reserves with shares of the share denom.
+type Pool struct {
+	ID          uint64   `json:"id" yaml:"id"`
+	ReserveA    sdk.Coin `json:"reserve_a" yaml:"reserve_a"`
+	ReserveB    sdk.Coin `json:"reserve_b" yaml:"reserve_b"`
+	ShareDenom  string   `json:"share_denom" yaml:"share_denom"`
+	TotalShares sdk.Int  `json:"total_shares" yaml:"total_shares"`
+}
+
+// NewPool returns the pool of the deposit of two denoms and the shares of the deposit, which include the minimum
+// liquidity
+func NewPool(id uint64, deposit sdk.Coins) (Pool, error) {
+	if len(deposit) != 2 || !deposit.IsValid() {
+		return Pool{}, sdkerrors.Wrapf(ErrInvalidDeposit, "%s must be of two denoms", deposit)
+	}
+	shares := sdk.NewIntFromBigInt(new(big.Int).Sqrt(deposit[0].Amount.Mul(deposit[1].Amount).BigInt()))
+	if shares.LTE(MinimumLiquidity) {
+		return Pool{}, sdkerrors.Wrapf(ErrInvalidDeposit, "%s is too small", deposit)
+	}
+	return Pool{ID: id, ReserveA: deposit[0], ReserveB: deposit[1], ShareDenom: ShareDenom(id), TotalShares: shares}, nil
+}
+
+// ShareDenom returns the denom of the shares of the pool
+func ShareDenom(id uint64) string {
+	return fmt.Sprintf("lpool%d", id)
+}
+
+// Validate validates the pool
+func (p Pool) Validate() error {
+	if p.ID == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "id must be positive")
+	}
+	if !p.ReserveA.IsValid() || !p.ReserveA.IsPositive() || !p.ReserveB.IsValid() || !p.ReserveB.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "reserves must be positive")
+	}
+	if p.ReserveA.Denom >= p.ReserveB.Denom {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "reserves must be of sorted denoms")
+	}
+	if p.ShareDenom != ShareDenom(p.ID) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "share denom must be %s", ShareDenom(p.ID))
+	}
+	if p.TotalShares.IsNil() || p.TotalShares.LTE(MinimumLiquidity) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "total shares must exceed the minimum liquidity")
+	}
+	return nil
+}
+
+// Reserves returns the reserves of the pool
+func (p Pool) Reserves() sdk.Coins {
+	return sdk.NewCoins(p.ReserveA, p.ReserveB)
+}
+
+// reserves returns the reserve of the denom and the other reserve
+func (p Pool) reserves(denom string) (sdk.Coin, sdk.Coin, error) {
+	switch denom {
+	case p.ReserveA.Denom:
+		return p.ReserveA, p.ReserveB, nil
+	case p.ReserveB.Denom:
+		return p.ReserveB, p.ReserveA, nil
+	default:
+		return sdk.Coin{}, sdk.Coin{}, sdkerrors.Wrapf(ErrInvalidDeposit, "%s is not a denom of pool %d", denom, p.ID)
+	}
+}
+
+// Price returns the spot price of the base denom in the other denom of the pool
+func (p Pool) Price(base string) (sdk.Dec, error) {
+	in, out, err := p.reserves(base)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return out.Amount.ToDec().Quo(in.Amount.ToDec()), nil
+}
+
+// SwapOut returns the coin received for the offer to the pool with the swap fee. The product of the reserves after the
+// swap, without the fee, is at least the product before.
+func (p Pool) SwapOut(offer sdk.Coin, fee sdk.Dec) (sdk.Coin, error) {
+	in, out, err := p.reserves(offer.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	offerAfterFee := offer.Amount.ToDec().Mul(sdk.OneDec().Sub(fee)).TruncateInt()
+	amount := out.Amount.Mul(offerAfterFee).Quo(in.Amount.Add(offerAfterFee))
+	return sdk.NewCoin(out.Denom, amount), nil
+}
+
+// Swap returns the pool with the offer added to its reserves and the received coin removed
+func (p Pool) Swap(offer, receive sdk.Coin) Pool {
+	if offer.Denom == p.ReserveA.Denom {
+		p.ReserveA, p.ReserveB = p.ReserveA.Add(offer), p.ReserveB.Sub(receive)
+	} else {
+		p.ReserveB, p.ReserveA = p.ReserveB.Add(offer), p.ReserveA.Sub(receive)
+	}
+	return p
+}
+
+// Deposit returns the shares for a deposit of at most the max deposit and the deposit they take, rounded up in favor
+// of the pool
+func (p Pool) Deposit(maxDeposit sdk.Coins) (sdk.Int, sdk.Coins, error) {
+	if len(maxDeposit) != 2 || maxDeposit[0].Denom != p.ReserveA.Denom || maxDeposit[1].Denom != p.ReserveB.Denom {
+		return sdk.Int{}, nil, sdkerrors.Wrapf(ErrInvalidDeposit, "must be of %s and %s", p.ReserveA.Denom, p.ReserveB.Denom)
+	}
+	shares := sdk.MinInt(
+		maxDeposit[0].Amount.Mul(p.TotalShares).Quo(p.ReserveA.Amount),
+		maxDeposit[1].Amount.Mul(p.TotalShares).Quo(p.ReserveB.Amount),
+	)
+	if !shares.IsPositive() {
+		return sdk.Int{}, nil, sdkerrors.Wrapf(ErrInvalidDeposit, "%s is too small", maxDeposit)
+	}
+	deposit := sdk.NewCoins(
+		sdk.NewCoin(p.ReserveA.Denom, ceilQuo(shares.Mul(p.ReserveA.Amount), p.TotalShares)),
+		sdk.NewCoin(p.ReserveB.Denom, ceilQuo(shares.Mul(p.ReserveB.Amount), p.TotalShares)),
+	)
+	return shares, deposit, nil
+}
+
+// Withdrawal returns the part of the reserves of the shares, rounded down in favor of the pool
+func (p Pool) Withdrawal(shares sdk.Int) sdk.Coins {
+	return sdk.NewCoins(
+		sdk.NewCoin(p.ReserveA.Denom, shares.Mul(p.ReserveA.Amount).Quo(p.TotalShares)),
+		sdk.NewCoin(p.ReserveB.Denom, shares.Mul(p.ReserveB.Amount).Quo(p.TotalShares)),
+	)
+}
+
+// ceilQuo returns a / b rounded up
+func ceilQuo(a, b sdk.Int) sdk.Int {
+	q := a.Quo(b)
+	if !q.Mul(b).Equal(a) {
+		q = q.AddRaw(1)
+	}
+	return q
+}
@@ -0,0 +1,126 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func coins(afet, ustake int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin("afet", afet), sdk.NewInt64Coin("ustake", ustake))
+}
+
+func TestNewPool(t *testing.T) {
+	specs := map[string]struct {
+		deposit   sdk.Coins
+		expShares sdk.Int
+		expErr    bool
+	}{
+		"valid":            {deposit: coins(1000000, 4000000), expShares: sdk.NewInt(2000000)},
+		"rounded down":     {deposit: coins(1001, 1002), expShares: sdk.NewInt(1001)},
+		"minimum":          {deposit: coins(1000, 1000), expErr: true},
+		"single denom":     {deposit: sdk.NewCoins(sdk.NewInt64Coin("afet", 1000000)), expErr: true},
+		"three denoms":     {deposit: coins(1000000, 1000000).Add(sdk.NewInt64Coin("ufet", 1000000)), expErr: true},
+		"zero of a denom":  {deposit: sdk.Coins{sdk.NewInt64Coin("afet", 1000000), sdk.NewInt64Coin("ustake", 0)}, expErr: true},
+		"unsorted denoms":  {deposit: sdk.Coins{sdk.NewInt64Coin("ustake", 1000000), sdk.NewInt64Coin("afet", 1000000)}, expErr: true},
+		"duplicate denoms": {deposit: sdk.Coins{sdk.NewInt64Coin("afet", 1000000), sdk.NewInt64Coin("afet", 1000000)}, expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			p, err := NewPool(1, spec.deposit)
+			if spec.expErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.expShares, p.TotalShares)
+			assert.Equal(t, "lpool1", p.ShareDenom)
+			assert.NoError(t, p.Validate())
+		})
+	}
+}
+
+func TestPoolMath(t *testing.T) {
+	p, err := NewPool(1, coins(1000000, 4000000))
+	require.NoError(t, err)
+
+	price, err := p.Price("afet")
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewDec(4).String(), price.String())
+	price, err = p.Price("ustake")
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewDecWithPrec(25, 2).String(), price.String())
+	_, err = p.Price("ufet")
+	assert.True(t, ErrInvalidDeposit.Is(err))
+
+	receive, err := p.SwapOut(sdk.NewInt64Coin("afet", 10000), sdk.NewDecWithPrec(3, 3))
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewInt64Coin("ustake", 39486), receive)
+	swapped := p.Swap(sdk.NewInt64Coin("afet", 10000), receive)
+	assert.Equal(t, coins(1010000, 3960514), swapped.Reserves())
+	assert.True(t, swapped.ReserveA.Amount.Mul(swapped.ReserveB.Amount).GT(p.ReserveA.Amount.Mul(p.ReserveB.Amount)))
+
+	specs := map[string]struct {
+		maxDeposit sdk.Coins
+		expShares  sdk.Int
+		expDeposit sdk.Coins
+		expErr     bool
+	}{
+		"in ratio":     {maxDeposit: coins(100, 400), expShares: sdk.NewInt(200), expDeposit: coins(100, 400)},
+		"excess of b":  {maxDeposit: coins(100, 1000), expShares: sdk.NewInt(200), expDeposit: coins(100, 400)},
+		"rounded up":   {maxDeposit: coins(1, 3), expShares: sdk.NewInt(1), expDeposit: coins(1, 2)},
+		"too small":    {maxDeposit: coins(1, 1), expErr: true},
+		"other denoms": {maxDeposit: sdk.NewCoins(sdk.NewInt64Coin("afet", 100), sdk.NewInt64Coin("ufet", 100)), expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			shares, deposit, err := p.Deposit(spec.maxDeposit)
+			if spec.expErr {
+				assert.True(t, ErrInvalidDeposit.Is(err), "got %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.expShares, shares)
+			assert.Equal(t, spec.expDeposit, deposit)
+		})
+	}
+
+	assert.Equal(t, coins(100, 400), p.Withdrawal(sdk.NewInt(200)))
+	assert.Equal(t, coins(0, 2), p.Withdrawal(sdk.NewInt(1)))
+}
+
+func TestValidateGenesis(t *testing.T) {
+	pool, err := NewPool(1, coins(1000000, 4000000))
+	require.NoError(t, err)
+	specs := map[string]struct {
+		mutate func(*GenesisState)
+		expErr bool
+	}{
+		"valid":             {mutate: func(*GenesisState) {}},
+		"id after last id":  {mutate: func(g *GenesisState) { g.LastPoolID = 0 }, expErr: true},
+		"duplicate pool":    {mutate: func(g *GenesisState) { g.Pools = append(g.Pools, pool) }, expErr: true},
+		"other share denom": {mutate: func(g *GenesisState) { g.Pools[0].ShareDenom = "lpool2" }, expErr: true},
+		"empty reserve":     {mutate: func(g *GenesisState) { g.Pools[0].ReserveB.Amount = sdk.ZeroInt() }, expErr: true},
+		"minimum shares":    {mutate: func(g *GenesisState) { g.Pools[0].TotalShares = MinimumLiquidity }, expErr: true},
+		"swap fee of one":   {mutate: func(g *GenesisState) { g.Params.SwapFee = sdk.OneDec() }, expErr: true},
+		"duplicate pair": {mutate: func(g *GenesisState) {
+			other := pool
+			other.ID, other.ShareDenom = 2, ShareDenom(2)
+			g.Pools, g.LastPoolID = append(g.Pools, other), 2
+		}, expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			g := GenesisState{Params: DefaultParams(), LastPoolID: 1, Pools: []Pool{pool}}
+			spec.mutate(&g)
+			err := ValidateGenesis(g)
+			if spec.expErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package oracle
+
+import (
+	"github.com/fetchai/fetchd/x/oracle/internal/keeper"
+	"github.com/fetchai/fetchd/x/oracle/internal/types"
+)
+
+const (
+	ModuleName            = types.ModuleName
+	StoreKey              = types.StoreKey
+	QuerierRoute          = types.QuerierRoute
+	RouterKey             = types.RouterKey
+	DefaultParamspace     = types.DefaultParamspace
+	AttributeKeyFeed      = types.AttributeKeyFeed
+	AttributeKeyValue     = types.AttributeKeyValue
+	AttributeKeyValidator = types.AttributeKeyValidator
+	AttributeKeyFeeder    = types.AttributeKeyFeeder
+	AttributeKeyMisses    = types.AttributeKeyMisses
+	EventTypePriceUpdate  = types.EventTypePriceUpdate
+	EventTypeOracleSlash  = types.EventTypeOracleSlash
+	QueryParams           = keeper.QueryParams
+	QueryPrice            = keeper.QueryPrice
+	QueryPrices           = keeper.QueryPrices
+	QueryVotes            = keeper.QueryVotes
+	QueryValidator        = keeper.QueryValidator
+	WasmQueryRoute        = keeper.WasmQueryRoute
+)
+
+var (
+	// functions aliases
+	RegisterCodec       = types.RegisterCodec
+	ValidateGenesis     = types.ValidateGenesis
+	DefaultGenesisState = types.DefaultGenesisState
+	DefaultParams       = types.DefaultParams
+	ValidateFeed        = types.ValidateFeed
+	NewKeeper           = keeper.NewKeeper
+	NewQuerier          = keeper.NewQuerier
+	NewWasmQuerier      = keeper.NewWasmQuerier
+	InitGenesis         = keeper.InitGenesis
+	ExportGenesis       = keeper.ExportGenesis
+
+	// variable aliases
+	ModuleCdc             = types.ModuleCdc
+	ErrInvalidFeed        = types.ErrInvalidFeed
+	ErrUnknownFeed        = types.ErrUnknownFeed
+	ErrNoVotingPermission = types.ErrNoVotingPermission
+	ErrNotBonded          = types.ErrNotBonded
+)
+
+type (
+	Keeper            = keeper.Keeper
+	ValidatorInfo     = keeper.ValidatorInfo
+	WasmQuery         = keeper.WasmQuery
+	GenesisState      = types.GenesisState
+	Params            = types.Params
+	FeedValue         = types.FeedValue
+	Vote              = types.Vote
+	Price             = types.Price
+	FeederDelegation  = types.FeederDelegation
+	MissCounter       = types.MissCounter
+	MsgFeedVote       = types.MsgFeedVote
+	MsgDelegateFeeder = types.MsgDelegateFeeder
+)
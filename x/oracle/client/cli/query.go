@@ -0,0 +1,129 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fetchai/fetchd/x/oracle/internal/keeper"
+	"github.com/fetchai/fetchd/x/oracle/internal/types"
+)
+
+// GetQueryCmd returns the query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the oracle",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(flags.GetCommands(
+		GetCmdQueryParams(cdc),
+		GetCmdQueryPrice(cdc),
+		GetCmdQueryPrices(cdc),
+		GetCmdQueryVotes(cdc),
+		GetCmdQueryValidator(cdc),
+	)...)
+	return queryCmd
+}
+
+// GetCmdQueryParams shows the oracle params
+func GetCmdQueryParams(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "Show the feeds, vote period and the other oracle params",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var params types.Params
+			return queryAndPrint(cdc, keeper.QueryParams, &params)
+		},
+	}
+}
+
+// GetCmdQueryPrice shows the value of a feed
+func GetCmdQueryPrice(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "price [feed]",
+		Short: "Show the value of a feed, e.g. FET/USD, and the height it was set at",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := types.ValidateFeed(args[0]); err != nil {
+				return err
+			}
+			var price *types.Price
+			if err := query(cdc, fmt.Sprintf("%s/%s", keeper.QueryPrice, args[0]), &price); err != nil {
+				return err
+			}
+			if price == nil {
+				return fmt.Errorf("no value of feed %s", args[0])
+			}
+			return context.NewCLIContext().WithCodec(cdc).PrintOutput(price)
+		},
+	}
+}
+
+// GetCmdQueryPrices lists the values of all feeds
+func GetCmdQueryPrices(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "prices",
+		Short: "List the values of all feeds",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var prices []types.Price
+			return queryAndPrint(cdc, keeper.QueryPrices, &prices)
+		},
+	}
+}
+
+// GetCmdQueryVotes lists the votes of the current vote period
+func GetCmdQueryVotes(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "votes",
+		Short: "List the feed votes of the validators in the current vote period",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var votes []types.Vote
+			return queryAndPrint(cdc, keeper.QueryVotes, &votes)
+		},
+	}
+}
+
+// GetCmdQueryValidator shows the oracle state of a validator
+func GetCmdQueryValidator(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "validator [validator_address]",
+		Short: "Show the delegated feeder and the missed vote periods of the slash window of a validator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if _, err := sdk.ValAddressFromBech32(args[0]); err != nil {
+				return fmt.Errorf("validator: %s", err)
+			}
+			var info keeper.ValidatorInfo
+			return queryAndPrint(cdc, fmt.Sprintf("%s/%s", keeper.QueryValidator, args[0]), &info)
+		},
+	}
+}
+
+func query(cdc *codec.Codec, path string, v interface{}) error {
+	cliCtx := context.NewCLIContext().WithCodec(cdc)
+	res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, path))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(res, v)
+}
+
+func queryAndPrint(cdc *codec.Codec, path string, v interface{}) error {
+	if err := query(cdc, path, v); err != nil {
+		return err
+	}
+	return context.NewCLIContext().WithCodec(cdc).PrintOutput(v)
+}
@@ -0,0 +1,119 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	"github.com/fetchai/fetchd/x/oracle/internal/types"
+	wasmUtils "github.com/fetchai/fetchd/x/wasm/client/utils"
+)
+
+const flagValidator = "validator"
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Oracle transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	txCmd.AddCommand(wasmUtils.AddBroadcastFlags(flags.PostCommands(
+		FeedVoteCmd(cdc),
+		DelegateFeederCmd(cdc),
+	)...)...)
+	return txCmd
+}
+
+// FeedVoteCmd submits the vote of a validator for the feed values of the current vote period
+func FeedVoteCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote [feed=value,...]",
+		Short: "Vote the feed values of the current vote period for a validator",
+		Long: `Vote the decimal values of the feeds, e.g. "FET/USD=0.25,ETH/USD=1800", for the --validator, which defaults
+to the validator of the --from operator account. The --from account must be the operator or the delegated feeder of
+the validator. At the end of the vote period the stake weighted median of the votes becomes the value of a feed.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			var values []types.FeedValue
+			for _, v := range strings.Split(args[0], ",") {
+				parts := strings.SplitN(v, "=", 2)
+				if len(parts) != 2 {
+					return fmt.Errorf("invalid feed value, expected feed=value: %s", v)
+				}
+				value, err := sdk.NewDecFromStr(parts[1])
+				if err != nil {
+					return fmt.Errorf("value of %s: %s", parts[0], err)
+				}
+				values = append(values, types.FeedValue{Feed: parts[0], Value: value})
+			}
+			val := sdk.ValAddress(cliCtx.GetFromAddress())
+			if v := viper.GetString(flagValidator); v != "" {
+				var err error
+				if val, err = sdk.ValAddressFromBech32(v); err != nil {
+					return fmt.Errorf("validator: %s", err)
+				}
+			}
+			msg := types.MsgFeedVote{
+				Feeder:    cliCtx.GetFromAddress(),
+				Validator: val,
+				Values:    values,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(flagValidator, "", "Validator operator address to vote for, defaults to the one of the --from account")
+	return cmd
+}
+
+// DelegateFeederCmd delegates the feed votes of the validator of the operator account
+func DelegateFeederCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delegate-feeder [feeder]",
+		Short: "Let an account vote the feeds for the validator of the --from operator account",
+		Long: `Delegate the feed votes of the validator of the --from operator account to the feeder account, so that the
+operator key can stay offline. Without feeder the delegation is removed.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			var feeder sdk.AccAddress
+			if len(args) != 0 {
+				var err error
+				if feeder, err = sdk.AccAddressFromBech32(args[0]); err != nil {
+					return fmt.Errorf("feeder: %s", err)
+				}
+			}
+			msg := types.MsgDelegateFeeder{
+				Operator: sdk.ValAddress(cliCtx.GetFromAddress()),
+				Feeder:   feeder,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
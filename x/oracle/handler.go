@@ -0,0 +1,56 @@
+package oracle
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewHandler returns a handler for "oracle" type messages.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case MsgFeedVote:
+			return handleFeedVote(ctx, k, &msg)
+		case MsgDelegateFeeder:
+			return handleDelegateFeeder(ctx, k, &msg)
+		default:
+			errMsg := fmt.Sprintf("unrecognized oracle message type: %T", msg)
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+		}
+	}
+}
+
+func handleFeedVote(ctx sdk.Context, k Keeper, msg *MsgFeedVote) (*sdk.Result, error) {
+	if err := k.Vote(ctx, msg.Feeder, msg.Validator, msg.Values); err != nil {
+		return nil, err
+	}
+	events := ctx.EventManager().Events()
+	ourEvent := sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Feeder.String()),
+		sdk.NewAttribute(AttributeKeyValidator, msg.Validator.String()),
+	)
+	return &sdk.Result{
+		Events: append(events, ourEvent),
+	}, nil
+}
+
+func handleDelegateFeeder(ctx sdk.Context, k Keeper, msg *MsgDelegateFeeder) (*sdk.Result, error) {
+	k.SetFeeder(ctx, msg.Operator, msg.Feeder)
+	events := ctx.EventManager().Events()
+	ourEvent := sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, sdk.AccAddress(msg.Operator).String()),
+		sdk.NewAttribute(AttributeKeyValidator, msg.Operator.String()),
+		sdk.NewAttribute(AttributeKeyFeeder, msg.Feeder.String()),
+	)
+	return &sdk.Result{
+		Events: append(events, ourEvent),
+	}, nil
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fetchai/fetchd/x/oracle/internal/types"
+)
+
+// InitGenesis stores the params and the state of the oracle of the genesis
+func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
+	keeper.setParams(ctx, data.Params)
+	for _, p := range data.Prices {
+		keeper.setPrice(ctx, p)
+	}
+	for _, v := range data.Votes {
+		keeper.setVote(ctx, v)
+	}
+	for _, d := range data.FeederDelegations {
+		keeper.SetFeeder(ctx, d.Validator, d.Feeder)
+	}
+	for _, m := range data.MissCounters {
+		keeper.setMissCounter(ctx, m.Validator, m.Misses)
+	}
+}
+
+// ExportGenesis returns the params and the state of the oracle as genesis state
+func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
+	data := types.GenesisState{Params: keeper.GetParams(ctx)}
+	keeper.IteratePrices(ctx, func(p types.Price) bool {
+		data.Prices = append(data.Prices, p)
+		return false
+	})
+	keeper.IterateVotes(ctx, func(v types.Vote) bool {
+		data.Votes = append(data.Votes, v)
+		return false
+	})
+	keeper.IterateFeeders(ctx, func(d types.FeederDelegation) bool {
+		data.FeederDelegations = append(data.FeederDelegations, d)
+		return false
+	})
+	keeper.IterateMissCounters(ctx, func(m types.MissCounter) bool {
+		data.MissCounters = append(data.MissCounters, m)
+		return false
+	})
+	return data
+}
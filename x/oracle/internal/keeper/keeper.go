@@ -0,0 +1,273 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	stakingexported "github.com/cosmos/cosmos-sdk/x/staking/exported"
+
+	"github.com/fetchai/fetchd/x/oracle/internal/types"
+)
+
+// Keeper tallies the feed votes of the bonded validators per vote period and slashes validators that miss votes
+type Keeper struct {
+	storeKey      sdk.StoreKey
+	cdc           *codec.Codec
+	paramSpace    params.Subspace
+	stakingKeeper types.StakingKeeper
+}
+
+// NewKeeper creates a new oracle Keeper instance
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, paramSpace params.Subspace, stakingKeeper types.StakingKeeper) Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+	return Keeper{
+		storeKey:      storeKey,
+		cdc:           cdc,
+		paramSpace:    paramSpace,
+		stakingKeeper: stakingKeeper,
+	}
+}
+
+// GetParams returns the total set of oracle parameters.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	var params types.Params
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+func (k Keeper) setParams(ctx sdk.Context, ps types.Params) {
+	k.paramSpace.SetParamSet(ctx, &ps)
+}
+
+// Vote stores the vote of the bonded validator for the current vote period. The feeder must be the operator or the
+// delegated feeder of the validator.
+func (k Keeper) Vote(ctx sdk.Context, feeder sdk.AccAddress, val sdk.ValAddress, values []types.FeedValue) error {
+	if !feeder.Equals(sdk.AccAddress(val)) {
+		if delegate := k.GetFeeder(ctx, val); delegate == nil || !delegate.Equals(feeder) {
+			return sdkerrors.Wrapf(types.ErrNoVotingPermission, "%s for %s", feeder, val)
+		}
+	}
+	validator := k.stakingKeeper.Validator(ctx, val)
+	if validator == nil || !validator.IsBonded() || validator.IsJailed() {
+		return sdkerrors.Wrap(types.ErrNotBonded, val.String())
+	}
+	params := k.GetParams(ctx)
+	for _, v := range values {
+		if !params.IsFeed(v.Feed) {
+			return sdkerrors.Wrap(types.ErrUnknownFeed, v.Feed)
+		}
+	}
+	k.setVote(ctx, types.Vote{Validator: val, Values: values})
+	return nil
+}
+
+// EndBlocker tallies the votes at the end of each vote period and slashes the validators that voted too few periods
+// at the end of each slash window
+func (k Keeper) EndBlocker(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	height := uint64(ctx.BlockHeight())
+	if (height+1)%params.VotePeriod != 0 {
+		return
+	}
+	k.tally(ctx, params)
+	if ((height+1)/params.VotePeriod)%params.SlashWindow == 0 {
+		k.slashMissers(ctx, params)
+	}
+}
+
+// tally sets the weighted median of the votes of each feed that was voted by at least the vote threshold of the
+// bonded power, counts a miss for every bonded validator that did not vote all feeds and clears the votes
+func (k Keeper) tally(ctx sdk.Context, params types.Params) {
+	votes := make(map[string][]types.FeedValue)
+	k.IterateVotes(ctx, func(v types.Vote) bool {
+		votes[string(v.Validator)] = v.Values
+		return false
+	})
+
+	weighted := make(map[string][]types.WeightedValue, len(params.Feeds))
+	var totalPower int64
+	k.stakingKeeper.IterateBondedValidatorsByPower(ctx, func(_ int64, validator stakingexported.ValidatorI) bool {
+		power := validator.GetConsensusPower()
+		totalPower += power
+		values := votes[string(validator.GetOperator())]
+		voted := 0
+		for _, v := range values {
+			// the feeds param may have changed during the period
+			if params.IsFeed(v.Feed) {
+				weighted[v.Feed] = append(weighted[v.Feed], types.WeightedValue{Value: v.Value, Power: power})
+				voted++
+			}
+		}
+		if voted < len(params.Feeds) {
+			k.setMissCounter(ctx, validator.GetOperator(), k.GetMissCounter(ctx, validator.GetOperator())+1)
+		}
+		return false
+	})
+
+	for _, feed := range params.Feeds {
+		var votedPower int64
+		for _, w := range weighted[feed] {
+			votedPower += w.Power
+		}
+		if votedPower == 0 || sdk.NewDec(votedPower).LT(params.VoteThreshold.MulInt64(totalPower)) {
+			continue
+		}
+		price := types.Price{Feed: feed, Value: types.WeightedMedian(weighted[feed]), Height: ctx.BlockHeight()}
+		k.setPrice(ctx, price)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypePriceUpdate,
+			sdk.NewAttribute(types.AttributeKeyFeed, feed),
+			sdk.NewAttribute(types.AttributeKeyValue, price.Value.String()),
+		))
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for val := range votes {
+		store.Delete(types.GetVoteKey(sdk.ValAddress(val)))
+	}
+}
+
+// slashMissers slashes and jails the bonded validators that voted all feeds in less than the min valid share of the
+// vote periods of the slash window and resets the miss counters
+func (k Keeper) slashMissers(ctx sdk.Context, params types.Params) {
+	var missers []stakingexported.ValidatorI
+	k.stakingKeeper.IterateBondedValidatorsByPower(ctx, func(_ int64, validator stakingexported.ValidatorI) bool {
+		misses := k.GetMissCounter(ctx, validator.GetOperator())
+		if misses > params.SlashWindow {
+			// the slash window param was reduced during the window
+			misses = params.SlashWindow
+		}
+		valid := sdk.NewDec(int64(params.SlashWindow - misses)).QuoInt64(int64(params.SlashWindow))
+		if valid.LT(params.MinValidPerWindow) && !validator.IsJailed() {
+			missers = append(missers, validator)
+		}
+		return false
+	})
+	// the slashes change the power index, so they are not applied while iterating it. The stake that was bonded in
+	// the window is slashed, like for downtime.
+	distributionHeight := ctx.BlockHeight() - sdk.ValidatorUpdateDelay - 1
+	for _, validator := range missers {
+		k.stakingKeeper.Slash(ctx, validator.GetConsAddr(), distributionHeight, validator.GetConsensusPower(), params.SlashFraction)
+		k.stakingKeeper.Jail(ctx, validator.GetConsAddr())
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeOracleSlash,
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.GetOperator().String()),
+			sdk.NewAttribute(types.AttributeKeyMisses, strconv.FormatUint(k.GetMissCounter(ctx, validator.GetOperator()), 10)),
+		))
+	}
+
+	var counters []types.MissCounter
+	k.IterateMissCounters(ctx, func(m types.MissCounter) bool {
+		counters = append(counters, m)
+		return false
+	})
+	store := ctx.KVStore(k.storeKey)
+	for _, m := range counters {
+		store.Delete(types.GetMissCounterKey(m.Validator))
+	}
+}
+
+// GetPrice returns the value of the feed, nil when it was never set
+func (k Keeper) GetPrice(ctx sdk.Context, feed string) *types.Price {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetPriceKey(feed))
+	if bz == nil {
+		return nil
+	}
+	var price types.Price
+	k.cdc.MustUnmarshalBinaryBare(bz, &price)
+	return &price
+}
+
+func (k Keeper) setPrice(ctx sdk.Context, price types.Price) {
+	ctx.KVStore(k.storeKey).Set(types.GetPriceKey(price.Feed), k.cdc.MustMarshalBinaryBare(price))
+}
+
+// IteratePrices calls cb for the values of all feeds in feed order, until cb returns true
+func (k Keeper) IteratePrices(ctx sdk.Context, cb func(types.Price) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.PricePrefix).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var price types.Price
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &price)
+		if cb(price) {
+			return
+		}
+	}
+}
+
+func (k Keeper) setVote(ctx sdk.Context, vote types.Vote) {
+	ctx.KVStore(k.storeKey).Set(types.GetVoteKey(vote.Validator), k.cdc.MustMarshalBinaryBare(vote))
+}
+
+// IterateVotes calls cb for the votes of the current vote period, until cb returns true
+func (k Keeper) IterateVotes(ctx sdk.Context, cb func(types.Vote) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.VotePrefix).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var vote types.Vote
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &vote)
+		if cb(vote) {
+			return
+		}
+	}
+}
+
+// GetFeeder returns the delegated feeder of the validator, nil when there is none
+func (k Keeper) GetFeeder(ctx sdk.Context, val sdk.ValAddress) sdk.AccAddress {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetFeederKey(val))
+	if bz == nil {
+		return nil
+	}
+	return sdk.AccAddress(bz)
+}
+
+// SetFeeder delegates the votes of the validator to the feeder, an empty feeder removes the delegation
+func (k Keeper) SetFeeder(ctx sdk.Context, val sdk.ValAddress, feeder sdk.AccAddress) {
+	if feeder.Empty() {
+		ctx.KVStore(k.storeKey).Delete(types.GetFeederKey(val))
+		return
+	}
+	ctx.KVStore(k.storeKey).Set(types.GetFeederKey(val), feeder)
+}
+
+// IterateFeeders calls cb for all feeder delegations, until cb returns true
+func (k Keeper) IterateFeeders(ctx sdk.Context, cb func(types.FeederDelegation) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.FeederPrefix).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if cb(types.FeederDelegation{Validator: sdk.ValAddress(iter.Key()), Feeder: sdk.AccAddress(iter.Value())}) {
+			return
+		}
+	}
+}
+
+// GetMissCounter returns the number of vote periods of the current slash window the validator missed
+func (k Keeper) GetMissCounter(ctx sdk.Context, val sdk.ValAddress) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetMissCounterKey(val))
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setMissCounter(ctx sdk.Context, val sdk.ValAddress, misses uint64) {
+	ctx.KVStore(k.storeKey).Set(types.GetMissCounterKey(val), sdk.Uint64ToBigEndian(misses))
+}
+
+// IterateMissCounters calls cb for all validators with missed vote periods, until cb returns true
+func (k Keeper) IterateMissCounters(ctx sdk.Context, cb func(types.MissCounter) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.MissCounterPrefix).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if cb(types.MissCounter{Validator: sdk.ValAddress(iter.Key()), Misses: binary.BigEndian.Uint64(iter.Value())}) {
+			return
+		}
+	}
+}
@@ -0,0 +1,253 @@
+package keeper
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingexported "github.com/cosmos/cosmos-sdk/x/staking/exported"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/fetchai/fetchd/testutil"
+	"github.com/fetchai/fetchd/x/oracle/internal/types"
+)
+
+var (
+	val1   = sdk.ValAddress([]byte("validator1__________"))
+	val2   = sdk.ValAddress([]byte("validator2__________"))
+	val3   = sdk.ValAddress([]byte("validator3__________"))
+	feeder = sdk.AccAddress([]byte("feeder______________"))
+)
+
+func setupKeeper(t *testing.T) (sdk.Context, Keeper, *mockStakingKeeper) {
+	cdc := codec.New()
+	types.RegisterCodec(cdc)
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	ctx, paramsKeeper := testutil.NewContext(t, cdc, abci.Header{Height: 9}, key)
+	sk := &mockStakingKeeper{validators: map[string]staking.Validator{}}
+	sk.add(val1, 50)
+	sk.add(val2, 30)
+	sk.add(val3, 20)
+	k := NewKeeper(cdc, key, paramsKeeper.Subspace(types.DefaultParamspace), sk)
+
+	p := types.DefaultParams()
+	p.Feeds = []string{"FET/USD", "ETH/USD"}
+	InitGenesis(ctx, k, types.GenesisState{Params: p})
+	return ctx, k, sk
+}
+
+func values(fetUSD, ethUSD string) []types.FeedValue {
+	return []types.FeedValue{
+		{Feed: "FET/USD", Value: sdk.MustNewDecFromStr(fetUSD)},
+		{Feed: "ETH/USD", Value: sdk.MustNewDecFromStr(ethUSD)},
+	}
+}
+
+func TestVote(t *testing.T) {
+	specs := map[string]struct {
+		feeder sdk.AccAddress
+		val    sdk.ValAddress
+		values []types.FeedValue
+		setup  func(sdk.Context, Keeper, *mockStakingKeeper)
+		expErr bool
+	}{
+		"operator":         {feeder: sdk.AccAddress(val1), val: val1, values: values("0.25", "1800")},
+		"some feeds":       {feeder: sdk.AccAddress(val1), val: val1, values: values("0.25", "1800")[:1]},
+		"delegated feeder": {feeder: feeder, val: val1, values: values("0.25", "1800"), setup: func(ctx sdk.Context, k Keeper, _ *mockStakingKeeper) { k.SetFeeder(ctx, val1, feeder) }},
+		"other feeder":     {feeder: feeder, val: val1, values: values("0.25", "1800"), expErr: true},
+		"other operator":   {feeder: sdk.AccAddress(val2), val: val1, values: values("0.25", "1800"), expErr: true},
+		"unknown validator": {
+			feeder: feeder,
+			val:    sdk.ValAddress(feeder),
+			values: values("0.25", "1800"),
+			expErr: true,
+		},
+		"jailed": {
+			feeder: sdk.AccAddress(val1),
+			val:    val1,
+			values: values("0.25", "1800"),
+			setup: func(ctx sdk.Context, _ Keeper, sk *mockStakingKeeper) {
+				sk.Jail(ctx, sk.validators[val1.String()].GetConsAddr())
+			},
+			expErr: true,
+		},
+		"unknown feed": {
+			feeder: sdk.AccAddress(val1),
+			val:    val1,
+			values: []types.FeedValue{{Feed: "BTC/USD", Value: sdk.NewDec(1)}},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, k, sk := setupKeeper(t)
+			if spec.setup != nil {
+				spec.setup(ctx, k, sk)
+			}
+			err := k.Vote(ctx, spec.feeder, spec.val, spec.values)
+			var votes []types.Vote
+			k.IterateVotes(ctx, func(v types.Vote) bool {
+				votes = append(votes, v)
+				return false
+			})
+			if spec.expErr {
+				require.Error(t, err)
+				assert.Empty(t, votes)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, []types.Vote{{Validator: spec.val, Values: spec.values}}, votes)
+		})
+	}
+}
+
+func TestEndBlockerTally(t *testing.T) {
+	specs := map[string]struct {
+		votes     map[string][]types.FeedValue
+		expPrices []types.Price
+		expMisses map[string]uint64
+	}{
+		"all voted": {
+			votes:     map[string][]types.FeedValue{val1.String(): values("0.2", "1800"), val2.String(): values("0.1", "1900"), val3.String(): values("0.3", "1700")},
+			expPrices: []types.Price{{Feed: "ETH/USD", Value: sdk.NewDec(1800), Height: 9}, {Feed: "FET/USD", Value: sdk.NewDecWithPrec(2, 1), Height: 9}},
+			expMisses: map[string]uint64{},
+		},
+		"threshold voted": {
+			votes:     map[string][]types.FeedValue{val2.String(): values("0.1", "1900"), val3.String(): values("0.3", "1700")},
+			expPrices: []types.Price{{Feed: "ETH/USD", Value: sdk.NewDec(1900), Height: 9}, {Feed: "FET/USD", Value: sdk.NewDecWithPrec(1, 1), Height: 9}},
+			expMisses: map[string]uint64{val1.String(): 1},
+		},
+		"below threshold": {
+			votes:     map[string][]types.FeedValue{val2.String(): values("0.1", "1900")},
+			expMisses: map[string]uint64{val1.String(): 1, val3.String(): 1},
+		},
+		"feed below threshold": {
+			votes:     map[string][]types.FeedValue{val1.String(): values("0.2", "1800")[:1], val2.String(): values("0.1", "1900")},
+			expPrices: []types.Price{{Feed: "FET/USD", Value: sdk.NewDecWithPrec(2, 1), Height: 9}},
+			expMisses: map[string]uint64{val1.String(): 1, val3.String(): 1},
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, k, _ := setupKeeper(t)
+			for val, v := range spec.votes {
+				addr, _ := sdk.ValAddressFromBech32(val)
+				require.NoError(t, k.Vote(ctx, sdk.AccAddress(addr), addr, v))
+			}
+			k.EndBlocker(ctx)
+
+			genesis := ExportGenesis(ctx, k)
+			assert.Equal(t, spec.expPrices, genesis.Prices)
+			assert.Empty(t, genesis.Votes)
+			misses := map[string]uint64{}
+			for _, m := range genesis.MissCounters {
+				misses[m.Validator.String()] = m.Misses
+			}
+			assert.Equal(t, spec.expMisses, misses)
+		})
+	}
+}
+
+func TestEndBlockerOutsideVotePeriodEnd(t *testing.T) {
+	ctx, k, _ := setupKeeper(t)
+	ctx = ctx.WithBlockHeight(10)
+	require.NoError(t, k.Vote(ctx, sdk.AccAddress(val1), val1, values("0.2", "1800")))
+	k.EndBlocker(ctx)
+
+	genesis := ExportGenesis(ctx, k)
+	assert.Empty(t, genesis.Prices)
+	assert.Len(t, genesis.Votes, 1)
+	assert.Empty(t, genesis.MissCounters)
+}
+
+func TestEndBlockerSlashMissers(t *testing.T) {
+	ctx, k, sk := setupKeeper(t)
+	p := k.GetParams(ctx)
+	p.VotePeriod = 1
+	p.SlashWindow = 4
+	p.MinValidPerWindow = sdk.NewDecWithPrec(50, 2)
+	k.setParams(ctx, p)
+
+	// val2 votes half of the window, val3 a single period
+	for height := int64(4); height < 8; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		require.NoError(t, k.Vote(ctx, sdk.AccAddress(val1), val1, values("0.2", "1800")))
+		if height%2 == 0 {
+			require.NoError(t, k.Vote(ctx, sdk.AccAddress(val2), val2, values("0.1", "1900")))
+		}
+		if height == 4 {
+			require.NoError(t, k.Vote(ctx, sdk.AccAddress(val3), val3, values("0.3", "1700")))
+		}
+		k.EndBlocker(ctx)
+	}
+
+	assert.Equal(t, []string{sk.validators[val3.String()].GetConsAddr().String()}, sk.slashed)
+	assert.False(t, sk.validators[val1.String()].IsJailed())
+	assert.False(t, sk.validators[val2.String()].IsJailed())
+	assert.True(t, sk.validators[val3.String()].IsJailed())
+	assert.Empty(t, ExportGenesis(ctx, k).MissCounters)
+}
+
+func TestFeederDelegation(t *testing.T) {
+	ctx, k, _ := setupKeeper(t)
+	k.SetFeeder(ctx, val1, feeder)
+	assert.Equal(t, feeder, k.GetFeeder(ctx, val1))
+	assert.Equal(t, []types.FeederDelegation{{Validator: val1, Feeder: feeder}}, ExportGenesis(ctx, k).FeederDelegations)
+
+	k.SetFeeder(ctx, val1, nil)
+	assert.Nil(t, k.GetFeeder(ctx, val1))
+	assert.Empty(t, ExportGenesis(ctx, k).FeederDelegations)
+}
+
+type mockStakingKeeper struct {
+	validators map[string]staking.Validator
+	slashed    []string
+}
+
+func (m *mockStakingKeeper) add(operator sdk.ValAddress, power int64) {
+	v := staking.NewValidator(operator, ed25519.GenPrivKey().PubKey(), staking.Description{})
+	v.Status = sdk.Bonded
+	v.Tokens = sdk.TokensFromConsensusPower(power)
+	m.validators[operator.String()] = v
+}
+
+func (m *mockStakingKeeper) Validator(_ sdk.Context, address sdk.ValAddress) stakingexported.ValidatorI {
+	v, ok := m.validators[address.String()]
+	if !ok {
+		return nil
+	}
+	return v
+}
+
+func (m *mockStakingKeeper) IterateBondedValidatorsByPower(_ sdk.Context, fn func(index int64, validator stakingexported.ValidatorI) (stop bool)) {
+	var bonded []staking.Validator
+	for _, v := range m.validators {
+		if v.IsBonded() {
+			bonded = append(bonded, v)
+		}
+	}
+	sort.Slice(bonded, func(i, j int) bool { return bonded[i].Tokens.GT(bonded[j].Tokens) })
+	for i, v := range bonded {
+		if fn(int64(i), v) {
+			return
+		}
+	}
+}
+
+func (m *mockStakingKeeper) Slash(_ sdk.Context, consAddr sdk.ConsAddress, _ int64, _ int64, _ sdk.Dec) {
+	m.slashed = append(m.slashed, consAddr.String())
+}
+
+func (m *mockStakingKeeper) Jail(_ sdk.Context, consAddr sdk.ConsAddress) {
+	for addr, v := range m.validators {
+		if v.GetConsAddr().Equals(consAddr) {
+			v.Jailed = true
+			m.validators[addr] = v
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"encoding/json"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/fetchai/fetchd/x/oracle/internal/types"
+)
+
+const (
+	// QueryParams returns the oracle params
+	QueryParams = "params"
+	// QueryPrice returns the value of a feed, path: feed name, the / of pair names is a path separator
+	QueryPrice = "price"
+	// QueryPrices lists the values of all feeds
+	QueryPrices = "prices"
+	// QueryVotes lists the votes of the current vote period
+	QueryVotes = "votes"
+	// QueryValidator returns the feeder and the missed vote periods of a validator, path: validator address
+	QueryValidator = "validator"
+)
+
+// ValidatorInfo is the oracle state of a validator
+type ValidatorInfo struct {
+	Validator sdk.ValAddress `json:"validator"`
+	Feeder    sdk.AccAddress `json:"feeder,omitempty"`
+	// Misses is the number of vote periods of the current slash window without a vote of all feeds
+	Misses uint64 `json:"misses"`
+}
+
+// NewQuerier creates a new querier
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		switch path[0] {
+		case QueryParams:
+			return marshal(keeper.GetParams(ctx))
+		case QueryPrice:
+			if len(path) < 2 {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "feed required")
+			}
+			price := keeper.GetPrice(ctx, strings.Join(path[1:], "/"))
+			if price == nil {
+				return []byte("null"), nil
+			}
+			return marshal(price)
+		case QueryPrices:
+			res := []types.Price{}
+			keeper.IteratePrices(ctx, func(p types.Price) bool {
+				res = append(res, p)
+				return false
+			})
+			return marshal(res)
+		case QueryVotes:
+			res := []types.Vote{}
+			keeper.IterateVotes(ctx, func(v types.Vote) bool {
+				res = append(res, v)
+				return false
+			})
+			return marshal(res)
+		case QueryValidator:
+			if len(path) < 2 {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "validator required")
+			}
+			val, err := sdk.ValAddressFromBech32(path[1])
+			if err != nil {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+			}
+			return marshal(ValidatorInfo{
+				Validator: val,
+				Feeder:    keeper.GetFeeder(ctx, val),
+				Misses:    keeper.GetMissCounter(ctx, val),
+			})
+		default:
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown data query endpoint")
+		}
+	}
+}
+
+func marshal(v interface{}) ([]byte, error) {
+	bz, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
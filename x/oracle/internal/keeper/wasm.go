@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/fetchai/fetchd/x/oracle/internal/types"
+)
+
+// WasmQueryRoute is the custom query route of contracts for the oracle feeds
+const WasmQueryRoute = types.ModuleName
+
+// WasmQuery is the custom query of contracts, e.g. `{"oracle":{"price":{"feed":"FET/USD"}}}`
+type WasmQuery struct {
+	Price  *WasmPriceQuery `json:"price,omitempty"`
+	Prices *struct{}       `json:"prices,omitempty"`
+}
+
+// WasmPriceQuery returns the value of the feed
+type WasmPriceQuery struct {
+	Feed string `json:"feed"`
+}
+
+// NewWasmQuerier returns the custom querier of contracts for the oracle feeds, to be registered with
+// WasmQueryRoute. The price query returns the price json, with the value as decimal string, and fails for feeds
+// without value; the prices query returns a list of them.
+func NewWasmQuerier(keeper Keeper) func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var query WasmQuery
+		if err := json.Unmarshal(request, &query); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+		switch {
+		case query.Price != nil:
+			price := keeper.GetPrice(ctx, query.Price.Feed)
+			if price == nil {
+				return nil, sdkerrors.Wrapf(types.ErrUnknownFeed, "no value of %s", query.Price.Feed)
+			}
+			return json.Marshal(price)
+		case query.Prices != nil:
+			res := []types.Price{}
+			keeper.IteratePrices(ctx, func(p types.Price) bool {
+				res = append(res, p)
+				return false
+			})
+			return json.Marshal(res)
+		default:
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown oracle query")
+		}
+	}
+}
@@ -0,0 +1,21 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers the msg types of the oracle module
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgFeedVote{}, "oracle/MsgFeedVote", nil)
+	cdc.RegisterConcrete(MsgDelegateFeeder{}, "oracle/MsgDelegateFeeder", nil)
+}
+
+// ModuleCdc generic sealed codec to be used throughout module
+var ModuleCdc *codec.Codec
+
+func init() {
+	cdc := codec.New()
+	RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	ModuleCdc = cdc.Seal()
+}
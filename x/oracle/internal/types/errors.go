@@ -0,0 +1,22 @@
+package types
+
+import (
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Codes for oracle errors
+var (
+	DefaultCodespace = ModuleName
+
+	// ErrInvalidFeed error for an invalid feed name or value
+	ErrInvalidFeed = sdkErrors.Register(DefaultCodespace, 1, "invalid feed")
+
+	// ErrUnknownFeed error for a feed that is not in the feeds param
+	ErrUnknownFeed = sdkErrors.Register(DefaultCodespace, 2, "unknown feed")
+
+	// ErrNoVotingPermission error for a vote by an account that is neither the operator nor the feeder of the validator
+	ErrNoVotingPermission = sdkErrors.Register(DefaultCodespace, 3, "no voting permission")
+
+	// ErrNotBonded error for a vote of a validator that is not bonded
+	ErrNotBonded = sdkErrors.Register(DefaultCodespace, 4, "validator not bonded")
+)
@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingexported "github.com/cosmos/cosmos-sdk/x/staking/exported"
+)
+
+// StakingKeeper defines the validator set that votes the feeds, weighted by consensus power, and the slashing of
+// validators that miss votes
+type StakingKeeper interface {
+	Validator(ctx sdk.Context, address sdk.ValAddress) stakingexported.ValidatorI
+	IterateBondedValidatorsByPower(ctx sdk.Context, fn func(index int64, validator stakingexported.ValidatorI) (stop bool))
+	Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight int64, power int64, slashFactor sdk.Dec)
+	Jail(ctx sdk.Context, consAddr sdk.ConsAddress)
+}
@@ -0,0 +1,69 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// FeederDelegation is the feeder account that votes for a validator
+type FeederDelegation struct {
+	Validator sdk.ValAddress `json:"validator"`
+	Feeder    sdk.AccAddress `json:"feeder"`
+}
+
+// MissCounter is the number of vote periods of the current slash window a validator did not vote all feeds in
+type MissCounter struct {
+	Validator sdk.ValAddress `json:"validator"`
+	Misses    uint64         `json:"misses"`
+}
+
+// GenesisState is the genesis state of the oracle module
+type GenesisState struct {
+	Params            Params             `json:"params"`
+	Prices            []Price            `json:"prices,omitempty"`
+	Votes             []Vote             `json:"votes,omitempty"`
+	FeederDelegations []FeederDelegation `json:"feeder_delegations,omitempty"`
+	MissCounters      []MissCounter      `json:"miss_counters,omitempty"`
+}
+
+// DefaultGenesisState returns the genesis state of an oracle without feeds
+func DefaultGenesisState() GenesisState {
+	return GenesisState{Params: DefaultParams()}
+}
+
+// ValidateGenesis performs basic validation of the genesis state
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "params")
+	}
+	for _, p := range data.Prices {
+		if err := ValidateFeedValues([]FeedValue{{Feed: p.Feed, Value: p.Value}}); err != nil {
+			return sdkerrors.Wrap(err, "price")
+		}
+	}
+	for _, v := range data.Votes {
+		if err := sdk.VerifyAddressFormat(v.Validator); err != nil {
+			return sdkerrors.Wrap(err, "vote validator")
+		}
+		if err := ValidateFeedValues(v.Values); err != nil {
+			return sdkerrors.Wrapf(err, "vote of %s", v.Validator)
+		}
+	}
+	for _, d := range data.FeederDelegations {
+		if err := sdk.VerifyAddressFormat(d.Validator); err != nil {
+			return sdkerrors.Wrap(err, "feeder delegation validator")
+		}
+		if err := sdk.VerifyAddressFormat(d.Feeder); err != nil {
+			return sdkerrors.Wrapf(err, "feeder of %s", d.Validator)
+		}
+	}
+	for _, m := range data.MissCounters {
+		if err := sdk.VerifyAddressFormat(m.Validator); err != nil {
+			return sdkerrors.Wrap(err, "miss counter validator")
+		}
+		if m.Misses > data.Params.SlashWindow {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "misses of %s exceed the slash window", m.Validator)
+		}
+	}
+	return nil
+}
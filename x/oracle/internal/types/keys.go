@@ -0,0 +1,62 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the oracle module
+	ModuleName = "oracle"
+
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for the oracle module
+	QuerierRoute = ModuleName
+
+	// RouterKey is the msg router key for the oracle module
+	RouterKey = ModuleName
+)
+
+const ( // event attributes
+	AttributeKeyFeed      = "feed"
+	AttributeKeyValue     = "value"
+	AttributeKeyValidator = "validator"
+	AttributeKeyFeeder    = "feeder"
+	AttributeKeyMisses    = "misses"
+)
+
+const (
+	// EventTypePriceUpdate is emitted when the votes of a period set the value of a feed
+	EventTypePriceUpdate = "price_update"
+	// EventTypeOracleSlash is emitted when a validator is slashed for missing votes
+	EventTypeOracleSlash = "oracle_slash"
+)
+
+// nolint
+var (
+	VotePrefix        = []byte{0x01}
+	PricePrefix       = []byte{0x02}
+	FeederPrefix      = []byte{0x03}
+	MissCounterPrefix = []byte{0x04}
+)
+
+// GetVoteKey returns the store key of the vote of the validator in the current vote period
+func GetVoteKey(val sdk.ValAddress) []byte {
+	return append(VotePrefix, val...)
+}
+
+// GetPriceKey returns the store key of the value of the feed
+func GetPriceKey(feed string) []byte {
+	return append(PricePrefix, []byte(feed)...)
+}
+
+// GetFeederKey returns the store key of the feeder delegation of the validator
+func GetFeederKey(val sdk.ValAddress) []byte {
+	return append(FeederPrefix, val...)
+}
+
+// GetMissCounterKey returns the store key of the missed vote periods of the validator in the slash window
+func GetMissCounterKey(val sdk.ValAddress) []byte {
+	return append(MissCounterPrefix, val...)
+}
@@ -0,0 +1,75 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MsgFeedVote is the vote of a validator for the feed values of the current vote period, it replaces an earlier vote
+// of the period. It is signed by the operator or the delegated feeder of the validator.
+type MsgFeedVote struct {
+	Feeder    sdk.AccAddress `json:"feeder" yaml:"feeder"`
+	Validator sdk.ValAddress `json:"validator" yaml:"validator"`
+	Values    []FeedValue    `json:"values" yaml:"values"`
+}
+
+func (msg MsgFeedVote) Route() string {
+	return RouterKey
+}
+
+func (msg MsgFeedVote) Type() string {
+	return "feed-vote"
+}
+
+func (msg MsgFeedVote) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Feeder); err != nil {
+		return sdkerrors.Wrap(err, "feeder")
+	}
+	if err := sdk.VerifyAddressFormat(msg.Validator); err != nil {
+		return sdkerrors.Wrap(err, "validator")
+	}
+	return ValidateFeedValues(msg.Values)
+}
+
+func (msg MsgFeedVote) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgFeedVote) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Feeder}
+}
+
+// MsgDelegateFeeder lets the feeder account vote for the validator, so that the operator key can stay offline. An
+// empty feeder removes the delegation.
+type MsgDelegateFeeder struct {
+	Operator sdk.ValAddress `json:"operator" yaml:"operator"`
+	Feeder   sdk.AccAddress `json:"feeder,omitempty" yaml:"feeder"`
+}
+
+func (msg MsgDelegateFeeder) Route() string {
+	return RouterKey
+}
+
+func (msg MsgDelegateFeeder) Type() string {
+	return "delegate-feeder"
+}
+
+func (msg MsgDelegateFeeder) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Operator); err != nil {
+		return sdkerrors.Wrap(err, "operator")
+	}
+	if !msg.Feeder.Empty() {
+		if err := sdk.VerifyAddressFormat(msg.Feeder); err != nil {
+			return sdkerrors.Wrap(err, "feeder")
+		}
+	}
+	return nil
+}
+
+func (msg MsgDelegateFeeder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgDelegateFeeder) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Operator)}
+}
@@ -0,0 +1,90 @@
+package types
+
+import (
+	"regexp"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// reFeed matches feed names like FET/USD or BTC
+var reFeed = regexp.MustCompile(`^[A-Za-z0-9]{1,16}(/[A-Za-z0-9]{1,16})?$`)
+
+// ValidateFeed validates the name of a feed
+func ValidateFeed(feed string) error {
+	if !reFeed.MatchString(feed) {
+		return sdkerrors.Wrapf(ErrInvalidFeed, "invalid feed name: %q", feed)
+	}
+	return nil
+}
+
+// FeedValue is the value of a feed voted by a validator
+type FeedValue struct {
+	Feed  string  `json:"feed" yaml:"feed"`
+	Value sdk.Dec `json:"value" yaml:"value"`
+}
+
+// ValidateFeedValues validates the values of a vote, each feed must be voted at most once with a positive value
+func ValidateFeedValues(values []FeedValue) error {
+	if len(values) == 0 {
+		return sdkerrors.Wrap(ErrInvalidFeed, "no values")
+	}
+	seen := make(map[string]struct{}, len(values))
+	for _, v := range values {
+		if err := ValidateFeed(v.Feed); err != nil {
+			return err
+		}
+		if _, exists := seen[v.Feed]; exists {
+			return sdkerrors.Wrapf(ErrInvalidFeed, "duplicate feed %s", v.Feed)
+		}
+		seen[v.Feed] = struct{}{}
+		if v.Value.IsNil() || !v.Value.IsPositive() {
+			return sdkerrors.Wrapf(ErrInvalidFeed, "value of %s must be positive", v.Feed)
+		}
+	}
+	return nil
+}
+
+// Vote is the vote of a validator in the current vote period
+type Vote struct {
+	Validator sdk.ValAddress `json:"validator" yaml:"validator"`
+	Values    []FeedValue    `json:"values" yaml:"values"`
+}
+
+// Price is the value of a feed set by the votes of a vote period
+type Price struct {
+	Feed  string  `json:"feed" yaml:"feed"`
+	Value sdk.Dec `json:"value" yaml:"value"`
+	// Height is the block height of the tally
+	Height int64 `json:"height" yaml:"height"`
+}
+
+// WeightedValue is a voted value with the consensus power of its validator
+type WeightedValue struct {
+	Value sdk.Dec
+	Power int64
+}
+
+// WeightedMedian returns the value at which the sorted values reach half of the total power, so that validators
+// with less than half of the power can not move the result out of the range of the honest votes. It panics on an
+// empty slice.
+func WeightedMedian(values []WeightedValue) sdk.Dec {
+	sorted := make([]WeightedValue, len(values))
+	copy(sorted, values)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Value.LT(sorted[j].Value)
+	})
+	var total int64
+	for _, v := range sorted {
+		total += v.Power
+	}
+	var sum int64
+	for _, v := range sorted {
+		sum += v.Power
+		if 2*sum >= total {
+			return v.Value
+		}
+	}
+	return sorted[len(sorted)-1].Value
+}
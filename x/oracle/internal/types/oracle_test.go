@@ -0,0 +1,82 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWeightedMedian(t *testing.T) {
+	dec := func(s string) sdk.Dec { return sdk.MustNewDecFromStr(s) }
+	specs := map[string]struct {
+		src []WeightedValue
+		exp sdk.Dec
+	}{
+		"single":       {src: []WeightedValue{{dec("1.5"), 1}}, exp: dec("1.5")},
+		"equal powers": {src: []WeightedValue{{dec("3"), 1}, {dec("1"), 1}, {dec("2"), 1}}, exp: dec("2")},
+		"heavy voter":  {src: []WeightedValue{{dec("1"), 1}, {dec("2"), 1}, {dec("9"), 5}}, exp: dec("9")},
+		"less than half outlier": {
+			src: []WeightedValue{{dec("1000"), 4}, {dec("1.01"), 3}, {dec("1"), 3}},
+			exp: dec("1.01"),
+		},
+		"even split": {src: []WeightedValue{{dec("2"), 1}, {dec("1"), 1}}, exp: dec("1")},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			assert.Equal(t, spec.exp.String(), WeightedMedian(spec.src).String())
+		})
+	}
+}
+
+func TestValidateFeedValues(t *testing.T) {
+	specs := map[string]struct {
+		src    []FeedValue
+		expErr bool
+	}{
+		"valid":          {src: []FeedValue{{"FET/USD", sdk.NewDecWithPrec(25, 2)}, {"BTC", sdk.NewDec(1)}}},
+		"empty":          {expErr: true},
+		"duplicate feed": {src: []FeedValue{{"FET/USD", sdk.NewDec(1)}, {"FET/USD", sdk.NewDec(2)}}, expErr: true},
+		"invalid feed":   {src: []FeedValue{{"FET-USD", sdk.NewDec(1)}}, expErr: true},
+		"zero value":     {src: []FeedValue{{"FET/USD", sdk.ZeroDec()}}, expErr: true},
+		"nil value":      {src: []FeedValue{{Feed: "FET/USD"}}, expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := ValidateFeedValues(spec.src)
+			if spec.expErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestParamsValidateBasic(t *testing.T) {
+	specs := map[string]struct {
+		mutate func(*Params)
+		expErr bool
+	}{
+		"default":          {mutate: func(*Params) {}},
+		"feeds":            {mutate: func(p *Params) { p.Feeds = []string{"FET/USD", "BTC/USD"} }},
+		"duplicate feed":   {mutate: func(p *Params) { p.Feeds = []string{"FET/USD", "FET/USD"} }, expErr: true},
+		"invalid feed":     {mutate: func(p *Params) { p.Feeds = []string{""} }, expErr: true},
+		"zero vote period": {mutate: func(p *Params) { p.VotePeriod = 0 }, expErr: true},
+		"zero window":      {mutate: func(p *Params) { p.SlashWindow = 0 }, expErr: true},
+		"threshold > 1":    {mutate: func(p *Params) { p.VoteThreshold = sdk.NewDec(2) }, expErr: true},
+		"nil slash":        {mutate: func(p *Params) { p.SlashFraction = sdk.Dec{} }, expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			p := DefaultParams()
+			spec.mutate(&p)
+			err := p.ValidateBasic()
+			if spec.expErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// DefaultParamspace for params keeper
+	DefaultParamspace = ModuleName
+)
+
+var ParamStoreKeyVotePeriod = []byte("votePeriod")
+var ParamStoreKeyFeeds = []byte("feeds")
+var ParamStoreKeyVoteThreshold = []byte("voteThreshold")
+var ParamStoreKeySlashWindow = []byte("slashWindow")
+var ParamStoreKeyMinValidPerWindow = []byte("minValidPerWindow")
+var ParamStoreKeySlashFraction = []byte("slashFraction")
+
+// Params defines the set of oracle parameters. They are changed by param change proposals.
+type Params struct {
+	// VotePeriod is the number of blocks of a vote period, at its end the votes are tallied
+	VotePeriod uint64 `json:"vote_period" yaml:"vote_period"`
+	// Feeds are the names of the data feeds the validators vote, e.g. FET/USD
+	Feeds []string `json:"feeds,omitempty" yaml:"feeds"`
+	// VoteThreshold is the min share of the bonded power that must vote a feed to update its value
+	VoteThreshold sdk.Dec `json:"vote_threshold" yaml:"vote_threshold"`
+	// SlashWindow is the number of vote periods after which the missed votes are counted
+	SlashWindow uint64 `json:"slash_window" yaml:"slash_window"`
+	// MinValidPerWindow is the min share of the vote periods of a slash window a validator must vote all feeds in
+	MinValidPerWindow sdk.Dec `json:"min_valid_per_window" yaml:"min_valid_per_window"`
+	// SlashFraction is the share of the stake a validator that votes too few periods is slashed and jailed for
+	SlashFraction sdk.Dec `json:"slash_fraction" yaml:"slash_fraction"`
+}
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns default oracle parameters, without feeds there is nothing to vote
+func DefaultParams() Params {
+	return Params{
+		VotePeriod:        5,
+		VoteThreshold:     sdk.NewDecWithPrec(50, 2),
+		SlashWindow:       1000,
+		MinValidPerWindow: sdk.NewDecWithPrec(5, 2),
+		SlashFraction:     sdk.NewDecWithPrec(1, 4),
+	}
+}
+
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(ParamStoreKeyVotePeriod, &p.VotePeriod, validatePositive),
+		params.NewParamSetPair(ParamStoreKeyFeeds, &p.Feeds, validateFeeds),
+		params.NewParamSetPair(ParamStoreKeyVoteThreshold, &p.VoteThreshold, validateFraction),
+		params.NewParamSetPair(ParamStoreKeySlashWindow, &p.SlashWindow, validatePositive),
+		params.NewParamSetPair(ParamStoreKeyMinValidPerWindow, &p.MinValidPerWindow, validateFraction),
+		params.NewParamSetPair(ParamStoreKeySlashFraction, &p.SlashFraction, validateFraction),
+	}
+}
+
+// ValidateBasic performs basic validation on oracle parameters.
+func (p Params) ValidateBasic() error {
+	if err := validatePositive(p.VotePeriod); err != nil {
+		return sdkerrors.Wrap(err, "vote period")
+	}
+	if err := validateFeeds(p.Feeds); err != nil {
+		return sdkerrors.Wrap(err, "feeds")
+	}
+	if err := validateFraction(p.VoteThreshold); err != nil {
+		return sdkerrors.Wrap(err, "vote threshold")
+	}
+	if err := validatePositive(p.SlashWindow); err != nil {
+		return sdkerrors.Wrap(err, "slash window")
+	}
+	if err := validateFraction(p.MinValidPerWindow); err != nil {
+		return sdkerrors.Wrap(err, "min valid per window")
+	}
+	if err := validateFraction(p.SlashFraction); err != nil {
+		return sdkerrors.Wrap(err, "slash fraction")
+	}
+	return nil
+}
+
+// IsFeed returns if the feed is in the feeds param
+func (p Params) IsFeed(feed string) bool {
+	for _, f := range p.Feeds {
+		if f == feed {
+			return true
+		}
+	}
+	return false
+}
+
+func validatePositive(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "must be positive")
+	}
+	return nil
+}
+
+func validateFeeds(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]struct{}, len(v))
+	for _, f := range v {
+		if err := ValidateFeed(f); err != nil {
+			return err
+		}
+		if _, exists := seen[f]; exists {
+			return sdkerrors.Wrapf(ErrInvalidFeed, "duplicate feed %s", f)
+		}
+		seen[f] = struct{}{}
+	}
+	return nil
+}
+
+func validateFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "must be in [0, 1]")
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package paychan
+
+import (
+	"github.com/fetchai/fetchd/x/paychan/internal/keeper"
+	"github.com/fetchai/fetchd/x/paychan/internal/types"
+)
+
+const (
+	ModuleName              = types.ModuleName
+	StoreKey                = types.StoreKey
+	QuerierRoute            = types.QuerierRoute
+	RouterKey               = types.RouterKey
+	DefaultParamspace       = types.DefaultParamspace
+	AttributeKeyChannelID   = types.AttributeKeyChannelID
+	AttributeKeySender      = types.AttributeKeySender
+	AttributeKeyRecipient   = types.AttributeKeyRecipient
+	AttributeKeyRefund      = types.AttributeKeyRefund
+	AttributeKeyCloseHeight = types.AttributeKeyCloseHeight
+	EventTypeOpen           = types.EventTypeOpen
+	EventTypeRedeem         = types.EventTypeRedeem
+	EventTypeStartClose     = types.EventTypeStartClose
+	EventTypeClose          = types.EventTypeClose
+	QueryParams             = keeper.QueryParams
+	QueryChannel            = keeper.QueryChannel
+	QueryChannels           = keeper.QueryChannels
+)
+
+var (
+	// functions aliases
+	RegisterCodec       = types.RegisterCodec
+	ValidateGenesis     = types.ValidateGenesis
+	DefaultGenesisState = types.DefaultGenesisState
+	DefaultParams       = types.DefaultParams
+	VoucherSignBytes    = types.VoucherSignBytes
+	NewKeeper           = keeper.NewKeeper
+	NewQuerier          = keeper.NewQuerier
+	InitGenesis         = keeper.InitGenesis
+	ExportGenesis       = keeper.ExportGenesis
+
+	// variable aliases
+	ModuleCdc          = types.ModuleCdc
+	ErrChannelNotFound = types.ErrChannelNotFound
+	ErrInvalidVoucher  = types.ErrInvalidVoucher
+	ErrExceedsDeposit  = types.ErrExceedsDeposit
+	ErrNotParty        = types.ErrNotParty
+	ErrClosing         = types.ErrClosing
+)
+
+type (
+	Keeper               = keeper.Keeper
+	GenesisState         = types.GenesisState
+	Params               = types.Params
+	Channel              = types.Channel
+	Voucher              = types.Voucher
+	MsgOpenChannel       = types.MsgOpenChannel
+	MsgRedeemVoucher     = types.MsgRedeemVoucher
+	MsgCloseChannel      = types.MsgCloseChannel
+	MsgStartCloseChannel = types.MsgStartCloseChannel
+)
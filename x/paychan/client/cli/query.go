@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/fetchai/fetchd/x/paychan/internal/keeper"
+	"github.com/fetchai/fetchd/x/paychan/internal/types"
+)
+
+// GetQueryCmd returns the query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the payment channels",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(flags.GetCommands(
+		GetCmdQueryParams(cdc),
+		GetCmdQueryChannel(cdc),
+		GetCmdQueryChannels(cdc),
+	)...)
+	return queryCmd
+}
+
+// GetCmdQueryParams shows the paychan params
+func GetCmdQueryParams(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "Show the dispute period",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, keeper.QueryParams))
+			if err != nil {
+				return err
+			}
+			var params types.Params
+			if err := json.Unmarshal(res, &params); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
+
+// GetCmdQueryChannel shows a channel
+func GetCmdQueryChannel(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "channel [channel_id]",
+		Short: "Show the deposit, the redeemed amount and the close height of an open or closing channel",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("channel id: %s", err)
+			}
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%d", types.QuerierRoute, keeper.QueryChannel, id))
+			if err != nil {
+				return err
+			}
+			var channel *types.Channel
+			if err := json.Unmarshal(res, &channel); err != nil {
+				return err
+			}
+			if channel == nil {
+				return sdkerrors.Wrapf(types.ErrChannelNotFound, "%d", id)
+			}
+			return cliCtx.PrintOutput(channel)
+		},
+	}
+}
+
+// GetCmdQueryChannels lists the channels of an account
+func GetCmdQueryChannels(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "channels [address]",
+		Short: "List the open and closing channels an account is the sender or the recipient of",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, keeper.QueryChannels, addr))
+			if err != nil {
+				return err
+			}
+			var channels []types.Channel
+			if err := json.Unmarshal(res, &channels); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(channels)
+		},
+	}
+}
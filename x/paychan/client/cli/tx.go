@@ -0,0 +1,220 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clientkeys "github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	"github.com/fetchai/fetchd/x/paychan/internal/types"
+	wasmUtils "github.com/fetchai/fetchd/x/wasm/client/utils"
+)
+
+const flagVoucher = "voucher"
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Payment channel transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	txCmd.AddCommand(wasmUtils.AddBroadcastFlags(flags.PostCommands(
+		OpenChannelCmd(cdc),
+		RedeemVoucherCmd(cdc),
+		CloseChannelCmd(cdc),
+		StartCloseChannelCmd(cdc),
+	)...)...)
+	txCmd.AddCommand(flags.PostCommands(CreateVoucherCmd(cdc))...)
+	return txCmd
+}
+
+// OpenChannelCmd opens a channel from the --from account
+func OpenChannelCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "open [recipient] [deposit]",
+		Short: "Open a payment channel to the recipient with a deposit of the --from account",
+		Long: `Open a unidirectional payment channel to the recipient. The --from account pays the recipient off-chain with
+vouchers made with "create-voucher", which the recipient redeems. The id of the channel is in the open_channel event.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			recipient, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("recipient: %s", err)
+			}
+			deposit, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+			msg := types.MsgOpenChannel{Sender: cliCtx.GetFromAddress(), Recipient: recipient, Deposit: deposit}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// CreateVoucherCmd signs a voucher off-chain with the key of the sender of a channel
+func CreateVoucherCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "create-voucher [channel_id] [total_amount]",
+		Short: "Sign a voucher of the total amount paid over a channel with the --from key, no tx is sent",
+		Long: `Sign the voucher of the total amount paid to the recipient of the channel so far with the --from key of the
+sender and print its json, which is given to the recipient. The voucher is only valid on the --chain-id. Every new
+voucher replaces the earlier ones, the recipient redeems the difference to the amount it redeemed before.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf)
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("channel id: %s", err)
+			}
+			amount, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+			chainID := viper.GetString(flags.FlagChainID)
+			if chainID == "" {
+				return fmt.Errorf("--%s required", flags.FlagChainID)
+			}
+			sig, pubKey, err := txBldr.Keybase().Sign(cliCtx.GetFromName(), clientkeys.DefaultKeyPass, types.VoucherSignBytes(chainID, id, amount))
+			if err != nil {
+				return err
+			}
+			bechPubKey, err := sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, pubKey)
+			if err != nil {
+				return err
+			}
+			voucher := types.Voucher{ChannelID: id, Amount: amount, PubKey: bechPubKey, Signature: sig}
+			if err := voucher.ValidateBasic(); err != nil {
+				return err
+			}
+			bz, err := cdc.MarshalJSONIndent(voucher, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+			return nil
+		},
+	}
+}
+
+// RedeemVoucherCmd redeems a voucher for the --from account
+func RedeemVoucherCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "redeem [voucher_json_file]",
+		Short: "Redeem a voucher of a channel to the --from account",
+		Long: `Redeem the voucher made with "create-voucher" by the sender of the channel. The --from account, the recipient
+of the channel, is paid the amount of the voucher that is not redeemed yet.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			voucher, err := readVoucher(cdc, args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.MsgRedeemVoucher{Recipient: cliCtx.GetFromAddress(), Voucher: voucher}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// CloseChannelCmd closes a channel cooperatively by its recipient
+func CloseChannelCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "close [channel_id]",
+		Short: "Close a channel to the --from account, after redeeming the optional last --voucher",
+		Long:  "Close the channel at once. The balance of the channel that is not redeemed is refunded to the sender.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("channel id: %s", err)
+			}
+			msg := types.MsgCloseChannel{Recipient: cliCtx.GetFromAddress(), ChannelID: id}
+			if file := viper.GetString(flagVoucher); file != "" {
+				voucher, err := readVoucher(cdc, file)
+				if err != nil {
+					return err
+				}
+				msg.Voucher = &voucher
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(flagVoucher, "", "Json file of the last voucher to redeem")
+	return cmd
+}
+
+// StartCloseChannelCmd starts the close of a channel by its sender
+func StartCloseChannelCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "start-close [channel_id]",
+		Short: "Start to close a channel of the --from account",
+		Long: `Start to close the channel, e.g. when the recipient does not close it. The recipient can redeem vouchers
+within the dispute period param, then the balance of the channel is refunded to the --from account.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("channel id: %s", err)
+			}
+			msg := types.MsgStartCloseChannel{Sender: cliCtx.GetFromAddress(), ChannelID: id}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func readVoucher(cdc *codec.Codec, file string) (types.Voucher, error) {
+	var voucher types.Voucher
+	bz, err := ioutil.ReadFile(file)
+	if err != nil {
+		return voucher, err
+	}
+	if err := cdc.UnmarshalJSON(bz, &voucher); err != nil {
+		return voucher, fmt.Errorf("voucher: %s", err)
+	}
+	return voucher, nil
+}
@@ -0,0 +1,72 @@
+package paychan
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewHandler returns a handler for "paychan" type messages.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case MsgOpenChannel:
+			return handleOpenChannel(ctx, k, &msg)
+		case MsgRedeemVoucher:
+			return handleRedeemVoucher(ctx, k, &msg)
+		case MsgCloseChannel:
+			return handleCloseChannel(ctx, k, &msg)
+		case MsgStartCloseChannel:
+			return handleStartCloseChannel(ctx, k, &msg)
+		default:
+			errMsg := fmt.Sprintf("unrecognized paychan message type: %T", msg)
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+		}
+	}
+}
+
+func handleOpenChannel(ctx sdk.Context, k Keeper, msg *MsgOpenChannel) (*sdk.Result, error) {
+	id, err := k.Open(ctx, msg.Sender, msg.Recipient, msg.Deposit)
+	if err != nil {
+		return nil, err
+	}
+	return result(ctx, msg.Sender, id), nil
+}
+
+func handleRedeemVoucher(ctx sdk.Context, k Keeper, msg *MsgRedeemVoucher) (*sdk.Result, error) {
+	if _, err := k.Redeem(ctx, msg.Recipient, msg.Voucher); err != nil {
+		return nil, err
+	}
+	return result(ctx, msg.Recipient, msg.Voucher.ChannelID), nil
+}
+
+func handleCloseChannel(ctx sdk.Context, k Keeper, msg *MsgCloseChannel) (*sdk.Result, error) {
+	if err := k.Close(ctx, msg.Recipient, msg.ChannelID, msg.Voucher); err != nil {
+		return nil, err
+	}
+	return result(ctx, msg.Recipient, msg.ChannelID), nil
+}
+
+func handleStartCloseChannel(ctx sdk.Context, k Keeper, msg *MsgStartCloseChannel) (*sdk.Result, error) {
+	if _, err := k.StartClose(ctx, msg.Sender, msg.ChannelID); err != nil {
+		return nil, err
+	}
+	return result(ctx, msg.Sender, msg.ChannelID), nil
+}
+
+func result(ctx sdk.Context, sender sdk.AccAddress, id uint64) *sdk.Result {
+	events := ctx.EventManager().Events()
+	ourEvent := sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(AttributeKeyChannelID, strconv.FormatUint(id, 10)),
+	)
+	return &sdk.Result{
+		Events: append(events, ourEvent),
+	}
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fetchai/fetchd/x/paychan/internal/types"
+)
+
+// InitGenesis stores the params, the last channel id and the channels of the genesis with their indexes
+func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
+	keeper.setParams(ctx, data.Params)
+	keeper.setLastChannelID(ctx, data.LastChannelID)
+	store := ctx.KVStore(keeper.storeKey)
+	for _, c := range data.Channels {
+		keeper.setChannel(ctx, c)
+		store.Set(types.GetPartyIndexKey(c.Sender, c.ID), []byte{})
+		store.Set(types.GetPartyIndexKey(c.Recipient, c.ID), []byte{})
+		if c.CloseHeight != 0 {
+			store.Set(types.GetCloseQueueKey(c.CloseHeight, c.ID), []byte{})
+		}
+	}
+}
+
+// ExportGenesis returns the params, the last channel id and the open and closing channels as genesis state
+func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
+	data := types.GenesisState{Params: keeper.GetParams(ctx), LastChannelID: keeper.GetLastChannelID(ctx)}
+	keeper.IterateChannels(ctx, func(c types.Channel) bool {
+		data.Channels = append(data.Channels, c)
+		return false
+	})
+	return data
+}
@@ -0,0 +1,259 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/params"
+
+	"github.com/fetchai/fetchd/x/paychan/internal/types"
+)
+
+// Keeper keeps the payment channels. The deposits of the channels are held by the module account until they are
+// redeemed by the recipients or refunded to the senders.
+type Keeper struct {
+	storeKey     sdk.StoreKey
+	cdc          *codec.Codec
+	paramSpace   params.Subspace
+	supplyKeeper types.SupplyKeeper
+}
+
+// NewKeeper creates a new paychan Keeper instance
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, paramSpace params.Subspace, supplyKeeper types.SupplyKeeper) Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+	return Keeper{
+		storeKey:     storeKey,
+		cdc:          cdc,
+		paramSpace:   paramSpace,
+		supplyKeeper: supplyKeeper,
+	}
+}
+
+// GetParams returns the total set of paychan parameters.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	var params types.Params
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+func (k Keeper) setParams(ctx sdk.Context, ps types.Params) {
+	k.paramSpace.SetParamSet(ctx, &ps)
+}
+
+// Open opens a channel with the deposit of the sender and returns its id. The ids are never reused, so that the
+// vouchers of a closed channel are never valid again.
+func (k Keeper) Open(ctx sdk.Context, sender, recipient sdk.AccAddress, deposit sdk.Coins) (uint64, error) {
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, deposit); err != nil {
+		return 0, err
+	}
+	id := k.GetLastChannelID(ctx) + 1
+	k.setLastChannelID(ctx, id)
+	channel := types.Channel{ID: id, Sender: sender, Recipient: recipient, Deposit: deposit}
+	k.setChannel(ctx, channel)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPartyIndexKey(sender, id), []byte{})
+	store.Set(types.GetPartyIndexKey(recipient, id), []byte{})
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeOpen,
+		sdk.NewAttribute(types.AttributeKeyChannelID, strconv.FormatUint(id, 10)),
+		sdk.NewAttribute(types.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, deposit.String()),
+	))
+	return id, nil
+}
+
+// Redeem pays the recipient of the channel the amount of the voucher that is not redeemed yet and returns it. The
+// voucher must be signed by the sender on this chain and must not exceed the deposit. Vouchers are redeemed also
+// while the channel is closing.
+func (k Keeper) Redeem(ctx sdk.Context, recipient sdk.AccAddress, voucher types.Voucher) (sdk.Coins, error) {
+	channel, err := k.recipientChannel(ctx, recipient, voucher.ChannelID)
+	if err != nil {
+		return nil, err
+	}
+	return k.redeem(ctx, channel, voucher)
+}
+
+func (k Keeper) redeem(ctx sdk.Context, channel *types.Channel, voucher types.Voucher) (sdk.Coins, error) {
+	if err := voucher.Verify(ctx.ChainID(), channel.Sender); err != nil {
+		return nil, err
+	}
+	if !channel.Deposit.IsAllGTE(voucher.Amount) {
+		return nil, sdkerrors.Wrapf(types.ErrExceedsDeposit, "%s of deposit %s", voucher.Amount, channel.Deposit)
+	}
+	if !voucher.Amount.IsAllGTE(channel.Redeemed) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidVoucher, "%s less than redeemed %s", voucher.Amount, channel.Redeemed)
+	}
+	payout := voucher.Amount.Sub(channel.Redeemed)
+	if payout.IsZero() {
+		return nil, sdkerrors.Wrap(types.ErrInvalidVoucher, "redeemed already")
+	}
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, channel.Recipient, payout); err != nil {
+		return nil, err
+	}
+	channel.Redeemed = voucher.Amount
+	k.setChannel(ctx, *channel)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRedeem,
+		sdk.NewAttribute(types.AttributeKeyChannelID, strconv.FormatUint(channel.ID, 10)),
+		sdk.NewAttribute(types.AttributeKeyRecipient, channel.Recipient.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, payout.String()),
+	))
+	return payout, nil
+}
+
+// Close closes the channel cooperatively by its recipient, after redeeming the optional voucher. The balance is
+// refunded to the sender.
+func (k Keeper) Close(ctx sdk.Context, recipient sdk.AccAddress, id uint64, voucher *types.Voucher) error {
+	channel, err := k.recipientChannel(ctx, recipient, id)
+	if err != nil {
+		return err
+	}
+	if voucher != nil {
+		if _, err := k.redeem(ctx, channel, *voucher); err != nil {
+			return err
+		}
+	}
+	return k.settle(ctx, *channel)
+}
+
+// StartClose starts the close of the channel by its sender. The recipient can redeem vouchers within the dispute
+// period, then the EndBlocker refunds the balance to the sender.
+func (k Keeper) StartClose(ctx sdk.Context, sender sdk.AccAddress, id uint64) (int64, error) {
+	channel := k.GetChannel(ctx, id)
+	if channel == nil {
+		return 0, sdkerrors.Wrapf(types.ErrChannelNotFound, "%d", id)
+	}
+	if !channel.Sender.Equals(sender) {
+		return 0, sdkerrors.Wrap(types.ErrNotParty, "not the sender")
+	}
+	if channel.CloseHeight != 0 {
+		return 0, sdkerrors.Wrapf(types.ErrClosing, "at height %d", channel.CloseHeight)
+	}
+	channel.CloseHeight = ctx.BlockHeight() + k.GetParams(ctx).DisputePeriod
+	k.setChannel(ctx, *channel)
+	ctx.KVStore(k.storeKey).Set(types.GetCloseQueueKey(channel.CloseHeight, id), []byte{})
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeStartClose,
+		sdk.NewAttribute(types.AttributeKeyChannelID, strconv.FormatUint(id, 10)),
+		sdk.NewAttribute(types.AttributeKeyCloseHeight, strconv.FormatInt(channel.CloseHeight, 10)),
+	))
+	return channel.CloseHeight, nil
+}
+
+// EndBlocker closes the channels whose dispute period ends at or before the block height
+func (k Keeper) EndBlocker(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CloseQueuePrefix)
+	iter := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight()+1)))
+	var closing []uint64
+	for ; iter.Valid(); iter.Next() {
+		// the key is the close height followed by the channel id
+		closing = append(closing, binary.BigEndian.Uint64(iter.Key()[8:]))
+	}
+	iter.Close()
+	for _, id := range closing {
+		channel := k.GetChannel(ctx, id)
+		if channel == nil {
+			continue
+		}
+		if err := k.settle(ctx, *channel); err != nil {
+			// the module account holds the balances of all channels
+			panic(err)
+		}
+	}
+}
+
+// settle refunds the balance of the channel to the sender and deletes the channel
+func (k Keeper) settle(ctx sdk.Context, channel types.Channel) error {
+	refund := channel.Balance()
+	if !refund.IsZero() {
+		if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, channel.Sender, refund); err != nil {
+			return err
+		}
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetChannelKey(channel.ID))
+	store.Delete(types.GetPartyIndexKey(channel.Sender, channel.ID))
+	store.Delete(types.GetPartyIndexKey(channel.Recipient, channel.ID))
+	if channel.CloseHeight != 0 {
+		store.Delete(types.GetCloseQueueKey(channel.CloseHeight, channel.ID))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeClose,
+		sdk.NewAttribute(types.AttributeKeyChannelID, strconv.FormatUint(channel.ID, 10)),
+		sdk.NewAttribute(types.AttributeKeySender, channel.Sender.String()),
+		sdk.NewAttribute(types.AttributeKeyRefund, refund.String()),
+	))
+	return nil
+}
+
+func (k Keeper) recipientChannel(ctx sdk.Context, recipient sdk.AccAddress, id uint64) (*types.Channel, error) {
+	channel := k.GetChannel(ctx, id)
+	if channel == nil {
+		return nil, sdkerrors.Wrapf(types.ErrChannelNotFound, "%d", id)
+	}
+	if !channel.Recipient.Equals(recipient) {
+		return nil, sdkerrors.Wrap(types.ErrNotParty, "not the recipient")
+	}
+	return channel, nil
+}
+
+// GetLastChannelID returns the id of the last opened channel, 0 when none was opened
+func (k Keeper) GetLastChannelID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.LastChannelIDKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setLastChannelID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.LastChannelIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetChannel returns the open or closing channel, nil when there is none
+func (k Keeper) GetChannel(ctx sdk.Context, id uint64) *types.Channel {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetChannelKey(id))
+	if bz == nil {
+		return nil
+	}
+	var channel types.Channel
+	k.cdc.MustUnmarshalBinaryBare(bz, &channel)
+	return &channel
+}
+
+func (k Keeper) setChannel(ctx sdk.Context, channel types.Channel) {
+	ctx.KVStore(k.storeKey).Set(types.GetChannelKey(channel.ID), k.cdc.MustMarshalBinaryBare(channel))
+}
+
+// GetPartyChannels returns the channels the account is the sender or the recipient of, ordered by id
+func (k Keeper) GetPartyChannels(ctx sdk.Context, addr sdk.AccAddress) []types.Channel {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetPartyIndexPrefix(addr)).Iterator(nil, nil)
+	defer iter.Close()
+	channels := []types.Channel{}
+	for ; iter.Valid(); iter.Next() {
+		if channel := k.GetChannel(ctx, binary.BigEndian.Uint64(iter.Key())); channel != nil {
+			channels = append(channels, *channel)
+		}
+	}
+	return channels
+}
+
+// IterateChannels calls cb for all open and closing channels until cb returns true
+func (k Keeper) IterateChannels(ctx sdk.Context, cb func(types.Channel) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChannelPrefix).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var channel types.Channel
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &channel)
+		if cb(channel) {
+			return
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/fetchai/fetchd/testutil"
+	"github.com/fetchai/fetchd/x/paychan/internal/types"
+)
+
+var (
+	senderKey = secp256k1.GenPrivKey()
+	sender    = sdk.AccAddress(senderKey.PubKey().Address())
+	recipient = sdk.AccAddress([]byte("recipient___________"))
+)
+
+func fet(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("afet", amount)) }
+
+func setupKeeper(t *testing.T) (sdk.Context, Keeper, *testutil.SupplyKeeper) {
+	cdc := codec.New()
+	types.RegisterCodec(cdc)
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	ctx, paramsKeeper := testutil.NewContext(t, cdc, abci.Header{ChainID: "testing", Height: 10}, key)
+	sk := testutil.NewSupplyKeeper(map[string]sdk.Coins{sender.String(): fet(100)})
+	k := NewKeeper(cdc, key, paramsKeeper.Subspace(types.DefaultParamspace), sk)
+	InitGenesis(ctx, k, types.GenesisState{Params: types.Params{DisputePeriod: 20}})
+	return ctx, k, sk
+}
+
+func voucher(t *testing.T, key crypto.PrivKey, chainID string, id uint64, amount sdk.Coins) types.Voucher {
+	pubKey, err := sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, key.PubKey())
+	require.NoError(t, err)
+	sig, err := key.Sign(types.VoucherSignBytes(chainID, id, amount))
+	require.NoError(t, err)
+	return types.Voucher{ChannelID: id, Amount: amount, PubKey: pubKey, Signature: sig}
+}
+
+func TestRedeem(t *testing.T) {
+	specs := map[string]struct {
+		vouchers   []types.Voucher
+		expPayouts []sdk.Coins
+		expErr     *sdkerrors.Error
+	}{
+		"one voucher": {
+			vouchers:   []types.Voucher{voucher(t, senderKey, "testing", 1, fet(30))},
+			expPayouts: []sdk.Coins{fet(30)},
+		},
+		"increasing vouchers": {
+			vouchers:   []types.Voucher{voucher(t, senderKey, "testing", 1, fet(30)), voucher(t, senderKey, "testing", 1, fet(45))},
+			expPayouts: []sdk.Coins{fet(30), fet(15)},
+		},
+		"whole deposit": {
+			vouchers:   []types.Voucher{voucher(t, senderKey, "testing", 1, fet(60))},
+			expPayouts: []sdk.Coins{fet(60)},
+		},
+		"redeemed again": {
+			vouchers: []types.Voucher{voucher(t, senderKey, "testing", 1, fet(30)), voucher(t, senderKey, "testing", 1, fet(30))},
+			expErr:   types.ErrInvalidVoucher,
+		},
+		"older voucher": {
+			vouchers: []types.Voucher{voucher(t, senderKey, "testing", 1, fet(30)), voucher(t, senderKey, "testing", 1, fet(20))},
+			expErr:   types.ErrInvalidVoucher,
+		},
+		"exceeds deposit": {
+			vouchers: []types.Voucher{voucher(t, senderKey, "testing", 1, fet(61))},
+			expErr:   types.ErrExceedsDeposit,
+		},
+		"signed by other key": {
+			vouchers: []types.Voucher{voucher(t, secp256k1.GenPrivKey(), "testing", 1, fet(30))},
+			expErr:   types.ErrInvalidVoucher,
+		},
+		"signed for other chain": {
+			vouchers: []types.Voucher{voucher(t, senderKey, "other", 1, fet(30))},
+			expErr:   types.ErrInvalidVoucher,
+		},
+		"unknown channel": {
+			vouchers: []types.Voucher{voucher(t, senderKey, "testing", 2, fet(30))},
+			expErr:   types.ErrChannelNotFound,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, k, sk := setupKeeper(t)
+			id, err := k.Open(ctx, sender, recipient, fet(60))
+			require.NoError(t, err)
+			require.Equal(t, uint64(1), id)
+
+			var payouts []sdk.Coins
+			for _, v := range spec.vouchers {
+				payout, err := k.Redeem(ctx, recipient, v)
+				if err != nil {
+					require.NotNil(t, spec.expErr, "got %v", err)
+					require.True(t, spec.expErr.Is(err), "got %v", err)
+					return
+				}
+				payouts = append(payouts, payout)
+			}
+			require.Nil(t, spec.expErr)
+			assert.Equal(t, spec.expPayouts, payouts)
+			redeemed := spec.vouchers[len(spec.vouchers)-1].Amount
+			assert.Equal(t, redeemed.String(), sk.Balances[recipient.String()].String())
+			assert.Equal(t, redeemed, k.GetChannel(ctx, id).Redeemed)
+		})
+	}
+}
+
+func TestCooperativeClose(t *testing.T) {
+	ctx, k, sk := setupKeeper(t)
+	id, err := k.Open(ctx, sender, recipient, fet(60))
+	require.NoError(t, err)
+	_, err = k.Redeem(ctx, recipient, voucher(t, senderKey, "testing", id, fet(10)))
+	require.NoError(t, err)
+
+	last := voucher(t, senderKey, "testing", id, fet(25))
+	assert.True(t, types.ErrNotParty.Is(k.Close(ctx, sender, id, &last)))
+	require.NoError(t, k.Close(ctx, recipient, id, &last))
+	assert.Nil(t, k.GetChannel(ctx, id))
+	assert.Equal(t, fet(25).String(), sk.Balances[recipient.String()].String())
+	assert.Equal(t, fet(75).String(), sk.Balances[sender.String()].String())
+	assert.True(t, sk.Balances[testutil.ModuleKey(types.ModuleName)].IsZero())
+	assert.Empty(t, k.GetPartyChannels(ctx, sender))
+	assert.Empty(t, k.GetPartyChannels(ctx, recipient))
+
+	// the ids of closed channels are not reused
+	id2, err := k.Open(ctx, sender, recipient, fet(10))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), id2)
+	_, err = k.Redeem(ctx, recipient, last)
+	assert.True(t, types.ErrChannelNotFound.Is(err))
+}
+
+func TestDisputedClose(t *testing.T) {
+	ctx, k, sk := setupKeeper(t)
+	id, err := k.Open(ctx, sender, recipient, fet(60))
+	require.NoError(t, err)
+
+	_, err = k.StartClose(ctx, recipient, id)
+	assert.True(t, types.ErrNotParty.Is(err))
+	closeHeight, err := k.StartClose(ctx, sender, id)
+	require.NoError(t, err)
+	assert.Equal(t, int64(30), closeHeight)
+	_, err = k.StartClose(ctx, sender, id)
+	assert.True(t, types.ErrClosing.Is(err))
+	assert.Equal(t, []types.Channel{*k.GetChannel(ctx, id)}, ExportGenesis(ctx, k).Channels)
+
+	// the recipient redeems its last voucher within the dispute period
+	k.EndBlocker(ctx.WithBlockHeight(29))
+	_, err = k.Redeem(ctx.WithBlockHeight(29), recipient, voucher(t, senderKey, "testing", id, fet(40)))
+	require.NoError(t, err)
+	require.NotNil(t, k.GetChannel(ctx, id))
+
+	k.EndBlocker(ctx.WithBlockHeight(30))
+	assert.Nil(t, k.GetChannel(ctx, id))
+	assert.Equal(t, fet(40).String(), sk.Balances[recipient.String()].String())
+	assert.Equal(t, fet(60).String(), sk.Balances[sender.String()].String())
+	assert.True(t, sk.Balances[testutil.ModuleKey(types.ModuleName)].IsZero())
+	assert.Equal(t, types.GenesisState{Params: types.Params{DisputePeriod: 20}, LastChannelID: 1}, ExportGenesis(ctx, k))
+}
@@ -0,0 +1,102 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Channel is a unidirectional payment channel. The sender deposits coins and pays the recipient off-chain with
+// vouchers of the total amount paid so far, which the recipient redeems on-chain.
+type Channel struct {
+	ID        uint64         `json:"id" yaml:"id"`
+	Sender    sdk.AccAddress `json:"sender" yaml:"sender"`
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	Deposit   sdk.Coins      `json:"deposit" yaml:"deposit"`
+	// Redeemed is the total amount of the redeemed vouchers, paid to the recipient
+	Redeemed sdk.Coins `json:"redeemed" yaml:"redeemed"`
+	// CloseHeight is the height the channel closes at after the sender started to close it, 0 while it is open
+	CloseHeight int64 `json:"close_height,omitempty" yaml:"close_height"`
+}
+
+// Validate validates the channel
+func (c Channel) Validate() error {
+	if c.ID == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "id must be positive")
+	}
+	if err := sdk.VerifyAddressFormat(c.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if err := sdk.VerifyAddressFormat(c.Recipient); err != nil {
+		return sdkerrors.Wrap(err, "recipient")
+	}
+	if !c.Deposit.IsValid() || c.Deposit.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "deposit")
+	}
+	if !c.Redeemed.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "redeemed")
+	}
+	if !c.Deposit.IsAllGTE(c.Redeemed) {
+		return sdkerrors.Wrap(ErrExceedsDeposit, "redeemed")
+	}
+	return nil
+}
+
+// Balance returns the deposit that is not redeemed yet
+func (c Channel) Balance() sdk.Coins {
+	return c.Deposit.Sub(c.Redeemed)
+}
+
+// Voucher pays the recipient of a channel the total amount, the recipient redeems the difference to the amount
+// redeemed before. Vouchers are signed off-chain by the sender of the channel.
+type Voucher struct {
+	ChannelID uint64    `json:"channel_id" yaml:"channel_id"`
+	Amount    sdk.Coins `json:"amount" yaml:"amount"`
+	// PubKey is the bech32 encoded account public key of the sender
+	PubKey    string `json:"pub_key" yaml:"pub_key"`
+	Signature []byte `json:"signature" yaml:"signature"`
+}
+
+// VoucherSignBytes returns the bytes the sender signs for a voucher of the channel on the chain
+func VoucherSignBytes(chainID string, channelID uint64, amount sdk.Coins) []byte {
+	bz, err := json.Marshal(struct {
+		ChainID   string    `json:"chain_id"`
+		ChannelID uint64    `json:"channel_id"`
+		Amount    sdk.Coins `json:"amount"`
+	}{ChainID: chainID, ChannelID: channelID, Amount: amount})
+	if err != nil {
+		panic(fmt.Sprintf("marshal voucher: %s", err))
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validates the voucher without its signature
+func (v Voucher) ValidateBasic() error {
+	if v.ChannelID == 0 {
+		return sdkerrors.Wrap(ErrInvalidVoucher, "channel id required")
+	}
+	if !v.Amount.IsValid() || v.Amount.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, v.Amount.String())
+	}
+	if len(v.Signature) == 0 {
+		return sdkerrors.Wrap(ErrInvalidVoucher, "signature required")
+	}
+	return nil
+}
+
+// Verify verifies that the voucher is signed on the chain by the key of the pub key and that it belongs to the signer
+func (v Voucher) Verify(chainID string, signer sdk.AccAddress) error {
+	pubKey, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeAccPub, v.PubKey)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+	}
+	if !sdk.AccAddress(pubKey.Address()).Equals(signer) {
+		return sdkerrors.Wrapf(ErrInvalidVoucher, "pub key does not belong to sender %s", signer)
+	}
+	if !pubKey.VerifyBytes(VoucherSignBytes(chainID, v.ChannelID, v.Amount), v.Signature) {
+		return sdkerrors.Wrap(ErrInvalidVoucher, "signature verification failed")
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+func TestVoucherSignBytes(t *testing.T) {
+	exp := `{"amount":[{"amount":"100","denom":"afet"}],"chain_id":"fetchhub","channel_id":1}`
+	assert.Equal(t, exp, string(VoucherSignBytes("fetchhub", 1, sdk.NewCoins(sdk.NewInt64Coin("afet", 100)))))
+}
+
+func TestVerifyVoucher(t *testing.T) {
+	privKey := secp256k1.GenPrivKey()
+	sender := sdk.AccAddress(privKey.PubKey().Address())
+	bechPubKey, err := sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, privKey.PubKey())
+	require.NoError(t, err)
+	amount := sdk.NewCoins(sdk.NewInt64Coin("afet", 100))
+	sig, err := privKey.Sign(VoucherSignBytes("fetchhub", 1, amount))
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		mutate  func(*Voucher)
+		chainID string
+		expErr  bool
+	}{
+		"valid":         {mutate: func(*Voucher) {}},
+		"other chain":   {mutate: func(*Voucher) {}, chainID: "other", expErr: true},
+		"other channel": {mutate: func(v *Voucher) { v.ChannelID = 2 }, expErr: true},
+		"other amount":  {mutate: func(v *Voucher) { v.Amount = amount.Add(amount...) }, expErr: true},
+		"other pub key": {
+			mutate: func(v *Voucher) {
+				v.PubKey, _ = sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, secp256k1.GenPrivKey().PubKey())
+			},
+			expErr: true,
+		},
+		"invalid pub key": {mutate: func(v *Voucher) { v.PubKey = "invalid" }, expErr: true},
+		"no signature":    {mutate: func(v *Voucher) { v.Signature = nil }, expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			v := Voucher{ChannelID: 1, Amount: amount, PubKey: bechPubKey, Signature: sig}
+			spec.mutate(&v)
+			chainID := spec.chainID
+			if chainID == "" {
+				chainID = "fetchhub"
+			}
+			err := v.Verify(chainID, sender)
+			if spec.expErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers the msg types of the paychan module
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgOpenChannel{}, "paychan/MsgOpenChannel", nil)
+	cdc.RegisterConcrete(MsgRedeemVoucher{}, "paychan/MsgRedeemVoucher", nil)
+	cdc.RegisterConcrete(MsgCloseChannel{}, "paychan/MsgCloseChannel", nil)
+	cdc.RegisterConcrete(MsgStartCloseChannel{}, "paychan/MsgStartCloseChannel", nil)
+}
+
+// ModuleCdc generic sealed codec to be used throughout module
+var ModuleCdc *codec.Codec
+
+func init() {
+	cdc := codec.New()
+	RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	ModuleCdc = cdc.Seal()
+}
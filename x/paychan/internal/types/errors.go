@@ -0,0 +1,21 @@
+package types
+
+import (
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Codes for paychan errors
+var (
+	DefaultCodespace = ModuleName
+
+	// ErrChannelNotFound error for a channel that is not open
+	ErrChannelNotFound = sdkErrors.Register(DefaultCodespace, 1, "channel not found")
+	// ErrInvalidVoucher error for a voucher that is not signed by the sender of the channel
+	ErrInvalidVoucher = sdkErrors.Register(DefaultCodespace, 2, "invalid voucher")
+	// ErrExceedsDeposit error for a voucher of more than the deposit of the channel
+	ErrExceedsDeposit = sdkErrors.Register(DefaultCodespace, 3, "exceeds deposit")
+	// ErrNotParty error for a change of a channel by an account that is not its sender or recipient
+	ErrNotParty = sdkErrors.Register(DefaultCodespace, 4, "not a party of the channel")
+	// ErrClosing error for a close of a channel that is closing already
+	ErrClosing = sdkErrors.Register(DefaultCodespace, 5, "channel closing")
+)
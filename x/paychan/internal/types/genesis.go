@@ -0,0 +1,39 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GenesisState is the genesis state of the paychan module
+type GenesisState struct {
+	Params        Params    `json:"params"`
+	LastChannelID uint64    `json:"last_channel_id"`
+	Channels      []Channel `json:"channels,omitempty"`
+}
+
+// DefaultGenesisState returns the genesis state without channels
+func DefaultGenesisState() GenesisState {
+	return GenesisState{Params: DefaultParams()}
+}
+
+// ValidateGenesis performs basic validation of the genesis state. The ids of the channels must not exceed the last
+// channel id, so that closed channels are never opened again.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "params")
+	}
+	ids := make(map[uint64]struct{}, len(data.Channels))
+	for _, c := range data.Channels {
+		if err := c.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "channel %d", c.ID)
+		}
+		if c.ID > data.LastChannelID {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "channel %d after last channel id", c.ID)
+		}
+		if _, exists := ids[c.ID]; exists {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate channel %d", c.ID)
+		}
+		ids[c.ID] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the paychan module
+	ModuleName = "paychan"
+
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for the paychan module
+	QuerierRoute = ModuleName
+
+	// RouterKey is the msg router key for the paychan module
+	RouterKey = ModuleName
+)
+
+const ( // event attributes
+	AttributeKeyChannelID   = "channel_id"
+	AttributeKeySender      = "sender"
+	AttributeKeyRecipient   = "recipient"
+	AttributeKeyRefund      = "refund"
+	AttributeKeyCloseHeight = "close_height"
+)
+
+const (
+	// EventTypeOpen is emitted when a channel is opened
+	EventTypeOpen = "open_channel"
+	// EventTypeRedeem is emitted when a voucher is redeemed, with the amount paid to the recipient
+	EventTypeRedeem = "redeem_voucher"
+	// EventTypeStartClose is emitted when the sender starts the close of a channel
+	EventTypeStartClose = "start_close_channel"
+	// EventTypeClose is emitted when a channel is closed, with the deposit refunded to the sender
+	EventTypeClose = "close_channel"
+)
+
+// nolint
+var (
+	ChannelPrefix    = []byte{0x01}
+	LastChannelIDKey = []byte{0x02}
+	PartyIndexPrefix = []byte{0x03}
+	CloseQueuePrefix = []byte{0x04}
+)
+
+// GetChannelKey returns the store key of the channel
+func GetChannelKey(id uint64) []byte {
+	return append(ChannelPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetPartyIndexPrefix returns the store key prefix of the channels the account is the sender or the recipient of. The
+// addresses are length prefixed so that an address is not a prefix of a longer one.
+func GetPartyIndexPrefix(addr sdk.AccAddress) []byte {
+	return append(append(PartyIndexPrefix, byte(len(addr))), addr...)
+}
+
+// GetPartyIndexKey returns the store key of the channel in the index of the account
+func GetPartyIndexKey(addr sdk.AccAddress, id uint64) []byte {
+	return append(GetPartyIndexPrefix(addr), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetCloseQueueKey returns the store key of the channel in the queue of the channels closing at the height
+func GetCloseQueueKey(height int64, id uint64) []byte {
+	return append(append(CloseQueuePrefix, sdk.Uint64ToBigEndian(uint64(height))...), sdk.Uint64ToBigEndian(id)...)
+}
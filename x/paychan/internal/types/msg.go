@@ -0,0 +1,138 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MsgOpenChannel opens a channel from the sender to the recipient with the deposit of the sender
+type MsgOpenChannel struct {
+	Sender    sdk.AccAddress `json:"sender" yaml:"sender"`
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	Deposit   sdk.Coins      `json:"deposit" yaml:"deposit"`
+}
+
+func (msg MsgOpenChannel) Route() string {
+	return RouterKey
+}
+
+func (msg MsgOpenChannel) Type() string {
+	return "open-channel"
+}
+
+func (msg MsgOpenChannel) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if err := sdk.VerifyAddressFormat(msg.Recipient); err != nil {
+		return sdkerrors.Wrap(err, "recipient")
+	}
+	if msg.Sender.Equals(msg.Recipient) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "sender is recipient")
+	}
+	if !msg.Deposit.IsValid() || msg.Deposit.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Deposit.String())
+	}
+	return nil
+}
+
+func (msg MsgOpenChannel) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgOpenChannel) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgRedeemVoucher pays the recipient of the channel the amount of the voucher that is not redeemed yet
+type MsgRedeemVoucher struct {
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	Voucher   Voucher        `json:"voucher" yaml:"voucher"`
+}
+
+func (msg MsgRedeemVoucher) Route() string {
+	return RouterKey
+}
+
+func (msg MsgRedeemVoucher) Type() string {
+	return "redeem-voucher"
+}
+
+func (msg MsgRedeemVoucher) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Recipient); err != nil {
+		return sdkerrors.Wrap(err, "recipient")
+	}
+	return msg.Voucher.ValidateBasic()
+}
+
+func (msg MsgRedeemVoucher) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgRedeemVoucher) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Recipient}
+}
+
+// MsgCloseChannel closes a channel cooperatively by its recipient, after it redeemed the optional last voucher. The
+// balance is refunded to the sender at once.
+type MsgCloseChannel struct {
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	ChannelID uint64         `json:"channel_id" yaml:"channel_id"`
+	Voucher   *Voucher       `json:"voucher,omitempty" yaml:"voucher"`
+}
+
+func (msg MsgCloseChannel) Route() string {
+	return RouterKey
+}
+
+func (msg MsgCloseChannel) Type() string {
+	return "close-channel"
+}
+
+func (msg MsgCloseChannel) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Recipient); err != nil {
+		return sdkerrors.Wrap(err, "recipient")
+	}
+	if msg.Voucher == nil {
+		return nil
+	}
+	if msg.Voucher.ChannelID != msg.ChannelID {
+		return sdkerrors.Wrap(ErrInvalidVoucher, "voucher of other channel")
+	}
+	return msg.Voucher.ValidateBasic()
+}
+
+func (msg MsgCloseChannel) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgCloseChannel) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Recipient}
+}
+
+// MsgStartCloseChannel starts the close of a channel by its sender, e.g. when the recipient is gone. The recipient
+// redeems its last voucher within the dispute period, then the balance is refunded to the sender.
+type MsgStartCloseChannel struct {
+	Sender    sdk.AccAddress `json:"sender" yaml:"sender"`
+	ChannelID uint64         `json:"channel_id" yaml:"channel_id"`
+}
+
+func (msg MsgStartCloseChannel) Route() string {
+	return RouterKey
+}
+
+func (msg MsgStartCloseChannel) Type() string {
+	return "start-close-channel"
+}
+
+func (msg MsgStartCloseChannel) ValidateBasic() error {
+	return sdkerrors.Wrap(sdk.VerifyAddressFormat(msg.Sender), "sender")
+}
+
+func (msg MsgStartCloseChannel) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgStartCloseChannel) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// DefaultParamspace for params keeper
+	DefaultParamspace = ModuleName
+)
+
+var ParamStoreKeyDisputePeriod = []byte("disputePeriod")
+
+// Params defines the set of paychan parameters. They are changed by param change proposals.
+type Params struct {
+	// DisputePeriod is the number of blocks the recipient has to redeem its last voucher after the sender started to
+	// close a channel
+	DisputePeriod int64 `json:"dispute_period" yaml:"dispute_period"`
+}
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns default paychan parameters, the dispute period is about a day of 5s blocks
+func DefaultParams() Params {
+	return Params{
+		DisputePeriod: 17280,
+	}
+}
+
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(ParamStoreKeyDisputePeriod, &p.DisputePeriod, validateDisputePeriod),
+	}
+}
+
+// ValidateBasic performs basic validation on paychan parameters.
+func (p Params) ValidateBasic() error {
+	return sdkerrors.Wrap(validateDisputePeriod(p.DisputePeriod), "dispute period")
+}
+
+func validateDisputePeriod(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v <= 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "must be positive")
+	}
+	return nil
+}
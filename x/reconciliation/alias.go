@@ -0,0 +1,53 @@
+package reconciliation
+
+import (
+	"github.com/fetchai/fetchd/x/reconciliation/internal/keeper"
+	"github.com/fetchai/fetchd/x/reconciliation/internal/types"
+)
+
+const (
+	ModuleName             = types.ModuleName
+	StoreKey               = types.StoreKey
+	QuerierRoute           = types.QuerierRoute
+	RouterKey              = types.RouterKey
+	EthSignatureLength     = types.EthSignatureLength
+	HashLength             = types.HashLength
+	MaxProofLength         = types.MaxProofLength
+	AttributeKeyEthAddress = types.AttributeKeyEthAddress
+	AttributeKeyRecipient  = types.AttributeKeyRecipient
+	AttributeKeyValidator  = types.AttributeKeyValidator
+	EventTypeClaim         = types.EventTypeClaim
+	QueryMerkleRoot        = keeper.QueryMerkleRoot
+	QueryClaim             = keeper.QueryClaim
+)
+
+var (
+	// functions aliases
+	RegisterCodec       = types.RegisterCodec
+	ValidateGenesis     = types.ValidateGenesis
+	DefaultGenesisState = types.DefaultGenesisState
+	ParseEthAddress     = types.ParseEthAddress
+	LeafHash            = types.LeafHash
+	VerifyProof         = types.VerifyProof
+	ClaimMessage        = types.ClaimMessage
+	RecoverEthAddress   = types.RecoverEthAddress
+	NewKeeper           = keeper.NewKeeper
+	NewQuerier          = keeper.NewQuerier
+	InitGenesis         = keeper.InitGenesis
+	ExportGenesis       = keeper.ExportGenesis
+
+	// variable aliases
+	ModuleCdc            = types.ModuleCdc
+	ErrInvalidEthAddress = types.ErrInvalidEthAddress
+	ErrInvalidProof      = types.ErrInvalidProof
+	ErrInvalidSignature  = types.ErrInvalidSignature
+	ErrAlreadyClaimed    = types.ErrAlreadyClaimed
+	ErrNoMerkleRoot      = types.ErrNoMerkleRoot
+)
+
+type (
+	Keeper       = keeper.Keeper
+	GenesisState = types.GenesisState
+	Claim        = types.Claim
+	MsgClaim     = types.MsgClaim
+)
@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	"github.com/fetchai/fetchd/x/reconciliation/internal/keeper"
+	"github.com/fetchai/fetchd/x/reconciliation/internal/types"
+)
+
+// GetQueryCmd returns the query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the migrated stake",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(flags.GetCommands(
+		GetCmdQueryMerkleRoot(cdc),
+		GetCmdQueryClaim(cdc),
+	)...)
+	return queryCmd
+}
+
+// GetCmdQueryMerkleRoot shows the merkle root of the migrated balances
+func GetCmdQueryMerkleRoot(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "merkle-root",
+		Short: "Show the merkle root of the staked balances imported from the Ethereum staking contract",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, keeper.QueryMerkleRoot))
+			if err != nil {
+				return err
+			}
+			var root tmbytes.HexBytes
+			if err := json.Unmarshal(res, &root); err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), root.String())
+			return nil
+		},
+	}
+}
+
+// GetCmdQueryClaim shows the claim of the balance of an Ethereum address
+func GetCmdQueryClaim(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "claim [eth_address]",
+		Short: "Show the recipient, the amount and the height of the claim of the staked balance of an Ethereum address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if _, err := types.ParseEthAddress(strings.ToLower(args[0])); err != nil {
+				return err
+			}
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, keeper.QueryClaim, args[0]))
+			if err != nil {
+				return err
+			}
+			var claim *types.Claim
+			if err := json.Unmarshal(res, &claim); err != nil {
+				return err
+			}
+			if claim == nil {
+				return fmt.Errorf("the balance of %s is not claimed", args[0])
+			}
+			return cliCtx.PrintOutput(claim)
+		},
+	}
+}
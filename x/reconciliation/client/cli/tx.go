@@ -0,0 +1,115 @@
+package cli
+
+import (
+	"bufio"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	"github.com/fetchai/fetchd/x/reconciliation/internal/types"
+	wasmUtils "github.com/fetchai/fetchd/x/wasm/client/utils"
+)
+
+const flagValidator = "validator"
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Migrated stake transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	txCmd.AddCommand(wasmUtils.AddBroadcastFlags(flags.PostCommands(
+		ClaimCmd(cdc),
+	)...)...)
+	txCmd.AddCommand(ClaimMessageCmd())
+	return txCmd
+}
+
+// ClaimCmd claims a migrated staked balance for the --from account
+func ClaimCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim [eth_address] [amount] [signature_hex] [proof_hex,...]",
+		Short: "Claim the staked balance of an Ethereum address for the --from account",
+		Long: `Claim the balance in base units that the Ethereum address staked at the migration, with the comma separated
+hex hashes of its merkle proof. The signature is the personal_sign signature of the claim message of the --from
+account, see "claim-message", by the key of the Ethereum address, e.g. from a wallet. With --validator the claimed
+balance is delegated to the validator at once.`,
+		Args: cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			amount, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("amount: invalid integer %s", args[1])
+			}
+			signature, err := decodeHex(args[2])
+			if err != nil {
+				return fmt.Errorf("signature: %s", err)
+			}
+			msg := types.MsgClaim{
+				Sender:     cliCtx.GetFromAddress(),
+				EthAddress: args[0],
+				Amount:     amount,
+				Signature:  signature,
+			}
+			if len(args) == 4 {
+				for _, s := range strings.Split(args[3], ",") {
+					hash, err := decodeHex(s)
+					if err != nil {
+						return fmt.Errorf("proof: %s", err)
+					}
+					msg.Proof = append(msg.Proof, hash)
+				}
+			}
+			if v := viper.GetString(flagValidator); v != "" {
+				if msg.Validator, err = sdk.ValAddressFromBech32(v); err != nil {
+					return fmt.Errorf("validator: %s", err)
+				}
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(flagValidator, "", "Bech32 operator address of the validator to restake the claimed balance with")
+	return cmd
+}
+
+// ClaimMessageCmd prints the message to sign with the Ethereum key to claim for an account
+func ClaimMessageCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "claim-message [address]",
+		Short: "Print the message the Ethereum key signs with personal_sign to claim its staked balance for the account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), string(types.ClaimMessage(addr)))
+			return nil
+		},
+	}
+}
+
+func decodeHex(s string) (tmbytes.HexBytes, error) {
+	return hex.DecodeString(strings.TrimPrefix(s, "0x"))
+}
@@ -0,0 +1,40 @@
+package reconciliation
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewHandler returns a handler for "reconciliation" type messages.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case MsgClaim:
+			return handleClaim(ctx, k, &msg)
+		default:
+			errMsg := fmt.Sprintf("unrecognized reconciliation message type: %T", msg)
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+		}
+	}
+}
+
+func handleClaim(ctx sdk.Context, k Keeper, msg *MsgClaim) (*sdk.Result, error) {
+	claim, err := k.Claim(ctx, *msg)
+	if err != nil {
+		return nil, err
+	}
+	events := ctx.EventManager().Events()
+	ourEvent := sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		sdk.NewAttribute(AttributeKeyEthAddress, claim.EthAddress),
+	)
+	return &sdk.Result{
+		Events: append(events, ourEvent),
+	}, nil
+}
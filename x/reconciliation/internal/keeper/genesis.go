@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fetchai/fetchd/x/reconciliation/internal/types"
+)
+
+// InitGenesis stores the merkle root and the claims of the genesis
+func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
+	keeper.setMerkleRoot(ctx, data.MerkleRoot)
+	for _, c := range data.Claims {
+		ethAddr, err := types.ParseEthAddress(strings.ToLower(c.EthAddress))
+		if err != nil {
+			panic(err)
+		}
+		keeper.setClaim(ctx, ethAddr, c)
+	}
+}
+
+// ExportGenesis returns the merkle root and the claims as genesis state
+func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
+	data := types.GenesisState{MerkleRoot: keeper.GetMerkleRoot(ctx)}
+	keeper.IterateClaims(ctx, func(c types.Claim) bool {
+		data.Claims = append(data.Claims, c)
+		return false
+	})
+	return data
+}
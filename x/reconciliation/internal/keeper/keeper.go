@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	"github.com/fetchai/fetchd/x/reconciliation/internal/types"
+)
+
+// Keeper keeps the merkle root of the balances of the Ethereum staking contract and the claims of the balances. The
+// module account holds the migrated stake that is not claimed.
+type Keeper struct {
+	storeKey      sdk.StoreKey
+	cdc           *codec.Codec
+	supplyKeeper  types.SupplyKeeper
+	stakingKeeper types.StakingKeeper
+}
+
+// NewKeeper creates a new reconciliation Keeper instance
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, supplyKeeper types.SupplyKeeper, stakingKeeper types.StakingKeeper) Keeper {
+	return Keeper{
+		storeKey:      storeKey,
+		cdc:           cdc,
+		supplyKeeper:  supplyKeeper,
+		stakingKeeper: stakingKeeper,
+	}
+}
+
+// Claim pays the staked balance of the Ethereum address in the bond denom to the sender, and delegates it to the
+// validator of the msg when it is set. The balance must be a leaf of the merkle root and the claim message of the
+// sender must be signed by the key of the Ethereum address. Each balance is claimed once.
+func (k Keeper) Claim(ctx sdk.Context, msg types.MsgClaim) (types.Claim, error) {
+	root := k.GetMerkleRoot(ctx)
+	if len(root) == 0 {
+		return types.Claim{}, types.ErrNoMerkleRoot
+	}
+	ethAddr, err := types.ParseEthAddress(strings.ToLower(msg.EthAddress))
+	if err != nil {
+		return types.Claim{}, err
+	}
+	if k.GetClaim(ctx, ethAddr) != nil {
+		return types.Claim{}, sdkerrors.Wrap(types.ErrAlreadyClaimed, msg.EthAddress)
+	}
+	leaf, err := types.LeafHash(ethAddr, msg.Amount)
+	if err != nil {
+		return types.Claim{}, err
+	}
+	proof := make([][]byte, len(msg.Proof))
+	for i, p := range msg.Proof {
+		proof[i] = p
+	}
+	if !types.VerifyProof(root, leaf, proof) {
+		return types.Claim{}, sdkerrors.Wrapf(types.ErrInvalidProof, "balance %s of %s", msg.Amount, msg.EthAddress)
+	}
+	signer, err := types.RecoverEthAddress(types.ClaimMessage(msg.Sender), msg.Signature)
+	if err != nil {
+		return types.Claim{}, err
+	}
+	if !bytes.Equal(signer, ethAddr) {
+		return types.Claim{}, sdkerrors.Wrap(types.ErrInvalidSignature, "not signed by the eth address")
+	}
+
+	amount := sdk.NewCoins(sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), msg.Amount))
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, msg.Sender, amount); err != nil {
+		return types.Claim{}, err
+	}
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyEthAddress, strings.ToLower(msg.EthAddress)),
+		sdk.NewAttribute(types.AttributeKeyRecipient, msg.Sender.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+	}
+	if msg.Validator != nil {
+		validator, found := k.stakingKeeper.GetValidator(ctx, msg.Validator)
+		if !found {
+			return types.Claim{}, sdkerrors.Wrap(staking.ErrNoValidatorFound, msg.Validator.String())
+		}
+		if _, err := k.stakingKeeper.Delegate(ctx, msg.Sender, msg.Amount, sdk.Unbonded, validator, true); err != nil {
+			return types.Claim{}, err
+		}
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyValidator, msg.Validator.String()))
+	}
+	claim := types.Claim{
+		EthAddress: strings.ToLower(msg.EthAddress),
+		Recipient:  msg.Sender,
+		Amount:     msg.Amount,
+		Height:     ctx.BlockHeight(),
+	}
+	k.setClaim(ctx, ethAddr, claim)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeClaim, attrs...))
+	return claim, nil
+}
+
+// GetMerkleRoot returns the merkle root of the migrated balances, nil when none was imported
+func (k Keeper) GetMerkleRoot(ctx sdk.Context) []byte {
+	return ctx.KVStore(k.storeKey).Get(types.MerkleRootKey)
+}
+
+func (k Keeper) setMerkleRoot(ctx sdk.Context, root []byte) {
+	if len(root) != 0 {
+		ctx.KVStore(k.storeKey).Set(types.MerkleRootKey, root)
+	}
+}
+
+// GetClaim returns the claim of the balance of the Ethereum address, nil when it is not claimed
+func (k Keeper) GetClaim(ctx sdk.Context, ethAddr []byte) *types.Claim {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetClaimKey(ethAddr))
+	if bz == nil {
+		return nil
+	}
+	var claim types.Claim
+	k.cdc.MustUnmarshalBinaryBare(bz, &claim)
+	return &claim
+}
+
+func (k Keeper) setClaim(ctx sdk.Context, ethAddr []byte, claim types.Claim) {
+	ctx.KVStore(k.storeKey).Set(types.GetClaimKey(ethAddr), k.cdc.MustMarshalBinaryBare(claim))
+}
+
+// IterateClaims calls cb for all claims, ordered by Ethereum address, until cb returns true
+func (k Keeper) IterateClaims(ctx sdk.Context, cb func(types.Claim) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClaimPrefix).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var claim types.Claim
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &claim)
+		if cb(claim) {
+			return
+		}
+	}
+}
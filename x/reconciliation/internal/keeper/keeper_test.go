@@ -0,0 +1,203 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	"github.com/fetchai/fetchd/testutil"
+	"github.com/fetchai/fetchd/x/reconciliation/internal/types"
+)
+
+var (
+	alice     = sdk.AccAddress([]byte("alice_______________"))
+	bob       = sdk.AccAddress([]byte("bob_________________"))
+	validator = sdk.ValAddress([]byte("validator___________"))
+)
+
+type staker struct {
+	key     *btcec.PrivateKey
+	ethAddr string
+	amount  sdk.Int
+	proof   []tmbytes.HexBytes
+}
+
+// sign returns the personal_sign signature of the claim message of the recipient
+func (s staker) sign(t *testing.T, recipient sdk.AccAddress) []byte {
+	message := types.ClaimMessage(recipient)
+	hash := types.Keccak256([]byte(fmt.Sprintf("\x19Ethereum Signed Message:\n%d", len(message))), message)
+	compact, err := btcec.SignCompact(btcec.S256(), s.key, hash, false)
+	require.NoError(t, err)
+	return append(compact[1:], compact[0])
+}
+
+func (s staker) claim(t *testing.T, sender sdk.AccAddress) types.MsgClaim {
+	return types.MsgClaim{Sender: sender, EthAddress: s.ethAddr, Amount: s.amount, Proof: s.proof, Signature: s.sign(t, sender)}
+}
+
+// setupKeeper imports the merkle root of the balances of two stakers, 100 and 200
+func setupKeeper(t *testing.T) (sdk.Context, Keeper, *testutil.SupplyKeeper, *mockStakingKeeper, []staker) {
+	cdc := codec.New()
+	types.RegisterCodec(cdc)
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	ctx, _ := testutil.NewContext(t, cdc, abci.Header{Height: 10}, key)
+
+	var stakers []staker
+	var leaves [][]byte
+	for _, amount := range []int64{100, 200} {
+		privKey, err := btcec.NewPrivateKey(btcec.S256())
+		require.NoError(t, err)
+		ethAddr := types.Keccak256(privKey.PubKey().SerializeUncompressed()[1:])[12:]
+		leaf, err := types.LeafHash(ethAddr, sdk.NewInt(amount))
+		require.NoError(t, err)
+		stakers = append(stakers, staker{key: privKey, ethAddr: fmt.Sprintf("0x%x", ethAddr), amount: sdk.NewInt(amount)})
+		leaves = append(leaves, leaf)
+	}
+	stakers[0].proof = []tmbytes.HexBytes{leaves[1]}
+	stakers[1].proof = []tmbytes.HexBytes{leaves[0]}
+	a, b := leaves[0], leaves[1]
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+
+	sk := testutil.NewSupplyKeeper(map[string]sdk.Coins{testutil.ModuleKey(types.ModuleName): sdk.NewCoins(sdk.NewInt64Coin("afet", 300))})
+	stk := &mockStakingKeeper{supply: sk, delegations: map[string]sdk.Int{}}
+	k := NewKeeper(cdc, key, sk, stk)
+	InitGenesis(ctx, k, types.GenesisState{MerkleRoot: types.Keccak256(a, b)})
+	return ctx, k, sk, stk, stakers
+}
+
+func TestClaim(t *testing.T) {
+	specs := map[string]struct {
+		mutate        func(t *testing.T, s []staker, msg *types.MsgClaim)
+		expErr        *sdkerrors.Error
+		expBalance    sdk.Coins
+		expDelegation int64
+	}{
+		"claim": {
+			mutate:     func(*testing.T, []staker, *types.MsgClaim) {},
+			expBalance: sdk.NewCoins(sdk.NewInt64Coin("afet", 100)),
+		},
+		"upper case eth address": {
+			mutate: func(_ *testing.T, _ []staker, m *types.MsgClaim) {
+				m.EthAddress = "0x" + strings.ToUpper(m.EthAddress[2:])
+			},
+			expBalance: sdk.NewCoins(sdk.NewInt64Coin("afet", 100)),
+		},
+		"restake": {
+			mutate:        func(_ *testing.T, _ []staker, m *types.MsgClaim) { m.Validator = validator },
+			expBalance:    sdk.NewCoins(),
+			expDelegation: 100,
+		},
+		"unknown validator": {
+			mutate: func(_ *testing.T, _ []staker, m *types.MsgClaim) {
+				m.Validator = sdk.ValAddress([]byte("other_______________"))
+			},
+			expErr: staking.ErrNoValidatorFound,
+		},
+		"other amount": {
+			mutate: func(_ *testing.T, _ []staker, m *types.MsgClaim) { m.Amount = sdk.NewInt(200) },
+			expErr: types.ErrInvalidProof,
+		},
+		"other proof": {
+			mutate: func(_ *testing.T, s []staker, m *types.MsgClaim) { m.Proof = s[1].proof },
+			expErr: types.ErrInvalidProof,
+		},
+		"signed for other recipient": {
+			mutate: func(t *testing.T, s []staker, m *types.MsgClaim) { m.Signature = s[0].sign(t, bob) },
+			expErr: types.ErrInvalidSignature,
+		},
+		"signed by other staker": {
+			mutate: func(t *testing.T, s []staker, m *types.MsgClaim) { m.Signature = s[1].sign(t, alice) },
+			expErr: types.ErrInvalidSignature,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, k, sk, stk, stakers := setupKeeper(t)
+			m := stakers[0].claim(t, alice)
+			spec.mutate(t, stakers, &m)
+			require.NoError(t, m.ValidateBasic())
+			claim, err := k.Claim(ctx, m)
+			if spec.expErr != nil {
+				require.True(t, spec.expErr.Is(err), "got %v", err)
+				assert.Nil(t, k.GetClaim(ctx, mustParse(t, stakers[0].ethAddr)))
+				return
+			}
+			require.NoError(t, err)
+			exp := types.Claim{EthAddress: stakers[0].ethAddr, Recipient: alice, Amount: sdk.NewInt(100), Height: 10}
+			assert.Equal(t, exp, claim)
+			assert.Equal(t, &exp, k.GetClaim(ctx, mustParse(t, stakers[0].ethAddr)))
+			assert.Equal(t, spec.expBalance.String(), sk.Balances[alice.String()].String())
+			if spec.expDelegation != 0 {
+				assert.Equal(t, sdk.NewInt(spec.expDelegation), stk.delegations[alice.String()])
+			}
+			assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("afet", 200)).String(), sk.Balances[testutil.ModuleKey(types.ModuleName)].String())
+
+			// the balance is claimed once, even for another recipient
+			_, err = k.Claim(ctx, stakers[0].claim(t, bob))
+			assert.True(t, types.ErrAlreadyClaimed.Is(err))
+		})
+	}
+}
+
+func TestGenesis(t *testing.T) {
+	ctx, k, _, _, stakers := setupKeeper(t)
+	_, err := k.Claim(ctx, stakers[1].claim(t, bob))
+	require.NoError(t, err)
+	genesis := ExportGenesis(ctx, k)
+	require.NoError(t, types.ValidateGenesis(genesis))
+	assert.Equal(t, []types.Claim{{EthAddress: stakers[1].ethAddr, Recipient: bob, Amount: sdk.NewInt(200), Height: 10}}, genesis.Claims)
+
+	ctx, k, _, _, _ = setupKeeper(t)
+	InitGenesis(ctx, k, genesis)
+	_, err = k.Claim(ctx, stakers[1].claim(t, alice))
+	assert.True(t, types.ErrAlreadyClaimed.Is(err))
+
+	// nothing can be claimed without merkle root
+	ctx, k, _, _, _ = setupKeeper(t)
+	ctx.KVStore(k.storeKey).Delete(types.MerkleRootKey)
+	_, err = k.Claim(ctx, stakers[0].claim(t, alice))
+	assert.True(t, types.ErrNoMerkleRoot.Is(err))
+	assert.Equal(t, types.DefaultGenesisState(), ExportGenesis(ctx, k))
+}
+
+func mustParse(t *testing.T, ethAddr string) []byte {
+	bz, err := types.ParseEthAddress(ethAddr)
+	require.NoError(t, err)
+	return bz
+}
+
+type mockStakingKeeper struct {
+	supply      *testutil.SupplyKeeper
+	delegations map[string]sdk.Int
+}
+
+func (m *mockStakingKeeper) BondDenom(sdk.Context) string { return "afet" }
+
+func (m *mockStakingKeeper) GetValidator(_ sdk.Context, addr sdk.ValAddress) (stakingtypes.Validator, bool) {
+	if !addr.Equals(validator) {
+		return stakingtypes.Validator{}, false
+	}
+	return stakingtypes.Validator{OperatorAddress: addr}, true
+}
+
+func (m *mockStakingKeeper) Delegate(_ sdk.Context, delAddr sdk.AccAddress, bondAmt sdk.Int, _ sdk.BondStatus, _ stakingtypes.Validator, _ bool) (sdk.Dec, error) {
+	if err := m.supply.Send(delAddr.String(), "bonded_tokens_pool", sdk.NewCoins(sdk.NewCoin("afet", bondAmt))); err != nil {
+		return sdk.Dec{}, err
+	}
+	m.delegations[delAddr.String()] = bondAmt
+	return bondAmt.ToDec(), nil
+}
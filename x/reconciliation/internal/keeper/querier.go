@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"encoding/json"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	"github.com/fetchai/fetchd/x/reconciliation/internal/types"
+)
+
+const (
+	// QueryMerkleRoot returns the merkle root of the migrated balances
+	QueryMerkleRoot = "merkle-root"
+	// QueryClaim returns the claim of the balance of an Ethereum address, path: eth address
+	QueryClaim = "claim"
+)
+
+// NewQuerier creates a new querier
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		switch path[0] {
+		case QueryMerkleRoot:
+			return marshal(tmbytes.HexBytes(keeper.GetMerkleRoot(ctx)))
+		case QueryClaim:
+			if len(path) < 2 {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "eth address required")
+			}
+			ethAddr, err := types.ParseEthAddress(strings.ToLower(path[1]))
+			if err != nil {
+				return nil, err
+			}
+			claim := keeper.GetClaim(ctx, ethAddr)
+			if claim == nil {
+				return []byte("null"), nil
+			}
+			return marshal(claim)
+		default:
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown data query endpoint")
+		}
+	}
+}
+
+func marshal(v interface{}) ([]byte, error) {
+	bz, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
@@ -0,0 +1,28 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Claim records the claim of the staked balance of an Ethereum address
+type Claim struct {
+	EthAddress string         `json:"eth_address" yaml:"eth_address"`
+	Recipient  sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	Amount     sdk.Int        `json:"amount" yaml:"amount"`
+	Height     int64          `json:"height" yaml:"height"`
+}
+
+// Validate validates the claim
+func (c Claim) Validate() error {
+	if _, err := ParseEthAddress(c.EthAddress); err != nil {
+		return err
+	}
+	if err := sdk.VerifyAddressFormat(c.Recipient); err != nil {
+		return sdkerrors.Wrap(err, "recipient")
+	}
+	if c.Amount.IsNil() || !c.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "amount must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package types
+
+import (
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Codes for reconciliation errors
+var (
+	DefaultCodespace = ModuleName
+
+	// ErrInvalidEthAddress error for an invalid Ethereum address
+	ErrInvalidEthAddress = sdkErrors.Register(DefaultCodespace, 1, "invalid eth address")
+	// ErrInvalidProof error for a balance that is not a leaf of the merkle root
+	ErrInvalidProof = sdkErrors.Register(DefaultCodespace, 2, "invalid merkle proof")
+	// ErrInvalidSignature error for a claim that is not signed by the key of the Ethereum address
+	ErrInvalidSignature = sdkErrors.Register(DefaultCodespace, 3, "invalid eth signature")
+	// ErrAlreadyClaimed error for a second claim of the balance of an Ethereum address
+	ErrAlreadyClaimed = sdkErrors.Register(DefaultCodespace, 4, "already claimed")
+	// ErrNoMerkleRoot error for claims on a chain without imported balances
+	ErrNoMerkleRoot = sdkErrors.Register(DefaultCodespace, 5, "no merkle root")
+)
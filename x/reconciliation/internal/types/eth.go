@@ -0,0 +1,98 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	// EthSignatureLength is the length of an Ethereum signature: r, s and the recovery id v
+	EthSignatureLength = 65
+	// HashLength is the length of the keccak256 hashes of the merkle tree
+	HashLength = 32
+)
+
+// ParseEthAddress returns the bytes of a 0x prefixed hex Ethereum address
+func ParseEthAddress(addr string) ([]byte, error) {
+	if !strings.HasPrefix(addr, "0x") || len(addr) != 42 {
+		return nil, sdkerrors.Wrap(ErrInvalidEthAddress, addr)
+	}
+	bz, err := hex.DecodeString(addr[2:])
+	if err != nil {
+		return nil, sdkerrors.Wrap(ErrInvalidEthAddress, addr)
+	}
+	return bz, nil
+}
+
+// Keccak256 returns the Ethereum keccak256 hash of the data
+func Keccak256(data ...[]byte) []byte {
+	h := sha3.NewLegacyKeccak256()
+	for _, d := range data {
+		h.Write(d) // nolint: errcheck
+	}
+	return h.Sum(nil)
+}
+
+// LeafHash returns the merkle leaf of the staked balance of the Ethereum address, keccak256(abi.encodePacked(address,
+// uint256)) like the staking contract computes it
+func LeafHash(ethAddr []byte, amount sdk.Int) ([]byte, error) {
+	if amount.IsNil() || !amount.IsPositive() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "amount must be positive")
+	}
+	bz := amount.BigInt().Bytes()
+	if len(bz) > 32 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "amount exceeds uint256")
+	}
+	uint256 := make([]byte, 32)
+	copy(uint256[32-len(bz):], bz)
+	return Keccak256(ethAddr, uint256), nil
+}
+
+// VerifyProof returns true when the leaf is in the tree of the merkle root. The pairs of the tree are hashed sorted,
+// keccak256(min(a, b), max(a, b)), like the OpenZeppelin MerkleProof library does.
+func VerifyProof(root, leaf []byte, proof [][]byte) bool {
+	hash := leaf
+	for _, p := range proof {
+		if bytes.Compare(hash, p) <= 0 {
+			hash = Keccak256(hash, p)
+		} else {
+			hash = Keccak256(p, hash)
+		}
+	}
+	return bytes.Equal(hash, root)
+}
+
+// ClaimMessage returns the message the Ethereum key of the staked balance signs to claim it for the recipient
+func ClaimMessage(recipient sdk.AccAddress) []byte {
+	return []byte(fmt.Sprintf("Claim migrated FET stake to %s", recipient))
+}
+
+// RecoverEthAddress returns the Ethereum address of the key that signed the message with personal_sign (EIP-191),
+// as wallets sign messages
+func RecoverEthAddress(message, sig []byte) ([]byte, error) {
+	if len(sig) != EthSignatureLength {
+		return nil, sdkerrors.Wrapf(ErrInvalidSignature, "must be %d bytes", EthSignatureLength)
+	}
+	v := sig[64]
+	if v >= 27 {
+		v -= 27
+	}
+	if v > 1 {
+		return nil, sdkerrors.Wrap(ErrInvalidSignature, "recovery id")
+	}
+	hash := Keccak256([]byte(fmt.Sprintf("\x19Ethereum Signed Message:\n%d", len(message))), message)
+	// btcec expects the recovery id of an uncompressed key in front of r and s
+	compact := append([]byte{27 + v}, sig[:64]...)
+	pubKey, _, err := btcec.RecoverCompact(btcec.S256(), compact, hash)
+	if err != nil {
+		return nil, sdkerrors.Wrap(ErrInvalidSignature, err.Error())
+	}
+	return Keccak256(pubKey.SerializeUncompressed()[1:])[12:], nil
+}
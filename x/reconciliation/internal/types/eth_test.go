@@ -0,0 +1,97 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func pair(a, b []byte) []byte {
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+	return Keccak256(a, b)
+}
+
+func TestVerifyProof(t *testing.T) {
+	assert.Equal(t, "c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470", hex.EncodeToString(Keccak256()))
+
+	var leaves [][]byte
+	for i, addr := range []string{"0x1111111111111111111111111111111111111111", "0x2222222222222222222222222222222222222222", "0x3333333333333333333333333333333333333333"} {
+		ethAddr, err := ParseEthAddress(addr)
+		require.NoError(t, err)
+		leaf, err := LeafHash(ethAddr, sdk.NewInt(int64(100*(i+1))))
+		require.NoError(t, err)
+		leaves = append(leaves, leaf)
+	}
+	ab := pair(leaves[0], leaves[1])
+	root := pair(ab, leaves[2])
+
+	specs := map[string]struct {
+		leaf  []byte
+		proof [][]byte
+		exp   bool
+	}{
+		"first":        {leaf: leaves[0], proof: [][]byte{leaves[1], leaves[2]}, exp: true},
+		"second":       {leaf: leaves[1], proof: [][]byte{leaves[0], leaves[2]}, exp: true},
+		"last":         {leaf: leaves[2], proof: [][]byte{ab}, exp: true},
+		"inner node":   {leaf: ab, proof: [][]byte{leaves[2]}, exp: true},
+		"wrong order":  {leaf: leaves[0], proof: [][]byte{leaves[2], leaves[1]}},
+		"other leaf":   {leaf: leaves[0], proof: [][]byte{leaves[2]}},
+		"empty proof":  {leaf: leaves[0]},
+		"root as leaf": {leaf: root, exp: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			assert.Equal(t, spec.exp, VerifyProof(root, spec.leaf, spec.proof))
+		})
+	}
+}
+
+func TestRecoverEthAddress(t *testing.T) {
+	privKey, err := btcec.NewPrivateKey(btcec.S256())
+	require.NoError(t, err)
+	ethAddr := Keccak256(privKey.PubKey().SerializeUncompressed()[1:])[12:]
+	recipient := sdk.AccAddress([]byte("recipient___________"))
+	message := ClaimMessage(recipient)
+	hash := Keccak256([]byte(fmt.Sprintf("\x19Ethereum Signed Message:\n%d", len(message))), message)
+	compact, err := btcec.SignCompact(btcec.S256(), privKey, hash, false)
+	require.NoError(t, err)
+	// Ethereum signatures are r, s and v
+	sig := append(compact[1:], compact[0])
+
+	specs := map[string]struct {
+		message []byte
+		sig     []byte
+		expErr  bool
+		expAddr []byte
+	}{
+		"signed":          {message: message, sig: sig, expAddr: ethAddr},
+		"recovery id 0/1": {message: message, sig: append(compact[1:], compact[0]-27), expAddr: ethAddr},
+		"other message":   {message: ClaimMessage(sdk.AccAddress([]byte("other_______________"))), sig: sig},
+		"short signature": {message: message, sig: sig[:64], expErr: true},
+		"bad recovery id": {message: message, sig: append(compact[1:], 35), expErr: true},
+		"empty signature": {message: message, expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			addr, err := RecoverEthAddress(spec.message, spec.sig)
+			if spec.expErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if spec.expAddr != nil {
+				assert.Equal(t, spec.expAddr, addr)
+			} else {
+				assert.NotEqual(t, ethAddr, addr)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+)
+
+// GenesisState is the genesis state of the reconciliation module. The merkle root is of the balances of the Ethereum
+// staking contract at the migration, the module account must hold the sum of the balances that are not claimed.
+type GenesisState struct {
+	MerkleRoot tmbytes.HexBytes `json:"merkle_root,omitempty"`
+	Claims     []Claim          `json:"claims,omitempty"`
+}
+
+// DefaultGenesisState returns the genesis state without merkle root, nothing can be claimed
+func DefaultGenesisState() GenesisState {
+	return GenesisState{}
+}
+
+// ValidateGenesis performs basic validation of the genesis state
+func ValidateGenesis(data GenesisState) error {
+	if len(data.MerkleRoot) == 0 {
+		if len(data.Claims) != 0 {
+			return sdkerrors.Wrap(ErrNoMerkleRoot, "claims")
+		}
+		return nil
+	}
+	if len(data.MerkleRoot) != HashLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "merkle root must be %d bytes", HashLength)
+	}
+	claimed := make(map[string]struct{}, len(data.Claims))
+	for _, c := range data.Claims {
+		if err := c.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "claim of %s", c.EthAddress)
+		}
+		addr := strings.ToLower(c.EthAddress)
+		if _, exists := claimed[addr]; exists {
+			return sdkerrors.Wrapf(ErrAlreadyClaimed, "duplicate claim of %s", c.EthAddress)
+		}
+		claimed[addr] = struct{}{}
+	}
+	return nil
+}
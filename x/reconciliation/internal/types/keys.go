@@ -0,0 +1,37 @@
+package types
+
+const (
+	// ModuleName is the name of the reconciliation module
+	ModuleName = "reconciliation"
+
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for the reconciliation module
+	QuerierRoute = ModuleName
+
+	// RouterKey is the msg router key for the reconciliation module
+	RouterKey = ModuleName
+)
+
+const ( // event attributes
+	AttributeKeyEthAddress = "eth_address"
+	AttributeKeyRecipient  = "recipient"
+	AttributeKeyValidator  = "validator"
+)
+
+const (
+	// EventTypeClaim is emitted when migrated stake is claimed, with the validator when it is restaked
+	EventTypeClaim = "claim_stake"
+)
+
+// nolint
+var (
+	MerkleRootKey = []byte{0x01}
+	ClaimPrefix   = []byte{0x02}
+)
+
+// GetClaimKey returns the store key of the claim of the Ethereum address
+func GetClaimKey(ethAddr []byte) []byte {
+	return append(ClaimPrefix, ethAddr...)
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+)
+
+// MaxProofLength is the max number of hashes of a merkle proof, enough for trees of 2^32 balances
+const MaxProofLength = 32
+
+// MsgClaim claims the staked balance of the Ethereum address from the merkle root for the sender, and delegates it to
+// the validator when it is set. The signature of the claim message of the sender by the Ethereum key proves the
+// ownership of the address.
+type MsgClaim struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	// EthAddress is the 0x prefixed hex Ethereum address of the staked balance
+	EthAddress string             `json:"eth_address" yaml:"eth_address"`
+	Amount     sdk.Int            `json:"amount" yaml:"amount"`
+	Proof      []tmbytes.HexBytes `json:"proof" yaml:"proof"`
+	// Signature is the personal_sign signature of ClaimMessage of the sender, r, s and v
+	Signature tmbytes.HexBytes `json:"signature" yaml:"signature"`
+	Validator sdk.ValAddress   `json:"validator,omitempty" yaml:"validator,omitempty"`
+}
+
+func (msg MsgClaim) Route() string {
+	return RouterKey
+}
+
+func (msg MsgClaim) Type() string {
+	return "claim"
+}
+
+func (msg MsgClaim) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if _, err := ParseEthAddress(msg.EthAddress); err != nil {
+		return err
+	}
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "amount must be positive")
+	}
+	if len(msg.Proof) > MaxProofLength {
+		return sdkerrors.Wrapf(ErrInvalidProof, "exceeds %d hashes", MaxProofLength)
+	}
+	for _, p := range msg.Proof {
+		if len(p) != HashLength {
+			return sdkerrors.Wrapf(ErrInvalidProof, "hashes must be %d bytes", HashLength)
+		}
+	}
+	if len(msg.Signature) != EthSignatureLength {
+		return sdkerrors.Wrapf(ErrInvalidSignature, "must be %d bytes", EthSignatureLength)
+	}
+	if msg.Validator != nil {
+		return sdkerrors.Wrap(sdk.VerifyAddressFormat(msg.Validator), "validator")
+	}
+	return nil
+}
+
+func (msg MsgClaim) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgClaim) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
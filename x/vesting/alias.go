@@ -0,0 +1,45 @@
+package vesting
+
+import (
+	"github.com/fetchai/fetchd/x/vesting/internal/keeper"
+	"github.com/fetchai/fetchd/x/vesting/internal/types"
+)
+
+const (
+	ModuleName                       = types.ModuleName
+	RouterKey                        = types.RouterKey
+	ProposalTypeCreateVestingAccount = types.ProposalTypeCreateVestingAccount
+	VestingTypeContinuous            = types.VestingTypeContinuous
+	VestingTypeDelayed               = types.VestingTypeDelayed
+	VestingTypePeriodic              = types.VestingTypePeriodic
+	VestingTypeClawback              = types.VestingTypeClawback
+	AttributeKeyAddress              = types.AttributeKeyAddress
+)
+
+var (
+	// functions aliases
+	RegisterCodec             = types.RegisterCodec
+	ValidateGenesis           = types.ValidateGenesis
+	ValidateSchedule          = types.ValidateSchedule
+	NewVestingAccount         = types.NewVestingAccount
+	NewVestingSchedule        = types.NewVestingSchedule
+	NewClawbackVestingAccount = types.NewClawbackVestingAccount
+	NewKeeper                 = keeper.NewKeeper
+	NewProposalHandler        = keeper.NewProposalHandler
+
+	// variable aliases
+	ModuleCdc          = types.ModuleCdc
+	ErrInvalidSchedule = types.ErrInvalidSchedule
+	ErrInvalidAccount  = types.ErrInvalidAccount
+)
+
+type (
+	Keeper                          = keeper.Keeper
+	GenesisState                    = types.GenesisState
+	CreateVestingAccountProposal    = types.CreateVestingAccountProposal
+	VestingSchedule                 = types.VestingSchedule
+	ClawbackVestingAccount          = types.ClawbackVestingAccount
+	MsgCreateClawbackVestingAccount = types.MsgCreateClawbackVestingAccount
+	MsgClawback                     = types.MsgClawback
+	Unlock                          = types.Unlock
+)
@@ -0,0 +1,104 @@
+package cli
+
+import (
+	"bufio"
+	"io/ioutil"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/fetchai/fetchd/x/vesting/internal/types"
+	wasmUtils "github.com/fetchai/fetchd/x/wasm/client/utils"
+)
+
+const (
+	flagStartTime = "start-time"
+	flagEndTime   = "end-time"
+	flagPeriods   = "periods"
+)
+
+// ProposalCreateVestingAccountCmd submits a proposal to create a vesting account funded by the community pool
+func ProposalCreateVestingAccountCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-vesting-account [address] [amount]",
+		Short: "Submit a proposal to create a vesting account funded by the community pool",
+		Long: `Submit a proposal to send the amount from the community pool to the address and to vest it. With a start
+and end time the coins vest continuously, with an end time only all at once at the end. A --periods file of amino json
+periods, e.g. [{"length":"2592000","amount":[{"denom":"afet","amount":"1000"}]}], vests the amounts one after another
+starting at the start time. The account must not exist or be a plain account, its coins stay spendable.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+			var periods vestingtypes.Periods
+			if file := viper.GetString(flagPeriods); file != "" {
+				if periods, err = ReadPeriods(cdc, file); err != nil {
+					return err
+				}
+			}
+
+			content := types.CreateVestingAccountProposal{
+				Title:       viper.GetString(cli.FlagTitle),
+				Description: viper.GetString(cli.FlagDescription),
+				Address:     addr,
+				Amount:      amount,
+				StartTime:   viper.GetInt64(flagStartTime),
+				EndTime:     viper.GetInt64(flagEndTime),
+				Periods:     periods,
+			}
+
+			deposit, err := sdk.ParseCoins(viper.GetString(cli.FlagDeposit))
+			if err != nil {
+				return err
+			}
+
+			msg := govtypes.NewMsgSubmitProposal(content, deposit, cliCtx.GetFromAddress())
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().Int64(flagStartTime, 0, "Unix time the coins start to vest at, for continuous and periodic vesting")
+	cmd.Flags().Int64(flagEndTime, 0, "Unix time the coins are vested at, for continuous and delayed vesting")
+	cmd.Flags().String(flagPeriods, "", "File of the vesting periods, for periodic vesting")
+	// proposal flags
+	cmd.Flags().String(cli.FlagTitle, "", "Title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "Description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "", "Deposit of proposal")
+	wasmUtils.AddBroadcastFlags(cmd)
+	return cmd
+}
+
+// ReadPeriods reads the amino json vesting periods of the file
+func ReadPeriods(cdc *codec.Codec, file string) (vestingtypes.Periods, error) {
+	bz, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var periods vestingtypes.Periods
+	if err := cdc.UnmarshalJSON(bz, &periods); err != nil {
+		return nil, err
+	}
+	return periods, nil
+}
@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+
+	"github.com/fetchai/fetchd/x/vesting/internal/types"
+)
+
+const flagAt = "at"
+
+// GetCmdQueryVestingSchedule shows the unlock timeline of a vesting account
+func GetCmdQueryVestingSchedule(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vesting-schedule [address]",
+		Short: "Show the unlock timeline of a vesting account",
+		Long: `Show the type, the original vesting coins and the times the coins of a vesting account unlock at, with the
+coins vested and still vesting now or at the unix time of --at. The coins of continuous vesting accounts unlock
+linearly from the start to the end time.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			acc, err := auth.NewAccountRetriever(cliCtx).GetAccount(addr)
+			if err != nil {
+				return err
+			}
+			at := time.Now()
+			if cmd.Flags().Changed(flagAt) {
+				at = time.Unix(viper.GetInt64(flagAt), 0)
+			}
+			schedule, err := types.NewVestingSchedule(acc, at)
+			if err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(schedule)
+		},
+	}
+	cmd.Flags().Int64(flagAt, 0, "Unix time to show the vested coins for, default now")
+	return flags.GetCommands(cmd)[0]
+}
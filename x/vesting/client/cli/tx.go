@@ -0,0 +1,123 @@
+package cli
+
+import (
+	"bufio"
+	"errors"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	"github.com/fetchai/fetchd/x/vesting/internal/types"
+	wasmUtils "github.com/fetchai/fetchd/x/wasm/client/utils"
+)
+
+const flagDest = "dest"
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Vesting transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	txCmd.AddCommand(wasmUtils.AddBroadcastFlags(flags.PostCommands(
+		CreateClawbackVestingAccountCmd(cdc),
+		ClawbackCmd(cdc),
+	)...)...)
+	return txCmd
+}
+
+// CreateClawbackVestingAccountCmd creates a periodic vesting account whose unvested coins the sender can claw back
+func CreateClawbackVestingAccountCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-clawback-account [to_address] --start-time [unix_time] --periods [file]",
+		Short: "Create a periodic vesting account whose unvested coins you can claw back",
+		Long: `Send the total of the periods to the new account to_address, which vests the amounts of the periods one after
+another from the start time on. The sender is the funder of the account and can claw back the coins that did not vest
+yet with "tx vesting clawback". The --periods file holds amino json periods, e.g.
+[{"length":"2592000","amount":[{"denom":"afet","amount":"1000"}]}].`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			to, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			file := viper.GetString(flagPeriods)
+			if file == "" {
+				return errors.New("periods file required")
+			}
+			periods, err := ReadPeriods(cdc, file)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgCreateClawbackVestingAccount{
+				FromAddress: cliCtx.GetFromAddress(),
+				ToAddress:   to,
+				StartTime:   viper.GetInt64(flagStartTime),
+				Periods:     periods,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().Int64(flagStartTime, 0, "Unix time the first period starts at")
+	cmd.Flags().String(flagPeriods, "", "File of the vesting periods")
+	return cmd
+}
+
+// ClawbackCmd claws back the unvested coins of a clawback vesting account
+func ClawbackCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback [address]",
+		Short: "Claw back the unvested coins of a clawback vesting account you funded",
+		Long: `Send the coins of the clawback vesting account that did not vest yet to the funder, or to --dest, and remove
+them from its schedule. Unvested coins that are delegated stay in the schedule, they can be clawed back once they are
+undelegated. "query auth vesting-schedule" shows the clawable coins.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			var dest sdk.AccAddress
+			if s := viper.GetString(flagDest); s != "" {
+				if dest, err = sdk.AccAddressFromBech32(s); err != nil {
+					return err
+				}
+			}
+
+			msg := types.MsgClawback{
+				FunderAddress: cliCtx.GetFromAddress(),
+				Address:       addr,
+				DestAddress:   dest,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(flagDest, "", "Address to send the clawed back coins to, default the funder")
+	return cmd
+}
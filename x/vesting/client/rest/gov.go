@@ -0,0 +1,62 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+
+	"github.com/fetchai/fetchd/x/vesting/internal/types"
+)
+
+type CreateVestingAccountJsonReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+
+	Proposer sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit  sdk.Coins      `json:"deposit" yaml:"deposit"`
+
+	Address   sdk.AccAddress       `json:"address" yaml:"address"`
+	Amount    sdk.Coins            `json:"amount" yaml:"amount"`
+	StartTime int64                `json:"start_time" yaml:"start_time"`
+	EndTime   int64                `json:"end_time" yaml:"end_time"`
+	Periods   vestingtypes.Periods `json:"periods" yaml:"periods"`
+}
+
+func CreateVestingAccountProposalHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "create_vesting_account",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req CreateVestingAccountJsonReq
+			if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+				return
+			}
+			content := types.CreateVestingAccountProposal{
+				Title:       req.Title,
+				Description: req.Description,
+				Address:     req.Address,
+				Amount:      req.Amount,
+				StartTime:   req.StartTime,
+				EndTime:     req.EndTime,
+				Periods:     req.Periods,
+			}
+			msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+			if err := msg.ValidateBasic(); err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			baseReq := req.BaseReq.Sanitize()
+			if !baseReq.ValidateBasic(w) {
+				return
+			}
+			utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+		},
+	}
+}
@@ -0,0 +1,59 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+
+	"github.com/fetchai/fetchd/x/vesting/internal/types"
+)
+
+func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/auth/accounts/{address}/vesting-schedule", queryVestingScheduleHandlerFn(cliCtx)).Methods("GET")
+}
+
+// queryVestingScheduleHandlerFn returns the unlock timeline of a vesting account, with the coins vested at the unix
+// time of the "at" query param or now
+func queryVestingScheduleHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		addr, err := sdk.AccAddressFromBech32(mux.Vars(r)["address"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		at := time.Now()
+		if s := r.FormValue("at"); s != "" {
+			unix, err := strconv.ParseInt(s, 10, 64)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid at: %s", err))
+				return
+			}
+			at = time.Unix(unix, 0)
+		}
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		acc, height, err := auth.NewAccountRetriever(cliCtx).GetAccountWithHeight(addr)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+		schedule, err := types.NewVestingSchedule(acc, at)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, schedule)
+	}
+}
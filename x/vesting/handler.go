@@ -0,0 +1,63 @@
+package vesting
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewHandler returns a handler for "vesting" type messages.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case MsgCreateClawbackVestingAccount:
+			return handleCreateClawbackVestingAccount(ctx, k, &msg)
+		case MsgClawback:
+			return handleClawback(ctx, k, &msg)
+		default:
+			errMsg := fmt.Sprintf("unrecognized vesting message type: %T", msg)
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+		}
+	}
+}
+
+func handleCreateClawbackVestingAccount(ctx sdk.Context, k Keeper, msg *MsgCreateClawbackVestingAccount) (*sdk.Result, error) {
+	if err := k.CreateClawbackVestingAccount(ctx, msg.FromAddress, msg.ToAddress, msg.StartTime, msg.Periods); err != nil {
+		return nil, err
+	}
+	events := ctx.EventManager().Events()
+	ourEvent := sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		sdk.NewAttribute(AttributeKeyAddress, msg.ToAddress.String()),
+	)
+	return &sdk.Result{
+		Events: append(events, ourEvent),
+	}, nil
+}
+
+func handleClawback(ctx sdk.Context, k Keeper, msg *MsgClawback) (*sdk.Result, error) {
+	dest := msg.DestAddress
+	if len(dest) == 0 {
+		dest = msg.FunderAddress
+	}
+	clawed, err := k.Clawback(ctx, msg.FunderAddress, msg.Address, dest)
+	if err != nil {
+		return nil, err
+	}
+	events := ctx.EventManager().Events()
+	ourEvent := sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.FunderAddress.String()),
+		sdk.NewAttribute(AttributeKeyAddress, msg.Address.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, clawed.String()),
+	)
+	return &sdk.Result{
+		Events: append(events, ourEvent),
+	}, nil
+}
@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+
+	"github.com/fetchai/fetchd/x/vesting/internal/types"
+)
+
+// Keeper creates vesting accounts and claws back the coins of clawback vesting accounts, the accounts are stored by
+// the account keeper
+type Keeper struct {
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	distrKeeper   types.DistributionKeeper
+}
+
+// NewKeeper creates a new vesting Keeper instance
+func NewKeeper(accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper, distrKeeper types.DistributionKeeper) Keeper {
+	return Keeper{accountKeeper: accountKeeper, bankKeeper: bankKeeper, distrKeeper: distrKeeper}
+}
+
+// CreateVestingAccount sends the amount from the community pool to the address and turns its account into a vesting
+// account of the amount. The account is created when it does not exist, existing accounts must be base accounts;
+// their coins stay spendable.
+func (k Keeper) CreateVestingAccount(ctx sdk.Context, addr sdk.AccAddress, amount sdk.Coins, startTime, endTime int64, periods vestingtypes.Periods) error {
+	if err := types.ValidateSchedule(amount, startTime, endTime, periods); err != nil {
+		return err
+	}
+	if acc := k.accountKeeper.GetAccount(ctx, addr); acc != nil {
+		if _, ok := acc.(*authtypes.BaseAccount); !ok {
+			return sdkerrors.Wrapf(types.ErrInvalidAccount, "%s is not a base account", addr)
+		}
+	}
+	if err := k.distrKeeper.DistributeFromFeePool(ctx, amount, addr); err != nil {
+		return err
+	}
+	base, ok := k.accountKeeper.GetAccount(ctx, addr).(*authtypes.BaseAccount)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrInvalidAccount, "%s is not a base account", addr)
+	}
+	acc, err := types.NewVestingAccount(base, amount, startTime, endTime, periods)
+	if err != nil {
+		return err
+	}
+	k.accountKeeper.SetAccount(ctx, acc)
+	return nil
+}
+
+// CreateClawbackVestingAccount sends the total of the periods from the funder to the new account to, which vests them
+// as clawback vesting account of the funder
+func (k Keeper) CreateClawbackVestingAccount(ctx sdk.Context, funder, to sdk.AccAddress, startTime int64, periods vestingtypes.Periods) error {
+	if !k.bankKeeper.GetSendEnabled(ctx) {
+		return bank.ErrSendDisabled
+	}
+	if k.bankKeeper.BlockedAddr(to) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", to)
+	}
+	if k.accountKeeper.GetAccount(ctx, to) != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidAccount, "account %s already exists", to)
+	}
+	var total sdk.Coins
+	for _, p := range periods {
+		total = total.Add(p.Amount...)
+	}
+	if err := k.bankKeeper.SendCoins(ctx, funder, to, total); err != nil {
+		return err
+	}
+	base, ok := k.accountKeeper.GetAccount(ctx, to).(*authtypes.BaseAccount)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrInvalidAccount, "%s is not a base account", to)
+	}
+	acc, err := types.NewClawbackVestingAccount(base, funder, startTime, periods)
+	if err != nil {
+		return err
+	}
+	k.accountKeeper.SetAccount(ctx, acc)
+	return nil
+}
+
+// Clawback sends the coins of the clawback vesting account addr that did not vest yet to dest and removes them from
+// its schedule. Only the funder of the account can claw them back, unvested coins that are delegated stay in the
+// schedule and can be clawed back once they are undelegated.
+func (k Keeper) Clawback(ctx sdk.Context, funder, addr, dest sdk.AccAddress) (sdk.Coins, error) {
+	acc, ok := k.accountKeeper.GetAccount(ctx, addr).(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAccount, "%s is not a clawback vesting account", addr)
+	}
+	if !acc.FunderAddress.Equals(funder) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "not the funder of the account")
+	}
+	if k.bankKeeper.BlockedAddr(dest) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", dest)
+	}
+	clawed := acc.Clawback(ctx.BlockTime())
+	k.accountKeeper.SetAccount(ctx, acc)
+	if clawed.IsZero() {
+		return clawed, nil
+	}
+	// the clawed coins are spendable now
+	if err := k.bankKeeper.SendCoins(ctx, addr, dest, clawed); err != nil {
+		return nil, err
+	}
+	return clawed, nil
+}
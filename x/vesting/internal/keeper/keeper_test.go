@@ -0,0 +1,213 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/fetchai/fetchd/x/vesting/internal/types"
+)
+
+func TestCreateVestingAccount(t *testing.T) {
+	var (
+		newAddr     = sdk.AccAddress([]byte("new_________________"))
+		baseAddr    = sdk.AccAddress([]byte("base________________"))
+		vestingAddr = sdk.AccAddress([]byte("vesting_____________"))
+		moduleAddr  = supply.NewModuleAddress("module")
+		myCoins     = sdk.NewCoins(sdk.NewInt64Coin("afet", 100))
+		otherCoins  = sdk.NewCoins(sdk.NewInt64Coin("afet", 7))
+	)
+	specs := map[string]struct {
+		addr     sdk.AccAddress
+		amount   sdk.Coins
+		expCoins sdk.Coins
+		expErr   bool
+	}{
+		"new account":            {addr: newAddr, amount: myCoins, expCoins: myCoins},
+		"existing base account":  {addr: baseAddr, amount: myCoins, expCoins: myCoins.Add(otherCoins...)},
+		"vesting account":        {addr: vestingAddr, amount: myCoins, expErr: true},
+		"module account":         {addr: moduleAddr, amount: myCoins, expErr: true},
+		"exceeds community pool": {addr: newAddr, amount: myCoins.Add(myCoins...).Add(myCoins...), expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ak := mockAccountKeeper{}
+			ak.SetAccount(sdk.Context{}, authtypes.NewBaseAccount(baseAddr, otherCoins, nil, 1, 0))
+			vacc, err := types.NewVestingAccount(authtypes.NewBaseAccount(vestingAddr, otherCoins, nil, 2, 0), otherCoins, 0, 2000, nil)
+			require.NoError(t, err)
+			ak.SetAccount(sdk.Context{}, vacc)
+			ak.SetAccount(sdk.Context{}, supply.NewEmptyModuleAccount("module"))
+			pool := sdk.NewCoins(sdk.NewInt64Coin("afet", 250))
+			k := NewKeeper(ak, mockBankKeeper{ak: ak}, &mockDistrKeeper{ak: ak, pool: pool})
+			ctx := sdk.NewContext(nil, abci.Header{}, false, log.NewNopLogger())
+
+			err = k.CreateVestingAccount(ctx, spec.addr, spec.amount, 1000, 2000, nil)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			acc, ok := ak.GetAccount(ctx, spec.addr).(*vestingtypes.ContinuousVestingAccount)
+			require.True(t, ok)
+			assert.Equal(t, spec.expCoins, acc.GetCoins())
+			assert.Equal(t, spec.amount, acc.OriginalVesting)
+		})
+	}
+}
+
+func TestClawback(t *testing.T) {
+	var (
+		funder  = sdk.AccAddress([]byte("funder______________"))
+		grantee = sdk.AccAddress([]byte("grantee_____________"))
+		other   = sdk.AccAddress([]byte("other_______________"))
+		blocked = supply.NewModuleAddress("module")
+		fet     = func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("afet", amount)) }
+		periods = vestingtypes.Periods{{Length: 100, Amount: fet(40)}, {Length: 100, Amount: fet(60)}}
+	)
+	specs := map[string]struct {
+		sender    sdk.AccAddress
+		dest      sdk.AccAddress
+		blockTime int64
+		expClawed sdk.Coins
+		expErr    bool
+	}{
+		"before start":        {sender: funder, dest: funder, blockTime: 900, expClawed: fet(100)},
+		"first period vested": {sender: funder, dest: funder, blockTime: 1150, expClawed: fet(60)},
+		"other dest":          {sender: funder, dest: other, blockTime: 1150, expClawed: fet(60)},
+		"all vested":          {sender: funder, dest: funder, blockTime: 1200, expClawed: fet(0)},
+		"not the funder":      {sender: other, dest: other, blockTime: 1150, expErr: true},
+		"blocked dest":        {sender: funder, dest: blocked, blockTime: 1150, expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ak := mockAccountKeeper{}
+			ak.SetAccount(sdk.Context{}, authtypes.NewBaseAccount(funder, fet(150), nil, 1, 0))
+			k := NewKeeper(ak, mockBankKeeper{ak: ak}, &mockDistrKeeper{ak: ak})
+			ctx := sdk.NewContext(nil, abci.Header{Time: time.Unix(spec.blockTime, 0)}, false, log.NewNopLogger())
+			require.NoError(t, k.CreateClawbackVestingAccount(ctx, funder, grantee, 1000, periods))
+			require.Equal(t, fet(50), ak.GetAccount(ctx, funder).GetCoins())
+
+			clawed, err := k.Clawback(ctx, spec.sender, grantee, spec.dest)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.expClawed.String(), clawed.String())
+			acc := ak.GetAccount(ctx, grantee).(*types.ClawbackVestingAccount)
+			assert.Equal(t, fet(100).Sub(spec.expClawed).String(), acc.GetCoins().String())
+			assert.Equal(t, fet(100).Sub(spec.expClawed).String(), acc.OriginalVesting.String())
+			assert.True(t, acc.GetVestingCoins(ctx.BlockTime()).IsZero())
+			expDest := spec.expClawed
+			if spec.dest.Equals(funder) {
+				expDest = expDest.Add(fet(50)...)
+			}
+			assert.Equal(t, expDest.String(), ak.GetAccount(ctx, spec.dest).GetCoins().String())
+		})
+	}
+}
+
+func TestCreateClawbackVestingAccount(t *testing.T) {
+	funder := sdk.AccAddress([]byte("funder______________"))
+	grantee := sdk.AccAddress([]byte("grantee_____________"))
+	periods := vestingtypes.Periods{{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("afet", 100))}}
+	ctx := sdk.NewContext(nil, abci.Header{}, false, log.NewNopLogger())
+
+	ak := mockAccountKeeper{}
+	ak.SetAccount(ctx, authtypes.NewBaseAccount(funder, sdk.NewCoins(sdk.NewInt64Coin("afet", 150)), nil, 1, 0))
+	k := NewKeeper(ak, mockBankKeeper{ak: ak}, &mockDistrKeeper{ak: ak})
+	require.NoError(t, k.CreateClawbackVestingAccount(ctx, funder, grantee, 1000, periods))
+	acc, ok := ak.GetAccount(ctx, grantee).(*types.ClawbackVestingAccount)
+	require.True(t, ok)
+	assert.Equal(t, funder, acc.FunderAddress)
+
+	// existing accounts are not turned into clawback accounts
+	err := k.CreateClawbackVestingAccount(ctx, funder, grantee, 1000, periods)
+	assert.True(t, types.ErrInvalidAccount.Is(err), err)
+	// the funder must hold the coins
+	err = k.CreateClawbackVestingAccount(ctx, funder, sdk.AccAddress([]byte("other_______________")), 1000, periods)
+	assert.True(t, sdkerrors.ErrInsufficientFunds.Is(err), err)
+	// send disabled
+	k = NewKeeper(ak, mockBankKeeper{ak: ak, sendDisabled: true}, &mockDistrKeeper{ak: ak})
+	assert.Error(t, k.CreateClawbackVestingAccount(ctx, funder, sdk.AccAddress([]byte("other_______________")), 1000, periods))
+}
+
+type mockAccountKeeper map[string]authexported.Account
+
+func (m mockAccountKeeper) GetAccount(_ sdk.Context, addr sdk.AccAddress) authexported.Account {
+	return m[addr.String()]
+}
+
+func (m mockAccountKeeper) SetAccount(_ sdk.Context, acc authexported.Account) {
+	m[acc.GetAddress().String()] = acc
+}
+
+type mockDistrKeeper struct {
+	ak   mockAccountKeeper
+	pool sdk.Coins
+}
+
+func (m *mockDistrKeeper) DistributeFromFeePool(ctx sdk.Context, amount sdk.Coins, addr sdk.AccAddress) error {
+	pool, negative := m.pool.SafeSub(amount)
+	if negative {
+		return sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, "community pool")
+	}
+	m.pool = pool
+	acc := m.ak.GetAccount(ctx, addr)
+	if acc == nil {
+		base := authtypes.NewBaseAccountWithAddress(addr)
+		acc = &base
+	}
+	if err := acc.SetCoins(acc.GetCoins().Add(amount...)); err != nil {
+		return err
+	}
+	m.ak.SetAccount(ctx, acc)
+	return nil
+}
+
+type mockBankKeeper struct {
+	ak           mockAccountKeeper
+	sendDisabled bool
+}
+
+func (m mockBankKeeper) SendCoins(ctx sdk.Context, from, to sdk.AccAddress, amt sdk.Coins) error {
+	fromAcc := m.ak.GetAccount(ctx, from)
+	if fromAcc == nil {
+		return sdkerrors.ErrUnknownAddress
+	}
+	if _, negative := fromAcc.SpendableCoins(ctx.BlockTime()).SafeSub(amt); negative {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	if err := fromAcc.SetCoins(fromAcc.GetCoins().Sub(amt)); err != nil {
+		return err
+	}
+	m.ak.SetAccount(ctx, fromAcc)
+	toAcc := m.ak.GetAccount(ctx, to)
+	if toAcc == nil {
+		base := authtypes.NewBaseAccountWithAddress(to)
+		toAcc = &base
+	}
+	if err := toAcc.SetCoins(toAcc.GetCoins().Add(amt...)); err != nil {
+		return err
+	}
+	m.ak.SetAccount(ctx, toAcc)
+	return nil
+}
+
+func (m mockBankKeeper) GetSendEnabled(sdk.Context) bool {
+	return !m.sendDisabled
+}
+
+func (m mockBankKeeper) BlockedAddr(addr sdk.AccAddress) bool {
+	return addr.Equals(supply.NewModuleAddress("module"))
+}
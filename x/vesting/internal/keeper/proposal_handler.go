@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/fetchai/fetchd/x/vesting/internal/types"
+)
+
+// NewProposalHandler creates a new governance Handler for vesting proposals
+func NewProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case types.CreateVestingAccountProposal:
+			return handleCreateVestingAccountProposal(ctx, k, c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized vesting proposal content type: %T", c)
+		}
+	}
+}
+
+func handleCreateVestingAccountProposal(ctx sdk.Context, k Keeper, p types.CreateVestingAccountProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+	if err := k.CreateVestingAccount(ctx, p.Address, p.Amount, p.StartTime, p.EndTime, p.Periods); err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCreateVestingAccount,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyAddress, p.Address.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, p.Amount.String()),
+	))
+	return nil
+}
@@ -0,0 +1,148 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+var (
+	_ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
+	_ authexported.GenesisAccount = (*ClawbackVestingAccount)(nil)
+)
+
+// ClawbackVestingAccount is a periodic vesting account whose funder can claw back the coins that did not vest yet,
+// for grants and employee allocations that must be revocable.
+type ClawbackVestingAccount struct {
+	*vestingtypes.PeriodicVestingAccount
+
+	FunderAddress sdk.AccAddress `json:"funder_address" yaml:"funder_address"`
+}
+
+// NewClawbackVestingAccount turns the base account into a clawback vesting account of the total of the periods
+func NewClawbackVestingAccount(base *authtypes.BaseAccount, funder sdk.AccAddress, startTime int64, periods vestingtypes.Periods) (*ClawbackVestingAccount, error) {
+	if err := sdk.VerifyAddressFormat(funder); err != nil {
+		return nil, sdkerrors.Wrap(err, "funder")
+	}
+	if len(periods) == 0 {
+		return nil, sdkerrors.Wrap(ErrInvalidSchedule, "periods required")
+	}
+	total, end, err := periodsTotal(startTime, periods)
+	if err != nil {
+		return nil, err
+	}
+	bva, err := vestingtypes.NewBaseVestingAccount(base, total, end)
+	if err != nil {
+		return nil, sdkerrors.Wrap(ErrInvalidAccount, err.Error())
+	}
+	return &ClawbackVestingAccount{
+		PeriodicVestingAccount: vestingtypes.NewPeriodicVestingAccountRaw(bva, startTime, periods),
+		FunderAddress:          funder,
+	}, nil
+}
+
+// Validate checks for errors on the account fields
+func (va ClawbackVestingAccount) Validate() error {
+	if va.FunderAddress.Empty() {
+		return errors.New("funder address must not be empty")
+	}
+	return va.PeriodicVestingAccount.Validate()
+}
+
+// ClawableCoins returns the coins that did not vest at blockTime and are held by the account. Unvested coins that
+// are delegated can be clawed back once they are undelegated.
+func (va ClawbackVestingAccount) ClawableCoins(blockTime time.Time) sdk.Coins {
+	coins := va.GetCoins()
+	var clawable sdk.Coins
+	for _, c := range va.GetVestingCoins(blockTime) {
+		amt := c.Amount.Sub(va.DelegatedVesting.AmountOf(c.Denom))
+		if held := coins.AmountOf(c.Denom); held.LT(amt) {
+			amt = held
+		}
+		if amt.IsPositive() {
+			clawable = append(clawable, sdk.NewCoin(c.Denom, amt))
+		}
+	}
+	return sdk.NewCoins(clawable...)
+}
+
+// Clawback removes the clawable coins from the vesting schedule, from the last periods on, and returns them. The
+// coins stay in the account as spendable coins until they are sent to the funder.
+func (va *ClawbackVestingAccount) Clawback(blockTime time.Time) sdk.Coins {
+	clawed := va.ClawableCoins(blockTime)
+	periods := make(vestingtypes.Periods, len(va.VestingPeriods))
+	copy(periods, va.VestingPeriods)
+	remaining := clawed
+	for i := len(periods) - 1; i >= 0 && !remaining.IsZero(); i-- {
+		var cut sdk.Coins
+		for _, c := range periods[i].Amount {
+			amt := remaining.AmountOf(c.Denom)
+			if c.Amount.LT(amt) {
+				amt = c.Amount
+			}
+			if amt.IsPositive() {
+				cut = append(cut, sdk.NewCoin(c.Denom, amt))
+			}
+		}
+		cut = sdk.NewCoins(cut...)
+		periods[i].Amount = periods[i].Amount.Sub(cut)
+		remaining = remaining.Sub(cut)
+	}
+	va.VestingPeriods = periods
+	va.OriginalVesting = va.OriginalVesting.Sub(clawed)
+	return clawed
+}
+
+// MarshalJSON returns the json of the periodic vesting account with the funder address
+func (va ClawbackVestingAccount) MarshalJSON() ([]byte, error) {
+	bz, err := va.PeriodicVestingAccount.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &fields); err != nil {
+		return nil, err
+	}
+	if fields["funder_address"], err = json.Marshal(va.FunderAddress); err != nil {
+		return nil, err
+	}
+	return json.Marshal(fields)
+}
+
+// UnmarshalJSON unmarshals the json of MarshalJSON
+func (va *ClawbackVestingAccount) UnmarshalJSON(bz []byte) error {
+	var funder struct {
+		FunderAddress sdk.AccAddress `json:"funder_address"`
+	}
+	if err := json.Unmarshal(bz, &funder); err != nil {
+		return err
+	}
+	pva := new(vestingtypes.PeriodicVestingAccount)
+	if err := pva.UnmarshalJSON(bz); err != nil {
+		return err
+	}
+	va.PeriodicVestingAccount, va.FunderAddress = pva, funder.FunderAddress
+	return nil
+}
+
+// MarshalYAML returns the yaml of the periodic vesting account with the funder address
+func (va ClawbackVestingAccount) MarshalYAML() (interface{}, error) {
+	out, err := va.PeriodicVestingAccount.MarshalYAML()
+	if err != nil {
+		return nil, err
+	}
+	return fmt.Sprintf("%sfunder_address: %s\n", out, va.FunderAddress), nil
+}
+
+func (va ClawbackVestingAccount) String() string {
+	out, _ := va.MarshalYAML()
+	return out.(string)
+}
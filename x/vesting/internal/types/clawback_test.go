@@ -0,0 +1,97 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClawback(t *testing.T) {
+	addr := sdk.AccAddress([]byte("grantee_____________"))
+	funder := sdk.AccAddress([]byte("funder______________"))
+	fet := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("afet", amount)) }
+	periods := vestingtypes.Periods{
+		{Length: 100, Amount: fet(30)},
+		{Length: 100, Amount: fet(30)},
+		{Length: 100, Amount: fet(40)},
+	}
+	specs := map[string]struct {
+		at          int64
+		delegated   sdk.Coins
+		expClawed   sdk.Coins
+		expPeriods  []sdk.Coins
+		expOriginal sdk.Coins
+	}{
+		"before start":                 {at: 0, expClawed: fet(100), expPeriods: []sdk.Coins{fet(0), fet(0), fet(0)}, expOriginal: fet(0)},
+		"first period vested":          {at: 1150, expClawed: fet(70), expPeriods: []sdk.Coins{fet(30), fet(0), fet(0)}, expOriginal: fet(30)},
+		"all vested":                   {at: 1300, expClawed: fet(0), expPeriods: []sdk.Coins{fet(30), fet(30), fet(40)}, expOriginal: fet(100)},
+		"unvested coins delegated":     {at: 1150, delegated: fet(50), expClawed: fet(20), expPeriods: []sdk.Coins{fet(30), fet(30), fet(20)}, expOriginal: fet(80)},
+		"all unvested coins delegated": {at: 1150, delegated: fet(100), expClawed: fet(0), expPeriods: []sdk.Coins{fet(30), fet(30), fet(40)}, expOriginal: fet(100)},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			acc, err := NewClawbackVestingAccount(authtypes.NewBaseAccount(addr, fet(100), nil, 0, 0), funder, 1000, periods)
+			require.NoError(t, err)
+			if !spec.delegated.Empty() {
+				acc.TrackDelegation(time.Unix(spec.at, 0), spec.delegated)
+				require.NoError(t, acc.SetCoins(acc.GetCoins().Sub(spec.delegated)))
+			}
+
+			clawed := acc.Clawback(time.Unix(spec.at, 0))
+			assert.Equal(t, spec.expClawed.String(), clawed.String())
+			for i, p := range acc.VestingPeriods {
+				assert.Equal(t, spec.expPeriods[i].String(), p.Amount.String(), "period %d", i)
+			}
+			assert.Equal(t, spec.expOriginal.String(), acc.OriginalVesting.String())
+			if spec.delegated.Empty() {
+				assert.NoError(t, acc.Validate())
+			}
+			// the clawed coins became spendable
+			assert.True(t, acc.SpendableCoins(time.Unix(spec.at, 0)).IsAllGTE(clawed))
+			assert.True(t, acc.ClawableCoins(time.Unix(spec.at, 0)).IsZero())
+		})
+	}
+	// the periods passed to the accounts are not changed by their clawbacks
+	assert.Equal(t, fet(40), periods[2].Amount)
+}
+
+func TestClawbackVestingAccountJSON(t *testing.T) {
+	addr := sdk.AccAddress([]byte("grantee_____________"))
+	funder := sdk.AccAddress([]byte("funder______________"))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("afet", 100))
+	acc, err := NewClawbackVestingAccount(authtypes.NewBaseAccount(addr, coins, nil, 3, 4), funder, 1000, vestingtypes.Periods{{Length: 100, Amount: coins}})
+	require.NoError(t, err)
+
+	bz, err := acc.MarshalJSON()
+	require.NoError(t, err)
+	assert.Contains(t, string(bz), `"funder_address":"`+funder.String()+`"`)
+	var got ClawbackVestingAccount
+	require.NoError(t, got.UnmarshalJSON(bz))
+	assert.Equal(t, acc.String(), got.String())
+	assert.Equal(t, funder, got.FunderAddress)
+
+	// the account is stored and exported with the amino codec like the other accounts
+	bz, err = ModuleCdc.MarshalBinaryBare(acc)
+	require.NoError(t, err)
+	var decoded ClawbackVestingAccount
+	require.NoError(t, ModuleCdc.UnmarshalBinaryBare(bz, &decoded))
+	assert.Equal(t, acc.String(), decoded.String())
+}
+
+func TestNewClawbackVestingAccount(t *testing.T) {
+	addr := sdk.AccAddress([]byte("grantee_____________"))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("afet", 100))
+	periods := vestingtypes.Periods{{Length: 100, Amount: coins}}
+
+	_, err := NewClawbackVestingAccount(authtypes.NewBaseAccount(addr, coins, nil, 0, 0), nil, 1000, periods)
+	assert.Error(t, err, "funder required")
+	_, err = NewClawbackVestingAccount(authtypes.NewBaseAccount(addr, coins, nil, 0, 0), addr, 1000, nil)
+	assert.Error(t, err, "periods required")
+	_, err = NewClawbackVestingAccount(authtypes.NewBaseAccount(addr, nil, nil, 0, 0), addr, 1000, periods)
+	assert.Error(t, err, "coins required")
+}
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// RegisterCodec registers the account, msg and proposal types of the vesting module
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(&ClawbackVestingAccount{}, "vesting/ClawbackVestingAccount", nil)
+
+	cdc.RegisterConcrete(MsgCreateClawbackVestingAccount{}, "vesting/MsgCreateClawbackVestingAccount", nil)
+	cdc.RegisterConcrete(MsgClawback{}, "vesting/MsgClawback", nil)
+
+	cdc.RegisterConcrete(CreateVestingAccountProposal{}, "vesting/CreateVestingAccountProposal", nil)
+}
+
+// ModuleCdc generic sealed codec to be used throughout module
+var ModuleCdc *codec.Codec
+
+func init() {
+	cdc := codec.New()
+	RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	ModuleCdc = cdc.Seal()
+	// the auth genesis is decoded with the auth codec
+	authtypes.RegisterAccountTypeCodec(&ClawbackVestingAccount{}, "vesting/ClawbackVestingAccount")
+}
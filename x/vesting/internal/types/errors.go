@@ -0,0 +1,16 @@
+package types
+
+import (
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Codes for vesting errors
+var (
+	DefaultCodespace = ModuleName
+
+	// ErrInvalidSchedule error for vesting parameters that do not define a schedule
+	ErrInvalidSchedule = sdkErrors.Register(DefaultCodespace, 1, "invalid vesting schedule")
+
+	// ErrInvalidAccount error for an account that can not be turned into a vesting account
+	ErrInvalidAccount = sdkErrors.Register(DefaultCodespace, 2, "invalid account")
+)
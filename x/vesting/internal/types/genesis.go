@@ -0,0 +1,10 @@
+package types
+
+// GenesisState is the genesis state of the vesting module. The vesting accounts are part of the auth genesis, the
+// module keeps no state of its own.
+type GenesisState struct{}
+
+// ValidateGenesis performs basic validation of the genesis state
+func ValidateGenesis(data GenesisState) error {
+	return nil
+}
@@ -0,0 +1,15 @@
+package types
+
+const (
+	// ModuleName is the name of the vesting module
+	ModuleName = "vesting"
+
+	// RouterKey is the msg and proposal router key for the vesting module
+	RouterKey = ModuleName
+)
+
+const ( // event attributes
+	AttributeKeyAddress = "address"
+)
+
+const EventTypeCreateVestingAccount = "create_vesting_account"
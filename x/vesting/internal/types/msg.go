@@ -0,0 +1,87 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// MsgCreateClawbackVestingAccount creates a clawback vesting account of the total of the periods, funded by and
+// revocable by the sender
+type MsgCreateClawbackVestingAccount struct {
+	FromAddress sdk.AccAddress       `json:"from_address" yaml:"from_address"`
+	ToAddress   sdk.AccAddress       `json:"to_address" yaml:"to_address"`
+	StartTime   int64                `json:"start_time" yaml:"start_time"`
+	Periods     vestingtypes.Periods `json:"periods" yaml:"periods"`
+}
+
+func (msg MsgCreateClawbackVestingAccount) Route() string {
+	return RouterKey
+}
+
+func (msg MsgCreateClawbackVestingAccount) Type() string {
+	return "create-clawback-vesting-account"
+}
+
+func (msg MsgCreateClawbackVestingAccount) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.FromAddress); err != nil {
+		return sdkerrors.Wrap(err, "from address")
+	}
+	if err := sdk.VerifyAddressFormat(msg.ToAddress); err != nil {
+		return sdkerrors.Wrap(err, "to address")
+	}
+	if msg.StartTime < 0 {
+		return sdkerrors.Wrap(ErrInvalidSchedule, "negative start time")
+	}
+	if len(msg.Periods) == 0 {
+		return sdkerrors.Wrap(ErrInvalidSchedule, "periods required")
+	}
+	return ValidateSchedule(nil, msg.StartTime, 0, msg.Periods)
+}
+
+func (msg MsgCreateClawbackVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgCreateClawbackVestingAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// MsgClawback sends the unvested coins of a clawback vesting account to the destination, the funder by default
+type MsgClawback struct {
+	FunderAddress sdk.AccAddress `json:"funder_address" yaml:"funder_address"`
+	Address       sdk.AccAddress `json:"address" yaml:"address"`
+	// DestAddress receives the coins, optional
+	DestAddress sdk.AccAddress `json:"dest_address,omitempty" yaml:"dest_address"`
+}
+
+func (msg MsgClawback) Route() string {
+	return RouterKey
+}
+
+func (msg MsgClawback) Type() string {
+	return "clawback"
+}
+
+func (msg MsgClawback) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.FunderAddress); err != nil {
+		return sdkerrors.Wrap(err, "funder address")
+	}
+	if err := sdk.VerifyAddressFormat(msg.Address); err != nil {
+		return sdkerrors.Wrap(err, "address")
+	}
+	if len(msg.DestAddress) != 0 {
+		if err := sdk.VerifyAddressFormat(msg.DestAddress); err != nil {
+			return sdkerrors.Wrap(err, "dest address")
+		}
+	}
+	return nil
+}
+
+func (msg MsgClawback) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgClawback) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FunderAddress}
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const ProposalTypeCreateVestingAccount = "CreateVestingAccount"
+
+func init() { // register new content types with the sdk
+	govtypes.RegisterProposalType(ProposalTypeCreateVestingAccount)
+	govtypes.RegisterProposalTypeCodec(CreateVestingAccountProposal{}, "vesting/CreateVestingAccountProposal")
+}
+
+// CreateVestingAccountProposal gov proposal content type to create a vesting account funded by the community pool.
+// The account is continuous, delayed or periodic like the accounts of NewVestingAccount.
+type CreateVestingAccountProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+
+	Address   sdk.AccAddress       `json:"address" yaml:"address"`
+	Amount    sdk.Coins            `json:"amount" yaml:"amount"`
+	StartTime int64                `json:"start_time,omitempty" yaml:"start_time"`
+	EndTime   int64                `json:"end_time,omitempty" yaml:"end_time"`
+	Periods   vestingtypes.Periods `json:"periods,omitempty" yaml:"periods"`
+}
+
+// GetTitle returns the title of the proposal
+func (p CreateVestingAccountProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal
+func (p CreateVestingAccountProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of the proposal
+func (p CreateVestingAccountProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type
+func (p CreateVestingAccountProposal) ProposalType() string { return ProposalTypeCreateVestingAccount }
+
+// ValidateBasic validates the proposal
+func (p CreateVestingAccountProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if err := sdk.VerifyAddressFormat(p.Address); err != nil {
+		return sdkerrors.Wrap(err, "address")
+	}
+	if !p.Amount.IsValid() || p.Amount.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "amount")
+	}
+	if p.StartTime < 0 || p.EndTime < 0 {
+		return sdkerrors.Wrap(ErrInvalidSchedule, "negative time")
+	}
+	return ValidateSchedule(p.Amount, p.StartTime, p.EndTime, p.Periods)
+}
+
+// String implements the Stringer interface.
+func (p CreateVestingAccountProposal) String() string {
+	return fmt.Sprintf(`Create Vesting Account Proposal:
+  Title:       %s
+  Description: %s
+  Address:     %s
+  Amount:      %s
+  Start time:  %d
+  End time:    %d
+  Periods:     %v
+`, p.Title, p.Description, p.Address, p.Amount, p.StartTime, p.EndTime, p.Periods)
+}
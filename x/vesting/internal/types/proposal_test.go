@@ -0,0 +1,48 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateVestingAccountProposalValidateBasic(t *testing.T) {
+	valid := CreateVestingAccountProposal{
+		Title:       "Grant",
+		Description: "Vesting grant of the foundation",
+		Address:     sdk.AccAddress(make([]byte, sdk.AddrLen)),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("afet", 100)),
+		StartTime:   1000,
+		EndTime:     2000,
+	}
+	specs := map[string]struct {
+		mutate func(*CreateVestingAccountProposal)
+		expErr bool
+	}{
+		"valid":            {mutate: func(*CreateVestingAccountProposal) {}},
+		"delayed":          {mutate: func(p *CreateVestingAccountProposal) { p.StartTime = 0 }},
+		"no title":         {mutate: func(p *CreateVestingAccountProposal) { p.Title = "" }, expErr: true},
+		"long description": {mutate: func(p *CreateVestingAccountProposal) { p.Description = strings.Repeat("a", 5001) }, expErr: true},
+		"no address":       {mutate: func(p *CreateVestingAccountProposal) { p.Address = nil }, expErr: true},
+		"no amount":        {mutate: func(p *CreateVestingAccountProposal) { p.Amount = nil }, expErr: true},
+		"negative start":   {mutate: func(p *CreateVestingAccountProposal) { p.StartTime = -1 }, expErr: true},
+		"no end":           {mutate: func(p *CreateVestingAccountProposal) { p.EndTime = 0 }, expErr: true},
+		"invalid amount": {mutate: func(p *CreateVestingAccountProposal) {
+			p.Amount = sdk.Coins{sdk.Coin{Denom: "afet", Amount: sdk.NewInt(-1)}}
+		}, expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			p := valid
+			spec.mutate(&p)
+			err := p.ValidateBasic()
+			if spec.expErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+const (
+	VestingTypeContinuous = "continuous"
+	VestingTypeDelayed    = "delayed"
+	VestingTypePeriodic   = "periodic"
+	VestingTypeClawback   = "clawback"
+)
+
+// ValidateSchedule validates the vesting parameters of NewVestingAccount
+func ValidateSchedule(amount sdk.Coins, startTime, endTime int64, periods vestingtypes.Periods) error {
+	if len(periods) != 0 {
+		total, end, err := periodsTotal(startTime, periods)
+		if err != nil {
+			return err
+		}
+		if !amount.Empty() && !(amount.IsAllGTE(total) && total.IsAllGTE(amount)) {
+			return sdkerrors.Wrapf(ErrInvalidSchedule, "amount %s is not the total %s of the periods", amount, total)
+		}
+		if endTime != 0 && endTime != end {
+			return sdkerrors.Wrapf(ErrInvalidSchedule, "end time %d is not the end %d of the periods", endTime, end)
+		}
+		return nil
+	}
+	if amount.Empty() {
+		return sdkerrors.Wrap(ErrInvalidSchedule, "vesting amount required")
+	}
+	switch {
+	case startTime != 0 && endTime != 0:
+		if startTime >= endTime {
+			return sdkerrors.Wrap(ErrInvalidSchedule, "start time must be before end time")
+		}
+	case endTime == 0:
+		return sdkerrors.Wrap(ErrInvalidSchedule, "start and end time or end time required")
+	}
+	return nil
+}
+
+// NewVestingAccount turns the base account into a vesting account of amount. Periods make a periodic vesting account
+// starting at startTime, otherwise a start and end time make a continuous and an end time only a delayed vesting
+// account. The amount of periodic vesting accounts is the total of the periods when empty.
+func NewVestingAccount(base *authtypes.BaseAccount, amount sdk.Coins, startTime, endTime int64, periods vestingtypes.Periods) (authexported.GenesisAccount, error) {
+	if err := ValidateSchedule(amount, startTime, endTime, periods); err != nil {
+		return nil, err
+	}
+	if len(periods) != 0 {
+		total, end, _ := periodsTotal(startTime, periods)
+		bva, err := vestingtypes.NewBaseVestingAccount(base, total, end)
+		if err != nil {
+			return nil, sdkerrors.Wrap(ErrInvalidAccount, err.Error())
+		}
+		return vestingtypes.NewPeriodicVestingAccountRaw(bva, startTime, periods), nil
+	}
+	bva, err := vestingtypes.NewBaseVestingAccount(base, amount.Sort(), endTime)
+	if err != nil {
+		return nil, sdkerrors.Wrap(ErrInvalidAccount, err.Error())
+	}
+	if startTime != 0 {
+		return vestingtypes.NewContinuousVestingAccountRaw(bva, startTime), nil
+	}
+	return vestingtypes.NewDelayedVestingAccountRaw(bva), nil
+}
+
+// periodsTotal returns the coins and end time of the periods
+func periodsTotal(startTime int64, periods vestingtypes.Periods) (sdk.Coins, int64, error) {
+	total, end := sdk.NewCoins(), startTime
+	for i, p := range periods {
+		if p.Length < 0 {
+			return nil, 0, sdkerrors.Wrapf(ErrInvalidSchedule, "period %d: negative length", i)
+		}
+		if !p.Amount.IsValid() || p.Amount.Empty() {
+			return nil, 0, sdkerrors.Wrapf(ErrInvalidSchedule, "period %d: invalid amount %s", i, p.Amount)
+		}
+		end += p.Length
+		total = total.Add(p.Amount...)
+	}
+	return total, end, nil
+}
+
+// Unlock is an amount of coins that vests at a time
+type Unlock struct {
+	Time   int64     `json:"time" yaml:"time"`
+	Amount sdk.Coins `json:"amount" yaml:"amount"`
+}
+
+// VestingSchedule is the unlock timeline of a vesting account
+type VestingSchedule struct {
+	Address         sdk.AccAddress `json:"address" yaml:"address"`
+	Type            string         `json:"type" yaml:"type"`
+	StartTime       int64          `json:"start_time" yaml:"start_time"`
+	EndTime         int64          `json:"end_time" yaml:"end_time"`
+	OriginalVesting sdk.Coins      `json:"original_vesting" yaml:"original_vesting"`
+	// Time is the time Vested and Vesting are calculated for
+	Time    int64     `json:"time" yaml:"time"`
+	Vested  sdk.Coins `json:"vested" yaml:"vested"`
+	Vesting sdk.Coins `json:"vesting" yaml:"vesting"`
+	// Funder can claw back the Clawable coins of clawback vesting accounts
+	Funder   sdk.AccAddress `json:"funder,omitempty" yaml:"funder"`
+	Clawable sdk.Coins      `json:"clawable,omitempty" yaml:"clawable"`
+	// Unlocks are the times the coins vest at. The coins of continuous vesting accounts vest linearly from the start
+	// to the end time instead.
+	Unlocks []Unlock `json:"unlocks,omitempty" yaml:"unlocks"`
+}
+
+// NewVestingSchedule returns the schedule of the vesting account with the coins vested at the given time
+func NewVestingSchedule(acc authexported.Account, at time.Time) (VestingSchedule, error) {
+	vacc, ok := acc.(vestexported.VestingAccount)
+	if !ok {
+		return VestingSchedule{}, sdkerrors.Wrapf(ErrInvalidAccount, "%s is not a vesting account", acc.GetAddress())
+	}
+	s := VestingSchedule{
+		Address:         acc.GetAddress(),
+		StartTime:       vacc.GetStartTime(),
+		EndTime:         vacc.GetEndTime(),
+		OriginalVesting: vacc.GetOriginalVesting(),
+		Time:            at.Unix(),
+		Vested:          vacc.GetVestedCoins(at),
+		Vesting:         vacc.GetVestingCoins(at),
+	}
+	switch a := acc.(type) {
+	case *vestingtypes.ContinuousVestingAccount:
+		s.Type = VestingTypeContinuous
+	case *vestingtypes.DelayedVestingAccount:
+		s.Type = VestingTypeDelayed
+		s.Unlocks = []Unlock{{Time: a.EndTime, Amount: a.OriginalVesting}}
+	case *vestingtypes.PeriodicVestingAccount:
+		s.Type = VestingTypePeriodic
+		s.Unlocks = periodUnlocks(a.StartTime, a.VestingPeriods)
+	case *ClawbackVestingAccount:
+		s.Type = VestingTypeClawback
+		s.Unlocks = periodUnlocks(a.StartTime, a.VestingPeriods)
+		s.Funder = a.FunderAddress
+		s.Clawable = a.ClawableCoins(at)
+	default:
+		return VestingSchedule{}, sdkerrors.Wrapf(ErrInvalidAccount, "unsupported vesting account type %T", acc)
+	}
+	return s, nil
+}
+
+func periodUnlocks(startTime int64, periods vestingtypes.Periods) []Unlock {
+	unlocks := make([]Unlock, len(periods))
+	t := startTime
+	for i, p := range periods {
+		t += p.Length
+		unlocks[i] = Unlock{Time: t, Amount: p.Amount}
+	}
+	return unlocks
+}
@@ -0,0 +1,84 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewVestingAccount(t *testing.T) {
+	addr := sdk.AccAddress(make([]byte, sdk.AddrLen))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("afet", 100))
+	periods := vestingtypes.Periods{
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("afet", 40))},
+		{Length: 50, Amount: sdk.NewCoins(sdk.NewInt64Coin("afet", 60))},
+	}
+	specs := map[string]struct {
+		amount  sdk.Coins
+		start   int64
+		end     int64
+		periods vestingtypes.Periods
+		expType string
+		expEnd  int64
+		expErr  bool
+	}{
+		"continuous":               {amount: coins, start: 1000, end: 2000, expType: VestingTypeContinuous, expEnd: 2000},
+		"delayed":                  {amount: coins, end: 2000, expType: VestingTypeDelayed, expEnd: 2000},
+		"periodic":                 {start: 1000, periods: periods, expType: VestingTypePeriodic, expEnd: 1150},
+		"periodic with amount":     {amount: coins, start: 1000, periods: periods, expType: VestingTypePeriodic, expEnd: 1150},
+		"periodic with end":        {start: 1000, end: 1150, periods: periods, expType: VestingTypePeriodic, expEnd: 1150},
+		"periodic other amount":    {amount: sdk.NewCoins(sdk.NewInt64Coin("afet", 99)), start: 1000, periods: periods, expErr: true},
+		"periodic other end":       {start: 1000, end: 2000, periods: periods, expErr: true},
+		"periodic negative length": {start: 1000, periods: vestingtypes.Periods{{Length: -1, Amount: coins}}, expErr: true},
+		"start after end":          {amount: coins, start: 2000, end: 1000, expErr: true},
+		"start only":               {amount: coins, start: 1000, expErr: true},
+		"no amount":                {end: 2000, expErr: true},
+		"amount exceeds the coins": {amount: coins.Add(coins...), end: 2000, expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			base := authtypes.NewBaseAccount(addr, coins, nil, 0, 0)
+			acc, err := NewVestingAccount(base, spec.amount, spec.start, spec.end, spec.periods)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.NoError(t, acc.Validate())
+			s, err := NewVestingSchedule(acc, time.Unix(0, 0))
+			require.NoError(t, err)
+			assert.Equal(t, spec.expType, s.Type)
+			assert.Equal(t, spec.expEnd, s.EndTime)
+			assert.Equal(t, coins, s.OriginalVesting)
+		})
+	}
+}
+
+func TestNewVestingSchedule(t *testing.T) {
+	addr := sdk.AccAddress(make([]byte, sdk.AddrLen))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("afet", 100))
+	base := authtypes.NewBaseAccount(addr, coins, nil, 0, 0)
+	acc, err := NewVestingAccount(base, nil, 1000, 0, vestingtypes.Periods{
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("afet", 40))},
+		{Length: 50, Amount: sdk.NewCoins(sdk.NewInt64Coin("afet", 60))},
+	})
+	require.NoError(t, err)
+
+	s, err := NewVestingSchedule(acc, time.Unix(1120, 0))
+	require.NoError(t, err)
+	assert.Equal(t, []Unlock{
+		{Time: 1100, Amount: sdk.NewCoins(sdk.NewInt64Coin("afet", 40))},
+		{Time: 1150, Amount: sdk.NewCoins(sdk.NewInt64Coin("afet", 60))},
+	}, s.Unlocks)
+	assert.Equal(t, int64(1120), s.Time)
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("afet", 40)), s.Vested)
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("afet", 60)), s.Vesting)
+
+	_, err = NewVestingSchedule(base, time.Unix(1120, 0))
+	assert.True(t, ErrInvalidAccount.Is(err))
+}
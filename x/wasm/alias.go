@@ -23,21 +23,44 @@ const (
 	MaxBuildTagSize                 = types.MaxBuildTagSize
 	CustomEventType                 = types.CustomEventType
 	AttributeKeyContractAddr        = types.AttributeKeyContractAddr
+	AttributeKeyEventType           = types.AttributeKeyEventType
+	MaxCustomEventTypeSize          = types.MaxCustomEventTypeSize
+	DefaultPageLimit                = types.DefaultPageLimit
+	MaxPageLimit                    = types.MaxPageLimit
 	ProposalTypeStoreCode           = types.ProposalTypeStoreCode
 	ProposalTypeInstantiateContract = types.ProposalTypeInstantiateContract
 	ProposalTypeMigrateContract     = types.ProposalTypeMigrateContract
 	ProposalTypeUpdateAdmin         = types.ProposalTypeUpdateAdmin
 	ProposalTypeClearAdmin          = types.ProposalTypeClearAdmin
+	ProposalTypePauseExecution      = types.ProposalTypePauseExecution
+	ProposalTypeResumeExecution     = types.ProposalTypeResumeExecution
+	ProposalTypeSetContractLimits   = types.ProposalTypeSetContractLimits
+	ProposalTypeScheduleExecution   = types.ProposalTypeScheduleExecution
+	ProposalTypeUnscheduleExecution = types.ProposalTypeUnscheduleExecution
+	ProposalTypeSudoContract        = types.ProposalTypeSudoContract
 	GasMultiplier                   = keeper.GasMultiplier
 	MaxGas                          = keeper.MaxGas
+	StargateQueryRoute              = keeper.StargateQueryRoute
 	QueryListContractByCode         = keeper.QueryListContractByCode
+	QueryListContractByCreator      = keeper.QueryListContractByCreator
 	QueryGetContract                = keeper.QueryGetContract
 	QueryGetContractState           = keeper.QueryGetContractState
 	QueryGetCode                    = keeper.QueryGetCode
 	QueryListCode                   = keeper.QueryListCode
+	QueryParams                     = keeper.QueryParams
+	QueryContractExecutionGrants    = keeper.QueryContractExecutionGrants
+	QueryContractMetadata           = keeper.QueryContractMetadata
+	QueryContractRewards            = keeper.QueryContractRewards
+	QueryContractLimits             = keeper.QueryContractLimits
+	QueryContractSchedule           = keeper.QueryContractSchedule
+	QueryIncompatibleCodes          = keeper.QueryIncompatibleCodes
+	QueryTxTrace                    = keeper.QueryTxTrace
+	InterfaceVersion3               = types.InterfaceVersion3
+	DefaultInterfaceVersion         = types.DefaultInterfaceVersion
 	QueryMethodContractStateSmart   = keeper.QueryMethodContractStateSmart
 	QueryMethodContractStateAll     = keeper.QueryMethodContractStateAll
 	QueryMethodContractStateRaw     = keeper.QueryMethodContractStateRaw
+	SummaryChainIDSuffix            = types.SummaryChainIDSuffix
 )
 
 var (
@@ -54,76 +77,136 @@ var (
 	NewEnv                    = types.NewEnv
 	NewWasmCoins              = types.NewWasmCoins
 	ParseEvents               = types.ParseEvents
+	ContractEventType         = types.ContractEventType
+	ParsePageRequest          = types.ParsePageRequest
 	DefaultWasmConfig         = types.DefaultWasmConfig
 	DefaultParams             = types.DefaultParams
 	InitGenesis               = keeper.InitGenesis
 	ExportGenesis             = keeper.ExportGenesis
+	ExportContract            = keeper.ExportContract
+	RegisterInvariants        = keeper.RegisterInvariants
+	AllInvariants             = keeper.AllInvariants
 	NewMessageHandler         = keeper.NewMessageHandler
 	DefaultEncoders           = keeper.DefaultEncoders
 	EncodeBankMsg             = keeper.EncodeBankMsg
 	NoCustomMsg               = keeper.NoCustomMsg
+	NewMessageRegistry        = keeper.NewMessageRegistry
 	EncodeStakingMsg          = keeper.EncodeStakingMsg
 	EncodeWasmMsg             = keeper.EncodeWasmMsg
 	NewKeeper                 = keeper.NewKeeper
+	NewLocalRunner            = keeper.NewLocalRunner
+	PrometheusMetrics         = keeper.PrometheusMetrics
+	NopMetrics                = keeper.NopMetrics
 	NewQuerier                = keeper.NewQuerier
 	DefaultQueryPlugins       = keeper.DefaultQueryPlugins
 	BankQuerier               = keeper.BankQuerier
 	NoCustomQuerier           = keeper.NoCustomQuerier
+	NewQueryRegistry          = keeper.NewQueryRegistry
+	StargateQuerier           = keeper.StargateQuerier
+	DefaultStargateWhitelist  = keeper.DefaultStargateWhitelist
 	StakingQuerier            = keeper.StakingQuerier
 	WasmQuerier               = keeper.WasmQuerier
 	MakeTestCodec             = keeper.MakeTestCodec
 	CreateTestInput           = keeper.CreateTestInput
 	TestHandler               = keeper.TestHandler
 	NewWasmProposalHandler    = keeper.NewWasmProposalHandler
+	SummarySignBytes          = types.SummarySignBytes
+	SummarizeMsg              = types.SummarizeMsg
+	PayloadChecksum           = types.PayloadChecksum
 
 	// variable aliases
-	ModuleCdc            = types.ModuleCdc
-	DefaultCodespace     = types.DefaultCodespace
-	ErrCreateFailed      = types.ErrCreateFailed
-	ErrAccountExists     = types.ErrAccountExists
-	ErrInstantiateFailed = types.ErrInstantiateFailed
-	ErrExecuteFailed     = types.ErrExecuteFailed
-	ErrGasLimit          = types.ErrGasLimit
-	ErrInvalidGenesis    = types.ErrInvalidGenesis
-	ErrNotFound          = types.ErrNotFound
-	ErrQueryFailed       = types.ErrQueryFailed
-	ErrInvalidMsg        = types.ErrInvalidMsg
-	KeyLastCodeID        = types.KeyLastCodeID
-	KeyLastInstanceID    = types.KeyLastInstanceID
-	CodeKeyPrefix        = types.CodeKeyPrefix
-	ContractKeyPrefix    = types.ContractKeyPrefix
-	ContractStorePrefix  = types.ContractStorePrefix
-	EnableAllProposals   = types.EnableAllProposals
-	DisableAllProposals  = types.DisableAllProposals
+	ModuleCdc                      = types.ModuleCdc
+	DefaultCodespace               = types.DefaultCodespace
+	ErrCreateFailed                = types.ErrCreateFailed
+	ErrAccountExists               = types.ErrAccountExists
+	ErrInstantiateFailed           = types.ErrInstantiateFailed
+	ErrExecuteFailed               = types.ErrExecuteFailed
+	ErrGasLimit                    = types.ErrGasLimit
+	ErrInvalidGenesis              = types.ErrInvalidGenesis
+	ErrNotFound                    = types.ErrNotFound
+	ErrQueryFailed                 = types.ErrQueryFailed
+	ErrInvalidMsg                  = types.ErrInvalidMsg
+	ErrPaused                      = types.ErrPaused
+	ErrUnsupportedVersion          = types.ErrUnsupportedVersion
+	KeyLastCodeID                  = types.KeyLastCodeID
+	KeyLastInstanceID              = types.KeyLastInstanceID
+	CodeKeyPrefix                  = types.CodeKeyPrefix
+	ContractKeyPrefix              = types.ContractKeyPrefix
+	ContractStorePrefix            = types.ContractStorePrefix
+	ContractHistoryStorePrefix     = types.ContractHistoryStorePrefix
+	EnableAllProposals             = types.EnableAllProposals
+	DisableAllProposals            = types.DisableAllProposals
+	ParamStoreKeyFeeDiscounts      = types.ParamStoreKeyFeeDiscounts
+	ParamStoreKeyDeveloperFeeShare = types.ParamStoreKeyDeveloperFeeShare
 )
 
 type (
-	ProposalType            = types.ProposalType
-	GenesisState            = types.GenesisState
-	Code                    = types.Code
-	Contract                = types.Contract
-	MsgStoreCode            = types.MsgStoreCode
-	MsgInstantiateContract  = types.MsgInstantiateContract
-	MsgExecuteContract      = types.MsgExecuteContract
-	MsgMigrateContract      = types.MsgMigrateContract
-	MsgUpdateAdmin          = types.MsgUpdateAdmin
-	MsgClearAdmin           = types.MsgClearAdmin
-	Model                   = types.Model
-	CodeInfo                = types.CodeInfo
-	ContractInfo            = types.ContractInfo
-	CreatedAt               = types.AbsoluteTxPosition
-	WasmConfig              = types.WasmConfig
-	MessageHandler          = keeper.MessageHandler
-	BankEncoder             = keeper.BankEncoder
-	CustomEncoder           = keeper.CustomEncoder
-	StakingEncoder          = keeper.StakingEncoder
-	WasmEncoder             = keeper.WasmEncoder
-	MessageEncoders         = keeper.MessageEncoders
-	Keeper                  = keeper.Keeper
-	ContractInfoWithAddress = keeper.ContractInfoWithAddress
-	GetCodeResponse         = keeper.GetCodeResponse
-	ListCodeResponse        = keeper.ListCodeResponse
-	QueryHandler            = keeper.QueryHandler
-	CustomQuerier           = keeper.CustomQuerier
-	QueryPlugins            = keeper.QueryPlugins
+	ProposalType                   = types.ProposalType
+	GenesisState                   = types.GenesisState
+	Code                           = types.Code
+	Contract                       = types.Contract
+	ContractDump                   = types.ContractDump
+	LocalRunner                    = keeper.LocalRunner
+	LocalResult                    = keeper.LocalResult
+	Metrics                        = keeper.Metrics
+	TxTrace                        = types.TxTrace
+	ContractCallTrace              = types.ContractCallTrace
+	HostCall                       = types.HostCall
+	MsgStoreCode                   = types.MsgStoreCode
+	MsgInstantiateContract         = types.MsgInstantiateContract
+	MsgExecuteContract             = types.MsgExecuteContract
+	MsgMigrateContract             = types.MsgMigrateContract
+	MsgUpdateAdmin                 = types.MsgUpdateAdmin
+	MsgClearAdmin                  = types.MsgClearAdmin
+	MsgPauseExecution              = types.MsgPauseExecution
+	MsgResumeExecution             = types.MsgResumeExecution
+	MsgGrantContractExecution      = types.MsgGrantContractExecution
+	MsgRevokeContractExecution     = types.MsgRevokeContractExecution
+	MsgExec                        = types.MsgExec
+	ContractExecutionAuthorization = types.ContractExecutionAuthorization
+	ContractExecutionGrant         = types.ContractExecutionGrant
+	MsgSetContractMetadata         = types.MsgSetContractMetadata
+	ContractMetadata               = types.ContractMetadata
+	MsgSetContractRewardAddress    = types.MsgSetContractRewardAddress
+	MsgWithdrawContractRewards     = types.MsgWithdrawContractRewards
+	ContractRewards                = types.ContractRewards
+	ContractRewardsResponse        = keeper.ContractRewardsResponse
+	MsgSetContractLimits           = types.MsgSetContractLimits
+	ContractLimits                 = types.ContractLimits
+	ContractLimitsInfo             = types.ContractLimitsInfo
+	ContractLimitsResponse         = keeper.ContractLimitsResponse
+	SetContractLimitsProposal      = types.SetContractLimitsProposal
+	ContractSchedule               = types.ContractSchedule
+	WasmerEngine                   = types.WasmerEngine
+	ScheduleExecutionProposal      = types.ScheduleExecutionProposal
+	UnscheduleExecutionProposal    = types.UnscheduleExecutionProposal
+	SudoContractProposal           = types.SudoContractProposal
+	Model                          = types.Model
+	CodeInfo                       = types.CodeInfo
+	ContractInfo                   = types.ContractInfo
+	CreatedAt                      = types.AbsoluteTxPosition
+	ContractProvenance             = types.ContractProvenance
+	WasmConfig                     = types.WasmConfig
+	MessageHandler                 = keeper.MessageHandler
+	BankEncoder                    = keeper.BankEncoder
+	CustomEncoder                  = keeper.CustomEncoder
+	StakingEncoder                 = keeper.StakingEncoder
+	WasmEncoder                    = keeper.WasmEncoder
+	MessageEncoders                = keeper.MessageEncoders
+	MessageRegistry                = keeper.MessageRegistry
+	Keeper                         = keeper.Keeper
+	ContractInfoWithAddress        = keeper.ContractInfoWithAddress
+	GetCodeResponse                = keeper.GetCodeResponse
+	ListCodeResponse               = keeper.ListCodeResponse
+	ListCodePageResponse           = keeper.ListCodePageResponse
+	ListContractsPageResponse      = keeper.ListContractsPageResponse
+	ContractStatePageResponse      = keeper.ContractStatePageResponse
+	PageRequest                    = types.PageRequest
+	PageResponse                   = types.PageResponse
+	FeeDiscount                    = types.FeeDiscount
+	QueryHandler                   = keeper.QueryHandler
+	CustomQuerier                  = keeper.CustomQuerier
+	QueryPlugins                   = keeper.QueryPlugins
+	QueryRegistry                  = keeper.QueryRegistry
+	StargateQuery                  = keeper.StargateQuery
 )
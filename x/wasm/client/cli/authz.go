@@ -0,0 +1,180 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	wasmUtils "github.com/fetchai/fetchd/x/wasm/client/utils"
+	"github.com/fetchai/fetchd/x/wasm/internal/keeper"
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+const (
+	flagGrantMessages = "messages"
+	flagGrantMaxFunds = "max-funds"
+)
+
+// GrantContractExecutionCmd allows a grantee to execute a contract on behalf of the sender
+func GrantContractExecutionCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [grantee_addr_bech32] [contract_addr_bech32]",
+		Short: "Allow the grantee to execute a contract on your behalf",
+		Long: `Allow the grantee to execute a contract on your behalf with tx wasm exec. The grant can be limited to
+execute messages with the given top level keys (--messages) and to a total amount of funds sent to the contract
+(--max-funds). An existing grant to the grantee for the contract is replaced.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "grantee")
+			}
+			contractAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "contract")
+			}
+			maxFunds, err := sdk.ParseCoins(viper.GetString(flagGrantMaxFunds))
+			if err != nil {
+				return sdkerrors.Wrap(err, "max funds")
+			}
+
+			msg := types.MsgGrantContractExecution{
+				Granter: cliCtx.GetFromAddress(),
+				Grantee: grantee,
+				Authorization: types.ContractExecutionAuthorization{
+					Contract: contractAddr,
+					Messages: viper.GetStringSlice(flagGrantMessages),
+					MaxFunds: maxFunds,
+				},
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().StringSlice(flagGrantMessages, nil, "Top level keys of the execute messages the grantee may send, all when empty")
+	cmd.Flags().String(flagGrantMaxFunds, "", "Total amount of coins the grantee may send to the contract, none when empty")
+	return cmd
+}
+
+// RevokeContractExecutionCmd removes a grant made with GrantContractExecutionCmd
+func RevokeContractExecutionCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke [grantee_addr_bech32] [contract_addr_bech32]",
+		Short: "Revoke the grant of a grantee to execute a contract on your behalf",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "grantee")
+			}
+			contractAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "contract")
+			}
+
+			msg := types.MsgRevokeContractExecution{
+				Granter:  cliCtx.GetFromAddress(),
+				Grantee:  grantee,
+				Contract: contractAddr,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// ExecAsGranteeCmd executes a contract on behalf of a granter
+func ExecAsGranteeCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec [granter_addr_bech32] [contract_addr_bech32] [json_encoded_send_args]",
+		Short: "Execute a contract on behalf of a granter",
+		Long: `Execute a contract on behalf of a granter that allowed it with tx wasm grant. The contract is called
+with the granter as sender, funds given with --amount are sent from the granter account.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			granter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "granter")
+			}
+			contractAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "contract")
+			}
+			amount, err := sdk.ParseCoins(viper.GetString(flagAmount))
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgExec{
+				Grantee: cliCtx.GetFromAddress(),
+				Msgs: []types.MsgExecuteContract{{
+					Sender:    granter,
+					Contract:  contractAddr,
+					Msg:       []byte(args[2]),
+					SentFunds: amount,
+				}},
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(flagAmount, "", "Coins to send to the contract from the granter account")
+	return cmd
+}
+
+// GetCmdListContractExecutionGrants lists the contract execution grants of a granter to a grantee
+func GetCmdListContractExecutionGrants(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "grants [granter_addr_bech32] [grantee_addr_bech32]",
+		Short: "List the contracts a grantee may execute on behalf of a granter",
+		Long:  "List the contracts a grantee may execute on behalf of a granter, with the allowed messages and remaining funds",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			granter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "granter")
+			}
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "grantee")
+			}
+
+			route := fmt.Sprintf("custom/%s/%s/%s/%s", types.QuerierRoute, keeper.QueryContractExecutionGrants, granter, grantee)
+			res, _, err := cliCtx.Query(route)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"bufio"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -11,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
 	"github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	wasmUtils "github.com/fetchai/fetchd/x/wasm/client/utils"
 	"github.com/fetchai/fetchd/x/wasm/internal/types"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -60,7 +62,7 @@ func ProposalStoreCodeCmd(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
 
@@ -77,6 +79,7 @@ func ProposalStoreCodeCmd(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(cli.FlagProposal, "", "Proposal file path (if this path is given, other proposal flags are ignored)")
 	// type values must match the "ProposalHandler" "routes" in cli
 	cmd.Flags().String(flagProposalType, "", "Type of proposal, types: store-code/instantiate/migrate/update-admin/clear-admin/text/parameter_change/software_upgrade")
+	wasmUtils.AddBroadcastFlags(cmd)
 	return cmd
 }
 
@@ -124,7 +127,7 @@ func ProposalInstantiateContractCmd(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
 	cmd.Flags().String(flagAmount, "", "Coins to send to the contract during instantiation")
@@ -139,6 +142,7 @@ func ProposalInstantiateContractCmd(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(cli.FlagProposal, "", "Proposal file path (if this path is given, other proposal flags are ignored)")
 	// type values must match the "ProposalHandler" "routes" in cli
 	cmd.Flags().String(flagProposalType, "", "Type of proposal, types: store-code/instantiate/migrate/update-admin/clear-admin/text/parameter_change/software_upgrade")
+	wasmUtils.AddBroadcastFlags(cmd)
 	return cmd
 }
 
@@ -186,7 +190,7 @@ func ProposalMigrateContractCmd(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
 	cmd.Flags().String(flagRunAs, "", "The address that is passed as sender to the contract on proposal execution")
@@ -198,6 +202,7 @@ func ProposalMigrateContractCmd(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(cli.FlagProposal, "", "Proposal file path (if this path is given, other proposal flags are ignored)")
 	// type values must match the "ProposalHandler" "routes" in cli
 	cmd.Flags().String(flagProposalType, "", "Type of proposal, types: store-code/instantiate/migrate/update-admin/clear-admin/text/parameter_change/software_upgrade")
+	wasmUtils.AddBroadcastFlags(cmd)
 	return cmd
 }
 
@@ -235,7 +240,7 @@ func ProposalUpdateContractAdminCmd(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
 	// proposal flags
@@ -245,6 +250,7 @@ func ProposalUpdateContractAdminCmd(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(cli.FlagProposal, "", "Proposal file path (if this path is given, other proposal flags are ignored)")
 	// type values must match the "ProposalHandler" "routes" in cli
 	cmd.Flags().String(flagProposalType, "", "Type of proposal, types: store-code/instantiate/migrate/update-admin/clear-admin/text/parameter_change/software_upgrade")
+	wasmUtils.AddBroadcastFlags(cmd)
 	return cmd
 }
 
@@ -281,7 +287,7 @@ func ProposalClearContractAdminCmd(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
 	// proposal flags
@@ -291,5 +297,304 @@ func ProposalClearContractAdminCmd(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(cli.FlagProposal, "", "Proposal file path (if this path is given, other proposal flags are ignored)")
 	// type values must match the "ProposalHandler" "routes" in cli
 	cmd.Flags().String(flagProposalType, "", "Type of proposal, types: store-code/instantiate/migrate/update-admin/clear-admin/text/parameter_change/software_upgrade")
+	wasmUtils.AddBroadcastFlags(cmd)
+	return cmd
+}
+
+func ProposalPauseExecutionCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause-execution [contract_addr_bech32|code_id]",
+		Short: "Submit a proposal to pause execution of a contract or of all contracts of a code",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			contractAddr, codeID, err := parsePauseTargetArg(args[0])
+			if err != nil {
+				return err
+			}
+
+			content := types.PauseExecutionProposal{
+				WasmProposal: types.WasmProposal{
+					Title:       viper.GetString(cli.FlagTitle),
+					Description: viper.GetString(cli.FlagDescription),
+				},
+				Contract: contractAddr,
+				CodeID:   codeID,
+			}
+
+			deposit, err := sdk.ParseCoins(viper.GetString(cli.FlagDeposit))
+			if err != nil {
+				return err
+			}
+
+			msg := govtypes.NewMsgSubmitProposal(content, deposit, cliCtx.GetFromAddress())
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	// proposal flags
+	cmd.Flags().String(cli.FlagTitle, "", "Title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "Description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "", "Deposit of proposal")
+	cmd.Flags().String(cli.FlagProposal, "", "Proposal file path (if this path is given, other proposal flags are ignored)")
+	// type values must match the "ProposalHandler" "routes" in cli
+	cmd.Flags().String(flagProposalType, "", "Type of proposal, types: store-code/instantiate/migrate/update-admin/clear-admin/text/parameter_change/software_upgrade")
+	wasmUtils.AddBroadcastFlags(cmd)
+	return cmd
+}
+
+func ProposalResumeExecutionCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume-execution [contract_addr_bech32|code_id]",
+		Short: "Submit a proposal to resume execution of a paused contract or code",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			contractAddr, codeID, err := parsePauseTargetArg(args[0])
+			if err != nil {
+				return err
+			}
+
+			content := types.ResumeExecutionProposal{
+				WasmProposal: types.WasmProposal{
+					Title:       viper.GetString(cli.FlagTitle),
+					Description: viper.GetString(cli.FlagDescription),
+				},
+				Contract: contractAddr,
+				CodeID:   codeID,
+			}
+
+			deposit, err := sdk.ParseCoins(viper.GetString(cli.FlagDeposit))
+			if err != nil {
+				return err
+			}
+
+			msg := govtypes.NewMsgSubmitProposal(content, deposit, cliCtx.GetFromAddress())
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	// proposal flags
+	cmd.Flags().String(cli.FlagTitle, "", "Title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "Description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "", "Deposit of proposal")
+	cmd.Flags().String(cli.FlagProposal, "", "Proposal file path (if this path is given, other proposal flags are ignored)")
+	// type values must match the "ProposalHandler" "routes" in cli
+	cmd.Flags().String(flagProposalType, "", "Type of proposal, types: store-code/instantiate/migrate/update-admin/clear-admin/text/parameter_change/software_upgrade")
+	wasmUtils.AddBroadcastFlags(cmd)
+	return cmd
+}
+
+func ProposalSetContractLimitsCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-contract-limits [contract_addr_bech32] --max-gas [wasm gas] --max-executions [count]",
+		Short: "Submit a proposal to set the bounds of the max gas per execution and max executions per block of a contract",
+		Long: `Submit a proposal to set the bounds of the max gas per execution and max executions per block of a contract.
+The contract admin can only set limits within the bounds. Bounds not given are cleared, 0 means no bound.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			contractAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "contract")
+			}
+
+			content := types.SetContractLimitsProposal{
+				WasmProposal: types.WasmProposal{
+					Title:       viper.GetString(cli.FlagTitle),
+					Description: viper.GetString(cli.FlagDescription),
+				},
+				Contract: contractAddr,
+				Bounds:   contractLimitsFromFlags(),
+			}
+
+			deposit, err := sdk.ParseCoins(viper.GetString(cli.FlagDeposit))
+			if err != nil {
+				return err
+			}
+
+			msg := govtypes.NewMsgSubmitProposal(content, deposit, cliCtx.GetFromAddress())
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	addContractLimitsFlags(cmd)
+	// proposal flags
+	cmd.Flags().String(cli.FlagTitle, "", "Title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "Description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "", "Deposit of proposal")
+	cmd.Flags().String(cli.FlagProposal, "", "Proposal file path (if this path is given, other proposal flags are ignored)")
+	// type values must match the "ProposalHandler" "routes" in cli
+	cmd.Flags().String(flagProposalType, "", "Type of proposal, types: store-code/instantiate/migrate/update-admin/clear-admin/text/parameter_change/software_upgrade")
+	wasmUtils.AddBroadcastFlags(cmd)
+	return cmd
+}
+
+func ProposalScheduleExecutionCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule-execution [contract_addr_bech32] [json_encoded_handle_msg] --interval [blocks] --gas-limit [gas]",
+		Short: "Submit a proposal to execute a contract at end block every interval blocks",
+		Long: `Submit a proposal to execute a contract with the msg at end block every interval blocks, e.g. with {"cron":{}}.
+The sender of the executions is the wasm scheduler address, every execution may use at most the gas limit.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			contractAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "contract")
+			}
+
+			content := types.ScheduleExecutionProposal{
+				WasmProposal: types.WasmProposal{
+					Title:       viper.GetString(cli.FlagTitle),
+					Description: viper.GetString(cli.FlagDescription),
+				},
+				Contract: contractAddr,
+				Msg:      []byte(args[1]),
+				Interval: viper.GetUint64(flagScheduleInterval),
+				GasLimit: viper.GetUint64(flagScheduleGasLimit),
+			}
+
+			deposit, err := sdk.ParseCoins(viper.GetString(cli.FlagDeposit))
+			if err != nil {
+				return err
+			}
+
+			msg := govtypes.NewMsgSubmitProposal(content, deposit, cliCtx.GetFromAddress())
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().Uint64(flagScheduleInterval, 0, "Number of blocks between two executions")
+	cmd.Flags().Uint64(flagScheduleGasLimit, 0, fmt.Sprintf("Max sdk gas of a single execution, at most %d", types.MaxScheduleGasLimit))
+	// proposal flags
+	cmd.Flags().String(cli.FlagTitle, "", "Title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "Description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "", "Deposit of proposal")
+	cmd.Flags().String(cli.FlagProposal, "", "Proposal file path (if this path is given, other proposal flags are ignored)")
+	// type values must match the "ProposalHandler" "routes" in cli
+	cmd.Flags().String(flagProposalType, "", "Type of proposal, types: store-code/instantiate/migrate/update-admin/clear-admin/text/parameter_change/software_upgrade")
+	wasmUtils.AddBroadcastFlags(cmd)
+	return cmd
+}
+
+func ProposalUnscheduleExecutionCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unschedule-execution [contract_addr_bech32]",
+		Short: "Submit a proposal to stop the end block executions of a contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			contractAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "contract")
+			}
+
+			content := types.UnscheduleExecutionProposal{
+				WasmProposal: types.WasmProposal{
+					Title:       viper.GetString(cli.FlagTitle),
+					Description: viper.GetString(cli.FlagDescription),
+				},
+				Contract: contractAddr,
+			}
+
+			deposit, err := sdk.ParseCoins(viper.GetString(cli.FlagDeposit))
+			if err != nil {
+				return err
+			}
+
+			msg := govtypes.NewMsgSubmitProposal(content, deposit, cliCtx.GetFromAddress())
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	// proposal flags
+	cmd.Flags().String(cli.FlagTitle, "", "Title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "Description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "", "Deposit of proposal")
+	cmd.Flags().String(cli.FlagProposal, "", "Proposal file path (if this path is given, other proposal flags are ignored)")
+	// type values must match the "ProposalHandler" "routes" in cli
+	cmd.Flags().String(flagProposalType, "", "Type of proposal, types: store-code/instantiate/migrate/update-admin/clear-admin/text/parameter_change/software_upgrade")
+	wasmUtils.AddBroadcastFlags(cmd)
+	return cmd
+}
+
+func ProposalSudoContractCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sudo-contract [contract_addr_bech32] [json_encoded_sudo_msg]",
+		Short: "Submit a proposal to call the privileged entry point of a contract",
+		Long: `Submit a proposal to call the privileged entry point of a contract, e.g. to adjust protocol parameters
+held by a system contract. The msg is executed with the wasm sudo address as sender.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			contractAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "contract")
+			}
+
+			content := types.SudoContractProposal{
+				WasmProposal: types.WasmProposal{
+					Title:       viper.GetString(cli.FlagTitle),
+					Description: viper.GetString(cli.FlagDescription),
+				},
+				Contract: contractAddr,
+				Msg:      []byte(args[1]),
+			}
+
+			deposit, err := sdk.ParseCoins(viper.GetString(cli.FlagDeposit))
+			if err != nil {
+				return err
+			}
+
+			msg := govtypes.NewMsgSubmitProposal(content, deposit, cliCtx.GetFromAddress())
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	// proposal flags
+	cmd.Flags().String(cli.FlagTitle, "", "Title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "Description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "", "Deposit of proposal")
+	cmd.Flags().String(cli.FlagProposal, "", "Proposal file path (if this path is given, other proposal flags are ignored)")
+	// type values must match the "ProposalHandler" "routes" in cli
+	cmd.Flags().String(flagProposalType, "", "Type of proposal, types: store-code/instantiate/migrate/update-admin/clear-admin/text/parameter_change/software_upgrade")
+	wasmUtils.AddBroadcastFlags(cmd)
 	return cmd
 }
@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	wasmUtils "github.com/fetchai/fetchd/x/wasm/client/utils"
+	"github.com/fetchai/fetchd/x/wasm/internal/keeper"
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+const (
+	flagMaxGasPerExecution    = "max-gas"
+	flagMaxExecutionsPerBlock = "max-executions"
+)
+
+// SetContractLimitsCmd sets the limits of a contract within the bounds set by governance
+func SetContractLimitsCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-limits [contract_addr_bech32]",
+		Short: "Set the max gas per execution and max executions per block of a contract, sent by the contract admin",
+		Long: `Set the max gas per execution and max executions per block of a contract, sent by the contract admin.
+The limits must be within the bounds set by governance. Limits not given are cleared, 0 means no limit of the admin.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			contractAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "contract")
+			}
+
+			msg := types.MsgSetContractLimits{
+				Sender:   cliCtx.GetFromAddress(),
+				Contract: contractAddr,
+				Limits:   contractLimitsFromFlags(),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	addContractLimitsFlags(cmd)
+	return cmd
+}
+
+// GetCmdGetContractLimits prints the limits of a contract
+func GetCmdGetContractLimits(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "limits [bech32_address]",
+		Short: "Prints out the governance bounds, the admin limits and the enforced limits of a contract",
+		Long:  "Prints out the governance bounds, the admin limits and the enforced limits of a contract, null when none are set",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, keeper.QueryContractLimits, addr.String())
+			res, _, err := cliCtx.Query(route)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
+
+func addContractLimitsFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint64(flagMaxGasPerExecution, 0, "Max wasm gas of a single execution, in the unit of the max_contract_gas param")
+	cmd.Flags().Uint64(flagMaxExecutionsPerBlock, 0, "Max number of executions in a block")
+}
+
+func contractLimitsFromFlags() types.ContractLimits {
+	return types.ContractLimits{
+		MaxGasPerExecution:    viper.GetUint64(flagMaxGasPerExecution),
+		MaxExecutionsPerBlock: viper.GetUint64(flagMaxExecutionsPerBlock),
+	}
+}
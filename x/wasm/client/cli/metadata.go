@@ -0,0 +1,94 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	wasmUtils "github.com/fetchai/fetchd/x/wasm/client/utils"
+	"github.com/fetchai/fetchd/x/wasm/internal/keeper"
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+const (
+	flagMetadataName        = "name"
+	flagMetadataDescription = "description"
+	flagMetadataSchema      = "schema"
+	flagMetadataRepository  = "repository"
+)
+
+// SetContractMetadataCmd sets the metadata of a contract
+func SetContractMetadataCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-metadata [contract_addr_bech32]",
+		Short: "Set the name, description, schema and repository of a contract, sent by the contract admin",
+		Long: `Set the name, description, schema and repository of a contract, sent by the contract admin.
+The metadata is replaced as a whole, fields not given are cleared. Without any field the metadata is removed.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			contractAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "contract")
+			}
+
+			msg := types.MsgSetContractMetadata{
+				Sender:   cliCtx.GetFromAddress(),
+				Contract: contractAddr,
+				Metadata: types.ContractMetadata{
+					Name:           viper.GetString(flagMetadataName),
+					Description:    viper.GetString(flagMetadataDescription),
+					SchemaIPFSHash: viper.GetString(flagMetadataSchema),
+					Repository:     viper.GetString(flagMetadataRepository),
+				},
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(flagMetadataName, "", "Display name of the contract")
+	cmd.Flags().String(flagMetadataDescription, "", "Description of the contract")
+	cmd.Flags().String(flagMetadataSchema, "", "IPFS hash of the json schema of the contract messages")
+	cmd.Flags().String(flagMetadataRepository, "", "Https url of the source code repository")
+	return cmd
+}
+
+// GetCmdGetContractMetadata prints the metadata set by the admin of a contract
+func GetCmdGetContractMetadata(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "metadata [bech32_address]",
+		Short: "Prints out the name, description, schema and repository of a contract",
+		Long:  "Prints out the name, description, schema and repository set by the admin of a contract, null when none is set",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, keeper.QueryContractMetadata, addr.String())
+			res, _, err := cliCtx.Query(route)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
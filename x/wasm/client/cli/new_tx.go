@@ -10,6 +10,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	wasmUtils "github.com/fetchai/fetchd/x/wasm/client/utils"
 	"github.com/fetchai/fetchd/x/wasm/internal/types"
 	"github.com/spf13/cobra"
 )
@@ -32,7 +33,7 @@ func MigrateContractCmd(cdc *codec.Codec) *cobra.Command {
 			if err := msg.ValidateBasic(); err != nil {
 				return nil
 			}
-			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
 	return cmd
@@ -79,7 +80,7 @@ func UpdateContractAdminCmd(cdc *codec.Codec) *cobra.Command {
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
-			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
 	return cmd
@@ -126,8 +127,80 @@ func ClearContractAdminCmd(cdc *codec.Codec) *cobra.Command {
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
-			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
 	return cmd
 }
+
+// PauseExecutionCmd pauses execution of a contract or of all contracts of a code
+func PauseExecutionCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause-execution [contract_addr_bech32|code_id]",
+		Short: "Pause execution of a contract or of all contracts of a code, requires the security address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			contractAddr, codeID, err := parsePauseTargetArg(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgPauseExecution{
+				Sender:   cliCtx.GetFromAddress(),
+				Contract: contractAddr,
+				CodeID:   codeID,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+// ResumeExecutionCmd resumes execution of a paused contract or code
+func ResumeExecutionCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume-execution [contract_addr_bech32|code_id]",
+		Short: "Resume execution of a paused contract or code, requires the security address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			contractAddr, codeID, err := parsePauseTargetArg(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgResumeExecution{
+				Sender:   cliCtx.GetFromAddress(),
+				Contract: contractAddr,
+				CodeID:   codeID,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+// parsePauseTargetArg accepts either a numeric code id or a bech32 contract address
+func parsePauseTargetArg(arg string) (sdk.AccAddress, uint64, error) {
+	if codeID, err := strconv.ParseUint(arg, 10, 64); err == nil {
+		return nil, codeID, nil
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(arg)
+	if err != nil {
+		return nil, 0, sdkerrors.Wrap(err, "contract")
+	}
+	return contractAddr, 0, nil
+}
@@ -23,6 +23,8 @@ import (
 	"github.com/fetchai/fetchd/x/wasm/internal/types"
 )
 
+const flagQueryGasLimit = "gas-limit"
+
 func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 	queryCmd := &cobra.Command{
 		Use:                        types.ModuleName,
@@ -33,18 +35,30 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 	}
 	queryCmd.AddCommand(flags.GetCommands(
 		GetCmdListCode(cdc),
+		GetCmdListIncompatibleCodes(cdc),
 		GetCmdListContractByCode(cdc),
+		GetCmdListContractByCreator(cdc),
 		GetCmdQueryCode(cdc),
 		GetCmdGetContractInfo(cdc),
+		GetCmdGetContractMetadata(cdc),
+		GetCmdGetContractRewards(cdc),
+		GetCmdGetContractLimits(cdc),
+		GetCmdGetContractSchedule(cdc),
 		GetCmdGetContractHistory(cdc),
 		GetCmdGetContractState(cdc),
+		GetCmdCW20(cdc),
+		GetCmdCW721(cdc),
+		GetCmdQueryParams(cdc),
+		GetCmdListContractExecutionGrants(cdc),
+		GetCmdGetTxTrace(cdc),
 	)...)
 	return queryCmd
 }
 
 // GetCmdListCode lists all wasm code uploaded
 func GetCmdListCode(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	pager := &pageFlags{}
+	cmd := &cobra.Command{
 		Use:   "list-code",
 		Short: "List all wasm bytecode on the chain",
 		Long:  "List all wasm bytecode on the chain",
@@ -52,7 +66,34 @@ func GetCmdListCode(cdc *codec.Codec) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
+			pageData, err := pager.QueryData()
+			if err != nil {
+				return err
+			}
 			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, keeper.QueryListCode)
+			res, _, err := cliCtx.QueryWithData(route, pageData)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+	pager.RegisterFlags(cmd.Flags(), "codes")
+	return cmd
+}
+
+// GetCmdListIncompatibleCodes lists the wasm code that the VMs of the node do not support
+func GetCmdListIncompatibleCodes(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "incompatible-codes",
+		Short: "List the wasm bytecode with an interface version the node does not support",
+		Long:  "List the wasm bytecode with a CosmWasm interface version that no VM of the node supports, so that its contracts can not be executed",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, keeper.QueryIncompatibleCodes)
 			res, _, err := cliCtx.Query(route)
 			if err != nil {
 				return err
@@ -65,7 +106,8 @@ func GetCmdListCode(cdc *codec.Codec) *cobra.Command {
 
 // GetCmdListContractByCode lists all wasm code uploaded for given code id
 func GetCmdListContractByCode(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	pager := &pageFlags{}
+	cmd := &cobra.Command{
 		Use:   "list-contract-by-code [code_id]",
 		Short: "List wasm all bytecode on the chain for given code id",
 		Long:  "List wasm all bytecode on the chain for given code id",
@@ -78,8 +120,12 @@ func GetCmdListContractByCode(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
+			pageData, err := pager.QueryData()
+			if err != nil {
+				return err
+			}
 			route := fmt.Sprintf("custom/%s/%s/%d", types.QuerierRoute, keeper.QueryListContractByCode, codeID)
-			res, _, err := cliCtx.Query(route)
+			res, _, err := cliCtx.QueryWithData(route, pageData)
 			if err != nil {
 				return err
 			}
@@ -87,6 +133,41 @@ func GetCmdListContractByCode(cdc *codec.Codec) *cobra.Command {
 			return nil
 		},
 	}
+	pager.RegisterFlags(cmd.Flags(), "contracts")
+	return cmd
+}
+
+// GetCmdListContractByCreator lists the contracts instantiated by the given address
+func GetCmdListContractByCreator(cdc *codec.Codec) *cobra.Command {
+	pager := &pageFlags{}
+	cmd := &cobra.Command{
+		Use:   "list-contracts-by-creator [creator]",
+		Short: "List the contracts instantiated by the given address",
+		Long:  "List the contracts instantiated by the given address, in the order of instantiation or paginated in address order",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			creator, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageData, err := pager.QueryData()
+			if err != nil {
+				return err
+			}
+			route := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, keeper.QueryListContractByCreator, creator)
+			res, _, err := cliCtx.QueryWithData(route, pageData)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+	pager.RegisterFlags(cmd.Flags(), "contracts")
+	return cmd
 }
 
 // GetCmdQueryCode returns the bytecode for a given contract
@@ -122,7 +203,19 @@ func GetCmdQueryCode(cdc *codec.Codec) *cobra.Command {
 				return fmt.Errorf("contract not found")
 			}
 
-			fmt.Printf("Downloading wasm code to %s\n", args[1])
+			if cliCtx.OutputFormat == "json" {
+				out, err := json.Marshal(struct {
+					CodeID uint64 `json:"code_id"`
+					File   string `json:"file"`
+					Size   int    `json:"size"`
+				}{codeID, args[1], len(code.Data)})
+				if err != nil {
+					return err
+				}
+				fmt.Println(string(out))
+			} else {
+				fmt.Printf("Downloading wasm code to %s\n", args[1])
+			}
 			return ioutil.WriteFile(args[1], code.Data, 0644)
 		},
 	}
@@ -173,7 +266,8 @@ func GetCmdGetContractState(cdc *codec.Codec) *cobra.Command {
 }
 
 func GetCmdGetContractStateAll(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	pager := &pageFlags{}
+	cmd := &cobra.Command{
 		Use:   "all [bech32_address]",
 		Short: "Prints out all internal state of a contract given its address",
 		Long:  "Prints out all internal state of a contract given its address",
@@ -186,8 +280,12 @@ func GetCmdGetContractStateAll(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
+			pageData, err := pager.QueryData()
+			if err != nil {
+				return err
+			}
 			route := fmt.Sprintf("custom/%s/%s/%s/%s", types.QuerierRoute, keeper.QueryGetContractState, addr.String(), keeper.QueryMethodContractStateAll)
-			res, _, err := cliCtx.Query(route)
+			res, _, err := cliCtx.QueryWithData(route, pageData)
 			if err != nil {
 				return err
 			}
@@ -195,6 +293,8 @@ func GetCmdGetContractStateAll(cdc *codec.Codec) *cobra.Command {
 			return nil
 		},
 	}
+	pager.RegisterFlags(cmd.Flags(), "state entries")
+	return cmd
 }
 
 func GetCmdGetContractStateRaw(cdc *codec.Codec) *cobra.Command {
@@ -234,9 +334,10 @@ func GetCmdGetContractStateSmart(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "smart [bech32_address] [query]",
 		Short: "Calls contract with given address with query data and prints the returned result",
-		Long:  "Calls contract with given address with query data and prints the returned result",
-		Args:  cobra.ExactArgs(2),
-		RunE: func(_ *cobra.Command, args []string) error {
+		Long: "Calls contract with given address with query data and prints the returned result. The query runs with the " +
+			"query gas limit of the node, --gas-limit sets a lower one",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
 			addr, err := sdk.AccAddressFromBech32(args[0])
@@ -248,6 +349,13 @@ func GetCmdGetContractStateSmart(cdc *codec.Codec) *cobra.Command {
 				return errors.New("key must not be empty")
 			}
 			route := fmt.Sprintf("custom/%s/%s/%s/%s", types.QuerierRoute, keeper.QueryGetContractState, addr.String(), keeper.QueryMethodContractStateSmart)
+			gasLimit, err := cmd.Flags().GetUint64(flagQueryGasLimit)
+			if err != nil {
+				return err
+			}
+			if gasLimit != 0 {
+				route = fmt.Sprintf("%s/%d", route, gasLimit)
+			}
 
 			queryData, err := decoder.DecodeString(args[1])
 			if err != nil {
@@ -265,6 +373,7 @@ func GetCmdGetContractStateSmart(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 	decoder.RegisterFlags(cmd.PersistentFlags(), "query argument")
+	cmd.Flags().Uint64(flagQueryGasLimit, 0, "Gas limit of the query, below the query gas limit of the node, 0 for the node limit")
 	return cmd
 }
 
@@ -294,6 +403,62 @@ func GetCmdGetContractHistory(cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+// GetCmdQueryParams prints the wasm module params
+func GetCmdQueryParams(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "Prints out the current wasm module params",
+		Long:  "Prints out the current wasm module params. Limits that are not set are shown with their defaults.",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, keeper.QueryParams)
+			res, _, err := cliCtx.Query(route)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
+
+// pageFlags are the pagination flags of the list queries
+type pageFlags struct {
+	limit, page uint64
+	key         string
+	reverse     bool
+}
+
+func (p *pageFlags) RegisterFlags(f *flag.FlagSet, itemName string) {
+	f.Uint64Var(&p.limit, "limit", types.DefaultPageLimit, "maximum number of "+itemName+" to return")
+	f.Uint64Var(&p.page, "page", 1, "page number of "+itemName+" to return, ignored when --page-key is set")
+	f.StringVar(&p.key, "page-key", "", "base64 encoded next_key of the previous page to continue from")
+	f.BoolVar(&p.reverse, "reverse", false, "return the "+itemName+" in descending key order")
+}
+
+// QueryData returns the json encoded PageRequest for the flags
+func (p *pageFlags) QueryData() ([]byte, error) {
+	if p.page == 0 {
+		return nil, errors.New("page must be greater than 0")
+	}
+	req := types.PageRequest{Limit: p.limit, Reverse: p.reverse}
+	if p.key != "" {
+		key, err := base64.StdEncoding.DecodeString(p.key)
+		if err != nil {
+			return nil, fmt.Errorf("decode page key: %s", err)
+		}
+		req.Key = key
+	} else {
+		req.Offset = (p.page - 1) * p.limit
+	}
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return json.Marshal(req)
+}
+
 type argumentDecoder struct {
 	// dec is the default decoder
 	dec                func(string) ([]byte, error)
@@ -336,3 +501,29 @@ func (a *argumentDecoder) DecodeString(s string) ([]byte, error) {
 func asciiDecodeString(s string) ([]byte, error) {
 	return []byte(s), nil
 }
+
+// GetCmdGetTxTrace prints the host function calls of the contracts called by a tx
+func GetCmdGetTxTrace(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "tx-trace [tx_hash]",
+		Short: "Prints out the host function calls of the contracts called by a tx",
+		Long: "Prints out the store reads, writes, scans and chain queries of every contract call of a tx with their keys and gas. " +
+			"The node must run with contract_trace_mode on and keeps the latest txs only, null when the tx was not traced",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			if _, err := hex.DecodeString(args[0]); err != nil {
+				return fmt.Errorf("tx hash must be hex: %s", err)
+			}
+
+			route := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, keeper.QueryTxTrace, args[0])
+			res, _, err := cliCtx.Query(route)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
@@ -0,0 +1,104 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	wasmUtils "github.com/fetchai/fetchd/x/wasm/client/utils"
+	"github.com/fetchai/fetchd/x/wasm/internal/keeper"
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+// SetContractRewardAddressCmd sets the address the developer rewards of a contract are accrued for
+func SetContractRewardAddressCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-reward-address [contract_addr_bech32] [reward_addr_bech32]",
+		Short: "Set the address the developer share of the execution fees of a contract is accrued for, sent by the contract admin",
+		Long: `Set the address the developer share of the execution fees of a contract is accrued for, sent by the contract admin.
+Without a reward address the accrual stops, rewards accrued so far can still be withdrawn.`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			contractAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "contract")
+			}
+			var rewardAddr sdk.AccAddress
+			if len(args) == 2 {
+				if rewardAddr, err = sdk.AccAddressFromBech32(args[1]); err != nil {
+					return sdkerrors.Wrap(err, "reward address")
+				}
+			}
+
+			msg := types.MsgSetContractRewardAddress{
+				Sender:        cliCtx.GetFromAddress(),
+				Contract:      contractAddr,
+				RewardAddress: rewardAddr,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// WithdrawContractRewardsCmd pays out the rewards accrued for the sender
+func WithdrawContractRewardsCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "withdraw-rewards",
+		Short: "Withdraw all developer rewards accrued for the --from address",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			msg := types.MsgWithdrawContractRewards{
+				RewardAddress: cliCtx.GetFromAddress(),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdGetContractRewards prints the reward address of a contract and the rewards accrued for it
+func GetCmdGetContractRewards(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "rewards [bech32_address]",
+		Short: "Prints out the reward address of a contract and the rewards not yet withdrawn",
+		Long:  "Prints out the reward address of a contract and the rewards not yet withdrawn, null when no reward address is set",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, keeper.QueryContractRewards, addr.String())
+			res, _, err := cliCtx.Query(route)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
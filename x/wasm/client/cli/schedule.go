@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fetchai/fetchd/x/wasm/internal/keeper"
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+const (
+	flagScheduleInterval = "interval"
+	flagScheduleGasLimit = "gas-limit"
+)
+
+// GetCmdGetContractSchedule prints the end block execution schedule of a contract
+func GetCmdGetContractSchedule(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "schedule [bech32_address]",
+		Short: "Prints out the end block execution schedule of a contract",
+		Long:  "Prints out the msg, interval, gas limit and next height of the end block executions of a contract, null when it is not scheduled",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, keeper.QueryContractSchedule, addr.String())
+			res, _, err := cliCtx.Query(route)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
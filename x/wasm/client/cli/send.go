@@ -0,0 +1,133 @@
+package cli
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+
+	wasmUtils "github.com/fetchai/fetchd/x/wasm/client/utils"
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+const (
+	flagSendTo  = "to"
+	flagSendMsg = "msg"
+)
+
+// cw20Transfer is the cw20 execute msg to move tokens to an account
+type cw20Transfer struct {
+	Recipient string `json:"recipient"`
+	Amount    string `json:"amount"`
+}
+
+// cw20Send is the cw20 execute msg to move tokens to a contract and call it with msg
+type cw20Send struct {
+	Contract string `json:"contract"`
+	Amount   string `json:"amount"`
+	// Msg is encoded as base64, like the cw20 Binary type
+	Msg []byte `json:"msg,omitempty"`
+}
+
+// SendCmd transfers cw20 tokens or native coins without hand written contract msgs
+func SendCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send [cw20_contract_addr_bech32] --to [address] --amount [amount] --msg [json_encoded_msg,optional]",
+		Short: "Transfer cw20 tokens or native coins",
+		Long: `Transfer cw20 tokens when the token contract is given, native coins otherwise.
+
+With a token contract, --amount is the number of tokens and a cw20 {"transfer":{...}} msg is executed. With --msg,
+a {"send":{...}} msg is executed instead, that moves the tokens to the --to contract and calls it with the msg.
+Without a token contract, --amount are coins, e.g. 100afet, that are sent to --to. With --msg, the --to contract
+is executed with the msg and the coins.`,
+		Args: cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx, err := wasmUtils.WithMultisig(context.NewCLIContextWithInput(inBuf).WithCodec(cdc))
+			if err != nil {
+				return err
+			}
+
+			msg, err := parseSendArgs(cliCtx.GetFromAddress(), args)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagSendTo, "", "Address of the recipient")
+	cmd.Flags().String(flagAmount, "", "Number of cw20 tokens, or coins without token contract")
+	cmd.Flags().String(flagSendMsg, "", "Msg to call the recipient contract with, optional")
+	cmd.Flags().String(wasmUtils.FlagMultisig, "", "Generate the unsigned tx for this multisig key name or address, to be signed with `tx sign --multisig`")
+	return cmd
+}
+
+func parseSendArgs(sender sdk.AccAddress, args []string) (sdk.Msg, error) {
+	to, err := sdk.AccAddressFromBech32(viper.GetString(flagSendTo))
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, flagSendTo)
+	}
+	amountStr := viper.GetString(flagAmount)
+	var hookMsg []byte
+	if s := viper.GetString(flagSendMsg); s != "" {
+		if !json.Valid([]byte(s)) {
+			return nil, fmt.Errorf("%s must be json", flagSendMsg)
+		}
+		hookMsg = []byte(s)
+	}
+
+	if len(args) == 0 {
+		coins, err := sdk.ParseCoins(amountStr)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, flagAmount)
+		}
+		if hookMsg == nil {
+			return bank.NewMsgSend(sender, to, coins), nil
+		}
+		return types.MsgExecuteContract{
+			Sender:    sender,
+			Contract:  to,
+			Msg:       hookMsg,
+			SentFunds: coins,
+		}, nil
+	}
+
+	tokenAddr, err := sdk.AccAddressFromBech32(args[0])
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "cw20 contract")
+	}
+	amount, ok := sdk.NewIntFromString(amountStr)
+	if !ok || !amount.IsPositive() {
+		return nil, fmt.Errorf("%s must be a positive number of tokens", flagAmount)
+	}
+	var execMsg interface{}
+	if hookMsg == nil {
+		execMsg = map[string]cw20Transfer{"transfer": {Recipient: to.String(), Amount: amount.String()}}
+	} else {
+		execMsg = map[string]cw20Send{"send": {Contract: to.String(), Amount: amount.String(), Msg: hookMsg}}
+	}
+	bz, err := json.Marshal(execMsg)
+	if err != nil {
+		return nil, err
+	}
+	return types.MsgExecuteContract{
+		Sender:   sender,
+		Contract: tokenAddr,
+		Msg:      bz,
+	}, nil
+}
@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fetchai/fetchd/x/wasm/client/subscribe"
+)
+
+const (
+	flagMinBackoff = "min-backoff"
+	flagMaxBackoff = "max-backoff"
+)
+
+// GetCmdSubscribe streams the events of a contract as json lines to stdout
+func GetCmdSubscribe(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "subscribe [contract_addr]",
+		Short: "Stream the events emitted for a contract",
+		Long: `Subscribe to the tendermint websocket of the node and print every event emitted for the contract
+as a json line. A lost connection is reconnected with an exponential backoff, txs committed in between are not replayed.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			contract, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			sub := subscribe.NewSubscription(subscribe.Config{
+				NodeURI:    viper.GetString(flags.FlagNode),
+				MinBackoff: viper.GetDuration(flagMinBackoff),
+				MaxBackoff: viper.GetDuration(flagMaxBackoff),
+				OnError: func(err error) {
+					_, _ = fmt.Fprintf(os.Stderr, "%s, reconnecting\n", err)
+				},
+			}, contract)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			sigs := make(chan os.Signal, 1)
+			signal.Notify(sigs, os.Interrupt, syscall.SIGTERM)
+			go func() {
+				<-sigs
+				cancel()
+			}()
+
+			events := make(chan subscribe.ContractEvent)
+			done := make(chan error, 1)
+			go func() {
+				done <- sub.Run(ctx, events)
+			}()
+
+			enc := json.NewEncoder(cmd.OutOrStdout())
+			for {
+				select {
+				case e := <-events:
+					if err := enc.Encode(e); err != nil {
+						return err
+					}
+				case err := <-done:
+					if err == context.Canceled {
+						return nil
+					}
+					return err
+				}
+			}
+		},
+	}
+	cmd.Flags().String(flags.FlagNode, "tcp://localhost:26657", "<host>:<port> to tendermint rpc interface for this chain")
+	cmd.Flags().Duration(flagMinBackoff, subscribe.DefaultMinBackoff, "Wait before the first reconnect attempt")
+	cmd.Flags().Duration(flagMaxBackoff, subscribe.DefaultMaxBackoff, "Longest wait between reconnect attempts")
+	return cmd
+}
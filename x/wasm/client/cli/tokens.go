@@ -0,0 +1,282 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fetchai/fetchd/x/wasm/internal/keeper"
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+const (
+	flagStartAfter = "start-after"
+	flagLimit      = "limit"
+)
+
+// cw20TokenInfo is the cw20 token_info query response
+type cw20TokenInfo struct {
+	Name        string `json:"name"`
+	Symbol      string `json:"symbol"`
+	Decimals    uint8  `json:"decimals"`
+	TotalSupply string `json:"total_supply"`
+}
+
+// cw20Balance is the cw20 balance query response, with the balance formatted by the token info
+type cw20Balance struct {
+	Address   string `json:"address"`
+	Balance   string `json:"balance"`
+	Formatted string `json:"formatted,omitempty"`
+}
+
+// cwExpiration is the cw0 Expiration of allowances and approvals. Only one of the fields is set.
+type cwExpiration struct {
+	AtHeight *uint64   `json:"at_height,omitempty"`
+	AtTime   *uint64   `json:"at_time,omitempty"`
+	Never    *struct{} `json:"never,omitempty"`
+}
+
+// cw20Allowance is the cw20 allowance query response
+type cw20Allowance struct {
+	Allowance string       `json:"allowance"`
+	Expires   cwExpiration `json:"expires"`
+}
+
+// cw721Approval is a spender approved for a cw721 token
+type cw721Approval struct {
+	Spender string       `json:"spender"`
+	Expires cwExpiration `json:"expires"`
+}
+
+// cw721Owner is the cw721 owner_of query response
+type cw721Owner struct {
+	Owner     string          `json:"owner"`
+	Approvals []cw721Approval `json:"approvals"`
+}
+
+// cw721Tokens is the cw721 tokens query response
+type cw721Tokens struct {
+	Tokens []string `json:"tokens"`
+}
+
+// GetCmdCW20 groups the queries of the cw20 token standard
+func GetCmdCW20(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "cw20",
+		Short:                      "Querying commands for cw20 token contracts",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(flags.GetCommands(
+		GetCmdCW20Balance(cdc),
+		GetCmdCW20TokenInfo(cdc),
+		GetCmdCW20Allowance(cdc),
+	)...)
+	return cmd
+}
+
+// GetCmdCW20Balance prints the token balance of an address
+func GetCmdCW20Balance(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "balance [cw20_contract_addr_bech32] [address]",
+		Short: "Prints the cw20 token balance of an address",
+		Long:  "Prints the cw20 token balance of an address, also formatted with the decimals and symbol of the token",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+			query := map[string]interface{}{"balance": map[string]string{"address": addr.String()}}
+			var balance cw20Balance
+			if err := querySmartTyped(cliCtx, args[0], query, &balance); err != nil {
+				return err
+			}
+			balance.Address = addr.String()
+
+			var info cw20TokenInfo
+			if err := querySmartTyped(cliCtx, args[0], map[string]struct{}{"token_info": {}}, &info); err == nil {
+				balance.Formatted = formatTokenAmount(balance.Balance, info.Decimals) + " " + info.Symbol
+			}
+			return printJSON(balance)
+		},
+	}
+}
+
+// GetCmdCW20TokenInfo prints name, symbol, decimals and total supply of a token
+func GetCmdCW20TokenInfo(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "token-info [cw20_contract_addr_bech32]",
+		Short: "Prints the cw20 token info",
+		Long:  "Prints name, symbol, decimals and total supply of the cw20 token",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var info cw20TokenInfo
+			if err := querySmartTyped(cliCtx, args[0], map[string]struct{}{"token_info": {}}, &info); err != nil {
+				return err
+			}
+			return printJSON(info)
+		},
+	}
+}
+
+// GetCmdCW20Allowance prints the tokens a spender can transfer on behalf of the owner
+func GetCmdCW20Allowance(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "allowance [cw20_contract_addr_bech32] [owner] [spender]",
+		Short: "Prints the cw20 allowance of a spender",
+		Long:  "Prints the cw20 tokens a spender can transfer on behalf of the owner, and when the allowance expires",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			owner, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+			spender, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+			query := map[string]interface{}{"allowance": map[string]string{
+				"owner":   owner.String(),
+				"spender": spender.String(),
+			}}
+			var allowance cw20Allowance
+			if err := querySmartTyped(cliCtx, args[0], query, &allowance); err != nil {
+				return err
+			}
+			return printJSON(allowance)
+		},
+	}
+}
+
+// GetCmdCW721 groups the queries of the cw721 non fungible token standard
+func GetCmdCW721(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "cw721",
+		Short:                      "Querying commands for cw721 non fungible token contracts",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(flags.GetCommands(
+		GetCmdCW721OwnerOf(cdc),
+		GetCmdCW721Tokens(cdc),
+	)...)
+	return cmd
+}
+
+// GetCmdCW721OwnerOf prints the owner and the approvals of a token
+func GetCmdCW721OwnerOf(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "owner-of [cw721_contract_addr_bech32] [token_id]",
+		Short: "Prints the owner of a cw721 token",
+		Long:  "Prints the owner of a cw721 token and the spenders approved for it",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			query := map[string]interface{}{"owner_of": map[string]string{"token_id": args[1]}}
+			var owner cw721Owner
+			if err := querySmartTyped(cliCtx, args[0], query, &owner); err != nil {
+				return err
+			}
+			return printJSON(owner)
+		},
+	}
+}
+
+// GetCmdCW721Tokens lists the token ids of an owner
+func GetCmdCW721Tokens(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tokens [cw721_contract_addr_bech32] [owner]",
+		Short: "Lists the cw721 token ids of an owner",
+		Long:  "Lists the cw721 token ids of an owner, a page at a time with --start-after and --limit",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			owner, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+			params := map[string]interface{}{"owner": owner.String()}
+			if startAfter := viper.GetString(flagStartAfter); startAfter != "" {
+				params["start_after"] = startAfter
+			}
+			if limit := viper.GetUint32(flagLimit); limit != 0 {
+				params["limit"] = limit
+			}
+			var tokens cw721Tokens
+			if err := querySmartTyped(cliCtx, args[0], map[string]interface{}{"tokens": params}, &tokens); err != nil {
+				return err
+			}
+			return printJSON(tokens)
+		},
+	}
+	cmd.Flags().String(flagStartAfter, "", "List the token ids after this one")
+	cmd.Flags().Uint32(flagLimit, 0, "Maximum number of token ids, the contract default when 0")
+	return cmd
+}
+
+// querySmartTyped runs the smart query on the contract and decodes the response into out
+func querySmartTyped(cliCtx context.CLIContext, contract string, query interface{}, out interface{}) error {
+	addr, err := sdk.AccAddressFromBech32(contract)
+	if err != nil {
+		return err
+	}
+	queryData, err := json.Marshal(query)
+	if err != nil {
+		return err
+	}
+	route := fmt.Sprintf("custom/%s/%s/%s/%s", types.QuerierRoute, keeper.QueryGetContractState, addr.String(), keeper.QueryMethodContractStateSmart)
+	res, _, err := cliCtx.QueryWithData(route, queryData)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(res, out); err != nil {
+		return fmt.Errorf("decode response: %s", err)
+	}
+	return nil
+}
+
+// printJSON prints the typed response as indented json, like the other wasm queries print the contract json
+func printJSON(v interface{}) error {
+	bz, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(bz))
+	return nil
+}
+
+// formatTokenAmount places the decimal point in the integer amount, e.g. "1500000" with 6 decimals is "1.5"
+func formatTokenAmount(amount string, decimals uint8) string {
+	if decimals == 0 || amount == "" {
+		return amount
+	}
+	d := int(decimals)
+	if len(amount) <= d {
+		amount = strings.Repeat("0", d-len(amount)+1) + amount
+	}
+	whole, frac := amount[:len(amount)-d], strings.TrimRight(amount[len(amount)-d:], "0")
+	if frac == "" {
+		return whole
+	}
+	return whole + "." + frac
+}
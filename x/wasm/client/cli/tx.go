@@ -4,6 +4,8 @@ import (
 	"bufio"
 	"fmt"
 	"io/ioutil"
+	"os"
+	"path/filepath"
 	"strconv"
 
 	"github.com/spf13/cobra"
@@ -32,6 +34,7 @@ const (
 	flagInstantiateByEverybody = "instantiate-everybody"
 	flagInstantiateByAddress   = "instantiate-only-address"
 	flagProposalType           = "type"
+	flagNoDedup                = "no-dedup"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -43,14 +46,26 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		SuggestionsMinimumDistance: 2,
 		RunE:                       client.ValidateCmd,
 	}
-	txCmd.AddCommand(flags.PostCommands(
+	txCmd.AddCommand(wasmUtils.AddBroadcastFlags(flags.PostCommands(
 		StoreCodeCmd(cdc),
+		StoreBatchCmd(cdc),
 		InstantiateContractCmd(cdc),
 		ExecuteContractCmd(cdc),
+		SendCmd(cdc),
 		MigrateContractCmd(cdc),
 		UpdateContractAdminCmd(cdc),
 		ClearContractAdminCmd(cdc),
-	)...)
+		PauseExecutionCmd(cdc),
+		ResumeExecutionCmd(cdc),
+		GrantContractExecutionCmd(cdc),
+		RevokeContractExecutionCmd(cdc),
+		ExecAsGranteeCmd(cdc),
+		SetContractMetadataCmd(cdc),
+		SetContractRewardAddressCmd(cdc),
+		WithdrawContractRewardsCmd(cdc),
+		SetContractLimitsCmd(cdc),
+	)...)...)
+	txCmd.AddCommand(InspectTxCmd(cdc))
 	return txCmd
 }
 
@@ -73,7 +88,7 @@ func StoreCodeCmd(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
 
@@ -81,10 +96,96 @@ func StoreCodeCmd(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(flagBuilder, "", "A valid docker tag for the build system, optional")
 	cmd.Flags().String(flagInstantiateByEverybody, "", "Everybody can instantiate a contract from the code, optional")
 	cmd.Flags().String(flagInstantiateByAddress, "", "Only this address can instantiate a contract instance from the code, optional")
+	cmd.Flags().Bool(flagNoDedup, false, "Store a copy even when the same code with the same instantiate permission is stored already")
 
 	return cmd
 }
 
+// StoreBatchCmd uploads several wasm binaries in one tx
+func StoreBatchCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store-batch [dir or wasm file]... --source [source] --builder [builder]",
+		Short: "Upload several wasm binaries in one tx",
+		Long: `Upload the given wasm files and the .wasm files of the given directories in one tx, one store msg per file.
+Once the tx is committed, a "<file>: <code id>" line is printed for every file, to stderr with --output json.
+The source, builder and instantiate permission flags apply to all files.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			files, err := wasmFiles(args)
+			if err != nil {
+				return err
+			}
+			msgs := make([]sdk.Msg, len(files))
+			for i, file := range files {
+				msg, err := parseStoreCodeArgs([]string{file}, cliCtx)
+				if err != nil {
+					return sdkerrors.Wrap(err, file)
+				}
+				if err = msg.ValidateBasic(); err != nil {
+					return sdkerrors.Wrap(err, file)
+				}
+				msgs[i] = msg
+			}
+
+			res, err := wasmUtils.GenerateOrBroadcastMsgsWithResponse(cliCtx, txBldr, msgs)
+			if err != nil {
+				return err
+			}
+			result, err := wasmUtils.NewTxResult(res)
+			if err != nil {
+				return err
+			}
+			out := cliCtx.Output
+			if cliCtx.OutputFormat == "json" {
+				out = os.Stderr
+			}
+			return wasmUtils.PrintStoredFiles(out, files, result)
+		},
+	}
+
+	cmd.Flags().String(flagSource, "", "A valid URI reference to the contracts' source code, optional")
+	cmd.Flags().String(flagBuilder, "", "A valid docker tag for the build system, optional")
+	cmd.Flags().String(flagInstantiateByEverybody, "", "Everybody can instantiate a contract from the codes, optional")
+	cmd.Flags().String(flagInstantiateByAddress, "", "Only this address can instantiate a contract instance from the codes, optional")
+	cmd.Flags().Bool(flagNoDedup, false, "Store a copy even when the same code with the same instantiate permission is stored already")
+
+	return cmd
+}
+
+// wasmFiles returns the files of the args, with the .wasm files of the directories in name order
+func wasmFiles(args []string) ([]string, error) {
+	var files []string
+	for _, arg := range args {
+		info, err := os.Stat(arg)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, arg)
+			continue
+		}
+		entries, err := ioutil.ReadDir(arg)
+		if err != nil {
+			return nil, err
+		}
+		var found bool
+		for _, e := range entries {
+			if !e.IsDir() && filepath.Ext(e.Name()) == ".wasm" {
+				files = append(files, filepath.Join(arg, e.Name()))
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("no .wasm files in %s", arg)
+		}
+	}
+	return files, nil
+}
+
 func parseStoreCodeArgs(args []string, cliCtx context.CLIContext) (types.MsgStoreCode, error) {
 	wasm, err := ioutil.ReadFile(args[0])
 	if err != nil {
@@ -121,6 +222,7 @@ func parseStoreCodeArgs(args []string, cliCtx context.CLIContext) (types.MsgStor
 		Source:                viper.GetString(flagSource),
 		Builder:               viper.GetString(flagBuilder),
 		InstantiatePermission: perm,
+		NoDedup:               viper.GetBool(flagNoDedup),
 	}
 	return msg, nil
 }
@@ -143,7 +245,7 @@ func InstantiateContractCmd(cdc *codec.Codec) *cobra.Command {
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
-			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
 
@@ -203,7 +305,10 @@ func ExecuteContractCmd(cdc *codec.Codec) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			inBuf := bufio.NewReader(cmd.InOrStdin())
 			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
-			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			cliCtx, err := wasmUtils.WithMultisig(context.NewCLIContextWithInput(inBuf).WithCodec(cdc))
+			if err != nil {
+				return err
+			}
 
 			// get the id of the code to instantiate
 			contractAddr, err := sdk.AccAddressFromBech32(args[0])
@@ -226,10 +331,29 @@ func ExecuteContractCmd(cdc *codec.Codec) *cobra.Command {
 				SentFunds: amount,
 				Msg:       []byte(execMsg),
 			}
-			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+			return wasmUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
 
 	cmd.Flags().String(flagAmount, "", "Coins to send to the contract along with command")
+	cmd.Flags().String(wasmUtils.FlagMultisig, "", "Generate the unsigned tx for this multisig key name or address, to be signed with `tx sign --multisig`")
 	return cmd
 }
+
+// InspectTxCmd prints a summary of the wasm messages and the collected signatures of a tx file
+func InspectTxCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "inspect [tx_file]",
+		Short: "Summarize the wasm messages and signatures of a generated, partially signed or signed tx",
+		Long: `Summarize the wasm messages and signatures of a tx file, e.g. the output of
+execute --multisig, tx sign --multisig or tx multisign, before signing or broadcasting it.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			stdTx, err := utils.ReadStdTxFromFile(cdc, args[0])
+			if err != nil {
+				return err
+			}
+			return wasmUtils.PrintTxSummary(cmd.OutOrStdout(), stdTx)
+		},
+	}
+}
@@ -13,4 +13,10 @@ var ProposalHandlers = []govclient.ProposalHandler{
 	govclient.NewProposalHandler(cli.ProposalMigrateContractCmd, rest.MigrateProposalHandler),
 	govclient.NewProposalHandler(cli.ProposalUpdateContractAdminCmd, rest.UpdateContractAdminProposalHandler),
 	govclient.NewProposalHandler(cli.ProposalClearContractAdminCmd, rest.ClearContractAdminProposalHandler),
+	govclient.NewProposalHandler(cli.ProposalPauseExecutionCmd, rest.PauseExecutionProposalHandler),
+	govclient.NewProposalHandler(cli.ProposalResumeExecutionCmd, rest.ResumeExecutionProposalHandler),
+	govclient.NewProposalHandler(cli.ProposalSetContractLimitsCmd, rest.SetContractLimitsProposalHandler),
+	govclient.NewProposalHandler(cli.ProposalScheduleExecutionCmd, rest.ScheduleExecutionProposalHandler),
+	govclient.NewProposalHandler(cli.ProposalUnscheduleExecutionCmd, rest.UnscheduleExecutionProposalHandler),
+	govclient.NewProposalHandler(cli.ProposalSudoContractCmd, rest.SudoContractProposalHandler),
 }
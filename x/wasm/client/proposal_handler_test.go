@@ -203,6 +203,120 @@ func TestGovRestHandlers(t *testing.T) {
 			},
 			expCode: http.StatusOK,
 		},
+		"pause execution of contract": {
+			srcPath: "/gov/proposals/wasm_pause_execution",
+			srcBody: dict{
+				"title":       "Test Proposal",
+				"description": "My proposal",
+				"contract":    "fetch1w25zsayvx3rwk0840vdpacev6rt83y7gyseyce",
+				"deposit":     []dict{{"denom": "ustake", "amount": "10"}},
+				"proposer":    "fetch13k6l84d7ceu744p660zy3zgtsz93v976zfuqml",
+				"base_req":    aBaseReq,
+			},
+			expCode: http.StatusOK,
+		},
+		"resume execution of code": {
+			srcPath: "/gov/proposals/wasm_resume_execution",
+			srcBody: dict{
+				"title":       "Test Proposal",
+				"description": "My proposal",
+				"code_id":     "1",
+				"deposit":     []dict{{"denom": "ustake", "amount": "10"}},
+				"proposer":    "fetch13k6l84d7ceu744p660zy3zgtsz93v976zfuqml",
+				"base_req":    aBaseReq,
+			},
+			expCode: http.StatusOK,
+		},
+		"pause execution without target": {
+			srcPath: "/gov/proposals/wasm_pause_execution",
+			srcBody: dict{
+				"title":       "Test Proposal",
+				"description": "My proposal",
+				"deposit":     []dict{{"denom": "ustake", "amount": "10"}},
+				"proposer":    "fetch13k6l84d7ceu744p660zy3zgtsz93v976zfuqml",
+				"base_req":    aBaseReq,
+			},
+			expCode: http.StatusBadRequest,
+		},
+		"set contract limits": {
+			srcPath: "/gov/proposals/wasm_set_contract_limits",
+			srcBody: dict{
+				"title":       "Test Proposal",
+				"description": "My proposal",
+				"contract":    "fetch1w25zsayvx3rwk0840vdpacev6rt83y7gyseyce",
+				"bounds":      dict{"max_gas_per_execution": "1000000", "max_executions_per_block": "10"},
+				"deposit":     []dict{{"denom": "ustake", "amount": "10"}},
+				"proposer":    "fetch13k6l84d7ceu744p660zy3zgtsz93v976zfuqml",
+				"base_req":    aBaseReq,
+			},
+			expCode: http.StatusOK,
+		},
+		"set contract limits without contract": {
+			srcPath: "/gov/proposals/wasm_set_contract_limits",
+			srcBody: dict{
+				"title":       "Test Proposal",
+				"description": "My proposal",
+				"bounds":      dict{"max_executions_per_block": "10"},
+				"deposit":     []dict{{"denom": "ustake", "amount": "10"}},
+				"proposer":    "fetch13k6l84d7ceu744p660zy3zgtsz93v976zfuqml",
+				"base_req":    aBaseReq,
+			},
+			expCode: http.StatusBadRequest,
+		},
+		"schedule execution": {
+			srcPath: "/gov/proposals/wasm_schedule_execution",
+			srcBody: dict{
+				"title":       "Test Proposal",
+				"description": "My proposal",
+				"contract":    "fetch1w25zsayvx3rwk0840vdpacev6rt83y7gyseyce",
+				"msg":         dict{"cron": dict{}},
+				"interval":    "100",
+				"gas_limit":   "1000000",
+				"deposit":     []dict{{"denom": "ustake", "amount": "10"}},
+				"proposer":    "fetch13k6l84d7ceu744p660zy3zgtsz93v976zfuqml",
+				"base_req":    aBaseReq,
+			},
+			expCode: http.StatusOK,
+		},
+		"schedule execution without gas limit": {
+			srcPath: "/gov/proposals/wasm_schedule_execution",
+			srcBody: dict{
+				"title":       "Test Proposal",
+				"description": "My proposal",
+				"contract":    "fetch1w25zsayvx3rwk0840vdpacev6rt83y7gyseyce",
+				"msg":         dict{"cron": dict{}},
+				"interval":    "100",
+				"deposit":     []dict{{"denom": "ustake", "amount": "10"}},
+				"proposer":    "fetch13k6l84d7ceu744p660zy3zgtsz93v976zfuqml",
+				"base_req":    aBaseReq,
+			},
+			expCode: http.StatusBadRequest,
+		},
+		"unschedule execution": {
+			srcPath: "/gov/proposals/wasm_unschedule_execution",
+			srcBody: dict{
+				"title":       "Test Proposal",
+				"description": "My proposal",
+				"contract":    "fetch1w25zsayvx3rwk0840vdpacev6rt83y7gyseyce",
+				"deposit":     []dict{{"denom": "ustake", "amount": "10"}},
+				"proposer":    "fetch13k6l84d7ceu744p660zy3zgtsz93v976zfuqml",
+				"base_req":    aBaseReq,
+			},
+			expCode: http.StatusOK,
+		},
+		"sudo contract": {
+			srcPath: "/gov/proposals/wasm_sudo_contract",
+			srcBody: dict{
+				"title":       "Test Proposal",
+				"description": "My proposal",
+				"contract":    "fetch1w25zsayvx3rwk0840vdpacev6rt83y7gyseyce",
+				"msg":         dict{"set_fee": dict{"amount": "10"}},
+				"deposit":     []dict{{"denom": "ustake", "amount": "10"}},
+				"proposer":    "fetch13k6l84d7ceu744p660zy3zgtsz93v976zfuqml",
+				"base_req":    aBaseReq,
+			},
+			expCode: http.StatusOK,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
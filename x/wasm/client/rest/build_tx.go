@@ -0,0 +1,146 @@
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+// buildTxReq holds the wasm msgs in amino json, e.g. {"type":"wasm/MsgExecuteContract","value":{...}}
+type buildTxReq struct {
+	BaseReq rest.BaseReq      `json:"base_req" yaml:"base_req"`
+	Msgs    []json.RawMessage `json:"msgs" yaml:"msgs"`
+}
+
+// buildTxResp is the unsigned tx with everything an external signer needs
+type buildTxResp struct {
+	GasEstimate   uint64     `json:"gas_estimate" yaml:"gas_estimate"`
+	AccountNumber uint64     `json:"account_number" yaml:"account_number"`
+	Sequence      uint64     `json:"sequence" yaml:"sequence"`
+	Tx            auth.StdTx `json:"tx" yaml:"tx"`
+	// SignBytes are the canonical json bytes to sign
+	SignBytes string `json:"sign_bytes" yaml:"sign_bytes"`
+	// TxBytes is the amino encoded unsigned tx
+	TxBytes []byte `json:"tx_bytes" yaml:"tx_bytes"`
+}
+
+// buildTxHandlerFn decodes the wasm msgs, simulates them and returns the unsigned tx. The account number and
+// sequence are queried when not set in the base request, the gas limit is the adjusted estimate unless a fixed gas
+// is set.
+func buildTxHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req buildTxReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		msgs, err := decodeWasmMsgs(cliCtx, fromAddr, req.Msgs)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		gasAdj, ok := rest.ParseFloat64OrReturnBadRequest(w, req.BaseReq.GasAdjustment, flags.DefaultGasAdjustment)
+		if !ok {
+			return
+		}
+		simAndExec, gas, err := flags.ParseGas(req.BaseReq.Gas)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		txBldr := auth.NewTxBuilder(
+			utils.GetTxEncoder(cliCtx.Codec), req.BaseReq.AccountNumber, req.BaseReq.Sequence, gas, gasAdj,
+			true, req.BaseReq.ChainID, req.BaseReq.Memo, req.BaseReq.Fees, req.BaseReq.GasPrices,
+		)
+		cliCtx := cliCtx.WithFromAddress(fromAddr)
+		txBldr, err = utils.PrepareTxBuilder(txBldr, cliCtx)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		simBldr, err := utils.EnrichWithGas(txBldr, cliCtx, msgs)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if simAndExec || gas == 0 {
+			txBldr = simBldr
+		}
+
+		signMsg, err := txBldr.BuildSignMsg(msgs)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		stdTx := auth.NewStdTx(signMsg.Msgs, signMsg.Fee, nil, signMsg.Memo)
+		txBytes, err := txBldr.TxEncoder()(stdTx)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		resp := buildTxResp{
+			GasEstimate:   simBldr.Gas(),
+			AccountNumber: txBldr.AccountNumber(),
+			Sequence:      txBldr.Sequence(),
+			Tx:            stdTx,
+			SignBytes:     string(signMsg.Bytes()),
+			TxBytes:       txBytes,
+		}
+		bz, err := cliCtx.Codec.MarshalJSON(resp)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		rest.PostProcessResponseBare(w, cliCtx, bz)
+	}
+}
+
+// decodeWasmMsgs returns the valid wasm msgs signed by the sender only
+func decodeWasmMsgs(cliCtx context.CLIContext, sender sdk.AccAddress, src []json.RawMessage) ([]sdk.Msg, error) {
+	if len(src) == 0 {
+		return nil, fmt.Errorf("msgs empty")
+	}
+	msgs := make([]sdk.Msg, len(src))
+	for i, bz := range src {
+		var msg sdk.Msg
+		if err := cliCtx.Codec.UnmarshalJSON(bz, &msg); err != nil {
+			return nil, fmt.Errorf("msg %d: %w", i, err)
+		}
+		if msg.Route() != types.RouterKey {
+			return nil, fmt.Errorf("msg %d: not a wasm msg: %s", i, msg.Type())
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, fmt.Errorf("msg %d: %w", i, err)
+		}
+		for _, signer := range msg.GetSigners() {
+			if !bytes.Equal(signer, sender) {
+				return nil, fmt.Errorf("msg %d: signer %s is not the sender", i, signer)
+			}
+		}
+		msgs[i] = msg
+	}
+	return msgs, nil
+}
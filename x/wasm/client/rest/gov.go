@@ -249,6 +249,90 @@ func ClearContractAdminProposalHandler(cliCtx context.CLIContext) govrest.Propos
 	}
 }
 
+type PauseExecutionJsonReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+
+	Proposer sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit  sdk.Coins      `json:"deposit" yaml:"deposit"`
+
+	Contract sdk.AccAddress `json:"contract,omitempty" yaml:"contract"`
+	CodeID   uint64         `json:"code_id,omitempty" yaml:"code_id"`
+}
+
+func (s PauseExecutionJsonReq) Content() gov.Content {
+	return types.PauseExecutionProposal{
+		WasmProposal: types.WasmProposal{Title: s.Title, Description: s.Description},
+		Contract:     s.Contract,
+		CodeID:       s.CodeID,
+	}
+}
+func (s PauseExecutionJsonReq) GetProposer() sdk.AccAddress {
+	return s.Proposer
+}
+func (s PauseExecutionJsonReq) GetDeposit() sdk.Coins {
+	return s.Deposit
+}
+func (s PauseExecutionJsonReq) GetBaseReq() rest.BaseReq {
+	return s.BaseReq
+}
+func PauseExecutionProposalHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "wasm_pause_execution",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req PauseExecutionJsonReq
+			if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+				return
+			}
+			toStdTxResponse(cliCtx, w, req)
+		},
+	}
+}
+
+type ResumeExecutionJsonReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+
+	Proposer sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit  sdk.Coins      `json:"deposit" yaml:"deposit"`
+
+	Contract sdk.AccAddress `json:"contract,omitempty" yaml:"contract"`
+	CodeID   uint64         `json:"code_id,omitempty" yaml:"code_id"`
+}
+
+func (s ResumeExecutionJsonReq) Content() gov.Content {
+	return types.ResumeExecutionProposal{
+		WasmProposal: types.WasmProposal{Title: s.Title, Description: s.Description},
+		Contract:     s.Contract,
+		CodeID:       s.CodeID,
+	}
+}
+func (s ResumeExecutionJsonReq) GetProposer() sdk.AccAddress {
+	return s.Proposer
+}
+func (s ResumeExecutionJsonReq) GetDeposit() sdk.Coins {
+	return s.Deposit
+}
+func (s ResumeExecutionJsonReq) GetBaseReq() rest.BaseReq {
+	return s.BaseReq
+}
+func ResumeExecutionProposalHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "wasm_resume_execution",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req ResumeExecutionJsonReq
+			if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+				return
+			}
+			toStdTxResponse(cliCtx, w, req)
+		},
+	}
+}
+
 type wasmProposalData interface {
 	Content() gov.Content
 	GetProposer() sdk.AccAddress
@@ -268,3 +352,173 @@ func toStdTxResponse(cliCtx context.CLIContext, w http.ResponseWriter, data wasm
 	}
 	utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
 }
+
+type SetContractLimitsJsonReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+
+	Proposer sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit  sdk.Coins      `json:"deposit" yaml:"deposit"`
+
+	Contract sdk.AccAddress       `json:"contract" yaml:"contract"`
+	Bounds   types.ContractLimits `json:"bounds" yaml:"bounds"`
+}
+
+func (s SetContractLimitsJsonReq) Content() gov.Content {
+	return types.SetContractLimitsProposal{
+		WasmProposal: types.WasmProposal{Title: s.Title, Description: s.Description},
+		Contract:     s.Contract,
+		Bounds:       s.Bounds,
+	}
+}
+func (s SetContractLimitsJsonReq) GetProposer() sdk.AccAddress {
+	return s.Proposer
+}
+func (s SetContractLimitsJsonReq) GetDeposit() sdk.Coins {
+	return s.Deposit
+}
+func (s SetContractLimitsJsonReq) GetBaseReq() rest.BaseReq {
+	return s.BaseReq
+}
+func SetContractLimitsProposalHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "wasm_set_contract_limits",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req SetContractLimitsJsonReq
+			if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+				return
+			}
+			toStdTxResponse(cliCtx, w, req)
+		},
+	}
+}
+
+type ScheduleExecutionJsonReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+
+	Proposer sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit  sdk.Coins      `json:"deposit" yaml:"deposit"`
+
+	Contract sdk.AccAddress  `json:"contract" yaml:"contract"`
+	Msg      json.RawMessage `json:"msg" yaml:"msg"`
+	Interval uint64          `json:"interval" yaml:"interval"`
+	GasLimit uint64          `json:"gas_limit" yaml:"gas_limit"`
+}
+
+func (s ScheduleExecutionJsonReq) Content() gov.Content {
+	return types.ScheduleExecutionProposal{
+		WasmProposal: types.WasmProposal{Title: s.Title, Description: s.Description},
+		Contract:     s.Contract,
+		Msg:          s.Msg,
+		Interval:     s.Interval,
+		GasLimit:     s.GasLimit,
+	}
+}
+func (s ScheduleExecutionJsonReq) GetProposer() sdk.AccAddress {
+	return s.Proposer
+}
+func (s ScheduleExecutionJsonReq) GetDeposit() sdk.Coins {
+	return s.Deposit
+}
+func (s ScheduleExecutionJsonReq) GetBaseReq() rest.BaseReq {
+	return s.BaseReq
+}
+func ScheduleExecutionProposalHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "wasm_schedule_execution",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req ScheduleExecutionJsonReq
+			if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+				return
+			}
+			toStdTxResponse(cliCtx, w, req)
+		},
+	}
+}
+
+type UnscheduleExecutionJsonReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+
+	Proposer sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit  sdk.Coins      `json:"deposit" yaml:"deposit"`
+
+	Contract sdk.AccAddress `json:"contract" yaml:"contract"`
+}
+
+func (s UnscheduleExecutionJsonReq) Content() gov.Content {
+	return types.UnscheduleExecutionProposal{
+		WasmProposal: types.WasmProposal{Title: s.Title, Description: s.Description},
+		Contract:     s.Contract,
+	}
+}
+func (s UnscheduleExecutionJsonReq) GetProposer() sdk.AccAddress {
+	return s.Proposer
+}
+func (s UnscheduleExecutionJsonReq) GetDeposit() sdk.Coins {
+	return s.Deposit
+}
+func (s UnscheduleExecutionJsonReq) GetBaseReq() rest.BaseReq {
+	return s.BaseReq
+}
+func UnscheduleExecutionProposalHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "wasm_unschedule_execution",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req UnscheduleExecutionJsonReq
+			if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+				return
+			}
+			toStdTxResponse(cliCtx, w, req)
+		},
+	}
+}
+
+type SudoContractJsonReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+
+	Proposer sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit  sdk.Coins      `json:"deposit" yaml:"deposit"`
+
+	Contract sdk.AccAddress  `json:"contract" yaml:"contract"`
+	Msg      json.RawMessage `json:"msg" yaml:"msg"`
+}
+
+func (s SudoContractJsonReq) Content() gov.Content {
+	return types.SudoContractProposal{
+		WasmProposal: types.WasmProposal{Title: s.Title, Description: s.Description},
+		Contract:     s.Contract,
+		Msg:          s.Msg,
+	}
+}
+func (s SudoContractJsonReq) GetProposer() sdk.AccAddress {
+	return s.Proposer
+}
+func (s SudoContractJsonReq) GetDeposit() sdk.Coins {
+	return s.Deposit
+}
+func (s SudoContractJsonReq) GetBaseReq() rest.BaseReq {
+	return s.BaseReq
+}
+func SudoContractProposalHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "wasm_sudo_contract",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req SudoContractJsonReq
+			if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+				return
+			}
+			toStdTxResponse(cliCtx, w, req)
+		},
+	}
+}
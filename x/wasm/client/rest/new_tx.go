@@ -2,6 +2,7 @@ package rest
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -13,7 +14,13 @@ import (
 
 func registerNewTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/wasm/contract/{contractAddr}/admin", setContractAdminHandlerFn(cliCtx)).Methods("PUT")
+	r.HandleFunc("/wasm/contract/{contractAddr}/admin", clearContractAdminHandlerFn(cliCtx)).Methods("DELETE")
 	r.HandleFunc("/wasm/contract/{contractAddr}/code", migrateContractHandlerFn(cliCtx)).Methods("PUT")
+	r.HandleFunc("/wasm/contract/{contractAddr}/migrate", migrateContractHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/wasm/contract/{contractAddr}/pause", pauseExecutionHandlerFn(cliCtx, true)).Methods("POST")
+	r.HandleFunc("/wasm/contract/{contractAddr}/resume", pauseExecutionHandlerFn(cliCtx, false)).Methods("POST")
+	r.HandleFunc("/wasm/code/{codeId}/pause", pauseExecutionHandlerFn(cliCtx, true)).Methods("POST")
+	r.HandleFunc("/wasm/code/{codeId}/resume", pauseExecutionHandlerFn(cliCtx, false)).Methods("POST")
 }
 
 type migrateContractReq struct {
@@ -26,6 +33,9 @@ type updateContractAdministrateReq struct {
 	BaseReq rest.BaseReq   `json:"base_req" yaml:"base_req"`
 	Admin   sdk.AccAddress `json:"admin,omitempty" yaml:"admin"`
 }
+type baseReqOnly struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+}
 
 func setContractAdminHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -47,8 +57,14 @@ func setContractAdminHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
 		msg := types.MsgUpdateAdmin{
-			Sender:   cliCtx.GetFromAddress(),
+			Sender:   fromAddr,
 			NewAdmin: req.Admin,
 			Contract: contractAddress,
 		}
@@ -61,6 +77,45 @@ func setContractAdminHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+func clearContractAdminHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req baseReqOnly
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+		vars := mux.Vars(r)
+		contractAddr := vars["contractAddr"]
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		contractAddress, err := sdk.AccAddressFromBech32(contractAddr)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.MsgClearAdmin{
+			Sender:   fromAddr,
+			Contract: contractAddress,
+		}
+		if err = msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
 func migrateContractHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var req migrateContractReq
@@ -81,8 +136,14 @@ func migrateContractHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
 		msg := types.MsgMigrateContract{
-			Sender:     cliCtx.GetFromAddress(),
+			Sender:     fromAddr,
 			Contract:   contractAddress,
 			CodeID:     req.CodeID,
 			MigrateMsg: req.MigrateMsg,
@@ -95,3 +156,49 @@ func migrateContractHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+// pauseExecutionHandlerFn handles the pause and resume routes of both contracts and codes
+func pauseExecutionHandlerFn(cliCtx context.CLIContext, pause bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req baseReqOnly
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+		vars := mux.Vars(r)
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		var contractAddress sdk.AccAddress
+		var codeID uint64
+		var err error
+		if s, ok := vars["contractAddr"]; ok {
+			contractAddress, err = sdk.AccAddressFromBech32(s)
+		} else {
+			codeID, err = strconv.ParseUint(vars["codeId"], 10, 64)
+		}
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var msg sdk.Msg = types.MsgResumeExecution{Sender: fromAddr, Contract: contractAddress, CodeID: codeID}
+		if pause {
+			msg = types.MsgPauseExecution{Sender: fromAddr, Contract: contractAddress, CodeID: codeID}
+		}
+		if err = msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
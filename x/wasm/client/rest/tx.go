@@ -18,20 +18,26 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/wasm/code", storeCodeHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc("/wasm/code/{codeId}", instantiateContractHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc("/wasm/contract/{contractAddr}", executeContractHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/wasm/tx/build", buildTxHandlerFn(cliCtx)).Methods("POST")
 }
 
 // limit max bytes read to prevent gzip bombs
 const maxSize = 400 * 1024
 
 type storeCodeReq struct {
-	BaseReq   rest.BaseReq `json:"base_req" yaml:"base_req"`
-	WasmBytes []byte       `json:"wasm_bytes"`
+	BaseReq               rest.BaseReq        `json:"base_req" yaml:"base_req"`
+	WasmBytes             []byte              `json:"wasm_bytes"`
+	Source                string              `json:"source,omitempty" yaml:"source"`
+	Builder               string              `json:"builder,omitempty" yaml:"builder"`
+	InstantiatePermission *types.AccessConfig `json:"instantiate_permission,omitempty" yaml:"instantiate_permission"`
+	NoDedup               bool                `json:"no_dedup,omitempty" yaml:"no_dedup"`
 }
 
 type instantiateContractReq struct {
 	BaseReq rest.BaseReq   `json:"base_req" yaml:"base_req"`
 	Deposit sdk.Coins      `json:"deposit" yaml:"deposit"`
 	Admin   sdk.AccAddress `json:"admin,omitempty" yaml:"admin"`
+	Label   string         `json:"label" yaml:"label"`
 	InitMsg []byte         `json:"init_msg" yaml:"init_msg"`
 }
 
@@ -79,8 +85,12 @@ func storeCodeHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		}
 		// build and sign the transaction, then broadcast to Tendermint
 		msg := types.MsgStoreCode{
-			Sender:       fromAddr,
-			WASMByteCode: wasm,
+			Sender:                fromAddr,
+			WASMByteCode:          wasm,
+			Source:                req.Source,
+			Builder:               req.Builder,
+			InstantiatePermission: req.InstantiatePermission,
+			NoDedup:               req.NoDedup,
 		}
 
 		err = msg.ValidateBasic()
@@ -110,12 +120,20 @@ func instantiateContractHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		// get the id of the code to instantiate
 		codeID, err := strconv.ParseUint(codeId, 10, 64)
 		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
 		msg := types.MsgInstantiateContract{
-			Sender:    cliCtx.GetFromAddress(),
+			Sender:    fromAddr,
 			CodeID:    codeID,
+			Label:     req.Label,
 			InitFunds: req.Deposit,
 			InitMsg:   req.InitMsg,
 			Admin:     req.Admin,
@@ -147,11 +165,18 @@ func executeContractHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 
 		contractAddress, err := sdk.AccAddressFromBech32(contractAddr)
 		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
 		msg := types.MsgExecuteContract{
-			Sender:    cliCtx.GetFromAddress(),
+			Sender:    fromAddr,
 			Contract:  contractAddress,
 			Msg:       req.ExecMsg,
 			SentFunds: req.Amount,
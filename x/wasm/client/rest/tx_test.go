@@ -0,0 +1,177 @@
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	wasmtypes "github.com/fetchai/fetchd/x/wasm/internal/types"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxRestHandlers(t *testing.T) {
+	type dict map[string]interface{}
+	var (
+		anyAddress = "fetch1ckvh6fp75eenpkpnj88e2fv4hsdqnhc9lmd60r"
+		contract   = "fetch1w25zsayvx3rwk0840vdpacev6rt83y7gyseyce"
+		aBaseReq   = dict{
+			"from":           anyAddress,
+			"memo":           "rest test",
+			"chain_id":       "testing",
+			"account_number": "1",
+			"sequence":       "1",
+			"fees":           []dict{{"denom": "ustake", "amount": "1000000"}},
+		}
+	)
+	wasmCode, err := ioutil.ReadFile("../../internal/keeper/testdata/contract.wasm")
+	require.NoError(t, err)
+
+	cdc := codec.New()
+	wasmtypes.RegisterCodec(cdc)
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	clientCtx := context.CLIContext{}.WithChainID("testing").WithCodec(cdc)
+
+	router := mux.NewRouter()
+	registerTxRoutes(clientCtx, router)
+	registerNewTxRoutes(clientCtx, router)
+
+	specs := map[string]struct {
+		srcMethod string
+		srcPath   string
+		srcBody   dict
+		expCode   int
+		expMsg    string
+	}{
+		"store code": {
+			srcMethod: "POST",
+			srcPath:   "/wasm/code",
+			srcBody:   dict{"wasm_bytes": wasmCode, "source": "https://example.com/", "builder": "my/builder:tag", "base_req": aBaseReq},
+			expCode:   http.StatusOK,
+			expMsg:    "wasm/MsgStoreCode",
+		},
+		"store invalid code": {
+			srcMethod: "POST",
+			srcPath:   "/wasm/code",
+			srcBody:   dict{"wasm_bytes": []byte("not wasm"), "base_req": aBaseReq},
+			expCode:   http.StatusBadRequest,
+		},
+		"instantiate": {
+			srcMethod: "POST",
+			srcPath:   "/wasm/code/1",
+			srcBody:   dict{"label": "my contract", "init_msg": []byte(`{}`), "base_req": aBaseReq},
+			expCode:   http.StatusOK,
+			expMsg:    "wasm/MsgInstantiateContract",
+		},
+		"instantiate without label": {
+			srcMethod: "POST",
+			srcPath:   "/wasm/code/1",
+			srcBody:   dict{"init_msg": []byte(`{}`), "base_req": aBaseReq},
+			expCode:   http.StatusBadRequest,
+		},
+		"execute": {
+			srcMethod: "POST",
+			srcPath:   "/wasm/contract/" + contract,
+			srcBody:   dict{"exec_msg": []byte(`{}`), "base_req": aBaseReq},
+			expCode:   http.StatusOK,
+			expMsg:    "wasm/MsgExecuteContract",
+		},
+		"execute invalid contract address": {
+			srcMethod: "POST",
+			srcPath:   "/wasm/contract/invalid",
+			srcBody:   dict{"exec_msg": []byte(`{}`), "base_req": aBaseReq},
+			expCode:   http.StatusBadRequest,
+		},
+		"migrate": {
+			srcMethod: "POST",
+			srcPath:   "/wasm/contract/" + contract + "/migrate",
+			srcBody:   dict{"code_id": "2", "migrate_msg": []byte(`{}`), "base_req": aBaseReq},
+			expCode:   http.StatusOK,
+			expMsg:    "wasm/MsgMigrateContract",
+		},
+		"update admin": {
+			srcMethod: "PUT",
+			srcPath:   "/wasm/contract/" + contract + "/admin",
+			srcBody:   dict{"admin": anyAddress, "base_req": aBaseReq},
+			expCode:   http.StatusOK,
+			expMsg:    "wasm/MsgUpdateAdmin",
+		},
+		"clear admin": {
+			srcMethod: "DELETE",
+			srcPath:   "/wasm/contract/" + contract + "/admin",
+			srcBody:   dict{"base_req": aBaseReq},
+			expCode:   http.StatusOK,
+			expMsg:    "wasm/MsgClearAdmin",
+		},
+		"pause contract": {
+			srcMethod: "POST",
+			srcPath:   "/wasm/contract/" + contract + "/pause",
+			srcBody:   dict{"base_req": aBaseReq},
+			expCode:   http.StatusOK,
+			expMsg:    "wasm/MsgPauseExecution",
+		},
+		"resume code": {
+			srcMethod: "POST",
+			srcPath:   "/wasm/code/1/resume",
+			srcBody:   dict{"base_req": aBaseReq},
+			expCode:   http.StatusOK,
+			expMsg:    "wasm/MsgResumeExecution",
+		},
+		"build tx without msgs": {
+			srcMethod: "POST",
+			srcPath:   "/wasm/tx/build",
+			srcBody:   dict{"base_req": aBaseReq},
+			expCode:   http.StatusBadRequest,
+			expMsg:    "msgs empty",
+		},
+		"build tx with msg of other sender": {
+			srcMethod: "POST",
+			srcPath:   "/wasm/tx/build",
+			srcBody: dict{"msgs": []dict{{
+				"type":  "wasm/MsgExecuteContract",
+				"value": dict{"sender": contract, "contract": contract, "msg": dict{}},
+			}}, "base_req": aBaseReq},
+			expCode: http.StatusBadRequest,
+			expMsg:  "is not the sender",
+		},
+		"build tx with invalid msg": {
+			srcMethod: "POST",
+			srcPath:   "/wasm/tx/build",
+			srcBody: dict{"msgs": []dict{{
+				"type":  "wasm/MsgInstantiateContract",
+				"value": dict{"sender": anyAddress, "code_id": "1", "init_msg": dict{}},
+			}}, "base_req": aBaseReq},
+			expCode: http.StatusBadRequest,
+		},
+		"without base request": {
+			srcMethod: "POST",
+			srcPath:   "/wasm/contract/" + contract,
+			srcBody:   dict{"exec_msg": []byte(`{}`)},
+			expCode:   http.StatusBadRequest,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			src, err := json.Marshal(spec.srcBody)
+			require.NoError(t, err)
+
+			// when
+			r := httptest.NewRequest(spec.srcMethod, spec.srcPath, bytes.NewReader(src))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, r)
+
+			// then
+			require.Equal(t, spec.expCode, w.Code, w.Body.String())
+			if spec.expMsg != "" {
+				require.Contains(t, w.Body.String(), spec.expMsg)
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+// Package subscribe streams the events of a contract from the Tendermint websocket of a node.
+package subscribe
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+const (
+	subscriber = "fetchcli-wasm-subscribe"
+
+	DefaultMinBackoff = time.Second
+	DefaultMaxBackoff = time.Minute
+)
+
+// ContractEvent is a single event emitted for a contract in a tx
+type ContractEvent struct {
+	Height     int64           `json:"height"`
+	TxHash     string          `json:"tx_hash"`
+	Type       string          `json:"type"`
+	Attributes []sdk.Attribute `json:"attributes"`
+}
+
+// Config of a Subscription
+type Config struct {
+	// NodeURI is the rpc address of the node, e.g. tcp://localhost:26657
+	NodeURI string
+	// MinBackoff and MaxBackoff limit the exponential wait before a reconnect
+	MinBackoff, MaxBackoff time.Duration
+	// OnError is called for every connection failure before reconnecting, optional
+	OnError func(error)
+}
+
+// Subscription streams the events of a contract until the context is cancelled.
+// A lost websocket connection is reconnected with an exponential backoff. Txs committed while
+// disconnected are not replayed.
+type Subscription struct {
+	cfg      Config
+	contract sdk.AccAddress
+}
+
+// NewSubscription creates a Subscription for the contract events
+func NewSubscription(cfg Config, contract sdk.AccAddress) *Subscription {
+	if cfg.MinBackoff == 0 {
+		cfg.MinBackoff = DefaultMinBackoff
+	}
+	if cfg.MaxBackoff == 0 {
+		cfg.MaxBackoff = DefaultMaxBackoff
+	}
+	return &Subscription{cfg: cfg, contract: contract}
+}
+
+// Query returns the tendermint event query for the txs of the contract
+func (s *Subscription) Query() string {
+	return fmt.Sprintf("tm.event='Tx' AND %s.%s='%s'", types.CustomEventType, types.AttributeKeyContractAddr, s.contract)
+}
+
+// Run sends the contract events to out until the context is cancelled
+func (s *Subscription) Run(ctx context.Context, out chan<- ContractEvent) error {
+	backoff := s.cfg.MinBackoff
+	for {
+		connected, err := s.stream(ctx, out)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if connected {
+			backoff = s.cfg.MinBackoff
+		}
+		if s.cfg.OnError != nil {
+			s.cfg.OnError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		if backoff *= 2; backoff > s.cfg.MaxBackoff {
+			backoff = s.cfg.MaxBackoff
+		}
+	}
+}
+
+// stream runs a single websocket connection. It returns true when the subscription was established.
+func (s *Subscription) stream(ctx context.Context, out chan<- ContractEvent) (bool, error) {
+	client, err := rpchttp.New(s.cfg.NodeURI, "/websocket")
+	if err != nil {
+		return false, err
+	}
+	if err := client.Start(); err != nil {
+		return false, err
+	}
+	defer client.Stop() // nolint: errcheck
+
+	events, err := client.Subscribe(ctx, subscriber, s.Query())
+	if err != nil {
+		return false, err
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return true, ctx.Err()
+		case <-client.Quit():
+			return true, fmt.Errorf("connection to %s closed", s.cfg.NodeURI)
+		case ev, ok := <-events:
+			if !ok {
+				return true, fmt.Errorf("subscription to %s cancelled", s.cfg.NodeURI)
+			}
+			for _, e := range DecodeContractEvents(s.contract, ev) {
+				select {
+				case out <- e:
+				case <-ctx.Done():
+					return true, ctx.Err()
+				}
+			}
+		}
+	}
+}
+
+// DecodeContractEvents returns the events of the tx that were emitted for the contract
+func DecodeContractEvents(contract sdk.AccAddress, ev ctypes.ResultEvent) []ContractEvent {
+	txEvent, ok := ev.Data.(tmtypes.EventDataTx)
+	if !ok {
+		return nil
+	}
+	txHash := fmt.Sprintf("%X", tmtypes.Tx(txEvent.Tx).Hash())
+	var res []ContractEvent
+	for _, e := range txEvent.Result.Events {
+		if !emittedFor(contract, e) {
+			continue
+		}
+		attrs := make([]sdk.Attribute, len(e.Attributes))
+		for i, a := range e.Attributes {
+			attrs[i] = sdk.NewAttribute(string(a.Key), string(a.Value))
+		}
+		res = append(res, ContractEvent{
+			Height:     txEvent.Height,
+			TxHash:     txHash,
+			Type:       e.Type,
+			Attributes: attrs,
+		})
+	}
+	return res
+}
+
+func emittedFor(contract sdk.AccAddress, e abci.Event) bool {
+	addr := contract.String()
+	for _, a := range e.Attributes {
+		if string(a.Key) == types.AttributeKeyContractAddr && string(a.Value) == addr {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,70 @@
+package subscribe
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+func TestDecodeContractEvents(t *testing.T) {
+	contract := sdk.AccAddress(bytes.Repeat([]byte{1}, sdk.AddrLen))
+	otherContract := sdk.AccAddress(bytes.Repeat([]byte{2}, sdk.AddrLen))
+	tx := tmtypes.Tx("my tx")
+	txHash := fmt.Sprintf("%X", tx.Hash())
+
+	txEvent := func(events ...sdk.Event) ctypes.ResultEvent {
+		return ctypes.ResultEvent{Data: tmtypes.EventDataTx{TxResult: tmtypes.TxResult{
+			Height: 7,
+			Tx:     tx,
+			Result: abci.ResponseDeliverTx{Events: sdk.Events(events).ToABCIEvents()},
+		}}}
+	}
+	contractAttr := sdk.NewAttribute(types.AttributeKeyContractAddr, contract.String())
+
+	specs := map[string]struct {
+		src ctypes.ResultEvent
+		exp []ContractEvent
+	}{
+		"contract event": {
+			src: txEvent(sdk.NewEvent(types.CustomEventType, contractAttr, sdk.NewAttribute("action", "transfer"))),
+			exp: []ContractEvent{{
+				Height:     7,
+				TxHash:     txHash,
+				Type:       types.CustomEventType,
+				Attributes: []sdk.Attribute{contractAttr, sdk.NewAttribute("action", "transfer")},
+			}},
+		},
+		"other contract and module events dropped": {
+			src: txEvent(
+				sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName)),
+				sdk.NewEvent(types.CustomEventType, sdk.NewAttribute(types.AttributeKeyContractAddr, otherContract.String())),
+				sdk.NewEvent("wasm-custom", contractAttr),
+			),
+			exp: []ContractEvent{{Height: 7, TxHash: txHash, Type: "wasm-custom", Attributes: []sdk.Attribute{contractAttr}}},
+		},
+		"no tx data": {
+			src: ctypes.ResultEvent{Data: tmtypes.EventDataNewBlockHeader{}},
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			assert.Equal(t, spec.exp, DecodeContractEvents(contract, spec.src))
+		})
+	}
+}
+
+func TestSubscriptionQuery(t *testing.T) {
+	contract := sdk.AccAddress(bytes.Repeat([]byte{1}, sdk.AddrLen))
+	sub := NewSubscription(Config{}, contract)
+	assert.Equal(t, "tm.event='Tx' AND wasm.contract_address='"+contract.String()+"'", sub.Query())
+	assert.Equal(t, DefaultMinBackoff, sub.cfg.MinBackoff)
+	assert.Equal(t, DefaultMaxBackoff, sub.cfg.MaxBackoff)
+}
@@ -0,0 +1,182 @@
+package utils
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+)
+
+const (
+	FlagRetrySequence   = "retry-sequence"
+	FlagSequenceRetries = "sequence-retries"
+	FlagRetryDelay      = "retry-delay"
+	FlagGasReport       = "gas-report"
+
+	DefaultSequenceRetries = 3
+	DefaultRetryDelay      = time.Second
+)
+
+// sequenceMismatchLog is part of the log returned by the ante handler when a signature was made for another sequence
+const sequenceMismatchLog = "account sequence"
+
+// AddBroadcastFlags registers the flags of GenerateOrBroadcastMsgs on the given commands
+func AddBroadcastFlags(cmds ...*cobra.Command) []*cobra.Command {
+	for _, c := range cmds {
+		c.Flags().Bool(FlagRetrySequence, false, "Re-sign and broadcast the tx again after an account sequence mismatch")
+		c.Flags().Int(FlagSequenceRetries, DefaultSequenceRetries, "Number of retries after an account sequence mismatch with --retry-sequence")
+		c.Flags().Duration(FlagRetryDelay, DefaultRetryDelay, "Time to wait before the account is queried again for a retry")
+		c.Flags().String(FlagRemoteSigner, "", "Endpoint of a remote signing service to sign with instead of the keyring")
+		c.Flags().String(FlagRemoteSignerKey, "", "Identifier of the key at the remote signing service")
+		c.Flags().String(FlagRemoteSignerToken, "", "Bearer token for the remote signing service, better set as WM_REMOTE_SIGNER_TOKEN env var")
+		c.Flags().Bool(FlagLedgerSummary, false, "Sign the checksums of the byte code and contract msgs instead of the msgs, for hardware wallets that can not display large msgs")
+		c.Flags().Bool(FlagGasReport, false, "Print the gas consumed by the contract calls of the tx, requires --broadcast-mode=block or --wait")
+		c.Flags().Bool(FlagWait, false, "With --broadcast-mode sync or async, wait until the tx is included in a block and print its result")
+		c.Flags().Duration(FlagWaitTimeout, DefaultWaitTimeout, "Time to wait for the tx to be included with --wait")
+	}
+	return cmds
+}
+
+// GenerateOrBroadcastMsgs works like the sdk version but can recover from account sequence mismatches.
+// With --retry-sequence, a broadcast rejected because of the sequence is followed by a query of the account and the
+// tx is rebuilt, re-signed and broadcast up to --sequence-retries times.
+func GenerateOrBroadcastMsgs(cliCtx context.CLIContext, txBldr auth.TxBuilder, msgs []sdk.Msg) error {
+	_, err := GenerateOrBroadcastMsgsWithResponse(cliCtx, txBldr, msgs)
+	return err
+}
+
+// GenerateOrBroadcastMsgsWithResponse is GenerateOrBroadcastMsgs, but also returns the response of the broadcast tx,
+// for commands that print more of the result. The response is empty when the tx was not broadcast.
+func GenerateOrBroadcastMsgsWithResponse(cliCtx context.CLIContext, txBldr auth.TxBuilder, msgs []sdk.Msg) (sdk.TxResponse, error) {
+	if cliCtx.GenerateOnly {
+		if viper.GetString(FlagMultisig) != "" {
+			return sdk.TxResponse{}, PrintUnsignedMultisigTx(cliCtx, txBldr, msgs)
+		}
+		return sdk.TxResponse{}, utils.PrintUnsignedStdTx(txBldr, cliCtx, msgs)
+	}
+	if err := ValidateBroadcastMode(cliCtx.BroadcastMode); err != nil {
+		return sdk.TxResponse{}, err
+	}
+	var retries int
+	if viper.GetBool(FlagRetrySequence) {
+		retries = viper.GetInt(FlagSequenceRetries)
+	}
+	return CompleteAndBroadcastTxCLI(txBldr, cliCtx, msgs, retries, viper.GetDuration(FlagRetryDelay))
+}
+
+// CompleteAndBroadcastTxCLI signs and broadcasts the msgs, retrying on account sequence mismatches.
+// The tx is signed with the remote signer configured by the --remote-signer flags or with the keyring.
+// With --wait, a tx broadcast in sync or async mode is polled until it is included and its final result printed.
+func CompleteAndBroadcastTxCLI(txBldr auth.TxBuilder, cliCtx context.CLIContext, msgs []sdk.Msg, retries int, delay time.Duration) (sdk.TxResponse, error) {
+	signer, err := RemoteSignerFromFlags()
+	if err != nil {
+		return sdk.TxResponse{}, err
+	}
+	txBldr, err = utils.PrepareTxBuilder(txBldr, cliCtx)
+	if err != nil {
+		return sdk.TxResponse{}, err
+	}
+
+	if txBldr.SimulateAndExecute() || cliCtx.Simulate {
+		txBldr, err = utils.EnrichWithGas(txBldr, cliCtx, msgs)
+		if err != nil {
+			return sdk.TxResponse{}, err
+		}
+		gasEst := utils.GasEstimateResponse{GasEstimate: txBldr.Gas()}
+		_, _ = fmt.Fprintf(os.Stderr, "%s\n", gasEst.String())
+	}
+	if cliCtx.Simulate {
+		return sdk.TxResponse{}, nil
+	}
+
+	if !cliCtx.SkipConfirm {
+		stdSignMsg, err := txBldr.BuildSignMsg(msgs)
+		if err != nil {
+			return sdk.TxResponse{}, err
+		}
+		var json []byte
+		if viper.GetBool(flags.FlagIndentResponse) {
+			json, err = cliCtx.Codec.MarshalJSONIndent(stdSignMsg, "", "  ")
+			if err != nil {
+				return sdk.TxResponse{}, err
+			}
+		} else {
+			json = cliCtx.Codec.MustMarshalJSON(stdSignMsg)
+		}
+		_, _ = fmt.Fprintf(os.Stderr, "%s\n\n", json)
+
+		buf := bufio.NewReader(os.Stdin)
+		ok, err := input.GetConfirmation("confirm transaction before signing and broadcasting", buf)
+		if err != nil || !ok {
+			_, _ = fmt.Fprintf(os.Stderr, "%s\n", "cancelled transaction")
+			return sdk.TxResponse{}, err
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		txBytes, err := buildAndSign(cliCtx, txBldr, msgs, signer)
+		if err != nil {
+			return sdk.TxResponse{}, err
+		}
+		res, err := cliCtx.BroadcastTx(txBytes)
+		if attempt >= retries || !isSequenceMismatch(res, err) {
+			if err != nil {
+				return res, err
+			}
+			if viper.GetBool(FlagWait) && cliCtx.BroadcastMode != flags.BroadcastBlock {
+				if res, err = WaitForTx(cliCtx, res, viper.GetDuration(FlagWaitTimeout)); err != nil {
+					return res, err
+				}
+			}
+			if err := PrintTxResult(cliCtx, res); err != nil {
+				return res, err
+			}
+			if err := PrintResultData(os.Stderr, res); err != nil {
+				return res, err
+			}
+			if viper.GetBool(FlagGasReport) {
+				return res, PrintGasReport(os.Stderr, res)
+			}
+			return res, nil
+		}
+
+		_, _ = fmt.Fprintf(os.Stderr, "account sequence mismatch for sequence %d, retrying (%d/%d)\n", txBldr.Sequence(), attempt+1, retries)
+		time.Sleep(delay)
+		accNum, seq, err := auth.NewAccountRetriever(cliCtx).GetAccountNumberSequence(cliCtx.GetFromAddress())
+		if err != nil {
+			return sdk.TxResponse{}, err
+		}
+		txBldr = txBldr.WithAccountNumber(accNum).WithSequence(nextSequence(txBldr.Sequence(), seq))
+	}
+}
+
+// isSequenceMismatch returns true when the tx was rejected because it was signed for another account sequence
+func isSequenceMismatch(res sdk.TxResponse, err error) bool {
+	if err != nil {
+		return strings.Contains(err.Error(), sequenceMismatchLog)
+	}
+	return res.Codespace == sdkerrors.RootCodespace &&
+		res.Code == sdkerrors.ErrUnauthorized.ABCICode() &&
+		strings.Contains(res.RawLog, sequenceMismatchLog)
+}
+
+// nextSequence returns the sequence to sign the retry with. The queried sequence does not include txs pending in the
+// mempool, so when it equals the rejected one, the node must be expecting a higher sequence.
+func nextSequence(rejected, queried uint64) uint64 {
+	if queried == rejected {
+		return rejected + 1
+	}
+	return queried
+}
@@ -0,0 +1,54 @@
+package utils
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsSequenceMismatch(t *testing.T) {
+	sigLog := "signature verification failed; verify correct account sequence and chain-id"
+	specs := map[string]struct {
+		res sdk.TxResponse
+		err error
+		exp bool
+	}{
+		"sequence mismatch": {
+			res: sdk.TxResponse{Codespace: sdkerrors.RootCodespace, Code: sdkerrors.ErrUnauthorized.ABCICode(), RawLog: sigLog},
+			exp: true,
+		},
+		"other unauthorized": {
+			res: sdk.TxResponse{Codespace: sdkerrors.RootCodespace, Code: sdkerrors.ErrUnauthorized.ABCICode(), RawLog: "unauthorized"},
+		},
+		"other codespace": {
+			res: sdk.TxResponse{Codespace: "wasm", Code: sdkerrors.ErrUnauthorized.ABCICode(), RawLog: sigLog},
+		},
+		"success": {
+			res: sdk.TxResponse{},
+		},
+		"broadcast error": {
+			err: errors.New(sigLog),
+			exp: true,
+		},
+		"other broadcast error": {
+			err: errors.New("connection refused"),
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			assert.Equal(t, spec.exp, isSequenceMismatch(spec.res, spec.err))
+		})
+	}
+}
+
+func TestNextSequence(t *testing.T) {
+	// pending tx in the mempool
+	assert.Equal(t, uint64(6), nextSequence(5, 5))
+	// committed meanwhile
+	assert.Equal(t, uint64(7), nextSequence(5, 7))
+	// signed with a sequence too high
+	assert.Equal(t, uint64(3), nextSequence(5, 3))
+}
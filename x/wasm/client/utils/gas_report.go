@@ -0,0 +1,35 @@
+package utils
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+// PrintGasReport prints a table of the gas consumed by the contract calls of each message of the tx
+func PrintGasReport(w io.Writer, res sdk.TxResponse) error {
+	if len(res.Logs) == 0 {
+		_, err := fmt.Fprintln(w, "no gas report: the tx result contains no events, use --broadcast-mode=block or --wait")
+		return err
+	}
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', tabwriter.AlignRight)
+	_, _ = fmt.Fprintln(tw, "msg\tcontract\toperation\tvm\tstorage\tdispatch\ttotal\t")
+	for _, log := range res.Logs {
+		reports, err := types.ParseGasReports(log.Events)
+		if err != nil {
+			return err
+		}
+		for _, r := range reports {
+			_, _ = fmt.Fprintf(tw, "%d\t%s\t%s\t%d\t%d\t%d\t%d\t\n", log.MsgIndex, r.Contract, r.Operation, r.VM, r.Storage, r.Dispatch, r.Total)
+		}
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintf(w, "gas used by the tx: %d of %d wanted\n", res.GasUsed, res.GasWanted)
+	return err
+}
@@ -0,0 +1,37 @@
+package utils
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+func TestPrintGasReport(t *testing.T) {
+	contract := sdk.AccAddress(bytes.Repeat([]byte{1}, sdk.AddrLen))
+	report := types.GasReport{Contract: contract, Operation: types.GasReportOperationExecute, VM: 1, Storage: 2, Dispatch: 3, Total: 70000}
+
+	var buf bytes.Buffer
+	err := PrintGasReport(&buf, sdk.TxResponse{
+		GasUsed:   80000,
+		GasWanted: 100000,
+		Logs: sdk.ABCIMessageLogs{
+			sdk.NewABCIMessageLog(0, "", sdk.StringifyEvents(sdk.Events{report.Event()}.ToABCIEvents())),
+		},
+	})
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 3)
+	assert.Equal(t, []string{"msg", "contract", "operation", "vm", "storage", "dispatch", "total"}, strings.Fields(lines[0]))
+	assert.Equal(t, []string{"0", contract.String(), "execute", "1", "2", "3", "70000"}, strings.Fields(lines[1]))
+	assert.Equal(t, "gas used by the tx: 80000 of 100000 wanted", lines[2])
+
+	buf.Reset()
+	require.NoError(t, PrintGasReport(&buf, sdk.TxResponse{}))
+	assert.Contains(t, buf.String(), "--broadcast-mode=block")
+}
@@ -0,0 +1,166 @@
+package utils
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+
+	"github.com/tendermint/tendermint/crypto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+)
+
+// grpcSignMethod is the method of the Signer service of signer.proto
+const grpcSignMethod = "/fetchd.signer.v1.Signer/Sign"
+
+// GRPCSigner signs with a key of an external key management service, e.g. a HashiCorp Vault or AWS KMS plugin,
+// that serves the Signer service of signer.proto. Endpoints are grpc://host:port, or grpcs://host:port with TLS.
+type GRPCSigner struct {
+	conn  *grpc.ClientConn
+	keyID string
+	token string
+}
+
+// NewGRPCSigner creates a GRPCSigner. The token is sent as bearer token in the authorization metadata when not empty.
+func NewGRPCSigner(endpoint, keyID, token string) (Signer, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	creds := grpc.WithInsecure()
+	if u.Scheme == "grpcs" {
+		creds = grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12}))
+	}
+	conn, err := grpc.Dial(u.Host, creds)
+	if err != nil {
+		return nil, fmt.Errorf("remote signer: %w", err)
+	}
+	return &GRPCSigner{conn: conn, keyID: keyID, token: token}, nil
+}
+
+// Sign returns the signature of msg and the public key of the signing key
+func (s *GRPCSigner) Sign(msg []byte) ([]byte, crypto.PubKey, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+	if s.token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+s.token)
+	}
+	var res grpcSignResponse
+	req := grpcSignRequest{KeyID: s.keyID, SignBytes: msg}
+	if err := s.conn.Invoke(ctx, grpcSignMethod, &req, &res, grpc.ForceCodec(signerCodec{})); err != nil {
+		return nil, nil, fmt.Errorf("remote signer: %w", err)
+	}
+	return verifiedSignature(msg, res.Signature, res.PubKey)
+}
+
+// grpcSignRequest is the SignRequest of signer.proto
+type grpcSignRequest struct {
+	KeyID     string
+	SignBytes []byte
+}
+
+// grpcSignResponse is the SignResponse of signer.proto
+type grpcSignResponse struct {
+	Signature []byte
+	// PubKey is the bech32 encoded account public key of the signing key
+	PubKey string
+}
+
+// signerCodec encodes the messages of signer.proto in the protobuf wire format. They only have length delimited
+// fields, which saves the generated code for two messages.
+type signerCodec struct{}
+
+func (signerCodec) Name() string {
+	return "proto"
+}
+
+// String makes the codec a grpc.Codec too, for servers
+func (signerCodec) String() string {
+	return "proto"
+}
+
+func (signerCodec) Marshal(v interface{}) ([]byte, error) {
+	switch m := v.(type) {
+	case *grpcSignRequest:
+		return appendBytesField(appendBytesField(nil, 1, []byte(m.KeyID)), 2, m.SignBytes), nil
+	case *grpcSignResponse:
+		return appendBytesField(appendBytesField(nil, 1, m.Signature), 2, []byte(m.PubKey)), nil
+	default:
+		return nil, fmt.Errorf("unsupported message type %T", v)
+	}
+}
+
+func (signerCodec) Unmarshal(data []byte, v interface{}) error {
+	fields, err := bytesFields(data)
+	if err != nil {
+		return err
+	}
+	switch m := v.(type) {
+	case *grpcSignRequest:
+		m.KeyID, m.SignBytes = string(fields[1]), fields[2]
+	case *grpcSignResponse:
+		m.Signature, m.PubKey = fields[1], string(fields[2])
+	default:
+		return fmt.Errorf("unsupported message type %T", v)
+	}
+	return nil
+}
+
+// appendBytesField appends a length delimited field, empty values are omitted like by proto3
+func appendBytesField(bz []byte, field uint64, value []byte) []byte {
+	if len(value) == 0 {
+		return bz
+	}
+	const wireTypeBytes = 2
+	bz = appendUvarint(bz, field<<3|wireTypeBytes)
+	bz = appendUvarint(bz, uint64(len(value)))
+	return append(bz, value...)
+}
+
+func appendUvarint(bz []byte, v uint64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	return append(bz, buf[:binary.PutUvarint(buf[:], v)]...)
+}
+
+// bytesFields returns the values of the length delimited fields by field number, fields of other wire types are
+// skipped
+func bytesFields(bz []byte) (map[uint64][]byte, error) {
+	res := make(map[uint64][]byte)
+	for len(bz) != 0 {
+		key, n := binary.Uvarint(bz)
+		if n <= 0 {
+			return nil, fmt.Errorf("invalid field key")
+		}
+		bz = bz[n:]
+		switch key & 7 {
+		case 0: // varint
+			if _, n = binary.Uvarint(bz); n <= 0 {
+				return nil, fmt.Errorf("invalid varint")
+			}
+			bz = bz[n:]
+		case 1: // fixed64
+			if len(bz) < 8 {
+				return nil, fmt.Errorf("invalid fixed64")
+			}
+			bz = bz[8:]
+		case 2: // length delimited
+			l, n := binary.Uvarint(bz)
+			if n <= 0 || uint64(len(bz)-n) < l {
+				return nil, fmt.Errorf("invalid length")
+			}
+			res[key>>3] = bz[n : n+int(l)]
+			bz = bz[n+int(l):]
+		case 5: // fixed32
+			if len(bz) < 4 {
+				return nil, fmt.Errorf("invalid fixed32")
+			}
+			bz = bz[4:]
+		default:
+			return nil, fmt.Errorf("unsupported wire type %d", key&7)
+		}
+	}
+	return res, nil
+}
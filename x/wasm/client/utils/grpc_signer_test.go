@@ -0,0 +1,90 @@
+package utils
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestGRPCSigner(t *testing.T) {
+	privKey := secp256k1.GenPrivKey()
+	bechPubKey, err := sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, privKey.PubKey())
+	require.NoError(t, err)
+	otherKey := secp256k1.GenPrivKey()
+
+	signHandler := func(_ interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+		if md, _ := metadata.FromIncomingContext(ctx); len(md.Get("authorization")) == 0 || md.Get("authorization")[0] != "Bearer secret" {
+			return nil, status.Error(codes.Unauthenticated, "invalid token")
+		}
+		var req grpcSignRequest
+		if err := dec(&req); err != nil {
+			return nil, err
+		}
+		signWith := privKey
+		if req.KeyID == "wrong-signature" {
+			signWith = otherKey
+		}
+		sig, err := signWith.Sign(req.SignBytes)
+		if err != nil {
+			return nil, err
+		}
+		return &grpcSignResponse{Signature: sig, PubKey: bechPubKey}, nil
+	}
+	srv := grpc.NewServer(grpc.CustomCodec(signerCodec{}))
+	srv.RegisterService(&grpc.ServiceDesc{
+		ServiceName: "fetchd.signer.v1.Signer",
+		HandlerType: (*interface{})(nil),
+		Methods:     []grpc.MethodDesc{{MethodName: "Sign", Handler: signHandler}},
+	}, struct{}{})
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() { _ = srv.Serve(lis) }()
+	defer srv.Stop()
+
+	specs := map[string]struct {
+		keyID  string
+		token  string
+		expErr bool
+	}{
+		"signed":            {keyID: "my-key", token: "secret"},
+		"unauthorized":      {keyID: "my-key", token: "other", expErr: true},
+		"invalid signature": {keyID: "wrong-signature", token: "secret", expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			signer, err := NewGRPCSigner("grpc://"+lis.Addr().String(), spec.keyID, spec.token)
+			require.NoError(t, err)
+			sig, pubKey, err := signer.Sign([]byte("sign bytes"))
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, privKey.PubKey(), pubKey)
+			assert.True(t, pubKey.VerifyBytes([]byte("sign bytes"), sig))
+		})
+	}
+}
+
+func TestSignerCodec(t *testing.T) {
+	// SignRequest{key_id: "k", sign_bytes: "ab"} as encoded by protoc generated code, with an unknown varint field 3
+	bz := []byte{0x0a, 0x01, 'k', 0x12, 0x02, 'a', 'b', 0x18, 0x96, 0x01}
+	var req grpcSignRequest
+	require.NoError(t, signerCodec{}.Unmarshal(bz, &req))
+	assert.Equal(t, grpcSignRequest{KeyID: "k", SignBytes: []byte("ab")}, req)
+
+	got, err := signerCodec{}.Marshal(&req)
+	require.NoError(t, err)
+	assert.Equal(t, bz[:7], got)
+
+	assert.Error(t, signerCodec{}.Unmarshal([]byte{0x0a, 0x05, 'k'}, &req))
+}
@@ -0,0 +1,54 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+const FlagLedgerSummary = "ledger-summary"
+
+// summarySignBytes returns the summary sign bytes of the sign msg, see types.SummarySignBytes
+func summarySignBytes(signMsg auth.StdSignMsg) ([]byte, error) {
+	bz, ok := types.SummarySignBytes(signMsg.ChainID, signMsg.AccountNumber, signMsg.Sequence, signMsg.Fee, signMsg.Msgs, signMsg.Memo)
+	if !ok {
+		return nil, fmt.Errorf("--%s: the tx has no wasm msg with a byte code or contract msg to summarize", FlagLedgerSummary)
+	}
+	return bz, nil
+}
+
+// PrintPayloadChecksums prints the checksums of the payloads that replace them in the summary sign bytes, to be
+// compared with the checksums displayed by the hardware wallet
+func PrintPayloadChecksums(w io.Writer, msgs []sdk.Msg) error {
+	for i, msg := range msgs {
+		var err error
+		switch m := msg.(type) {
+		case types.MsgStoreCode:
+			// the byte code is replaced by the checksum bytes, displayed base64 encoded like all bytes of the sign doc
+			checksum := sha256.Sum256(m.WASMByteCode)
+			_, err = fmt.Fprintf(w, "msg %d: wasm_byte_code sha256 (base64) %s\n", i, base64.StdEncoding.EncodeToString(checksum[:]))
+		case types.MsgInstantiateContract:
+			_, err = fmt.Fprintf(w, "msg %d: init_msg sha256 %s\n", i, types.PayloadChecksum(m.InitMsg))
+		case types.MsgExecuteContract:
+			_, err = fmt.Fprintf(w, "msg %d: msg sha256 %s\n", i, types.PayloadChecksum(m.Msg))
+		case types.MsgMigrateContract:
+			_, err = fmt.Fprintf(w, "msg %d: msg sha256 %s\n", i, types.PayloadChecksum(m.MigrateMsg))
+		case types.MsgExec:
+			for j, e := range m.Msgs {
+				if _, err = fmt.Fprintf(w, "msg %d.%d: msg sha256 %s\n", i, j, types.PayloadChecksum(e.Msg)); err != nil {
+					break
+				}
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
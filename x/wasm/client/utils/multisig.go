@@ -0,0 +1,236 @@
+package utils
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/multisig"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+// FlagMultisig sets the multisig account a tx is generated for
+const FlagMultisig = "multisig"
+
+// WithMultisig sets the multisig account of the --multisig flag, a multisig key name or address, as sender of the
+// tx. The tx is only generated then, it is signed with `tx sign --multisig` and assembled with `tx multisign`.
+func WithMultisig(cliCtx context.CLIContext) (context.CLIContext, error) {
+	name := viper.GetString(FlagMultisig)
+	if name == "" {
+		return cliCtx, nil
+	}
+	addr, err := sdk.AccAddressFromBech32(name)
+	if err != nil {
+		kb, err := keys.NewKeyring(sdk.KeyringServiceName(), viper.GetString(flags.FlagKeyringBackend), viper.GetString(flags.FlagHome), cliCtx.Input)
+		if err != nil {
+			return cliCtx, err
+		}
+		info, err := kb.Get(name)
+		if err != nil {
+			return cliCtx, fmt.Errorf("failed to get multisig key %q from keybase: %w", name, err)
+		}
+		if info.GetType() != keys.TypeMulti {
+			return cliCtx, fmt.Errorf("key %q is not a multisig key", name)
+		}
+		addr = info.GetAddress()
+	}
+	cliCtx = cliCtx.WithFromAddress(addr).WithFromName(name)
+	cliCtx.GenerateOnly = true
+	return cliCtx, nil
+}
+
+// PrintUnsignedMultisigTx prints the unsigned tx like `--generate-only` and a summary of it to stderr
+func PrintUnsignedMultisigTx(cliCtx context.CLIContext, txBldr auth.TxBuilder, msgs []sdk.Msg) error {
+	if txBldr.SimulateAndExecute() {
+		var err error
+		txBldr, err = utils.EnrichWithGas(txBldr, cliCtx, msgs)
+		if err != nil {
+			return err
+		}
+	}
+	stdSignMsg, err := txBldr.BuildSignMsg(msgs)
+	if err != nil {
+		return err
+	}
+	stdTx := auth.NewStdTx(stdSignMsg.Msgs, stdSignMsg.Fee, nil, stdSignMsg.Memo)
+	_, _ = fmt.Fprintf(os.Stderr, "unsigned tx for multisig %s\n", cliCtx.GetFromAddress())
+	if err := PrintTxSummary(os.Stderr, stdTx); err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintln(os.Stderr, "sign with `tx sign [file] --multisig <address> --from <key>`, assemble with `tx multisign`")
+
+	json, err := cliCtx.Codec.MarshalJSON(stdTx)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(cliCtx.Output, "%s\n", json)
+	return err
+}
+
+// PrintTxSummary prints the messages of the tx as in the summary sign doc, with the payloads of the wasm messages
+// replaced by their checksums, the fee and the collected signatures
+func PrintTxSummary(w io.Writer, tx auth.StdTx) error {
+	for i, msg := range tx.Msgs {
+		summary, _ := types.SummarizeMsg(msg)
+		if _, err := fmt.Fprintf(w, "msg %d: %s/%s %s\n", i, msg.Route(), msg.Type(), summary.GetSignBytes()); err != nil {
+			return err
+		}
+	}
+	if _, err := fmt.Fprintf(w, "fee: %s, gas: %d, memo: %q\n", tx.Fee.Amount, tx.Fee.Gas, tx.Memo); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "signatures: %d\n", len(tx.Signatures)); err != nil {
+		return err
+	}
+	for _, sig := range tx.Signatures {
+		if sig.PubKey == nil {
+			continue
+		}
+		signer := sdk.AccAddress(sig.PubKey.Address())
+		line := fmt.Sprintf("  %s\n", signer)
+		if pk, ok := sig.PubKey.(multisig.PubKeyMultisigThreshold); ok {
+			var mSig multisig.Multisignature
+			if err := types.ModuleCdc.UnmarshalBinaryBare(sig.Signature, &mSig); err != nil {
+				return err
+			}
+			line = fmt.Sprintf("  %s: multisig %d of %d, %d signed\n", signer, pk.K, len(pk.PubKeys), len(mSig.Sigs))
+		}
+		if _, err := io.WriteString(w, line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SignatureStatus is the signature state of a signer of a tx. The keys of a multisig signer have their own status,
+// nested multisig keys included.
+type SignatureStatus struct {
+	Address sdk.AccAddress `json:"address"`
+	Signed  bool           `json:"signed"`
+	// Threshold is the number of keys that must sign for a multisig key, 0 for other keys
+	Threshold uint              `json:"threshold,omitempty"`
+	Keys      []SignatureStatus `json:"keys,omitempty"`
+	// Unknown is set when the pub key of the signer could not be found
+	Unknown bool `json:"unknown,omitempty"`
+}
+
+// MultisigStatus returns the signature status of every signer of the tx. The pub keys of the signers without a
+// signature in the tx are looked up with pubKeyOf, which returns nil for unknown keys.
+func MultisigStatus(tx auth.StdTx, pubKeyOf func(sdk.AccAddress) (crypto.PubKey, error)) ([]SignatureStatus, error) {
+	signers := tx.GetSigners()
+	res := make([]SignatureStatus, len(signers))
+	for i, signer := range signers {
+		var pubKey crypto.PubKey
+		var sig []byte
+		if i < len(tx.Signatures) && tx.Signatures[i].PubKey != nil {
+			pubKey, sig = tx.Signatures[i].PubKey, tx.Signatures[i].Signature
+		} else {
+			var err error
+			if pubKey, err = pubKeyOf(signer); err != nil {
+				return nil, err
+			}
+		}
+		if pubKey == nil {
+			res[i] = SignatureStatus{Address: signer, Unknown: true}
+			continue
+		}
+		s, err := signatureStatus(pubKey, sig)
+		if err != nil {
+			return nil, fmt.Errorf("signer %s: %w", signer, err)
+		}
+		res[i] = s
+	}
+	return res, nil
+}
+
+func signatureStatus(pubKey crypto.PubKey, sig []byte) (SignatureStatus, error) {
+	status := SignatureStatus{Address: sdk.AccAddress(pubKey.Address())}
+	pk, ok := pubKey.(multisig.PubKeyMultisigThreshold)
+	if !ok {
+		status.Signed = len(sig) != 0
+		return status, nil
+	}
+	var mSig multisig.Multisignature
+	if len(sig) != 0 {
+		if err := types.ModuleCdc.UnmarshalBinaryBare(sig, &mSig); err != nil {
+			return status, err
+		}
+	}
+	status.Threshold = pk.K
+	var signed uint
+	var sigIndex int
+	for i, subKey := range pk.PubKeys {
+		var subSig []byte
+		if mSig.BitArray != nil && mSig.BitArray.GetIndex(i) {
+			if sigIndex >= len(mSig.Sigs) {
+				return status, fmt.Errorf("multisignature has fewer signatures than set bits")
+			}
+			subSig = mSig.Sigs[sigIndex]
+			sigIndex++
+		}
+		s, err := signatureStatus(subKey, subSig)
+		if err != nil {
+			return status, err
+		}
+		if s.Signed {
+			signed++
+		}
+		status.Keys = append(status.Keys, s)
+	}
+	status.Signed = signed >= pk.K
+	return status, nil
+}
+
+// PrintMultisigStatus prints the signature status of the signers, with the keys of the multisig signers indented
+func PrintMultisigStatus(w io.Writer, statuses []SignatureStatus) error {
+	for _, s := range statuses {
+		if err := printSignatureStatus(w, s, ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printSignatureStatus(w io.Writer, s SignatureStatus, indent string) error {
+	var err error
+	switch {
+	case s.Unknown:
+		_, err = fmt.Fprintf(w, "%s%s: pub key unknown\n", indent, s.Address)
+	case s.Threshold != 0:
+		var signed int
+		for _, k := range s.Keys {
+			if k.Signed {
+				signed++
+			}
+		}
+		_, err = fmt.Fprintf(w, "%s%s: multisig %d of %d, %d signed, %s\n", indent, s.Address, s.Threshold, len(s.Keys), signed, signedText(s.Signed))
+	default:
+		_, err = fmt.Fprintf(w, "%s%s: %s\n", indent, s.Address, signedText(s.Signed))
+	}
+	if err != nil {
+		return err
+	}
+	for _, k := range s.Keys {
+		if err := printSignatureStatus(w, k, indent+"  "); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func signedText(signed bool) string {
+	if signed {
+		return "signed"
+	}
+	return "missing"
+}
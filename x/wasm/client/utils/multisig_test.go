@@ -0,0 +1,151 @@
+package utils
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/multisig"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+func TestPrintTxSummary(t *testing.T) {
+	privKeys := []crypto.PrivKey{secp256k1.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey()}
+	pubKeys := []crypto.PubKey{privKeys[0].PubKey(), privKeys[1].PubKey(), privKeys[2].PubKey()}
+	multisigKey := multisig.NewPubKeyMultisigThreshold(2, pubKeys)
+	multisigAddr := sdk.AccAddress(multisigKey.Address())
+	contract := sdk.AccAddress(bytes.Repeat([]byte{1}, sdk.AddrLen))
+
+	msgs := []sdk.Msg{
+		types.MsgExecuteContract{
+			Sender:    multisigAddr,
+			Contract:  contract,
+			Msg:       []byte(`{"release":{}}`),
+			SentFunds: sdk.NewCoins(sdk.NewInt64Coin("afet", 100)),
+		},
+		bank.NewMsgSend(multisigAddr, contract, sdk.NewCoins(sdk.NewInt64Coin("afet", 1))),
+	}
+	fee := auth.NewStdFee(200000, sdk.NewCoins(sdk.NewInt64Coin("afet", 5000)))
+
+	partialSig, err := privKeys[0].Sign([]byte("any"))
+	require.NoError(t, err)
+	mSig := multisig.NewMultisig(len(pubKeys))
+	require.NoError(t, mSig.AddSignatureFromPubKey(partialSig, pubKeys[0], pubKeys))
+
+	specs := map[string]struct {
+		src    []auth.StdSignature
+		expSig []string
+	}{
+		"unsigned": {},
+		"partially signed": {
+			src:    []auth.StdSignature{{PubKey: pubKeys[0], Signature: partialSig}},
+			expSig: []string{"  " + sdk.AccAddress(pubKeys[0].Address()).String()},
+		},
+		"multisigned": {
+			src:    []auth.StdSignature{{PubKey: multisigKey, Signature: mSig.Marshal()}},
+			expSig: []string{"  " + multisigAddr.String() + ": multisig 2 of 3, 1 signed"},
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, PrintTxSummary(&buf, auth.NewStdTx(msgs, fee, spec.src, "dao")))
+			lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+			require.Len(t, lines, 4+len(spec.expSig))
+			summary, _ := types.SummarizeMsg(msgs[0])
+			assert.Equal(t, "msg 0: wasm/execute "+string(summary.GetSignBytes()), lines[0])
+			assert.Contains(t, lines[0], types.PayloadChecksum([]byte(`{"release":{}}`)))
+			assert.Equal(t, "msg 1: bank/send "+string(msgs[1].GetSignBytes()), lines[1])
+			assert.Equal(t, `fee: 5000afet, gas: 200000, memo: "dao"`, lines[2])
+			assert.Equal(t, "signatures: "+strconv.Itoa(len(spec.src)), lines[3])
+			assert.Equal(t, append([]string{}, spec.expSig...), lines[4:])
+		})
+	}
+}
+
+func TestMultisigStatus(t *testing.T) {
+	privKeys := []crypto.PrivKey{secp256k1.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey()}
+	innerKeys := []crypto.PubKey{privKeys[0].PubKey(), privKeys[1].PubKey()}
+	innerKey := multisig.NewPubKeyMultisigThreshold(2, innerKeys)
+	outerKeys := []crypto.PubKey{innerKey, privKeys[2].PubKey()}
+	outerKey := multisig.NewPubKeyMultisigThreshold(1, outerKeys)
+	outerAddr := sdk.AccAddress(outerKey.Address())
+	tx := func(sigs ...auth.StdSignature) auth.StdTx {
+		msg := types.MsgExecuteContract{Sender: outerAddr, Contract: outerAddr, Msg: []byte(`{}`)}
+		return auth.NewStdTx([]sdk.Msg{msg}, auth.NewStdFee(200000, nil), sigs, "")
+	}
+	sign := func(priv crypto.PrivKey) []byte {
+		sig, err := priv.Sign([]byte("any"))
+		require.NoError(t, err)
+		return sig
+	}
+	multisign := func(keys []crypto.PubKey, sigs map[int][]byte) []byte {
+		mSig := multisig.NewMultisig(len(keys))
+		for i, sig := range sigs {
+			require.NoError(t, mSig.AddSignatureFromPubKey(sig, keys[i], keys))
+		}
+		return mSig.Marshal()
+	}
+	innerPartial := multisign(innerKeys, map[int][]byte{0: sign(privKeys[0])})
+	innerComplete := multisign(innerKeys, map[int][]byte{0: sign(privKeys[0]), 1: sign(privKeys[1])})
+
+	specs := map[string]struct {
+		srcTx     auth.StdTx
+		srcPubKey crypto.PubKey
+		exp       []string
+	}{
+		"unsigned": {
+			srcTx:     tx(),
+			srcPubKey: outerKey,
+			exp: []string{
+				outerAddr.String() + ": multisig 1 of 2, 0 signed, missing",
+				"  " + sdk.AccAddress(innerKey.Address()).String() + ": multisig 2 of 2, 0 signed, missing",
+				"    " + sdk.AccAddress(innerKeys[0].Address()).String() + ": missing",
+				"    " + sdk.AccAddress(innerKeys[1].Address()).String() + ": missing",
+				"  " + sdk.AccAddress(outerKeys[1].Address()).String() + ": missing",
+			},
+		},
+		"nested multisig partially signed": {
+			srcTx: tx(auth.StdSignature{PubKey: outerKey, Signature: multisign(outerKeys, map[int][]byte{0: innerPartial})}),
+			exp: []string{
+				outerAddr.String() + ": multisig 1 of 2, 0 signed, missing",
+				"  " + sdk.AccAddress(innerKey.Address()).String() + ": multisig 2 of 2, 1 signed, missing",
+				"    " + sdk.AccAddress(innerKeys[0].Address()).String() + ": signed",
+				"    " + sdk.AccAddress(innerKeys[1].Address()).String() + ": missing",
+				"  " + sdk.AccAddress(outerKeys[1].Address()).String() + ": missing",
+			},
+		},
+		"nested multisig signed": {
+			srcTx: tx(auth.StdSignature{PubKey: outerKey, Signature: multisign(outerKeys, map[int][]byte{0: innerComplete})}),
+			exp: []string{
+				outerAddr.String() + ": multisig 1 of 2, 1 signed, signed",
+				"  " + sdk.AccAddress(innerKey.Address()).String() + ": multisig 2 of 2, 2 signed, signed",
+				"    " + sdk.AccAddress(innerKeys[0].Address()).String() + ": signed",
+				"    " + sdk.AccAddress(innerKeys[1].Address()).String() + ": signed",
+				"  " + sdk.AccAddress(outerKeys[1].Address()).String() + ": missing",
+			},
+		},
+		"unknown pub key": {
+			srcTx: tx(),
+			exp:   []string{outerAddr.String() + ": pub key unknown"},
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			statuses, err := MultisigStatus(spec.srcTx, func(sdk.AccAddress) (crypto.PubKey, error) { return spec.srcPubKey, nil })
+			require.NoError(t, err)
+			var buf bytes.Buffer
+			require.NoError(t, PrintMultisigStatus(&buf, statuses))
+			assert.Equal(t, spec.exp, strings.Split(strings.TrimSpace(buf.String()), "\n"))
+		})
+	}
+}
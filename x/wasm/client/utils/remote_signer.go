@@ -0,0 +1,199 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+const (
+	FlagRemoteSigner      = "remote-signer"
+	FlagRemoteSignerKey   = "remote-signer-key"
+	FlagRemoteSignerToken = "remote-signer-token"
+
+	remoteSignerTimeout = 30 * time.Second
+)
+
+// Signer signs the sign bytes of a tx with a key held outside of the keyring, e.g. by an HSM or a KMS
+type Signer interface {
+	// Sign returns the signature of msg and the public key of the signing key
+	Sign(msg []byte) ([]byte, crypto.PubKey, error)
+}
+
+// SignerFactory creates the Signer of the --remote-signer endpoint for the key with the id
+type SignerFactory func(endpoint, keyID, token string) (Signer, error)
+
+// signerFactories are the factories by url scheme of the --remote-signer endpoint
+var signerFactories = map[string]SignerFactory{
+	"http":  newHTTPSigner,
+	"https": newHTTPSigner,
+	"grpc":  NewGRPCSigner,
+	"grpcs": NewGRPCSigner,
+}
+
+// RegisterSigner registers the factory of the signers of the url scheme, for clients of key management services that
+// are linked into a custom build of the cli
+func RegisterSigner(scheme string, factory SignerFactory) {
+	signerFactories[scheme] = factory
+}
+
+// RemoteSigner signs with a key held by an external signing service, e.g. an HSM gateway.
+// The service is called with POST <endpoint>/sign and a RemoteSignRequest body and must answer with a RemoteSignResponse.
+type RemoteSigner struct {
+	endpoint string
+	keyID    string
+	token    string
+	client   *http.Client
+}
+
+// RemoteSignRequest is sent to the signing service
+type RemoteSignRequest struct {
+	KeyID     string `json:"key_id"`
+	SignBytes []byte `json:"sign_bytes"`
+}
+
+// RemoteSignResponse is returned by the signing service
+type RemoteSignResponse struct {
+	Signature []byte `json:"signature"`
+	// PubKey is the bech32 encoded account public key of the signing key
+	PubKey string `json:"pub_key"`
+}
+
+// NewRemoteSigner creates a RemoteSigner. The token is sent as bearer token when not empty.
+func NewRemoteSigner(endpoint, keyID, token string) *RemoteSigner {
+	return &RemoteSigner{
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		keyID:    keyID,
+		token:    token,
+		client:   &http.Client{Timeout: remoteSignerTimeout},
+	}
+}
+
+func newHTTPSigner(endpoint, keyID, token string) (Signer, error) {
+	return NewRemoteSigner(endpoint, keyID, token), nil
+}
+
+// RemoteSignerFromFlags returns the Signer configured with the --remote-signer flags or nil when not set. The flags
+// can also be set in the config.toml of the cli home. The scheme of the endpoint selects the signer.
+func RemoteSignerFromFlags() (Signer, error) {
+	endpoint := viper.GetString(FlagRemoteSigner)
+	if endpoint == "" {
+		return nil, nil
+	}
+	keyID := viper.GetString(FlagRemoteSignerKey)
+	if keyID == "" {
+		return nil, fmt.Errorf("--%s required with --%s", FlagRemoteSignerKey, FlagRemoteSigner)
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("--%s: %w", FlagRemoteSigner, err)
+	}
+	factory, ok := signerFactories[u.Scheme]
+	if !ok {
+		return nil, fmt.Errorf("--%s: unsupported scheme %q", FlagRemoteSigner, u.Scheme)
+	}
+	return factory(endpoint, keyID, viper.GetString(FlagRemoteSignerToken))
+}
+
+// Sign returns the signature of msg and the public key of the signing key
+func (s *RemoteSigner) Sign(msg []byte) ([]byte, crypto.PubKey, error) {
+	reqBz, err := json.Marshal(RemoteSignRequest{KeyID: s.keyID, SignBytes: msg})
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := http.NewRequest(http.MethodPost, s.endpoint+"/sign", bytes.NewReader(reqBz))
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.token)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("remote signer: %w", err)
+	}
+	defer resp.Body.Close()
+	respBz, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("remote signer: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("remote signer: %s: %s", resp.Status, strings.TrimSpace(string(respBz)))
+	}
+
+	var res RemoteSignResponse
+	if err := json.Unmarshal(respBz, &res); err != nil {
+		return nil, nil, fmt.Errorf("remote signer response: %w", err)
+	}
+	return verifiedSignature(msg, res.Signature, res.PubKey)
+}
+
+// verifiedSignature returns the signature and the decoded bech32 account pub key of a signer response after checking
+// that the signature is valid for msg
+func verifiedSignature(msg, sig []byte, bechPubKey string) ([]byte, crypto.PubKey, error) {
+	pubKey, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeAccPub, bechPubKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("remote signer pub key: %w", err)
+	}
+	if !pubKey.VerifyBytes(msg, sig) {
+		return nil, nil, fmt.Errorf("remote signer returned an invalid signature")
+	}
+	return sig, pubKey, nil
+}
+
+// buildAndSign builds the tx and signs it with the remote signer, or with the keyring when signer is nil. With
+// --ledger-summary the summary sign bytes are signed instead, after the checksums of the payloads were printed.
+func buildAndSign(cliCtx context.CLIContext, txBldr auth.TxBuilder, msgs []sdk.Msg, signer Signer) ([]byte, error) {
+	summary := viper.GetBool(FlagLedgerSummary)
+	if signer == nil && !summary {
+		return txBldr.BuildAndSign(cliCtx.GetFromName(), keys.DefaultKeyPass, msgs)
+	}
+	signMsg, err := txBldr.BuildSignMsg(msgs)
+	if err != nil {
+		return nil, err
+	}
+	signBytes := signMsg.Bytes()
+	if summary {
+		if signBytes, err = summarySignBytes(signMsg); err != nil {
+			return nil, err
+		}
+		if err := PrintPayloadChecksums(os.Stderr, msgs); err != nil {
+			return nil, err
+		}
+	}
+
+	var sig []byte
+	var pubKey crypto.PubKey
+	if signer != nil {
+		if sig, pubKey, err = signer.Sign(signBytes); err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(pubKey.Address(), cliCtx.GetFromAddress()) {
+			return nil, fmt.Errorf("remote signer key %s does not belong to %s", viper.GetString(FlagRemoteSignerKey), cliCtx.GetFromAddress())
+		}
+	} else {
+		if txBldr.Keybase() == nil {
+			return nil, fmt.Errorf("keybase missing")
+		}
+		if sig, pubKey, err = txBldr.Keybase().Sign(cliCtx.GetFromName(), keys.DefaultKeyPass, signBytes); err != nil {
+			return nil, err
+		}
+	}
+	stdTx := auth.NewStdTx(signMsg.Msgs, signMsg.Fee, []auth.StdSignature{{PubKey: pubKey, Signature: sig}}, signMsg.Memo)
+	return txBldr.TxEncoder()(stdTx)
+}
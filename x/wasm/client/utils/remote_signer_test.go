@@ -0,0 +1,60 @@
+package utils
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+func TestRemoteSigner(t *testing.T) {
+	privKey := secp256k1.GenPrivKey()
+	bechPubKey, err := sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, privKey.PubKey())
+	require.NoError(t, err)
+	otherKey := secp256k1.GenPrivKey()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/sign" || r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var req RemoteSignRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		signWith := privKey
+		if req.KeyID == "wrong-signature" {
+			signWith = otherKey
+		}
+		sig, err := signWith.Sign(req.SignBytes)
+		require.NoError(t, err)
+		require.NoError(t, json.NewEncoder(w).Encode(RemoteSignResponse{Signature: sig, PubKey: bechPubKey}))
+	}))
+	defer srv.Close()
+
+	specs := map[string]struct {
+		keyID  string
+		token  string
+		expErr bool
+	}{
+		"signed":            {keyID: "my-key", token: "secret"},
+		"unauthorized":      {keyID: "my-key", token: "other", expErr: true},
+		"invalid signature": {keyID: "wrong-signature", token: "secret", expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			signer := NewRemoteSigner(srv.URL+"/", spec.keyID, spec.token)
+			sig, pubKey, err := signer.Sign([]byte("sign bytes"))
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, privKey.PubKey(), pubKey)
+			assert.True(t, pubKey.VerifyBytes([]byte("sign bytes"), sig))
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package utils
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"unicode"
+	"unicode/utf8"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+// PrintResultData prints the data returned by the contracts for each message of the tx, if any
+func PrintResultData(w io.Writer, res sdk.TxResponse) error {
+	for _, log := range res.Logs {
+		data, err := types.ParseResultData(res.Logs, log.MsgIndex)
+		if err != nil {
+			return err
+		}
+		if len(data) == 0 {
+			continue
+		}
+		if _, err := fmt.Fprintf(w, "msg %d result data: %s\n", log.MsgIndex, DecodeResultData(data)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeResultData renders contract result data as json when it is valid json, as quoted string when it is
+// printable text and hex encoded otherwise
+func DecodeResultData(data []byte) string {
+	if json.Valid(data) {
+		return string(data)
+	}
+	if utf8.Valid(data) && isPrintable(string(data)) {
+		return strconv.Quote(string(data))
+	}
+	return "0x" + hex.EncodeToString(data)
+}
+
+func isPrintable(s string) bool {
+	for _, r := range s {
+		if !unicode.IsPrint(r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,57 @@
+package utils
+
+import (
+	"bytes"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+func TestDecodeResultData(t *testing.T) {
+	specs := map[string]struct {
+		src []byte
+		exp string
+	}{
+		"json": {
+			src: []byte(`{"count":1}`),
+			exp: `{"count":1}`,
+		},
+		"text": {
+			src: []byte("burnt 1 keys"),
+			exp: `"burnt 1 keys"`,
+		},
+		"binary": {
+			src: []byte{0xf0, 0x0b, 0xaa},
+			exp: "0xf00baa",
+		},
+		"control characters": {
+			src: []byte("a\x00b"),
+			exp: "0x610062",
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			assert.Equal(t, spec.exp, DecodeResultData(spec.src))
+		})
+	}
+}
+
+func TestPrintResultData(t *testing.T) {
+	event := func(data []byte) sdk.StringEvents {
+		e := types.WithResultData(sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName)), data)
+		return sdk.StringifyEvents(sdk.Events{e}.ToABCIEvents())
+	}
+	var buf bytes.Buffer
+	err := PrintResultData(&buf, sdk.TxResponse{
+		Logs: sdk.ABCIMessageLogs{
+			sdk.NewABCIMessageLog(0, "", event(nil)),
+			sdk.NewABCIMessageLog(1, "", event([]byte("ok"))),
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "msg 1 result data: \"ok\"\n", buf.String())
+}
@@ -0,0 +1,79 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+// msgSignDataType is the amino type of the msg of ADR-036 off-chain sign docs
+const msgSignDataType = "sign/MsgSignData"
+
+// SignedMessage is an off-chain message signed by an account, e.g. to log in to a service with a Fetch account.
+// The signature is made over the ADR-036 sign doc of MessageSignBytes, which wallets and ledgers sign like a tx.
+type SignedMessage struct {
+	Signer sdk.AccAddress `json:"signer"`
+	Data   []byte         `json:"data"`
+	// PubKey is the bech32 encoded account public key of the signer
+	PubKey    string `json:"pub_key"`
+	Signature []byte `json:"signature"`
+}
+
+// MessageSignBytes returns the ADR-036 sign bytes of data signed by signer: the amino json sign doc of a tx with an
+// empty chain id, fee and memo, account number and sequence 0, and a single sign/MsgSignData msg. They can never be
+// valid sign bytes of a tx of a chain.
+func MessageSignBytes(signer sdk.AccAddress, data []byte) []byte {
+	return auth.StdSignBytes("", 0, 0, auth.StdFee{}, []sdk.Msg{msgSignData{Signer: signer, Data: data}}, "")
+}
+
+// VerifyMessage verifies that the message was signed by the key of the public key and that it belongs to the signer
+func VerifyMessage(msg SignedMessage) error {
+	pubKey, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeAccPub, msg.PubKey)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+	}
+	if !sdk.AccAddress(pubKey.Address()).Equals(msg.Signer) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "pub key does not belong to signer %s", msg.Signer)
+	}
+	if !pubKey.VerifyBytes(MessageSignBytes(msg.Signer, msg.Data), msg.Signature) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "signature verification failed")
+	}
+	return nil
+}
+
+// msgSignData is the msg of ADR-036 sign docs, it is only signed and never part of a tx
+type msgSignData struct {
+	Signer sdk.AccAddress `json:"signer"`
+	Data   []byte         `json:"data"`
+}
+
+func (m msgSignData) Route() string { return "sign" }
+
+func (m msgSignData) Type() string { return "MsgSignData" }
+
+func (m msgSignData) ValidateBasic() error {
+	if m.Signer.Empty() {
+		return sdkerrors.ErrInvalidAddress
+	}
+	return nil
+}
+
+// GetSignBytes returns the msg as amino json with sorted keys, which the codec of the app would produce if the msg
+// was registered
+func (m msgSignData) GetSignBytes() []byte {
+	bz, err := json.Marshal(struct {
+		Type  string      `json:"type"`
+		Value msgSignData `json:"value"`
+	}{Type: msgSignDataType, Value: m})
+	if err != nil {
+		panic(fmt.Sprintf("marshal sign data: %s", err))
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+func (m msgSignData) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
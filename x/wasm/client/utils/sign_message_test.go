@@ -0,0 +1,52 @@
+package utils
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+func TestMessageSignBytes(t *testing.T) {
+	signer := sdk.AccAddress(make([]byte, sdk.AddrLen))
+	exp := `{"account_number":"0","chain_id":"","fee":{"amount":[],"gas":"0"},"memo":"","msgs":[{"type":"sign/MsgSignData","value":{"data":"aGVsbG8=","signer":"` + signer.String() + `"}}],"sequence":"0"}`
+	assert.Equal(t, exp, string(MessageSignBytes(signer, []byte("hello"))))
+}
+
+func TestVerifyMessage(t *testing.T) {
+	privKey := secp256k1.GenPrivKey()
+	signer := sdk.AccAddress(privKey.PubKey().Address())
+	bechPubKey, err := sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, privKey.PubKey())
+	require.NoError(t, err)
+	otherKey, err := sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	sig, err := privKey.Sign(MessageSignBytes(signer, []byte("login nonce 1")))
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		mutate func(*SignedMessage)
+		expErr bool
+	}{
+		"valid":             {mutate: func(*SignedMessage) {}},
+		"other data":        {mutate: func(m *SignedMessage) { m.Data = []byte("login nonce 2") }, expErr: true},
+		"other signer":      {mutate: func(m *SignedMessage) { m.Signer = sdk.AccAddress(make([]byte, sdk.AddrLen)) }, expErr: true},
+		"other pub key":     {mutate: func(m *SignedMessage) { m.PubKey = otherKey }, expErr: true},
+		"invalid pub key":   {mutate: func(m *SignedMessage) { m.PubKey = "invalid" }, expErr: true},
+		"signature of data": {mutate: func(m *SignedMessage) { m.Signature, _ = privKey.Sign([]byte("login nonce 1")) }, expErr: true},
+		"empty signature":   {mutate: func(m *SignedMessage) { m.Signature = nil }, expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			m := SignedMessage{Signer: signer, Data: []byte("login nonce 1"), PubKey: bechPubKey, Signature: sig}
+			spec.mutate(&m)
+			err := VerifyMessage(m)
+			if spec.expErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
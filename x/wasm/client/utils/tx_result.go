@@ -0,0 +1,163 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+// outputJSON is the value of the --output flag for machine readable output
+const outputJSON = "json"
+
+// TxResult extends the json output of a tx response with the code ids stored and the contracts instantiated by the
+// tx, and the decoded result of every message. The lists are empty instead of missing when the result is not known
+// yet, e.g. for a sync broadcast.
+type TxResult struct {
+	CodeIDs           []uint64    `json:"code_ids"`
+	ContractAddresses []string    `json:"contract_addresses"`
+	Messages          []MsgResult `json:"messages"`
+}
+
+// MsgResult is the outcome of a single message of a tx
+type MsgResult struct {
+	MsgIndex        uint16           `json:"msg_index"`
+	Action          string           `json:"action"`
+	CodeID          uint64           `json:"code_id,omitempty"`
+	ContractAddress string           `json:"contract_address,omitempty"`
+	ResultData      string           `json:"result_data,omitempty"`
+	Events          sdk.StringEvents `json:"events"`
+}
+
+// NewTxResult extracts the wasm results from the message logs of the tx response
+func NewTxResult(res sdk.TxResponse) (TxResult, error) {
+	result := TxResult{CodeIDs: []uint64{}, ContractAddresses: []string{}, Messages: []MsgResult{}}
+	for _, log := range res.Logs {
+		msg := MsgResult{MsgIndex: log.MsgIndex, Events: log.Events}
+		if msg.Events == nil {
+			msg.Events = sdk.StringEvents{}
+		}
+		for _, e := range log.Events {
+			if e.Type != sdk.EventTypeMessage {
+				continue
+			}
+			for _, a := range e.Attributes {
+				switch {
+				case a.Key == sdk.AttributeKeyAction && msg.Action == "":
+					msg.Action = a.Value
+				case a.Key == types.AttributeKeyCodeID && msg.CodeID == 0:
+					codeID, err := strconv.ParseUint(a.Value, 10, 64)
+					if err != nil {
+						return result, fmt.Errorf("code id of msg %d: %w", log.MsgIndex, err)
+					}
+					msg.CodeID = codeID
+				case a.Key == types.AttributeKeyContract && msg.ContractAddress == "":
+					msg.ContractAddress = a.Value
+				}
+			}
+		}
+		data, err := types.ParseResultData(res.Logs, log.MsgIndex)
+		if err != nil {
+			return result, fmt.Errorf("result data of msg %d: %w", log.MsgIndex, err)
+		}
+		if len(data) != 0 {
+			msg.ResultData = DecodeResultData(data)
+		}
+
+		switch msg.Action {
+		case types.MsgStoreCode{}.Type():
+			result.CodeIDs = append(result.CodeIDs, msg.CodeID)
+		case types.MsgInstantiateContract{}.Type():
+			result.ContractAddresses = append(result.ContractAddresses, msg.ContractAddress)
+		}
+		result.Messages = append(result.Messages, msg)
+	}
+	return result, nil
+}
+
+// MarshalTxResult renders the tx response as json with the fields of the TxResult added at the top level
+func MarshalTxResult(cdc *codec.Codec, res sdk.TxResponse, indent bool) ([]byte, error) {
+	result, err := NewTxResult(res)
+	if err != nil {
+		return nil, err
+	}
+	bz, err := cdc.MarshalJSON(res)
+	if err != nil {
+		return nil, err
+	}
+	fields := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(bz, &fields); err != nil {
+		return nil, err
+	}
+	bz, err = json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(bz, &fields); err != nil {
+		return nil, err
+	}
+	if indent {
+		return json.MarshalIndent(fields, "", "  ")
+	}
+	return json.Marshal(fields)
+}
+
+// PrintTxResult prints the tx response like the sdk followed by the code ids and contract addresses created by the
+// tx, with --output json as TxResult
+func PrintTxResult(cliCtx context.CLIContext, res sdk.TxResponse) error {
+	if cliCtx.OutputFormat != outputJSON {
+		if err := cliCtx.PrintOutput(res); err != nil {
+			return err
+		}
+		result, err := NewTxResult(res)
+		if err != nil {
+			return err
+		}
+		return PrintCreated(cliCtx.Output, result)
+	}
+	bz, err := MarshalTxResult(cliCtx.Codec, res, cliCtx.Indent)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(cliCtx.Output, "%s\n", bz)
+	return err
+}
+
+// PrintCreated prints a `code_id:` line for every code stored and a `contract_address:` line for every contract
+// instantiated by the tx
+func PrintCreated(w io.Writer, result TxResult) error {
+	for _, id := range result.CodeIDs {
+		if _, err := fmt.Fprintf(w, "code_id: %d\n", id); err != nil {
+			return err
+		}
+	}
+	for _, addr := range result.ContractAddresses {
+		if _, err := fmt.Fprintf(w, "contract_address: %s\n", addr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PrintStoredFiles prints a `<file>: <code id>` line for every wasm file stored by the tx, given in the order of the
+// store code messages. Nothing is printed when the result is not known yet.
+func PrintStoredFiles(w io.Writer, files []string, result TxResult) error {
+	if len(result.CodeIDs) == 0 {
+		return nil
+	}
+	if len(result.CodeIDs) != len(files) {
+		return fmt.Errorf("%d code ids for %d files", len(result.CodeIDs), len(files))
+	}
+	for i, file := range files {
+		if _, err := fmt.Fprintf(w, "%s: %d\n", file, result.CodeIDs[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
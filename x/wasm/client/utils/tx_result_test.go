@@ -0,0 +1,162 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+func TestNewTxResult(t *testing.T) {
+	contract := "cosmos18vd8fpwxzck93qlwghaj6arh4p7c5n89uzcee5"
+	msgEvents := func(attrs ...sdk.Attribute) sdk.StringEvents {
+		return sdk.StringifyEvents(sdk.Events{sdk.NewEvent(sdk.EventTypeMessage, attrs...)}.ToABCIEvents())
+	}
+	storeEvents := msgEvents(
+		sdk.NewAttribute(sdk.AttributeKeyAction, "store-code"),
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyCodeID, "3"),
+	)
+	instantiateEvents := msgEvents(
+		sdk.NewAttribute(sdk.AttributeKeyAction, "instantiate"),
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyCodeID, "3"),
+		sdk.NewAttribute(types.AttributeKeyContract, contract),
+	)
+	executeEvents := types.WithResultData(sdk.NewEvent(sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyAction, "execute"),
+		sdk.NewAttribute(types.AttributeKeyContract, contract),
+	), []byte(`{"count":1}`))
+
+	specs := map[string]struct {
+		src    sdk.TxResponse
+		exp    TxResult
+		expErr bool
+	}{
+		"store and instantiate": {
+			src: sdk.TxResponse{Logs: sdk.ABCIMessageLogs{
+				sdk.NewABCIMessageLog(0, "", storeEvents),
+				sdk.NewABCIMessageLog(1, "", instantiateEvents),
+			}},
+			exp: TxResult{
+				CodeIDs:           []uint64{3},
+				ContractAddresses: []string{contract},
+				Messages: []MsgResult{
+					{MsgIndex: 0, Action: "store-code", CodeID: 3, Events: storeEvents},
+					{MsgIndex: 1, Action: "instantiate", CodeID: 3, ContractAddress: contract, Events: instantiateEvents},
+				},
+			},
+		},
+		"execute with result data": {
+			src: sdk.TxResponse{Logs: sdk.ABCIMessageLogs{
+				sdk.NewABCIMessageLog(0, "", sdk.StringifyEvents(sdk.Events{executeEvents}.ToABCIEvents())),
+			}},
+			exp: TxResult{
+				CodeIDs:           []uint64{},
+				ContractAddresses: []string{},
+				Messages: []MsgResult{{
+					MsgIndex:        0,
+					Action:          "execute",
+					ContractAddress: contract,
+					ResultData:      `{"count":1}`,
+					Events:          sdk.StringifyEvents(sdk.Events{executeEvents}.ToABCIEvents()),
+				}},
+			},
+		},
+		"no logs": {
+			src: sdk.TxResponse{TxHash: "ABCD"},
+			exp: TxResult{CodeIDs: []uint64{}, ContractAddresses: []string{}, Messages: []MsgResult{}},
+		},
+		"invalid code id": {
+			src: sdk.TxResponse{Logs: sdk.ABCIMessageLogs{
+				sdk.NewABCIMessageLog(0, "", msgEvents(sdk.NewAttribute(types.AttributeKeyCodeID, "x"))),
+			}},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, err := NewTxResult(spec.src)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
+
+func TestMarshalTxResult(t *testing.T) {
+	res := sdk.TxResponse{
+		Height: 7,
+		TxHash: "ABCD",
+		Logs: sdk.ABCIMessageLogs{sdk.NewABCIMessageLog(0, "", sdk.StringifyEvents(sdk.Events{sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyAction, "store-code"),
+			sdk.NewAttribute(types.AttributeKeyCodeID, "1"),
+		)}.ToABCIEvents()))},
+	}
+	bz, err := MarshalTxResult(codec.New(), res, false)
+	require.NoError(t, err)
+
+	var got map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(bz, &got))
+	assert.JSONEq(t, `"7"`, string(got["height"]))
+	assert.JSONEq(t, `"ABCD"`, string(got["txhash"]))
+	assert.JSONEq(t, `[1]`, string(got["code_ids"]))
+	assert.JSONEq(t, `[]`, string(got["contract_addresses"]))
+	assert.Contains(t, got, "logs")
+	assert.Contains(t, got, "messages")
+}
+
+func TestPrintCreated(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, PrintCreated(&buf, TxResult{
+		CodeIDs:           []uint64{1, 2},
+		ContractAddresses: []string{"cosmos18vd8fpwxzck93qlwghaj6arh4p7c5n89uzcee5"},
+	}))
+	assert.Equal(t, "code_id: 1\ncode_id: 2\ncontract_address: cosmos18vd8fpwxzck93qlwghaj6arh4p7c5n89uzcee5\n", buf.String())
+
+	buf.Reset()
+	require.NoError(t, PrintCreated(&buf, TxResult{}))
+	assert.Empty(t, buf.String())
+}
+
+func TestPrintStoredFiles(t *testing.T) {
+	files := []string{"dex/factory.wasm", "dex/pair.wasm"}
+	specs := map[string]struct {
+		src    TxResult
+		exp    string
+		expErr bool
+	}{
+		"all stored": {
+			src: TxResult{CodeIDs: []uint64{7, 8}},
+			exp: "dex/factory.wasm: 7\ndex/pair.wasm: 8\n",
+		},
+		"not committed": {
+			src: TxResult{},
+		},
+		"code ids missing": {
+			src:    TxResult{CodeIDs: []uint64{7}},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := PrintStoredFiles(&buf, files, spec.src)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.exp, buf.String())
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+)
+
+const (
+	FlagWait        = "wait"
+	FlagWaitTimeout = "wait-timeout"
+
+	DefaultWaitTimeout = time.Minute
+	waitPollInterval   = time.Second
+)
+
+// ValidateBroadcastMode fails on modes other than block, sync and async before anything is signed
+func ValidateBroadcastMode(mode string) error {
+	switch mode {
+	case flags.BroadcastBlock, flags.BroadcastSync, flags.BroadcastAsync:
+		return nil
+	default:
+		return fmt.Errorf("unsupported broadcast mode %q, use %s, %s or %s", mode, flags.BroadcastBlock, flags.BroadcastSync, flags.BroadcastAsync)
+	}
+}
+
+// WaitForTx polls the node until the broadcast tx is included in a block and returns its result with the events.
+// Txs rejected by the mempool are returned as they are, they never get included.
+func WaitForTx(cliCtx context.CLIContext, res sdk.TxResponse, timeout time.Duration) (sdk.TxResponse, error) {
+	if res.Code != 0 {
+		return res, nil
+	}
+	_, _ = fmt.Fprintf(os.Stderr, "waiting for tx %s to be included\n", res.TxHash)
+	return pollTx(func(hash string) (sdk.TxResponse, error) {
+		return utils.QueryTx(cliCtx, hash)
+	}, res.TxHash, timeout, waitPollInterval)
+}
+
+// pollTx queries the tx every interval until it is found or the timeout elapsed
+func pollTx(query func(hash string) (sdk.TxResponse, error), hash string, timeout, interval time.Duration) (sdk.TxResponse, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		res, err := query(hash)
+		if err == nil {
+			return res, nil
+		}
+		if !time.Now().Add(interval).Before(deadline) {
+			return sdk.TxResponse{}, fmt.Errorf("tx %s not included within %s, last query: %w", hash, timeout, err)
+		}
+		time.Sleep(interval)
+	}
+}
@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPollTx(t *testing.T) {
+	notFound := errors.New("tx not found")
+	specs := map[string]struct {
+		foundAfter int
+		expErr     bool
+		expQueries int
+	}{
+		"included": {
+			foundAfter: 0,
+			expQueries: 1,
+		},
+		"included after polling": {
+			foundAfter: 2,
+			expQueries: 3,
+		},
+		"timeout": {
+			foundAfter: 100,
+			expErr:     true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			var queries int
+			query := func(hash string) (sdk.TxResponse, error) {
+				queries++
+				if queries <= spec.foundAfter {
+					return sdk.TxResponse{}, notFound
+				}
+				return sdk.TxResponse{TxHash: hash, Height: 7}, nil
+			}
+			res, err := pollTx(query, "ABCD", 50*time.Millisecond, 10*time.Millisecond)
+			if spec.expErr {
+				require.Error(t, err)
+				assert.True(t, errors.Is(err, notFound))
+				assert.True(t, queries > 1, "polled only %d times", queries)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, sdk.TxResponse{TxHash: "ABCD", Height: 7}, res)
+			assert.Equal(t, spec.expQueries, queries)
+		})
+	}
+}
+
+func TestValidateBroadcastMode(t *testing.T) {
+	for _, mode := range []string{"block", "sync", "async"} {
+		assert.NoError(t, ValidateBroadcastMode(mode), mode)
+	}
+	assert.Error(t, ValidateBroadcastMode("commit"))
+	assert.Error(t, ValidateBroadcastMode(""))
+}
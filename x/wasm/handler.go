@@ -26,6 +26,24 @@ func NewHandler(k Keeper) sdk.Handler {
 			return handleUpdateContractAdmin(ctx, k, &msg)
 		case MsgClearAdmin:
 			return handleClearContractAdmin(ctx, k, &msg)
+		case MsgPauseExecution:
+			return handlePauseExecution(ctx, k, &msg)
+		case MsgResumeExecution:
+			return handleResumeExecution(ctx, k, &msg)
+		case MsgGrantContractExecution:
+			return handleGrantContractExecution(ctx, k, &msg)
+		case MsgRevokeContractExecution:
+			return handleRevokeContractExecution(ctx, k, &msg)
+		case MsgExec:
+			return handleExec(ctx, k, &msg)
+		case MsgSetContractMetadata:
+			return handleSetContractMetadata(ctx, k, &msg)
+		case MsgSetContractRewardAddress:
+			return handleSetContractRewardAddress(ctx, k, &msg)
+		case MsgWithdrawContractRewards:
+			return handleWithdrawContractRewards(ctx, k, &msg)
+		case MsgSetContractLimits:
+			return handleSetContractLimits(ctx, k, &msg)
 		default:
 			errMsg := fmt.Sprintf("unrecognized wasm message type: %T", msg)
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
@@ -52,7 +70,12 @@ func handleStoreCode(ctx sdk.Context, k Keeper, msg *MsgStoreCode) (*sdk.Result,
 		return nil, err
 	}
 
-	codeID, err := k.Create(ctx, msg.Sender, msg.WASMByteCode, msg.Source, msg.Builder, msg.InstantiatePermission)
+	var codeID uint64
+	if msg.NoDedup {
+		codeID, err = k.Create(ctx, msg.Sender, msg.WASMByteCode, msg.Source, msg.Builder, msg.InstantiatePermission)
+	} else {
+		codeID, err = k.CreateDeduplicated(ctx, msg.Sender, msg.WASMByteCode, msg.Source, msg.Builder, msg.InstantiatePermission)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -72,7 +95,7 @@ func handleStoreCode(ctx sdk.Context, k Keeper, msg *MsgStoreCode) (*sdk.Result,
 }
 
 func handleInstantiate(ctx sdk.Context, k Keeper, msg *MsgInstantiateContract) (*sdk.Result, error) {
-	contractAddr, err := k.Instantiate(ctx, msg.CodeID, msg.Sender, msg.Admin, msg.InitMsg, msg.Label, msg.InitFunds)
+	contractAddr, data, err := k.Instantiate(ctx, msg.CodeID, msg.Sender, msg.Admin, msg.InitMsg, msg.Label, msg.InitFunds)
 	if err != nil {
 		return nil, err
 	}
@@ -85,6 +108,8 @@ func handleInstantiate(ctx sdk.Context, k Keeper, msg *MsgInstantiateContract) (
 		sdk.NewAttribute(types.AttributeKeyCodeID, fmt.Sprintf("%d", msg.CodeID)),
 		sdk.NewAttribute(types.AttributeKeyContract, contractAddr.String()),
 	)
+	// the result data of instantiate is the contract address, the data of the contract is only in the event
+	ourEvent = types.WithResultData(ourEvent, data)
 
 	return &sdk.Result{
 		Data:   contractAddr,
@@ -105,6 +130,7 @@ func handleExecute(ctx sdk.Context, k Keeper, msg *MsgExecuteContract) (*sdk.Res
 		sdk.NewAttribute(types.AttributeKeySigner, msg.Sender.String()),
 		sdk.NewAttribute(types.AttributeKeyContract, msg.Contract.String()),
 	)
+	ourEvent = types.WithResultData(ourEvent, res.Data)
 
 	res.Events = append(events, ourEvent)
 	return res, nil
@@ -123,6 +149,7 @@ func handleMigration(ctx sdk.Context, k Keeper, msg *MsgMigrateContract) (*sdk.R
 		sdk.NewAttribute(types.AttributeKeySigner, msg.Sender.String()),
 		sdk.NewAttribute(types.AttributeKeyContract, msg.Contract.String()),
 	)
+	ourEvent = types.WithResultData(ourEvent, res.Data)
 	res.Events = append(events, ourEvent)
 	return res, nil
 }
@@ -158,3 +185,155 @@ func handleClearContractAdmin(ctx sdk.Context, k Keeper, msg *MsgClearAdmin) (*s
 		Events: append(events, ourEvent),
 	}, nil
 }
+
+func handleSetContractMetadata(ctx sdk.Context, k Keeper, msg *MsgSetContractMetadata) (*sdk.Result, error) {
+	if err := k.SetContractMetadata(ctx, msg.Contract, msg.Sender, msg.Metadata); err != nil {
+		return nil, err
+	}
+	events := ctx.EventManager().Events()
+	ourEvent := sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(types.AttributeKeySigner, msg.Sender.String()),
+		sdk.NewAttribute(types.AttributeKeyContract, msg.Contract.String()),
+	)
+	return &sdk.Result{
+		Events: append(events, ourEvent),
+	}, nil
+}
+
+func handleSetContractLimits(ctx sdk.Context, k Keeper, msg *MsgSetContractLimits) (*sdk.Result, error) {
+	if err := k.SetContractLimits(ctx, msg.Contract, msg.Sender, msg.Limits); err != nil {
+		return nil, err
+	}
+	events := ctx.EventManager().Events()
+	ourEvent := sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(types.AttributeKeySigner, msg.Sender.String()),
+		sdk.NewAttribute(types.AttributeKeyContract, msg.Contract.String()),
+	)
+	return &sdk.Result{
+		Events: append(events, ourEvent),
+	}, nil
+}
+
+func handleSetContractRewardAddress(ctx sdk.Context, k Keeper, msg *MsgSetContractRewardAddress) (*sdk.Result, error) {
+	if err := k.SetContractRewardAddress(ctx, msg.Contract, msg.Sender, msg.RewardAddress); err != nil {
+		return nil, err
+	}
+	events := ctx.EventManager().Events()
+	ourEvent := sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(types.AttributeKeySigner, msg.Sender.String()),
+		sdk.NewAttribute(types.AttributeKeyContract, msg.Contract.String()),
+		sdk.NewAttribute(types.AttributeKeyRewardAddress, msg.RewardAddress.String()),
+	)
+	return &sdk.Result{
+		Events: append(events, ourEvent),
+	}, nil
+}
+
+func handleWithdrawContractRewards(ctx sdk.Context, k Keeper, msg *MsgWithdrawContractRewards) (*sdk.Result, error) {
+	amount, err := k.WithdrawContractRewards(ctx, msg.RewardAddress)
+	if err != nil {
+		return nil, err
+	}
+	events := ctx.EventManager().Events()
+	ourEvent := sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(types.AttributeKeySigner, msg.RewardAddress.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+	)
+	return &sdk.Result{
+		Events: append(events, ourEvent),
+	}, nil
+}
+
+func handlePauseExecution(ctx sdk.Context, k Keeper, msg *MsgPauseExecution) (*sdk.Result, error) {
+	if err := k.PauseExecution(ctx, msg.Sender, msg.Contract, msg.CodeID); err != nil {
+		return nil, err
+	}
+	events := ctx.EventManager().Events()
+	return &sdk.Result{
+		Events: append(events, pauseTargetEvent(msg.Sender, msg.Contract, msg.CodeID)),
+	}, nil
+}
+
+func handleResumeExecution(ctx sdk.Context, k Keeper, msg *MsgResumeExecution) (*sdk.Result, error) {
+	if err := k.ResumeExecution(ctx, msg.Sender, msg.Contract, msg.CodeID); err != nil {
+		return nil, err
+	}
+	events := ctx.EventManager().Events()
+	return &sdk.Result{
+		Events: append(events, pauseTargetEvent(msg.Sender, msg.Contract, msg.CodeID)),
+	}, nil
+}
+
+func pauseTargetEvent(signer, contract sdk.AccAddress, codeID uint64) sdk.Event {
+	target := sdk.NewAttribute(types.AttributeKeyCodeID, fmt.Sprintf("%d", codeID))
+	if len(contract) != 0 {
+		target = sdk.NewAttribute(types.AttributeKeyContract, contract.String())
+	}
+	return sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(types.AttributeKeySigner, signer.String()),
+		target,
+	)
+}
+
+func handleGrantContractExecution(ctx sdk.Context, k Keeper, msg *MsgGrantContractExecution) (*sdk.Result, error) {
+	if err := k.GrantContractExecution(ctx, msg.Granter, msg.Grantee, msg.Authorization); err != nil {
+		return nil, err
+	}
+	events := ctx.EventManager().Events()
+	return &sdk.Result{
+		Events: append(events, grantEvent(msg.Granter, msg.Grantee, msg.Authorization.Contract, msg.Granter)),
+	}, nil
+}
+
+func handleRevokeContractExecution(ctx sdk.Context, k Keeper, msg *MsgRevokeContractExecution) (*sdk.Result, error) {
+	if err := k.RevokeContractExecution(ctx, msg.Granter, msg.Grantee, msg.Contract); err != nil {
+		return nil, err
+	}
+	events := ctx.EventManager().Events()
+	return &sdk.Result{
+		Events: append(events, grantEvent(msg.Granter, msg.Grantee, msg.Contract, msg.Granter)),
+	}, nil
+}
+
+// handleExec executes the contracts on behalf of the granters. The result data is the one of the last execution.
+func handleExec(ctx sdk.Context, k Keeper, msg *MsgExec) (*sdk.Result, error) {
+	var (
+		data      []byte
+		ourEvents sdk.Events
+	)
+	for _, execMsg := range msg.Msgs {
+		res, err := k.ExecuteAsGrantee(ctx, msg.Grantee, execMsg)
+		if err != nil {
+			return nil, err
+		}
+		data = res.Data
+		ourEvents = append(ourEvents, types.WithResultData(grantEvent(execMsg.Sender, msg.Grantee, execMsg.Contract, msg.Grantee), res.Data))
+	}
+
+	events := filterMessageEvents(ctx.EventManager())
+	return &sdk.Result{
+		Data:   data,
+		Events: append(events, ourEvents...),
+	}, nil
+}
+
+func grantEvent(granter, grantee, contract, signer sdk.AccAddress) sdk.Event {
+	return sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(types.AttributeKeySigner, signer.String()),
+		sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+		sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+		sdk.NewAttribute(types.AttributeKeyContract, contract.String()),
+	)
+}
@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+// GrantContractExecution allows the grantee to execute the contract of the authorization on behalf of the granter.
+// An existing grant for the same contract is replaced.
+func (k Keeper) GrantContractExecution(ctx sdk.Context, granter, grantee sdk.AccAddress, authorization types.ContractExecutionAuthorization) error {
+	grant := types.ContractExecutionGrant{Granter: granter, Grantee: grantee, Authorization: authorization}
+	if err := grant.ValidateBasic(); err != nil {
+		return err
+	}
+	if !k.containsContractInfo(ctx, authorization.Contract) {
+		return sdkerrors.Wrap(types.ErrNotFound, "contract")
+	}
+	k.setContractExecutionGrant(ctx, grant)
+	return nil
+}
+
+// RevokeContractExecution removes the grant of the granter to the grantee for the contract
+func (k Keeper) RevokeContractExecution(ctx sdk.Context, granter, grantee, contractAddr sdk.AccAddress) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetContractExecutionGrantKey(granter, grantee, contractAddr)
+	if !store.Has(key) {
+		return sdkerrors.Wrap(types.ErrNotFound, "grant")
+	}
+	store.Delete(key)
+	return nil
+}
+
+// GetContractExecutionAuthorization returns the authorization of the granter to the grantee for the contract,
+// nil when there is none
+func (k Keeper) GetContractExecutionAuthorization(ctx sdk.Context, granter, grantee, contractAddr sdk.AccAddress) *types.ContractExecutionAuthorization {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetContractExecutionGrantKey(granter, grantee, contractAddr))
+	if bz == nil {
+		return nil
+	}
+	var grant types.ContractExecutionGrant
+	k.cdc.MustUnmarshalBinaryBare(bz, &grant)
+	return &grant.Authorization
+}
+
+// ExecuteAsGrantee executes the contract on behalf of the sender of the msg, who must have granted the execution to
+// the grantee. The funds sent to the contract are deducted from the grant.
+func (k Keeper) ExecuteAsGrantee(ctx sdk.Context, grantee sdk.AccAddress, msg types.MsgExecuteContract) (*sdk.Result, error) {
+	authorization := k.GetContractExecutionAuthorization(ctx, msg.Sender, grantee, msg.Contract)
+	if authorization == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "no grant of %s for contract %s", msg.Sender, msg.Contract)
+	}
+	updated, err := authorization.Accept(msg)
+	if err != nil {
+		return nil, err
+	}
+	k.setContractExecutionGrant(ctx, types.ContractExecutionGrant{Granter: msg.Sender, Grantee: grantee, Authorization: updated})
+	return k.Execute(ctx, msg.Contract, msg.Sender, msg.Msg, msg.SentFunds)
+}
+
+// IterateContractExecutionGrantsOf calls cb with every grant of the granter to the grantee, in contract address
+// order. cb returns true to stop early.
+func (k Keeper) IterateContractExecutionGrantsOf(ctx sdk.Context, granter, grantee sdk.AccAddress, cb func(types.ContractExecutionGrant) bool) {
+	k.iterateContractExecutionGrants(ctx, types.GetContractExecutionGrantPrefix(granter, grantee), cb)
+}
+
+// IterateContractExecutionGrants calls cb with every grant. cb returns true to stop early.
+func (k Keeper) IterateContractExecutionGrants(ctx sdk.Context, cb func(types.ContractExecutionGrant) bool) {
+	k.iterateContractExecutionGrants(ctx, types.ContractExecutionGrantPrefix, cb)
+}
+
+func (k Keeper) iterateContractExecutionGrants(ctx sdk.Context, grantPrefix []byte, cb func(types.ContractExecutionGrant) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), grantPrefix).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var grant types.ContractExecutionGrant
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &grant)
+		if cb(grant) {
+			break
+		}
+	}
+}
+
+func (k Keeper) setContractExecutionGrant(ctx sdk.Context, grant types.ContractExecutionGrant) {
+	key := types.GetContractExecutionGrantKey(grant.Granter, grant.Grantee, grant.Authorization.Contract)
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshalBinaryBare(grant))
+}
+
+func (k Keeper) importContractExecutionGrant(ctx sdk.Context, grant types.ContractExecutionGrant) error {
+	if !k.containsContractInfo(ctx, grant.Authorization.Contract) {
+		return sdkerrors.Wrapf(types.ErrNotFound, "contract %s", grant.Authorization.Contract)
+	}
+	k.setContractExecutionGrant(ctx, grant)
+	return nil
+}
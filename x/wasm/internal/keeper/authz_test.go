@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+func TestExecuteAsGrantee(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keepers := CreateTestInput(t, false, tempDir, SupportedFeatures, nil, nil)
+	accKeeper, keeper := keepers.AccountKeeper, keepers.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, deposit)
+	// the verifier is the granter, only it can release the funds of the contract
+	verifier := createFakeFundedAccount(ctx, accKeeper, deposit)
+	grantee := createFakeFundedAccount(ctx, accKeeper, deposit)
+	_, _, beneficiary := keyPubAddr()
+
+	wasmCode, err := ioutil.ReadFile("./testdata/contract.wasm")
+	require.NoError(t, err)
+	codeID, err := keeper.Create(ctx, creator, wasmCode, "", "", nil)
+	require.NoError(t, err)
+	initMsgBz, err := json.Marshal(InitMsg{Verifier: verifier, Beneficiary: beneficiary})
+	require.NoError(t, err)
+	contractAddr, _, err := keeper.Instantiate(ctx, codeID, creator, nil, initMsgBz, "demo contract", sdk.NewCoins(sdk.NewInt64Coin("denom", 100)))
+	require.NoError(t, err)
+
+	release := func(funds sdk.Coins) types.MsgExecuteContract {
+		return types.MsgExecuteContract{Sender: verifier, Contract: contractAddr, Msg: []byte(`{"release":{}}`), SentFunds: funds}
+	}
+	specs := map[string]struct {
+		grant       *types.ContractExecutionAuthorization
+		msg         types.MsgExecuteContract
+		expMaxFunds sdk.Coins
+		expErr      *sdkerrors.Error
+	}{
+		"granted": {
+			grant:       &types.ContractExecutionAuthorization{Contract: contractAddr, Messages: []string{"release"}, MaxFunds: sdk.NewCoins(sdk.NewInt64Coin("denom", 10))},
+			msg:         release(sdk.NewCoins(sdk.NewInt64Coin("denom", 4))),
+			expMaxFunds: sdk.NewCoins(sdk.NewInt64Coin("denom", 6)),
+		},
+		"without grant": {
+			msg:    release(nil),
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"message not granted": {
+			grant:  &types.ContractExecutionAuthorization{Contract: contractAddr, Messages: []string{"transfer"}},
+			msg:    release(nil),
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"funds exceed grant": {
+			grant:  &types.ContractExecutionAuthorization{Contract: contractAddr, MaxFunds: sdk.NewCoins(sdk.NewInt64Coin("denom", 1))},
+			msg:    release(sdk.NewCoins(sdk.NewInt64Coin("denom", 2))),
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"grant of another granter": {
+			grant:  &types.ContractExecutionAuthorization{Contract: contractAddr},
+			msg:    types.MsgExecuteContract{Sender: creator, Contract: contractAddr, Msg: []byte(`{"release":{}}`)},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			trialCtx := ctx.WithMultiStore(ctx.MultiStore().CacheWrap().(sdk.MultiStore))
+			if spec.grant != nil {
+				require.NoError(t, keeper.GrantContractExecution(trialCtx, verifier, grantee, *spec.grant))
+			}
+
+			_, err := keeper.ExecuteAsGrantee(trialCtx, grantee, spec.msg)
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(err), err)
+				return
+			}
+			require.NoError(t, err)
+			// the contract released its funds to the beneficiary
+			assert.False(t, accKeeper.GetAccount(trialCtx, beneficiary).GetCoins().IsZero())
+			got := keeper.GetContractExecutionAuthorization(trialCtx, verifier, grantee, contractAddr)
+			require.NotNil(t, got)
+			assert.Equal(t, spec.expMaxFunds, got.MaxFunds)
+
+			require.NoError(t, keeper.RevokeContractExecution(trialCtx, verifier, grantee, contractAddr))
+			_, err = keeper.ExecuteAsGrantee(trialCtx, grantee, spec.msg)
+			assert.True(t, sdkerrors.ErrUnauthorized.Is(err), err)
+		})
+	}
+}
+
+func TestGrantContractExecution(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keepers := CreateTestInput(t, false, tempDir, SupportedFeatures, nil, nil)
+	keeper := keepers.WasmKeeper
+
+	_, _, granter := keyPubAddr()
+	_, _, grantee := keyPubAddr()
+	_, _, nonExistingContract := keyPubAddr()
+	err = keeper.GrantContractExecution(ctx, granter, grantee, types.ContractExecutionAuthorization{Contract: nonExistingContract})
+	assert.True(t, types.ErrNotFound.Is(err), err)
+
+	err = keeper.RevokeContractExecution(ctx, granter, grantee, nonExistingContract)
+	assert.True(t, types.ErrNotFound.Is(err), err)
+
+	var grants []types.ContractExecutionGrant
+	keeper.IterateContractExecutionGrants(ctx, func(grant types.ContractExecutionGrant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+	assert.Empty(t, grants)
+}
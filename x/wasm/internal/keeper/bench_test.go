@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// benchmarks of the keeper with the hackatom contract, run with
+// go test ./x/wasm/internal/keeper -run none -bench . -benchmem
+
+func BenchmarkStoreCode(b *testing.B) {
+	tempDir, err := ioutil.TempDir("", "wasm")
+	require.NoError(b, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keepers := CreateTestInput(b, false, tempDir, SupportedFeatures, nil, nil)
+	creator := createFakeFundedAccount(ctx, keepers.AccountKeeper, nil)
+	wasmCode, err := ioutil.ReadFile("./testdata/contract.wasm")
+	require.NoError(b, err)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := keepers.WasmKeeper.Create(ctx, creator, wasmCode, "", "", nil)
+		require.NoError(b, err)
+	}
+}
+
+func BenchmarkInstantiate(b *testing.B) {
+	tempDir, err := ioutil.TempDir("", "wasm")
+	require.NoError(b, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keepers := CreateTestInput(b, false, tempDir, SupportedFeatures, nil, nil)
+	keeper := keepers.WasmKeeper
+	creator := createFakeFundedAccount(ctx, keepers.AccountKeeper, nil)
+	codeID := benchStoreHackatom(b, ctx, keeper, creator)
+	initMsgBz, err := json.Marshal(InitMsg{Verifier: creator, Beneficiary: creator})
+	require.NoError(b, err)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _, err := keeper.Instantiate(ctx, codeID, creator, nil, initMsgBz, "bench", nil)
+		require.NoError(b, err)
+	}
+}
+
+func BenchmarkExecute(b *testing.B) {
+	tempDir, err := ioutil.TempDir("", "wasm")
+	require.NoError(b, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keepers := CreateTestInput(b, false, tempDir, SupportedFeatures, nil, nil)
+	keeper := keepers.WasmKeeper
+	// every release sends the coin deposited with it to the beneficiary
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 1))
+	verifier := createFakeFundedAccount(ctx, keepers.AccountKeeper, sdk.NewCoins(sdk.NewInt64Coin("denom", 1_000_000_000)))
+	_, _, beneficiary := keyPubAddr()
+	codeID := benchStoreHackatom(b, ctx, keeper, verifier)
+	initMsgBz, err := json.Marshal(InitMsg{Verifier: verifier, Beneficiary: beneficiary})
+	require.NoError(b, err)
+	contractAddr, _, err := keeper.Instantiate(ctx, codeID, verifier, nil, initMsgBz, "bench", nil)
+	require.NoError(b, err)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := keeper.Execute(ctx, contractAddr, verifier, []byte(`{"release":{}}`), deposit)
+		require.NoError(b, err)
+	}
+}
+
+func BenchmarkQuerySmart(b *testing.B) {
+	tempDir, err := ioutil.TempDir("", "wasm")
+	require.NoError(b, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keepers := CreateTestInput(b, false, tempDir, SupportedFeatures, nil, nil)
+	keeper := keepers.WasmKeeper
+	creator := createFakeFundedAccount(ctx, keepers.AccountKeeper, nil)
+	codeID := benchStoreHackatom(b, ctx, keeper, creator)
+	initMsgBz, err := json.Marshal(InitMsg{Verifier: creator, Beneficiary: creator})
+	require.NoError(b, err)
+	contractAddr, _, err := keeper.Instantiate(ctx, codeID, creator, nil, initMsgBz, "bench", nil)
+	require.NoError(b, err)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := keeper.QuerySmart(ctx, contractAddr, []byte(`{"verifier":{}}`))
+		require.NoError(b, err)
+	}
+}
+
+func benchStoreHackatom(b *testing.B, ctx sdk.Context, keeper Keeper, creator sdk.AccAddress) uint64 {
+	wasmCode, err := ioutil.ReadFile("./testdata/contract.wasm")
+	require.NoError(b, err)
+	codeID, err := keeper.Create(ctx, creator, wasmCode, "", "", nil)
+	require.NoError(b, err)
+	return codeID
+}
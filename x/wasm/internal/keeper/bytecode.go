@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+// compiledCodes are the checksums of the codes known to be in the VM cache of the node
+type compiledCodes struct {
+	hashes sync.Map
+}
+
+func newCompiledCodes() *compiledCodes {
+	return &compiledCodes{}
+}
+
+func (c *compiledCodes) has(codeHash []byte) bool {
+	_, ok := c.hashes.Load(string(codeHash))
+	return ok
+}
+
+func (c *compiledCodes) add(codeHash []byte) {
+	c.hashes.Store(string(codeHash), struct{}{})
+}
+
+// bytecodeStore returns the store of the wasm module that does not charge gas. Whether the code has to be read from
+// the state depends on the VM cache of the node, so reading it must not cost gas.
+func (k Keeper) bytecodeStore(ctx sdk.Context) sdk.KVStore {
+	return ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).KVStore(k.storeKey)
+}
+
+// storeBytecode keeps the code in the state as uploaded, once per checksum, so that a gzip upload stays compressed. The
+// bytes are the tx input and thus the same on every node, unlike a compression by the node. The write is charged to
+// the gas meter of the context like any other store write.
+func (k Keeper) storeBytecode(ctx sdk.Context, codeHash []byte, uploaded []byte) {
+	key := types.GetCodeBytecodeKey(codeHash)
+	if k.bytecodeStore(ctx).Has(key) {
+		return
+	}
+	ctx.KVStore(k.storeKey).Set(key, uploaded)
+}
+
+// loadBytecode returns the uncompressed wasm code with the checksum from the state, false when it is not stored
+func (k Keeper) loadBytecode(ctx sdk.Context, codeHash []byte) ([]byte, bool, error) {
+	stored := k.bytecodeStore(ctx).Get(types.GetCodeBytecodeKey(codeHash))
+	if stored == nil {
+		return nil, false, nil
+	}
+	wasmCode, err := uncompress(stored)
+	if err != nil {
+		return nil, false, sdkerrors.Wrapf(types.ErrInvalid, "stored code %X: %s", codeHash, err)
+	}
+	if checksum := sha256.Sum256(wasmCode); !bytes.Equal(checksum[:], codeHash) {
+		return nil, false, sdkerrors.Wrapf(types.ErrInvalid, "checksum of stored code %X", codeHash)
+	}
+	return wasmCode, true, nil
+}
+
+// compiledVM returns the VM of the code after making sure the code is in its cache. A code missing from the cache,
+// e.g. of a node with a new wasm dir, is read from the state and compiled again. The VM cache is checked once
+// per code and process.
+func (k Keeper) compiledVM(ctx sdk.Context, codeInfo types.CodeInfo) (types.WasmerEngine, error) {
+	vm, err := k.vmFor(codeInfo.GetInterfaceVersion())
+	if err != nil {
+		return nil, err
+	}
+	if k.compiled.has(codeInfo.CodeHash) {
+		return vm, nil
+	}
+	if _, err := vm.GetCode(codeInfo.CodeHash); err != nil {
+		wasmCode, found, loadErr := k.loadBytecode(ctx, codeInfo.CodeHash)
+		if loadErr != nil {
+			return nil, loadErr
+		}
+		if !found {
+			return nil, sdkerrors.Wrapf(types.ErrNotFound, "code %X not in the VM cache or state", codeInfo.CodeHash)
+		}
+		codeHash, err := vm.Create(wasmCode)
+		if err != nil {
+			return nil, sdkerrors.Wrap(types.ErrCreateFailed, err.Error())
+		}
+		if !bytes.Equal(codeHash, codeInfo.CodeHash) {
+			return nil, sdkerrors.Wrap(types.ErrInvalid, "code hashes not same")
+		}
+	}
+	k.compiled.add(codeInfo.CodeHash)
+	return vm, nil
+}
+
+// MigrateBytecodeToState stores the code of every code info that is only in the VM cache in the state. The code of
+// chains started before the wasm code was kept in the state is only on the disks of the nodes. It is stored
+// uncompressed, as the output of a compression by the nodes could differ between their go versions.
+func (k Keeper) MigrateBytecodeToState(ctx sdk.Context) error {
+	var err error
+	k.IterateCodeInfos(ctx, func(codeID uint64, codeInfo types.CodeInfo) bool {
+		if k.bytecodeStore(ctx).Has(types.GetCodeBytecodeKey(codeInfo.CodeHash)) {
+			return false
+		}
+		var vm types.WasmerEngine
+		if vm, err = k.vmFor(codeInfo.GetInterfaceVersion()); err != nil {
+			return true
+		}
+		var wasmCode []byte
+		if wasmCode, err = vm.GetCode(codeInfo.CodeHash); err != nil {
+			err = sdkerrors.Wrapf(types.ErrNotFound, "code %d: %s", codeID, err)
+			return true
+		}
+		k.storeBytecode(ctx, codeInfo.CodeHash, wasmCode)
+		return false
+	})
+	return err
+}
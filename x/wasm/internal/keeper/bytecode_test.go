@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	wasm "github.com/CosmWasm/go-cosmwasm"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+func TestBytecodeStoredAsUploaded(t *testing.T) {
+	wasmCode, err := ioutil.ReadFile("./testdata/contract.wasm")
+	require.NoError(t, err)
+	gzipCode, err := ioutil.ReadFile("./testdata/contract.wasm.gzip")
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		src []byte
+	}{
+		"wasm": {src: wasmCode},
+		"gzip": {src: gzipCode},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			tempDir, err := ioutil.TempDir("", "wasm")
+			require.NoError(t, err)
+			defer os.RemoveAll(tempDir)
+			ctx, keepers := CreateTestInput(t, false, tempDir, SupportedFeatures, nil, nil)
+			keeper := keepers.WasmKeeper
+			creator := createFakeFundedAccount(ctx, keepers.AccountKeeper, nil)
+
+			ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+			codeID, err := keeper.Create(ctx, creator, spec.src, "", "", nil)
+			require.NoError(t, err)
+			// the write of the uploaded bytes is charged on top of the compile cost
+			writeGas := storetypes.KVGasConfig().WriteCostPerByte * uint64(len(spec.src))
+			assert.True(t, ctx.GasMeter().GasConsumed() > CompileCost*uint64(len(wasmCode))+writeGas)
+
+			codeInfo := keeper.GetCodeInfo(ctx, codeID)
+			stored := ctx.KVStore(keeper.storeKey).Get(types.GetCodeBytecodeKey(codeInfo.CodeHash))
+			assert.Equal(t, spec.src, stored)
+
+			gotCode, err := keeper.GetByteCode(ctx, codeID)
+			require.NoError(t, err)
+			assert.Equal(t, wasmCode, gotCode)
+		})
+	}
+}
+
+func TestCompiledVM(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keepers := CreateTestInput(t, false, tempDir, SupportedFeatures, nil, nil)
+	keeper := keepers.WasmKeeper
+	creator := createFakeFundedAccount(ctx, keepers.AccountKeeper, nil)
+	wasmCode, err := ioutil.ReadFile("./testdata/contract.wasm")
+	require.NoError(t, err)
+	gzipCode, err := ioutil.ReadFile("./testdata/contract.wasm.gzip")
+	require.NoError(t, err)
+	codeID, err := keeper.Create(ctx, creator, wasmCode, "", "", nil)
+	require.NoError(t, err)
+	codeInfo := keeper.GetCodeInfo(ctx, codeID)
+	initMsgBz, err := json.Marshal(InitMsg{Verifier: creator, Beneficiary: creator})
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		srcBytecode []byte
+		expErr      *sdkerrors.Error
+	}{
+		"code compiled from state": {},
+		"compressed code compiled from state": {
+			srcBytecode: gzipCode,
+		},
+		"code not in state": {
+			srcBytecode: []byte{},
+			expErr:      types.ErrNotFound,
+		},
+		"checksum mismatch": {
+			srcBytecode: []byte("not the code"),
+			expErr:      types.ErrInvalid,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			cacheCtx, _ := ctx.CacheContext()
+			if spec.srcBytecode != nil {
+				store := cacheCtx.KVStore(keeper.storeKey)
+				key := types.GetCodeBytecodeKey(codeInfo.CodeHash)
+				store.Delete(key)
+				if len(spec.srcBytecode) != 0 {
+					store.Set(key, spec.srcBytecode)
+				}
+			}
+			// a node with an empty VM cache
+			vmDir, err := ioutil.TempDir("", "wasm")
+			require.NoError(t, err)
+			defer os.RemoveAll(vmDir)
+			vm, err := wasm.NewWasmer(vmDir, SupportedFeatures, 0)
+			require.NoError(t, err)
+			nodeKeeper := keeper
+			nodeKeeper.vms = map[string]types.WasmerEngine{codeInfo.InterfaceVersion: vm}
+			nodeKeeper.compiled = newCompiledCodes()
+
+			_, _, err = nodeKeeper.Instantiate(cacheCtx, codeID, creator, nil, initMsgBz, "demo contract", nil)
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(err), "got %+v", err)
+				return
+			}
+			require.NoError(t, err)
+			gotCode, err := vm.GetCode(codeInfo.CodeHash)
+			require.NoError(t, err)
+			assert.Equal(t, wasmCode, []byte(gotCode))
+		})
+	}
+}
+
+func TestMigrateBytecodeToState(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keepers := CreateTestInput(t, false, tempDir, SupportedFeatures, nil, nil)
+	keeper := keepers.WasmKeeper
+	creator := createFakeFundedAccount(ctx, keepers.AccountKeeper, nil)
+	wasmCode, err := ioutil.ReadFile("./testdata/contract.wasm")
+	require.NoError(t, err)
+	codeID, err := keeper.Create(ctx, creator, wasmCode, "", "", nil)
+	require.NoError(t, err)
+	codeInfo := keeper.GetCodeInfo(ctx, codeID)
+
+	// code stored before it was kept in the state
+	key := types.GetCodeBytecodeKey(codeInfo.CodeHash)
+	ctx.KVStore(keeper.storeKey).Delete(key)
+	gotCode, err := keeper.GetByteCode(ctx, codeID)
+	require.NoError(t, err)
+	assert.Equal(t, wasmCode, gotCode)
+
+	require.NoError(t, keeper.MigrateBytecodeToState(ctx))
+	assert.True(t, ctx.KVStore(keeper.storeKey).Has(key))
+	wasmCode, found, err := keeper.loadBytecode(ctx, codeInfo.CodeHash)
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, gotCode, wasmCode)
+}
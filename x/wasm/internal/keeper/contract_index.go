@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+// indexStore returns the store of the wasm module that does not charge gas, for the index entries
+func (k Keeper) indexStore(ctx sdk.Context) sdk.KVStore {
+	return ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).KVStore(k.storeKey)
+}
+
+// updateContractIndexes moves the index entries of the contract to the given contract info. It must be called before
+// the contract info is stored. The indexes are read and written without charging gas, so that they do not change the
+// gas cost of instantiating or migrating contracts.
+func (k Keeper) updateContractIndexes(ctx sdk.Context, contractAddr sdk.AccAddress, info *types.ContractInfo) {
+	store := k.indexStore(ctx)
+	if bz := store.Get(types.GetContractAddressKey(contractAddr)); bz != nil {
+		var old types.ContractInfo
+		k.cdc.MustUnmarshalBinaryBare(bz, &old)
+		if old.CodeID == info.CodeID && old.Creator.Equals(info.Creator) {
+			return
+		}
+		store.Delete(types.GetContractByCodeIndexKey(old.CodeID, contractAddr))
+		store.Delete(types.GetContractByCreatorIndexKey(old.Creator, contractAddr))
+	}
+	store.Set(types.GetContractByCodeIndexKey(info.CodeID, contractAddr), []byte{1})
+	store.Set(types.GetContractByCreatorIndexKey(info.Creator, contractAddr), []byte{1})
+}
+
+// indexCode adds the code to the index of the codes by checksum, without charging gas
+func (k Keeper) indexCode(ctx sdk.Context, codeID uint64, codeHash []byte) {
+	k.indexStore(ctx).Set(types.GetCodeByHashIndexKey(codeHash, codeID), []byte{1})
+}
+
+// findCode returns the id of the first stored code with the checksum and the instantiate permission
+func (k Keeper) findCode(ctx sdk.Context, codeHash []byte, instantiateAccess types.AccessConfig) (uint64, bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetCodeByHashIndexPrefix(codeHash)).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		codeID := binary.BigEndian.Uint64(iter.Key())
+		if info := k.GetCodeInfo(ctx, codeID); info != nil && info.InstantiateConfig.Equals(instantiateAccess) {
+			return codeID, true
+		}
+	}
+	return 0, false
+}
+
+// IterateContractsByCode calls cb with the address of every contract currently running the code, in address order.
+// cb returns true to stop early.
+func (k Keeper) IterateContractsByCode(ctx sdk.Context, codeID uint64, cb func(sdk.AccAddress) bool) {
+	k.iterateIndex(ctx, types.GetContractByCodeIndexPrefix(codeID), cb)
+}
+
+// IterateContractsByCreator calls cb with the address of every contract instantiated by the creator, in address
+// order. cb returns true to stop early.
+func (k Keeper) IterateContractsByCreator(ctx sdk.Context, creator sdk.AccAddress, cb func(sdk.AccAddress) bool) {
+	k.iterateIndex(ctx, types.GetContractByCreatorIndexPrefix(creator), cb)
+}
+
+func (k Keeper) iterateIndex(ctx sdk.Context, indexPrefix []byte, cb func(sdk.AccAddress) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), indexPrefix).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if cb(append(sdk.AccAddress{}, iter.Key()...)) {
+			break
+		}
+	}
+}
+
+// IterateContractState calls cb with every key and value of the contract state in key order.
+// cb returns true to stop early.
+func (k Keeper) IterateContractState(ctx sdk.Context, contractAddr sdk.AccAddress, cb func(key, value []byte) bool) {
+	iter := k.GetContractState(ctx, contractAddr)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if cb(iter.Key(), iter.Value()) {
+			break
+		}
+	}
+}
+
+// RebuildContractIndexes writes the index entries of all contracts. Contracts instantiated before the indexes were
+// introduced are only found by the iterators after the wasm-bytecode-in-state upgrade handler called this once.
+func (k Keeper) RebuildContractIndexes(ctx sdk.Context) {
+	var keys [][]byte
+	k.IterateContractInfo(ctx, func(addr sdk.AccAddress, info types.ContractInfo) bool {
+		keys = append(keys, types.GetContractByCodeIndexKey(info.CodeID, addr), types.GetContractByCreatorIndexKey(info.Creator, addr))
+		return false
+	})
+	// not written while iterating the same store
+	store := k.indexStore(ctx)
+	for _, key := range keys {
+		store.Set(key, []byte{1})
+	}
+}
@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"sort"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+func TestContractIndexes(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keepers := CreateTestInput(t, false, tempDir, SupportedFeatures, nil, nil)
+	accKeeper, keeper := keepers.AccountKeeper, keepers.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	alice := createFakeFundedAccount(ctx, accKeeper, deposit)
+	bob := createFakeFundedAccount(ctx, accKeeper, deposit)
+	_, _, verifier := keyPubAddr()
+
+	wasmCode, err := ioutil.ReadFile("./testdata/contract.wasm")
+	require.NoError(t, err)
+	codeA, err := keeper.Create(ctx, alice, wasmCode, "", "", nil)
+	require.NoError(t, err)
+	codeB, err := keeper.Create(ctx, alice, wasmCode, "", "", nil)
+	require.NoError(t, err)
+
+	initMsgBz, err := json.Marshal(InitMsg{Verifier: verifier, Beneficiary: verifier})
+	require.NoError(t, err)
+	instantiate := func(codeID uint64, creator sdk.AccAddress) sdk.AccAddress {
+		addr, _, err := keeper.Instantiate(ctx, codeID, creator, creator, initMsgBz, "index test", nil)
+		require.NoError(t, err)
+		return addr
+	}
+	aliceA1 := instantiate(codeA, alice)
+	aliceA2 := instantiate(codeA, alice)
+	bobA := instantiate(codeA, bob)
+	bobB := instantiate(codeB, bob)
+
+	// moves the contract from the code A to the code B index
+	migMsgBz, err := json.Marshal(struct {
+		Verifier sdk.AccAddress `json:"verifier"`
+	}{Verifier: verifier})
+	require.NoError(t, err)
+	_, err = keeper.Migrate(ctx, aliceA2, alice, codeB, migMsgBz)
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		iterate func(cb func(sdk.AccAddress) bool)
+		exp     []sdk.AccAddress
+	}{
+		"by code A": {
+			iterate: func(cb func(sdk.AccAddress) bool) { keeper.IterateContractsByCode(ctx, codeA, cb) },
+			exp:     []sdk.AccAddress{aliceA1, bobA},
+		},
+		"by code B": {
+			iterate: func(cb func(sdk.AccAddress) bool) { keeper.IterateContractsByCode(ctx, codeB, cb) },
+			exp:     []sdk.AccAddress{aliceA2, bobB},
+		},
+		"by unknown code": {
+			iterate: func(cb func(sdk.AccAddress) bool) { keeper.IterateContractsByCode(ctx, 100, cb) },
+		},
+		"by creator alice": {
+			iterate: func(cb func(sdk.AccAddress) bool) { keeper.IterateContractsByCreator(ctx, alice, cb) },
+			exp:     []sdk.AccAddress{aliceA1, aliceA2},
+		},
+		"by creator bob": {
+			iterate: func(cb func(sdk.AccAddress) bool) { keeper.IterateContractsByCreator(ctx, bob, cb) },
+			exp:     []sdk.AccAddress{bobA, bobB},
+		},
+		"by creator without contracts": {
+			iterate: func(cb func(sdk.AccAddress) bool) { keeper.IterateContractsByCreator(ctx, verifier, cb) },
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			var got []sdk.AccAddress
+			spec.iterate(func(addr sdk.AccAddress) bool {
+				got = append(got, addr)
+				return false
+			})
+			assert.Equal(t, sortedAddrs(spec.exp), got)
+
+			// stops early
+			var count int
+			spec.iterate(func(sdk.AccAddress) bool {
+				count++
+				return true
+			})
+			assert.Equal(t, len(spec.exp) > 0, count == 1)
+		})
+	}
+
+	t.Run("rebuild", func(t *testing.T) {
+		store := ctx.KVStore(keeper.storeKey)
+		for _, p := range [][]byte{types.ContractByCodeIndexPrefix, types.ContractByCreatorIndexPrefix} {
+			var keys [][]byte
+			iter := sdk.KVStorePrefixIterator(store, p)
+			for ; iter.Valid(); iter.Next() {
+				keys = append(keys, iter.Key())
+			}
+			iter.Close()
+			require.Len(t, keys, 4)
+			for _, k := range keys {
+				store.Delete(k)
+			}
+		}
+		var got []sdk.AccAddress
+		keeper.IterateContractsByCreator(ctx, bob, func(addr sdk.AccAddress) bool {
+			got = append(got, addr)
+			return false
+		})
+		require.Empty(t, got)
+
+		keeper.RebuildContractIndexes(ctx)
+		keeper.IterateContractsByCreator(ctx, bob, func(addr sdk.AccAddress) bool {
+			got = append(got, addr)
+			return false
+		})
+		assert.Equal(t, sortedAddrs([]sdk.AccAddress{bobA, bobB}), got)
+	})
+}
+
+func TestIterateContractState(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keepers := CreateTestInput(t, false, tempDir, SupportedFeatures, nil, nil)
+	keeper := keepers.WasmKeeper
+
+	_, _, contractAddr := keyPubAddr()
+	models := []types.Model{
+		{Key: []byte("b"), Value: []byte("2")},
+		{Key: []byte("a"), Value: []byte("1")},
+		{Key: []byte("c"), Value: []byte("3")},
+	}
+	require.NoError(t, keeper.importContractState(ctx, contractAddr, models))
+
+	var keys []string
+	keeper.IterateContractState(ctx, contractAddr, func(key, value []byte) bool {
+		keys = append(keys, string(key)+"="+string(value))
+		return string(key) == "b"
+	})
+	assert.Equal(t, []string{"a=1", "b=2"}, keys)
+}
+
+func sortedAddrs(addrs []sdk.AccAddress) []sdk.AccAddress {
+	if len(addrs) == 0 {
+		return nil
+	}
+	res := append([]sdk.AccAddress{}, addrs...)
+	sort.Slice(res, func(i, j int) bool { return string(res[i]) < string(res[j]) })
+	return res
+}
@@ -29,6 +29,16 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) error
 		if err != nil {
 			return sdkerrors.Wrapf(err, "contract number %d", i)
 		}
+		if contract.Metadata != nil {
+			keeper.storeContractMetadata(ctx, contract.ContractAddress, *contract.Metadata)
+		}
+		keeper.storeContractRewardAddress(ctx, contract.ContractAddress, contract.RewardAddress)
+		if contract.Limits != nil {
+			keeper.storeContractLimits(ctx, contract.ContractAddress, *contract.Limits)
+		}
+		if contract.Provenance != nil {
+			keeper.storeContractProvenance(ctx, contract.ContractAddress, *contract.Provenance)
+		}
 		maxContractID = i + 1 // not ideal but max(contractID) is not persisted otherwise
 	}
 
@@ -39,6 +49,40 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) error
 		}
 	}
 
+	for i, addr := range data.PausedContracts {
+		if err := keeper.importPausedContract(ctx, addr); err != nil {
+			return sdkerrors.Wrapf(err, "paused contract number %d", i)
+		}
+	}
+
+	for i, codeID := range data.PausedCodes {
+		if err := keeper.importPausedCode(ctx, codeID); err != nil {
+			return sdkerrors.Wrapf(err, "paused code number %d", i)
+		}
+	}
+
+	for i, grant := range data.ContractExecutionGrants {
+		if err := keeper.importContractExecutionGrant(ctx, grant); err != nil {
+			return sdkerrors.Wrapf(err, "contract execution grant number %d", i)
+		}
+	}
+
+	var totalRewards sdk.Coins
+	for _, rewards := range data.ContractRewards {
+		keeper.storeContractRewards(ctx, rewards.RewardAddress, rewards.Amount)
+		totalRewards = totalRewards.Add(rewards.Amount...)
+	}
+	if pool := keeper.bankKeeper.GetCoins(ctx, types.RewardsPoolAddress); !pool.IsAllGTE(totalRewards) {
+		return sdkerrors.Wrapf(types.ErrInvalidGenesis, "rewards pool %s holds %s, less than the contract rewards %s", types.RewardsPoolAddress, pool, totalRewards)
+	}
+
+	for i, schedule := range data.ContractSchedules {
+		if !keeper.containsContractInfo(ctx, schedule.Contract) {
+			return sdkerrors.Wrapf(types.ErrNotFound, "contract schedule number %d: contract %s", i, schedule.Contract)
+		}
+		keeper.storeContractSchedule(ctx, schedule)
+	}
+
 	// sanity check seq values
 	if keeper.peekAutoIncrementID(ctx, types.KeyLastCodeID) <= maxCodeID {
 		return sdkerrors.Wrapf(types.ErrInvalid, "seq %s must be greater %d ", string(types.KeyLastCodeID), maxCodeID)
@@ -71,15 +115,11 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 	})
 
 	keeper.IterateContractInfo(ctx, func(addr sdk.AccAddress, contract types.ContractInfo) bool {
-		contractStateIterator := keeper.GetContractState(ctx, addr)
 		var state []types.Model
-		for ; contractStateIterator.Valid(); contractStateIterator.Next() {
-			m := types.Model{
-				Key:   contractStateIterator.Key(),
-				Value: contractStateIterator.Value(),
-			}
-			state = append(state, m)
-		}
+		keeper.IterateContractState(ctx, addr, func(key, value []byte) bool {
+			state = append(state, types.Model{Key: key, Value: value})
+			return false
+		})
 		// redact contract info
 		contract.Created = nil
 
@@ -87,11 +127,40 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 			ContractAddress: addr,
 			ContractInfo:    contract,
 			ContractState:   state,
+			Metadata:        keeper.GetContractMetadata(ctx, addr),
+			RewardAddress:   keeper.GetContractRewardAddress(ctx, addr),
+			Limits:          keeper.GetContractLimits(ctx, addr),
+			Provenance:      keeper.GetContractProvenance(ctx, addr),
 		})
 
 		return false
 	})
 
+	keeper.IteratePausedContracts(ctx, func(addr sdk.AccAddress) bool {
+		genState.PausedContracts = append(genState.PausedContracts, addr)
+		return false
+	})
+
+	keeper.IteratePausedCodes(ctx, func(codeID uint64) bool {
+		genState.PausedCodes = append(genState.PausedCodes, codeID)
+		return false
+	})
+
+	keeper.IterateContractExecutionGrants(ctx, func(grant types.ContractExecutionGrant) bool {
+		genState.ContractExecutionGrants = append(genState.ContractExecutionGrants, grant)
+		return false
+	})
+
+	keeper.IterateContractRewards(ctx, func(rewards types.ContractRewards) bool {
+		genState.ContractRewards = append(genState.ContractRewards, rewards)
+		return false
+	})
+
+	keeper.IterateContractSchedules(ctx, func(schedule types.ContractSchedule) bool {
+		genState.ContractSchedules = append(genState.ContractSchedules, schedule)
+		return false
+	})
+
 	for _, k := range [][]byte{types.KeyLastCodeID, types.KeyLastInstanceID} {
 		genState.Sequences = append(genState.Sequences, types.Sequence{
 			IDKey: k,
@@ -101,3 +170,58 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 
 	return genState
 }
+
+// ExportContract returns a single contract with its code and state, for import into the genesis of another chain
+// with GenesisState.ImportContract.
+func ExportContract(ctx sdk.Context, keeper Keeper, contractAddr sdk.AccAddress) (types.ContractDump, error) {
+	contractInfo := keeper.GetContractInfo(ctx, contractAddr)
+	if contractInfo == nil {
+		return types.ContractDump{}, sdkerrors.Wrapf(types.ErrNotFound, "contract: %s", contractAddr)
+	}
+	codeInfo := keeper.GetCodeInfo(ctx, contractInfo.CodeID)
+	if codeInfo == nil {
+		return types.ContractDump{}, sdkerrors.Wrapf(types.ErrNotFound, "code: %d", contractInfo.CodeID)
+	}
+	bytecode, err := keeper.GetByteCode(ctx, contractInfo.CodeID)
+	if err != nil {
+		return types.ContractDump{}, err
+	}
+
+	var state []types.Model
+	keeper.IterateContractState(ctx, contractAddr, func(key, value []byte) bool {
+		state = append(state, types.Model{Key: key, Value: value})
+		return false
+	})
+	// redact contract info
+	info := *contractInfo
+	info.Created = nil
+
+	return types.ContractDump{
+		InstanceID: contractInstanceID(ctx, keeper, contractAddr, info.CodeID),
+		Code: types.Code{
+			CodeID:     info.CodeID,
+			CodeInfo:   *codeInfo,
+			CodesBytes: bytecode,
+		},
+		Contract: types.Contract{
+			ContractAddress: contractAddr,
+			ContractInfo:    info,
+			ContractState:   state,
+			Metadata:        keeper.GetContractMetadata(ctx, contractAddr),
+			Provenance:      keeper.GetContractProvenance(ctx, contractAddr),
+		},
+	}, nil
+}
+
+// contractInstanceID returns the instance id the contract address was generated with. The address of a contract
+// imported from another chain is not generated with its code id here, the last instance id is returned then which is
+// past the one of the contract as the import raised the sequence.
+func contractInstanceID(ctx sdk.Context, keeper Keeper, contractAddr sdk.AccAddress, codeID uint64) uint64 {
+	next := keeper.peekAutoIncrementID(ctx, types.KeyLastInstanceID)
+	for id := uint64(1); id < next; id++ {
+		if contractAddress(codeID, id).Equals(contractAddr) {
+			return id
+		}
+	}
+	return next - 1
+}
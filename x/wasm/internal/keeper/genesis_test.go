@@ -53,6 +53,7 @@ func TestGenesisExportImport(t *testing.T) {
 		srcKeeper.setContractInfo(srcCtx, contractAddr, &contract)
 		srcKeeper.appendToContractHistory(srcCtx, contractAddr, history...)
 		srcKeeper.importContractState(srcCtx, contractAddr, stateModels)
+		srcKeeper.storeContractProvenance(srcCtx, contractAddr, types.ContractProvenance{BlockHeight: int64(i), TxHash: txHash(contractAddr)})
 	}
 	var wasmParams types.Params
 	f.Fuzz(&wasmParams)
@@ -440,6 +441,7 @@ func TestImportContractWithCodeHistoryReset(t *testing.T) {
 			Type:    types.OnlyAddress,
 			Address: codeCreatorAddr,
 		},
+		InterfaceVersion: types.InterfaceVersion3,
 	}
 	assert.Equal(t, expCodeInfo, *gotCodeInfo)
 
@@ -468,6 +470,74 @@ func TestImportContractWithCodeHistoryReset(t *testing.T) {
 	assert.Equal(t, expHistory, keeper.GetContractHistory(ctx, contractAddr))
 }
 
+func TestExportContractIntoGenesis(t *testing.T) {
+	srcKeeper, srcCtx, _, srcCleanup := setupKeeper(t)
+	defer srcCleanup()
+	wasmCode, err := ioutil.ReadFile("./testdata/contract.wasm")
+	require.NoError(t, err)
+
+	_, _, creator := keyPubAddr()
+	codeID, err := srcKeeper.Create(srcCtx, creator, wasmCode, "", "", nil)
+	require.NoError(t, err)
+	contractAddr := srcKeeper.generateContractAddress(srcCtx, codeID)
+	contractInfo := types.NewContractInfo(codeID, creator, nil, "dumped", &types.AbsoluteTxPosition{BlockHeight: 1})
+	srcKeeper.setContractInfo(srcCtx, contractAddr, &contractInfo)
+	models := []types.Model{{Key: []byte("a"), Value: []byte("1")}, {Key: []byte("b"), Value: []byte("2")}}
+	require.NoError(t, srcKeeper.importContractState(srcCtx, contractAddr, models))
+
+	_, err = ExportContract(srcCtx, srcKeeper, sdk.AccAddress(bytes.Repeat([]byte{1}, sdk.AddrLen)))
+	require.True(t, types.ErrNotFound.Is(err), err)
+
+	dump, err := ExportContract(srcCtx, srcKeeper, contractAddr)
+	require.NoError(t, err)
+	require.NoError(t, dump.ValidateBasic())
+	assert.Equal(t, uint64(1), dump.InstanceID)
+	assert.Nil(t, dump.Contract.ContractInfo.Created)
+	assert.Equal(t, wasmCode, dump.Code.CodesBytes)
+
+	// survives the json round trip of the dump file
+	bz, err := srcKeeper.cdc.MarshalJSON(dump)
+	require.NoError(t, err)
+	var loaded types.ContractDump
+	require.NoError(t, srcKeeper.cdc.UnmarshalJSON(bz, &loaded))
+
+	// imported into an empty chain
+	genState := types.GenesisState{Params: types.DefaultParams()}
+	require.NoError(t, genState.ImportContract(loaded))
+	dstKeeper, dstCtx, _, dstCleanup := setupKeeper(t)
+	defer dstCleanup()
+	dstCtx = dstCtx.WithBlockHeight(0).WithGasMeter(sdk.NewInfiniteGasMeter())
+	require.NoError(t, InitGenesis(dstCtx, dstKeeper, genState))
+
+	assert.Equal(t, uint64(2), dstKeeper.peekAutoIncrementID(dstCtx, types.KeyLastInstanceID))
+
+	gotInfo := dstKeeper.GetContractInfo(dstCtx, contractAddr)
+	require.NotNil(t, gotInfo)
+	assert.Equal(t, uint64(1), gotInfo.CodeID)
+	assert.Equal(t, "dumped", gotInfo.Label)
+	gotCode, err := dstKeeper.GetByteCode(dstCtx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, wasmCode, gotCode)
+	var gotState []types.Model
+	dstKeeper.IterateContractState(dstCtx, contractAddr, func(key, value []byte) bool {
+		gotState = append(gotState, types.Model{Key: key, Value: value})
+		return false
+	})
+	assert.Equal(t, models, gotState)
+
+	// exported again from the chain it was imported into
+	redump, err := ExportContract(dstCtx, dstKeeper, contractAddr)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), redump.InstanceID)
+
+	// the address of a contract imported with another code id is not generated here, the last instance id bounds it
+	otherAddr := sdk.AccAddress(bytes.Repeat([]byte{2}, sdk.AddrLen))
+	dstKeeper.setContractInfo(dstCtx, otherAddr, &contractInfo)
+	otherDump, err := ExportContract(dstCtx, dstKeeper, otherAddr)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), otherDump.InstanceID)
+}
+
 func setupKeeper(t *testing.T) (Keeper, sdk.Context, []sdk.StoreKey, func()) {
 	t.Helper()
 	tempDir, err := ioutil.TempDir("", "wasm")
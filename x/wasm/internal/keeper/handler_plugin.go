@@ -235,7 +235,12 @@ func (h MessageHandler) Dispatch(ctx sdk.Context, contractAddr sdk.AccAddress, m
 		return err
 	}
 	for _, sdkMsg := range sdkMsgs {
-		if err := h.handleSdkMessage(ctx, contractAddr, sdkMsg); err != nil {
+		if limited, ok := sdkMsg.(gasLimitedMsg); ok {
+			err = h.handleGasLimitedMessage(ctx, contractAddr, limited)
+		} else {
+			err = h.handleSdkMessage(ctx, contractAddr, sdkMsg)
+		}
+		if err != nil {
 			return err
 		}
 	}
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+// RegisterInvariants registers all wasm invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "code-references", CodeReferencesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "escrowed-funds", EscrowedFundsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "sequences", SequencesInvariant(k))
+}
+
+// AllInvariants runs all invariants of the wasm module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, inv := range []sdk.Invariant{
+			CodeReferencesInvariant(k),
+			EscrowedFundsInvariant(k),
+			SequencesInvariant(k),
+		} {
+			if res, stop := inv(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// CodeReferencesInvariant checks that the code of every contract is stored
+func CodeReferencesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+		k.IterateContractInfo(ctx, func(addr sdk.AccAddress, info types.ContractInfo) bool {
+			if !k.containsCodeInfo(ctx, info.CodeID) {
+				count++
+				msg += fmt.Sprintf("\tcontract %s references unknown code id %d\n", addr, info.CodeID)
+			}
+			return false
+		})
+		broken := count != 0
+		return sdk.FormatInvariant(types.ModuleName, "code-references",
+			fmt.Sprintf("%d contracts reference unknown codes\n%s", count, msg)), broken
+	}
+}
+
+// EscrowedFundsInvariant checks that the rewards pool account holds the accrued contract rewards
+func EscrowedFundsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var accrued sdk.Coins
+		k.IterateContractRewards(ctx, func(rewards types.ContractRewards) bool {
+			accrued = accrued.Add(rewards.Amount...)
+			return false
+		})
+		pool := k.bankKeeper.GetCoins(ctx, types.RewardsPoolAddress)
+		broken := !pool.IsAllGTE(accrued)
+		return sdk.FormatInvariant(types.ModuleName, "escrowed-funds",
+			fmt.Sprintf("\trewards pool %s holds %s, accrued contract rewards are %s\n", types.RewardsPoolAddress, pool, accrued)), broken
+	}
+}
+
+// SequencesInvariant checks that the code id and the instance id sequences are greater than the ids in use
+func SequencesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var maxCodeID uint64
+		k.IterateCodeInfos(ctx, func(codeID uint64, _ types.CodeInfo) bool {
+			if codeID > maxCodeID {
+				maxCodeID = codeID
+			}
+			return false
+		})
+		// the instance id is not stored with the contract, but every contract took one
+		var contracts uint64
+		k.IterateContractInfo(ctx, func(sdk.AccAddress, types.ContractInfo) bool {
+			contracts++
+			return false
+		})
+		lastCodeID := k.peekAutoIncrementID(ctx, types.KeyLastCodeID)
+		lastInstanceID := k.peekAutoIncrementID(ctx, types.KeyLastInstanceID)
+		broken := lastCodeID <= maxCodeID || lastInstanceID <= contracts
+		return sdk.FormatInvariant(types.ModuleName, "sequences",
+			fmt.Sprintf("\tnext code id %d, max code id %d\n\tnext instance id %d, contracts %d\n",
+				lastCodeID, maxCodeID, lastInstanceID, contracts)), broken
+	}
+}
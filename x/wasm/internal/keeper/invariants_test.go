@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+func TestInvariants(t *testing.T) {
+	specs := map[string]struct {
+		srcMutator func(ctx sdk.Context, keeper Keeper, contractAddr sdk.AccAddress)
+		expBroken  bool
+	}{
+		"valid state": {
+			srcMutator: func(sdk.Context, Keeper, sdk.AccAddress) {},
+		},
+		"unknown code id": {
+			srcMutator: func(ctx sdk.Context, keeper Keeper, contractAddr sdk.AccAddress) {
+				info := keeper.GetContractInfo(ctx, contractAddr)
+				info.CodeID = 99
+				keeper.setContractInfo(ctx, contractAddr, info)
+			},
+			expBroken: true,
+		},
+		"rewards without funds": {
+			srcMutator: func(ctx sdk.Context, keeper Keeper, contractAddr sdk.AccAddress) {
+				keeper.storeContractRewards(ctx, contractAddr, sdk.NewCoins(sdk.NewInt64Coin("denom", 1)))
+			},
+			expBroken: true,
+		},
+		"code id sequence reset": {
+			srcMutator: func(ctx sdk.Context, keeper Keeper, _ sdk.AccAddress) {
+				ctx.KVStore(keeper.storeKey).Set(types.KeyLastCodeID, sdk.Uint64ToBigEndian(1))
+			},
+			expBroken: true,
+		},
+		"instance id sequence reset": {
+			srcMutator: func(ctx sdk.Context, keeper Keeper, _ sdk.AccAddress) {
+				ctx.KVStore(keeper.storeKey).Set(types.KeyLastInstanceID, sdk.Uint64ToBigEndian(1))
+			},
+			expBroken: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			tempDir, err := ioutil.TempDir("", "wasm")
+			require.NoError(t, err)
+			defer os.RemoveAll(tempDir)
+			ctx, keepers := CreateTestInput(t, false, tempDir, SupportedFeatures, nil, nil)
+			accKeeper, keeper := keepers.AccountKeeper, keepers.WasmKeeper
+
+			deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+			creator := createFakeFundedAccount(ctx, accKeeper, deposit)
+			wasmCode, err := ioutil.ReadFile("./testdata/contract.wasm")
+			require.NoError(t, err)
+			codeID, err := keeper.Create(ctx, creator, wasmCode, "", "", nil)
+			require.NoError(t, err)
+			initMsgBz, err := json.Marshal(InitMsg{Verifier: creator, Beneficiary: creator})
+			require.NoError(t, err)
+			contractAddr, _, err := keeper.Instantiate(ctx, codeID, creator, nil, initMsgBz, "demo contract", nil)
+			require.NoError(t, err)
+
+			spec.srcMutator(ctx, keeper, contractAddr)
+
+			res, broken := AllInvariants(keeper)(ctx)
+			assert.Equal(t, spec.expBroken, broken, res)
+		})
+	}
+}
@@ -2,8 +2,10 @@ package keeper
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"encoding/binary"
 	"path/filepath"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/x/params/subspace"
 	"github.com/cosmos/cosmos-sdk/x/staking"
@@ -23,18 +25,11 @@ import (
 	"github.com/fetchai/fetchd/x/wasm/internal/types"
 )
 
-// GasMultiplier is how many cosmwasm gas points = 1 sdk gas point
-// SDK reference costs can be found here: https://github.com/cosmos/cosmos-sdk/blob/02c6c9fafd58da88550ab4d7d494724a477c8a68/store/types/gas.go#L153-L164
-// A write at ~3000 gas and ~200us = 10 gas per us (microsecond) cpu/io
-// Rough timing have 88k gas at 90us, which is equal to 1k sdk gas... (one read)
-//
-// Please not that all gas prices returned to the wasmer engine should have this multiplied
-const GasMultiplier uint64 = 100
+// GasMultiplier is the default of the gas_multiplier param, see types.DefaultGasMultiplier
+const GasMultiplier = types.DefaultGasMultiplier
 
-// MaxGas for a contract is 10 billion wasmer gas (enforced in rust to prevent overflow)
-// The limit for v0.9.3 is defined here: https://github.com/CosmWasm/cosmwasm/blob/v0.9.3/packages/vm/src/backends/singlepass.rs#L15-L23
-// (this will be increased in future releases)
-const MaxGas = 10_000_000_000
+// MaxGas is the default of the max_contract_gas param, see types.DefaultMaxContractGas
+const MaxGas = types.DefaultMaxContractGas
 
 // InstanceCost is how much SDK gas we charge each time we load a WASM instance.
 // Creating a new instance is costly, and this helps put a recursion limit to contracts calling contracts.
@@ -50,13 +45,22 @@ type Keeper struct {
 	accountKeeper auth.AccountKeeper
 	bankKeeper    bank.Keeper
 
-	wasmer       wasm.Wasmer
+	// vms are the VMs by contract interface version
+	vms          map[string]types.WasmerEngine
 	queryPlugins QueryPlugins
 	messenger    MessageHandler
 	// queryGasLimit is the max wasm gas that can be spent on executing a query with a contract
 	queryGasLimit uint64
 	authZPolicy   AuthorizationPolicy
 	paramSpace    subspace.Subspace
+	// contractDebugMode logs every contract call, see types.WasmConfig
+	contractDebugMode bool
+	metrics           *Metrics
+	callStats         *callStats
+	// tracer records the host function calls of contracts when ContractTraceMode is on, nil otherwise
+	tracer *tracer
+	// compiled are the codes known to be in the VM cache, see compiledVM
+	compiled *compiledCodes
 }
 
 // NewKeeper creates a new contract Keeper instance
@@ -64,7 +68,11 @@ type Keeper struct {
 func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, paramSpace params.Subspace, accountKeeper auth.AccountKeeper, bankKeeper bank.Keeper,
 	stakingKeeper staking.Keeper,
 	router sdk.Router, homeDir string, wasmConfig types.WasmConfig, supportedFeatures string, customEncoders *MessageEncoders, customPlugins *QueryPlugins) Keeper {
-	wasmer, err := wasm.NewWasmer(filepath.Join(homeDir, "wasm"), supportedFeatures, wasmConfig.CacheSize)
+	cacheDir := filepath.Join(homeDir, "wasm")
+	if wasmConfig.CacheDir != "" {
+		cacheDir = wasmConfig.CacheDir
+	}
+	wasmer, err := wasm.NewWasmer(cacheDir, supportedFeatures, wasmConfig.CacheSize)
 	if err != nil {
 		panic(err)
 	}
@@ -77,13 +85,21 @@ func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, paramSpace params.Subspa
 	keeper := Keeper{
 		storeKey:      storeKey,
 		cdc:           cdc,
-		wasmer:        *wasmer,
+		vms:           map[string]types.WasmerEngine{types.InterfaceVersion3: wasmer},
 		accountKeeper: accountKeeper,
 		bankKeeper:    bankKeeper,
 		messenger:     NewMessageHandler(router, customEncoders),
 		queryGasLimit: wasmConfig.SmartQueryGasLimit,
 		authZPolicy:   DefaultAuthorizationPolicy{},
 		paramSpace:    paramSpace,
+
+		contractDebugMode: wasmConfig.ContractDebugMode,
+		metrics:           NopMetrics(),
+		callStats:         newCallStats(wasmConfig.CacheSize),
+		compiled:          newCompiledCodes(),
+	}
+	if wasmConfig.ContractTraceMode {
+		keeper.tracer = newTracer()
 	}
 	keeper.queryPlugins = DefaultQueryPlugins(bankKeeper, stakingKeeper, &keeper).Merge(customPlugins)
 	return keeper
@@ -95,16 +111,76 @@ func (k Keeper) getUploadAccessConfig(ctx sdk.Context) types.AccessConfig {
 	return a
 }
 
+func (k Keeper) getSecurityAddress(ctx sdk.Context) sdk.AccAddress {
+	var a sdk.AccAddress
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreKeySecurityAddress, &a)
+	return a
+}
+
+// GetFeeDiscount returns the share of the tx fee that is paid from the community pool for executions of the contract
+func (k Keeper) GetFeeDiscount(ctx sdk.Context, contractAddr sdk.AccAddress) (sdk.Dec, bool) {
+	var discounts []types.FeeDiscount
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreKeyFeeDiscounts, &discounts)
+	for _, d := range discounts {
+		if d.Contract.Equals(contractAddr) {
+			return d.Discount, true
+		}
+	}
+	return sdk.ZeroDec(), false
+}
+
+// paramsCtx returns a context that does not charge param reads. The limits below are read for every contract call
+// and charging them would change the gas cost of all existing calls. A limit that is not set or 0 uses its default.
+func paramsCtx(ctx sdk.Context) sdk.Context {
+	return ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+}
+
+func (k Keeper) getMaxWasmCodeSize(ctx sdk.Context) uint64 {
+	var a uint64
+	k.paramSpace.GetIfExists(paramsCtx(ctx), types.ParamStoreKeyMaxWasmCodeSize, &a)
+	if a == 0 {
+		return uint64(types.MaxWasmSize)
+	}
+	return a
+}
+
+func (k Keeper) getMaxContractGas(ctx sdk.Context) uint64 {
+	var a uint64
+	k.paramSpace.GetIfExists(paramsCtx(ctx), types.ParamStoreKeyMaxContractGas, &a)
+	if a == 0 {
+		return types.DefaultMaxContractGas
+	}
+	return a
+}
+
+func (k Keeper) getGasMultiplier(ctx sdk.Context) uint64 {
+	var a uint64
+	k.paramSpace.GetIfExists(paramsCtx(ctx), types.ParamStoreKeyGasMultiplier, &a)
+	if a == 0 {
+		return types.DefaultGasMultiplier
+	}
+	return a
+}
+
 func (k Keeper) getInstantiateAccessConfig(ctx sdk.Context) types.AccessType {
 	var a types.AccessType
 	k.paramSpace.Get(ctx, types.ParamStoreKeyInstantiateAccess, &a)
 	return a
 }
 
-// GetParams returns the total set of wasm parameters.
+// GetParams returns the total set of wasm parameters. The optional params are not set on chains started before
+// they were added, so they are read one by one and left empty when they are not set.
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	var params types.Params
-	k.paramSpace.GetParamSet(ctx, &params)
+	params := types.Params{
+		UploadAccess:                 k.getUploadAccessConfig(ctx),
+		DefaultInstantiatePermission: k.getInstantiateAccessConfig(ctx),
+		SecurityAddress:              k.getSecurityAddress(ctx),
+	}
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreKeyFeeDiscounts, &params.FeeDiscounts)
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreKeyMaxWasmCodeSize, &params.MaxWasmCodeSize)
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreKeyMaxContractGas, &params.MaxContractGas)
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreKeyGasMultiplier, &params.GasMultiplier)
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreKeyDeveloperFeeShare, &params.DeveloperFeeShare)
 	return params
 }
 
@@ -114,49 +190,86 @@ func (k Keeper) setParams(ctx sdk.Context, ps types.Params) {
 
 // Create uploads and compiles a WASM contract, returning a short identifier for the contract
 func (k Keeper) Create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, source string, builder string, instantiateAccess *types.AccessConfig) (codeID uint64, err error) {
-	return k.create(ctx, creator, wasmCode, source, builder, instantiateAccess, k.authZPolicy)
+	return k.create(ctx, creator, wasmCode, source, builder, instantiateAccess, k.authZPolicy, false)
+}
+
+// CreateDeduplicated is Create, but returns the id of a stored code with the same checksum and instantiate permission
+// instead of storing another copy of it
+func (k Keeper) CreateDeduplicated(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, source string, builder string, instantiateAccess *types.AccessConfig) (codeID uint64, err error) {
+	return k.create(ctx, creator, wasmCode, source, builder, instantiateAccess, k.authZPolicy, true)
 }
 
-func (k Keeper) create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, source string, builder string, instantiateAccess *types.AccessConfig, authZ AuthorizationPolicy) (codeID uint64, err error) {
+func (k Keeper) create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, source string, builder string, instantiateAccess *types.AccessConfig, authZ AuthorizationPolicy, dedup bool) (codeID uint64, err error) {
 	if !authZ.CanCreateCode(k.getUploadAccessConfig(ctx), creator) {
 		return 0, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not create code")
 	}
+	if maxSize := k.getMaxWasmCodeSize(ctx); uint64(len(wasmCode)) > maxSize {
+		return 0, sdkerrors.Wrapf(types.ErrLimit, "code cannot be longer than %d bytes", maxSize)
+	}
+	uploaded := wasmCode
 	wasmCode, err = uncompress(wasmCode)
 	if err != nil {
 		return 0, sdkerrors.Wrap(types.ErrCreateFailed, err.Error())
 	}
+	if instantiateAccess == nil {
+		defaultAccessConfig := k.getInstantiateAccessConfig(ctx).With(creator)
+		instantiateAccess = &defaultAccessConfig
+	}
+	if dedup {
+		checksum := sha256.Sum256(wasmCode)
+		if existingID, found := k.findCode(ctx, checksum[:], *instantiateAccess); found {
+			return existingID, nil
+		}
+	}
 	ctx.GasMeter().ConsumeGas(CompileCost*uint64(len(wasmCode)), "Compiling WASM Bytecode")
 
-	codeHash, err := k.wasmer.Create(wasmCode)
+	version, err := interfaceVersion(wasmCode)
+	if err != nil {
+		return 0, sdkerrors.Wrap(types.ErrCreateFailed, err.Error())
+	}
+	vm, err := k.vmFor(version)
+	if err != nil {
+		return 0, sdkerrors.Wrap(types.ErrCreateFailed, err.Error())
+	}
+	codeHash, err := vm.Create(wasmCode)
 	if err != nil {
 		// return 0, sdkerrors.Wrap(err, "cosmwasm create")
 		return 0, sdkerrors.Wrap(types.ErrCreateFailed, err.Error())
 	}
+	k.storeBytecode(ctx, codeHash, uploaded)
+	k.compiled.add(codeHash)
 	store := ctx.KVStore(k.storeKey)
 	codeID = k.autoIncrementID(ctx, types.KeyLastCodeID)
-	if instantiateAccess == nil {
-		defaultAccessConfig := k.getInstantiateAccessConfig(ctx).With(creator)
-		instantiateAccess = &defaultAccessConfig
-	}
-	codeInfo := types.NewCodeInfo(codeHash, creator, source, builder, *instantiateAccess)
+	codeInfo := types.NewCodeInfo(codeHash, creator, source, builder, *instantiateAccess, version)
 	// 0x01 | codeID (uint64) -> ContractInfo
 	store.Set(types.GetCodeKey(codeID), k.cdc.MustMarshalBinaryBare(codeInfo))
+	k.indexCode(ctx, codeID, codeHash)
 
 	return codeID, nil
 }
 
-func (k Keeper) importCode(ctx sdk.Context, codeID uint64, codeInfo types.CodeInfo, wasmCode []byte) error {
-	wasmCode, err := uncompress(wasmCode)
+func (k Keeper) importCode(ctx sdk.Context, codeID uint64, codeInfo types.CodeInfo, uploaded []byte) error {
+	wasmCode, err := uncompress(uploaded)
 	if err != nil {
 		return sdkerrors.Wrap(types.ErrCreateFailed, err.Error())
 	}
-	newCodeHash, err := k.wasmer.Create(wasmCode)
+	codeInfo.InterfaceVersion, err = interfaceVersion(wasmCode)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrCreateFailed, err.Error())
+	}
+	vm, err := k.vmFor(codeInfo.InterfaceVersion)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrCreateFailed, err.Error())
+	}
+	newCodeHash, err := vm.Create(wasmCode)
 	if err != nil {
 		return sdkerrors.Wrap(types.ErrCreateFailed, err.Error())
 	}
 	if !bytes.Equal(codeInfo.CodeHash, newCodeHash) {
 		return sdkerrors.Wrap(types.ErrInvalid, "code hashes not same")
 	}
+	k.storeBytecode(ctx, newCodeHash, uploaded)
+	k.compiled.add(newCodeHash)
 
 	store := ctx.KVStore(k.storeKey)
 	key := types.GetCodeKey(codeID)
@@ -165,32 +278,36 @@ func (k Keeper) importCode(ctx sdk.Context, codeID uint64, codeInfo types.CodeIn
 	}
 	// 0x01 | codeID (uint64) -> ContractInfo
 	store.Set(key, k.cdc.MustMarshalBinaryBare(codeInfo))
+	k.indexCode(ctx, codeID, codeInfo.CodeHash)
 	return nil
 }
 
-// Instantiate creates an instance of a WASM contract
-func (k Keeper) Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins) (sdk.AccAddress, error) {
+// Instantiate creates an instance of a WASM contract. It returns the address of the new contract and the data
+// returned by the contract.
+func (k Keeper) Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins) (sdk.AccAddress, []byte, error) {
 	return k.instantiate(ctx, codeID, creator, admin, initMsg, label, deposit, k.authZPolicy)
 }
 
-func (k Keeper) instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins, authZ AuthorizationPolicy) (sdk.AccAddress, error) {
+func (k Keeper) instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins, authZ AuthorizationPolicy) (_ sdk.AccAddress, _ []byte, err error) {
+	defer func() { k.recordFailure(types.GasReportOperationInstantiate, err) }()
+	gasStart := ctx.GasMeter().GasConsumed()
 	ctx.GasMeter().ConsumeGas(InstanceCost, "Loading CosmWasm module: init")
 
 	// create contract address
 	contractAddress := k.generateContractAddress(ctx, codeID)
 	existingAcct := k.accountKeeper.GetAccount(ctx, contractAddress)
 	if existingAcct != nil {
-		return nil, sdkerrors.Wrap(types.ErrAccountExists, existingAcct.GetAddress().String())
+		return nil, nil, sdkerrors.Wrap(types.ErrAccountExists, existingAcct.GetAddress().String())
 	}
 
 	// deposit initial contract funds
 	if !deposit.IsZero() {
 		if k.bankKeeper.BlacklistedAddr(creator) {
-			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "blocked address can not be used")
+			return nil, nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "blocked address can not be used")
 		}
 		sdkerr := k.bankKeeper.SendCoins(ctx, creator, contractAddress, deposit)
 		if sdkerr != nil {
-			return nil, sdkerr
+			return nil, nil, sdkerr
 		}
 	} else {
 		// create an empty account (so we don't have issues later)
@@ -203,13 +320,17 @@ func (k Keeper) instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.A
 	store := ctx.KVStore(k.storeKey)
 	bz := store.Get(types.GetCodeKey(codeID))
 	if bz == nil {
-		return nil, sdkerrors.Wrap(types.ErrNotFound, "code")
+		return nil, nil, sdkerrors.Wrap(types.ErrNotFound, "code")
 	}
 	var codeInfo types.CodeInfo
 	k.cdc.MustUnmarshalBinaryBare(bz, &codeInfo)
 
 	if !authZ.CanInstantiateContract(codeInfo.InstantiateConfig, creator) {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not instantiate")
+		return nil, nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not instantiate")
+	}
+	vm, err := k.compiledVM(ctx, codeInfo)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	// prepare params for contract instantiate call
@@ -222,43 +343,71 @@ func (k Keeper) instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.A
 
 	// prepare querier
 	querier := QueryHandler{
-		Ctx:     ctx,
-		Plugins: k.queryPlugins,
+		Ctx:           ctx,
+		Plugins:       k.queryPlugins,
+		GasMultiplier: k.getGasMultiplier(ctx),
 	}
 
 	// instantiate wasm contract
-	gas := gasForContract(ctx)
-	res, gasUsed, err := k.wasmer.Instantiate(codeInfo.CodeHash, params, initMsg, prefixStore, cosmwasmAPI, querier, gasMeter(ctx), gas)
-	consumeGas(ctx, gasUsed)
+	gas := k.gasForContract(ctx)
+	report := types.GasReport{Contract: contractAddress, Operation: types.GasReportOperationInstantiate}
+	storageStart := ctx.GasMeter().GasConsumed()
+	trace := k.startContractTrace(ctx, types.GasReportOperationInstantiate, contractAddress)
+	callStart := time.Now()
+	res, gasUsed, err := vm.Instantiate(codeInfo.CodeHash, params, initMsg, k.tracedStore(ctx, trace, prefixStore), cosmwasmAPI, k.tracedQuerier(ctx, trace, querier), k.gasMeter(ctx), gas)
+	k.debugContractCall(ctx, types.GasReportOperationInstantiate, contractAddress, gasUsed, err)
+	report.Storage = ctx.GasMeter().GasConsumed() - storageStart
+	report.VM = k.consumeGas(ctx, gasUsed)
+	k.recordContractCall(ctx, types.GasReportOperationInstantiate, contractAddress, codeInfo.CodeHash, callStart, report.Storage+report.VM)
 	if err != nil {
-		return contractAddress, sdkerrors.Wrap(types.ErrInstantiateFailed, err.Error())
+		return contractAddress, nil, sdkerrors.Wrap(types.ErrInstantiateFailed, err.Error())
 	}
 
 	// emit all events from this contract itself
 	events := types.ParseEvents(res.Log, contractAddress)
 	ctx.EventManager().EmitEvents(events)
 
+	dispatchStart := ctx.GasMeter().GasConsumed()
 	err = k.dispatchMessages(ctx, contractAddress, res.Messages)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
+	report.Dispatch = ctx.GasMeter().GasConsumed() - dispatchStart
 
 	// persist instance
 	createdAt := types.NewAbsoluteTxPosition(ctx)
 	instance := types.NewContractInfo(codeID, creator, admin, label, createdAt)
-	store.Set(types.GetContractAddressKey(contractAddress), k.cdc.MustMarshalBinaryBare(instance))
+	k.setContractInfo(ctx, contractAddress, &instance)
 	k.appendToContractHistory(ctx, contractAddress, instance.InitialHistory(initMsg))
-	return contractAddress, nil
+	k.recordContractProvenance(ctx, contractAddress)
+
+	report.Total = ctx.GasMeter().GasConsumed() - gasStart
+	ctx.EventManager().EmitEvent(report.Event())
+	return contractAddress, res.Data, nil
 }
 
 // Execute executes the contract instance
-func (k Keeper) Execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) (*sdk.Result, error) {
+func (k Keeper) Execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) (_ *sdk.Result, err error) {
+	defer func() { k.recordFailure(types.GasReportOperationExecute, err) }()
+	gasStart := ctx.GasMeter().GasConsumed()
 	ctx.GasMeter().ConsumeGas(InstanceCost, "Loading CosmWasm module: execute")
 
+	if err := k.assertExecutionAllowed(ctx, contractAddress); err != nil {
+		return nil, err
+	}
+	limits, err := k.applyContractLimits(ctx, contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
 	codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
 	if err != nil {
 		return nil, err
 	}
+	vm, err := k.compiledVM(ctx, codeInfo)
+	if err != nil {
+		return nil, err
+	}
 
 	// add more funds
 	if !coins.IsZero() {
@@ -276,13 +425,24 @@ func (k Keeper) Execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 
 	// prepare querier
 	querier := QueryHandler{
-		Ctx:     ctx,
-		Plugins: k.queryPlugins,
-	}
-
-	gas := gasForContract(ctx)
-	res, gasUsed, execErr := k.wasmer.Execute(codeInfo.CodeHash, params, msg, prefixStore, cosmwasmAPI, querier, gasMeter(ctx), gas)
-	consumeGas(ctx, gasUsed)
+		Ctx:           ctx,
+		Plugins:       k.queryPlugins,
+		GasMultiplier: k.getGasMultiplier(ctx),
+	}
+
+	gas := k.gasForContract(ctx)
+	if limits.MaxGasPerExecution != 0 && gas > limits.MaxGasPerExecution {
+		gas = limits.MaxGasPerExecution
+	}
+	report := types.GasReport{Contract: contractAddress, Operation: types.GasReportOperationExecute}
+	storageStart := ctx.GasMeter().GasConsumed()
+	trace := k.startContractTrace(ctx, types.GasReportOperationExecute, contractAddress)
+	callStart := time.Now()
+	res, gasUsed, execErr := vm.Execute(codeInfo.CodeHash, params, msg, k.tracedStore(ctx, trace, prefixStore), cosmwasmAPI, k.tracedQuerier(ctx, trace, querier), k.gasMeter(ctx), gas)
+	k.debugContractCall(ctx, types.GasReportOperationExecute, contractAddress, gasUsed, execErr)
+	report.Storage = ctx.GasMeter().GasConsumed() - storageStart
+	report.VM = k.consumeGas(ctx, gasUsed)
+	k.recordContractCall(ctx, types.GasReportOperationExecute, contractAddress, codeInfo.CodeHash, callStart, report.Storage+report.VM)
 	if execErr != nil {
 		return nil, sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
@@ -291,22 +451,34 @@ func (k Keeper) Execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 	events := types.ParseEvents(res.Log, contractAddress)
 	ctx.EventManager().EmitEvents(events)
 
+	dispatchStart := ctx.GasMeter().GasConsumed()
 	err = k.dispatchMessages(ctx, contractAddress, res.Messages)
 	if err != nil {
 		return nil, err
 	}
+	report.Dispatch = ctx.GasMeter().GasConsumed() - dispatchStart
 
+	report.Total = ctx.GasMeter().GasConsumed() - gasStart
+	ctx.EventManager().EmitEvent(report.Event())
 	return &sdk.Result{
 		Data: res.Data,
 	}, nil
 }
 
+// Sudo calls the privileged entry point of the contract, as decided by governance. go-cosmwasm has no sudo entry
+// point, so the msg is passed to the handle entry point with the SudoAddress as sender.
+func (k Keeper) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) (*sdk.Result, error) {
+	return k.Execute(ctx, contractAddress, types.SudoAddress, msg, nil)
+}
+
 // Migrate allows to upgrade a contract to a new code with data migration.
 func (k Keeper) Migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, newCodeID uint64, msg []byte) (*sdk.Result, error) {
 	return k.migrate(ctx, contractAddress, caller, newCodeID, msg, k.authZPolicy)
 }
 
-func (k Keeper) migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, newCodeID uint64, msg []byte, authZ AuthorizationPolicy) (*sdk.Result, error) {
+func (k Keeper) migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, newCodeID uint64, msg []byte, authZ AuthorizationPolicy) (_ *sdk.Result, err error) {
+	defer func() { k.recordFailure(types.GasReportOperationMigrate, err) }()
+	gasStart := ctx.GasMeter().GasConsumed()
 	ctx.GasMeter().ConsumeGas(InstanceCost, "Loading CosmWasm module: migrate")
 
 	contractInfo := k.GetContractInfo(ctx, contractAddress)
@@ -321,21 +493,33 @@ func (k Keeper) migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 	if newCodeInfo == nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unknown code")
 	}
+	vm, err := k.compiledVM(ctx, *newCodeInfo)
+	if err != nil {
+		return nil, err
+	}
 
 	var noDeposit sdk.Coins
 	params := types.NewEnv(ctx, caller, noDeposit, contractAddress)
 
 	// prepare querier
 	querier := QueryHandler{
-		Ctx:     ctx,
-		Plugins: k.queryPlugins,
+		Ctx:           ctx,
+		Plugins:       k.queryPlugins,
+		GasMultiplier: k.getGasMultiplier(ctx),
 	}
 
 	prefixStoreKey := types.GetContractStorePrefixKey(contractAddress)
 	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixStoreKey)
-	gas := gasForContract(ctx)
-	res, gasUsed, err := k.wasmer.Migrate(newCodeInfo.CodeHash, params, msg, &prefixStore, cosmwasmAPI, &querier, gasMeter(ctx), gas)
-	consumeGas(ctx, gasUsed)
+	gas := k.gasForContract(ctx)
+	report := types.GasReport{Contract: contractAddress, Operation: types.GasReportOperationMigrate}
+	storageStart := ctx.GasMeter().GasConsumed()
+	trace := k.startContractTrace(ctx, types.GasReportOperationMigrate, contractAddress)
+	callStart := time.Now()
+	res, gasUsed, err := vm.Migrate(newCodeInfo.CodeHash, params, msg, k.tracedStore(ctx, trace, &prefixStore), cosmwasmAPI, k.tracedQuerier(ctx, trace, &querier), k.gasMeter(ctx), gas)
+	k.debugContractCall(ctx, types.GasReportOperationMigrate, contractAddress, gasUsed, err)
+	report.Storage = ctx.GasMeter().GasConsumed() - storageStart
+	report.VM = k.consumeGas(ctx, gasUsed)
+	k.recordContractCall(ctx, types.GasReportOperationMigrate, contractAddress, newCodeInfo.CodeHash, callStart, report.Storage+report.VM)
 	if err != nil {
 		return nil, sdkerrors.Wrap(types.ErrMigrationFailed, err.Error())
 	}
@@ -348,10 +532,14 @@ func (k Keeper) migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 	k.appendToContractHistory(ctx, contractAddress, historyEntry)
 	k.setContractInfo(ctx, contractAddress, contractInfo)
 
+	dispatchStart := ctx.GasMeter().GasConsumed()
 	if err := k.dispatchMessages(ctx, contractAddress, res.Messages); err != nil {
 		return nil, sdkerrors.Wrap(err, "dispatch")
 	}
+	report.Dispatch = ctx.GasMeter().GasConsumed() - dispatchStart
 
+	report.Total = ctx.GasMeter().GasConsumed() - gasStart
+	ctx.EventManager().EmitEvent(report.Event())
 	return &sdk.Result{
 		Data: res.Data,
 	}, nil
@@ -397,26 +585,60 @@ func (k Keeper) GetContractHistory(ctx sdk.Context, contractAddr sdk.AccAddress)
 }
 
 // QuerySmart queries the smart contract itself.
-func (k Keeper) QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error) {
+func (k Keeper) QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) (_ []byte, err error) {
+	defer func() { k.recordFailure("query", err) }()
 	ctx.GasMeter().ConsumeGas(InstanceCost, "Loading CosmWasm module: query")
 
 	codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
 	if err != nil {
 		return nil, err
 	}
+	vm, err := k.compiledVM(ctx, codeInfo)
+	if err != nil {
+		return nil, err
+	}
 	// prepare querier
 	querier := QueryHandler{
-		Ctx:     ctx,
-		Plugins: k.queryPlugins,
-	}
-	queryResult, gasUsed, qErr := k.wasmer.Query(codeInfo.CodeHash, req, prefixStore, cosmwasmAPI, querier, gasMeter(ctx), gasForContract(ctx))
-	consumeGas(ctx, gasUsed)
+		Ctx:           ctx,
+		Plugins:       k.queryPlugins,
+		GasMultiplier: k.getGasMultiplier(ctx),
+	}
+	storageStart := ctx.GasMeter().GasConsumed()
+	trace := k.startContractTrace(ctx, "query", contractAddr)
+	callStart := time.Now()
+	queryResult, gasUsed, qErr := vm.Query(codeInfo.CodeHash, req, k.tracedStore(ctx, trace, prefixStore), cosmwasmAPI, k.tracedQuerier(ctx, trace, querier), k.gasMeter(ctx), k.gasForContract(ctx))
+	k.debugContractCall(ctx, "query", contractAddr, gasUsed, qErr)
+	storageGas := ctx.GasMeter().GasConsumed() - storageStart
+	k.recordContractCall(ctx, "query", contractAddr, codeInfo.CodeHash, callStart, storageGas+k.consumeGas(ctx, gasUsed))
 	if qErr != nil {
 		return nil, sdkerrors.Wrap(types.ErrQueryFailed, qErr.Error())
 	}
 	return queryResult, nil
 }
 
+// QuerySmartWithGasLimit runs a smart query of a client. The query is limited to the query gas limit of the node, or
+// to the lower gas limit of the client, 0 for the node limit, and fails with an out of gas error when it exceeds it.
+func (k Keeper) QuerySmartWithGasLimit(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte, gasLimit uint64) (res []byte, err error) {
+	if gasLimit > k.queryGasLimit {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "gas limit %d above the query gas limit %d of the node", gasLimit, k.queryGasLimit)
+	}
+	if gasLimit == 0 {
+		gasLimit = k.queryGasLimit
+	}
+	ctx = ctx.WithGasMeter(sdk.NewGasMeter(gasLimit))
+	// the querier of the app does not recover, an out of gas panic must not reach it
+	defer func() {
+		if r := recover(); r != nil {
+			oog, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			res, err = nil, sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "query gas limit %d exceeded in %s", gasLimit, oog.Descriptor)
+		}
+	}()
+	return k.QuerySmart(ctx, contractAddr, req)
+}
+
 // QueryRaw returns the contract's state for give key. For a `nil` key a empty slice result is returned.
 func (k Keeper) QueryRaw(ctx sdk.Context, contractAddress sdk.AccAddress, key []byte) []types.Model {
 	result := make([]types.Model, 0)
@@ -473,6 +695,7 @@ func (k Keeper) containsContractInfo(ctx sdk.Context, contractAddress sdk.AccAdd
 }
 
 func (k Keeper) setContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress, contract *types.ContractInfo) {
+	k.updateContractIndexes(ctx, contractAddress, contract)
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.GetContractAddressKey(contractAddress), k.cdc.MustMarshalBinaryBare(contract))
 }
@@ -540,6 +763,7 @@ func (k Keeper) IterateCodeInfos(ctx sdk.Context, cb func(uint64, types.CodeInfo
 	}
 }
 
+// GetByteCode returns the uncompressed wasm code of the code id, nil when the code does not exist
 func (k Keeper) GetByteCode(ctx sdk.Context, codeID uint64) ([]byte, error) {
 	store := ctx.KVStore(k.storeKey)
 	var codeInfo types.CodeInfo
@@ -548,7 +772,16 @@ func (k Keeper) GetByteCode(ctx sdk.Context, codeID uint64) ([]byte, error) {
 		return nil, nil
 	}
 	k.cdc.MustUnmarshalBinaryBare(codeInfoBz, &codeInfo)
-	return k.wasmer.GetCode(codeInfo.CodeHash)
+	wasmCode, found, err := k.loadBytecode(ctx, codeInfo.CodeHash)
+	if err != nil || found {
+		return wasmCode, err
+	}
+	// code stored before it was kept in the state
+	vm, err := k.vmFor(codeInfo.GetInterfaceVersion())
+	if err != nil {
+		return nil, err
+	}
+	return vm.GetCode(codeInfo.CodeHash)
 }
 
 func (k Keeper) dispatchMessages(ctx sdk.Context, contractAddr sdk.AccAddress, msgs []wasmTypes.CosmosMsg) error {
@@ -560,22 +793,39 @@ func (k Keeper) dispatchMessages(ctx sdk.Context, contractAddr sdk.AccAddress, m
 	return nil
 }
 
-func gasForContract(ctx sdk.Context) uint64 {
+func (k Keeper) gasForContract(ctx sdk.Context) uint64 {
 	meter := ctx.GasMeter()
-	remaining := (meter.Limit() - meter.GasConsumed()) * GasMultiplier
-	if remaining > MaxGas {
-		return MaxGas
+	remaining := (meter.Limit() - meter.GasConsumed()) * k.getGasMultiplier(ctx)
+	if maxGas := k.getMaxContractGas(ctx); remaining > maxGas {
+		return maxGas
 	}
 	return remaining
 }
 
-func consumeGas(ctx sdk.Context, gas uint64) {
-	consumed := gas / GasMultiplier
+// consumeGas charges the wasm gas to the context and returns the consumed sdk gas
+func (k Keeper) consumeGas(ctx sdk.Context, gas uint64) uint64 {
+	consumed := gas / k.getGasMultiplier(ctx)
 	ctx.GasMeter().ConsumeGas(consumed, "wasm contract")
 	// throw OutOfGas error if we ran out (got exactly to zero due to better limit enforcing)
 	if ctx.GasMeter().IsOutOfGas() {
 		panic(sdk.ErrorOutOfGas{"Wasmer function execution"})
 	}
+	return consumed
+}
+
+// debugContractCall logs the contract call when the contract debug mode is enabled. It runs before the gas is charged,
+// so that calls running out of gas are logged as well.
+func (k Keeper) debugContractCall(ctx sdk.Context, operation string, contractAddr sdk.AccAddress, wasmGas uint64, err error) {
+	if !k.contractDebugMode {
+		return
+	}
+	logger := ctx.Logger().With("module", "x/"+types.ModuleName, "contract", contractAddr.String(),
+		"operation", operation, "wasm_gas", wasmGas)
+	if err != nil {
+		logger.Info("contract call failed", "error", err.Error())
+		return
+	}
+	logger.Info("contract call")
 }
 
 // generates a contract address from codeID + instanceID
@@ -659,16 +909,18 @@ func addrFromUint64(id uint64) sdk.AccAddress {
 // MultipliedGasMeter wraps the GasMeter from context and multiplies all reads by out defined multiplier
 type MultipiedGasMeter struct {
 	originalMeter sdk.GasMeter
+	multiplier    uint64
 }
 
 var _ wasm.GasMeter = MultipiedGasMeter{}
 
 func (m MultipiedGasMeter) GasConsumed() sdk.Gas {
-	return m.originalMeter.GasConsumed() * GasMultiplier
+	return m.originalMeter.GasConsumed() * m.multiplier
 }
 
-func gasMeter(ctx sdk.Context) MultipiedGasMeter {
+func (k Keeper) gasMeter(ctx sdk.Context) MultipiedGasMeter {
 	return MultipiedGasMeter{
 		originalMeter: ctx.GasMeter(),
+		multiplier:    k.getGasMultiplier(ctx),
 	}
 }
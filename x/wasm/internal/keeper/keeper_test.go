@@ -32,6 +32,24 @@ func TestNewKeeper(t *testing.T) {
 	require.NotNil(t, keepers.WasmKeeper)
 }
 
+func TestGetParams(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keepers := CreateTestInput(t, false, tempDir, SupportedFeatures, nil, nil)
+	keeper := keepers.WasmKeeper
+	assert.Equal(t, types.DefaultParams().String(), keeper.GetParams(ctx).String())
+
+	share := sdk.NewDecWithPrec(1, 1)
+	params := types.DefaultParams()
+	params.SecurityAddress = sdk.AccAddress(bytes.Repeat([]byte{1}, sdk.AddrLen))
+	params.FeeDiscounts = []types.FeeDiscount{{Contract: sdk.AccAddress(bytes.Repeat([]byte{2}, sdk.AddrLen)), Discount: sdk.OneDec()}}
+	params.MaxWasmCodeSize, params.MaxContractGas, params.GasMultiplier = 1000, 2000, 50
+	params.DeveloperFeeShare = &share
+	keeper.setParams(ctx, params)
+	assert.Equal(t, params.String(), keeper.GetParams(ctx).String())
+}
+
 func TestCreate(t *testing.T) {
 	tempDir, err := ioutil.TempDir("", "wasm")
 	require.NoError(t, err)
@@ -142,6 +160,13 @@ func TestCreateWithParamPermissions(t *testing.T) {
 			srcPermission: types.OnlyAddress.With(otherAddr),
 			expError:      sdkerrors.ErrUnauthorized,
 		},
+		"anyOfAddresses with matching address": {
+			srcPermission: types.AllowAnyOf(otherAddr, creator),
+		},
+		"anyOfAddresses with non matching address": {
+			srcPermission: types.AllowAnyOf(otherAddr),
+			expError:      sdkerrors.ErrUnauthorized,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -157,6 +182,45 @@ func TestCreateWithParamPermissions(t *testing.T) {
 	}
 }
 
+func TestCreateWithMaxWasmCodeSize(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keepers := CreateTestInput(t, false, tempDir, SupportedFeatures, nil, nil)
+	accKeeper, keeper := keepers.AccountKeeper, keepers.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/contract.wasm")
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		srcMaxSize uint64
+		expError   *sdkerrors.Error
+	}{
+		"default": {
+			srcMaxSize: 0,
+		},
+		"exact size": {
+			srcMaxSize: uint64(len(wasmCode)),
+		},
+		"too big": {
+			srcMaxSize: uint64(len(wasmCode)) - 1,
+			expError:   types.ErrLimit,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.MaxWasmCodeSize = spec.srcMaxSize
+			keeper.setParams(ctx, params)
+			_, err := keeper.Create(ctx, creator, wasmCode, "", "", nil)
+			require.True(t, spec.expError.Is(err), err)
+		})
+	}
+}
+
 func TestCreateDuplicate(t *testing.T) {
 	tempDir, err := ioutil.TempDir("", "wasm")
 	require.NoError(t, err)
@@ -301,7 +365,7 @@ func TestInstantiate(t *testing.T) {
 	gasBefore := ctx.GasMeter().GasConsumed()
 
 	// create with no balance is also legal
-	contractAddr, err := keeper.Instantiate(ctx, contractID, creator, nil, initMsgBz, "demo contract 1", nil)
+	contractAddr, _, err := keeper.Instantiate(ctx, contractID, creator, nil, initMsgBz, "demo contract 1", nil)
 	require.NoError(t, err)
 	require.Equal(t, "fetch18vd8fpwxzck93qlwghaj6arh4p7c5n890l3amr", contractAddr.String())
 
@@ -373,7 +437,7 @@ func TestInstantiateWithDeposit(t *testing.T) {
 			require.NoError(t, err)
 
 			// when
-			addr, err := keeper.Instantiate(ctx, contractID, spec.srcActor, nil, initMsgBz, "my label", deposit)
+			addr, _, err := keeper.Instantiate(ctx, contractID, spec.srcActor, nil, initMsgBz, "my label", deposit)
 			// then
 			if spec.expError {
 				require.Error(t, err)
@@ -444,7 +508,7 @@ func TestInstantiateWithPermissions(t *testing.T) {
 			contractID, err := keeper.Create(ctx, myAddr, wasmCode, "https://github.com/fetchai/fetchd/blob/master/x/wasm/testdata/escrow.wasm", "", &spec.srcPermission)
 			require.NoError(t, err)
 
-			_, err = keeper.Instantiate(ctx, contractID, spec.srcActor, nil, initMsgBz, "demo contract 1", nil)
+			_, _, err = keeper.Instantiate(ctx, contractID, spec.srcActor, nil, initMsgBz, "demo contract 1", nil)
 			assert.True(t, spec.expError.Is(err), "got %+v", err)
 		})
 	}
@@ -467,7 +531,7 @@ func TestInstantiateWithNonExistingCodeID(t *testing.T) {
 	require.NoError(t, err)
 
 	const nonExistingCodeID = 9999
-	addr, err := keeper.Instantiate(ctx, nonExistingCodeID, creator, nil, initMsgBz, "demo contract 2", nil)
+	addr, _, err := keeper.Instantiate(ctx, nonExistingCodeID, creator, nil, initMsgBz, "demo contract 2", nil)
 	require.True(t, types.ErrNotFound.Is(err), err)
 	require.Nil(t, addr)
 }
@@ -498,7 +562,7 @@ func TestExecute(t *testing.T) {
 	initMsgBz, err := json.Marshal(initMsg)
 	require.NoError(t, err)
 
-	addr, err := keeper.Instantiate(ctx, contractID, creator, nil, initMsgBz, "demo contract 3", deposit)
+	addr, _, err := keeper.Instantiate(ctx, contractID, creator, nil, initMsgBz, "demo contract 3", deposit)
 	require.NoError(t, err)
 	require.Equal(t, "fetch18vd8fpwxzck93qlwghaj6arh4p7c5n890l3amr", addr.String())
 
@@ -608,7 +672,7 @@ func TestExecuteWithDeposit(t *testing.T) {
 			initMsgBz, err := json.Marshal(initMsg)
 			require.NoError(t, err)
 
-			contractAddr, err := keeper.Instantiate(ctx, codeID, spec.srcActor, nil, initMsgBz, "my label", nil)
+			contractAddr, _, err := keeper.Instantiate(ctx, codeID, spec.srcActor, nil, initMsgBz, "my label", nil)
 			require.NoError(t, err)
 
 			// when
@@ -668,7 +732,7 @@ func TestExecuteWithPanic(t *testing.T) {
 	initMsgBz, err := json.Marshal(initMsg)
 	require.NoError(t, err)
 
-	addr, err := keeper.Instantiate(ctx, contractID, creator, nil, initMsgBz, "demo contract 4", deposit)
+	addr, _, err := keeper.Instantiate(ctx, contractID, creator, nil, initMsgBz, "demo contract 4", deposit)
 	require.NoError(t, err)
 
 	// let's make sure we get a reasonable error, no panic/crash
@@ -702,7 +766,7 @@ func TestExecuteWithCpuLoop(t *testing.T) {
 	initMsgBz, err := json.Marshal(initMsg)
 	require.NoError(t, err)
 
-	addr, err := keeper.Instantiate(ctx, contractID, creator, nil, initMsgBz, "demo contract 5", deposit)
+	addr, _, err := keeper.Instantiate(ctx, contractID, creator, nil, initMsgBz, "demo contract 5", deposit)
 	require.NoError(t, err)
 
 	// make sure we set a limit before calling
@@ -750,7 +814,7 @@ func TestExecuteWithStorageLoop(t *testing.T) {
 	initMsgBz, err := json.Marshal(initMsg)
 	require.NoError(t, err)
 
-	addr, err := keeper.Instantiate(ctx, contractID, creator, nil, initMsgBz, "demo contract 6", deposit)
+	addr, _, err := keeper.Instantiate(ctx, contractID, creator, nil, initMsgBz, "demo contract 6", deposit)
 	require.NoError(t, err)
 
 	// make sure we set a limit before calling
@@ -878,7 +942,7 @@ func TestMigrate(t *testing.T) {
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
 			ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
-			contractAddr, err := keeper.Instantiate(ctx, originalCodeID, creator, spec.admin, initMsgBz, "demo contract", nil)
+			contractAddr, _, err := keeper.Instantiate(ctx, originalCodeID, creator, spec.admin, initMsgBz, "demo contract", nil)
 			require.NoError(t, err)
 			if spec.overrideContractAddr != nil {
 				contractAddr = spec.overrideContractAddr
@@ -946,7 +1010,7 @@ func TestMigrateWithDispatchedMessage(t *testing.T) {
 	require.NoError(t, err)
 
 	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
-	contractAddr, err := keeper.Instantiate(ctx, originalContractID, creator, fred, initMsgBz, "demo contract", deposit)
+	contractAddr, _, err := keeper.Instantiate(ctx, originalContractID, creator, fred, initMsgBz, "demo contract", deposit)
 	require.NoError(t, err)
 
 	migMsg := struct {
@@ -969,6 +1033,14 @@ func TestMigrateWithDispatchedMessage(t *testing.T) {
 				{"payout": myPayoutAddr},
 			},
 		},
+		{
+			"Type": "wasm-" + contractAddr.String(),
+			"Attr": []dict{
+				{"contract_address": contractAddr},
+				{"action": "burn"},
+				{"payout": myPayoutAddr},
+			},
+		},
 		{
 			"Type": "transfer",
 			"Attr": []dict{
@@ -991,7 +1063,13 @@ func TestMigrateWithDispatchedMessage(t *testing.T) {
 		},
 	}
 	expJsonEvts := string(mustMarshal(t, expEvents))
-	assert.JSONEq(t, expJsonEvts, prettyEvents(t, ctx.EventManager().Events()))
+	// the gas report of the migration comes last
+	events := ctx.EventManager().Events()
+	require.NotEmpty(t, events)
+	gasReport := events[len(events)-1]
+	assert.Equal(t, types.EventTypeGasReport, gasReport.Type)
+	assert.Equal(t, types.GasReportOperationMigrate, string(gasReport.Attributes[1].Value))
+	assert.JSONEq(t, expJsonEvts, prettyEvents(t, events[:len(events)-1]))
 
 	// all persistent data cleared
 	m := keeper.QueryRaw(ctx, contractAddr, []byte("config"))
@@ -1086,7 +1164,7 @@ func TestUpdateContractAdmin(t *testing.T) {
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
 			require.NotNil(t, spec.newAdmin)
-			addr, err := keeper.Instantiate(ctx, originalContractID, creator, spec.instAdmin, initMsgBz, "demo contract", nil)
+			addr, _, err := keeper.Instantiate(ctx, originalContractID, creator, spec.instAdmin, initMsgBz, "demo contract", nil)
 			require.NoError(t, err)
 			if spec.overrideContractAddr != nil {
 				addr = spec.overrideContractAddr
@@ -1155,7 +1233,7 @@ func TestClearContractAdmin(t *testing.T) {
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
-			addr, err := keeper.Instantiate(ctx, originalContractID, creator, spec.instAdmin, initMsgBz, "demo contract", nil)
+			addr, _, err := keeper.Instantiate(ctx, originalContractID, creator, spec.instAdmin, initMsgBz, "demo contract", nil)
 			require.NoError(t, err)
 			if spec.overrideContractAddr != nil {
 				addr = spec.overrideContractAddr
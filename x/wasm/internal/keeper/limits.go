@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+// SetContractLimits sets the limits of the contract within the bounds set by governance.
+// The caller must be the contract admin, empty limits remove the ones set before.
+func (k Keeper) SetContractLimits(ctx sdk.Context, contractAddress, caller sdk.AccAddress, limits types.ContractLimits) error {
+	return k.setContractLimits(ctx, contractAddress, caller, limits, k.authZPolicy)
+}
+
+func (k Keeper) setContractLimits(ctx sdk.Context, contractAddress, caller sdk.AccAddress, limits types.ContractLimits, authZ AuthorizationPolicy) error {
+	contractInfo := k.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	if !authZ.CanModifyContract(contractInfo.Admin, caller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
+	}
+	if err := limits.ValidateBasic(); err != nil {
+		return err
+	}
+	var info types.ContractLimitsInfo
+	if existing := k.GetContractLimits(ctx, contractAddress); existing != nil {
+		info = *existing
+	}
+	if err := limits.WithinBounds(info.Bounds); err != nil {
+		return err
+	}
+	info.Admin = limits
+	k.storeContractLimits(ctx, contractAddress, info)
+	return nil
+}
+
+// setContractLimitBounds sets the bounds of the limits of the contract, as decided by governance. Limits set by the
+// admin before are kept, the stricter of both is enforced.
+func (k Keeper) setContractLimitBounds(ctx sdk.Context, contractAddress sdk.AccAddress, bounds types.ContractLimits) error {
+	if !k.containsContractInfo(ctx, contractAddress) {
+		return sdkerrors.Wrap(types.ErrNotFound, "contract")
+	}
+	if err := bounds.ValidateBasic(); err != nil {
+		return err
+	}
+	var info types.ContractLimitsInfo
+	if existing := k.GetContractLimits(ctx, contractAddress); existing != nil {
+		info = *existing
+	}
+	info.Bounds = bounds
+	k.storeContractLimits(ctx, contractAddress, info)
+	return nil
+}
+
+// GetContractLimits returns the bounds and admin limits of the contract, nil when none are set
+func (k Keeper) GetContractLimits(ctx sdk.Context, contractAddress sdk.AccAddress) *types.ContractLimitsInfo {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetContractLimitsKey(contractAddress))
+	if bz == nil {
+		return nil
+	}
+	var info types.ContractLimitsInfo
+	k.cdc.MustUnmarshalBinaryBare(bz, &info)
+	return &info
+}
+
+// applyContractLimits counts the execution of the contract against its executions per block limit and returns the
+// limits to enforce. ErrLimit is returned when the contract was executed too often in this block.
+// Like assertExecutionAllowed, it works on the raw store so that contracts without limits keep their gas cost.
+func (k Keeper) applyContractLimits(ctx sdk.Context, contractAddress sdk.AccAddress) (types.ContractLimits, error) {
+	store := ctx.MultiStore().GetKVStore(k.storeKey)
+	bz := store.Get(types.GetContractLimitsKey(contractAddress))
+	if bz == nil {
+		return types.ContractLimits{}, nil
+	}
+	var info types.ContractLimitsInfo
+	k.cdc.MustUnmarshalBinaryBare(bz, &info)
+	limits := info.Effective()
+	if limits.MaxExecutionsPerBlock == 0 {
+		return limits, nil
+	}
+
+	// the count is stored with the height it belongs to, so it starts over in every block without a cleanup
+	countKey := types.GetContractExecutionCountKey(contractAddress)
+	height := uint64(ctx.BlockHeight())
+	var count uint64
+	if bz := store.Get(countKey); len(bz) == 16 && binary.BigEndian.Uint64(bz) == height {
+		count = binary.BigEndian.Uint64(bz[8:])
+	}
+	if count >= limits.MaxExecutionsPerBlock {
+		return limits, sdkerrors.Wrapf(types.ErrLimit, "contract %s executed %d times in this block", contractAddress, count)
+	}
+	store.Set(countKey, append(sdk.Uint64ToBigEndian(height), sdk.Uint64ToBigEndian(count+1)...))
+	return limits, nil
+}
+
+func (k Keeper) storeContractLimits(ctx sdk.Context, contractAddress sdk.AccAddress, info types.ContractLimitsInfo) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetContractLimitsKey(contractAddress)
+	if info.IsEmpty() {
+		store.Delete(key)
+		store.Delete(types.GetContractExecutionCountKey(contractAddress))
+		return
+	}
+	store.Set(key, k.cdc.MustMarshalBinaryBare(info))
+}
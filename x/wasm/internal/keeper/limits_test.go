@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+func TestSetContractLimits(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keepers := CreateTestInput(t, false, tempDir, SupportedFeatures, nil, nil)
+	accKeeper, keeper := keepers.AccountKeeper, keepers.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, deposit)
+	admin := createFakeFundedAccount(ctx, accKeeper, deposit)
+	_, _, anyAddr := keyPubAddr()
+
+	wasmCode, err := ioutil.ReadFile("./testdata/contract.wasm")
+	require.NoError(t, err)
+	codeID, err := keeper.Create(ctx, creator, wasmCode, "", "", nil)
+	require.NoError(t, err)
+	initMsgBz, err := json.Marshal(InitMsg{Verifier: anyAddr, Beneficiary: anyAddr})
+	require.NoError(t, err)
+	contractAddr, _, err := keeper.Instantiate(ctx, codeID, creator, admin, initMsgBz, "demo contract", nil)
+	require.NoError(t, err)
+
+	bounds := types.ContractLimits{MaxGasPerExecution: 1000000, MaxExecutionsPerBlock: 10}
+	specs := map[string]struct {
+		contract  sdk.AccAddress
+		caller    sdk.AccAddress
+		bounds    types.ContractLimits
+		src       types.ContractLimits
+		expErr    *sdkerrors.Error
+		expLimits *types.ContractLimitsInfo
+	}{
+		"admin sets limits": {
+			contract:  contractAddr,
+			caller:    admin,
+			src:       types.ContractLimits{MaxExecutionsPerBlock: 100},
+			expLimits: &types.ContractLimitsInfo{Admin: types.ContractLimits{MaxExecutionsPerBlock: 100}},
+		},
+		"admin sets limits within bounds": {
+			contract: contractAddr,
+			caller:   admin,
+			bounds:   bounds,
+			src:      types.ContractLimits{MaxGasPerExecution: 500000, MaxExecutionsPerBlock: 10},
+			expLimits: &types.ContractLimitsInfo{
+				Bounds: bounds,
+				Admin:  types.ContractLimits{MaxGasPerExecution: 500000, MaxExecutionsPerBlock: 10},
+			},
+		},
+		"admin clears limits": {
+			contract: contractAddr,
+			caller:   admin,
+		},
+		"admin clears limits with bounds": {
+			contract:  contractAddr,
+			caller:    admin,
+			bounds:    bounds,
+			expLimits: &types.ContractLimitsInfo{Bounds: bounds},
+		},
+		"admin exceeds bounds": {
+			contract: contractAddr,
+			caller:   admin,
+			bounds:   bounds,
+			src:      types.ContractLimits{MaxExecutionsPerBlock: 11},
+			expErr:   types.ErrLimit,
+		},
+		"not the admin": {
+			contract: contractAddr,
+			caller:   creator,
+			src:      types.ContractLimits{MaxExecutionsPerBlock: 1},
+			expErr:   sdkerrors.ErrUnauthorized,
+		},
+		"unknown contract": {
+			contract: anyAddr,
+			caller:   admin,
+			src:      types.ContractLimits{MaxExecutionsPerBlock: 1},
+			expErr:   sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			trialCtx := ctx.WithMultiStore(ctx.MultiStore().CacheWrap().(sdk.MultiStore))
+			require.NoError(t, keeper.setContractLimitBounds(trialCtx, contractAddr, spec.bounds))
+			before := keeper.GetContractLimits(trialCtx, contractAddr)
+
+			err := keeper.SetContractLimits(trialCtx, spec.contract, spec.caller, spec.src)
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(err), err)
+				assert.Equal(t, before, keeper.GetContractLimits(trialCtx, contractAddr))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.expLimits, keeper.GetContractLimits(trialCtx, spec.contract))
+		})
+	}
+}
+
+func TestExecuteWithContractLimits(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keepers := CreateTestInput(t, false, tempDir, SupportedFeatures, nil, nil)
+	accKeeper, keeper := keepers.AccountKeeper, keepers.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, deposit)
+	fred := createFakeFundedAccount(ctx, accKeeper, deposit)
+	_, _, bob := keyPubAddr()
+
+	wasmCode, err := ioutil.ReadFile("./testdata/contract.wasm")
+	require.NoError(t, err)
+	codeID, err := keeper.Create(ctx, creator, wasmCode, "", "", nil)
+	require.NoError(t, err)
+	initMsgBz, err := json.Marshal(InitMsg{Verifier: fred, Beneficiary: bob})
+	require.NoError(t, err)
+	contractAddr, _, err := keeper.Instantiate(ctx, codeID, creator, creator, initMsgBz, "demo contract", nil)
+	require.NoError(t, err)
+
+	t.Run("max executions per block", func(t *testing.T) {
+		trialCtx := ctx.WithMultiStore(ctx.MultiStore().CacheWrap().(sdk.MultiStore))
+		require.NoError(t, keeper.SetContractLimits(trialCtx, contractAddr, creator, types.ContractLimits{MaxExecutionsPerBlock: 2}))
+
+		// failed executions count as well, the creator is not the verifier
+		for i := 0; i < 2; i++ {
+			_, err := keeper.Execute(trialCtx, contractAddr, creator, []byte(`{"release":{}}`), nil)
+			assert.True(t, types.ErrExecuteFailed.Is(err), err)
+		}
+		_, err := keeper.Execute(trialCtx, contractAddr, creator, []byte(`{"release":{}}`), nil)
+		assert.True(t, types.ErrLimit.Is(err), err)
+
+		// the count starts over in the next block
+		nextBlockCtx := trialCtx.WithBlockHeight(trialCtx.BlockHeight() + 1)
+		_, err = keeper.Execute(nextBlockCtx, contractAddr, creator, []byte(`{"release":{}}`), nil)
+		assert.True(t, types.ErrExecuteFailed.Is(err), err)
+	})
+
+	t.Run("max gas per execution", func(t *testing.T) {
+		trialCtx := ctx.WithMultiStore(ctx.MultiStore().CacheWrap().(sdk.MultiStore))
+		require.NoError(t, keeper.setContractLimitBounds(trialCtx, contractAddr, types.ContractLimits{MaxGasPerExecution: 1000000}))
+
+		gasCtx := trialCtx.WithGasMeter(sdk.NewGasMeter(1000000))
+		_, err := keeper.Execute(gasCtx, contractAddr, fred, []byte(`{"cpu_loop":{}}`), nil)
+		// the contract runs out of its own gas without using up the tx gas
+		assert.True(t, types.ErrExecuteFailed.Is(err), err)
+		assert.False(t, gasCtx.GasMeter().IsOutOfGas())
+	})
+}
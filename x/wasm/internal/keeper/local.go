@@ -0,0 +1,218 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	wasm "github.com/CosmWasm/go-cosmwasm"
+	wasmTypes "github.com/CosmWasm/go-cosmwasm/types"
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/gaskv"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+// LocalRunner runs a single contract on an in-memory store without a chain, for a fast development loop.
+// Contract state is kept between the calls and the funds sent to the contract are its balance. The messages the
+// contract returns are not dispatched and queries of the contract other than for bank balances fail.
+type LocalRunner struct {
+	vm       types.WasmerEngine
+	codeHash []byte
+	store    sdk.KVStore
+	balance  sdk.Coins
+
+	// Contract is the address the contract runs at
+	Contract sdk.AccAddress
+	// Block is the block info of the env the contract sees
+	Block wasmTypes.BlockInfo
+	// GasLimit is the sdk gas limit of every call, for the VM and the storage together
+	GasLimit uint64
+}
+
+// LocalResult is the outcome of a LocalRunner call. Gas is sdk gas, as charged on chain.
+type LocalResult struct {
+	Data       []byte                   `json:"data,omitempty"`
+	Log        []wasmTypes.LogAttribute `json:"log,omitempty"`
+	Messages   []wasmTypes.CosmosMsg    `json:"messages,omitempty"`
+	Query      json.RawMessage          `json:"query,omitempty"`
+	GasUsed    uint64                   `json:"gas_used"`
+	VMGas      uint64                   `json:"vm_gas"`
+	StorageGas uint64                   `json:"storage_gas"`
+}
+
+// NewLocalRunner compiles the wasm code with a VM in cacheDir
+func NewLocalRunner(cacheDir string, wasmCode []byte, supportedFeatures string, gasLimit uint64) (*LocalRunner, error) {
+	wasmCode, err := uncompress(wasmCode)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrCreateFailed, err.Error())
+	}
+	wasmer, err := wasm.NewWasmer(cacheDir, supportedFeatures, 0)
+	if err != nil {
+		return nil, err
+	}
+	codeHash, err := wasmer.Create(wasmCode)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrCreateFailed, err.Error())
+	}
+	return &LocalRunner{
+		vm:       wasmer,
+		codeHash: codeHash,
+		store:    dbadapter.Store{DB: dbm.NewMemDB()},
+		Contract: contractAddress(1, 1),
+		Block:    wasmTypes.BlockInfo{Height: 1, ChainID: "local"},
+		GasLimit: gasLimit,
+	}, nil
+}
+
+// Instantiate calls the init entry point of the contract
+func (r *LocalRunner) Instantiate(sender sdk.AccAddress, funds sdk.Coins, msg []byte) (*LocalResult, error) {
+	var res *wasmTypes.InitResponse
+	result, err := r.run(true, func(store wasm.KVStore, gasMeter wasm.GasMeter, gas uint64) (uint64, error) {
+		var gasUsed uint64
+		var err error
+		res, gasUsed, err = r.vm.Instantiate(r.codeHash, r.env(sender, funds), msg, store, cosmwasmAPI, r.querier(funds), gasMeter, gas)
+		if err != nil {
+			return gasUsed, sdkerrors.Wrap(types.ErrInstantiateFailed, err.Error())
+		}
+		return gasUsed, nil
+	})
+	if err != nil {
+		return result, err
+	}
+	r.balance = r.balance.Add(funds...)
+	result.Data, result.Log, result.Messages = res.Data, res.Log, res.Messages
+	return result, nil
+}
+
+// Execute calls the handle entry point of the contract
+func (r *LocalRunner) Execute(sender sdk.AccAddress, funds sdk.Coins, msg []byte) (*LocalResult, error) {
+	var res *wasmTypes.HandleResponse
+	result, err := r.run(true, func(store wasm.KVStore, gasMeter wasm.GasMeter, gas uint64) (uint64, error) {
+		var gasUsed uint64
+		var err error
+		res, gasUsed, err = r.vm.Execute(r.codeHash, r.env(sender, funds), msg, store, cosmwasmAPI, r.querier(funds), gasMeter, gas)
+		if err != nil {
+			return gasUsed, sdkerrors.Wrap(types.ErrExecuteFailed, err.Error())
+		}
+		return gasUsed, nil
+	})
+	if err != nil {
+		return result, err
+	}
+	r.balance = r.balance.Add(funds...)
+	result.Data, result.Log, result.Messages = res.Data, res.Log, res.Messages
+	return result, nil
+}
+
+// Query calls the query entry point of the contract
+func (r *LocalRunner) Query(msg []byte) (*LocalResult, error) {
+	var res []byte
+	result, err := r.run(false, func(store wasm.KVStore, gasMeter wasm.GasMeter, gas uint64) (uint64, error) {
+		var gasUsed uint64
+		var err error
+		res, gasUsed, err = r.vm.Query(r.codeHash, msg, store, cosmwasmAPI, r.querier(nil), gasMeter, gas)
+		if err != nil {
+			return gasUsed, sdkerrors.Wrap(types.ErrQueryFailed, err.Error())
+		}
+		return gasUsed, nil
+	})
+	if err != nil {
+		return result, err
+	}
+	result.Query = res
+	return result, nil
+}
+
+// run calls the VM with the gas metered like on chain. The state changes are written when write is set and
+// the call succeeded.
+func (r *LocalRunner) run(write bool, call func(store wasm.KVStore, gasMeter wasm.GasMeter, gas uint64) (uint64, error)) (result *LocalResult, err error) {
+	sdkGasMeter := sdk.NewGasMeter(r.GasLimit)
+	cache := cachekv.NewStore(r.store)
+	store := gaskv.NewStore(cache, sdkGasMeter, storetypes.KVGasConfig())
+	result = &LocalResult{}
+
+	defer func() {
+		result.StorageGas = sdkGasMeter.GasConsumedToLimit()
+		result.GasUsed = result.StorageGas + result.VMGas
+		if rec := recover(); rec != nil {
+			oog, ok := rec.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(rec)
+			}
+			err = sdkerrors.Wrap(types.ErrGasLimit, oog.Descriptor)
+		}
+		if err == nil && result.GasUsed > r.GasLimit {
+			err = sdkerrors.Wrapf(types.ErrGasLimit, "used %d of %d", result.GasUsed, r.GasLimit)
+		}
+		if err == nil && write {
+			cache.Write()
+		}
+	}()
+
+	gasMeter := MultipiedGasMeter{originalMeter: sdkGasMeter, multiplier: GasMultiplier}
+	gasLimit := r.GasLimit * GasMultiplier
+	gasUsed, err := call(store, gasMeter, gasLimit)
+	result.VMGas = gasUsed / GasMultiplier
+	if err != nil && gasUsed >= gasLimit {
+		return result, sdkerrors.Wrap(types.ErrGasLimit, err.Error())
+	}
+	return result, err
+}
+
+func (r *LocalRunner) env(sender sdk.AccAddress, funds sdk.Coins) wasmTypes.Env {
+	return wasmTypes.Env{
+		Block: r.Block,
+		Message: wasmTypes.MessageInfo{
+			Sender:    sender.String(),
+			SentFunds: types.NewWasmCoins(funds),
+		},
+		Contract: wasmTypes.ContractInfo{
+			Address: r.Contract.String(),
+		},
+	}
+}
+
+func (r *LocalRunner) querier(funds sdk.Coins) localQuerier {
+	return localQuerier{contract: r.Contract, balance: r.balance.Add(funds...)}
+}
+
+// localQuerier answers the bank balance queries with the balance of the contract, other accounts have none.
+// There is no chain to answer the other queries.
+type localQuerier struct {
+	contract sdk.AccAddress
+	balance  sdk.Coins
+}
+
+var _ wasmTypes.Querier = localQuerier{}
+
+func (q localQuerier) Query(request wasmTypes.QueryRequest, _ uint64) ([]byte, error) {
+	if request.Bank == nil {
+		return nil, wasmTypes.UnsupportedRequest{Kind: "queries other than bank in local runs"}
+	}
+	if request.Bank.AllBalances != nil {
+		return json.Marshal(wasmTypes.AllBalancesResponse{
+			Amount: convertSdkCoinsToWasmCoins(q.balanceOf(request.Bank.AllBalances.Address)),
+		})
+	}
+	if request.Bank.Balance != nil {
+		amount := q.balanceOf(request.Bank.Balance.Address).AmountOf(request.Bank.Balance.Denom)
+		return json.Marshal(wasmTypes.BalanceResponse{
+			Amount: wasmTypes.Coin{
+				Denom:  request.Bank.Balance.Denom,
+				Amount: amount.String(),
+			},
+		})
+	}
+	return nil, wasmTypes.UnsupportedRequest{Kind: "unknown BankQuery variant"}
+}
+
+func (q localQuerier) balanceOf(address string) sdk.Coins {
+	if address != q.contract.String() {
+		return nil
+	}
+	return q.balance
+}
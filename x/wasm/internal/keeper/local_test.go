@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+func TestLocalRunner(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/contract.wasm")
+	require.NoError(t, err)
+	runner, err := NewLocalRunner(tempDir, wasmCode, SupportedFeatures, 1000000)
+	require.NoError(t, err)
+
+	_, _, creator := keyPubAddr()
+	_, _, verifier := keyPubAddr()
+	_, _, bob := keyPubAddr()
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+
+	initMsgBz, err := json.Marshal(InitMsg{Verifier: verifier, Beneficiary: bob})
+	require.NoError(t, err)
+	res, err := runner.Instantiate(creator, deposit, initMsgBz)
+	require.NoError(t, err)
+	assert.NotZero(t, res.VMGas)
+	assert.NotZero(t, res.StorageGas)
+	assert.Equal(t, res.VMGas+res.StorageGas, res.GasUsed)
+
+	res, err = runner.Query([]byte(`{"verifier":{}}`))
+	require.NoError(t, err)
+	assert.JSONEq(t, fmt.Sprintf(`{"verifier":"%s"}`, verifier), string(res.Query))
+
+	// a failed execution does not change the state
+	_, err = runner.Execute(bob, nil, []byte(`{"release":{}}`))
+	assert.True(t, types.ErrExecuteFailed.Is(err), "got %+v", err)
+
+	res, err = runner.Execute(verifier, nil, []byte(`{"release":{}}`))
+	require.NoError(t, err)
+	require.Len(t, res.Messages, 1)
+	require.NotNil(t, res.Messages[0].Bank)
+	send := res.Messages[0].Bank.Send
+	require.NotNil(t, send)
+	assert.Equal(t, runner.Contract.String(), send.FromAddress)
+	assert.Equal(t, bob.String(), send.ToAddress)
+	assert.EqualValues(t, types.NewWasmCoins(deposit), send.Amount)
+
+	runner.GasLimit = 1000
+	_, err = runner.Execute(verifier, nil, []byte(`{"release":{}}`))
+	assert.True(t, types.ErrGasLimit.Is(err), "got %+v", err)
+}
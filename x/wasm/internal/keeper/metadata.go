@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+// SetContractMetadata sets the metadata of the contract, the caller must be the contract admin.
+// Empty metadata removes it.
+func (k Keeper) SetContractMetadata(ctx sdk.Context, contractAddress, caller sdk.AccAddress, metadata types.ContractMetadata) error {
+	return k.setContractMetadata(ctx, contractAddress, caller, metadata, k.authZPolicy)
+}
+
+func (k Keeper) setContractMetadata(ctx sdk.Context, contractAddress, caller sdk.AccAddress, metadata types.ContractMetadata, authZ AuthorizationPolicy) error {
+	if err := metadata.ValidateBasic(); err != nil {
+		return err
+	}
+	contractInfo := k.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	if !authZ.CanModifyContract(contractInfo.Admin, caller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
+	}
+	k.storeContractMetadata(ctx, contractAddress, metadata)
+	return nil
+}
+
+// GetContractMetadata returns the metadata of the contract, nil when none was set
+func (k Keeper) GetContractMetadata(ctx sdk.Context, contractAddress sdk.AccAddress) *types.ContractMetadata {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetContractMetadataKey(contractAddress))
+	if bz == nil {
+		return nil
+	}
+	var metadata types.ContractMetadata
+	k.cdc.MustUnmarshalBinaryBare(bz, &metadata)
+	return &metadata
+}
+
+func (k Keeper) storeContractMetadata(ctx sdk.Context, contractAddress sdk.AccAddress, metadata types.ContractMetadata) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetContractMetadataKey(contractAddress)
+	if metadata.IsEmpty() {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, k.cdc.MustMarshalBinaryBare(metadata))
+}
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+func TestSetContractMetadata(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keepers := CreateTestInput(t, false, tempDir, SupportedFeatures, nil, nil)
+	accKeeper, keeper := keepers.AccountKeeper, keepers.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, deposit)
+	admin := createFakeFundedAccount(ctx, accKeeper, deposit)
+	_, _, anyAddr := keyPubAddr()
+
+	wasmCode, err := ioutil.ReadFile("./testdata/contract.wasm")
+	require.NoError(t, err)
+	codeID, err := keeper.Create(ctx, creator, wasmCode, "", "", nil)
+	require.NoError(t, err)
+	initMsgBz, err := json.Marshal(InitMsg{Verifier: anyAddr, Beneficiary: anyAddr})
+	require.NoError(t, err)
+	contractAddr, _, err := keeper.Instantiate(ctx, codeID, creator, admin, initMsgBz, "demo contract", nil)
+	require.NoError(t, err)
+	noAdminContractAddr, _, err := keeper.Instantiate(ctx, codeID, creator, nil, initMsgBz, "demo contract", nil)
+	require.NoError(t, err)
+
+	metadata := types.ContractMetadata{
+		Name:           "escrow",
+		Description:    "releases funds to the beneficiary",
+		SchemaIPFSHash: "QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG",
+		Repository:     "https://github.com/CosmWasm/cosmwasm-examples",
+	}
+	specs := map[string]struct {
+		contract sdk.AccAddress
+		caller   sdk.AccAddress
+		src      types.ContractMetadata
+		expErr   *sdkerrors.Error
+	}{
+		"admin sets metadata": {
+			contract: contractAddr,
+			caller:   admin,
+			src:      metadata,
+		},
+		"admin clears metadata": {
+			contract: contractAddr,
+			caller:   admin,
+		},
+		"not the admin": {
+			contract: contractAddr,
+			caller:   creator,
+			src:      metadata,
+			expErr:   sdkerrors.ErrUnauthorized,
+		},
+		"contract without admin": {
+			contract: noAdminContractAddr,
+			caller:   creator,
+			src:      metadata,
+			expErr:   sdkerrors.ErrUnauthorized,
+		},
+		"unknown contract": {
+			contract: anyAddr,
+			caller:   admin,
+			src:      metadata,
+			expErr:   sdkerrors.ErrInvalidRequest,
+		},
+		"invalid metadata": {
+			contract: contractAddr,
+			caller:   admin,
+			src:      types.ContractMetadata{Repository: "http://example.com"},
+			expErr:   types.ErrInvalid,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			trialCtx := ctx.WithMultiStore(ctx.MultiStore().CacheWrap().(sdk.MultiStore))
+			keeper.storeContractMetadata(trialCtx, contractAddr, types.ContractMetadata{Name: "old"})
+
+			err := keeper.SetContractMetadata(trialCtx, spec.contract, spec.caller, spec.src)
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(err), err)
+				assert.Equal(t, &types.ContractMetadata{Name: "old"}, keeper.GetContractMetadata(trialCtx, contractAddr))
+				return
+			}
+			require.NoError(t, err)
+			got := keeper.GetContractMetadata(trialCtx, spec.contract)
+			if spec.src.IsEmpty() {
+				assert.Nil(t, got)
+				return
+			}
+			assert.Equal(t, &spec.src, got)
+		})
+	}
+}
@@ -0,0 +1,223 @@
+package keeper
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	"github.com/pkg/errors"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+// MetricsSubsystem is the subsystem of the wasm metrics
+const MetricsSubsystem = "wasm"
+
+// Metrics contains the metrics of the contract calls. Only the calls of the node are counted, they are not part
+// of the chain state.
+type Metrics struct {
+	// ContractCalls counts the VM calls by operation
+	ContractCalls metrics.Counter
+	// BlockContractCalls is the number of VM calls of a block, including its txs, begin and end block
+	BlockContractCalls metrics.Histogram
+	// ExecutionTime is the run time of the VM calls in seconds, by operation
+	ExecutionTime metrics.Histogram
+	// CacheHits and CacheMisses count the VM calls that found the compiled code in the in-memory cache of the VM or
+	// had to load it from disk
+	CacheHits   metrics.Counter
+	CacheMisses metrics.Counter
+	// GasConsumed is the sdk gas of the VM calls and their storage access, by contract
+	GasConsumed metrics.Counter
+	// FailedCalls counts the failed instantiations, executions, migrations and queries by operation and error
+	FailedCalls metrics.Counter
+}
+
+// PrometheusMetrics returns the metrics registered with the default prometheus registry, that is served by the
+// prometheus endpoint of Tendermint, see `instrumentation.prometheus` in config.toml. It can be called more than
+// once, the metrics are registered only once.
+func PrometheusMetrics(namespace string) *Metrics {
+	return &Metrics{
+		ContractCalls: registerCounter(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "contract_calls",
+			Help:      "Number of contract calls.",
+		}, []string{"operation"}),
+		BlockContractCalls: registerHistogram(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "block_contract_calls",
+			Help:      "Number of contract calls in a block.",
+			Buckets:   stdprometheus.ExponentialBuckets(1, 4, 8),
+		}, nil),
+		ExecutionTime: registerHistogram(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "execution_time_seconds",
+			Help:      "Time of a contract call in the VM, in seconds.",
+			Buckets:   stdprometheus.ExponentialBuckets(0.0005, 2, 12),
+		}, []string{"operation"}),
+		CacheHits: registerCounter(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "cache_hits",
+			Help:      "Number of contract calls with the compiled code in the memory cache.",
+		}, nil),
+		CacheMisses: registerCounter(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "cache_misses",
+			Help:      "Number of contract calls that loaded the compiled code from disk.",
+		}, nil),
+		GasConsumed: registerCounter(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "gas_consumed",
+			Help:      "Gas consumed by contract calls.",
+		}, []string{"contract"}),
+		FailedCalls: registerCounter(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "failed_calls",
+			Help:      "Number of failed contract calls.",
+		}, []string{"operation", "error"}),
+	}
+}
+
+// NopMetrics returns the metrics that record nothing
+func NopMetrics() *Metrics {
+	return &Metrics{
+		ContractCalls:      discard.NewCounter(),
+		BlockContractCalls: discard.NewHistogram(),
+		ExecutionTime:      discard.NewHistogram(),
+		CacheHits:          discard.NewCounter(),
+		CacheMisses:        discard.NewCounter(),
+		GasConsumed:        discard.NewCounter(),
+		FailedCalls:        discard.NewCounter(),
+	}
+}
+
+func registerCounter(opts stdprometheus.CounterOpts, labels []string) metrics.Counter {
+	cv := stdprometheus.NewCounterVec(opts, labels)
+	if err := stdprometheus.Register(cv); err != nil {
+		existing, ok := err.(stdprometheus.AlreadyRegisteredError)
+		if !ok {
+			panic(err)
+		}
+		cv = existing.ExistingCollector.(*stdprometheus.CounterVec)
+	}
+	return kitprometheus.NewCounter(cv)
+}
+
+func registerHistogram(opts stdprometheus.HistogramOpts, labels []string) metrics.Histogram {
+	hv := stdprometheus.NewHistogramVec(opts, labels)
+	if err := stdprometheus.Register(hv); err != nil {
+		existing, ok := err.(stdprometheus.AlreadyRegisteredError)
+		if !ok {
+			panic(err)
+		}
+		hv = existing.ExistingCollector.(*stdprometheus.HistogramVec)
+	}
+	return kitprometheus.NewHistogram(hv)
+}
+
+// SetMetrics sets the metrics of the contract calls, they are discarded by default. It must be called when the app
+// is set up, before the keeper is passed to the modules.
+func (k *Keeper) SetMetrics(m *Metrics) {
+	k.metrics = m
+}
+
+// ObserveBlockMetrics records the number of contract calls of the block, it is called at the end of every block
+func (k Keeper) ObserveBlockMetrics() {
+	k.metrics.BlockContractCalls.Observe(float64(k.callStats.endBlock()))
+}
+
+// recordContractCall updates the metrics of a VM call with the sdk gas it consumed
+func (k Keeper) recordContractCall(ctx sdk.Context, operation string, contractAddr sdk.AccAddress, codeHash []byte, start time.Time, gas uint64) {
+	k.metrics.ExecutionTime.With("operation", operation).Observe(time.Since(start).Seconds())
+	k.metrics.ContractCalls.With("operation", operation).Add(1)
+	k.metrics.GasConsumed.With("contract", contractAddr.String()).Add(float64(gas))
+	if k.callStats.loadCode(codeHash) {
+		k.metrics.CacheHits.Add(1)
+	} else {
+		k.metrics.CacheMisses.Add(1)
+	}
+	// check txs, simulations and queries are not part of a block
+	if !ctx.IsCheckTx() {
+		k.callStats.addBlockCall()
+	}
+}
+
+// recordFailure counts the error of a contract call, if any
+func (k Keeper) recordFailure(operation string, err error) {
+	if err != nil {
+		k.metrics.FailedCalls.With("operation", operation, "error", errorType(err)).Add(1)
+	}
+}
+
+// errorType returns the codespace and the description of the registered error that err wraps, e.g.
+// "wasm: execute wasm contract failed"
+func errorType(err error) string {
+	if sdkErr, ok := errors.Cause(err).(*sdkerrors.Error); ok {
+		return sdkErr.Codespace() + ": " + sdkErr.Error()
+	}
+	return "internal"
+}
+
+// callStats counts the contract calls of the current block and mirrors the in-memory cache of the VM, which keeps
+// the most recently used compiled codes up to the cache size of the WasmConfig.
+type callStats struct {
+	mu         sync.Mutex
+	blockCalls int
+	cacheSize  int
+	lru        *list.List
+	cached     map[string]*list.Element
+}
+
+func newCallStats(cacheSize uint64) *callStats {
+	return &callStats{
+		cacheSize: int(cacheSize),
+		lru:       list.New(),
+		cached:    make(map[string]*list.Element),
+	}
+}
+
+// loadCode marks the code as used and returns true when it was in the cache
+func (s *callStats) loadCode(codeHash []byte) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := string(codeHash)
+	if e, ok := s.cached[key]; ok {
+		s.lru.MoveToFront(e)
+		return true
+	}
+	if s.cacheSize == 0 {
+		return false
+	}
+	s.cached[key] = s.lru.PushFront(key)
+	if s.lru.Len() > s.cacheSize {
+		oldest := s.lru.Back()
+		s.lru.Remove(oldest)
+		delete(s.cached, oldest.Value.(string))
+	}
+	return false
+}
+
+func (s *callStats) addBlockCall() {
+	s.mu.Lock()
+	s.blockCalls++
+	s.mu.Unlock()
+}
+
+// endBlock returns the number of calls of the block and resets it
+func (s *callStats) endBlock() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := s.blockCalls
+	s.blockCalls = 0
+	return n
+}
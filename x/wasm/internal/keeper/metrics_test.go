@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"errors"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+func TestCallStatsCache(t *testing.T) {
+	specs := map[string]struct {
+		cacheSize uint64
+		loads     []string
+		expHits   []bool
+	}{
+		"no cache": {
+			cacheSize: 0,
+			loads:     []string{"a", "a"},
+			expHits:   []bool{false, false},
+		},
+		"cached": {
+			cacheSize: 1,
+			loads:     []string{"a", "a"},
+			expHits:   []bool{false, true},
+		},
+		"evicted": {
+			cacheSize: 1,
+			loads:     []string{"a", "b", "a"},
+			expHits:   []bool{false, false, false},
+		},
+		"least recently used evicted": {
+			cacheSize: 2,
+			loads:     []string{"a", "b", "a", "c", "a", "b"},
+			expHits:   []bool{false, false, true, false, true, false},
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			stats := newCallStats(spec.cacheSize)
+			var hits []bool
+			for _, codeHash := range spec.loads {
+				hits = append(hits, stats.loadCode([]byte(codeHash)))
+			}
+			assert.Equal(t, spec.expHits, hits)
+		})
+	}
+}
+
+func TestCallStatsBlockCalls(t *testing.T) {
+	stats := newCallStats(0)
+	stats.addBlockCall()
+	stats.addBlockCall()
+	assert.Equal(t, 2, stats.endBlock())
+	assert.Equal(t, 0, stats.endBlock())
+}
+
+func TestErrorType(t *testing.T) {
+	specs := map[string]struct {
+		src     error
+		expType string
+	}{
+		"registered error": {
+			src:     types.ErrExecuteFailed,
+			expType: "wasm: execute wasm contract failed",
+		},
+		"wrapped error": {
+			src:     sdkerrors.Wrap(sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not migrate"), "dispatch"),
+			expType: "sdk: unauthorized",
+		},
+		"other error": {
+			src:     errors.New("testing"),
+			expType: "internal",
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			assert.Equal(t, spec.expType, errorType(spec.src))
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+// MessageRegistry routes the custom messages of contracts to the encoders registered at app wiring time.
+// A custom message is a json object with the route as single key, e.g. `{"mint":{"amount":"100"}}`, the encoder
+// of the route is called with the value and returns the native module messages to dispatch.
+type MessageRegistry struct {
+	routes map[string]customMsgRoute
+}
+
+type customMsgRoute struct {
+	encoder  CustomEncoder
+	gasLimit uint64
+}
+
+// NewMessageRegistry creates an empty MessageRegistry
+func NewMessageRegistry() *MessageRegistry {
+	return &MessageRegistry{routes: make(map[string]customMsgRoute)}
+}
+
+// Register adds the encoder for the route. The messages of a single custom message can consume at most gasLimit sdk
+// gas, 0 limits them by the tx gas only. It panics when the route is empty or registered already.
+func (r *MessageRegistry) Register(route string, encoder CustomEncoder, gasLimit uint64) *MessageRegistry {
+	if route == "" {
+		panic("empty custom message route")
+	}
+	if _, exists := r.routes[route]; exists {
+		panic(fmt.Sprintf("custom message route %q already registered", route))
+	}
+	r.routes[route] = customMsgRoute{encoder: encoder, gasLimit: gasLimit}
+	return r
+}
+
+// Routes returns the registered routes in sorted order
+func (r *MessageRegistry) Routes() []string {
+	res := make([]string, 0, len(r.routes))
+	for route := range r.routes {
+		res = append(res, route)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// Encoder returns the CustomEncoder to set as MessageEncoders.Custom
+func (r *MessageRegistry) Encoder() CustomEncoder {
+	return func(sender sdk.AccAddress, msg json.RawMessage) ([]sdk.Msg, error) {
+		var routed map[string]json.RawMessage
+		if err := json.Unmarshal(msg, &routed); err != nil {
+			return nil, sdkerrors.Wrap(types.ErrInvalidMsg, err.Error())
+		}
+		if len(routed) != 1 {
+			return nil, sdkerrors.Wrap(types.ErrInvalidMsg, "custom message must have exactly one route")
+		}
+		for name, raw := range routed {
+			route, ok := r.routes[name]
+			if !ok {
+				return nil, sdkerrors.Wrapf(types.ErrInvalidMsg, "unknown custom message route %q", name)
+			}
+			msgs, err := route.encoder(sender, raw)
+			if err != nil || route.gasLimit == 0 {
+				return msgs, err
+			}
+			for i := range msgs {
+				msgs[i] = gasLimitedMsg{Msg: msgs[i], route: name, gasLimit: route.gasLimit}
+			}
+			return msgs, nil
+		}
+		return nil, sdkerrors.Wrap(types.ErrInvalidMsg, "custom message must have exactly one route")
+	}
+}
+
+// gasLimitedMsg is dispatched with the gas limit of the custom message route that encoded it
+type gasLimitedMsg struct {
+	sdk.Msg
+	route    string
+	gasLimit uint64
+}
+
+// handleGasLimitedMessage dispatches the message with a gas meter limited to the gas limit of its route.
+// Running out of that gas fails the message, the consumed gas is charged to the contract in any case.
+func (h MessageHandler) handleGasLimitedMessage(ctx sdk.Context, contractAddr sdk.Address, msg gasLimitedMsg) (err error) {
+	subCtx := ctx.WithGasMeter(sdk.NewGasMeter(msg.gasLimit))
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); !ok || !subCtx.GasMeter().IsOutOfGas() {
+				panic(r)
+			}
+			err = sdkerrors.Wrapf(types.ErrLimit, "custom message %q exceeds its gas limit of %d", msg.route, msg.gasLimit)
+		}
+		ctx.GasMeter().ConsumeGas(subCtx.GasMeter().GasConsumedToLimit(), "custom message")
+	}()
+	return h.handleSdkMessage(subCtx, contractAddr, msg.Msg)
+}
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"encoding/json"
+	"testing"
+
+	wasmTypes "github.com/CosmWasm/go-cosmwasm/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+func TestMessageRegistryDispatch(t *testing.T) {
+	_, _, contract := keyPubAddr()
+	_, _, other := keyPubAddr()
+
+	// pay sends the amount from the contract, the handler below charges the amount as gas
+	pay := func(sender sdk.AccAddress, msg json.RawMessage) ([]sdk.Msg, error) {
+		var amount int64
+		if err := json.Unmarshal(msg, &amount); err != nil {
+			return nil, err
+		}
+		return []sdk.Msg{bank.NewMsgSend(sender, other, sdk.NewCoins(sdk.NewInt64Coin("denom", amount)))}, nil
+	}
+	registry := NewMessageRegistry().
+		Register("pay", pay, 0).
+		Register("limited", pay, 1000)
+	assert.Equal(t, []string{"limited", "pay"}, registry.Routes())
+	assert.Panics(t, func() { registry.Register("pay", pay, 0) })
+	assert.Panics(t, func() { registry.Register("", pay, 0) })
+
+	router := baseapp.NewRouter()
+	router.AddRoute(bank.RouterKey, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx.GasMeter().ConsumeGas(msg.(bank.MsgSend).Amount.AmountOf("denom").Uint64(), "test")
+		return &sdk.Result{}, nil
+	})
+	handler := NewMessageHandler(router, &MessageEncoders{Custom: registry.Encoder()})
+
+	specs := map[string]struct {
+		src     string
+		expGas  uint64
+		isError bool
+	}{
+		"without limit": {
+			src:    `{"pay":5000}`,
+			expGas: 5000,
+		},
+		"within limit": {
+			src:    `{"limited":1000}`,
+			expGas: 1000,
+		},
+		"exceeds limit": {
+			src:     `{"limited":1001}`,
+			expGas:  1000,
+			isError: true,
+		},
+		"unknown route": {
+			src:     `{"mint":1}`,
+			isError: true,
+		},
+		"several routes": {
+			src:     `{"pay":1,"limited":1}`,
+			isError: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx := sdk.Context{}.WithGasMeter(sdk.NewGasMeter(100000)).WithEventManager(sdk.NewEventManager())
+			err := handler.Dispatch(ctx, contract, wasmTypes.CosmosMsg{Custom: json.RawMessage(spec.src)})
+			if spec.isError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, spec.expGas, ctx.GasMeter().GasConsumed())
+		})
+	}
+}
+
+func TestMessageRegistryGasLimitError(t *testing.T) {
+	_, _, contract := keyPubAddr()
+	registry := NewMessageRegistry().Register("burn", func(sender sdk.AccAddress, _ json.RawMessage) ([]sdk.Msg, error) {
+		return []sdk.Msg{bank.NewMsgSend(sender, sender, sdk.NewCoins(sdk.NewInt64Coin("denom", 1)))}, nil
+	}, 10)
+	router := baseapp.NewRouter()
+	router.AddRoute(bank.RouterKey, func(ctx sdk.Context, _ sdk.Msg) (*sdk.Result, error) {
+		ctx.GasMeter().ConsumeGas(11, "test")
+		return &sdk.Result{}, nil
+	})
+	handler := NewMessageHandler(router, &MessageEncoders{Custom: registry.Encoder()})
+
+	ctx := sdk.Context{}.WithGasMeter(sdk.NewGasMeter(100000)).WithEventManager(sdk.NewEventManager())
+	err := handler.Dispatch(ctx, contract, wasmTypes.CosmosMsg{Custom: json.RawMessage(`{"burn":{}}`)})
+	require.Error(t, err)
+	assert.True(t, types.ErrLimit.Is(err), err)
+
+	// the gas consumed up to the route limit is charged to the tx
+	assert.Panics(t, func() {
+		outOfGas := sdk.Context{}.WithGasMeter(sdk.NewGasMeter(5)).WithEventManager(sdk.NewEventManager())
+		_ = handler.Dispatch(outOfGas, contract, wasmTypes.CosmosMsg{Custom: json.RawMessage(`{"burn":{}}`)})
+	})
+}
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+// paginate iterates the prefix store in key order for the page selected by req and calls onResult for every entry.
+// onResult returns false for entries that are filtered out, they do not count towards offset or limit.
+// The returned NextKey points to the first entry of the next page.
+func paginate(prefixStore sdk.KVStore, req types.PageRequest, onResult func(key, value []byte, accumulate bool) bool) types.PageResponse {
+	var iter sdk.Iterator
+	switch {
+	case req.Reverse && len(req.Key) != 0:
+		// the iterator end is exclusive, the key must be included
+		iter = prefixStore.ReverseIterator(nil, append(append([]byte{}, req.Key...), 0x00))
+	case req.Reverse:
+		iter = prefixStore.ReverseIterator(nil, nil)
+	default:
+		iter = prefixStore.Iterator(req.Key, nil)
+	}
+	defer iter.Close()
+
+	var skipped, count uint64
+	for ; iter.Valid(); iter.Next() {
+		if count == req.Limit {
+			if onResult(iter.Key(), iter.Value(), false) {
+				return types.PageResponse{NextKey: append([]byte{}, iter.Key()...)}
+			}
+			continue
+		}
+		if skipped < req.Offset {
+			if onResult(iter.Key(), iter.Value(), false) {
+				skipped++
+			}
+			continue
+		}
+		if onResult(iter.Key(), iter.Value(), true) {
+			count++
+		}
+	}
+	return types.PageResponse{}
+}
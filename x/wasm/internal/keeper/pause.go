@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+// PauseExecution stops execution of a single contract, or of all contracts of a code when contractAddress is empty.
+// The caller must be the security address set in the params.
+func (k Keeper) PauseExecution(ctx sdk.Context, caller, contractAddress sdk.AccAddress, codeID uint64) error {
+	return k.setExecutionPaused(ctx, caller, contractAddress, codeID, true, k.authZPolicy)
+}
+
+// ResumeExecution lifts a pause set with PauseExecution.
+// The caller must be the security address set in the params.
+func (k Keeper) ResumeExecution(ctx sdk.Context, caller, contractAddress sdk.AccAddress, codeID uint64) error {
+	return k.setExecutionPaused(ctx, caller, contractAddress, codeID, false, k.authZPolicy)
+}
+
+func (k Keeper) setExecutionPaused(ctx sdk.Context, caller, contractAddress sdk.AccAddress, codeID uint64, paused bool, authZ AuthorizationPolicy) error {
+	if !authZ.CanPauseExecution(k.getSecurityAddress(ctx), caller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not pause or resume execution")
+	}
+
+	var key []byte
+	if len(contractAddress) != 0 {
+		if !k.containsContractInfo(ctx, contractAddress) {
+			return sdkerrors.Wrap(types.ErrNotFound, "contract")
+		}
+		key = types.GetPausedContractKey(contractAddress)
+	} else {
+		if !k.containsCodeInfo(ctx, codeID) {
+			return sdkerrors.Wrap(types.ErrNotFound, "code")
+		}
+		key = types.GetPausedCodeKey(codeID)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if paused {
+		store.Set(key, []byte{1})
+	} else {
+		store.Delete(key)
+	}
+	return nil
+}
+
+// IsContractPaused returns true when execution of the contract itself was paused
+func (k Keeper) IsContractPaused(ctx sdk.Context, contractAddress sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetPausedContractKey(contractAddress))
+}
+
+// IsCodePaused returns true when execution of all contracts of the code was paused
+func (k Keeper) IsCodePaused(ctx sdk.Context, codeID uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetPausedCodeKey(codeID))
+}
+
+// assertExecutionAllowed returns ErrPaused when the contract or its code is paused.
+// The lookups are done on the raw store so that the check does not change the gas cost of an execution.
+func (k Keeper) assertExecutionAllowed(ctx sdk.Context, contractAddress sdk.AccAddress) error {
+	store := ctx.MultiStore().GetKVStore(k.storeKey)
+	if store.Has(types.GetPausedContractKey(contractAddress)) {
+		return sdkerrors.Wrapf(types.ErrPaused, "contract %s", contractAddress)
+	}
+	contractBz := store.Get(types.GetContractAddressKey(contractAddress))
+	if contractBz == nil {
+		// reported by the contract lookup
+		return nil
+	}
+	var contract types.ContractInfo
+	k.cdc.MustUnmarshalBinaryBare(contractBz, &contract)
+	if store.Has(types.GetPausedCodeKey(contract.CodeID)) {
+		return sdkerrors.Wrapf(types.ErrPaused, "code %d", contract.CodeID)
+	}
+	return nil
+}
+
+// IteratePausedContracts calls cb for every contract that was paused individually
+func (k Keeper) IteratePausedContracts(ctx sdk.Context, cb func(sdk.AccAddress) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PausedContractPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		// cb returns true to stop early
+		if cb(iter.Key()) {
+			return
+		}
+	}
+}
+
+// IteratePausedCodes calls cb for every code id with all contracts paused
+func (k Keeper) IteratePausedCodes(ctx sdk.Context, cb func(uint64) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PausedCodePrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		// cb returns true to stop early
+		if cb(binary.BigEndian.Uint64(iter.Key())) {
+			return
+		}
+	}
+}
+
+func (k Keeper) importPausedContract(ctx sdk.Context, contractAddress sdk.AccAddress) error {
+	if !k.containsContractInfo(ctx, contractAddress) {
+		return sdkerrors.Wrapf(types.ErrNotFound, "contract %s", contractAddress)
+	}
+	ctx.KVStore(k.storeKey).Set(types.GetPausedContractKey(contractAddress), []byte{1})
+	return nil
+}
+
+func (k Keeper) importPausedCode(ctx sdk.Context, codeID uint64) error {
+	if !k.containsCodeInfo(ctx, codeID) {
+		return sdkerrors.Wrapf(types.ErrNotFound, "code %d", codeID)
+	}
+	ctx.KVStore(k.storeKey).Set(types.GetPausedCodeKey(codeID), []byte{1})
+	return nil
+}
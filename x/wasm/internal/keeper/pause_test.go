@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPauseExecution(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keepers := CreateTestInput(t, false, tempDir, SupportedFeatures, nil, nil)
+	accKeeper, keeper := keepers.AccountKeeper, keepers.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, deposit)
+	fred := createFakeFundedAccount(ctx, accKeeper, deposit)
+	security := createFakeFundedAccount(ctx, accKeeper, deposit)
+
+	params := types.DefaultParams()
+	params.SecurityAddress = security
+	keeper.setParams(ctx, params)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/contract.wasm")
+	require.NoError(t, err)
+
+	codeID, err := keeper.Create(ctx, creator, wasmCode, "", "", nil)
+	require.NoError(t, err)
+
+	_, _, bob := keyPubAddr()
+	initMsgBz, err := json.Marshal(InitMsg{Verifier: fred, Beneficiary: bob})
+	require.NoError(t, err)
+	addr, _, err := keeper.Instantiate(ctx, codeID, creator, nil, initMsgBz, "demo contract", nil)
+	require.NoError(t, err)
+
+	// only the security address can pause
+	err = keeper.PauseExecution(ctx, fred, addr, 0)
+	assert.True(t, sdkerrors.ErrUnauthorized.Is(err), err)
+
+	specs := map[string]struct {
+		contract sdk.AccAddress
+		codeID   uint64
+	}{
+		"pause contract": {contract: addr},
+		"pause code":     {codeID: codeID},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			trialCtx := ctx.WithMultiStore(ctx.MultiStore().CacheWrap().(sdk.MultiStore))
+			require.NoError(t, keeper.PauseExecution(trialCtx, security, spec.contract, spec.codeID))
+			assert.Equal(t, len(spec.contract) != 0, keeper.IsContractPaused(trialCtx, addr))
+			assert.Equal(t, spec.codeID != 0, keeper.IsCodePaused(trialCtx, codeID))
+
+			_, err := keeper.Execute(trialCtx, addr, fred, []byte(`{"release":{}}`), nil)
+			assert.True(t, types.ErrPaused.Is(err), err)
+
+			require.NoError(t, keeper.ResumeExecution(trialCtx, security, spec.contract, spec.codeID))
+			_, err = keeper.Execute(trialCtx, addr, fred, []byte(`{"release":{}}`), nil)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestPauseExecutionUnknownTarget(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keepers := CreateTestInput(t, false, tempDir, SupportedFeatures, nil, nil)
+	keeper := keepers.WasmKeeper
+
+	_, _, nonExistingAddress := keyPubAddr()
+	err = keeper.setExecutionPaused(ctx, nil, nonExistingAddress, 0, true, GovAuthorizationPolicy{})
+	assert.True(t, types.ErrNotFound.Is(err), err)
+
+	err = keeper.setExecutionPaused(ctx, nil, nil, 99, true, GovAuthorizationPolicy{})
+	assert.True(t, types.ErrNotFound.Is(err), err)
+}
@@ -33,6 +33,18 @@ func NewWasmProposalHandler(k Keeper, enabledProposalTypes []types.ProposalType)
 			return handleUpdateAdminProposal(ctx, k, c)
 		case types.ClearAdminProposal:
 			return handleClearAdminProposal(ctx, k, c)
+		case types.PauseExecutionProposal:
+			return handlePauseExecutionProposal(ctx, k, c)
+		case types.ResumeExecutionProposal:
+			return handleResumeExecutionProposal(ctx, k, c)
+		case types.SetContractLimitsProposal:
+			return handleSetContractLimitsProposal(ctx, k, c)
+		case types.ScheduleExecutionProposal:
+			return handleScheduleExecutionProposal(ctx, k, c)
+		case types.UnscheduleExecutionProposal:
+			return handleUnscheduleExecutionProposal(ctx, k, c)
+		case types.SudoContractProposal:
+			return handleSudoContractProposal(ctx, k, c)
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized wasm proposal content type: %T", c)
 		}
@@ -44,7 +56,7 @@ func handleStoreCodeProposal(ctx sdk.Context, k Keeper, p types.StoreCodeProposa
 		return err
 	}
 
-	codeID, err := k.create(ctx, p.RunAs, p.WASMByteCode, p.Source, p.Builder, p.InstantiatePermission, GovAuthorizationPolicy{})
+	codeID, err := k.create(ctx, p.RunAs, p.WASMByteCode, p.Source, p.Builder, p.InstantiatePermission, GovAuthorizationPolicy{}, false)
 	if err != nil {
 		return err
 	}
@@ -63,7 +75,7 @@ func handleInstantiateProposal(ctx sdk.Context, k Keeper, p types.InstantiateCon
 		return err
 	}
 
-	contractAddr, err := k.instantiate(ctx, p.CodeID, p.RunAs, p.Admin, p.InitMsg, p.Label, p.InitFunds, GovAuthorizationPolicy{})
+	contractAddr, data, err := k.instantiate(ctx, p.CodeID, p.RunAs, p.Admin, p.InitMsg, p.Label, p.InitFunds, GovAuthorizationPolicy{})
 	if err != nil {
 		return err
 	}
@@ -74,7 +86,7 @@ func handleInstantiateProposal(ctx sdk.Context, k Keeper, p types.InstantiateCon
 		sdk.NewAttribute(types.AttributeKeyCodeID, fmt.Sprintf("%d", p.CodeID)),
 		sdk.NewAttribute(types.AttributeKeyContract, contractAddr.String()),
 	)
-	ctx.EventManager().EmitEvent(ourEvent)
+	ctx.EventManager().EmitEvent(types.WithResultData(ourEvent, data))
 	return nil
 }
 
@@ -93,7 +105,7 @@ func handleMigrateProposal(ctx sdk.Context, k Keeper, p types.MigrateContractPro
 		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
 		sdk.NewAttribute(types.AttributeKeyContract, p.Contract.String()),
 	)
-	ctx.EventManager().EmitEvents(append(res.Events, ourEvent))
+	ctx.EventManager().EmitEvents(append(res.Events, types.WithResultData(ourEvent, res.Data)))
 	return nil
 }
 
@@ -131,3 +143,109 @@ func handleClearAdminProposal(ctx sdk.Context, k Keeper, p types.ClearAdminPropo
 	ctx.EventManager().EmitEvent(ourEvent)
 	return nil
 }
+
+func handlePauseExecutionProposal(ctx sdk.Context, k Keeper, p types.PauseExecutionProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if err := k.setExecutionPaused(ctx, nil, p.Contract, p.CodeID, true, GovAuthorizationPolicy{}); err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(pauseTargetEvent(p.Contract, p.CodeID))
+	return nil
+}
+
+func handleResumeExecutionProposal(ctx sdk.Context, k Keeper, p types.ResumeExecutionProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if err := k.setExecutionPaused(ctx, nil, p.Contract, p.CodeID, false, GovAuthorizationPolicy{}); err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(pauseTargetEvent(p.Contract, p.CodeID))
+	return nil
+}
+
+func handleSetContractLimitsProposal(ctx sdk.Context, k Keeper, p types.SetContractLimitsProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if err := k.setContractLimitBounds(ctx, p.Contract, p.Bounds); err != nil {
+		return err
+	}
+	ourEvent := sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyContract, p.Contract.String()),
+	)
+	ctx.EventManager().EmitEvent(ourEvent)
+	return nil
+}
+
+func handleScheduleExecutionProposal(ctx sdk.Context, k Keeper, p types.ScheduleExecutionProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if err := k.setContractSchedule(ctx, p.Schedule()); err != nil {
+		return err
+	}
+	ourEvent := sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyContract, p.Contract.String()),
+	)
+	ctx.EventManager().EmitEvent(ourEvent)
+	return nil
+}
+
+func handleUnscheduleExecutionProposal(ctx sdk.Context, k Keeper, p types.UnscheduleExecutionProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if err := k.deleteContractSchedule(ctx, p.Contract); err != nil {
+		return err
+	}
+	ourEvent := sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyContract, p.Contract.String()),
+	)
+	ctx.EventManager().EmitEvent(ourEvent)
+	return nil
+}
+
+func handleSudoContractProposal(ctx sdk.Context, k Keeper, p types.SudoContractProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	res, err := k.Sudo(ctx, p.Contract, p.Msg)
+	if err != nil {
+		return err
+	}
+
+	ourEvent := sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyContract, p.Contract.String()),
+	)
+	ctx.EventManager().EmitEvents(append(res.Events, types.WithResultData(ourEvent, res.Data)))
+	return nil
+}
+
+func pauseTargetEvent(contract sdk.AccAddress, codeID uint64) sdk.Event {
+	target := sdk.NewAttribute(types.AttributeKeyCodeID, fmt.Sprintf("%d", codeID))
+	if len(contract) != 0 {
+		target = sdk.NewAttribute(types.AttributeKeyContract, contract.String())
+	}
+	return sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		target,
+	)
+}
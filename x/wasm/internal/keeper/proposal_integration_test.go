@@ -190,6 +190,52 @@ func TestMigrateProposal(t *testing.T) {
 
 }
 
+func TestSudoProposal(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	ctx, keepers := CreateTestInput(t, false, tempDir, "staking", nil, nil)
+	accKeeper, govKeeper, wasmKeeper := keepers.AccountKeeper, keepers.GovKeeper, keepers.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, deposit)
+	_, _, bob := keyPubAddr()
+
+	wasmCode, err := ioutil.ReadFile("./testdata/contract.wasm")
+	require.NoError(t, err)
+	codeID, err := wasmKeeper.Create(ctx, creator, wasmCode, "", "", nil)
+	require.NoError(t, err)
+	// governance is the verifier, so the sudo release pays out the beneficiary
+	initMsgBz, err := json.Marshal(InitMsg{Verifier: types.SudoAddress, Beneficiary: bob})
+	require.NoError(t, err)
+	contractAddr, _, err := wasmKeeper.Instantiate(ctx, codeID, creator, nil, initMsgBz, "demo contract", deposit)
+	require.NoError(t, err)
+
+	src := types.SudoContractProposal{
+		WasmProposal: types.WasmProposal{
+			Title:       "Foo",
+			Description: "Bar",
+		},
+		Contract: contractAddr,
+		Msg:      []byte(`{"release":{}}`),
+	}
+
+	// when stored
+	storedProposal, err := govKeeper.SubmitProposal(ctx, src)
+	require.NoError(t, err)
+
+	// and proposal execute
+	handler := govKeeper.Router().GetRoute(storedProposal.ProposalRoute())
+	err = handler(ctx, storedProposal.Content)
+	require.NoError(t, err)
+
+	// then
+	bobAcct := accKeeper.GetAccount(ctx, bob)
+	require.NotNil(t, bobAcct)
+	assert.Equal(t, deposit, bobAcct.GetCoins())
+}
+
 func TestAdminProposals(t *testing.T) {
 	var (
 		otherAddress sdk.AccAddress = bytes.Repeat([]byte{0x2}, sdk.AddrLen)
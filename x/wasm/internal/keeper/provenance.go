@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+// GetContractProvenance returns the creation of the contract, nil for contracts instantiated before it was recorded
+func (k Keeper) GetContractProvenance(ctx sdk.Context, contractAddress sdk.AccAddress) *types.ContractProvenance {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetContractProvenanceKey(contractAddress))
+	if bz == nil {
+		return nil
+	}
+	var provenance types.ContractProvenance
+	k.cdc.MustUnmarshalBinaryBare(bz, &provenance)
+	return &provenance
+}
+
+// recordContractProvenance stores the block and tx the contract is instantiated in. It is written without charging
+// gas, so that it does not change the gas cost of instantiating contracts.
+func (k Keeper) recordContractProvenance(ctx sdk.Context, contractAddress sdk.AccAddress) {
+	provenance := types.ContractProvenance{BlockHeight: ctx.BlockHeight()}
+	if len(ctx.TxBytes()) != 0 {
+		provenance.TxHash = txHash(ctx.TxBytes())
+	}
+	k.storeContractProvenance(ctx, contractAddress, provenance)
+}
+
+func (k Keeper) storeContractProvenance(ctx sdk.Context, contractAddress sdk.AccAddress, provenance types.ContractProvenance) {
+	store := ctx.MultiStore().GetKVStore(k.storeKey)
+	store.Set(types.GetContractProvenanceKey(contractAddress), k.cdc.MustMarshalBinaryBare(provenance))
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+func TestContractProvenance(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keepers := CreateTestInput(t, false, tempDir, SupportedFeatures, nil, nil)
+	keeper := keepers.WasmKeeper
+	creator := createFakeFundedAccount(ctx, keepers.AccountKeeper, nil)
+	wasmCode, err := ioutil.ReadFile("./testdata/contract.wasm")
+	require.NoError(t, err)
+	codeID, err := keeper.Create(ctx, creator, wasmCode, "", "", nil)
+	require.NoError(t, err)
+	initMsgBz, err := json.Marshal(InitMsg{Verifier: creator, Beneficiary: creator})
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		srcTx     []byte
+		expTxHash string
+	}{
+		"instantiated by tx": {
+			srcTx:     []byte("instantiate tx"),
+			expTxHash: txHash([]byte("instantiate tx")),
+		},
+		"instantiated without tx": {},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			contractAddr, _, err := keeper.Instantiate(ctx.WithTxBytes(spec.srcTx), codeID, creator, nil, initMsgBz, msg, nil)
+			require.NoError(t, err)
+
+			exp := &types.ContractProvenance{BlockHeight: ctx.BlockHeight(), TxHash: spec.expTxHash}
+			assert.Equal(t, exp, keeper.GetContractProvenance(ctx, contractAddr))
+
+			q := NewQuerier(keeper)
+			res, err := q(ctx, []string{QueryGetContract, contractAddr.String()}, abci.RequestQuery{})
+			require.NoError(t, err)
+			var info ContractInfoWithAddress
+			require.NoError(t, json.Unmarshal(res, &info))
+			assert.Equal(t, creator, info.Creator)
+			assert.Equal(t, exp, info.Provenance)
+		})
+	}
+}
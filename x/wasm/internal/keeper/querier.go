@@ -1,10 +1,13 @@
 package keeper
 
 import (
+	"encoding/binary"
 	"encoding/json"
 	"sort"
 	"strconv"
+	"strings"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/fetchai/fetchd/x/wasm/internal/types"
@@ -20,6 +23,22 @@ const (
 	QueryGetCode            = "code"
 	QueryListCode           = "list-code"
 	QueryContractHistory    = "contract-history"
+	QueryParams             = "params"
+	// QueryContractExecutionGrants lists the grants of a granter to a grantee, path: granter/grantee
+	QueryContractExecutionGrants = "contract-execution-grants"
+	QueryContractMetadata        = "contract-metadata"
+	// QueryContractRewards returns the reward address of a contract and the rewards accrued for it, path: contract
+	QueryContractRewards = "contract-rewards"
+	// QueryContractLimits returns the limits of a contract, path: contract
+	QueryContractLimits = "contract-limits"
+	// QueryContractSchedule returns the end block execution schedule of a contract, path: contract
+	QueryContractSchedule = "contract-schedule"
+	// QueryIncompatibleCodes lists the codes with an interface version that no VM of the node supports
+	QueryIncompatibleCodes = "incompatible-codes"
+	// QueryTxTrace returns the host function calls of the contracts called by a tx, when the node traces them, path: tx hash
+	QueryTxTrace = "tx-trace"
+	// QueryListContractByCreator lists the contracts instantiated by an address, path: creator
+	QueryListContractByCreator = "list-contracts-by-creator"
 )
 
 const (
@@ -33,33 +52,90 @@ type ContractInfoWithAddress struct {
 	// embedded here, so all json items remain top level
 	*types.ContractInfo
 	Address sdk.AccAddress `json:"address"`
+	// Provenance is only set by the contract info query
+	Provenance *types.ContractProvenance `json:"provenance,omitempty"`
+}
+
+// ListContractsPageResponse is returned for a paginated list-contracts-by-code or list-contracts-by-creator query
+type ListContractsPageResponse struct {
+	Contracts  []ContractInfoWithAddress `json:"contracts"`
+	Pagination types.PageResponse        `json:"pagination"`
+}
+
+// ContractStatePageResponse is returned for a paginated contract-state all query
+type ContractStatePageResponse struct {
+	Models     []types.Model      `json:"models"`
+	Pagination types.PageResponse `json:"pagination"`
+}
+
+// ListCodePageResponse is returned for a paginated list-code query
+type ListCodePageResponse struct {
+	Codes      []ListCodeResponse `json:"codes"`
+	Pagination types.PageResponse `json:"pagination"`
 }
 
 // NewQuerier creates a new querier
 func NewQuerier(keeper Keeper) sdk.Querier {
 	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		ctx = historicalCtx(ctx, req.Height)
 		switch path[0] {
 		case QueryGetContract:
 			return queryContractInfo(ctx, path[1], keeper)
 		case QueryListContractByCode:
-			return queryContractListByCode(ctx, path[1], keeper)
+			return queryContractListByCode(ctx, path[1], req, keeper)
+		case QueryListContractByCreator:
+			return queryContractListByCreator(ctx, path[1], req, keeper)
 		case QueryGetContractState:
 			if len(path) < 3 {
 				return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown data query endpoint")
 			}
-			return queryContractState(ctx, path[1], path[2], req, keeper)
+			// smart queries take an optional gas limit, path: contract/smart/gas limit
+			var gasLimit string
+			if len(path) > 3 {
+				gasLimit = path[3]
+			}
+			return queryContractState(ctx, path[1], path[2], gasLimit, req, keeper)
 		case QueryGetCode:
 			return queryCode(ctx, path[1], keeper)
 		case QueryListCode:
-			return queryCodeList(ctx, keeper)
+			return queryCodeList(ctx, req, keeper)
 		case QueryContractHistory:
 			return queryContractHistory(ctx, path[1], keeper)
+		case QueryParams:
+			return queryParams(ctx, keeper)
+		case QueryContractExecutionGrants:
+			if len(path) < 3 {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "granter and grantee required")
+			}
+			return queryContractExecutionGrants(ctx, path[1], path[2], keeper)
+		case QueryContractMetadata:
+			return queryContractMetadata(ctx, path[1], keeper)
+		case QueryContractRewards:
+			return queryContractRewards(ctx, path[1], keeper)
+		case QueryContractLimits:
+			return queryContractLimits(ctx, path[1], keeper)
+		case QueryContractSchedule:
+			return queryContractSchedule(ctx, path[1], keeper)
+		case QueryIncompatibleCodes:
+			return queryIncompatibleCodes(ctx, keeper)
+		case QueryTxTrace:
+			return queryTxTrace(path[1], keeper)
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown data query endpoint")
 		}
 	}
 }
 
+// historicalCtx returns the context for a query at the given height. The app loads the multistore of the context at
+// that version already, so contracts and their sub queries read the historical state, but the block header is the
+// one of the latest block. The block height is set to the query height, the block time is left at the latest block.
+func historicalCtx(ctx sdk.Context, height int64) sdk.Context {
+	if height <= 0 || height >= ctx.BlockHeight() {
+		return ctx
+	}
+	return ctx.WithBlockHeight(height)
+}
+
 func queryContractInfo(ctx sdk.Context, bech string, keeper Keeper) ([]byte, error) {
 	addr, err := sdk.AccAddressFromBech32(bech)
 	if err != nil {
@@ -73,6 +149,7 @@ func queryContractInfo(ctx sdk.Context, bech string, keeper Keeper) ([]byte, err
 	infoWithAddress := ContractInfoWithAddress{
 		Address:      addr,
 		ContractInfo: info,
+		Provenance:   keeper.GetContractProvenance(ctx, addr),
 	}
 	bz, err := json.MarshalIndent(infoWithAddress, "", "  ")
 	if err != nil {
@@ -86,25 +163,56 @@ func redact(info *types.ContractInfo) {
 	info.Created = nil
 }
 
-func queryContractListByCode(ctx sdk.Context, codeIDstr string, keeper Keeper) ([]byte, error) {
+func queryContractListByCode(ctx sdk.Context, codeIDstr string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
 	codeID, err := strconv.ParseUint(codeIDstr, 10, 64)
 	if err != nil {
 		return nil, err
 	}
+	page, err := types.ParsePageRequest(req.Data)
+	if err != nil {
+		return nil, err
+	}
+	if page != nil {
+		return queryContractIndexPage(ctx, types.GetContractByCodeIndexPrefix(codeID), *page, keeper)
+	}
 
 	var contracts []ContractInfoWithAddress
-	keeper.IterateContractInfo(ctx, func(addr sdk.AccAddress, info types.ContractInfo) bool {
-		if info.CodeID == codeID {
-			// and add the address
-			infoWithAddress := ContractInfoWithAddress{
-				Address:      addr,
-				ContractInfo: &info,
-			}
-			contracts = append(contracts, infoWithAddress)
-		}
+	keeper.IterateContractsByCode(ctx, codeID, func(addr sdk.AccAddress) bool {
+		contracts = append(contracts, ContractInfoWithAddress{
+			Address:      addr,
+			ContractInfo: keeper.GetContractInfo(ctx, addr),
+		})
 		return false
 	})
+	return marshalContractsByCreation(contracts)
+}
 
+func queryContractListByCreator(ctx sdk.Context, bech string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	creator, err := sdk.AccAddressFromBech32(bech)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	page, err := types.ParsePageRequest(req.Data)
+	if err != nil {
+		return nil, err
+	}
+	if page != nil {
+		return queryContractIndexPage(ctx, types.GetContractByCreatorIndexPrefix(creator), *page, keeper)
+	}
+
+	var contracts []ContractInfoWithAddress
+	keeper.IterateContractsByCreator(ctx, creator, func(addr sdk.AccAddress) bool {
+		contracts = append(contracts, ContractInfoWithAddress{
+			Address:      addr,
+			ContractInfo: keeper.GetContractInfo(ctx, addr),
+		})
+		return false
+	})
+	return marshalContractsByCreation(contracts)
+}
+
+// marshalContractsByCreation returns the json of the contracts in the order they were instantiated
+func marshalContractsByCreation(contracts []ContractInfoWithAddress) ([]byte, error) {
 	// now we sort them by AbsoluteTxPosition
 	sort.Slice(contracts, func(i, j int) bool {
 		return contracts[i].ContractInfo.Created.LessThan(contracts[j].ContractInfo.Created)
@@ -121,7 +229,33 @@ func queryContractListByCode(ctx sdk.Context, codeIDstr string, keeper Keeper) (
 	return bz, nil
 }
 
-func queryContractState(ctx sdk.Context, bech, queryMethod string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+// queryContractIndexPage returns the contracts of the index prefix in address order as the creation order can not be
+// paginated on
+func queryContractIndexPage(ctx sdk.Context, indexPrefix []byte, page types.PageRequest, keeper Keeper) ([]byte, error) {
+	res := ListContractsPageResponse{Contracts: make([]ContractInfoWithAddress, 0)}
+	// the keys of the index are the contract addresses
+	prefixStore := prefix.NewStore(ctx.KVStore(keeper.storeKey), indexPrefix)
+	res.Pagination = paginate(prefixStore, page, func(key, _ []byte, accumulate bool) bool {
+		if accumulate {
+			addr := append(sdk.AccAddress{}, key...)
+			info := keeper.GetContractInfo(ctx, addr)
+			redact(info)
+			res.Contracts = append(res.Contracts, ContractInfoWithAddress{
+				Address:      addr,
+				ContractInfo: info,
+			})
+		}
+		return true
+	})
+
+	bz, err := json.MarshalIndent(res, "", "  ")
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryContractState(ctx sdk.Context, bech, queryMethod, gasLimit string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
 	contractAddr, err := sdk.AccAddressFromBech32(bech)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, bech)
@@ -130,6 +264,13 @@ func queryContractState(ctx sdk.Context, bech, queryMethod string, req abci.Requ
 	var resultData []types.Model
 	switch queryMethod {
 	case QueryMethodContractStateAll:
+		page, err := types.ParsePageRequest(req.Data)
+		if err != nil {
+			return nil, err
+		}
+		if page != nil {
+			return queryContractStatePage(ctx, contractAddr, *page, keeper)
+		}
 		// this returns a serialized json object (which internally encoded binary fields properly)
 		for iter := keeper.GetContractState(ctx, contractAddr); iter.Valid(); iter.Next() {
 			resultData = append(resultData, types.Model{
@@ -144,10 +285,15 @@ func queryContractState(ctx sdk.Context, bech, queryMethod string, req abci.Requ
 		// this returns a serialized json object
 		resultData = keeper.QueryRaw(ctx, contractAddr, req.Data)
 	case QueryMethodContractStateSmart:
+		var limit uint64
+		if gasLimit != "" {
+			if limit, err = strconv.ParseUint(gasLimit, 10, 64); err != nil {
+				return nil, sdkerrors.Wrapf(types.ErrInvalid, "gas limit: %s", err)
+			}
+		}
 		// we enforce a subjective gas limit on all queries to avoid infinite loops
-		ctx = ctx.WithGasMeter(sdk.NewGasMeter(keeper.queryGasLimit))
 		// this returns raw bytes (must be base64-encoded)
-		return keeper.QuerySmart(ctx, contractAddr, req.Data)
+		return keeper.QuerySmartWithGasLimit(ctx, contractAddr, req.Data, limit)
 	default:
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, queryMethod)
 	}
@@ -158,12 +304,151 @@ func queryContractState(ctx sdk.Context, bech, queryMethod string, req abci.Requ
 	return bz, nil
 }
 
+func queryContractStatePage(ctx sdk.Context, contractAddr sdk.AccAddress, page types.PageRequest, keeper Keeper) ([]byte, error) {
+	res := ContractStatePageResponse{Models: make([]types.Model, 0)}
+	prefixStore := prefix.NewStore(ctx.KVStore(keeper.storeKey), types.GetContractStorePrefixKey(contractAddr))
+	res.Pagination = paginate(prefixStore, page, func(key, value []byte, accumulate bool) bool {
+		if accumulate {
+			res.Models = append(res.Models, types.Model{
+				Key:   append([]byte{}, key...),
+				Value: append([]byte{}, value...),
+			})
+		}
+		return true
+	})
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
 type GetCodeResponse struct {
 	ListCodeResponse
 	// Data is the entire wasm bytecode
 	Data []byte `json:"data" yaml:"data"`
 }
 
+// queryParams returns the wasm params with the limits that are not set filled with their defaults
+func queryParams(ctx sdk.Context, keeper Keeper) ([]byte, error) {
+	params := keeper.GetParams(ctx)
+	params.MaxWasmCodeSize = keeper.getMaxWasmCodeSize(ctx)
+	params.MaxContractGas = keeper.getMaxContractGas(ctx)
+	params.GasMultiplier = keeper.getGasMultiplier(ctx)
+	bz, err := json.MarshalIndent(params, "", "  ")
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryContractExecutionGrants(ctx sdk.Context, granterBech, granteeBech string, keeper Keeper) ([]byte, error) {
+	granter, err := sdk.AccAddressFromBech32(granterBech)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "granter: "+err.Error())
+	}
+	grantee, err := sdk.AccAddressFromBech32(granteeBech)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "grantee: "+err.Error())
+	}
+	grants := make([]types.ContractExecutionGrant, 0)
+	keeper.IterateContractExecutionGrantsOf(ctx, granter, grantee, func(grant types.ContractExecutionGrant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+	bz, err := json.MarshalIndent(grants, "", "  ")
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryContractMetadata(ctx sdk.Context, bech string, keeper Keeper) ([]byte, error) {
+	addr, err := sdk.AccAddressFromBech32(bech)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	metadata := keeper.GetContractMetadata(ctx, addr)
+	if metadata == nil {
+		return []byte("null"), nil
+	}
+	bz, err := json.MarshalIndent(metadata, "", "  ")
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+// ContractLimitsResponse is returned for a contract-limits query. Effective are the limits that are enforced.
+type ContractLimitsResponse struct {
+	Bounds    types.ContractLimits `json:"bounds"`
+	Admin     types.ContractLimits `json:"admin"`
+	Effective types.ContractLimits `json:"effective"`
+}
+
+func queryContractLimits(ctx sdk.Context, bech string, keeper Keeper) ([]byte, error) {
+	addr, err := sdk.AccAddressFromBech32(bech)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	info := keeper.GetContractLimits(ctx, addr)
+	if info == nil {
+		return []byte("null"), nil
+	}
+	bz, err := json.MarshalIndent(ContractLimitsResponse{
+		Bounds:    info.Bounds,
+		Admin:     info.Admin,
+		Effective: info.Effective(),
+	}, "", "  ")
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryContractSchedule(ctx sdk.Context, bech string, keeper Keeper) ([]byte, error) {
+	addr, err := sdk.AccAddressFromBech32(bech)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	schedule := keeper.GetContractSchedule(ctx, addr)
+	if schedule == nil {
+		return []byte("null"), nil
+	}
+	bz, err := json.MarshalIndent(schedule, "", "  ")
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+// ContractRewardsResponse is returned for a contract-rewards query. The rewards are those of the reward address, which
+// can be shared by several contracts.
+type ContractRewardsResponse struct {
+	RewardAddress sdk.AccAddress `json:"reward_address"`
+	Rewards       sdk.Coins      `json:"rewards"`
+}
+
+func queryContractRewards(ctx sdk.Context, bech string, keeper Keeper) ([]byte, error) {
+	addr, err := sdk.AccAddressFromBech32(bech)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	rewardAddr := keeper.GetContractRewardAddress(ctx, addr)
+	if rewardAddr == nil {
+		return []byte("null"), nil
+	}
+	bz, err := json.MarshalIndent(ContractRewardsResponse{
+		RewardAddress: rewardAddr,
+		Rewards:       keeper.GetContractRewards(ctx, rewardAddr),
+	}, "", "  ")
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
 func queryCode(ctx sdk.Context, codeIDstr string, keeper Keeper) ([]byte, error) {
 	codeID, err := strconv.ParseUint(codeIDstr, 10, 64)
 	if err != nil {
@@ -181,6 +466,8 @@ func queryCode(ctx sdk.Context, codeIDstr string, keeper Keeper) ([]byte, error)
 		DataHash: res.CodeHash,
 		Source:   res.Source,
 		Builder:  res.Builder,
+
+		InterfaceVersion: res.GetInterfaceVersion(),
 	}
 
 	code, err := keeper.GetByteCode(ctx, codeID)
@@ -201,9 +488,19 @@ type ListCodeResponse struct {
 	DataHash tmbytes.HexBytes `json:"data_hash"`
 	Source   string           `json:"source"`
 	Builder  string           `json:"builder"`
+	// InterfaceVersion is the CosmWasm interface version of the code
+	InterfaceVersion string `json:"interface_version"`
 }
 
-func queryCodeList(ctx sdk.Context, keeper Keeper) ([]byte, error) {
+func queryCodeList(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	page, err := types.ParsePageRequest(req.Data)
+	if err != nil {
+		return nil, err
+	}
+	if page != nil {
+		return queryCodeListPage(ctx, *page, keeper)
+	}
+
 	var info []ListCodeResponse
 
 	var i uint64
@@ -219,6 +516,8 @@ func queryCodeList(ctx sdk.Context, keeper Keeper) ([]byte, error) {
 			DataHash: res.CodeHash,
 			Source:   res.Source,
 			Builder:  res.Builder,
+
+			InterfaceVersion: res.GetInterfaceVersion(),
 		})
 	}
 
@@ -229,6 +528,58 @@ func queryCodeList(ctx sdk.Context, keeper Keeper) ([]byte, error) {
 	return bz, nil
 }
 
+func queryCodeListPage(ctx sdk.Context, page types.PageRequest, keeper Keeper) ([]byte, error) {
+	res := ListCodePageResponse{Codes: make([]ListCodeResponse, 0)}
+	prefixStore := prefix.NewStore(ctx.KVStore(keeper.storeKey), types.CodeKeyPrefix)
+	res.Pagination = paginate(prefixStore, page, func(key, value []byte, accumulate bool) bool {
+		if accumulate {
+			var c types.CodeInfo
+			keeper.cdc.MustUnmarshalBinaryBare(value, &c)
+			res.Codes = append(res.Codes, ListCodeResponse{
+				ID:       binary.BigEndian.Uint64(key),
+				Creator:  c.Creator,
+				DataHash: c.CodeHash,
+				Source:   c.Source,
+				Builder:  c.Builder,
+
+				InterfaceVersion: c.GetInterfaceVersion(),
+			})
+		}
+		return true
+	})
+
+	bz, err := json.MarshalIndent(res, "", "  ")
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+// queryIncompatibleCodes returns the codes that can not be instantiated, executed or queried on this node
+func queryIncompatibleCodes(ctx sdk.Context, keeper Keeper) ([]byte, error) {
+	var info []ListCodeResponse
+	keeper.IterateCodeInfos(ctx, func(codeID uint64, res types.CodeInfo) bool {
+		if !keeper.IsCompatible(res) {
+			info = append(info, ListCodeResponse{
+				ID:       codeID,
+				Creator:  res.Creator,
+				DataHash: res.CodeHash,
+				Source:   res.Source,
+				Builder:  res.Builder,
+
+				InterfaceVersion: res.GetInterfaceVersion(),
+			})
+		}
+		return false
+	})
+
+	bz, err := json.MarshalIndent(info, "", "  ")
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
 func queryContractHistory(ctx sdk.Context, bech string, keeper Keeper) ([]byte, error) {
 	contractAddr, err := sdk.AccAddressFromBech32(bech)
 	if err != nil {
@@ -250,3 +601,15 @@ func queryContractHistory(ctx sdk.Context, bech string, keeper Keeper) ([]byte,
 	}
 	return bz, nil
 }
+
+func queryTxTrace(hash string, keeper Keeper) ([]byte, error) {
+	trace, ok := keeper.GetTxTrace(strings.ToUpper(hash))
+	if !ok {
+		return []byte("null"), nil
+	}
+	bz, err := json.MarshalIndent(trace, "", "  ")
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
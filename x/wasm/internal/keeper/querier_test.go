@@ -42,7 +42,7 @@ func TestQueryContractState(t *testing.T) {
 	initMsgBz, err := json.Marshal(initMsg)
 	require.NoError(t, err)
 
-	addr, err := keeper.Instantiate(ctx, contractID, creator, nil, initMsgBz, "demo contract to query", deposit)
+	addr, _, err := keeper.Instantiate(ctx, contractID, creator, nil, initMsgBz, "demo contract to query", deposit)
 	require.NoError(t, err)
 
 	contractModel := []types.Model{
@@ -89,6 +89,26 @@ func TestQueryContractState(t *testing.T) {
 			srcReq:      abci.RequestQuery{Data: []byte(`{"verifier":{}}`)},
 			expSmartRes: fmt.Sprintf(`{"verifier":"%s"}`, anyAddr.String()),
 		},
+		"query smart with gas limit": {
+			srcPath:     []string{QueryGetContractState, addr.String(), QueryMethodContractStateSmart, "1000000"},
+			srcReq:      abci.RequestQuery{Data: []byte(`{"verifier":{}}`)},
+			expSmartRes: fmt.Sprintf(`{"verifier":"%s"}`, anyAddr.String()),
+		},
+		"query smart out of gas": {
+			srcPath: []string{QueryGetContractState, addr.String(), QueryMethodContractStateSmart, "1"},
+			srcReq:  abci.RequestQuery{Data: []byte(`{"verifier":{}}`)},
+			expErr:  sdkErrors.ErrOutOfGas,
+		},
+		"query smart gas limit above node limit": {
+			srcPath: []string{QueryGetContractState, addr.String(), QueryMethodContractStateSmart, "3000001"},
+			srcReq:  abci.RequestQuery{Data: []byte(`{"verifier":{}}`)},
+			expErr:  types.ErrInvalid,
+		},
+		"query smart invalid gas limit": {
+			srcPath: []string{QueryGetContractState, addr.String(), QueryMethodContractStateSmart, "all"},
+			srcReq:  abci.RequestQuery{Data: []byte(`{"verifier":{}}`)},
+			expErr:  types.ErrInvalid,
+		},
 		"query smart invalid request": {
 			srcPath: []string{QueryGetContractState, addr.String(), QueryMethodContractStateSmart},
 			srcReq:  abci.RequestQuery{Data: []byte(`{"raw":{"key":"config"}}`)},
@@ -150,6 +170,92 @@ func TestQueryContractState(t *testing.T) {
 	}
 }
 
+func TestQueryContractStateAtHeight(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keepers := CreateTestInput(t, false, tempDir, SupportedFeatures, nil, nil)
+	accKeeper, keeper := keepers.AccountKeeper, keepers.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, deposit)
+	_, _, oldVerifier := keyPubAddr()
+	_, _, newVerifier := keyPubAddr()
+	_, _, bob := keyPubAddr()
+
+	wasmCode, err := ioutil.ReadFile("./testdata/contract.wasm")
+	require.NoError(t, err)
+	codeID, err := keeper.Create(ctx, creator, wasmCode, "", "", nil)
+	require.NoError(t, err)
+	initMsgBz, err := json.Marshal(InitMsg{Verifier: oldVerifier, Beneficiary: bob})
+	require.NoError(t, err)
+	addr, _, err := keeper.Instantiate(ctx, codeID, creator, creator, initMsgBz, "demo contract", nil)
+	require.NoError(t, err)
+
+	cms := ctx.MultiStore().(sdk.CommitMultiStore)
+	initialVersion := cms.Commit().Version
+
+	migMsgBz, err := json.Marshal(struct {
+		Verifier sdk.AccAddress `json:"verifier"`
+	}{Verifier: newVerifier})
+	require.NoError(t, err)
+	_, err = keeper.Migrate(ctx, addr, creator, codeID, migMsgBz)
+	require.NoError(t, err)
+	latestVersion := cms.Commit().Version
+
+	q := NewQuerier(keeper)
+	specs := map[string]struct {
+		version     int64
+		expVerifier sdk.AccAddress
+	}{
+		"initial version": {
+			version:     initialVersion,
+			expVerifier: oldVerifier,
+		},
+		"latest version": {
+			version:     latestVersion,
+			expVerifier: newVerifier,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			// the app loads the multistore at the query height
+			historicalMS, err := cms.CacheMultiStoreWithVersion(spec.version)
+			require.NoError(t, err)
+			queryCtx := ctx.WithMultiStore(historicalMS)
+
+			binResult, err := q(queryCtx, []string{QueryGetContractState, addr.String(), QueryMethodContractStateSmart},
+				abci.RequestQuery{Data: []byte(`{"verifier":{}}`), Height: spec.version})
+			require.NoError(t, err)
+			assert.JSONEq(t, fmt.Sprintf(`{"verifier":"%s"}`, spec.expVerifier), string(binResult))
+
+			binResult, err = q(queryCtx, []string{QueryGetContract, addr.String()}, abci.RequestQuery{Height: spec.version})
+			require.NoError(t, err)
+			var info ContractInfoWithAddress
+			require.NoError(t, json.Unmarshal(binResult, &info))
+			assert.Equal(t, codeID, info.CodeID)
+		})
+	}
+}
+
+func TestHistoricalCtx(t *testing.T) {
+	ctx := sdk.Context{}.WithBlockHeight(100)
+	specs := map[string]struct {
+		src int64
+		exp int64
+	}{
+		"no height":    {src: 0, exp: 100},
+		"older height": {src: 10, exp: 10},
+		"latest":       {src: 100, exp: 100},
+		"future":       {src: 101, exp: 100},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			assert.Equal(t, spec.exp, historicalCtx(ctx, spec.src).BlockHeight())
+		})
+	}
+}
+
 func TestListContractByCodeOrdering(t *testing.T) {
 	tempDir, err := ioutil.TempDir("", "wasm")
 	require.NoError(t, err)
@@ -193,7 +299,7 @@ func TestListContractByCodeOrdering(t *testing.T) {
 			ctx = setBlock(ctx, h)
 			h++
 		}
-		_, err = keeper.Instantiate(ctx, codeID, creator, nil, initMsgBz, fmt.Sprintf("contract %d", i), topUp)
+		_, _, err = keeper.Instantiate(ctx, codeID, creator, nil, initMsgBz, fmt.Sprintf("contract %d", i), topUp)
 		require.NoError(t, err)
 	}
 
@@ -218,6 +324,61 @@ func TestListContractByCodeOrdering(t *testing.T) {
 	}
 }
 
+func TestQueryParams(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keepers := CreateTestInput(t, false, tempDir, SupportedFeatures, nil, nil)
+	keeper := keepers.WasmKeeper
+	q := NewQuerier(keeper)
+
+	specs := map[string]struct {
+		src types.Params
+		exp types.Params
+	}{
+		"defaults": {
+			src: types.DefaultParams(),
+			exp: types.DefaultParams(),
+		},
+		"limits not set": {
+			src: types.Params{UploadAccess: types.AllowNobody, DefaultInstantiatePermission: types.Nobody},
+			exp: types.Params{
+				UploadAccess:                 types.AllowNobody,
+				DefaultInstantiatePermission: types.Nobody,
+				MaxWasmCodeSize:              types.MaxWasmSize,
+				MaxContractGas:               types.DefaultMaxContractGas,
+				GasMultiplier:                types.DefaultGasMultiplier,
+			},
+		},
+		"custom limits": {
+			src: types.Params{
+				UploadAccess:                 types.AllowEverybody,
+				DefaultInstantiatePermission: types.Everybody,
+				MaxWasmCodeSize:              1024,
+				MaxContractGas:               1_000_000,
+				GasMultiplier:                50,
+			},
+			exp: types.Params{
+				UploadAccess:                 types.AllowEverybody,
+				DefaultInstantiatePermission: types.Everybody,
+				MaxWasmCodeSize:              1024,
+				MaxContractGas:               1_000_000,
+				GasMultiplier:                50,
+			},
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			keeper.setParams(ctx, spec.src)
+			bz, err := q(ctx, []string{QueryParams}, abci.RequestQuery{})
+			require.NoError(t, err)
+			var got types.Params
+			require.NoError(t, json.Unmarshal(bz, &got))
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
+
 func TestQueryContractHistory(t *testing.T) {
 	tempDir, err := ioutil.TempDir("", "wasm")
 	require.NoError(t, err)
@@ -318,3 +479,142 @@ func TestQueryContractHistory(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryPagination(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keepers := CreateTestInput(t, false, tempDir, SupportedFeatures, nil, nil)
+	accKeeper, keeper := keepers.AccountKeeper, keepers.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 1000000))
+	creator := createFakeFundedAccount(ctx, accKeeper, deposit)
+	anyAddr := createFakeFundedAccount(ctx, accKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/contract.wasm")
+	require.NoError(t, err)
+
+	var codeID uint64
+	for range [3]int{} {
+		codeID, err = keeper.Create(ctx, creator, wasmCode, "", "", nil)
+		require.NoError(t, err)
+	}
+	_, _, bob := keyPubAddr()
+	initMsgBz, err := json.Marshal(InitMsg{Verifier: anyAddr, Beneficiary: bob})
+	require.NoError(t, err)
+	var addr sdk.AccAddress
+	for i := range [3]int{} {
+		addr, _, err = keeper.Instantiate(ctx, codeID, creator, nil, initMsgBz, fmt.Sprintf("contract %d", i), nil)
+		require.NoError(t, err)
+	}
+	// a contract of another code is skipped
+	_, _, err = keeper.Instantiate(ctx, 1, creator, nil, initMsgBz, "other", nil)
+	require.NoError(t, err)
+	// a contract of another creator is skipped
+	_, _, err = keeper.Instantiate(ctx, 1, anyAddr, nil, initMsgBz, "other creator", nil)
+	require.NoError(t, err)
+
+	q := NewQuerier(keeper)
+	query := func(t *testing.T, path []string, page types.PageRequest, res interface{}) []byte {
+		bz, err := json.Marshal(page)
+		require.NoError(t, err)
+		resBz, err := q(ctx, path, abci.RequestQuery{Data: bz})
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(resBz, res))
+		return resBz
+	}
+
+	t.Run("list code", func(t *testing.T) {
+		var page ListCodePageResponse
+		query(t, []string{QueryListCode}, types.PageRequest{Limit: 2}, &page)
+		require.Len(t, page.Codes, 2)
+		assert.Equal(t, uint64(1), page.Codes[0].ID)
+		assert.Equal(t, uint64(2), page.Codes[1].ID)
+		require.NotEmpty(t, page.Pagination.NextKey)
+
+		query(t, []string{QueryListCode}, types.PageRequest{Key: page.Pagination.NextKey, Limit: 2}, &page)
+		require.Len(t, page.Codes, 1)
+		assert.Equal(t, uint64(3), page.Codes[0].ID)
+		assert.Empty(t, page.Pagination.NextKey)
+
+		query(t, []string{QueryListCode}, types.PageRequest{Offset: 1, Limit: 1, Reverse: true}, &page)
+		require.Len(t, page.Codes, 1)
+		assert.Equal(t, uint64(2), page.Codes[0].ID)
+		assert.NotEmpty(t, page.Pagination.NextKey)
+	})
+	t.Run("list contracts by code", func(t *testing.T) {
+		var first, second ListContractsPageResponse
+		path := []string{QueryListContractByCode, fmt.Sprintf("%d", codeID)}
+		query(t, path, types.PageRequest{Limit: 2}, &first)
+		require.Len(t, first.Contracts, 2)
+		require.NotEmpty(t, first.Pagination.NextKey)
+
+		query(t, path, types.PageRequest{Key: first.Pagination.NextKey}, &second)
+		require.Len(t, second.Contracts, 1)
+		assert.Empty(t, second.Pagination.NextKey)
+
+		seen := make(map[string]bool)
+		for _, c := range append(first.Contracts, second.Contracts...) {
+			assert.Equal(t, codeID, c.CodeID)
+			assert.Nil(t, c.Created)
+			seen[c.Address.String()] = true
+		}
+		assert.Len(t, seen, 3)
+	})
+	t.Run("list contracts by creator", func(t *testing.T) {
+		var first, second ListContractsPageResponse
+		path := []string{QueryListContractByCreator, creator.String()}
+		query(t, path, types.PageRequest{Limit: 3}, &first)
+		require.Len(t, first.Contracts, 3)
+		require.NotEmpty(t, first.Pagination.NextKey)
+
+		query(t, path, types.PageRequest{Key: first.Pagination.NextKey}, &second)
+		require.Len(t, second.Contracts, 1)
+		assert.Empty(t, second.Pagination.NextKey)
+
+		seen := make(map[string]bool)
+		for _, c := range append(first.Contracts, second.Contracts...) {
+			assert.Equal(t, creator, c.Creator)
+			assert.Nil(t, c.Created)
+			seen[c.Address.String()] = true
+		}
+		assert.Len(t, seen, 4)
+
+		// without page all contracts are returned
+		res, err := q(ctx, path, abci.RequestQuery{})
+		require.NoError(t, err)
+		var contracts []ContractInfoWithAddress
+		require.NoError(t, json.Unmarshal(res, &contracts))
+		assert.Len(t, contracts, 4)
+	})
+	t.Run("contract state", func(t *testing.T) {
+		keeper.importContractState(ctx, addr, []types.Model{
+			{Key: []byte("a"), Value: []byte(`1`)},
+			{Key: []byte("b"), Value: []byte(`2`)},
+		})
+		var page ContractStatePageResponse
+		path := []string{QueryGetContractState, addr.String(), QueryMethodContractStateAll}
+		query(t, path, types.PageRequest{Limit: 1, Reverse: true}, &page)
+		require.Len(t, page.Models, 1)
+		lastKey := page.Models[0].Key
+		require.NotEmpty(t, page.Pagination.NextKey)
+
+		query(t, path, types.PageRequest{Key: page.Pagination.NextKey, Reverse: true}, &page)
+		require.Len(t, page.Models, 2)
+		assert.True(t, bytes.Compare(page.Models[0].Key, lastKey) < 0)
+		assert.Equal(t, []byte("a"), page.Models[1].Key)
+		assert.Empty(t, page.Pagination.NextKey)
+	})
+	t.Run("key and offset", func(t *testing.T) {
+		bz, err := json.Marshal(types.PageRequest{Key: []byte{0x1}, Offset: 1})
+		require.NoError(t, err)
+		_, err = q(ctx, []string{QueryListCode}, abci.RequestQuery{Data: bz})
+		assert.True(t, types.ErrInvalid.Is(err), err)
+	})
+	t.Run("limit exceeded", func(t *testing.T) {
+		bz, err := json.Marshal(types.PageRequest{Limit: types.MaxPageLimit + 1})
+		require.NoError(t, err)
+		_, err = q(ctx, []string{QueryListCode}, abci.RequestQuery{Data: bz})
+		assert.True(t, types.ErrLimit.Is(err), err)
+	})
+}
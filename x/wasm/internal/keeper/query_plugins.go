@@ -12,13 +12,15 @@ import (
 type QueryHandler struct {
 	Ctx     sdk.Context
 	Plugins QueryPlugins
+	// GasMultiplier converts the wasm gas limit of a query into sdk gas
+	GasMultiplier uint64
 }
 
 var _ wasmTypes.Querier = QueryHandler{}
 
 func (q QueryHandler) Query(request wasmTypes.QueryRequest, gasLimit uint64) ([]byte, error) {
 	// set a limit for a subctx
-	sdkGas := gasLimit / GasMultiplier
+	sdkGas := gasLimit / q.GasMultiplier
 	subctx := q.Ctx.WithGasMeter(sdk.NewGasMeter(sdkGas))
 
 	// make sure we charge the higher level context even on panic
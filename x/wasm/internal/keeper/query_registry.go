@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	wasmTypes "github.com/CosmWasm/go-cosmwasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+// StargateQueryRoute is the custom query route of the StargateQuerier
+const StargateQueryRoute = "stargate"
+
+// QueryRegistry routes the custom queries of contracts to the queriers registered at app wiring time.
+// A custom query is a json object with the route as single key, e.g. `{"oracle":{"price":{"symbol":"FET"}}}`,
+// the querier of the route is called with the value.
+type QueryRegistry struct {
+	routes map[string]CustomQuerier
+}
+
+// NewQueryRegistry creates an empty QueryRegistry
+func NewQueryRegistry() *QueryRegistry {
+	return &QueryRegistry{routes: make(map[string]CustomQuerier)}
+}
+
+// Register adds the querier for the route. It panics when the route is empty or registered already.
+func (r *QueryRegistry) Register(route string, q CustomQuerier) *QueryRegistry {
+	if route == "" {
+		panic("empty custom query route")
+	}
+	if _, exists := r.routes[route]; exists {
+		panic(fmt.Sprintf("custom query route %q already registered", route))
+	}
+	r.routes[route] = q
+	return r
+}
+
+// Routes returns the registered routes in sorted order
+func (r *QueryRegistry) Routes() []string {
+	res := make([]string, 0, len(r.routes))
+	for route := range r.routes {
+		res = append(res, route)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// Querier returns the CustomQuerier to set as QueryPlugins.Custom
+func (r *QueryRegistry) Querier() CustomQuerier {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var routed map[string]json.RawMessage
+		if err := json.Unmarshal(request, &routed); err != nil {
+			return nil, sdkerrors.Wrap(types.ErrInvalidMsg, err.Error())
+		}
+		if len(routed) != 1 {
+			return nil, sdkerrors.Wrap(types.ErrInvalidMsg, "custom query must have exactly one route")
+		}
+		for route, req := range routed {
+			q, ok := r.routes[route]
+			if !ok {
+				return nil, wasmTypes.UnsupportedRequest{Kind: fmt.Sprintf("custom query route %q", route)}
+			}
+			return q(ctx, req)
+		}
+		return nil, wasmTypes.UnsupportedRequest{Kind: "custom"}
+	}
+}
+
+// StargateQuery calls a module querier of the app by its query path, e.g. `mint/inflation`.
+// Data is passed to the querier as request data.
+type StargateQuery struct {
+	Path string          `json:"path"`
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+// DefaultStargateWhitelist returns the module query paths a contract may call with a StargateQuery.
+// They are read only and have a small result that does not grow with the chain state.
+func DefaultStargateWhitelist() []string {
+	return []string{
+		mint.QuerierRoute + "/" + mint.QueryParameters,
+		mint.QuerierRoute + "/" + mint.QueryInflation,
+		mint.QuerierRoute + "/" + mint.QueryAnnualProvisions,
+		staking.QuerierRoute + "/" + staking.QueryPool,
+		staking.QuerierRoute + "/" + staking.QueryParameters,
+		staking.QuerierRoute + "/" + staking.QueryValidator,
+		distribution.QuerierRoute + "/" + distribution.QueryParams,
+		distribution.QuerierRoute + "/" + distribution.QueryCommunityPool,
+		supply.QuerierRoute + "/" + supply.QuerySupplyOf,
+	}
+}
+
+// StargateQuerier routes a StargateQuery to the module querier of the app when its path is whitelisted.
+// Register with StargateQueryRoute.
+func StargateQuerier(router sdk.QueryRouter, whitelist []string) CustomQuerier {
+	allowed := make(map[string]bool, len(whitelist))
+	for _, p := range whitelist {
+		allowed[p] = true
+	}
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var query StargateQuery
+		if err := json.Unmarshal(request, &query); err != nil {
+			return nil, sdkerrors.Wrap(types.ErrInvalidMsg, err.Error())
+		}
+		path := strings.TrimPrefix(query.Path, "custom/")
+		if !allowed[path] {
+			return nil, wasmTypes.UnsupportedRequest{Kind: fmt.Sprintf("stargate query path %q is not whitelisted", query.Path)}
+		}
+		segments := strings.Split(path, "/")
+		querier := router.Route(segments[0])
+		if querier == nil {
+			return nil, wasmTypes.UnsupportedRequest{Kind: fmt.Sprintf("no querier for stargate query path %q", query.Path)}
+		}
+		return querier(ctx, segments[1:], abci.RequestQuery{
+			Path:   "custom/" + path,
+			Data:   query.Data,
+			Height: ctx.BlockHeight(),
+		})
+	}
+}
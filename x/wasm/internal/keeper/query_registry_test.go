@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"encoding/json"
+	"testing"
+
+	wasmTypes "github.com/CosmWasm/go-cosmwasm/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestQueryRegistry(t *testing.T) {
+	echo := func(_ sdk.Context, request json.RawMessage) ([]byte, error) {
+		return request, nil
+	}
+	registry := NewQueryRegistry().Register("echo", echo)
+	assert.Equal(t, []string{"echo"}, registry.Routes())
+	assert.Panics(t, func() { registry.Register("echo", echo) })
+	assert.Panics(t, func() { registry.Register("", echo) })
+
+	specs := map[string]struct {
+		src    string
+		expRes string
+		expErr bool
+	}{
+		"registered route": {
+			src:    `{"echo":{"foo":"bar"}}`,
+			expRes: `{"foo":"bar"}`,
+		},
+		"unknown route": {
+			src:    `{"oracle":{}}`,
+			expErr: true,
+		},
+		"several routes": {
+			src:    `{"echo":{},"oracle":{}}`,
+			expErr: true,
+		},
+		"no route": {
+			src:    `{}`,
+			expErr: true,
+		},
+		"not an object": {
+			src:    `"echo"`,
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			res, err := registry.Querier()(sdk.Context{}, json.RawMessage(spec.src))
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.JSONEq(t, spec.expRes, string(res))
+		})
+	}
+}
+
+func TestStargateQuerier(t *testing.T) {
+	var gotPath []string
+	var gotReq abci.RequestQuery
+	router := baseapp.NewQueryRouter()
+	router.AddRoute("mint", func(_ sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		gotPath, gotReq = path, req
+		return []byte(`"0.1"`), nil
+	})
+	querier := StargateQuerier(router, []string{"mint/inflation", "staking/pool"})
+
+	specs := map[string]struct {
+		src     StargateQuery
+		expPath []string
+		expErr  bool
+	}{
+		"whitelisted": {
+			src:     StargateQuery{Path: "mint/inflation"},
+			expPath: []string{"inflation"},
+		},
+		"whitelisted with custom prefix": {
+			src:     StargateQuery{Path: "custom/mint/inflation"},
+			expPath: []string{"inflation"},
+		},
+		"with data": {
+			src:     StargateQuery{Path: "mint/inflation", Data: json.RawMessage(`{"foo":"bar"}`)},
+			expPath: []string{"inflation"},
+		},
+		"not whitelisted": {
+			src:    StargateQuery{Path: "mint/parameters"},
+			expErr: true,
+		},
+		"whitelisted without querier": {
+			src:    StargateQuery{Path: "staking/pool"},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			gotPath, gotReq = nil, abci.RequestQuery{}
+			bz, err := json.Marshal(spec.src)
+			require.NoError(t, err)
+
+			res, err := querier(sdk.Context{}, bz)
+			if spec.expErr {
+				require.Error(t, err)
+				assert.IsType(t, wasmTypes.UnsupportedRequest{}, err)
+				assert.Nil(t, gotPath)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, `"0.1"`, string(res))
+			assert.Equal(t, spec.expPath, gotPath)
+			assert.Equal(t, []byte(spec.src.Data), gotReq.Data)
+		})
+	}
+}
@@ -73,7 +73,7 @@ func initRecurseContract(t *testing.T) (contract sdk.AccAddress, creator sdk.Acc
 	}
 	initMsgBz, err := json.Marshal(initMsg)
 	require.NoError(t, err)
-	contractAddr, err := keeper.Instantiate(ctx, codeID, creator, nil, initMsgBz, "recursive contract", deposit)
+	contractAddr, _, err := keeper.Instantiate(ctx, codeID, creator, nil, initMsgBz, "recursive contract", deposit)
 	require.NoError(t, err)
 
 	return contractAddr, creator, ctx, keeper, cleanup
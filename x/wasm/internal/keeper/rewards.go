@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+// SetContractRewardAddress sets the address the developer share of the fees for executions of the contract is
+// accrued for. The caller must be the contract admin, an empty reward address stops the accrual.
+func (k Keeper) SetContractRewardAddress(ctx sdk.Context, contractAddress, caller, rewardAddr sdk.AccAddress) error {
+	return k.setContractRewardAddress(ctx, contractAddress, caller, rewardAddr, k.authZPolicy)
+}
+
+func (k Keeper) setContractRewardAddress(ctx sdk.Context, contractAddress, caller, rewardAddr sdk.AccAddress, authZ AuthorizationPolicy) error {
+	contractInfo := k.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	if !authZ.CanModifyContract(contractInfo.Admin, caller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
+	}
+	k.storeContractRewardAddress(ctx, contractAddress, rewardAddr)
+	return nil
+}
+
+// GetContractRewardAddress returns the reward address of the contract, nil when none is set
+func (k Keeper) GetContractRewardAddress(ctx sdk.Context, contractAddress sdk.AccAddress) sdk.AccAddress {
+	return ctx.KVStore(k.storeKey).Get(types.GetContractRewardAddressKey(contractAddress))
+}
+
+// GetDeveloperFeeShare returns the share of the fee of contract executions that is accrued for the contracts
+func (k Keeper) GetDeveloperFeeShare(ctx sdk.Context) sdk.Dec {
+	var share *sdk.Dec
+	k.paramSpace.GetIfExists(paramsCtx(ctx), types.ParamStoreKeyDeveloperFeeShare, &share)
+	if share == nil {
+		return sdk.ZeroDec()
+	}
+	return *share
+}
+
+// AccrueContractRewards moves the amount from the fee collector to the rewards pool and adds it to the rewards of the
+// reward address of the contract. It returns false without moving any coins when the contract has no reward address.
+func (k Keeper) AccrueContractRewards(ctx sdk.Context, feeCollector, contractAddress sdk.AccAddress, amount sdk.Coins) (bool, error) {
+	rewardAddr := k.GetContractRewardAddress(ctx, contractAddress)
+	if rewardAddr == nil || amount.IsZero() {
+		return false, nil
+	}
+	if err := k.bankKeeper.SendCoins(ctx, feeCollector, types.RewardsPoolAddress, amount); err != nil {
+		return false, err
+	}
+	k.storeContractRewards(ctx, rewardAddr, k.GetContractRewards(ctx, rewardAddr).Add(amount...))
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeContractRewards,
+		sdk.NewAttribute(types.AttributeKeyContract, contractAddress.String()),
+		sdk.NewAttribute(types.AttributeKeyRewardAddress, rewardAddr.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+	))
+	return true, nil
+}
+
+// GetContractRewards returns the accrued and not yet withdrawn rewards of the reward address
+func (k Keeper) GetContractRewards(ctx sdk.Context, rewardAddr sdk.AccAddress) sdk.Coins {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetContractRewardsKey(rewardAddr))
+	if bz == nil {
+		return sdk.NewCoins()
+	}
+	var amount sdk.Coins
+	k.cdc.MustUnmarshalBinaryBare(bz, &amount)
+	return amount
+}
+
+// WithdrawContractRewards pays out all rewards accrued for the reward address
+func (k Keeper) WithdrawContractRewards(ctx sdk.Context, rewardAddr sdk.AccAddress) (sdk.Coins, error) {
+	amount := k.GetContractRewards(ctx, rewardAddr)
+	if amount.IsZero() {
+		return nil, sdkerrors.Wrap(types.ErrEmpty, "no rewards")
+	}
+	if err := k.bankKeeper.SendCoins(ctx, types.RewardsPoolAddress, rewardAddr, amount); err != nil {
+		return nil, err
+	}
+	k.storeContractRewards(ctx, rewardAddr, nil)
+	return amount, nil
+}
+
+// IterateContractRewards calls cb with the rewards of every reward address. cb returns true to stop early.
+func (k Keeper) IterateContractRewards(ctx sdk.Context, cb func(types.ContractRewards) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.ContractRewardsPrefix).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var amount sdk.Coins
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &amount)
+		if cb(types.ContractRewards{RewardAddress: iter.Key(), Amount: amount}) {
+			break
+		}
+	}
+}
+
+func (k Keeper) storeContractRewardAddress(ctx sdk.Context, contractAddress, rewardAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetContractRewardAddressKey(contractAddress)
+	if len(rewardAddr) == 0 {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, rewardAddr)
+}
+
+func (k Keeper) storeContractRewards(ctx sdk.Context, rewardAddr sdk.AccAddress, amount sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetContractRewardsKey(rewardAddr)
+	if amount.IsZero() {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, k.cdc.MustMarshalBinaryBare(amount))
+}
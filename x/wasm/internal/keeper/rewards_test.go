@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+func TestContractRewards(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keepers := CreateTestInput(t, false, tempDir, SupportedFeatures, nil, nil)
+	accKeeper, keeper := keepers.AccountKeeper, keepers.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, deposit)
+	admin := createFakeFundedAccount(ctx, accKeeper, deposit)
+	feeCollector := createFakeFundedAccount(ctx, accKeeper, deposit)
+	_, _, rewardAddr := keyPubAddr()
+
+	wasmCode, err := ioutil.ReadFile("./testdata/contract.wasm")
+	require.NoError(t, err)
+	codeID, err := keeper.Create(ctx, creator, wasmCode, "", "", nil)
+	require.NoError(t, err)
+	initMsgBz, err := json.Marshal(InitMsg{Verifier: creator, Beneficiary: creator})
+	require.NoError(t, err)
+	contractAddr, _, err := keeper.Instantiate(ctx, codeID, creator, admin, initMsgBz, "demo contract", nil)
+	require.NoError(t, err)
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("denom", 100))
+
+	// nothing accrued without reward address
+	accrued, err := keeper.AccrueContractRewards(ctx, feeCollector, contractAddr, fee)
+	require.NoError(t, err)
+	assert.False(t, accrued)
+
+	err = keeper.SetContractRewardAddress(ctx, contractAddr, creator, rewardAddr)
+	assert.True(t, sdkerrors.ErrUnauthorized.Is(err), err)
+	require.NoError(t, keeper.SetContractRewardAddress(ctx, contractAddr, admin, rewardAddr))
+	assert.Equal(t, rewardAddr, keeper.GetContractRewardAddress(ctx, contractAddr))
+
+	for i := 0; i < 2; i++ {
+		accrued, err = keeper.AccrueContractRewards(ctx, feeCollector, contractAddr, fee)
+		require.NoError(t, err)
+		assert.True(t, accrued)
+	}
+	expRewards := sdk.NewCoins(sdk.NewInt64Coin("denom", 200))
+	assert.Equal(t, expRewards, keeper.GetContractRewards(ctx, rewardAddr))
+	assert.Equal(t, expRewards, accKeeper.GetAccount(ctx, types.RewardsPoolAddress).GetCoins())
+	assert.Equal(t, deposit.Sub(expRewards), accKeeper.GetAccount(ctx, feeCollector).GetCoins())
+
+	withdrawn, err := keeper.WithdrawContractRewards(ctx, rewardAddr)
+	require.NoError(t, err)
+	assert.Equal(t, expRewards, withdrawn)
+	assert.Equal(t, expRewards, accKeeper.GetAccount(ctx, rewardAddr).GetCoins())
+	assert.True(t, keeper.GetContractRewards(ctx, rewardAddr).IsZero())
+	assert.True(t, accKeeper.GetAccount(ctx, types.RewardsPoolAddress).GetCoins().IsZero())
+
+	_, err = keeper.WithdrawContractRewards(ctx, rewardAddr)
+	assert.True(t, types.ErrEmpty.Is(err), err)
+
+	// clearing the reward address stops the accrual
+	require.NoError(t, keeper.SetContractRewardAddress(ctx, contractAddr, admin, nil))
+	assert.Nil(t, keeper.GetContractRewardAddress(ctx, contractAddr))
+	accrued, err = keeper.AccrueContractRewards(ctx, feeCollector, contractAddr, fee)
+	require.NoError(t, err)
+	assert.False(t, accrued)
+}
+
+func TestGetDeveloperFeeShare(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keepers := CreateTestInput(t, false, tempDir, SupportedFeatures, nil, nil)
+	keeper := keepers.WasmKeeper
+
+	assert.True(t, keeper.GetDeveloperFeeShare(ctx).IsZero())
+
+	share := sdk.NewDecWithPrec(2, 1)
+	params := types.DefaultParams()
+	params.DeveloperFeeShare = &share
+	keeper.setParams(ctx, params)
+	assert.Equal(t, share.String(), keeper.GetDeveloperFeeShare(ctx).String())
+}
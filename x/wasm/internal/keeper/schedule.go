@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+// setContractSchedule registers the contract to be executed at end block, as decided by governance.
+// The first execution is Interval blocks after the current one.
+func (k Keeper) setContractSchedule(ctx sdk.Context, schedule types.ContractSchedule) error {
+	if !k.containsContractInfo(ctx, schedule.Contract) {
+		return sdkerrors.Wrap(types.ErrNotFound, "contract")
+	}
+	schedule.NextHeight = uint64(ctx.BlockHeight()) + schedule.Interval
+	if err := schedule.ValidateBasic(); err != nil {
+		return err
+	}
+	k.storeContractSchedule(ctx, schedule)
+	return nil
+}
+
+// deleteContractSchedule removes the schedule of the contract
+func (k Keeper) deleteContractSchedule(ctx sdk.Context, contractAddress sdk.AccAddress) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetContractScheduleKey(contractAddress)
+	if !store.Has(key) {
+		return sdkerrors.Wrap(types.ErrNotFound, "contract schedule")
+	}
+	store.Delete(key)
+	return nil
+}
+
+// GetContractSchedule returns the execution schedule of the contract, nil when it is not scheduled
+func (k Keeper) GetContractSchedule(ctx sdk.Context, contractAddress sdk.AccAddress) *types.ContractSchedule {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetContractScheduleKey(contractAddress))
+	if bz == nil {
+		return nil
+	}
+	var schedule types.ContractSchedule
+	k.cdc.MustUnmarshalBinaryBare(bz, &schedule)
+	return &schedule
+}
+
+// IterateContractSchedules calls cb for every scheduled contract. cb returns true to stop early.
+func (k Keeper) IterateContractSchedules(ctx sdk.Context, cb func(types.ContractSchedule) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.ContractSchedulePrefix).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var schedule types.ContractSchedule
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &schedule)
+		if cb(schedule) {
+			break
+		}
+	}
+}
+
+// ExecuteScheduled runs the scheduled executions that are due at the current height. Every execution runs with its
+// own gas limit and is reverted on failure, without affecting the other executions or the block.
+func (k Keeper) ExecuteScheduled(ctx sdk.Context) {
+	height := uint64(ctx.BlockHeight())
+	var due []types.ContractSchedule
+	k.IterateContractSchedules(ctx, func(schedule types.ContractSchedule) bool {
+		if schedule.NextHeight <= height {
+			due = append(due, schedule)
+		}
+		return false
+	})
+	for _, schedule := range due {
+		gasUsed, err := k.executeScheduled(ctx, schedule)
+		attrs := []sdk.Attribute{
+			sdk.NewAttribute(types.AttributeKeyContract, schedule.Contract.String()),
+			sdk.NewAttribute(types.AttributeKeyGasUsed, fmt.Sprintf("%d", gasUsed)),
+		}
+		if err != nil {
+			attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyError, err.Error()))
+			ctx.Logger().With("module", "x/"+types.ModuleName).Info("scheduled execution failed",
+				"contract", schedule.Contract.String(), "error", err.Error())
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeScheduledExecution, attrs...))
+
+		schedule.NextHeight = height + schedule.Interval
+		k.storeContractSchedule(ctx, schedule)
+	}
+}
+
+func (k Keeper) executeScheduled(ctx sdk.Context, schedule types.ContractSchedule) (gasUsed uint64, err error) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	gasMeter := sdk.NewGasMeter(schedule.GasLimit)
+	cacheCtx = cacheCtx.WithGasMeter(gasMeter).WithEventManager(sdk.NewEventManager())
+
+	defer func() {
+		gasUsed = gasMeter.GasConsumedToLimit()
+		if r := recover(); r != nil {
+			if oog, ok := r.(sdk.ErrorOutOfGas); ok {
+				err = sdkerrors.Wrap(types.ErrGasLimit, oog.Descriptor)
+				return
+			}
+			err = sdkerrors.Wrapf(types.ErrExecuteFailed, "panic: %v", r)
+		}
+	}()
+
+	if _, err = k.Execute(cacheCtx, schedule.Contract, types.SchedulerAddress, schedule.Msg, nil); err != nil {
+		return 0, err
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return 0, nil
+}
+
+func (k Keeper) storeContractSchedule(ctx sdk.Context, schedule types.ContractSchedule) {
+	ctx.KVStore(k.storeKey).Set(types.GetContractScheduleKey(schedule.Contract), k.cdc.MustMarshalBinaryBare(schedule))
+}
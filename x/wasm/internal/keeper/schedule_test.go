@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+func TestExecuteScheduled(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keepers := CreateTestInput(t, false, tempDir, SupportedFeatures, nil, nil)
+	accKeeper, keeper := keepers.AccountKeeper, keepers.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, deposit.Add(deposit...))
+	_, _, bob := keyPubAddr()
+
+	wasmCode, err := ioutil.ReadFile("./testdata/contract.wasm")
+	require.NoError(t, err)
+	codeID, err := keeper.Create(ctx, creator, wasmCode, "", "", nil)
+	require.NoError(t, err)
+	// the scheduler is the verifier, so the scheduled release pays out the beneficiary
+	initMsgBz, err := json.Marshal(InitMsg{Verifier: types.SchedulerAddress, Beneficiary: bob})
+	require.NoError(t, err)
+	contractAddr, _, err := keeper.Instantiate(ctx, codeID, creator, nil, initMsgBz, "demo contract", deposit)
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(10)
+	schedule := types.ContractSchedule{
+		Contract: contractAddr,
+		Msg:      []byte(`{"release":{}}`),
+		Interval: 5,
+		GasLimit: 1000000,
+	}
+
+	t.Run("not due", func(t *testing.T) {
+		trialCtx := ctx.WithMultiStore(ctx.MultiStore().CacheWrap().(sdk.MultiStore)).WithEventManager(sdk.NewEventManager())
+		require.NoError(t, keeper.setContractSchedule(trialCtx, schedule))
+
+		keeper.ExecuteScheduled(trialCtx.WithBlockHeight(14))
+		assert.Nil(t, accKeeper.GetAccount(trialCtx, bob))
+		assert.Empty(t, trialCtx.EventManager().Events())
+	})
+
+	t.Run("due", func(t *testing.T) {
+		trialCtx := ctx.WithMultiStore(ctx.MultiStore().CacheWrap().(sdk.MultiStore)).WithEventManager(sdk.NewEventManager())
+		require.NoError(t, keeper.setContractSchedule(trialCtx, schedule))
+
+		dueCtx := trialCtx.WithBlockHeight(15)
+		keeper.ExecuteScheduled(dueCtx)
+		bobAcct := accKeeper.GetAccount(dueCtx, bob)
+		require.NotNil(t, bobAcct)
+		assert.Equal(t, deposit, bobAcct.GetCoins())
+		assert.Equal(t, uint64(20), keeper.GetContractSchedule(dueCtx, contractAddr).NextHeight)
+
+		events := dueCtx.EventManager().Events()
+		require.NotEmpty(t, events)
+		last := events[len(events)-1]
+		assert.Equal(t, types.EventTypeScheduledExecution, last.Type)
+		for _, attr := range last.Attributes {
+			assert.NotEqual(t, types.AttributeKeyError, string(attr.Key))
+		}
+	})
+
+	t.Run("out of gas", func(t *testing.T) {
+		trialCtx := ctx.WithMultiStore(ctx.MultiStore().CacheWrap().(sdk.MultiStore)).WithEventManager(sdk.NewEventManager())
+		lowGas := schedule
+		lowGas.GasLimit = 1000
+		require.NoError(t, keeper.setContractSchedule(trialCtx, lowGas))
+
+		dueCtx := trialCtx.WithBlockHeight(15)
+		keeper.ExecuteScheduled(dueCtx)
+		// reverted, but rescheduled
+		assert.Nil(t, accKeeper.GetAccount(dueCtx, bob))
+		assert.Equal(t, uint64(20), keeper.GetContractSchedule(dueCtx, contractAddr).NextHeight)
+
+		events := dueCtx.EventManager().Events()
+		require.Len(t, events, 1)
+		assert.Equal(t, types.EventTypeScheduledExecution, events[0].Type)
+		assert.Contains(t, sdk.StringifyEvents(events).String(), types.ErrGasLimit.Error())
+	})
+
+	t.Run("unscheduled", func(t *testing.T) {
+		trialCtx := ctx.WithMultiStore(ctx.MultiStore().CacheWrap().(sdk.MultiStore)).WithEventManager(sdk.NewEventManager())
+		require.NoError(t, keeper.setContractSchedule(trialCtx, schedule))
+		require.NoError(t, keeper.deleteContractSchedule(trialCtx, contractAddr))
+		assert.Nil(t, keeper.GetContractSchedule(trialCtx, contractAddr))
+
+		keeper.ExecuteScheduled(trialCtx.WithBlockHeight(15))
+		assert.Nil(t, accKeeper.GetAccount(trialCtx, bob))
+		assert.True(t, types.ErrNotFound.Is(keeper.deleteContractSchedule(trialCtx, contractAddr)))
+	})
+}
@@ -0,0 +1,186 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	wasm "github.com/CosmWasm/go-cosmwasm"
+	wasmTypes "github.com/CosmWasm/go-cosmwasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+// maxTracedTxs is the number of txs the tracer keeps, the oldest trace is dropped first
+const maxTracedTxs = 1000
+
+// tracer keeps the host function calls of the contracts called by the latest delivered txs. It is node local, the
+// traces are not part of the chain state.
+type tracer struct {
+	mu    sync.Mutex
+	txs   map[string]*tracedTx
+	order []string
+}
+
+type tracedTx struct {
+	height int64
+	calls  []*types.ContractCallTrace
+}
+
+func newTracer() *tracer {
+	return &tracer{txs: make(map[string]*tracedTx)}
+}
+
+// start adds the trace of a contract call to the tx
+func (t *tracer) start(txHash string, height int64, operation string, contractAddr sdk.AccAddress) *types.ContractCallTrace {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	tx, ok := t.txs[txHash]
+	if !ok {
+		tx = &tracedTx{height: height}
+		t.txs[txHash] = tx
+		t.order = append(t.order, txHash)
+		if len(t.order) > maxTracedTxs {
+			delete(t.txs, t.order[0])
+			t.order = t.order[1:]
+		}
+	}
+	call := &types.ContractCallTrace{Contract: contractAddr, Operation: operation}
+	tx.calls = append(tx.calls, call)
+	return call
+}
+
+func (t *tracer) record(call *types.ContractCallTrace, hostCall types.HostCall) {
+	t.mu.Lock()
+	call.HostCalls = append(call.HostCalls, hostCall)
+	t.mu.Unlock()
+}
+
+// get returns a copy of the trace of the tx, false when it is not kept
+func (t *tracer) get(txHash string) (types.TxTrace, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	tx, ok := t.txs[txHash]
+	if !ok {
+		return types.TxTrace{}, false
+	}
+	res := types.TxTrace{TxHash: txHash, Height: tx.height, Calls: make([]types.ContractCallTrace, len(tx.calls))}
+	for i, c := range tx.calls {
+		res.Calls[i] = *c
+		res.Calls[i].HostCalls = append([]types.HostCall{}, c.HostCalls...)
+	}
+	return res, true
+}
+
+// txHash returns the hash of the tx as shown by Tendermint
+func txHash(txBytes []byte) string {
+	return fmt.Sprintf("%X", tmhash.Sum(txBytes))
+}
+
+// GetTxTrace returns the host function calls of the contracts called by the tx, false when the tx was not traced.
+// Only the latest txs delivered while ContractTraceMode is on are kept.
+func (k Keeper) GetTxTrace(txHash string) (types.TxTrace, bool) {
+	if k.tracer == nil {
+		return types.TxTrace{}, false
+	}
+	return k.tracer.get(txHash)
+}
+
+// startContractTrace starts the trace of a VM call, nil when tracing is off or the call is not part of a delivered
+// tx, e.g. of a check tx, a query or a scheduled execution
+func (k Keeper) startContractTrace(ctx sdk.Context, operation string, contractAddr sdk.AccAddress) *types.ContractCallTrace {
+	if k.tracer == nil || ctx.IsCheckTx() || len(ctx.TxBytes()) == 0 {
+		return nil
+	}
+	return k.tracer.start(txHash(ctx.TxBytes()), ctx.BlockHeight(), operation, contractAddr)
+}
+
+// tracedStore returns the store that records the calls of the contract, the store itself when the call is not traced
+func (k Keeper) tracedStore(ctx sdk.Context, trace *types.ContractCallTrace, store wasm.KVStore) wasm.KVStore {
+	if trace == nil {
+		return store
+	}
+	return traceStore{KVStore: store, ctx: ctx, tracer: k.tracer, trace: trace}
+}
+
+// tracedQuerier returns the querier that records the queries of the contract, the querier itself when the call is not
+// traced
+func (k Keeper) tracedQuerier(ctx sdk.Context, trace *types.ContractCallTrace, querier wasmTypes.Querier) wasmTypes.Querier {
+	if trace == nil {
+		return querier
+	}
+	return traceQuerier{Querier: querier, ctx: ctx, tracer: k.tracer, trace: trace}
+}
+
+// traceStore records the store calls of a contract with the gas the context was charged for them
+type traceStore struct {
+	wasm.KVStore
+	ctx    sdk.Context
+	tracer *tracer
+	trace  *types.ContractCallTrace
+}
+
+func (s traceStore) Get(key []byte) []byte {
+	start := s.ctx.GasMeter().GasConsumed()
+	value := s.KVStore.Get(key)
+	s.record(types.HostCall{Function: types.HostFunctionDBRead, Key: key}, start)
+	return value
+}
+
+func (s traceStore) Set(key, value []byte) {
+	start := s.ctx.GasMeter().GasConsumed()
+	s.KVStore.Set(key, value)
+	s.record(types.HostCall{Function: types.HostFunctionDBWrite, Key: key}, start)
+}
+
+func (s traceStore) Delete(key []byte) {
+	start := s.ctx.GasMeter().GasConsumed()
+	s.KVStore.Delete(key)
+	s.record(types.HostCall{Function: types.HostFunctionDBRemove, Key: key}, start)
+}
+
+// Iterator records the start of the scan, the gas of reading the entries is not included
+func (s traceStore) Iterator(start, end []byte) dbm.Iterator {
+	gasStart := s.ctx.GasMeter().GasConsumed()
+	iter := s.KVStore.Iterator(start, end)
+	s.record(types.HostCall{Function: types.HostFunctionDBScan, Key: start, End: end}, gasStart)
+	return iter
+}
+
+// ReverseIterator records the start of the scan, the gas of reading the entries is not included
+func (s traceStore) ReverseIterator(start, end []byte) dbm.Iterator {
+	gasStart := s.ctx.GasMeter().GasConsumed()
+	iter := s.KVStore.ReverseIterator(start, end)
+	s.record(types.HostCall{Function: types.HostFunctionDBScan, Key: start, End: end}, gasStart)
+	return iter
+}
+
+func (s traceStore) record(hostCall types.HostCall, gasStart uint64) {
+	hostCall.Gas = s.ctx.GasMeter().GasConsumed() - gasStart
+	s.tracer.record(s.trace, hostCall)
+}
+
+// traceQuerier records the queries of a contract with the gas the context was charged for them
+type traceQuerier struct {
+	wasmTypes.Querier
+	ctx    sdk.Context
+	tracer *tracer
+	trace  *types.ContractCallTrace
+}
+
+func (q traceQuerier) Query(request wasmTypes.QueryRequest, gasLimit uint64) ([]byte, error) {
+	hostCall := types.HostCall{Function: types.HostFunctionQueryChain}
+	if bz, err := json.Marshal(request); err == nil {
+		hostCall.Request = bz
+	}
+	start := q.ctx.GasMeter().GasConsumed()
+	// record the charged gas even on panic
+	defer func() {
+		hostCall.Gas = q.ctx.GasMeter().GasConsumed() - start
+		q.tracer.record(q.trace, hostCall)
+	}()
+	return q.Querier.Query(request, gasLimit)
+}
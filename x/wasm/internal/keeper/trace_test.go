@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+func TestTxTrace(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keepers := CreateTestInput(t, false, tempDir, SupportedFeatures, nil, nil)
+	accKeeper, keeper := keepers.AccountKeeper, keepers.WasmKeeper
+	keeper.tracer = newTracer()
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, deposit)
+	_, _, bob := keyPubAddr()
+	wasmCode, err := ioutil.ReadFile("./testdata/contract.wasm")
+	require.NoError(t, err)
+	codeID, err := keeper.Create(ctx, creator, wasmCode, "", "", nil)
+	require.NoError(t, err)
+
+	initMsgBz, err := json.Marshal(InitMsg{Verifier: creator, Beneficiary: bob})
+	require.NoError(t, err)
+	initTx := []byte("instantiate tx")
+	contractAddr, _, err := keeper.Instantiate(ctx.WithTxBytes(initTx), codeID, creator, nil, initMsgBz, "demo contract", deposit)
+	require.NoError(t, err)
+
+	trace, ok := keeper.GetTxTrace(txHash(initTx))
+	require.True(t, ok)
+	assert.Equal(t, ctx.BlockHeight(), trace.Height)
+	require.Len(t, trace.Calls, 1)
+	assert.Equal(t, contractAddr, trace.Calls[0].Contract)
+	assert.Equal(t, types.GasReportOperationInstantiate, trace.Calls[0].Operation)
+	var write *types.HostCall
+	for i, c := range trace.Calls[0].HostCalls {
+		if c.Function == types.HostFunctionDBWrite && string(c.Key) == "config" {
+			write = &trace.Calls[0].HostCalls[i]
+		}
+	}
+	require.NotNil(t, write, "got %+v", trace.Calls[0].HostCalls)
+	assert.NotZero(t, write.Gas)
+
+	// the release queries the contract balance
+	releaseTx := []byte("release tx")
+	_, err = keeper.Execute(ctx.WithTxBytes(releaseTx), contractAddr, creator, []byte(`{"release":{}}`), nil)
+	require.NoError(t, err)
+	trace, ok = keeper.GetTxTrace(txHash(releaseTx))
+	require.True(t, ok)
+	require.Len(t, trace.Calls, 1)
+	var functions []string
+	for _, c := range trace.Calls[0].HostCalls {
+		functions = append(functions, c.Function)
+	}
+	assert.Contains(t, functions, types.HostFunctionDBRead)
+	assert.Contains(t, functions, types.HostFunctionQueryChain)
+
+	// calls outside of delivered txs are not traced
+	_, err = keeper.QuerySmart(ctx, contractAddr, []byte(`{"verifier":{}}`))
+	require.NoError(t, err)
+	checkTx := []byte("check tx")
+	_, err = keeper.QuerySmart(ctx.WithTxBytes(checkTx).WithIsCheckTx(true), contractAddr, []byte(`{"verifier":{}}`))
+	require.NoError(t, err)
+	_, ok = keeper.GetTxTrace(txHash(checkTx))
+	assert.False(t, ok)
+}
+
+func TestTracerKeepsLatestTxs(t *testing.T) {
+	tr := newTracer()
+	_, _, contractAddr := keyPubAddr()
+	for i := 0; i <= maxTracedTxs; i++ {
+		tr.start(fmt.Sprintf("%d", i), 1, types.GasReportOperationExecute, contractAddr)
+	}
+	_, ok := tr.get("0")
+	assert.False(t, ok)
+	trace, ok := tr.get(fmt.Sprintf("%d", maxTracedTxs))
+	require.True(t, ok)
+	assert.Len(t, trace.Calls, 1)
+}
@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+// wasm binary format, see https://webassembly.github.io/spec/core/binary/modules.html
+var wasmIdent = []byte("\x00asm")
+
+const (
+	wasmExportSectionID = 7
+	// interfaceVersionPrefix is the prefix of the export that marks the interface version of a contract
+	interfaceVersionPrefix = "cosmwasm_vm_version_"
+)
+
+// RegisterVM adds the VM for the contracts of the given interface version. It must be called when the app is set up,
+// before any code of that version is stored.
+func (k Keeper) RegisterVM(interfaceVersion string, vm types.WasmerEngine) {
+	if _, exists := k.vms[interfaceVersion]; exists {
+		panic("duplicate VM for interface version " + interfaceVersion)
+	}
+	k.vms[interfaceVersion] = vm
+}
+
+// IsCompatible returns true when the node has a VM for the interface version of the code
+func (k Keeper) IsCompatible(codeInfo types.CodeInfo) bool {
+	_, ok := k.vms[codeInfo.GetInterfaceVersion()]
+	return ok
+}
+
+// vmFor returns the VM of the interface version
+func (k Keeper) vmFor(interfaceVersion string) (types.WasmerEngine, error) {
+	vm, ok := k.vms[interfaceVersion]
+	if !ok {
+		return nil, sdkerrors.Wrap(types.ErrUnsupportedVersion, interfaceVersion)
+	}
+	return vm, nil
+}
+
+// interfaceVersion returns the name of the interface version export of the uncompressed wasm code
+func interfaceVersion(wasmCode []byte) (string, error) {
+	if len(wasmCode) < 8 || !bytes.Equal(wasmIdent, wasmCode[0:4]) {
+		return "", sdkerrors.Wrap(types.ErrInvalid, "not a wasm binary")
+	}
+	pos := 8 // magic and binary format version
+	for pos < len(wasmCode) {
+		sectionID := wasmCode[pos]
+		size, n := binary.Uvarint(wasmCode[pos+1:])
+		if n <= 0 || size > uint64(len(wasmCode)-pos-1-n) {
+			return "", sdkerrors.Wrap(types.ErrInvalid, "wasm section")
+		}
+		start := pos + 1 + n
+		end := start + int(size)
+		if sectionID == wasmExportSectionID {
+			return exportedInterfaceVersion(wasmCode[start:end])
+		}
+		pos = end
+	}
+	return "", sdkerrors.Wrap(types.ErrUnsupportedVersion, "no interface version export")
+}
+
+// exportedInterfaceVersion returns the interface version from the wasm export section
+func exportedInterfaceVersion(section []byte) (string, error) {
+	count, pos := binary.Uvarint(section)
+	if pos <= 0 {
+		return "", sdkerrors.Wrap(types.ErrInvalid, "wasm export section")
+	}
+	for i := uint64(0); i < count; i++ {
+		nameLen, n := binary.Uvarint(section[pos:])
+		if n <= 0 || nameLen > uint64(len(section)-pos-n) {
+			return "", sdkerrors.Wrap(types.ErrInvalid, "wasm export name")
+		}
+		pos += n
+		name := string(section[pos : pos+int(nameLen)])
+		pos += int(nameLen)
+		// export kind and index
+		if pos >= len(section) {
+			return "", sdkerrors.Wrap(types.ErrInvalid, "wasm export kind")
+		}
+		pos++
+		if _, n = binary.Uvarint(section[pos:]); n <= 0 {
+			return "", sdkerrors.Wrap(types.ErrInvalid, "wasm export index")
+		}
+		pos += n
+		if strings.HasPrefix(name, interfaceVersionPrefix) {
+			return name, nil
+		}
+	}
+	return "", sdkerrors.Wrap(types.ErrUnsupportedVersion, "no interface version export")
+}
@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestInterfaceVersion(t *testing.T) {
+	wasmCode, err := ioutil.ReadFile("./testdata/contract.wasm")
+	require.NoError(t, err)
+
+	header := []byte("\x00asm\x01\x00\x00\x00")
+	// export section with a function "handle" and a global "cosmwasm_vm_version_4"
+	exports := append(append([]byte{}, header...), 0x07, 0x22, 0x02,
+		0x06, 'h', 'a', 'n', 'd', 'l', 'e', 0x00, 0x01)
+	exports = append(append(exports, 0x15), []byte("cosmwasm_vm_version_4")...)
+	exports = append(exports, 0x03, 0x00)
+
+	specs := map[string]struct {
+		src        []byte
+		expVersion string
+		expErr     *sdkerrors.Error
+	}{
+		"contract": {
+			src:        wasmCode,
+			expVersion: types.InterfaceVersion3,
+		},
+		"other version": {
+			src:        exports,
+			expVersion: "cosmwasm_vm_version_4",
+		},
+		"no exports": {
+			src:    header,
+			expErr: types.ErrUnsupportedVersion,
+		},
+		"no version export": {
+			src:    append(append([]byte{}, header...), 0x07, 0x0a, 0x01, 0x06, 'h', 'a', 'n', 'd', 'l', 'e', 0x00, 0x01),
+			expErr: types.ErrUnsupportedVersion,
+		},
+		"section exceeds code": {
+			src:    append(append([]byte{}, header...), 0x07, 0x7f, 0x01),
+			expErr: types.ErrInvalid,
+		},
+		"export exceeds section": {
+			src:    append(append([]byte{}, header...), 0x07, 0x03, 0x01, 0x06, 'h'),
+			expErr: types.ErrInvalid,
+		},
+		"not wasm": {
+			src:    []byte("not a wasm binary"),
+			expErr: types.ErrInvalid,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			version, err := interfaceVersion(spec.src)
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(err), "got %+v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.expVersion, version)
+		})
+	}
+}
+
+func TestIncompatibleCodes(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keepers := CreateTestInput(t, false, tempDir, SupportedFeatures, nil, nil)
+	accKeeper, keeper := keepers.AccountKeeper, keepers.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/contract.wasm")
+	require.NoError(t, err)
+	codeID, err := keeper.Create(ctx, creator, wasmCode, "", "", nil)
+	require.NoError(t, err)
+	assert.Equal(t, types.InterfaceVersion3, keeper.GetCodeInfo(ctx, codeID).InterfaceVersion)
+
+	// a code stored by a VM the node no longer has
+	const unsupported = "cosmwasm_vm_version_2"
+	oldCodeID, err := keeper.Create(ctx, creator, wasmCode, "", "", nil)
+	require.NoError(t, err)
+	codeInfo := keeper.GetCodeInfo(ctx, oldCodeID)
+	codeInfo.InterfaceVersion = unsupported
+	ctx.KVStore(keeper.storeKey).Set(types.GetCodeKey(oldCodeID), keeper.cdc.MustMarshalBinaryBare(codeInfo))
+
+	_, _, bob := keyPubAddr()
+	initMsgBz, err := json.Marshal(InitMsg{Verifier: creator, Beneficiary: bob})
+	require.NoError(t, err)
+	_, _, err = keeper.Instantiate(ctx, oldCodeID, creator, nil, initMsgBz, "demo contract", nil)
+	assert.True(t, types.ErrUnsupportedVersion.Is(err), "got %+v", err)
+
+	q := NewQuerier(keeper)
+	res, err := q(ctx, []string{QueryIncompatibleCodes}, abci.RequestQuery{})
+	require.NoError(t, err)
+	var codes []ListCodeResponse
+	require.NoError(t, json.Unmarshal(res, &codes))
+	require.Len(t, codes, 1)
+	assert.Equal(t, oldCodeID, codes[0].ID)
+	assert.Equal(t, unsupported, codes[0].InterfaceVersion)
+
+	// when a VM for the version is registered
+	keeper.RegisterVM(unsupported, keeper.vms[types.InterfaceVersion3])
+	_, _, err = keeper.Instantiate(ctx, oldCodeID, creator, nil, initMsgBz, "demo contract", nil)
+	require.NoError(t, err)
+
+	res, err = q(ctx, []string{QueryIncompatibleCodes}, abci.RequestQuery{})
+	require.NoError(t, err)
+	assert.Equal(t, "null", string(res))
+}
@@ -0,0 +1,108 @@
+package types
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ContractExecutionAuthorization allows a grantee to execute a single contract on behalf of the granter
+type ContractExecutionAuthorization struct {
+	Contract sdk.AccAddress `json:"contract" yaml:"contract"`
+	// Messages are the allowed top level keys of the execute msg, e.g. "transfer" for `{"transfer":{...}}`.
+	// Empty allows all messages.
+	Messages []string `json:"messages,omitempty" yaml:"messages"`
+	// MaxFunds is the total amount the grantee can send to the contract from the granter account.
+	// It is reduced by every execution, empty allows no funds.
+	MaxFunds sdk.Coins `json:"max_funds,omitempty" yaml:"max_funds"`
+}
+
+func (a ContractExecutionAuthorization) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(a.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	seen := make(map[string]bool, len(a.Messages))
+	for _, m := range a.Messages {
+		if m == "" {
+			return sdkerrors.Wrap(ErrEmpty, "message key")
+		}
+		if seen[m] {
+			return sdkerrors.Wrapf(ErrDuplicate, "message key %q", m)
+		}
+		seen[m] = true
+	}
+	if !a.MaxFunds.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "max funds")
+	}
+	return nil
+}
+
+// Accept checks the execution against the authorization and returns the authorization with the funds of the
+// execution deducted from MaxFunds
+func (a ContractExecutionAuthorization) Accept(msg MsgExecuteContract) (ContractExecutionAuthorization, error) {
+	if !a.Contract.Equals(msg.Contract) {
+		return a, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "contract not granted")
+	}
+	if len(a.Messages) != 0 {
+		key, err := executeMsgKey(msg.Msg)
+		if err != nil {
+			return a, err
+		}
+		if !containsKey(a.Messages, key) {
+			return a, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "message %q not granted", key)
+		}
+	}
+	if !msg.SentFunds.IsZero() {
+		remaining, hasNeg := a.MaxFunds.SafeSub(msg.SentFunds)
+		if hasNeg {
+			return a, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "funds exceed the granted %s", a.MaxFunds)
+		}
+		a.MaxFunds = remaining
+	}
+	return a, nil
+}
+
+// executeMsgKey returns the single top level key of a contract execute msg
+func executeMsgKey(msg json.RawMessage) (string, error) {
+	var keys map[string]json.RawMessage
+	if err := json.Unmarshal(msg, &keys); err != nil {
+		return "", sdkerrors.Wrap(ErrInvalid, "msg must be a json object")
+	}
+	if len(keys) != 1 {
+		return "", sdkerrors.Wrap(ErrInvalid, "msg must have exactly one top level key")
+	}
+	for k := range keys {
+		return k, nil
+	}
+	return "", nil
+}
+
+func containsKey(keys []string, key string) bool {
+	for _, k := range keys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+// ContractExecutionGrant is a ContractExecutionAuthorization of a granter to a grantee
+type ContractExecutionGrant struct {
+	Granter       sdk.AccAddress                 `json:"granter" yaml:"granter"`
+	Grantee       sdk.AccAddress                 `json:"grantee" yaml:"grantee"`
+	Authorization ContractExecutionAuthorization `json:"authorization" yaml:"authorization"`
+}
+
+func (g ContractExecutionGrant) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(g.Granter); err != nil {
+		return sdkerrors.Wrap(err, "granter")
+	}
+	if err := sdk.VerifyAddressFormat(g.Grantee); err != nil {
+		return sdkerrors.Wrap(err, "grantee")
+	}
+	if g.Granter.Equals(g.Grantee) {
+		return sdkerrors.Wrap(ErrInvalid, "granter and grantee must differ")
+	}
+	return sdkerrors.Wrap(g.Authorization.ValidateBasic(), "authorization")
+}
@@ -0,0 +1,147 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContractExecutionAuthorizationAccept(t *testing.T) {
+	contract := sdk.AccAddress(bytes.Repeat([]byte{1}, sdk.AddrLen))
+	otherContract := sdk.AccAddress(bytes.Repeat([]byte{2}, sdk.AddrLen))
+	anyAuthorization := ContractExecutionAuthorization{
+		Contract: contract,
+		Messages: []string{"transfer", "burn"},
+		MaxFunds: sdk.NewCoins(sdk.NewInt64Coin("denom", 10), sdk.NewInt64Coin("other", 1)),
+	}
+
+	specs := map[string]struct {
+		src         ContractExecutionAuthorization
+		msg         string
+		funds       sdk.Coins
+		contract    sdk.AccAddress
+		expMaxFunds sdk.Coins
+		expErr      bool
+	}{
+		"granted message without funds": {
+			src:         anyAuthorization,
+			msg:         `{"burn":{"amount":"1"}}`,
+			expMaxFunds: anyAuthorization.MaxFunds,
+		},
+		"granted message with funds": {
+			src:         anyAuthorization,
+			msg:         `{"transfer":{}}`,
+			funds:       sdk.NewCoins(sdk.NewInt64Coin("denom", 10)),
+			expMaxFunds: sdk.NewCoins(sdk.NewInt64Coin("other", 1)),
+		},
+		"all messages granted": {
+			src:         ContractExecutionAuthorization{Contract: contract},
+			msg:         `"not an object"`,
+			expMaxFunds: nil,
+		},
+		"message not granted": {
+			src:    anyAuthorization,
+			msg:    `{"mint":{}}`,
+			expErr: true,
+		},
+		"several message keys": {
+			src:    anyAuthorization,
+			msg:    `{"burn":{},"transfer":{}}`,
+			expErr: true,
+		},
+		"funds exceed max funds": {
+			src:    anyAuthorization,
+			msg:    `{"transfer":{}}`,
+			funds:  sdk.NewCoins(sdk.NewInt64Coin("denom", 11)),
+			expErr: true,
+		},
+		"funds of other denom": {
+			src:    anyAuthorization,
+			msg:    `{"transfer":{}}`,
+			funds:  sdk.NewCoins(sdk.NewInt64Coin("third", 1)),
+			expErr: true,
+		},
+		"funds without max funds": {
+			src:    ContractExecutionAuthorization{Contract: contract},
+			msg:    `{"transfer":{}}`,
+			funds:  sdk.NewCoins(sdk.NewInt64Coin("denom", 1)),
+			expErr: true,
+		},
+		"other contract": {
+			src:      anyAuthorization,
+			msg:      `{"burn":{}}`,
+			contract: otherContract,
+			expErr:   true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			execContract := contract
+			if spec.contract != nil {
+				execContract = spec.contract
+			}
+			got, err := spec.src.Accept(MsgExecuteContract{Contract: execContract, Msg: []byte(spec.msg), SentFunds: spec.funds})
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.expMaxFunds, got.MaxFunds)
+			assert.Equal(t, spec.src.Messages, got.Messages)
+		})
+	}
+}
+
+func TestContractExecutionGrantValidateBasic(t *testing.T) {
+	granter := sdk.AccAddress(bytes.Repeat([]byte{1}, sdk.AddrLen))
+	grantee := sdk.AccAddress(bytes.Repeat([]byte{2}, sdk.AddrLen))
+	contract := sdk.AccAddress(bytes.Repeat([]byte{3}, sdk.AddrLen))
+
+	specs := map[string]struct {
+		src    ContractExecutionGrant
+		expErr bool
+	}{
+		"all good": {
+			src: ContractExecutionGrant{Granter: granter, Grantee: grantee, Authorization: ContractExecutionAuthorization{
+				Contract: contract, Messages: []string{"transfer"}, MaxFunds: sdk.NewCoins(sdk.NewInt64Coin("denom", 1)),
+			}},
+		},
+		"granter is grantee": {
+			src:    ContractExecutionGrant{Granter: granter, Grantee: granter, Authorization: ContractExecutionAuthorization{Contract: contract}},
+			expErr: true,
+		},
+		"grantee missing": {
+			src:    ContractExecutionGrant{Granter: granter, Authorization: ContractExecutionAuthorization{Contract: contract}},
+			expErr: true,
+		},
+		"contract missing": {
+			src:    ContractExecutionGrant{Granter: granter, Grantee: grantee},
+			expErr: true,
+		},
+		"empty message key": {
+			src:    ContractExecutionGrant{Granter: granter, Grantee: grantee, Authorization: ContractExecutionAuthorization{Contract: contract, Messages: []string{""}}},
+			expErr: true,
+		},
+		"duplicate message key": {
+			src:    ContractExecutionGrant{Granter: granter, Grantee: grantee, Authorization: ContractExecutionAuthorization{Contract: contract, Messages: []string{"a", "a"}}},
+			expErr: true,
+		},
+		"invalid max funds": {
+			src:    ContractExecutionGrant{Granter: granter, Grantee: grantee, Authorization: ContractExecutionAuthorization{Contract: contract, MaxFunds: sdk.Coins{sdk.Coin{Denom: "denom", Amount: sdk.NewInt(-1)}}}},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -12,12 +12,27 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgMigrateContract{}, "wasm/MsgMigrateContract", nil)
 	cdc.RegisterConcrete(MsgUpdateAdmin{}, "wasm/MsgUpdateAdmin", nil)
 	cdc.RegisterConcrete(MsgClearAdmin{}, "wasm/MsgClearAdmin", nil)
+	cdc.RegisterConcrete(MsgPauseExecution{}, "wasm/MsgPauseExecution", nil)
+	cdc.RegisterConcrete(MsgResumeExecution{}, "wasm/MsgResumeExecution", nil)
+	cdc.RegisterConcrete(MsgGrantContractExecution{}, "wasm/MsgGrantContractExecution", nil)
+	cdc.RegisterConcrete(MsgRevokeContractExecution{}, "wasm/MsgRevokeContractExecution", nil)
+	cdc.RegisterConcrete(MsgExec{}, "wasm/MsgExec", nil)
+	cdc.RegisterConcrete(MsgSetContractMetadata{}, "wasm/MsgSetContractMetadata", nil)
+	cdc.RegisterConcrete(MsgSetContractRewardAddress{}, "wasm/MsgSetContractRewardAddress", nil)
+	cdc.RegisterConcrete(MsgWithdrawContractRewards{}, "wasm/MsgWithdrawContractRewards", nil)
+	cdc.RegisterConcrete(MsgSetContractLimits{}, "wasm/MsgSetContractLimits", nil)
 
 	cdc.RegisterConcrete(StoreCodeProposal{}, "wasm/StoreCodeProposal", nil)
 	cdc.RegisterConcrete(InstantiateContractProposal{}, "wasm/InstantiateContractProposal", nil)
 	cdc.RegisterConcrete(MigrateContractProposal{}, "wasm/MigrateContractProposal", nil)
 	cdc.RegisterConcrete(UpdateAdminProposal{}, "wasm/UpdateAdminProposal", nil)
 	cdc.RegisterConcrete(ClearAdminProposal{}, "wasm/ClearAdminProposal", nil)
+	cdc.RegisterConcrete(PauseExecutionProposal{}, "wasm/PauseExecutionProposal", nil)
+	cdc.RegisterConcrete(ResumeExecutionProposal{}, "wasm/ResumeExecutionProposal", nil)
+	cdc.RegisterConcrete(SetContractLimitsProposal{}, "wasm/SetContractLimitsProposal", nil)
+	cdc.RegisterConcrete(ScheduleExecutionProposal{}, "wasm/ScheduleExecutionProposal", nil)
+	cdc.RegisterConcrete(UnscheduleExecutionProposal{}, "wasm/UnscheduleExecutionProposal", nil)
+	cdc.RegisterConcrete(SudoContractProposal{}, "wasm/SudoContractProposal", nil)
 }
 
 // ModuleCdc generic sealed codec to be used throughout module
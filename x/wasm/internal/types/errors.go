@@ -49,4 +49,10 @@ var (
 
 	// ErrDuplicate error for content that exsists
 	ErrDuplicate = sdkErrors.Register(DefaultCodespace, 14, "duplicate")
+
+	// ErrPaused error for executing a contract that was paused by the circuit breaker
+	ErrPaused = sdkErrors.Register(DefaultCodespace, 15, "contract execution paused")
+
+	// ErrUnsupportedVersion error for code with an interface version that no VM of the node supports
+	ErrUnsupportedVersion = sdkErrors.Register(DefaultCodespace, 16, "unsupported interface version")
 )
@@ -0,0 +1,101 @@
+package types
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EventTypeGasReport is emitted for every contract call with the breakdown of the sdk gas it consumed
+const EventTypeGasReport = "wasm_gas"
+
+const (
+	AttributeKeyOperation   = "operation"
+	AttributeKeyVMGas       = "vm_gas"
+	AttributeKeyStorageGas  = "storage_gas"
+	AttributeKeyDispatchGas = "dispatch_gas"
+	AttributeKeyTotalGas    = "total_gas"
+)
+
+// operations of a GasReport
+const (
+	GasReportOperationInstantiate = "instantiate"
+	GasReportOperationExecute     = "execute"
+	GasReportOperationMigrate     = "migrate"
+)
+
+// GasReport is the sdk gas consumed by a single instantiate, execute or migrate call of a contract
+type GasReport struct {
+	Contract  sdk.AccAddress `json:"contract_address"`
+	Operation string         `json:"operation"`
+	// VM is the gas of the wasm execution
+	VM uint64 `json:"vm_gas"`
+	// Storage is the gas of the store reads, writes and queries of the contract during execution
+	Storage uint64 `json:"storage_gas"`
+	// Dispatch is the gas of the messages returned by the contract, including calls of other contracts
+	Dispatch uint64 `json:"dispatch_gas"`
+	// Total includes the fixed costs of loading the contract and transferring funds
+	Total uint64 `json:"total_gas"`
+}
+
+// Event returns the EventTypeGasReport event for the report
+func (r GasReport) Event() sdk.Event {
+	return sdk.NewEvent(
+		EventTypeGasReport,
+		sdk.NewAttribute(AttributeKeyContractAddr, r.Contract.String()),
+		sdk.NewAttribute(AttributeKeyOperation, r.Operation),
+		sdk.NewAttribute(AttributeKeyVMGas, strconv.FormatUint(r.VM, 10)),
+		sdk.NewAttribute(AttributeKeyStorageGas, strconv.FormatUint(r.Storage, 10)),
+		sdk.NewAttribute(AttributeKeyDispatchGas, strconv.FormatUint(r.Dispatch, 10)),
+		sdk.NewAttribute(AttributeKeyTotalGas, strconv.FormatUint(r.Total, 10)),
+	)
+}
+
+// ParseGasReports returns the gas reports contained in the events of a tx log. The string events of a log
+// merge all events of the same type, so the attributes are read in the order they were emitted.
+func ParseGasReports(events sdk.StringEvents) ([]GasReport, error) {
+	var res []GasReport
+	for _, e := range events {
+		if e.Type != EventTypeGasReport {
+			continue
+		}
+		var r *GasReport
+		for _, a := range e.Attributes {
+			if a.Key == AttributeKeyContractAddr {
+				addr, err := sdk.AccAddressFromBech32(a.Value)
+				if err != nil {
+					return nil, err
+				}
+				res = append(res, GasReport{Contract: addr})
+				r = &res[len(res)-1]
+				continue
+			}
+			if r == nil {
+				continue
+			}
+			if a.Key == AttributeKeyOperation {
+				r.Operation = a.Value
+				continue
+			}
+			var dst *uint64
+			switch a.Key {
+			case AttributeKeyVMGas:
+				dst = &r.VM
+			case AttributeKeyStorageGas:
+				dst = &r.Storage
+			case AttributeKeyDispatchGas:
+				dst = &r.Dispatch
+			case AttributeKeyTotalGas:
+				dst = &r.Total
+			default:
+				continue
+			}
+			v, err := strconv.ParseUint(a.Value, 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			*dst = v
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseGasReports(t *testing.T) {
+	contract := sdk.AccAddress(bytes.Repeat([]byte{1}, sdk.AddrLen))
+	otherContract := sdk.AccAddress(bytes.Repeat([]byte{2}, sdk.AddrLen))
+	execReport := GasReport{Contract: contract, Operation: GasReportOperationExecute, VM: 1, Storage: 2, Dispatch: 3, Total: 7}
+	nestedReport := GasReport{Contract: otherContract, Operation: GasReportOperationExecute, VM: 10, Storage: 20, Total: 30}
+
+	specs := map[string]struct {
+		src    sdk.Events
+		exp    []GasReport
+		expErr bool
+	}{
+		"single report": {
+			src: sdk.Events{execReport.Event()},
+			exp: []GasReport{execReport},
+		},
+		"merged reports in emit order": {
+			src: sdk.Events{
+				sdk.NewEvent(CustomEventType, sdk.NewAttribute(AttributeKeyContractAddr, contract.String())),
+				nestedReport.Event(),
+				execReport.Event(),
+			},
+			exp: []GasReport{nestedReport, execReport},
+		},
+		"no report": {
+			src: sdk.Events{sdk.NewEvent(CustomEventType, sdk.NewAttribute(AttributeKeyContractAddr, contract.String()))},
+		},
+		"invalid gas value": {
+			src: sdk.Events{sdk.NewEvent(EventTypeGasReport,
+				sdk.NewAttribute(AttributeKeyContractAddr, contract.String()),
+				sdk.NewAttribute(AttributeKeyVMGas, "-1"),
+			)},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, err := ParseGasReports(sdk.StringifyEvents(spec.src.ToABCIEvents()))
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
@@ -2,6 +2,8 @@ package types
 
 import "C"
 import (
+	"bytes"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -24,6 +26,15 @@ type GenesisState struct {
 	Codes     []Code     `json:"codes,omitempty"`
 	Contracts []Contract `json:"contracts,omitempty"`
 	Sequences []Sequence `json:"sequences,omitempty"`
+	// PausedContracts and PausedCodes hold the circuit breaker state
+	PausedContracts []sdk.AccAddress `json:"paused_contracts,omitempty"`
+	PausedCodes     []uint64         `json:"paused_codes,omitempty"`
+	// ContractExecutionGrants allow grantees to execute contracts on behalf of the granters
+	ContractExecutionGrants []ContractExecutionGrant `json:"contract_execution_grants,omitempty"`
+	// ContractRewards are accrued and not yet withdrawn, they are held by the RewardsPoolAddress
+	ContractRewards []ContractRewards `json:"contract_rewards,omitempty"`
+	// ContractSchedules execute contracts at end block, as registered by governance
+	ContractSchedules []ContractSchedule `json:"contract_schedules,omitempty"`
 }
 
 func (s GenesisState) ValidateBasic() error {
@@ -45,6 +56,43 @@ func (s GenesisState) ValidateBasic() error {
 			return sdkerrors.Wrapf(err, "sequence: %d", i)
 		}
 	}
+	for i := range s.PausedContracts {
+		if err := sdk.VerifyAddressFormat(s.PausedContracts[i]); err != nil {
+			return sdkerrors.Wrapf(err, "paused contract: %d", i)
+		}
+	}
+	for i := range s.PausedCodes {
+		if s.PausedCodes[i] == 0 {
+			return sdkerrors.Wrapf(ErrEmpty, "paused code: %d", i)
+		}
+	}
+	for i := range s.ContractExecutionGrants {
+		if err := s.ContractExecutionGrants[i].ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "contract execution grant: %d", i)
+		}
+	}
+	seen := make(map[string]struct{}, len(s.ContractRewards))
+	for i := range s.ContractRewards {
+		if err := s.ContractRewards[i].ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "contract rewards: %d", i)
+		}
+		addr := string(s.ContractRewards[i].RewardAddress)
+		if _, exists := seen[addr]; exists {
+			return sdkerrors.Wrapf(ErrDuplicate, "contract rewards: %d", i)
+		}
+		seen[addr] = struct{}{}
+	}
+	scheduled := make(map[string]struct{}, len(s.ContractSchedules))
+	for i := range s.ContractSchedules {
+		if err := s.ContractSchedules[i].ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "contract schedule: %d", i)
+		}
+		addr := string(s.ContractSchedules[i].Contract)
+		if _, exists := scheduled[addr]; exists {
+			return sdkerrors.Wrapf(ErrDuplicate, "contract schedule: %d", i)
+		}
+		scheduled[addr] = struct{}{}
+	}
 	return nil
 }
 
@@ -73,6 +121,14 @@ type Contract struct {
 	ContractAddress sdk.AccAddress `json:"contract_address"`
 	ContractInfo    ContractInfo   `json:"contract_info"`
 	ContractState   []Model        `json:"contract_state"`
+	// Metadata is set by the contract admin, nil when there is none
+	Metadata *ContractMetadata `json:"metadata,omitempty"`
+	// RewardAddress is set by the contract admin to accrue the developer share of the fees, optional
+	RewardAddress sdk.AccAddress `json:"reward_address,omitempty"`
+	// Limits are set by governance and the contract admin, nil when there are none
+	Limits *ContractLimitsInfo `json:"limits,omitempty"`
+	// Provenance is the creation of the contract, nil for contracts instantiated before it was recorded
+	Provenance *ContractProvenance `json:"provenance,omitempty"`
 }
 
 func (c Contract) ValidateBasic() error {
@@ -91,6 +147,32 @@ func (c Contract) ValidateBasic() error {
 			return sdkerrors.Wrapf(err, "contract state %d", i)
 		}
 	}
+	if len(c.RewardAddress) != 0 {
+		if err := sdk.VerifyAddressFormat(c.RewardAddress); err != nil {
+			return sdkerrors.Wrap(err, "reward address")
+		}
+	}
+	if c.Metadata != nil {
+		if c.Metadata.IsEmpty() {
+			return sdkerrors.Wrap(ErrEmpty, "metadata")
+		}
+		if err := c.Metadata.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "metadata")
+		}
+	}
+	if c.Provenance != nil {
+		if err := c.Provenance.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "provenance")
+		}
+	}
+	if c.Limits != nil {
+		if c.Limits.IsEmpty() {
+			return sdkerrors.Wrap(ErrEmpty, "limits")
+		}
+		if err := c.Limits.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "limits")
+		}
+	}
 	return nil
 }
 
@@ -99,3 +181,97 @@ func (c Contract) ValidateBasic() error {
 func ValidateGenesis(data GenesisState) error {
 	return data.ValidateBasic()
 }
+
+// ContractDump is a single contract with its code, as written by `fetchd wasm dump`.
+// It is imported into the genesis of another chain with GenesisState.ImportContract.
+type ContractDump struct {
+	Code     Code     `json:"code"`
+	Contract Contract `json:"contract"`
+	// InstanceID is the instance id the contract address was generated with, or an upper bound of it for a contract
+	// that was imported itself
+	InstanceID uint64 `json:"instance_id"`
+}
+
+func (d ContractDump) ValidateBasic() error {
+	if err := d.Code.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "code")
+	}
+	if err := d.Contract.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if d.Contract.ContractInfo.CodeID != d.Code.CodeID {
+		return sdkerrors.Wrap(ErrInvalid, "contract code id does not match the code")
+	}
+	if d.InstanceID == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "instance id")
+	}
+	return nil
+}
+
+// ImportContract adds the dumped contract to the genesis state. The code is reused when a code with the same code hash
+// exists already, otherwise it is added with the next free code id. The sequences are raised so that the chain does
+// not hand out the ids again, the instance id past the one of the dump so that the chain does not generate the
+// contract address again.
+func (s *GenesisState) ImportContract(dump ContractDump) error {
+	if err := dump.ValidateBasic(); err != nil {
+		return err
+	}
+	for _, c := range s.Contracts {
+		if c.ContractAddress.Equals(dump.Contract.ContractAddress) {
+			return sdkerrors.Wrapf(ErrDuplicate, "contract: %s", dump.Contract.ContractAddress)
+		}
+	}
+
+	var codeID, maxCodeID uint64
+	for _, c := range s.Codes {
+		if bytes.Equal(c.CodeInfo.CodeHash, dump.Code.CodeInfo.CodeHash) {
+			codeID = c.CodeID
+		}
+		if c.CodeID > maxCodeID {
+			maxCodeID = c.CodeID
+		}
+	}
+	if codeID == 0 {
+		if seq := s.sequence(KeyLastCodeID); seq > maxCodeID+1 {
+			maxCodeID = seq - 1
+		}
+		codeID = maxCodeID + 1
+		code := dump.Code
+		code.CodeID = codeID
+		s.Codes = append(s.Codes, code)
+		s.raiseSequence(KeyLastCodeID, codeID+1)
+	}
+
+	contract := dump.Contract
+	contract.ContractInfo.CodeID = codeID
+	s.Contracts = append(s.Contracts, contract)
+	instanceID := dump.InstanceID
+	if n := uint64(len(s.Contracts)); n > instanceID {
+		instanceID = n
+	}
+	s.raiseSequence(KeyLastInstanceID, instanceID+1)
+	return nil
+}
+
+// sequence returns the value of the sequence, 0 when it is not set
+func (s GenesisState) sequence(idKey []byte) uint64 {
+	for _, seq := range s.Sequences {
+		if bytes.Equal(seq.IDKey, idKey) {
+			return seq.Value
+		}
+	}
+	return 0
+}
+
+// raiseSequence sets the sequence to at least the value
+func (s *GenesisState) raiseSequence(idKey []byte, value uint64) {
+	for i := range s.Sequences {
+		if bytes.Equal(s.Sequences[i].IDKey, idKey) {
+			if s.Sequences[i].Value < value {
+				s.Sequences[i].Value = value
+			}
+			return
+		}
+	}
+	s.Sequences = append(s.Sequences, Sequence{IDKey: idKey, Value: value})
+}
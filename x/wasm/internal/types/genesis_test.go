@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -39,6 +40,18 @@ func TestValidateGenesisState(t *testing.T) {
 			},
 			expError: true,
 		},
+		"paused contract invalid": {
+			srcMutator: func(s *GenesisState) {
+				s.PausedContracts = []sdk.AccAddress{bytes.Repeat([]byte{0x1}, sdk.AddrLen-1)}
+			},
+			expError: true,
+		},
+		"paused code invalid": {
+			srcMutator: func(s *GenesisState) {
+				s.PausedCodes = []uint64{0}
+			},
+			expError: true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -146,3 +159,120 @@ func TestContractValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesisImportContract(t *testing.T) {
+	dumpAddr := bytes.Repeat([]byte{1}, sdk.AddrLen)
+	dump := ContractDump{
+		Code:       CodeFixture(func(c *Code) { c.CodeID = 7 }),
+		Contract:   ContractFixture(func(c *Contract) { c.ContractAddress = dumpAddr; c.ContractInfo.CodeID = 7 }),
+		InstanceID: 5,
+	}
+	existing := func(s *GenesisState) {
+		s.Codes = []Code{CodeFixture(func(c *Code) { c.CodeID = 1 }), CodeFixture(func(c *Code) { c.CodeID = 2 })}
+		s.Contracts = []Contract{ContractFixture()}
+		s.Sequences = []Sequence{{IDKey: KeyLastCodeID, Value: 3}, {IDKey: KeyLastInstanceID, Value: 2}}
+	}
+
+	specs := map[string]struct {
+		srcMutator func(*GenesisState)
+		src        ContractDump
+		expCodeID  uint64
+		expCodes   int
+		expSeqs    []Sequence
+		expError   bool
+	}{
+		"new code": {
+			srcMutator: existing,
+			src:        dump,
+			expCodeID:  3,
+			expCodes:   3,
+			expSeqs:    []Sequence{{IDKey: KeyLastCodeID, Value: 4}, {IDKey: KeyLastInstanceID, Value: 6}},
+		},
+		"existing code": {
+			srcMutator: func(s *GenesisState) {
+				existing(s)
+				s.Codes[1].CodeInfo.CodeHash = dump.Code.CodeInfo.CodeHash
+			},
+			src:       dump,
+			expCodeID: 2,
+			expCodes:  2,
+			expSeqs:   []Sequence{{IDKey: KeyLastCodeID, Value: 3}, {IDKey: KeyLastInstanceID, Value: 6}},
+		},
+		"empty genesis": {
+			srcMutator: func(s *GenesisState) {
+				s.Codes, s.Contracts, s.Sequences = nil, nil, nil
+			},
+			src:       dump,
+			expCodeID: 1,
+			expCodes:  1,
+			expSeqs:   []Sequence{{IDKey: KeyLastCodeID, Value: 2}, {IDKey: KeyLastInstanceID, Value: 6}},
+		},
+		"code sequence ahead of codes": {
+			srcMutator: func(s *GenesisState) {
+				existing(s)
+				s.Sequences[0].Value = 10
+			},
+			src:       dump,
+			expCodeID: 10,
+			expCodes:  3,
+			expSeqs:   []Sequence{{IDKey: KeyLastCodeID, Value: 11}, {IDKey: KeyLastInstanceID, Value: 6}},
+		},
+		"instance id below the contracts": {
+			srcMutator: existing,
+			src:        ContractDump{Code: dump.Code, Contract: dump.Contract, InstanceID: 1},
+			expCodeID:  3,
+			expCodes:   3,
+			expSeqs:    []Sequence{{IDKey: KeyLastCodeID, Value: 4}, {IDKey: KeyLastInstanceID, Value: 3}},
+		},
+		"instance sequence ahead": {
+			srcMutator: func(s *GenesisState) {
+				existing(s)
+				s.Sequences[1].Value = 20
+			},
+			src:       dump,
+			expCodeID: 3,
+			expCodes:  3,
+			expSeqs:   []Sequence{{IDKey: KeyLastCodeID, Value: 4}, {IDKey: KeyLastInstanceID, Value: 20}},
+		},
+		"no instance id": {
+			srcMutator: existing,
+			src:        ContractDump{Code: dump.Code, Contract: dump.Contract},
+			expError:   true,
+		},
+		"duplicate contract": {
+			srcMutator: func(s *GenesisState) {
+				existing(s)
+				s.Contracts[0].ContractAddress = dumpAddr
+			},
+			src:      dump,
+			expError: true,
+		},
+		"code id mismatch": {
+			srcMutator: existing,
+			src: ContractDump{
+				Code:       dump.Code,
+				Contract:   ContractFixture(func(c *Contract) { c.ContractAddress = dumpAddr }),
+				InstanceID: 5,
+			},
+			expError: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			state := GenesisFixture(spec.srcMutator)
+			err := state.ImportContract(spec.src)
+			if spec.expError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, state.Codes, spec.expCodes)
+			imported := state.Contracts[len(state.Contracts)-1]
+			require.Equal(t, sdk.AccAddress(dumpAddr), imported.ContractAddress)
+			require.Equal(t, spec.expCodeID, imported.ContractInfo.CodeID)
+			require.Equal(t, spec.src.Contract.ContractState, imported.ContractState)
+			require.Equal(t, spec.expSeqs, state.Sequences)
+			require.NoError(t, state.ValidateBasic())
+		})
+	}
+}
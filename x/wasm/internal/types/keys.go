@@ -27,15 +27,35 @@ const ( // event attributes
 	AttributeKeyContract = "contract_address"
 	AttributeKeyCodeID   = "code_id"
 	AttributeKeySigner   = "signer"
+	AttributeKeyGranter  = "granter"
+	AttributeKeyGrantee  = "grantee"
+	// AttributeKeyRewardAddress is the address contract rewards are accrued for
+	AttributeKeyRewardAddress = "reward_address"
+	// AttributeKeyResultData is the base64 encoded data returned by the contract
+	AttributeKeyResultData = "result_data"
 )
 
 // nolint
 var (
-	CodeKeyPrefix              = []byte{0x01}
-	ContractKeyPrefix          = []byte{0x02}
-	ContractStorePrefix        = []byte{0x03}
-	SequenceKeyPrefix          = []byte{0x04}
-	ContractHistoryStorePrefix = []byte{0x05}
+	CodeKeyPrefix                = []byte{0x01}
+	ContractKeyPrefix            = []byte{0x02}
+	ContractStorePrefix          = []byte{0x03}
+	SequenceKeyPrefix            = []byte{0x04}
+	ContractHistoryStorePrefix   = []byte{0x05}
+	PausedContractPrefix         = []byte{0x06}
+	PausedCodePrefix             = []byte{0x07}
+	ContractByCodeIndexPrefix    = []byte{0x08}
+	ContractByCreatorIndexPrefix = []byte{0x09}
+	ContractExecutionGrantPrefix = []byte{0x0a}
+	ContractMetadataPrefix       = []byte{0x0b}
+	ContractRewardAddressPrefix  = []byte{0x0c}
+	ContractRewardsPrefix        = []byte{0x0d}
+	ContractLimitsPrefix         = []byte{0x0e}
+	ContractExecutionCountPrefix = []byte{0x0f}
+	ContractSchedulePrefix       = []byte{0x10}
+	CodeByHashIndexPrefix        = []byte{0x11}
+	CodeBytecodePrefix           = []byte{0x12}
+	ContractProvenancePrefix     = []byte{0x13}
 
 	KeyLastCodeID     = append(SequenceKeyPrefix, []byte("lastCodeId")...)
 	KeyLastInstanceID = append(SequenceKeyPrefix, []byte("lastContractId")...)
@@ -60,3 +80,99 @@ func GetContractAddressKey(addr sdk.AccAddress) []byte {
 func GetContractStorePrefixKey(addr sdk.AccAddress) []byte {
 	return append(ContractStorePrefix, addr...)
 }
+
+// GetPausedContractKey returns the key marking execution of the WASM contract instance as paused
+func GetPausedContractKey(addr sdk.AccAddress) []byte {
+	return append(PausedContractPrefix, addr...)
+}
+
+// GetPausedCodeKey returns the key marking execution of all contracts of the WASM code as paused
+func GetPausedCodeKey(codeID uint64) []byte {
+	return append(PausedCodePrefix, sdk.Uint64ToBigEndian(codeID)...)
+}
+
+// GetContractMetadataKey returns the key of the metadata of the WASM contract instance
+func GetContractMetadataKey(addr sdk.AccAddress) []byte {
+	return append(append([]byte{}, ContractMetadataPrefix...), addr...)
+}
+
+// GetContractProvenanceKey returns the key of the creation of the WASM contract instance
+func GetContractProvenanceKey(addr sdk.AccAddress) []byte {
+	return append(append([]byte{}, ContractProvenancePrefix...), addr...)
+}
+
+// GetContractRewardAddressKey returns the key of the reward address of the WASM contract instance
+func GetContractRewardAddressKey(addr sdk.AccAddress) []byte {
+	return append(append([]byte{}, ContractRewardAddressPrefix...), addr...)
+}
+
+// GetContractLimitsKey returns the key of the limits of the WASM contract instance
+func GetContractLimitsKey(addr sdk.AccAddress) []byte {
+	return append(append([]byte{}, ContractLimitsPrefix...), addr...)
+}
+
+// GetContractExecutionCountKey returns the key of the number of executions of the WASM contract instance in the
+// current block
+func GetContractExecutionCountKey(addr sdk.AccAddress) []byte {
+	return append(append([]byte{}, ContractExecutionCountPrefix...), addr...)
+}
+
+// GetContractScheduleKey returns the key of the execution schedule of the WASM contract instance
+func GetContractScheduleKey(addr sdk.AccAddress) []byte {
+	return append(append([]byte{}, ContractSchedulePrefix...), addr...)
+}
+
+// GetContractRewardsKey returns the key of the rewards accrued for the reward address
+func GetContractRewardsKey(rewardAddr sdk.AccAddress) []byte {
+	return append(append([]byte{}, ContractRewardsPrefix...), rewardAddr...)
+}
+
+// GetCodeByHashIndexPrefix returns the prefix of the index of the codes with the checksum
+func GetCodeByHashIndexPrefix(codeHash []byte) []byte {
+	return append(append([]byte{}, CodeByHashIndexPrefix...), codeHash...)
+}
+
+// GetCodeByHashIndexKey returns the key of the code in the index of the codes with the checksum
+func GetCodeByHashIndexKey(codeHash []byte, codeID uint64) []byte {
+	return append(GetCodeByHashIndexPrefix(codeHash), sdk.Uint64ToBigEndian(codeID)...)
+}
+
+// GetCodeBytecodeKey returns the key of the gzip compressed wasm code with the checksum
+func GetCodeBytecodeKey(codeHash []byte) []byte {
+	return append(append([]byte{}, CodeBytecodePrefix...), codeHash...)
+}
+
+// GetContractByCodeIndexPrefix returns the prefix of the index of the contracts instantiated from the WASM code
+func GetContractByCodeIndexPrefix(codeID uint64) []byte {
+	return append(append([]byte{}, ContractByCodeIndexPrefix...), sdk.Uint64ToBigEndian(codeID)...)
+}
+
+// GetContractByCodeIndexKey returns the key of the contract in the index by code id
+func GetContractByCodeIndexKey(codeID uint64, contractAddr sdk.AccAddress) []byte {
+	return append(GetContractByCodeIndexPrefix(codeID), contractAddr...)
+}
+
+// GetContractByCreatorIndexPrefix returns the prefix of the index of the contracts instantiated by the creator.
+// The creator is length prefixed so that no creator address is the prefix of another.
+func GetContractByCreatorIndexPrefix(creator sdk.AccAddress) []byte {
+	res := append(append([]byte{}, ContractByCreatorIndexPrefix...), byte(len(creator)))
+	return append(res, creator...)
+}
+
+// GetContractByCreatorIndexKey returns the key of the contract in the index by creator
+func GetContractByCreatorIndexKey(creator, contractAddr sdk.AccAddress) []byte {
+	return append(GetContractByCreatorIndexPrefix(creator), contractAddr...)
+}
+
+// GetContractExecutionGrantPrefix returns the prefix of the contract execution grants of the granter to the grantee.
+// Both addresses are length prefixed.
+func GetContractExecutionGrantPrefix(granter, grantee sdk.AccAddress) []byte {
+	res := append(append([]byte{}, ContractExecutionGrantPrefix...), byte(len(granter)))
+	res = append(append(res, granter...), byte(len(grantee)))
+	return append(res, grantee...)
+}
+
+// GetContractExecutionGrantKey returns the key of the grant of the granter to the grantee for the contract
+func GetContractExecutionGrantKey(granter, grantee, contractAddr sdk.AccAddress) []byte {
+	return append(GetContractExecutionGrantPrefix(granter, grantee), contractAddr...)
+}
@@ -0,0 +1,80 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ContractLimits contain runaway or abusive contracts. A limit of 0 means no limit.
+type ContractLimits struct {
+	// MaxGasPerExecution is the wasm gas a single execution of the contract may use, in the unit of max_contract_gas
+	MaxGasPerExecution uint64 `json:"max_gas_per_execution,omitempty" yaml:"max_gas_per_execution"`
+	// MaxExecutionsPerBlock is the number of executions of the contract allowed in a block
+	MaxExecutionsPerBlock uint64 `json:"max_executions_per_block,omitempty" yaml:"max_executions_per_block"`
+}
+
+func (l ContractLimits) ValidateBasic() error {
+	if l.MaxGasPerExecution > DefaultMaxContractGas {
+		return sdkerrors.Wrapf(ErrLimit, "max gas per execution cannot be bigger than %d", DefaultMaxContractGas)
+	}
+	return nil
+}
+
+// IsEmpty returns true when no limit is set
+func (l ContractLimits) IsEmpty() bool {
+	return l == ContractLimits{}
+}
+
+// WithinBounds returns an error when a limit is less strict than its bound. A limit of 0 leaves it to the bound,
+// a bound of 0 does not restrict the limit.
+func (l ContractLimits) WithinBounds(bounds ContractLimits) error {
+	if !withinBound(l.MaxGasPerExecution, bounds.MaxGasPerExecution) {
+		return sdkerrors.Wrapf(ErrLimit, "max gas per execution cannot be bigger than %d", bounds.MaxGasPerExecution)
+	}
+	if !withinBound(l.MaxExecutionsPerBlock, bounds.MaxExecutionsPerBlock) {
+		return sdkerrors.Wrapf(ErrLimit, "max executions per block cannot be bigger than %d", bounds.MaxExecutionsPerBlock)
+	}
+	return nil
+}
+
+// Tighten returns the stricter value of every limit
+func (l ContractLimits) Tighten(o ContractLimits) ContractLimits {
+	return ContractLimits{
+		MaxGasPerExecution:    stricterLimit(l.MaxGasPerExecution, o.MaxGasPerExecution),
+		MaxExecutionsPerBlock: stricterLimit(l.MaxExecutionsPerBlock, o.MaxExecutionsPerBlock),
+	}
+}
+
+func withinBound(limit, bound uint64) bool {
+	return limit == 0 || bound == 0 || limit <= bound
+}
+
+func stricterLimit(a, b uint64) uint64 {
+	if a == 0 || (b != 0 && b < a) {
+		return b
+	}
+	return a
+}
+
+// ContractLimitsInfo holds the limits of a contract. Governance sets the bounds, the contract admin can set limits
+// within them. The stricter of both is enforced.
+type ContractLimitsInfo struct {
+	Bounds ContractLimits `json:"bounds" yaml:"bounds"`
+	Admin  ContractLimits `json:"admin" yaml:"admin"`
+}
+
+func (i ContractLimitsInfo) ValidateBasic() error {
+	if err := i.Bounds.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "bounds")
+	}
+	return sdkerrors.Wrap(i.Admin.ValidateBasic(), "admin")
+}
+
+// IsEmpty returns true when neither bounds nor admin limits are set
+func (i ContractLimitsInfo) IsEmpty() bool {
+	return i.Bounds.IsEmpty() && i.Admin.IsEmpty()
+}
+
+// Effective returns the limits that are enforced
+func (i ContractLimitsInfo) Effective() ContractLimits {
+	return i.Bounds.Tighten(i.Admin)
+}
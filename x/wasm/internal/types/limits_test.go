@@ -0,0 +1,76 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContractLimitsWithinBounds(t *testing.T) {
+	specs := map[string]struct {
+		src    ContractLimits
+		bounds ContractLimits
+		expErr bool
+	}{
+		"no bounds": {
+			src: ContractLimits{MaxGasPerExecution: 100, MaxExecutionsPerBlock: 10},
+		},
+		"within bounds": {
+			src:    ContractLimits{MaxGasPerExecution: 100, MaxExecutionsPerBlock: 10},
+			bounds: ContractLimits{MaxGasPerExecution: 100, MaxExecutionsPerBlock: 20},
+		},
+		"no limits with bounds": {
+			bounds: ContractLimits{MaxGasPerExecution: 100, MaxExecutionsPerBlock: 20},
+		},
+		"max gas above bound": {
+			src:    ContractLimits{MaxGasPerExecution: 101},
+			bounds: ContractLimits{MaxGasPerExecution: 100},
+			expErr: true,
+		},
+		"max executions above bound": {
+			src:    ContractLimits{MaxExecutionsPerBlock: 21},
+			bounds: ContractLimits{MaxExecutionsPerBlock: 20},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.WithinBounds(spec.bounds)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestContractLimitsInfoEffective(t *testing.T) {
+	specs := map[string]struct {
+		src ContractLimitsInfo
+		exp ContractLimits
+	}{
+		"empty": {},
+		"bounds only": {
+			src: ContractLimitsInfo{Bounds: ContractLimits{MaxGasPerExecution: 100, MaxExecutionsPerBlock: 20}},
+			exp: ContractLimits{MaxGasPerExecution: 100, MaxExecutionsPerBlock: 20},
+		},
+		"admin only": {
+			src: ContractLimitsInfo{Admin: ContractLimits{MaxGasPerExecution: 100, MaxExecutionsPerBlock: 20}},
+			exp: ContractLimits{MaxGasPerExecution: 100, MaxExecutionsPerBlock: 20},
+		},
+		"stricter of both": {
+			src: ContractLimitsInfo{
+				Bounds: ContractLimits{MaxGasPerExecution: 100, MaxExecutionsPerBlock: 20},
+				Admin:  ContractLimits{MaxGasPerExecution: 200, MaxExecutionsPerBlock: 10},
+			},
+			exp: ContractLimits{MaxGasPerExecution: 100, MaxExecutionsPerBlock: 10},
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			assert.Equal(t, spec.exp, spec.src.Effective())
+		})
+	}
+}
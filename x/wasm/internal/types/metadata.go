@@ -0,0 +1,47 @@
+package types
+
+import (
+	"regexp"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	MaxMetadataNameSize        = 128
+	MaxMetadataDescriptionSize = 1024
+
+	// SchemaIPFSHashRegexp matches CIDv0 (base58 "Qm...") and base32 CIDv1 ("b...") ipfs hashes
+	SchemaIPFSHashRegexp = "^(Qm[1-9A-HJ-NP-Za-km-z]{44}|b[a-z2-7]{58,})$"
+)
+
+var schemaIPFSHashRegexp = regexp.MustCompile(SchemaIPFSHashRegexp)
+
+// ContractMetadata is descriptive information about a contract, set by its admin for explorers and wallets
+type ContractMetadata struct {
+	Name        string `json:"name,omitempty" yaml:"name"`
+	Description string `json:"description,omitempty" yaml:"description"`
+	// SchemaIPFSHash is the ipfs hash of the json schema of the contract messages
+	SchemaIPFSHash string `json:"schema_ipfs_hash,omitempty" yaml:"schema_ipfs_hash"`
+	Repository     string `json:"repository,omitempty" yaml:"repository"`
+}
+
+func (m ContractMetadata) ValidateBasic() error {
+	if len(m.Name) > MaxMetadataNameSize {
+		return sdkerrors.Wrapf(ErrLimit, "name cannot be longer than %d characters", MaxMetadataNameSize)
+	}
+	if len(m.Description) > MaxMetadataDescriptionSize {
+		return sdkerrors.Wrapf(ErrLimit, "description cannot be longer than %d characters", MaxMetadataDescriptionSize)
+	}
+	if m.SchemaIPFSHash != "" && !schemaIPFSHashRegexp.MatchString(m.SchemaIPFSHash) {
+		return sdkerrors.Wrap(ErrInvalid, "schema ipfs hash")
+	}
+	if err := validateSourceURL(m.Repository); err != nil {
+		return sdkerrors.Wrap(err, "repository")
+	}
+	return nil
+}
+
+// IsEmpty returns true when no field is set
+func (m ContractMetadata) IsEmpty() bool {
+	return m == ContractMetadata{}
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestContractMetadataValidateBasic(t *testing.T) {
+	specs := map[string]struct {
+		src    ContractMetadata
+		expErr bool
+	}{
+		"all good": {
+			src: ContractMetadata{
+				Name:           "escrow",
+				Description:    "releases funds to the beneficiary",
+				SchemaIPFSHash: "QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG",
+				Repository:     "https://github.com/CosmWasm/cosmwasm-examples",
+			},
+		},
+		"empty": {
+			src: ContractMetadata{},
+		},
+		"cid v1 schema": {
+			src: ContractMetadata{SchemaIPFSHash: "bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi"},
+		},
+		"name too long": {
+			src:    ContractMetadata{Name: strings.Repeat("a", MaxMetadataNameSize+1)},
+			expErr: true,
+		},
+		"description too long": {
+			src:    ContractMetadata{Description: strings.Repeat("a", MaxMetadataDescriptionSize+1)},
+			expErr: true,
+		},
+		"invalid schema hash": {
+			src:    ContractMetadata{SchemaIPFSHash: "not-a-hash"},
+			expErr: true,
+		},
+		"repository not https": {
+			src:    ContractMetadata{Repository: "http://github.com/CosmWasm/cosmwasm-examples"},
+			expErr: true,
+		},
+		"repository not absolute": {
+			src:    ContractMetadata{Repository: "github.com/CosmWasm/cosmwasm-examples"},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -17,6 +17,8 @@ type MsgStoreCode struct {
 	Builder string `json:"builder" yaml:"builder"`
 	// InstantiatePermission to apply on contract creation, optional
 	InstantiatePermission *AccessConfig `json:"instantiate_permission,omitempty" yaml:"instantiate_permission"`
+	// NoDedup stores a copy of the code even when a code with the same checksum and instantiate permission exists
+	NoDedup bool `json:"no_dedup,omitempty" yaml:"no_dedup"`
 }
 
 func (msg MsgStoreCode) Route() string {
@@ -261,3 +263,301 @@ func (msg MsgClearAdmin) GetSignBytes() []byte {
 func (msg MsgClearAdmin) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Sender}
 }
+
+// MsgPauseExecution pauses execution of a single contract or of all contracts of a code.
+// Exactly one of Contract or CodeID must be set.
+type MsgPauseExecution struct {
+	Sender   sdk.AccAddress `json:"sender" yaml:"sender"`
+	Contract sdk.AccAddress `json:"contract,omitempty" yaml:"contract"`
+	CodeID   uint64         `json:"code_id,omitempty" yaml:"code_id"`
+}
+
+func (msg MsgPauseExecution) Route() string {
+	return RouterKey
+}
+
+func (msg MsgPauseExecution) Type() string {
+	return "pause-execution"
+}
+
+func (msg MsgPauseExecution) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	return validatePauseTarget(msg.Contract, msg.CodeID)
+}
+
+func (msg MsgPauseExecution) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgPauseExecution) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgResumeExecution lifts a pause set with MsgPauseExecution.
+// Exactly one of Contract or CodeID must be set.
+type MsgResumeExecution struct {
+	Sender   sdk.AccAddress `json:"sender" yaml:"sender"`
+	Contract sdk.AccAddress `json:"contract,omitempty" yaml:"contract"`
+	CodeID   uint64         `json:"code_id,omitempty" yaml:"code_id"`
+}
+
+func (msg MsgResumeExecution) Route() string {
+	return RouterKey
+}
+
+func (msg MsgResumeExecution) Type() string {
+	return "resume-execution"
+}
+
+func (msg MsgResumeExecution) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	return validatePauseTarget(msg.Contract, msg.CodeID)
+}
+
+func (msg MsgResumeExecution) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgResumeExecution) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgGrantContractExecution allows the grantee to execute a contract on behalf of the granter.
+// An existing grant of the granter to the grantee for the same contract is replaced.
+type MsgGrantContractExecution struct {
+	Granter       sdk.AccAddress                 `json:"granter" yaml:"granter"`
+	Grantee       sdk.AccAddress                 `json:"grantee" yaml:"grantee"`
+	Authorization ContractExecutionAuthorization `json:"authorization" yaml:"authorization"`
+}
+
+func (msg MsgGrantContractExecution) Route() string {
+	return RouterKey
+}
+
+func (msg MsgGrantContractExecution) Type() string {
+	return "grant-contract-execution"
+}
+
+func (msg MsgGrantContractExecution) ValidateBasic() error {
+	return ContractExecutionGrant{Granter: msg.Granter, Grantee: msg.Grantee, Authorization: msg.Authorization}.ValidateBasic()
+}
+
+func (msg MsgGrantContractExecution) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgGrantContractExecution) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// MsgRevokeContractExecution removes the grant of the granter to the grantee for the contract
+type MsgRevokeContractExecution struct {
+	Granter  sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee  sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	Contract sdk.AccAddress `json:"contract" yaml:"contract"`
+}
+
+func (msg MsgRevokeContractExecution) Route() string {
+	return RouterKey
+}
+
+func (msg MsgRevokeContractExecution) Type() string {
+	return "revoke-contract-execution"
+}
+
+func (msg MsgRevokeContractExecution) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Granter); err != nil {
+		return sdkerrors.Wrap(err, "granter")
+	}
+	if err := sdk.VerifyAddressFormat(msg.Grantee); err != nil {
+		return sdkerrors.Wrap(err, "grantee")
+	}
+	if err := sdk.VerifyAddressFormat(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return nil
+}
+
+func (msg MsgRevokeContractExecution) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgRevokeContractExecution) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// MsgExec executes contracts on behalf of granters. The sender of every message is the granter, who must have
+// granted the execution to the grantee with MsgGrantContractExecution.
+type MsgExec struct {
+	Grantee sdk.AccAddress       `json:"grantee" yaml:"grantee"`
+	Msgs    []MsgExecuteContract `json:"msgs" yaml:"msgs"`
+}
+
+func (msg MsgExec) Route() string {
+	return RouterKey
+}
+
+func (msg MsgExec) Type() string {
+	return "exec"
+}
+
+func (msg MsgExec) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Grantee); err != nil {
+		return sdkerrors.Wrap(err, "grantee")
+	}
+	if len(msg.Msgs) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "msgs")
+	}
+	for i := range msg.Msgs {
+		if err := msg.Msgs[i].ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "msg %d", i)
+		}
+	}
+	return nil
+}
+
+func (msg MsgExec) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgExec) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Grantee}
+}
+
+// MsgSetContractMetadata sets the metadata of a contract, sent by the contract admin. Empty metadata removes it.
+type MsgSetContractMetadata struct {
+	Sender   sdk.AccAddress   `json:"sender" yaml:"sender"`
+	Contract sdk.AccAddress   `json:"contract" yaml:"contract"`
+	Metadata ContractMetadata `json:"metadata" yaml:"metadata"`
+}
+
+func (msg MsgSetContractMetadata) Route() string {
+	return RouterKey
+}
+
+func (msg MsgSetContractMetadata) Type() string {
+	return "set-contract-metadata"
+}
+
+func (msg MsgSetContractMetadata) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if err := sdk.VerifyAddressFormat(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return sdkerrors.Wrap(msg.Metadata.ValidateBasic(), "metadata")
+}
+
+func (msg MsgSetContractMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgSetContractMetadata) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgSetContractLimits sets the limits of a contract, sent by the contract admin. The limits must be within the
+// bounds set by governance, empty limits remove the ones of the admin.
+type MsgSetContractLimits struct {
+	Sender   sdk.AccAddress `json:"sender" yaml:"sender"`
+	Contract sdk.AccAddress `json:"contract" yaml:"contract"`
+	Limits   ContractLimits `json:"limits" yaml:"limits"`
+}
+
+func (msg MsgSetContractLimits) Route() string {
+	return RouterKey
+}
+
+func (msg MsgSetContractLimits) Type() string {
+	return "set-contract-limits"
+}
+
+func (msg MsgSetContractLimits) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if err := sdk.VerifyAddressFormat(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return sdkerrors.Wrap(msg.Limits.ValidateBasic(), "limits")
+}
+
+func (msg MsgSetContractLimits) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgSetContractLimits) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgSetContractRewardAddress sets the address the developer share of the fees for executions of the contract is
+// accrued for, sent by the contract admin. An empty reward address stops the accrual.
+type MsgSetContractRewardAddress struct {
+	Sender        sdk.AccAddress `json:"sender" yaml:"sender"`
+	Contract      sdk.AccAddress `json:"contract" yaml:"contract"`
+	RewardAddress sdk.AccAddress `json:"reward_address,omitempty" yaml:"reward_address"`
+}
+
+func (msg MsgSetContractRewardAddress) Route() string {
+	return RouterKey
+}
+
+func (msg MsgSetContractRewardAddress) Type() string {
+	return "set-contract-reward-address"
+}
+
+func (msg MsgSetContractRewardAddress) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if err := sdk.VerifyAddressFormat(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if len(msg.RewardAddress) != 0 {
+		if err := sdk.VerifyAddressFormat(msg.RewardAddress); err != nil {
+			return sdkerrors.Wrap(err, "reward address")
+		}
+	}
+	return nil
+}
+
+func (msg MsgSetContractRewardAddress) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgSetContractRewardAddress) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgWithdrawContractRewards pays out the contract rewards accrued for the reward address
+type MsgWithdrawContractRewards struct {
+	RewardAddress sdk.AccAddress `json:"reward_address" yaml:"reward_address"`
+}
+
+func (msg MsgWithdrawContractRewards) Route() string {
+	return RouterKey
+}
+
+func (msg MsgWithdrawContractRewards) Type() string {
+	return "withdraw-contract-rewards"
+}
+
+func (msg MsgWithdrawContractRewards) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.RewardAddress); err != nil {
+		return sdkerrors.Wrap(err, "reward address")
+	}
+	return nil
+}
+
+func (msg MsgWithdrawContractRewards) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgWithdrawContractRewards) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.RewardAddress}
+}
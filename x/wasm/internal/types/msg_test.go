@@ -549,3 +549,108 @@ func TestMsgMigrateContract(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgPauseExecution(t *testing.T) {
+	badAddress, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20))
+	anotherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x2}, 20))
+
+	specs := map[string]struct {
+		src    MsgPauseExecution
+		expErr bool
+	}{
+		"all good with contract": {
+			src: MsgPauseExecution{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+			},
+		},
+		"all good with code id": {
+			src: MsgPauseExecution{
+				Sender: goodAddress,
+				CodeID: 1,
+			},
+		},
+		"bad sender": {
+			src: MsgPauseExecution{
+				Sender:   badAddress,
+				Contract: anotherGoodAddress,
+			},
+			expErr: true,
+		},
+		"bad contract addr": {
+			src: MsgPauseExecution{
+				Sender:   goodAddress,
+				Contract: badAddress,
+			},
+			expErr: true,
+		},
+		"contract and code id missing": {
+			src: MsgPauseExecution{
+				Sender: goodAddress,
+			},
+			expErr: true,
+		},
+		"contract and code id both set": {
+			src: MsgPauseExecution{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+				CodeID:   1,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgExec(t *testing.T) {
+	badAddress, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20))
+	anotherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x2}, 20))
+	execMsg := MsgExecuteContract{Sender: anotherGoodAddress, Contract: anotherGoodAddress, Msg: []byte(`{"release":{}}`)}
+
+	specs := map[string]struct {
+		src    MsgExec
+		expErr bool
+	}{
+		"all good": {
+			src: MsgExec{Grantee: goodAddress, Msgs: []MsgExecuteContract{execMsg}},
+		},
+		"bad grantee": {
+			src:    MsgExec{Grantee: badAddress, Msgs: []MsgExecuteContract{execMsg}},
+			expErr: true,
+		},
+		"no msgs": {
+			src:    MsgExec{Grantee: goodAddress},
+			expErr: true,
+		},
+		"invalid msg": {
+			src:    MsgExec{Grantee: goodAddress, Msgs: []MsgExecuteContract{execMsg, {Sender: anotherGoodAddress, Contract: badAddress, Msg: []byte(`{}`)}}},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, []sdk.AccAddress{spec.src.Grantee}, spec.src.GetSigners())
+		})
+	}
+}
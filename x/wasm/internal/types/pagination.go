@@ -0,0 +1,59 @@
+package types
+
+import (
+	"encoding/json"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// DefaultPageLimit is used when a PageRequest does not set a limit
+	DefaultPageLimit uint64 = 100
+	// MaxPageLimit is the maximum number of results returned for a single page
+	MaxPageLimit uint64 = 1000
+)
+
+// PageRequest selects a page of results for the list queries. It is passed json encoded as query data.
+// Either Key or Offset can be set: Key continues from the NextKey returned before, Offset skips results.
+type PageRequest struct {
+	Key     []byte `json:"key,omitempty"`
+	Offset  uint64 `json:"offset,omitempty"`
+	Limit   uint64 `json:"limit,omitempty"`
+	Reverse bool   `json:"reverse,omitempty"`
+}
+
+// PageResponse is returned with a page of results
+type PageResponse struct {
+	// NextKey is the key to request the next page with, empty when there are no more results
+	NextKey []byte `json:"next_key"`
+}
+
+// ValidateBasic checks the request is consistent and sets the default limit
+func (p *PageRequest) ValidateBasic() error {
+	if len(p.Key) != 0 && p.Offset != 0 {
+		return sdkerrors.Wrap(ErrInvalid, "only one of key or offset can be set")
+	}
+	if p.Limit == 0 {
+		p.Limit = DefaultPageLimit
+	}
+	if p.Limit > MaxPageLimit {
+		return sdkerrors.Wrapf(ErrLimit, "page limit must not exceed %d", MaxPageLimit)
+	}
+	return nil
+}
+
+// ParsePageRequest decodes the optional pagination parameters of a list query.
+// It returns nil when no query data was given.
+func ParsePageRequest(bz []byte) (*PageRequest, error) {
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var p PageRequest
+	if err := json.Unmarshal(bz, &p); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	if err := p.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
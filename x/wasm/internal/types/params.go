@@ -17,6 +17,27 @@ const (
 
 var ParamStoreKeyUploadAccess = []byte("uploadAccess")
 var ParamStoreKeyInstantiateAccess = []byte("instantiateAccess")
+var ParamStoreKeySecurityAddress = []byte("securityAddress")
+var ParamStoreKeyFeeDiscounts = []byte("feeDiscounts")
+var ParamStoreKeyMaxWasmCodeSize = []byte("maxWasmCodeSize")
+var ParamStoreKeyMaxContractGas = []byte("maxContractGas")
+var ParamStoreKeyGasMultiplier = []byte("gasMultiplier")
+var ParamStoreKeyDeveloperFeeShare = []byte("developerFeeShare")
+
+const (
+	// DefaultGasMultiplier is how many cosmwasm gas points = 1 sdk gas point
+	// SDK reference costs can be found here: https://github.com/cosmos/cosmos-sdk/blob/02c6c9fafd58da88550ab4d7d494724a477c8a68/store/types/gas.go#L153-L164
+	// A write at ~3000 gas and ~200us = 10 gas per us (microsecond) cpu/io
+	// Rough timing have 88k gas at 90us, which is equal to 1k sdk gas... (one read)
+	//
+	// Please not that all gas prices returned to the wasmer engine should have this multiplied
+	DefaultGasMultiplier uint64 = 100
+
+	// DefaultMaxContractGas for a contract is 10 billion wasmer gas (enforced in rust to prevent overflow)
+	// The limit for v0.9.3 is defined here: https://github.com/CosmWasm/cosmwasm/blob/v0.9.3/packages/vm/src/backends/singlepass.rs#L15-L23
+	// (this will be increased in future releases). The max_contract_gas param can not be set higher.
+	DefaultMaxContractGas uint64 = 10_000_000_000
+)
 
 type AccessType string
 
@@ -25,12 +46,15 @@ const (
 	Nobody      AccessType = "Nobody"
 	OnlyAddress AccessType = "OnlyAddress"
 	Everybody   AccessType = "Everybody"
+	// AnyOfAddresses allows the addresses of the allowlist of the AccessConfig
+	AnyOfAddresses AccessType = "AnyOfAddresses"
 )
 
 var AllAccessTypes = map[AccessType]struct{}{
-	Nobody:      {},
-	OnlyAddress: {},
-	Everybody:   {},
+	Nobody:         {},
+	OnlyAddress:    {},
+	Everybody:      {},
+	AnyOfAddresses: {},
 }
 
 func (a AccessType) With(addr sdk.AccAddress) AccessConfig {
@@ -44,6 +68,11 @@ func (a AccessType) With(addr sdk.AccAddress) AccessConfig {
 		return AccessConfig{Type: OnlyAddress, Address: addr}
 	case Everybody:
 		return AllowEverybody
+	case AnyOfAddresses:
+		if err := sdk.VerifyAddressFormat(addr); err != nil {
+			panic(err)
+		}
+		return AccessConfig{Type: AnyOfAddresses, Addresses: []sdk.AccAddress{addr}}
 	}
 	panic("unsupported access type")
 }
@@ -68,10 +97,25 @@ func (a AccessType) MarshalText() ([]byte, error) {
 type AccessConfig struct {
 	Type    AccessType     `json:"permission" yaml:"permission"`
 	Address sdk.AccAddress `json:"address,omitempty" yaml:"address"`
+	// Addresses is the allowlist of AnyOfAddresses
+	Addresses []sdk.AccAddress `json:"addresses,omitempty" yaml:"addresses"`
 }
 
 func (a AccessConfig) Equals(o AccessConfig) bool {
-	return a.Type == o.Type && a.Address.Equals(o.Address)
+	if a.Type != o.Type || !a.Address.Equals(o.Address) || len(a.Addresses) != len(o.Addresses) {
+		return false
+	}
+	for i := range a.Addresses {
+		if !a.Addresses[i].Equals(o.Addresses[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// AllowAnyOf returns the access config that allows the addresses
+func AllowAnyOf(addrs ...sdk.AccAddress) AccessConfig {
+	return AccessConfig{Type: AnyOfAddresses, Addresses: addrs}
 }
 
 var (
@@ -84,6 +128,27 @@ var (
 type Params struct {
 	UploadAccess                 AccessConfig `json:"code_upload_access" yaml:"code_upload_access"`
 	DefaultInstantiatePermission AccessType   `json:"instantiate_default_permission" yaml:"instantiate_default_permission"`
+	// SecurityAddress can pause and resume contract execution without a governance proposal, optional
+	SecurityAddress sdk.AccAddress `json:"security_address,omitempty" yaml:"security_address"`
+	// FeeDiscounts are the contracts with executions subsidized by the community pool, optional
+	FeeDiscounts []FeeDiscount `json:"fee_discounts,omitempty" yaml:"fee_discounts"`
+	// MaxWasmCodeSize is the max size in bytes of uploaded, possibly gzipped, wasm code. It can not exceed MaxWasmSize.
+	// The limits below fall back to their defaults when 0, optional
+	MaxWasmCodeSize uint64 `json:"max_wasm_code_size,omitempty" yaml:"max_wasm_code_size"`
+	// MaxContractGas is the max wasm gas a single contract call can consume
+	MaxContractGas uint64 `json:"max_contract_gas,omitempty" yaml:"max_contract_gas"`
+	// GasMultiplier is how many wasm gas points are charged as one sdk gas point
+	GasMultiplier uint64 `json:"gas_multiplier,omitempty" yaml:"gas_multiplier"`
+	// DeveloperFeeShare is the share of the fee of contract executions that is accrued for the reward address of the
+	// contract, in the range [0, 1]. Not set or 0 disables the contract rewards, optional
+	DeveloperFeeShare *sdk.Dec `json:"developer_fee_share,omitempty" yaml:"developer_fee_share"`
+}
+
+// FeeDiscount is the share of the tx fee for executions of a contract that is paid from the community pool
+type FeeDiscount struct {
+	Contract sdk.AccAddress `json:"contract" yaml:"contract"`
+	// Discount is in the range (0, 1], 1 means the execution is free for the sender
+	Discount sdk.Dec `json:"discount" yaml:"discount"`
 }
 
 // ParamKeyTable returns the parameter key table.
@@ -96,6 +161,9 @@ func DefaultParams() Params {
 	return Params{
 		UploadAccess:                 AllowEverybody,
 		DefaultInstantiatePermission: Everybody,
+		MaxWasmCodeSize:              MaxWasmSize,
+		MaxContractGas:               DefaultMaxContractGas,
+		GasMultiplier:                DefaultGasMultiplier,
 	}
 }
 
@@ -109,6 +177,12 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
 		params.NewParamSetPair(ParamStoreKeyUploadAccess, &p.UploadAccess, validateAccessConfig),
 		params.NewParamSetPair(ParamStoreKeyInstantiateAccess, &p.DefaultInstantiatePermission, validateAccessType),
+		params.NewParamSetPair(ParamStoreKeySecurityAddress, &p.SecurityAddress, validateSecurityAddress),
+		params.NewParamSetPair(ParamStoreKeyFeeDiscounts, &p.FeeDiscounts, validateFeeDiscounts),
+		params.NewParamSetPair(ParamStoreKeyMaxWasmCodeSize, &p.MaxWasmCodeSize, validateMaxWasmCodeSize),
+		params.NewParamSetPair(ParamStoreKeyMaxContractGas, &p.MaxContractGas, validateMaxContractGas),
+		params.NewParamSetPair(ParamStoreKeyGasMultiplier, &p.GasMultiplier, validateGasMultiplier),
+		params.NewParamSetPair(ParamStoreKeyDeveloperFeeShare, &p.DeveloperFeeShare, validateDeveloperFeeShare),
 	}
 }
 
@@ -120,6 +194,24 @@ func (p Params) ValidateBasic() error {
 	if err := validateAccessConfig(p.UploadAccess); err != nil {
 		return errors.Wrap(err, "upload access")
 	}
+	if err := validateSecurityAddress(p.SecurityAddress); err != nil {
+		return errors.Wrap(err, "security address")
+	}
+	if err := validateFeeDiscounts(p.FeeDiscounts); err != nil {
+		return errors.Wrap(err, "fee discounts")
+	}
+	if err := validateMaxWasmCodeSize(p.MaxWasmCodeSize); err != nil {
+		return errors.Wrap(err, "max wasm code size")
+	}
+	if err := validateMaxContractGas(p.MaxContractGas); err != nil {
+		return errors.Wrap(err, "max contract gas")
+	}
+	if err := validateGasMultiplier(p.GasMultiplier); err != nil {
+		return errors.Wrap(err, "gas multiplier")
+	}
+	if err := validateDeveloperFeeShare(p.DeveloperFeeShare); err != nil {
+		return errors.Wrap(err, "developer fee share")
+	}
 	return nil
 }
 
@@ -131,6 +223,85 @@ func validateAccessConfig(i interface{}) error {
 	return v.ValidateBasic()
 }
 
+func validateSecurityAddress(i interface{}) error {
+	v, ok := i.(sdk.AccAddress)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if len(v) == 0 {
+		return nil
+	}
+	return sdk.VerifyAddressFormat(v)
+}
+
+func validateFeeDiscounts(i interface{}) error {
+	v, ok := i.([]FeeDiscount)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]struct{}, len(v))
+	for _, d := range v {
+		if err := sdk.VerifyAddressFormat(d.Contract); err != nil {
+			return sdkerrors.Wrap(err, "contract")
+		}
+		if _, exists := seen[string(d.Contract)]; exists {
+			return sdkerrors.Wrapf(ErrDuplicate, "contract %s", d.Contract)
+		}
+		seen[string(d.Contract)] = struct{}{}
+		if d.Discount.IsNil() || !d.Discount.IsPositive() || d.Discount.GT(sdk.OneDec()) {
+			return sdkerrors.Wrapf(ErrInvalid, "discount of contract %s must be in (0, 1]", d.Contract)
+		}
+	}
+	return nil
+}
+
+func validateMaxWasmCodeSize(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	// the msg validation is stateless and rejects anything bigger
+	if v > MaxWasmSize {
+		return sdkerrors.Wrapf(ErrLimit, "cannot be bigger than %d", MaxWasmSize)
+	}
+	return nil
+}
+
+func validateMaxContractGas(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v > DefaultMaxContractGas {
+		return sdkerrors.Wrapf(ErrLimit, "cannot be bigger than %d", DefaultMaxContractGas)
+	}
+	return nil
+}
+
+func validateGasMultiplier(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateDeveloperFeeShare(i interface{}) error {
+	v, ok := i.(*sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == nil {
+		return nil
+	}
+	if v.IsNil() {
+		return sdkerrors.Wrap(ErrEmpty, "value")
+	}
+	if v.IsNegative() || v.GT(sdk.OneDec()) {
+		return sdkerrors.Wrap(ErrInvalid, "must be in [0, 1]")
+	}
+	return nil
+}
+
 func validateAccessType(i interface{}) error {
 	v, ok := i.(AccessType)
 	if !ok {
@@ -150,12 +321,33 @@ func (v AccessConfig) ValidateBasic() error {
 	case Undefined, "":
 		return sdkerrors.Wrap(ErrEmpty, "type")
 	case Nobody, Everybody:
-		if len(v.Address) != 0 {
+		if len(v.Address) != 0 || len(v.Addresses) != 0 {
 			return sdkerrors.Wrap(ErrInvalid, "address not allowed for this type")
 		}
 		return nil
 	case OnlyAddress:
+		if len(v.Addresses) != 0 {
+			return sdkerrors.Wrap(ErrInvalid, "addresses not allowed for this type")
+		}
 		return sdk.VerifyAddressFormat(v.Address)
+	case AnyOfAddresses:
+		if len(v.Address) != 0 {
+			return sdkerrors.Wrap(ErrInvalid, "address not allowed for this type, use addresses")
+		}
+		if len(v.Addresses) == 0 {
+			return sdkerrors.Wrap(ErrEmpty, "addresses")
+		}
+		seen := make(map[string]struct{}, len(v.Addresses))
+		for _, a := range v.Addresses {
+			if err := sdk.VerifyAddressFormat(a); err != nil {
+				return sdkerrors.Wrap(err, "addresses")
+			}
+			if _, exists := seen[string(a)]; exists {
+				return sdkerrors.Wrapf(ErrDuplicate, "address %s", a)
+			}
+			seen[string(a)] = struct{}{}
+		}
+		return nil
 	}
 	return sdkerrors.Wrapf(ErrInvalid, "unknown type: %q", v.Type)
 }
@@ -168,6 +360,13 @@ func (v AccessConfig) Allowed(actor sdk.AccAddress) bool {
 		return true
 	case OnlyAddress:
 		return v.Address.Equals(actor)
+	case AnyOfAddresses:
+		for _, a := range v.Addresses {
+			if a.Equals(actor) {
+				return true
+			}
+		}
+		return false
 	default:
 		panic("unknown type")
 	}
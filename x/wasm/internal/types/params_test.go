@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"encoding/json"
 	"testing"
 
@@ -12,7 +13,11 @@ import (
 func TestValidateParams(t *testing.T) {
 	var (
 		anyAddress     = make([]byte, sdk.AddrLen)
+		otherAddress   = bytes.Repeat([]byte{1}, sdk.AddrLen)
 		invalidAddress = make([]byte, sdk.AddrLen-1)
+		halfShare      = sdk.NewDecWithPrec(5, 1)
+		aboveOneShare  = sdk.NewDecWithPrec(11, 1)
+		negativeShare  = sdk.NewDec(-1)
 	)
 
 	specs := map[string]struct {
@@ -40,6 +45,47 @@ func TestValidateParams(t *testing.T) {
 				DefaultInstantiatePermission: OnlyAddress,
 			},
 		},
+		"all good with any of addresses": {
+			src: Params{
+				UploadAccess:                 AllowAnyOf(anyAddress, otherAddress),
+				DefaultInstantiatePermission: AnyOfAddresses,
+			},
+		},
+		"reject empty any of addresses": {
+			src: Params{
+				UploadAccess:                 AccessConfig{Type: AnyOfAddresses},
+				DefaultInstantiatePermission: OnlyAddress,
+			},
+			expErr: true,
+		},
+		"reject invalid address in any of addresses": {
+			src: Params{
+				UploadAccess:                 AllowAnyOf(anyAddress, invalidAddress),
+				DefaultInstantiatePermission: OnlyAddress,
+			},
+			expErr: true,
+		},
+		"reject duplicate address in any of addresses": {
+			src: Params{
+				UploadAccess:                 AllowAnyOf(anyAddress, anyAddress),
+				DefaultInstantiatePermission: OnlyAddress,
+			},
+			expErr: true,
+		},
+		"reject any of addresses with single address": {
+			src: Params{
+				UploadAccess:                 AccessConfig{Type: AnyOfAddresses, Address: anyAddress, Addresses: []sdk.AccAddress{otherAddress}},
+				DefaultInstantiatePermission: OnlyAddress,
+			},
+			expErr: true,
+		},
+		"reject only address with addresses": {
+			src: Params{
+				UploadAccess:                 AccessConfig{Type: OnlyAddress, Address: anyAddress, Addresses: []sdk.AccAddress{otherAddress}},
+				DefaultInstantiatePermission: OnlyAddress,
+			},
+			expErr: true,
+		},
 		"reject empty type in instantiate permission": {
 			src: Params{
 				UploadAccess:                 AllowNobody,
@@ -87,6 +133,114 @@ func TestValidateParams(t *testing.T) {
 			},
 			expErr: true,
 		},
+		"all good with security address": {
+			src: Params{
+				UploadAccess:                 AllowEverybody,
+				DefaultInstantiatePermission: Everybody,
+				SecurityAddress:              anyAddress,
+			},
+		},
+		"reject invalid security address": {
+			src: Params{
+				UploadAccess:                 AllowEverybody,
+				DefaultInstantiatePermission: Everybody,
+				SecurityAddress:              invalidAddress,
+			},
+			expErr: true,
+		},
+		"all good with fee discounts": {
+			src: Params{
+				UploadAccess:                 AllowEverybody,
+				DefaultInstantiatePermission: Everybody,
+				FeeDiscounts: []FeeDiscount{
+					{Contract: anyAddress, Discount: sdk.OneDec()},
+					{Contract: otherAddress, Discount: sdk.NewDecWithPrec(5, 1)},
+				},
+			},
+		},
+		"reject duplicate fee discount contract": {
+			src: Params{
+				UploadAccess:                 AllowEverybody,
+				DefaultInstantiatePermission: Everybody,
+				FeeDiscounts: []FeeDiscount{
+					{Contract: anyAddress, Discount: sdk.OneDec()},
+					{Contract: anyAddress, Discount: sdk.NewDecWithPrec(5, 1)},
+				},
+			},
+			expErr: true,
+		},
+		"reject fee discount above 1": {
+			src: Params{
+				UploadAccess:                 AllowEverybody,
+				DefaultInstantiatePermission: Everybody,
+				FeeDiscounts:                 []FeeDiscount{{Contract: anyAddress, Discount: sdk.NewDecWithPrec(11, 1)}},
+			},
+			expErr: true,
+		},
+		"reject zero fee discount": {
+			src: Params{
+				UploadAccess:                 AllowEverybody,
+				DefaultInstantiatePermission: Everybody,
+				FeeDiscounts:                 []FeeDiscount{{Contract: anyAddress, Discount: sdk.ZeroDec()}},
+			},
+			expErr: true,
+		},
+		"all good with limits": {
+			src: Params{
+				UploadAccess:                 AllowEverybody,
+				DefaultInstantiatePermission: Everybody,
+				MaxWasmCodeSize:              MaxWasmSize,
+				MaxContractGas:               DefaultMaxContractGas,
+				GasMultiplier:                1,
+			},
+		},
+		"reject max wasm code size above hard limit": {
+			src: Params{
+				UploadAccess:                 AllowEverybody,
+				DefaultInstantiatePermission: Everybody,
+				MaxWasmCodeSize:              MaxWasmSize + 1,
+			},
+			expErr: true,
+		},
+		"reject max contract gas above hard limit": {
+			src: Params{
+				UploadAccess:                 AllowEverybody,
+				DefaultInstantiatePermission: Everybody,
+				MaxContractGas:               DefaultMaxContractGas + 1,
+			},
+			expErr: true,
+		},
+		"all good with developer fee share": {
+			src: Params{
+				UploadAccess:                 AllowEverybody,
+				DefaultInstantiatePermission: Everybody,
+				DeveloperFeeShare:            &halfShare,
+			},
+		},
+		"reject developer fee share above 1": {
+			src: Params{
+				UploadAccess:                 AllowEverybody,
+				DefaultInstantiatePermission: Everybody,
+				DeveloperFeeShare:            &aboveOneShare,
+			},
+			expErr: true,
+		},
+		"reject negative developer fee share": {
+			src: Params{
+				UploadAccess:                 AllowEverybody,
+				DefaultInstantiatePermission: Everybody,
+				DeveloperFeeShare:            &negativeShare,
+			},
+			expErr: true,
+		},
+		"reject fee discount with invalid contract": {
+			src: Params{
+				UploadAccess:                 AllowEverybody,
+				DefaultInstantiatePermission: Everybody,
+				FeeDiscounts:                 []FeeDiscount{{Contract: invalidAddress, Discount: sdk.OneDec()}},
+			},
+			expErr: true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -105,11 +259,12 @@ func TestAccessTypeMarshalJson(t *testing.T) {
 		src AccessType
 		exp string
 	}{
-		"Undefined":   {src: Undefined, exp: `"Undefined"`},
-		"Nobody":      {src: Nobody, exp: `"Nobody"`},
-		"OnlyAddress": {src: OnlyAddress, exp: `"OnlyAddress"`},
-		"Everybody":   {src: Everybody, exp: `"Everybody"`},
-		"unknown":     {src: "", exp: `"Undefined"`},
+		"Undefined":      {src: Undefined, exp: `"Undefined"`},
+		"Nobody":         {src: Nobody, exp: `"Nobody"`},
+		"OnlyAddress":    {src: OnlyAddress, exp: `"OnlyAddress"`},
+		"Everybody":      {src: Everybody, exp: `"Everybody"`},
+		"AnyOfAddresses": {src: AnyOfAddresses, exp: `"AnyOfAddresses"`},
+		"unknown":        {src: "", exp: `"Undefined"`},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -124,11 +279,12 @@ func TestAccessTypeUnMarshalJson(t *testing.T) {
 		src string
 		exp AccessType
 	}{
-		"Undefined":   {src: `"Undefined"`, exp: Undefined},
-		"Nobody":      {src: `"Nobody"`, exp: Nobody},
-		"OnlyAddress": {src: `"OnlyAddress"`, exp: OnlyAddress},
-		"Everybody":   {src: `"Everybody"`, exp: Everybody},
-		"unknown":     {src: `""`, exp: Undefined},
+		"Undefined":      {src: `"Undefined"`, exp: Undefined},
+		"Nobody":         {src: `"Nobody"`, exp: Nobody},
+		"OnlyAddress":    {src: `"OnlyAddress"`, exp: OnlyAddress},
+		"Everybody":      {src: `"Everybody"`, exp: Everybody},
+		"AnyOfAddresses": {src: `"AnyOfAddresses"`, exp: AnyOfAddresses},
+		"unknown":        {src: `""`, exp: Undefined},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
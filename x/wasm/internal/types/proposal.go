@@ -19,6 +19,12 @@ const (
 	ProposalTypeMigrateContract     ProposalType = "MigrateContract"
 	ProposalTypeUpdateAdmin         ProposalType = "UpdateAdmin"
 	ProposalTypeClearAdmin          ProposalType = "ClearAdmin"
+	ProposalTypePauseExecution      ProposalType = "PauseExecution"
+	ProposalTypeResumeExecution     ProposalType = "ResumeExecution"
+	ProposalTypeSetContractLimits   ProposalType = "SetContractLimits"
+	ProposalTypeScheduleExecution   ProposalType = "ScheduleExecution"
+	ProposalTypeUnscheduleExecution ProposalType = "UnscheduleExecution"
+	ProposalTypeSudoContract        ProposalType = "SudoContract"
 )
 
 // DisableAllProposals contains no wasm gov types.
@@ -31,6 +37,12 @@ var EnableAllProposals = []ProposalType{
 	ProposalTypeMigrateContract,
 	ProposalTypeUpdateAdmin,
 	ProposalTypeClearAdmin,
+	ProposalTypePauseExecution,
+	ProposalTypeResumeExecution,
+	ProposalTypeSetContractLimits,
+	ProposalTypeScheduleExecution,
+	ProposalTypeUnscheduleExecution,
+	ProposalTypeSudoContract,
 }
 
 // ConvertToProposals maps each key to a ProposalType and returns a typed list.
@@ -57,11 +69,23 @@ func init() { // register new content types with the sdk
 	govtypes.RegisterProposalType(string(ProposalTypeMigrateContract))
 	govtypes.RegisterProposalType(string(ProposalTypeUpdateAdmin))
 	govtypes.RegisterProposalType(string(ProposalTypeClearAdmin))
+	govtypes.RegisterProposalType(string(ProposalTypePauseExecution))
+	govtypes.RegisterProposalType(string(ProposalTypeResumeExecution))
+	govtypes.RegisterProposalType(string(ProposalTypeSetContractLimits))
+	govtypes.RegisterProposalType(string(ProposalTypeScheduleExecution))
+	govtypes.RegisterProposalType(string(ProposalTypeUnscheduleExecution))
+	govtypes.RegisterProposalType(string(ProposalTypeSudoContract))
 	govtypes.RegisterProposalTypeCodec(StoreCodeProposal{}, "wasm/StoreCodeProposal")
 	govtypes.RegisterProposalTypeCodec(InstantiateContractProposal{}, "wasm/InstantiateContractProposal")
 	govtypes.RegisterProposalTypeCodec(MigrateContractProposal{}, "wasm/MigrateContractProposal")
 	govtypes.RegisterProposalTypeCodec(UpdateAdminProposal{}, "wasm/UpdateAdminProposal")
 	govtypes.RegisterProposalTypeCodec(ClearAdminProposal{}, "wasm/ClearAdminProposal")
+	govtypes.RegisterProposalTypeCodec(PauseExecutionProposal{}, "wasm/PauseExecutionProposal")
+	govtypes.RegisterProposalTypeCodec(ResumeExecutionProposal{}, "wasm/ResumeExecutionProposal")
+	govtypes.RegisterProposalTypeCodec(SetContractLimitsProposal{}, "wasm/SetContractLimitsProposal")
+	govtypes.RegisterProposalTypeCodec(ScheduleExecutionProposal{}, "wasm/ScheduleExecutionProposal")
+	govtypes.RegisterProposalTypeCodec(UnscheduleExecutionProposal{}, "wasm/UnscheduleExecutionProposal")
+	govtypes.RegisterProposalTypeCodec(SudoContractProposal{}, "wasm/SudoContractProposal")
 }
 
 // WasmProposal contains common proposal data.
@@ -381,3 +405,239 @@ func (p ClearAdminProposal) String() string {
   Contract:    %s
 `, p.Title, p.Description, p.Contract)
 }
+
+// PauseExecutionProposal gov proposal content type to pause execution of a contract or of all contracts of a code.
+type PauseExecutionProposal struct {
+	WasmProposal `yaml:",inline"`
+
+	Contract sdk.AccAddress `json:"contract,omitempty" yaml:"contract"`
+	CodeID   uint64         `json:"code_id,omitempty" yaml:"code_id"`
+}
+
+// ProposalType returns the type
+func (p PauseExecutionProposal) ProposalType() string { return string(ProposalTypePauseExecution) }
+
+// ValidateBasic validates the proposal
+func (p PauseExecutionProposal) ValidateBasic() error {
+	if err := p.WasmProposal.ValidateBasic(); err != nil {
+		return err
+	}
+	return validatePauseTarget(p.Contract, p.CodeID)
+}
+
+// String implements the Stringer interface.
+func (p PauseExecutionProposal) String() string {
+	return fmt.Sprintf(`Pause Execution Proposal:
+  Title:       %s
+  Description: %s
+  Contract:    %s
+  Code id:     %d
+`, p.Title, p.Description, p.Contract, p.CodeID)
+}
+
+// ResumeExecutionProposal gov proposal content type to resume execution of a paused contract or code.
+type ResumeExecutionProposal struct {
+	WasmProposal `yaml:",inline"`
+
+	Contract sdk.AccAddress `json:"contract,omitempty" yaml:"contract"`
+	CodeID   uint64         `json:"code_id,omitempty" yaml:"code_id"`
+}
+
+// ProposalType returns the type
+func (p ResumeExecutionProposal) ProposalType() string { return string(ProposalTypeResumeExecution) }
+
+// ValidateBasic validates the proposal
+func (p ResumeExecutionProposal) ValidateBasic() error {
+	if err := p.WasmProposal.ValidateBasic(); err != nil {
+		return err
+	}
+	return validatePauseTarget(p.Contract, p.CodeID)
+}
+
+// String implements the Stringer interface.
+func (p ResumeExecutionProposal) String() string {
+	return fmt.Sprintf(`Resume Execution Proposal:
+  Title:       %s
+  Description: %s
+  Contract:    %s
+  Code id:     %d
+`, p.Title, p.Description, p.Contract, p.CodeID)
+}
+
+// SetContractLimitsProposal gov proposal content type to set the bounds of the limits of a contract.
+// Empty bounds remove them.
+type SetContractLimitsProposal struct {
+	WasmProposal `yaml:",inline"`
+
+	Contract sdk.AccAddress `json:"contract" yaml:"contract"`
+	Bounds   ContractLimits `json:"bounds" yaml:"bounds"`
+}
+
+// ProposalType returns the type
+func (p SetContractLimitsProposal) ProposalType() string {
+	return string(ProposalTypeSetContractLimits)
+}
+
+// ValidateBasic validates the proposal
+func (p SetContractLimitsProposal) ValidateBasic() error {
+	if err := p.WasmProposal.ValidateBasic(); err != nil {
+		return err
+	}
+	if err := sdk.VerifyAddressFormat(p.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return sdkerrors.Wrap(p.Bounds.ValidateBasic(), "bounds")
+}
+
+// String implements the Stringer interface.
+func (p SetContractLimitsProposal) String() string {
+	return fmt.Sprintf(`Set Contract Limits Proposal:
+  Title:                    %s
+  Description:              %s
+  Contract:                 %s
+  Max gas per execution:    %d
+  Max executions per block: %d
+`, p.Title, p.Description, p.Contract, p.Bounds.MaxGasPerExecution, p.Bounds.MaxExecutionsPerBlock)
+}
+
+// ScheduleExecutionProposal gov proposal content type to execute a contract at end block every interval blocks.
+// It replaces an existing schedule of the contract.
+type ScheduleExecutionProposal struct {
+	WasmProposal `yaml:",inline"`
+
+	Contract sdk.AccAddress  `json:"contract"`
+	Msg      json.RawMessage `json:"msg"`
+	Interval uint64          `json:"interval"`
+	GasLimit uint64          `json:"gas_limit"`
+}
+
+// ProposalType returns the type
+func (p ScheduleExecutionProposal) ProposalType() string {
+	return string(ProposalTypeScheduleExecution)
+}
+
+// ValidateBasic validates the proposal
+func (p ScheduleExecutionProposal) ValidateBasic() error {
+	if err := p.WasmProposal.ValidateBasic(); err != nil {
+		return err
+	}
+	return p.Schedule().ValidateBasic()
+}
+
+// Schedule returns the schedule without its next height, which is set on execution of the proposal
+func (p ScheduleExecutionProposal) Schedule() ContractSchedule {
+	return ContractSchedule{
+		Contract: p.Contract,
+		Msg:      p.Msg,
+		Interval: p.Interval,
+		GasLimit: p.GasLimit,
+	}
+}
+
+// String implements the Stringer interface.
+func (p ScheduleExecutionProposal) String() string {
+	return fmt.Sprintf(`Schedule Execution Proposal:
+  Title:       %s
+  Description: %s
+  Contract:    %s
+  Msg:         %q
+  Interval:    %d
+  Gas limit:   %d
+`, p.Title, p.Description, p.Contract, p.Msg, p.Interval, p.GasLimit)
+}
+
+func (p ScheduleExecutionProposal) MarshalYAML() (interface{}, error) {
+	return struct {
+		WasmProposal `yaml:",inline"`
+		Contract     sdk.AccAddress `yaml:"contract"`
+		Msg          string         `yaml:"msg"`
+		Interval     uint64         `yaml:"interval"`
+		GasLimit     uint64         `yaml:"gas_limit"`
+	}{
+		WasmProposal: p.WasmProposal,
+		Contract:     p.Contract,
+		Msg:          string(p.Msg),
+		Interval:     p.Interval,
+		GasLimit:     p.GasLimit,
+	}, nil
+}
+
+// UnscheduleExecutionProposal gov proposal content type to remove the schedule of a contract.
+type UnscheduleExecutionProposal struct {
+	WasmProposal `yaml:",inline"`
+
+	Contract sdk.AccAddress `json:"contract" yaml:"contract"`
+}
+
+// ProposalType returns the type
+func (p UnscheduleExecutionProposal) ProposalType() string {
+	return string(ProposalTypeUnscheduleExecution)
+}
+
+// ValidateBasic validates the proposal
+func (p UnscheduleExecutionProposal) ValidateBasic() error {
+	if err := p.WasmProposal.ValidateBasic(); err != nil {
+		return err
+	}
+	if err := sdk.VerifyAddressFormat(p.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p UnscheduleExecutionProposal) String() string {
+	return fmt.Sprintf(`Unschedule Execution Proposal:
+  Title:       %s
+  Description: %s
+  Contract:    %s
+`, p.Title, p.Description, p.Contract)
+}
+
+// SudoContractProposal gov proposal content type to call the privileged entry point of a contract, e.g. to adjust
+// protocol parameters held by a system contract.
+type SudoContractProposal struct {
+	WasmProposal `yaml:",inline"`
+
+	Contract sdk.AccAddress  `json:"contract"`
+	Msg      json.RawMessage `json:"msg"`
+}
+
+// ProposalType returns the type
+func (p SudoContractProposal) ProposalType() string { return string(ProposalTypeSudoContract) }
+
+// ValidateBasic validates the proposal
+func (p SudoContractProposal) ValidateBasic() error {
+	if err := p.WasmProposal.ValidateBasic(); err != nil {
+		return err
+	}
+	if err := sdk.VerifyAddressFormat(p.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if !json.Valid(p.Msg) {
+		return sdkerrors.Wrap(ErrInvalid, "msg json")
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p SudoContractProposal) String() string {
+	return fmt.Sprintf(`Sudo Contract Proposal:
+  Title:       %s
+  Description: %s
+  Contract:    %s
+  Msg:         %q
+`, p.Title, p.Description, p.Contract, p.Msg)
+}
+
+func (p SudoContractProposal) MarshalYAML() (interface{}, error) {
+	return struct {
+		WasmProposal `yaml:",inline"`
+		Contract     sdk.AccAddress `yaml:"contract"`
+		Msg          string         `yaml:"msg"`
+	}{
+		WasmProposal: p.WasmProposal,
+		Contract:     p.Contract,
+		Msg:          string(p.Msg),
+	}, nil
+}
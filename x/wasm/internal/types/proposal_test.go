@@ -427,6 +427,225 @@ func TestValidateClearAdminProposal(t *testing.T) {
 	}
 }
 
+func TestValidatePauseExecutionProposal(t *testing.T) {
+	var (
+		invalidAddress sdk.AccAddress = bytes.Repeat([]byte{0x1}, sdk.AddrLen-1)
+	)
+
+	specs := map[string]struct {
+		src    PauseExecutionProposal
+		expErr bool
+	}{
+		"all good": {
+			src: PauseExecutionProposalFixture(),
+		},
+		"all good with code id": {
+			src: PauseExecutionProposalFixture(func(p *PauseExecutionProposal) {
+				p.Contract = nil
+				p.CodeID = 1
+			}),
+		},
+		"base data missing": {
+			src: PauseExecutionProposalFixture(func(p *PauseExecutionProposal) {
+				p.WasmProposal = WasmProposal{}
+			}),
+			expErr: true,
+		},
+		"contract and code id missing": {
+			src: PauseExecutionProposalFixture(func(p *PauseExecutionProposal) {
+				p.Contract = nil
+			}),
+			expErr: true,
+		},
+		"contract and code id both set": {
+			src: PauseExecutionProposalFixture(func(p *PauseExecutionProposal) {
+				p.CodeID = 1
+			}),
+			expErr: true,
+		},
+		"contract invalid": {
+			src: PauseExecutionProposalFixture(func(p *PauseExecutionProposal) {
+				p.Contract = invalidAddress
+			}),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSetContractLimitsProposal(t *testing.T) {
+	var (
+		invalidAddress sdk.AccAddress = bytes.Repeat([]byte{0x1}, sdk.AddrLen-1)
+	)
+
+	specs := map[string]struct {
+		src    SetContractLimitsProposal
+		expErr bool
+	}{
+		"all good": {
+			src: SetContractLimitsProposalFixture(),
+		},
+		"empty bounds": {
+			src: SetContractLimitsProposalFixture(func(p *SetContractLimitsProposal) {
+				p.Bounds = ContractLimits{}
+			}),
+		},
+		"base data missing": {
+			src: SetContractLimitsProposalFixture(func(p *SetContractLimitsProposal) {
+				p.WasmProposal = WasmProposal{}
+			}),
+			expErr: true,
+		},
+		"contract missing": {
+			src: SetContractLimitsProposalFixture(func(p *SetContractLimitsProposal) {
+				p.Contract = nil
+			}),
+			expErr: true,
+		},
+		"contract invalid": {
+			src: SetContractLimitsProposalFixture(func(p *SetContractLimitsProposal) {
+				p.Contract = invalidAddress
+			}),
+			expErr: true,
+		},
+		"max gas above max contract gas": {
+			src: SetContractLimitsProposalFixture(func(p *SetContractLimitsProposal) {
+				p.Bounds.MaxGasPerExecution = DefaultMaxContractGas + 1
+			}),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateScheduleExecutionProposal(t *testing.T) {
+	var (
+		invalidAddress sdk.AccAddress = bytes.Repeat([]byte{0x1}, sdk.AddrLen-1)
+	)
+
+	specs := map[string]struct {
+		src    ScheduleExecutionProposal
+		expErr bool
+	}{
+		"all good": {
+			src: ScheduleExecutionProposalFixture(),
+		},
+		"base data missing": {
+			src: ScheduleExecutionProposalFixture(func(p *ScheduleExecutionProposal) {
+				p.WasmProposal = WasmProposal{}
+			}),
+			expErr: true,
+		},
+		"contract invalid": {
+			src: ScheduleExecutionProposalFixture(func(p *ScheduleExecutionProposal) {
+				p.Contract = invalidAddress
+			}),
+			expErr: true,
+		},
+		"msg not json": {
+			src: ScheduleExecutionProposalFixture(func(p *ScheduleExecutionProposal) {
+				p.Msg = []byte("cron")
+			}),
+			expErr: true,
+		},
+		"interval missing": {
+			src: ScheduleExecutionProposalFixture(func(p *ScheduleExecutionProposal) {
+				p.Interval = 0
+			}),
+			expErr: true,
+		},
+		"gas limit missing": {
+			src: ScheduleExecutionProposalFixture(func(p *ScheduleExecutionProposal) {
+				p.GasLimit = 0
+			}),
+			expErr: true,
+		},
+		"gas limit too high": {
+			src: ScheduleExecutionProposalFixture(func(p *ScheduleExecutionProposal) {
+				p.GasLimit = MaxScheduleGasLimit + 1
+			}),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSudoContractProposal(t *testing.T) {
+	var (
+		invalidAddress sdk.AccAddress = bytes.Repeat([]byte{0x1}, sdk.AddrLen-1)
+	)
+
+	specs := map[string]struct {
+		src    SudoContractProposal
+		expErr bool
+	}{
+		"all good": {
+			src: SudoContractProposalFixture(),
+		},
+		"base data missing": {
+			src: SudoContractProposalFixture(func(p *SudoContractProposal) {
+				p.WasmProposal = WasmProposal{}
+			}),
+			expErr: true,
+		},
+		"contract missing": {
+			src: SudoContractProposalFixture(func(p *SudoContractProposal) {
+				p.Contract = nil
+			}),
+			expErr: true,
+		},
+		"contract invalid": {
+			src: SudoContractProposalFixture(func(p *SudoContractProposal) {
+				p.Contract = invalidAddress
+			}),
+			expErr: true,
+		},
+		"msg not json": {
+			src: SudoContractProposalFixture(func(p *SudoContractProposal) {
+				p.Msg = []byte("set_fee")
+			}),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestProposalStrings(t *testing.T) {
 	specs := map[string]struct {
 		src gov.Content
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"encoding/hex"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+// ContractProvenance is the creation of a contract, the original creator is ContractInfo.Creator. It is kept through
+// genesis exports, so it refers to the chain the contract was instantiated on.
+type ContractProvenance struct {
+	// BlockHeight is the block the contract was instantiated in
+	BlockHeight int64 `json:"block_height" yaml:"block_height"`
+	// TxHash is the hash of the instantiate tx, empty when the contract was not instantiated by a tx, e.g. by a
+	// governance proposal
+	TxHash string `json:"tx_hash,omitempty" yaml:"tx_hash"`
+}
+
+func (p ContractProvenance) ValidateBasic() error {
+	if p.BlockHeight < 0 {
+		return sdkerrors.Wrap(ErrInvalid, "block height")
+	}
+	if p.TxHash == "" {
+		return nil
+	}
+	if bz, err := hex.DecodeString(p.TxHash); err != nil || len(bz) != tmhash.Size {
+		return sdkerrors.Wrap(ErrInvalid, "tx hash")
+	}
+	return nil
+}
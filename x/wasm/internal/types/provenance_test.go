@@ -0,0 +1,44 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestContractProvenanceValidateBasic(t *testing.T) {
+	specs := map[string]struct {
+		src    ContractProvenance
+		expErr bool
+	}{
+		"all good": {
+			src: ContractProvenance{BlockHeight: 1, TxHash: strings.Repeat("AB", 32)},
+		},
+		"without tx": {
+			src: ContractProvenance{BlockHeight: 1},
+		},
+		"negative height": {
+			src:    ContractProvenance{BlockHeight: -1},
+			expErr: true,
+		},
+		"tx hash not hex": {
+			src:    ContractProvenance{BlockHeight: 1, TxHash: strings.Repeat("XY", 32)},
+			expErr: true,
+		},
+		"tx hash too short": {
+			src:    ContractProvenance{BlockHeight: 1, TxHash: strings.Repeat("AB", 31)},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
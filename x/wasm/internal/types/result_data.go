@@ -0,0 +1,43 @@
+package types
+
+import (
+	"encoding/base64"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// WithResultData appends the data returned by a contract base64 encoded to the message event. Empty data is left out.
+func WithResultData(e sdk.Event, data []byte) sdk.Event {
+	if len(data) == 0 {
+		return e
+	}
+	return e.AppendAttributes(sdk.NewAttribute(AttributeKeyResultData, base64.StdEncoding.EncodeToString(data)))
+}
+
+// ParseResultData returns the contract result data of the msg with the index in the logs of a tx, nil when the
+// contract returned none. The event of the msg is the last message event of its log, the result data before it is the
+// one of the executions of a MsgExec for which the msg returns the data of the last execution.
+func ParseResultData(logs sdk.ABCIMessageLogs, msgIndex uint16) ([]byte, error) {
+	for _, log := range logs {
+		if log.MsgIndex != msgIndex {
+			continue
+		}
+		var data string
+		for _, e := range log.Events {
+			if e.Type != sdk.EventTypeMessage {
+				continue
+			}
+			for _, a := range e.Attributes {
+				if a.Key == AttributeKeyResultData {
+					data = a.Value
+				}
+			}
+		}
+		if data == "" {
+			return nil, nil
+		}
+		return base64.StdEncoding.DecodeString(data)
+	}
+	return nil, sdkerrors.Wrapf(ErrNotFound, "log of msg %d", msgIndex)
+}
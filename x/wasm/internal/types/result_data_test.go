@@ -0,0 +1,74 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResultData(t *testing.T) {
+	specs := map[string]struct {
+		src []byte
+		exp []byte
+	}{
+		"text": {
+			src: []byte("burnt 1 keys"),
+			exp: []byte("burnt 1 keys"),
+		},
+		"binary": {
+			src: []byte{0xf0, 0x0b, 0xaa},
+			exp: []byte{0xf0, 0x0b, 0xaa},
+		},
+		"empty": {
+			src: []byte{},
+		},
+		"nil": {},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			event := WithResultData(sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName)), spec.src)
+			if len(spec.exp) == 0 {
+				assert.Len(t, event.Attributes, 1)
+			}
+			logs := sdk.ABCIMessageLogs{sdk.NewABCIMessageLog(0, "", sdk.Events{event})}
+			got, err := ParseResultData(logs, 0)
+			require.NoError(t, err)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
+
+func TestParseResultDataOfMsg(t *testing.T) {
+	event := func(data string) sdk.Event {
+		return WithResultData(sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName)), []byte(data))
+	}
+	logs := sdk.ABCIMessageLogs{
+		sdk.NewABCIMessageLog(0, "", sdk.Events{event("first")}),
+		sdk.NewABCIMessageLog(1, "", sdk.Events{sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyAction, "send"))}),
+		// the executions of a MsgExec
+		sdk.NewABCIMessageLog(2, "", sdk.Events{event("exec 1"), event("exec 2")}),
+	}
+	specs := map[string]struct {
+		src    uint16
+		exp    []byte
+		expErr bool
+	}{
+		"first msg":         {src: 0, exp: []byte("first")},
+		"msg without data":  {src: 1},
+		"last execution":    {src: 2, exp: []byte("exec 2")},
+		"msg index unknown": {src: 3, expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, err := ParseResultData(logs, spec.src)
+			if spec.expErr {
+				assert.True(t, ErrNotFound.Is(err), err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
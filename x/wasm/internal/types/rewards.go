@@ -0,0 +1,34 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+const (
+	// RewardsPoolName is the name the address of the rewards pool is derived from
+	RewardsPoolName = "wasm_rewards"
+
+	// EventTypeContractRewards is emitted when a share of the tx fee was accrued for a contract
+	EventTypeContractRewards = "contract_rewards"
+)
+
+// RewardsPoolAddress holds the accrued contract rewards until they are withdrawn. It has no private key.
+var RewardsPoolAddress = sdk.AccAddress(crypto.AddressHash([]byte(RewardsPoolName)))
+
+// ContractRewards are the not yet withdrawn rewards of a reward address
+type ContractRewards struct {
+	RewardAddress sdk.AccAddress `json:"reward_address" yaml:"reward_address"`
+	Amount        sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+func (r ContractRewards) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(r.RewardAddress); err != nil {
+		return sdkerrors.Wrap(err, "reward address")
+	}
+	if !r.Amount.IsValid() || r.Amount.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "amount")
+	}
+	return nil
+}
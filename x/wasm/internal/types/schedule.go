@@ -0,0 +1,60 @@
+package types
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+const (
+	// SchedulerName is the name the sender address of scheduled executions is derived from
+	SchedulerName = "wasm_scheduler"
+
+	// MaxScheduleGasLimit is the highest sdk gas limit of a single scheduled execution
+	MaxScheduleGasLimit = 10000000
+
+	// EventTypeScheduledExecution is emitted for every scheduled execution at end block
+	EventTypeScheduledExecution = "scheduled_execution"
+	// AttributeKeyGasUsed is the sdk gas used by a scheduled execution
+	AttributeKeyGasUsed = "gas_used"
+	// AttributeKeyError is the error of a failed scheduled execution
+	AttributeKeyError = "error"
+)
+
+// SchedulerAddress is the sender of scheduled executions, so that contracts can tell them apart from executions by
+// accounts. It has no private key.
+var SchedulerAddress = sdk.AccAddress(crypto.AddressHash([]byte(SchedulerName)))
+
+// ContractSchedule executes a contract with Msg at end block every Interval blocks, as registered by governance
+type ContractSchedule struct {
+	Contract sdk.AccAddress `json:"contract" yaml:"contract"`
+	// Msg is the json encoded handle msg, e.g. {"cron":{}}
+	Msg json.RawMessage `json:"msg" yaml:"msg"`
+	// Interval is the number of blocks between two executions
+	Interval uint64 `json:"interval" yaml:"interval"`
+	// GasLimit is the sdk gas a single execution may use, at most MaxScheduleGasLimit
+	GasLimit uint64 `json:"gas_limit" yaml:"gas_limit"`
+	// NextHeight is the height of the next execution
+	NextHeight uint64 `json:"next_height" yaml:"next_height"`
+}
+
+func (s ContractSchedule) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(s.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if !json.Valid(s.Msg) {
+		return sdkerrors.Wrap(ErrInvalid, "msg json")
+	}
+	if s.Interval == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "interval")
+	}
+	if s.GasLimit == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "gas limit")
+	}
+	if s.GasLimit > MaxScheduleGasLimit {
+		return sdkerrors.Wrapf(ErrLimit, "gas limit cannot be bigger than %d", MaxScheduleGasLimit)
+	}
+	return nil
+}
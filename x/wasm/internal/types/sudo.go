@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// SudoName is the name the sender address of sudo calls is derived from
+const SudoName = "wasm_sudo"
+
+// SudoAddress is the sender of the sudo calls decided by governance. Contracts authorize their privileged msgs by this
+// sender. It has no private key.
+var SudoAddress = sdk.AccAddress(crypto.AddressHash([]byte(SudoName)))
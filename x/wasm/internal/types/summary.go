@@ -0,0 +1,71 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// SummaryChainIDSuffix is appended to the chain id of summary sign bytes, so that a summary signature can never be
+// valid for the full sign bytes of another tx
+const SummaryChainIDSuffix = "/wasm-summary"
+
+// SummarySignBytes returns the sign bytes of the tx with the payloads of the wasm msgs, i.e. the byte code and the
+// contract msgs, replaced by their sha256 checksums. They are small enough to be displayed and signed by hardware
+// wallets whatever the size of the payloads. False is returned when the msgs have no payload to summarize.
+func SummarySignBytes(chainID string, accnum, sequence uint64, fee authtypes.StdFee, msgs []sdk.Msg, memo string) ([]byte, bool) {
+	summaries := make([]sdk.Msg, len(msgs))
+	var summarized bool
+	for i, msg := range msgs {
+		var ok bool
+		summaries[i], ok = SummarizeMsg(msg)
+		summarized = summarized || ok
+	}
+	if !summarized {
+		return nil, false
+	}
+	return authtypes.StdSignBytes(chainID+SummaryChainIDSuffix, accnum, sequence, fee, summaries, memo), true
+}
+
+// SummarizeMsg returns a copy of the msg with the payloads replaced by their checksums. The byte code is replaced by
+// its 32 bytes checksum and the json msgs by {"sha256":"<hex checksum>"}. False is returned for msgs without payload.
+func SummarizeMsg(msg sdk.Msg) (sdk.Msg, bool) {
+	switch m := msg.(type) {
+	case MsgStoreCode:
+		checksum := sha256.Sum256(m.WASMByteCode)
+		m.WASMByteCode = checksum[:]
+		return m, true
+	case MsgInstantiateContract:
+		m.InitMsg = payloadSummary(m.InitMsg)
+		return m, true
+	case MsgExecuteContract:
+		m.Msg = payloadSummary(m.Msg)
+		return m, true
+	case MsgMigrateContract:
+		m.MigrateMsg = payloadSummary(m.MigrateMsg)
+		return m, true
+	case MsgExec:
+		msgs := make([]MsgExecuteContract, len(m.Msgs))
+		for i, e := range m.Msgs {
+			e.Msg = payloadSummary(e.Msg)
+			msgs[i] = e
+		}
+		m.Msgs = msgs
+		return m, true
+	default:
+		return msg, false
+	}
+}
+
+// PayloadChecksum returns the hex encoded sha256 checksum that replaces the payload in the summary sign bytes
+func PayloadChecksum(payload []byte) string {
+	checksum := sha256.Sum256(payload)
+	return hex.EncodeToString(checksum[:])
+}
+
+func payloadSummary(payload []byte) []byte {
+	return []byte(fmt.Sprintf(`{"sha256":%q}`, PayloadChecksum(payload)))
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSummarySignBytes(t *testing.T) {
+	var (
+		anyAddress = sdk.AccAddress(make([]byte, sdk.AddrLen))
+		largeMsg   = []byte(`{"release":{"note":"` + string(bytes.Repeat([]byte("a"), 10000)) + `"}}`)
+	)
+	specs := map[string]struct {
+		srcMsgs    []sdk.Msg
+		expOK      bool
+		expPayload string
+	}{
+		"store code": {
+			srcMsgs: []sdk.Msg{MsgStoreCode{Sender: anyAddress, WASMByteCode: bytes.Repeat([]byte{1}, 10000)}},
+			expOK:   true,
+		},
+		"execute": {
+			srcMsgs:    []sdk.Msg{MsgExecuteContract{Sender: anyAddress, Contract: anyAddress, Msg: largeMsg}},
+			expOK:      true,
+			expPayload: PayloadChecksum(largeMsg),
+		},
+		"exec grant": {
+			srcMsgs:    []sdk.Msg{MsgExec{Grantee: anyAddress, Msgs: []MsgExecuteContract{{Sender: anyAddress, Contract: anyAddress, Msg: largeMsg}}}},
+			expOK:      true,
+			expPayload: PayloadChecksum(largeMsg),
+		},
+		"wasm msg without payload": {
+			srcMsgs: []sdk.Msg{MsgClearAdmin{Sender: anyAddress, Contract: anyAddress}},
+		},
+		"other msg": {
+			srcMsgs: []sdk.Msg{bank.MsgSend{FromAddress: anyAddress, ToAddress: anyAddress}},
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			bz, ok := SummarySignBytes("testing", 1, 2, authtypes.NewStdFee(100000, nil), spec.srcMsgs, "memo")
+			require.Equal(t, spec.expOK, ok)
+			if !ok {
+				return
+			}
+			assert.True(t, json.Valid(bz))
+			assert.Less(t, len(bz), 1000)
+			assert.Contains(t, string(bz), `"chain_id":"testing`+SummaryChainIDSuffix+`"`)
+			if spec.expPayload != "" {
+				assert.Contains(t, string(bz), spec.expPayload)
+			}
+		})
+	}
+}
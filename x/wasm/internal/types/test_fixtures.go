@@ -241,3 +241,84 @@ func ClearAdminProposalFixture(mutators ...func(p *ClearAdminProposal)) ClearAdm
 	}
 	return p
 }
+
+func PauseExecutionProposalFixture(mutators ...func(p *PauseExecutionProposal)) PauseExecutionProposal {
+	contractAddr, err := sdk.AccAddressFromBech32("fetch13k6l84d7ceu744p660zy3zgtsz93v976zfuqml")
+	if err != nil {
+		panic(err)
+	}
+
+	p := PauseExecutionProposal{
+		WasmProposal: WasmProposal{
+			Title:       "Foo",
+			Description: "Bar",
+		},
+		Contract: contractAddr,
+	}
+	for _, m := range mutators {
+		m(&p)
+	}
+	return p
+}
+
+func SetContractLimitsProposalFixture(mutators ...func(p *SetContractLimitsProposal)) SetContractLimitsProposal {
+	contractAddr, err := sdk.AccAddressFromBech32("fetch13k6l84d7ceu744p660zy3zgtsz93v976zfuqml")
+	if err != nil {
+		panic(err)
+	}
+
+	p := SetContractLimitsProposal{
+		WasmProposal: WasmProposal{
+			Title:       "Foo",
+			Description: "Bar",
+		},
+		Contract: contractAddr,
+		Bounds:   ContractLimits{MaxGasPerExecution: 1000000, MaxExecutionsPerBlock: 10},
+	}
+	for _, m := range mutators {
+		m(&p)
+	}
+	return p
+}
+
+func ScheduleExecutionProposalFixture(mutators ...func(p *ScheduleExecutionProposal)) ScheduleExecutionProposal {
+	contractAddr, err := sdk.AccAddressFromBech32("fetch13k6l84d7ceu744p660zy3zgtsz93v976zfuqml")
+	if err != nil {
+		panic(err)
+	}
+
+	p := ScheduleExecutionProposal{
+		WasmProposal: WasmProposal{
+			Title:       "Foo",
+			Description: "Bar",
+		},
+		Contract: contractAddr,
+		Msg:      []byte(`{"cron":{}}`),
+		Interval: 100,
+		GasLimit: 1000000,
+	}
+	for _, m := range mutators {
+		m(&p)
+	}
+	return p
+}
+
+func SudoContractProposalFixture(mutators ...func(p *SudoContractProposal)) SudoContractProposal {
+	contractAddr, err := sdk.AccAddressFromBech32("fetch13k6l84d7ceu744p660zy3zgtsz93v976zfuqml")
+	if err != nil {
+		panic(err)
+	}
+
+	p := SudoContractProposal{
+		WasmProposal: WasmProposal{
+			Title:       "Foo",
+			Description: "Bar",
+		},
+		Contract: contractAddr,
+		Msg:      []byte(`{"set_fee":{"amount":"10"}}`),
+	}
+	for _, m := range mutators {
+		m(&p)
+	}
+	return p
+}
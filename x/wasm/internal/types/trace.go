@@ -0,0 +1,44 @@
+package types
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmBytes "github.com/tendermint/tendermint/libs/bytes"
+)
+
+// host functions of a HostCall
+const (
+	HostFunctionDBRead     = "db_read"
+	HostFunctionDBWrite    = "db_write"
+	HostFunctionDBRemove   = "db_remove"
+	HostFunctionDBScan     = "db_scan"
+	HostFunctionQueryChain = "query_chain"
+)
+
+// TxTrace is the record of the host function calls of the contracts a tx called, see WasmConfig.ContractTraceMode
+type TxTrace struct {
+	TxHash string              `json:"tx_hash"`
+	Height int64               `json:"height"`
+	Calls  []ContractCallTrace `json:"calls"`
+}
+
+// ContractCallTrace is the record of a single instantiate, execute, migrate or query call of a contract. Calls of
+// other contracts by sub queries are separate traces.
+type ContractCallTrace struct {
+	Contract  sdk.AccAddress `json:"contract_address"`
+	Operation string         `json:"operation"`
+	HostCalls []HostCall     `json:"host_calls"`
+}
+
+// HostCall is a call of the contract to the chain with the sdk gas it consumed
+type HostCall struct {
+	Function string `json:"function"`
+	// Key is the key of a store call, the start of a scan
+	Key tmBytes.HexBytes `json:"key,omitempty"`
+	// End is the end of a scan
+	End tmBytes.HexBytes `json:"end,omitempty"`
+	// Request is the query of a query_chain call
+	Request json.RawMessage `json:"request,omitempty"`
+	Gas     uint64          `json:"gas"`
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"regexp"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	tmBytes "github.com/tendermint/tendermint/libs/bytes"
@@ -35,6 +36,8 @@ type CodeInfo struct {
 	Source            string         `json:"source"`
 	Builder           string         `json:"builder"`
 	InstantiateConfig AccessConfig   `json:"instantiate_config"`
+	// InterfaceVersion is the CosmWasm interface version exported by the code, empty for DefaultInterfaceVersion
+	InterfaceVersion string `json:"interface_version,omitempty"`
 }
 
 func (c CodeInfo) ValidateBasic() error {
@@ -57,16 +60,25 @@ func (c CodeInfo) ValidateBasic() error {
 }
 
 // NewCodeInfo fills a new Contract struct
-func NewCodeInfo(codeHash []byte, creator sdk.AccAddress, source string, builder string, instantiatePermission AccessConfig) CodeInfo {
+func NewCodeInfo(codeHash []byte, creator sdk.AccAddress, source string, builder string, instantiatePermission AccessConfig, interfaceVersion string) CodeInfo {
 	return CodeInfo{
 		CodeHash:          codeHash,
 		Creator:           creator,
 		Source:            source,
 		Builder:           builder,
 		InstantiateConfig: instantiatePermission,
+		InterfaceVersion:  interfaceVersion,
 	}
 }
 
+// GetInterfaceVersion returns the interface version of the code, DefaultInterfaceVersion when it was not recorded
+func (c CodeInfo) GetInterfaceVersion() string {
+	if c.InterfaceVersion == "" {
+		return DefaultInterfaceVersion
+	}
+	return c.InterfaceVersion
+}
+
 type ContractCodeHistoryOperationType string
 
 const (
@@ -228,27 +240,82 @@ func NewWasmCoins(cosmosCoins sdk.Coins) (wasmCoins []wasmTypes.Coin) {
 const CustomEventType = "wasm"
 const AttributeKeyContractAddr = "contract_address"
 
-// ParseEvents converts wasm LogAttributes into an sdk.Events (with 0 or 1 elements)
+// AttributeKeyEventType is the reserved log key a contract uses to start a custom event.
+// All following attributes, up to the next such key, belong to an event of type "wasm-<value>".
+const AttributeKeyEventType = "_event_type"
+
+// MaxCustomEventTypeSize is the longest custom event type a contract can define
+const MaxCustomEventTypeSize = 64
+
+var customEventTypeRegexp = regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`)
+
+// ContractEventType returns the event type namespaced for a single contract
+func ContractEventType(contractAddr sdk.AccAddress) string {
+	return CustomEventType + "-" + contractAddr.String()
+}
+
+// ParseEvents converts wasm LogAttributes into sdk.Events.
+//
+// Attributes logged before any custom event are emitted twice: in the generic "wasm" event and in
+// the "wasm-<contract_addr>" event so that indexers can filter by contract without collisions.
+// Contracts can open custom events with the AttributeKeyEventType log key. Each event is tagged with
+// the contract address issuing it.
 func ParseEvents(logs []wasmTypes.LogAttribute, contractAddr sdk.AccAddress) sdk.Events {
 	if len(logs) == 0 {
 		return nil
 	}
+	contractAttr := sdk.NewAttribute(AttributeKeyContractAddr, contractAddr.String())
+
 	// we always tag with the contract address issuing this event
-	attrs := []sdk.Attribute{sdk.NewAttribute(AttributeKeyContractAddr, contractAddr.String())}
+	attrs := []sdk.Attribute{contractAttr}
+	var custom sdk.Events
 	for _, l := range logs {
+		if l.Key == AttributeKeyEventType && validCustomEventType(l.Value) {
+			custom = append(custom, sdk.NewEvent(CustomEventType+"-"+l.Value, contractAttr))
+			continue
+		}
 		// and reserve the contract_address key for our use (not contract)
-		if l.Key != AttributeKeyContractAddr {
-			attr := sdk.NewAttribute(l.Key, l.Value)
-			attrs = append(attrs, attr)
+		if l.Key == AttributeKeyContractAddr {
+			continue
 		}
+		attr := sdk.NewAttribute(l.Key, l.Value)
+		if len(custom) != 0 {
+			last := &custom[len(custom)-1]
+			last.Attributes = append(last.Attributes, attr.ToKVPair())
+			continue
+		}
+		attrs = append(attrs, attr)
+	}
+	events := sdk.Events{
+		sdk.NewEvent(CustomEventType, attrs...),
+		sdk.NewEvent(ContractEventType(contractAddr), attrs...),
+	}
+	return append(events, custom...)
+}
+
+func validCustomEventType(s string) bool {
+	if len(s) > MaxCustomEventTypeSize || !customEventTypeRegexp.MatchString(s) {
+		return false
 	}
-	return sdk.Events{sdk.NewEvent(CustomEventType, attrs...)}
+	// must not spoof the namespace of another contract
+	_, err := sdk.AccAddressFromBech32(s)
+	return err != nil
 }
 
-// WasmConfig is the extra config required for wasm
+// WasmConfig is the [wasm] section of app.toml. It is node local and read once at app construction.
 type WasmConfig struct {
+	// SmartQueryGasLimit is the max sdk gas a smart query can consume
 	SmartQueryGasLimit uint64 `mapstructure:"query_gas_limit"`
-	CacheSize          uint64 `mapstructure:"lru_size"`
+	// CacheSize is the number of wasm vm instances kept in memory
+	CacheSize uint64 `mapstructure:"lru_size"`
+	// CacheDir is the directory of the compiled contract cache, empty for the default `<home>/wasm/wasm`.
+	// A relative path is resolved against the node home by the app.
+	CacheDir string `mapstructure:"cache_dir"`
+	// ContractDebugMode logs every contract call with its wasm gas and error
+	ContractDebugMode bool `mapstructure:"contract_debug_mode"`
+	// ContractTraceMode records the host function calls of the contracts called by delivered txs, with their keys and
+	// gas, served by the tx-trace query
+	ContractTraceMode bool `mapstructure:"contract_trace_mode"`
 }
 
 // DefaultWasmConfig returns the default settings for WasmConfig
@@ -258,3 +325,11 @@ func DefaultWasmConfig() WasmConfig {
 		CacheSize:          defaultLRUCacheSize,
 	}
 }
+
+// ValidateBasic checks the config for values the node can not run with
+func (c WasmConfig) ValidateBasic() error {
+	if c.SmartQueryGasLimit == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "query_gas_limit must be greater than 0")
+	}
+	return nil
+}
@@ -1,10 +1,13 @@
 package types
 
 import (
+	"bytes"
 	"strings"
 	"testing"
 
+	wasmTypes "github.com/CosmWasm/go-cosmwasm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -118,3 +121,96 @@ func TestCodeInfoValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestWasmConfigValidateBasic(t *testing.T) {
+	specs := map[string]struct {
+		srcMutator func(*WasmConfig)
+		expError   bool
+	}{
+		"default": {srcMutator: func(_ *WasmConfig) {}},
+		"all set": {
+			srcMutator: func(c *WasmConfig) {
+				c.CacheSize = 10
+				c.CacheDir = "/var/lib/fetchd/wasm"
+				c.ContractDebugMode = true
+			},
+		},
+		"query gas limit zero": {
+			srcMutator: func(c *WasmConfig) { c.SmartQueryGasLimit = 0 },
+			expError:   true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			cfg := DefaultWasmConfig()
+			spec.srcMutator(&cfg)
+			got := cfg.ValidateBasic()
+			if spec.expError {
+				require.Error(t, got)
+				return
+			}
+			require.NoError(t, got)
+		})
+	}
+}
+
+func TestParseEvents(t *testing.T) {
+	var myContract sdk.AccAddress = bytes.Repeat([]byte{0x1}, sdk.AddrLen)
+	var otherContract sdk.AccAddress = bytes.Repeat([]byte{0x2}, sdk.AddrLen)
+	contractAttr := sdk.NewAttribute(AttributeKeyContractAddr, myContract.String())
+
+	specs := map[string]struct {
+		src []wasmTypes.LogAttribute
+		exp sdk.Events
+	}{
+		"no logs": {},
+		"default attributes": {
+			src: []wasmTypes.LogAttribute{{Key: "action", Value: "release"}},
+			exp: sdk.Events{
+				sdk.NewEvent("wasm", contractAttr, sdk.NewAttribute("action", "release")),
+				sdk.NewEvent("wasm-"+myContract.String(), contractAttr, sdk.NewAttribute("action", "release")),
+			},
+		},
+		"contract address key reserved": {
+			src: []wasmTypes.LogAttribute{{Key: AttributeKeyContractAddr, Value: otherContract.String()}},
+			exp: sdk.Events{
+				sdk.NewEvent("wasm", contractAttr),
+				sdk.NewEvent("wasm-"+myContract.String(), contractAttr),
+			},
+		},
+		"custom events": {
+			src: []wasmTypes.LogAttribute{
+				{Key: "action", Value: "transfer"},
+				{Key: AttributeKeyEventType, Value: "transfer"},
+				{Key: "amount", Value: "1"},
+				{Key: AttributeKeyEventType, Value: "fee"},
+				{Key: "amount", Value: "2"},
+			},
+			exp: sdk.Events{
+				sdk.NewEvent("wasm", contractAttr, sdk.NewAttribute("action", "transfer")),
+				sdk.NewEvent("wasm-"+myContract.String(), contractAttr, sdk.NewAttribute("action", "transfer")),
+				sdk.NewEvent("wasm-transfer", contractAttr, sdk.NewAttribute("amount", "1")),
+				sdk.NewEvent("wasm-fee", contractAttr, sdk.NewAttribute("amount", "2")),
+			},
+		},
+		"invalid custom event type kept as attribute": {
+			src: []wasmTypes.LogAttribute{{Key: AttributeKeyEventType, Value: "with space"}},
+			exp: sdk.Events{
+				sdk.NewEvent("wasm", contractAttr, sdk.NewAttribute(AttributeKeyEventType, "with space")),
+				sdk.NewEvent("wasm-"+myContract.String(), contractAttr, sdk.NewAttribute(AttributeKeyEventType, "with space")),
+			},
+		},
+		"other contract namespace rejected": {
+			src: []wasmTypes.LogAttribute{{Key: AttributeKeyEventType, Value: otherContract.String()}},
+			exp: sdk.Events{
+				sdk.NewEvent("wasm", contractAttr, sdk.NewAttribute(AttributeKeyEventType, otherContract.String())),
+				sdk.NewEvent("wasm-"+myContract.String(), contractAttr, sdk.NewAttribute(AttributeKeyEventType, otherContract.String())),
+			},
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			assert.Equal(t, spec.exp, ParseEvents(spec.src, myContract))
+		})
+	}
+}
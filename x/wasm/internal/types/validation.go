@@ -4,6 +4,7 @@ import (
 	"net/url"
 	"regexp"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -78,3 +79,16 @@ func validateLabel(label string) error {
 	}
 	return nil
 }
+
+// validatePauseTarget ensures exactly one of a contract address or a code id is given
+func validatePauseTarget(contract sdk.AccAddress, codeID uint64) error {
+	switch {
+	case len(contract) == 0 && codeID == 0:
+		return sdkerrors.Wrap(ErrEmpty, "contract or code id is required")
+	case len(contract) != 0 && codeID != 0:
+		return sdkerrors.Wrap(ErrInvalid, "only one of contract or code id can be set")
+	case len(contract) != 0:
+		return sdkerrors.Wrap(sdk.VerifyAddressFormat(contract), "contract")
+	}
+	return nil
+}
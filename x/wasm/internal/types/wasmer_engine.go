@@ -0,0 +1,31 @@
+package types
+
+import (
+	wasm "github.com/CosmWasm/go-cosmwasm"
+	wasmTypes "github.com/CosmWasm/go-cosmwasm/types"
+)
+
+const (
+	// InterfaceVersion3 is the interface version of CosmWasm 0.10 contracts, as run by go-cosmwasm 0.10
+	InterfaceVersion3 = "cosmwasm_vm_version_3"
+	// DefaultInterfaceVersion is the interface version of codes stored before it was recorded
+	DefaultInterfaceVersion = InterfaceVersion3
+)
+
+// WasmerEngine defines the VM of a contract interface version. The keeper routes every call to the VM of the
+// interface version of the code, so that a node can host VMs for multiple versions.
+type WasmerEngine interface {
+	// Create stores and compiles the wasm code and returns its checksum
+	Create(code wasm.WasmCode) (wasm.CodeID, error)
+	// GetCode returns the wasm code with the given checksum
+	GetCode(code wasm.CodeID) (wasm.WasmCode, error)
+
+	Instantiate(code wasm.CodeID, env wasmTypes.Env, initMsg []byte, store wasm.KVStore, goapi wasm.GoAPI,
+		querier wasm.Querier, gasMeter wasm.GasMeter, gasLimit uint64) (*wasmTypes.InitResponse, uint64, error)
+	Execute(code wasm.CodeID, env wasmTypes.Env, executeMsg []byte, store wasm.KVStore, goapi wasm.GoAPI,
+		querier wasm.Querier, gasMeter wasm.GasMeter, gasLimit uint64) (*wasmTypes.HandleResponse, uint64, error)
+	Query(code wasm.CodeID, queryMsg []byte, store wasm.KVStore, goapi wasm.GoAPI,
+		querier wasm.Querier, gasMeter wasm.GasMeter, gasLimit uint64) ([]byte, uint64, error)
+	Migrate(code wasm.CodeID, env wasmTypes.Env, migrateMsg []byte, store wasm.KVStore, goapi wasm.GoAPI,
+		querier wasm.Querier, gasMeter wasm.GasMeter, gasLimit uint64) (*wasmTypes.MigrateResponse, uint64, error)
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/tendermint/tendermint/libs/kv"
 
 	"github.com/fetchai/fetchd/x/wasm/internal/keeper"
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
 )
 
 type testData struct {
@@ -37,7 +38,7 @@ func setupTest(t *testing.T) (testData, func()) {
 	ctx, keepers := CreateTestInput(t, false, tempDir, "staking", nil, nil)
 	acctKeeper, keeper := keepers.AccountKeeper, keepers.WasmKeeper
 	data := testData{
-		module:     NewAppModule(keeper),
+		module:     NewAppModule(keeper, acctKeeper),
 		ctx:        ctx,
 		acctKeeper: acctKeeper,
 		keeper:     keeper,
@@ -129,6 +130,34 @@ func TestHandleCreate(t *testing.T) {
 	}
 }
 
+func TestHandleCreateDeduplicated(t *testing.T) {
+	data, cleanup := setupTest(t)
+	defer cleanup()
+
+	addr1 := createFakeFundedAccount(data.ctx, data.acctKeeper, sdk.NewCoins(sdk.NewInt64Coin("denom", 100000)))
+	onlyAddr1 := types.OnlyAddress.With(addr1)
+	h := data.module.NewHandler()
+	q := data.module.NewQuerierHandler()
+
+	specs := []struct {
+		msg       MsgStoreCode
+		expCodeID string
+	}{
+		{msg: MsgStoreCode{Sender: addr1, WASMByteCode: testContract}, expCodeID: "1"},
+		// same code and instantiate permission
+		{msg: MsgStoreCode{Sender: addr1, WASMByteCode: testContract}, expCodeID: "1"},
+		{msg: MsgStoreCode{Sender: addr1, WASMByteCode: testContract, NoDedup: true}, expCodeID: "2"},
+		{msg: MsgStoreCode{Sender: addr1, WASMByteCode: testContract, InstantiatePermission: &onlyAddr1}, expCodeID: "3"},
+		{msg: MsgStoreCode{Sender: addr1, WASMByteCode: maskContract}, expCodeID: "4"},
+	}
+	for _, spec := range specs {
+		res, err := h(data.ctx, spec.msg)
+		require.NoError(t, err)
+		assert.Equal(t, spec.expCodeID, string(res.Data))
+	}
+	assertCodeList(t, q, data.ctx, 4)
+}
+
 type initMsg struct {
 	Verifier    sdk.AccAddress `json:"verifier"`
 	Beneficiary sdk.AccAddress `json:"beneficiary"`
@@ -180,11 +209,16 @@ func TestHandleInstantiate(t *testing.T) {
 	contractAddr := sdk.AccAddress(res.Data)
 	require.Equal(t, "fetch18vd8fpwxzck93qlwghaj6arh4p7c5n890l3amr", contractAddr.String())
 	// this should be standard x/wasm init event, nothing from contract
-	require.Equal(t, 2, len(res.Events), prettyEvents(res.Events))
+	require.Equal(t, 4, len(res.Events), prettyEvents(res.Events))
 	assert.Equal(t, "wasm", res.Events[0].Type)
 	assertAttribute(t, "contract_address", contractAddr.String(), res.Events[0].Attributes[0])
-	assert.Equal(t, "message", res.Events[1].Type)
-	assertAttribute(t, "module", "wasm", res.Events[1].Attributes[0])
+	assert.Equal(t, "wasm-"+contractAddr.String(), res.Events[1].Type)
+	assertAttribute(t, "contract_address", contractAddr.String(), res.Events[1].Attributes[0])
+	assert.Equal(t, "wasm_gas", res.Events[2].Type)
+	assertAttribute(t, "contract_address", contractAddr.String(), res.Events[2].Attributes[0])
+	assertAttribute(t, "operation", "instantiate", res.Events[2].Attributes[1])
+	assert.Equal(t, "message", res.Events[3].Type)
+	assertAttribute(t, "module", "wasm", res.Events[3].Attributes[0])
 
 	assertCodeList(t, q, data.ctx, 1)
 	assertCodeBytes(t, q, data.ctx, 1, testContract)
@@ -236,13 +270,15 @@ func TestHandleExecute(t *testing.T) {
 	require.NoError(t, err)
 	contractAddr := sdk.AccAddress(res.Data)
 	require.Equal(t, "fetch18vd8fpwxzck93qlwghaj6arh4p7c5n890l3amr", contractAddr.String())
-	// this should be standard x/wasm init event, plus a bank send event (2), with no custom contract events
-	require.Equal(t, 3, len(res.Events), prettyEvents(res.Events))
+	// this should be standard x/wasm init event, plus a bank send event (2) and the gas report, with no custom contract events
+	require.Equal(t, 5, len(res.Events), prettyEvents(res.Events))
 	assert.Equal(t, "transfer", res.Events[0].Type)
 	assert.Equal(t, "wasm", res.Events[1].Type)
 	assertAttribute(t, "contract_address", contractAddr.String(), res.Events[1].Attributes[0])
-	assert.Equal(t, "message", res.Events[2].Type)
-	assertAttribute(t, "module", "wasm", res.Events[2].Attributes[0])
+	assert.Equal(t, "wasm-"+contractAddr.String(), res.Events[2].Type)
+	assert.Equal(t, "wasm_gas", res.Events[3].Type)
+	assert.Equal(t, "message", res.Events[4].Type)
+	assertAttribute(t, "module", "wasm", res.Events[4].Attributes[0])
 
 	// ensure bob doesn't exist
 	bobAcct := data.acctKeeper.GetAccount(data.ctx, bob)
@@ -267,8 +303,8 @@ func TestHandleExecute(t *testing.T) {
 	}
 	res, err = h(data.ctx, execCmd)
 	require.NoError(t, err)
-	// this should be standard x/wasm init event, plus 2 bank send event, plus a special event from the contract
-	require.Equal(t, 4, len(res.Events), prettyEvents(res.Events))
+	// this should be standard x/wasm init event, plus 2 bank send event, plus a special event from the contract and the gas report
+	require.Equal(t, 6, len(res.Events), prettyEvents(res.Events))
 	assert.Equal(t, "transfer", res.Events[0].Type)
 	assertAttribute(t, "recipient", contractAddr.String(), res.Events[0].Attributes[0])
 	assertAttribute(t, "sender", fred.String(), res.Events[0].Attributes[1])
@@ -277,14 +313,25 @@ func TestHandleExecute(t *testing.T) {
 	assert.Equal(t, "wasm", res.Events[1].Type)
 	assertAttribute(t, "contract_address", contractAddr.String(), res.Events[1].Attributes[0])
 	assertAttribute(t, "action", "release", res.Events[1].Attributes[1])
+	// same attributes namespaced by contract
+	assert.Equal(t, "wasm-"+contractAddr.String(), res.Events[2].Type)
+	assertAttribute(t, "contract_address", contractAddr.String(), res.Events[2].Attributes[0])
+	assertAttribute(t, "action", "release", res.Events[2].Attributes[1])
 	// second transfer (this without conflicting message)
-	assert.Equal(t, "transfer", res.Events[2].Type)
-	assertAttribute(t, "recipient", bob.String(), res.Events[2].Attributes[0])
-	assertAttribute(t, "sender", contractAddr.String(), res.Events[2].Attributes[1])
-	assertAttribute(t, "amount", "105000denom", res.Events[2].Attributes[2])
+	assert.Equal(t, "transfer", res.Events[3].Type)
+	assertAttribute(t, "recipient", bob.String(), res.Events[3].Attributes[0])
+	assertAttribute(t, "sender", contractAddr.String(), res.Events[3].Attributes[1])
+	assertAttribute(t, "amount", "105000denom", res.Events[3].Attributes[2])
+	// gas breakdown of the execution
+	assert.Equal(t, "wasm_gas", res.Events[4].Type)
+	assertAttribute(t, "contract_address", contractAddr.String(), res.Events[4].Attributes[0])
+	assertAttribute(t, "operation", "execute", res.Events[4].Attributes[1])
 	// finally, standard x/wasm tag
-	assert.Equal(t, "message", res.Events[3].Type)
-	assertAttribute(t, "module", "wasm", res.Events[3].Attributes[0])
+	assert.Equal(t, "message", res.Events[5].Type)
+	assertAttribute(t, "module", "wasm", res.Events[5].Attributes[0])
+	// with the data returned by the contract
+	assert.Equal(t, []byte{0xf0, 0x0b, 0xaa}, res.Data)
+	assertAttribute(t, "result_data", "8Auq", res.Events[5].Attributes[len(res.Events[5].Attributes)-1])
 
 	// ensure bob now exists and got both payments released
 	bobAcct = data.acctKeeper.GetAccount(data.ctx, bob)
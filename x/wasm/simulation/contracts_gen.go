@@ -0,0 +1,826 @@
+// Code generated from x/wasm/internal/keeper/testdata/contract.wasm.gzip with base64. DO NOT EDIT.
+
+package simulation
+
+// hackatomWasmGzipBase64 is the gzip compressed hackatom contract of CosmWasm 0.10
+const hackatomWasmGzipBase64 = "" +
+	"H4sICEChGl8AA2NvbnRyYWN0Lndhc20A5Ft7jF3FeZ+Z87jn3nPP3bO71+u115g5x2t8l6zJpkFrQ5HqWXV3cVyKgfyRqFXXi72K" +
+	"fW2v8drhEajvAoY4UQOmJcGlRqC+bApOiUQlUjWtUYEiFbVuaBVSSpUWktKGqK7ktrQFud9jzrn37sNeAq0qdQ175syZ+eZ7zW++" +
+	"+WZWTB7YK4UQclZWt6lGQzbwt9jmNKiMD7FN0v/w6jboXYhtXoOLVANf4PMhaH3oEFYdOoSNoJZbMR37BR6FRiOrV4fo6yF8qJs9" +
+	"Z2r61sKOmydmpiZ3CHoL4O22mV0Hp4TE1+r2yel907u2T+7Z9YWpickdO2amDhwQAj/FOz+/d3J6XnW4//NTM3dMbN85uWsaSD6u" +
+	"HlOBdJzAD4Qj8Z/vSBn4UoqCK6RbKgj8ka7rCqHgi8QfaOLQT6FQcEFdnhBFhxoKaAZUoBl0sk2xDA/huQUXCDuehEYF+AwyuNL+" +
+	"OI5wnQ4fGaj40BY+RtDNcV1ZgJalErwAD64bwStQ8OBFFj0kKLm5S4wC2YKDA8IPSAZMY3XgiEAo33M8x/G4rlIUnuf7UnmdrgL+" +
+	"BAjgdAnRzXIoqeJyGJZdT94i9+3zoEGnv8JpSDM7e1qEDWH+8b7nm8/CbSV/79TefTN3KOHumt51UIz6Oyend+yZEmMeqVyMF/bu" +
+	"+tzMJJju2mByz55927G4t7RjKn+ZXrZ934G9t4ELTtwK/03NHNi1b3rik2JfaWJix+TByYmp6R2ODCcmdk5N3jJx8+SBKUcVT4L4" +
+	"RobT5bV/L1f33f65df0n5ZrLBq7aeMXg+q8qfUdyw9r+639+68Nq9c8N7Nh+xU2fvvE1+bM/k1y39s6BX7wrWft38itq4FF5XP6a" +
+	"/E/5uFy7buCE/G2ZPC7/UA5ccdMz8mn5pPwH+bvyT+QP5Nflt6D0x/IFeePvyBflSzJ5Chqdkd+GVn8m/1z+k3xLHlHfle/IgSfl" +
+	"j+S/yn+Tx9S/y3flf8hH1NdU6YGzxcfU60q6jTXCxLtTv19ouV64o+4mLWtKpF5NBWlBe8atw+uY24CijH2N9Z4uhFgQdTN4tRDa" +
+	"o1oj68MqCLU04mrhfiAq6Vwqtrd2sK0J6mkB3wNurhXUP6pW6oJ2dADNBTdXRkFzhc0d7q6I+q+fEz8J9B2gpKi1T+21Gz8g1Sbt" +
+	"Y1tpnDEQXRgHGpRlqH1TRpYTBaWASo4W2NYdVi6UJBIS0EgrLgV1jX2xZ1zvF+EzUqqG2qSQKEmfAgFUh9qt5WZUs1b1FH5tjqR2" +
+	"jDyYKqzScksfcAMaMAGUlBHXRsgldBUGhICP0Bc0MIbd0FwKNLo5kWUFqkdCWsWfgleQwX6B+tFINXl2meewI4z/Sgpu9ioU/rnp" +
+	"Dt5cd3CQcxfI54Z0yJAOGdINsZC5g0O1C7nDEqmkc6nY3sbHtmhfF999bg713wIcqAkxjN2Atnke3j8uyOou2MNv+ohDPjKPRuYj" +
+	"2Jp9xENTwKc4AOV7C7qIl7uI1+Ii3hJcxGMXedxnhW8CGfqR9Ro0jX8FzQ1c1Yyk8bQzoAY35ATMlfUBJTYwC6SkQaQOJTNUT9V6" +
+	"QeomWo556p7Twnhgg7geF5koCUHzw7VcgzNZU7tsGK3QNC6ZxjYCdYTabdoYq8FhcYKBiVLyJxiPxl4SlXQ+FRTDJRsrnr7UMvVb" +
+	"zevPMS/q3rc91ZyemVFVPvGBwWEVa2dEbnACUBlYqIouOKxWkQKr0KqXSggr/fC8pm7IjNhFDULFW/efBho1atQLjTaisUx/3bz9" +
+	"ldNswvhNCbpkG5ZhgsMjTtVYJBTN+etxStG8hUnqkMsHqQeTWdEcNicfBJP1m2PwiF+HWQm9FDQ3MpHai1+hiY0WpXmewsxGGuhh" +
+	"ZRYooAFx1pMPuDSAlvFR61YB9hHEHD4lkCQmaujg4+gb4EfWLcDXsITOzH7W7tZu7tZo/zLMXzSDiL+Mgpl3QFNmA3FhXnkAyu98" +
+	"EUT6YSvikHM7tdy51YCDno2sb9oNE+TN/+cLlP0VsPH+hxaqhxxWsl4A9nHw1M9mdMt8JvX47ZBAtQspeYlU0rlUbG/jWARJSQMF" +
+	"iwuuVhnGg9pArapO4IfqKxhnQbVbzG9TexPzsTXOMtALGHAKpxkaNE7p0QtWwGcVlYOz5gILQ5AvDOULLgxO2wzCnhpN8pbLJhla" +
+	"wCQ+e6zfVKZPyvQzj/WbJuHahUyyRCrpXCq2N/m93+L3/gfxe3+u32cGcDOt9dbzGQB4Ff+yZF338pJIBvIAdHEhdGhiDqhVAOmo" +
+	"fGm8g4lDmgR3AOyCfqvARm498dUmB/BtFMDWNeflfqtTDEl8qwVJWpBZPCKbupRUm+mSWIQIIQa4NdvqCawsMJSCQZF/4DQFFwTM" +
+	"JwDRCTnOKhKtWsdyP8UYKIe00QYCOGgeqGcuBNS/lHlZsJCXVXMvi1u9rHzx8GMIvSwUFFXCMDvDR39C9TXcQ+BzT6jdNhp5TdZx" +
+	"wYpnMaw8I+tcFX8RdJT0UWQLccMZeENFGjdRFJGsF38hzX8JWElMxVZRt7uRyss5lQa2fEUmGKqcxtqaelmmIVDHD6czqiqn+rwE" +
+	"SQE39icujv3R/XNQEoX+kHpmcJz8630xFjnsJBGK222KwMhlIVfRatxtYq4KWbTnQIiQldON/D5HIkT9WP4m8B7Ch5IdR5oU9ROb" +
+	"bnC498V4X6KwG1JzzDNzCD3DhNZg+Ru2J2p2bT70WepxP4Ua78nEw+e7pNqaOgdDt0qLdWeBIlJxkiJ6l7mzDpI7WzBCoSawBO6u" +
+	"OI5UtFT//r2n0Zq2ZLz4uIKYA/y9iFsOZf4o/44lU8DvJG2R9yAvZd8HhBh582+/+Rsnvv7aN86JGyMZgnmLoFP8SGFI0agtMDmL" +
+	"OL5yXEWByaxK/PLlYS7nfZJlAFjpQSuxsNIKW+CY+D2Z+sPquOKY6Bg+YWo9rDhUOqrKZZxY5VGKd+bTHY8gwjMb2RCo+I0tXl4j" +
+	"f7yqxclruSmGsEsDCQ0hS9jwSuialq4W70hm5m3JkZm0pGtNVwellssfI/ID/DYikkt1KfHguZpdVTXd1WV3dcldPV0CV0U3vRxn" +
+	"OMIbaliA01Fc/g5FjpLZ1M3hNXnXZVhMEjIokyY/Aydn8uCh5XUhxbx5z17quRaLK5puuS4fBNUqfyy39MkJ70K3dOe4pesIR5Ff" +
+	"vZr7HZZg35D5pc9++d3sO2wdzLlv/95T/jj7pc9++UabX565709/8KPvPPtW5pd+q1/67Jf+fL8cDHNBPxq/BFe6dETeEFUsXce8" +
+	"DWhZyfRDm7cB512Zrh7RR06wUpGTTKl69YnEJR/CVokekcmluM1Y/eGJXp4RTYDoalRyy/xpl3+c95hgdd/qwbO0XPLxIVRb3HSl" +
+	"eN706mx6VG0xj1ppaUeWdjn3okjlIpQzz4XOZHMTxB24pz7XJm7G6tlMXB1FAww7RxWOGuPXo4rRYU1Ie0TiHwTykOMib6QhRNgP" +
+	"6z6tXGvJmDdGq1H9N2a6Is80btxZ1q0qzJjrXExzGXu5OAA1h9ucTi7aNcIpXgZnJvVnaJoh+jx9mPckRRTtKikPhFpTk0v0ylMA" +
+	"TPoE2CA5Ac2iZBl4GUzSYHFIXYf1oompt7QY/RIy+v4WTL0kx9S3RQ6qOzNQ3YOgGs0B1e+Jpkdta0PVgEReLyb5Ddj14BlfDFEj" +
+	"DgB0kBQIWVe3I2u0ALK+1sLDZwggV2Lxs01oLVloBaUCXV2isfoomuhDu5xpobCVKKzA4g3UjVipcNMPDLOtIKuSEkHqF1pB1kKs" +
+	"Uop36d/PIfb7OcQqWlMYQn+Yf8eSKeF3SYFOK3qWjET0hMfuSNmUB4HnJYuBp5oPnua4qjcBFMPTY8oGz3MhtG+OHyJ14LmwJHjC" +
+	"OQlxqrWBahgXlX8t2UGsNBV82wymgJf5BiB0XG1JBQuhURDiXLLTjObUpdlctCpe6lxcFVo6BXzvQTiOo6gN4LsuAPBmVjWp5hiP" +
+	"7kUckXKWW+X02jYxR/ML4OFyTtm04aFhPNzEeEiOi5i4ImyxTSZ0Z5uY5l0uzkM+tCISiCOr98zvlthf94xGfTrALFpfpnYbtC5V" +
+	"7bWFsXc86tTLk07dm3TpOKnonmQFWqRjPhq+Z926zRPbsBojFFzAzUnZBMuTrfusTgKzJ1v3WZ05XD4hLVyuF7/J+6tjvL96QqZF" +
+	"S+1Yy/4qH2+9+NXmHgtU3bGUvRHCYldzXxTza5FZOQoDZ0Me5W1MjOWH5IUjTZTPHGnpfIQ7d2D5Sy17oM58qLPU+mIo2IqA0iJg" +
+	"AeYy8PLiPRmWYYm3MRw/t2HZaNQc7aMEra4mLHYjfrV4Dg5Em8cl4dcnUHezVncZfs3KNgC7W1oEm6e8NgTrWAjBOuYh2FA2lazi" +
+	"ljiVdDWqoljlj2dItgrrq02QKbaDTAXr3xUsGeVbQJQ7GGVub4m6OlrnXcbUUhEGGfrYYvGVWjS+qkYd1DWPrF5aJNK8EKygzGG7" +
+	"zAVM7oct8kqWV7TIu6YtirQhXefSBqZEBoaEa9pg2YLi0pXWjdn/Fq2/lMeyS8LlFRjZ0ATrhFInpvCKumKq9UQSBEnYDFLul44s" +
+	"oITPAnWQmHPDnMG2eloEleCU7dZdo7oLqIzqyujMTOTqCmK+m9mVU1BoW+QOZyyyxnw1Z5hktr12lpfBlzKN3M50hdiuw7JfQaa1" +
+	"TAvEGXBf4PxcGhHXnLbDYsQC6GAc+c55nkE1VuhbdRSi5g7kfV077/fI/9u8CgqhVp3C9KwwL0C5yklICBI6uHRNXXdyaWNdd3Hp" +
+	"yrqucGmojgCJpcG6XsGlWl0HXNJ1fSmTXlXXy7iut67LXIKNZcJfYYumoQQu0dfcBL66SKC1wCawGSbYPduSOq3MOtnoZKmAiMFX" +
+	"B6dsluHiLGBThVtE1CkHZccpMQHUHlZpGwavCnm1IaoLtoC1zMVVt8qHiSXs0hEJKvZxcpKMB8yjQFXgZ1XiYbS1GOb15D61HErL" +
+	"edLGP/akDXTvqO4lp4qW6Rh5WXYxqSisxAUDo0sSL8a92UUYCloZChZmqIUZQcyEEDzqAr0WeB8dlCvEdzmCYUE/PaCTAqgsmuNo" +
+	"nYurMNvRuxe2LsgVQeuVtGstlwvWlNEoAP8Mypvv7N2QHrDJWgkdLgFunEVHx9Nm1yamEbwjnsSC/ID3ULqbBP4o4XkONAuLHstI" +
+	"FtwkHsBKRi0OoDh04jgJnu4p7Z0a0fcf3kDxE2n68SOg6V5eQuNuhgWIIMlzGBOqdd7z8AmzPX1D1M8qMPY5KzcoOsXu42jtCYXn" +
+	"JH/tKLehDvFFDdWPweIQn09BYMbJg5oaSh2IECh+rNXtXRNO4st8+6042etSaSCV5qY6nm5uofMoTLlL6SjETjwnLPuovOvrGCSb" +
+	"4ma8wGMqWI8MmghLaFoM4BDnRgTjnMxr8TAKSkckkNlaPzVyXt6LiVXmI0mxkmlvwfg3SwQLB0UABPVHxEgpfkxZYgNO71bEejES" +
+	"bcDjJdDfCN1C8UL8GKeF5jB3pb4ufBo4VnTmhCwA/WLbkFRlc2UhI7XfIsG8YSjfcE2dT2gVLBl8MQE0fyV4tMCTLZehf6H7CR6e" +
+	"e6EtwuxgTPENg0kp7UErnXm136PAax329ofaQDdFZo+e5hskBhoO0iWQWn1Y6fzOSA1Zwds2Q3QYACP8S8BnubNyt3WejTa30HYK" +
+	"herYmDvHVeBNv9VA70ivow3HL+A1p9KWyKfrJuArpUL241KqJkumKptMRe1nWVT2HFEObM5IUUqNUhYKM3J8dgiq3IO6ramdtEGC" +
+	"Xj10hDuqHUCBgA69kYbCINyq35wTNqTLTLCo/gG22LUFujY5fOribdbFf5QrnbwT+X2IJSe0U5C0iHIO5Zr7BO+JM5G9XGQ6cWmg" +
+	"eDUUFtsOQq+rxQ48th5W21gXiaMaNAVQRQpTivEnse1OzqIoSvA5fMBPfoR4DXNvK+mPTh7Wi10YmveEOA7GQXhVAfvlXWDCbN2A" +
+	"R9EY4jftcZi1D/OkZyzXP02wTM1O02Coahxy8SsFTa25VmvCfkUGNmXzi07dsQFtyXWrWnWu1sS83qpWN1drb67W3kytq5am1iv/" +
+	"t9R674JqdT+UWptuqS6mVkIuOis4koMeK7WYOMTt4Bj8el+MzYziDU/zhhjvG20y6mS6zilIZDjfA4b2TgAOA/v+Dw5qf/PgBwa1" +
+	"F6uq2nBwSTyTwxpmq1oWRV4WT0rAMLsukjVwYeywQLUz18SuzL1G7p6dnf2etAtLB/sY5vU70MnWo1eRqwDpbWlk6XzGJrMwpnHJ" +
+	"Uz6bYMIjqRqIC/Mzftme4n9DjMHGsAorTjWSpgB9AaAiHhFz8Bn1rXnijVpAzQ10p5ZWMiBCuXxarskrqR+wsgw78DSy2WNTQcTE" +
+	"9DFAFG2paCzYEUUs3E8DjNLyANJtSgOko7jLRqZASwNdbgHhYIFOejAtMO8yg2MvGZCg0ibs/Dxh5yCI+3nCjl4D5gVANbBiD+Vi" +
+	"UwOE1gRDC6Jdyc4wHAgDkX6BCKF6aJdmadRaaLDqBrLcHVXYUdFzAlqNaupZyWvQMxiwwvNpyUsT5j/HIg8UUiFT3lkHASWGLvDY" +
+	"TXmHp2wGL7v2mp9WVDilhNdI+TuW+DSDTisqoA6s57DXOm/FyOtceuyOJGMj83mftI7tcEqImZWWWV8rFiMtDqvDNGuH1Sw+IYy9" +
+	"izHx9jLmFPnu9hyaLm/nWRFFSzu0tL1yOWTIzRTc2+KbZVJwfhJPFQsrOLR0PUvXzRQ8HpGun8O7G6GVOFNmBxqsh48xF1LFfLZz" +
+	"laC4tLJkbOt5fpF8OL8IWM8HWb+3cIiCkBc/qBTNrdvtQoPlO64WjyB9gLs/gCfi4NP4fvghBMKTWAQqr9gmL6DxFF5SGgbNUPkR" +
+	"LL/MZVg3htVpbItpdmI6/suMe6qMvyMZDI9JvnsLxeOyefmWpEhiK1WnlbJo+A5/Tf2STLrwecSq9rBtNAudjAhBL7n3jsikh277" +
+	"mypo0s9MaP29g2tQNUFM491OloscG0EGnGj0KXTkRSbOE40OInLTazO/6FzMWa1ciIwgfqvnZPws1tV6FUjt0V09e+uZVRrZMAm8" +
+	"zi7o4amkF3Ml2gOWYxi4MwnJrRYirj0Ef96aJi5MKVBJFx26eBRpkXqLVr000F3gwA7v8Dv5zywWcXPLtWPNhrMoBAlAZ5Ql40Ed" +
+	"GMsHHoEh+HgKmMetLFiteCpZrpdFHjSPFCispMsoXAmv10td2swnqAdTupRq/0oE2DYulDxz/vz5n9qfAkUMfDzYXsNX/ssRyukJ" +
+	"XepLC0QOPvla4l0Bf9ReRcdVkkI6E8SboQgi4tVQjPg/xekVT4djxjmYFDT7LWzuoSE0G42on3crphAKuoxpRWB3+QgwdL7jXt3F" +
+	"O3UHaOkezMlJjJ9i6AIo1ksVtDXnLVlgV91uTBp043oOFGU9KXO01NwzSjCb/esVZPpVPq5G9+iw4V7TPQQmEjEmE3kwV86DuVIe" +
+	"YT+b5aYV3sDLd5eEMxQIIgmZJxi9BXY5JEXZ3UR500Q4/Mc/nBohvQmbGuF5j2nMRICw2+ppNwVypVEy0ChmrAs2Y80h0en/Zu9d" +
+	"4Ky6qvvxs8/jvs69MxcYYHgkOfdKdVCSkIQAwVQ5GCCIMamP1vbX3y8hMAkMBMIwkERjmBiSYpv+flixRZu2WFODbVKxpS1aqqOl" +
+	"LdqkxTa2qFFR0R+2aYtt2sYazX9919p7n33uvQMXQqz9/5p8hnte+732eu+1WAgOnI6qrKOR09GwtaMZCxvlOsr+O9APQ5tTlS7n" +
+	"tTmRq82JXG1OFV2utnTZaHw69GHqq0zLUxYIo3qUGdUPk3wHTvWot74ZzvIyWTjd/lrm52hP8UmJSM4yNQu0NyA70lIuhuP2fH8h" +
+	"/dDArqaHYJzEq3yxHAFZKP6r8EgO0Q0/icTNOAlnBwsBlFxTkoQ4ENKPsxI49RGCsZ+Dr4hXBveLK5z1mEe/j3uafUYZbvbEA6Aa" +
+	"C/kr8M3X0u88fR4Enap/XfGGDtkPGT8JHwdB31bQQjNkhHAZxoSf8bgHZGdw9DPphzrSj+lYvB4Vi+ZezsJQN5sw5Sb2EcZcWhAs" +
+	"lKv+Bf41iVf/FbXIg/4ohIczA4myGrNwSKbJLGI4279mgdG0+/KZWFS9tG+5HCkJmwwsWpBjkGeYCMFRz/J64/pMLH/9As+eD8EU" +
+	"tZwPaTsDYw+MvKuXhE0CmFOecVKe6/go85GnudpDuVfsvS4TqrQr8WxrN5cnKGPcIvmGjeez+ARMPxtwk2boOj2y4dzUNo23IjHk" +
+	"OYfGnMzRYjv3rV9xmdhxJVTZ+BWXiZPmR6H0CTMvIyrnPeX4I3jKNZiJLeu2rHuR0q5FEVcYaY81XVfFuKrl6yobNsztAQkoobas" +
+	"v060x9c22BJ6TabblKOOAg6LjRtnNfMwilrcOKPAg4MxMMqBdwtbruCH+W+f+fSff/PRL/zOP3tvEGfOKp9rTA+Zj/gqja2TcVV8" +
+	"/v/MvseV5e39Brhic2CD8Bb7cVbFj7Mgfpw3EItSi804xaa/mIlHTQ82ksH64sL5uiahhw04rTPfXyvS9RqRrm8Sr+KCmEFbq1ue" +
+	"NTJZ5rKem8uCmTtW+B016ogCLWSdFf8F002eMjEuY6PLd+y0NKDVOMQARaDO43Sk3NKRCbmOFG1HfLcjRerIBO5IEcIBd0QvRseO" +
+	"xM68UX0EUmX0rIYOlsbpmfg1vE4sI9nEm/ayHk+UD3rkg1LnHpeoxz3U/ETi7Sq6xxo8Ova4rcMlFIL2IRSr/E0yfXziLhRe2Rej" +
+	"vMob5VmHbeHArNeEbhplFECLzYa5EPBkl9FMd9f1sGXMltZD7660RwilBu67qIkQzz9jR7abUw1syaTiupBZwMjyTk6tMb0o8B6s" +
+	"gT/iq56lJLeVtFI5q/4d6jxU54E3ABMyy9qWE2tbnmntyP3WBk1MaM3yK0XLM9UtzwTNM5yiqHa20MZioY3Bo6Er2tC2QWB3rfR1" +
+	"DcGuYJl2mDaOkiU2vPVktsjyUlj2ikuHWWyQwcVLCZwy81hBm8ee9jT/KV6ykbWUGYwkK/8E8SLpNIHlzDyWqU61eYw2Tt48dsTL" +
+	"mccWa+tYr7R4CiQ9/j19fPC4pxWBjnHDaClFyae0McLR8WV65Lo1SMy0Ktn+jA3vEzGrLpYJlvKrIvOXdGESgG82n7Dp6UnDqfng" +
+	"1K6hJw+zfL9YxPqfZun9GKT3GxIxVc333yI6MCjlRIgnoMQTK8LPbQZagp/HjI5wvMKi+845Vn+x5Zt8vcVExKkPpeFwPRFWPrG2" +
+	"k9PIFx00z45IwOpnaq9vQYuMoyeSFcOho2wADNCy/XLoR9vVPZr5Fg1uaaj+89puyDfv1oNOjJL+CCvv55hzd9C4+7MWBItZ4sWX" +
+	"s3B+2mclYDPg05gzTX39QxoNUkemSq3BcltrADjTVQaJqdKWFMRH1L061KEwmjt9eUF9LHWeVXks/wCGdLE3s/6zWGp/u2hnlang" +
+	"8cyEcMTLAPaaRiSTV4LQwhDD560LehUe96zUe8RsYh+rfo2A9WLuvdm5DJD9snG18dPuW8Cnu23NaIxFIiHI0LokkgCIustXtFPE" +
+	"aFHEey+WvjCbHj/v2yPB/0+du9YHgAvCdA/iHDZOhuIAcEEfAC7kDwCPdybbHAAuOAeAO53Jbj0AXJADwD+sgnH0/5pgHL1AwVj9" +
+	"gATjf508DjrPy6QMRIo7tr4n8ANPVJX1JuvSq9iHKMdbEc9po/EH9Oi7fLTe1yR2CEo8SynSZ0fZO24XE+mkGRByFFNCNzUvaqsY" +
+	"OsFGYDobGvJjWnsKmuegvltp4iTG3nGJkx87Zb8iWmtb9gyEze9A2MI2wvb3GH4NqkiEWWklL2EbbfFd2vQUFSa8VemOLPltZOnn" +
+	"VEfy+8J7maOg73jH2fSynXj+nEDaYsDGMjEVAa2OAxvKwMb3AHVpwOQ1AEZy4UScUTTVVUJ1Q15HS5eFqg1oNagPOjuL9S3nAejf" +
+	"ee/5BPpxof2jgNjquUH74+L8/kKgvZ2NE4/68eConZPLQbuNSNTXqfALA/kX3tUfENwWzw1uI2dp69N5OGKVOx/Q/Nwog0oOmv0X" +
+	"BM0ZudHejURvXOB86L48YLvAmUBMQhy0DEgVXy0Wxx1Zo1k8R7TqgIjArfvnUXdv14AfdAVNO1BpuXtoCjoBbm5//gfgs3Ke9+fZ" +
+	"djO3P//4Xl34POxPO8xTpxnmaVZadV7pF2FDRue2IQPxd2kqK/bNYo58zlCTg+ZwKeUId7Nkss6uiDrrIrFoSBKRVwOzgnOs4XBg" +
+	"yK3rjIKqGk9QDayg+u530hL/aPIDFlQ/EYicNFd4Xg7mE8AobqRKN55PYOL5CB5UTkCfRBwZ0/vuZRXpLmX81/oNmHVZ9aJxara6" +
+	"lH4D/XW94Qj4Axf4xe+xZN0GqYxsbgD/z2p4FvSsuu0VA7+WT3O9E3CcZeEicX0gZ4qwGlhhVeU1UdzDPlu27patnr6sOPDSAlY9" +
+	"E4PoQRUXPDbpV/ChiDVZiKJ74gcmqirWeizvSD1N5nWhmdeD7HbzpLNp9yu9jc0ca9ecw14Ow169INiLcTyT0xvC4XD3/RBkb6Ir" +
+	"7cPFPjTsZ0SXezGTd9LFTmVcW59UJEDypPwd47hdbKefrzTW3KkWqJuhBljkRdDZQSuCHaBuWqAKZm98k3qnvAXKM2pBbqmeGKw4" +
+	"4OspoMFf3azJeBhH1Efgd2Ascg4llmnSjlpXp+wEwj6jFvlpP1qqnrH8nYlWh9Jw6+vNEAf8uawAhdGVUSI/7+DyV9Yuf8baKk/4" +
+	"43utnnEnB4NiXiYU96xZiO1Ac/OQEjy7R8kmCluc3nzbxDRC6wp4LDBDFTfFgthdCzqcg3JcIKv5UA5owkOTmN7QqqTDnEt9SftF" +
+	"xbZ6e3S5IEeX9QhLWUm2sdaK7MCS+biVbSM4rR1qHzfHa4rp18NKhGBZur323DLHBEsLrFoYz6vRg3+R9QtL4qW1rDVx29vrejDu" +
+	"000/bD0YH2UPxg2C69eK/6Lx6WZfQ/FSg2muVCvousXfbaLpruxI7aVSHm+IMGvKq6J+VdGvNBY0FjgfFrhAH4v1jQUuaneJZtAo" +
+	"atsX98xxOUsftf0BIrAONA/rLu1VZhllRDvlqCiXa2jHPikhM8hCn/PtO5zZtUczy+ZopmmoyG5C0QjVWIK2I2R3gdA9SBXmDlJB" +
+	"ZQU/NbjeqBbXm7LYvcqwBZTmGwf0Ys7LKfVkUN3iR9UdbjxgcWPxPxM38oQTgbsuNBjA0zJHTUKoYtpFB2dWPVPCGRDF+2piPKxw" +
+	"jfdi1O+6gtipIJYK4jgrXnGKV+R1xXlddl6X5TVW1RiGmKtqsdv5rXY7P2e3M8jcD0Vd4myG3i43gw6E+CIDcShAHPJsbBB0sVYa" +
+	"W8Mxu0BTjLn0vLZsDK8+21PLsiusPTWH+fQE/uHP0gS+TEP0JLMwB1XLwuxXLQuzT+U43r2KWd5Yg/CdGr4G/LstbGVnr9kFmVim" +
+	"L/pK6cDihPBIcipYoDcSN0C/QOJ2GBU9PqhiXBALjA94Ygr6PJZ5FWWv2CnWeRVmr0KISs6rIHsViLeC89LLXmY+j3Xz1udZ4re+" +
+	"xI7quqjKiio5lqbOqryXlddve2P5rXlLnvnOr37zHz/wm9/5vhdfIQdp6uub3iyUuQhWZy/d8W7gvBI3kR6Ftt5LH6Zn9U8Ry9oj" +
+	"LKtwrRscBvc/VDxB39A/IczkftwrT2bDEba+y4+nyH2z5bNf9HNyDtt5qX0gYU/shRBXS2IU92kaWPJIPMS3htznIG0IE2KZ6bdm" +
+	"7wFtXOrT1hmwWzaMdUv4egULDbFbOJEaGKfWZigWGiUWmsD6bMPDBEv0WtjNw+yoFIwoyhEEeq3Zg4WiM5k9lJYPYMB4n8+B9qUf" +
+	"KvX1bpBd4AccZpomkkfCINH0BQKqBgI4tEsGjwY4+zVwMmT0Si3pdn3uRJlzJ6ZSXajqFiLxys99kWvWgGWfC9b9Llj3nlVxLyuu" +
+	"38ZfLqrCdp4ZeBnw8sGlhvp+xNsw1CgEdNNU7MQTacsQrKVmCn07hVqPJ1Onsq3lazuo0ptLv+/LvacHxqlDibDZZOsl8DcABMae" +
+	"QLj2u7NaglwVcM4EXAF/h4gnpRBQNxCXZJkAHFPl8L+E0n3me8V3UC+6HZYdGAKcct+rbt/rrX0fMG9BPuZoPMhvBeN1XT7IygdS" +
+	"PuimfL9565uJ5NO9Fr5OW57f97vvZ7bWv9C8BUxfbeDP1zvY1B4utXUPI2iUhgGsoz3d0EVjsywgzDl/gDBLAGGgAyAoAQTQz+wQ" +
+	"hu4kdyM5f93ol27M1GW4G76NkhK4M2DmZyA/P1ECqS7WOzajtN7SJKLKEFVlg9TG/vjxJzRdqDNdMEiQZX+qfFRdz8PyQBfotr6C" +
+	"l3h0NFzBTlQqffVm+mh4kdfDd9Vt6XHcsWdVWthmX9fSQFTkWYlevpu4LT3VsYStMKuCw1h4orDyMoWVxworzyisMIAhcP9gkby8" +
+	"osppvmYaHOPmUz9ucjXWxyPUNfmsfGJXhwBK0iFNdBD6PvXinxIKnxjfNY8ZuDDR+sB6JhPBx6xdSV1iamuoZglUkxUbxKddSWRJ" +
+	"rwe7X7T3zfhg4Nw0d4w69Fu+H25X9wh/J7HJ7WSBwVXsvrBCQkaMwDSD4pwpBr0OV854bEn/Pc0CwodwWonCIySI2qNAOo1MOIMh" +
+	"Pkz7N8CZBoqgiGHURJjjUElhfYX4LyLEOG2M+mslGne0NPVHGpr8A5Ot4HBuzA1SlxtM531L54O0fyPPOjM1aBNjUJLFxMxsfcje" +
+	"l7KZ9vNQYDLWoGsg/wc0+Nu4HHyO2Zf0Hr6k9/DTMnxCfGZvXKYHJoWrXc5ojuWMBvgjnd6DycUsu8ZOgg/oOZva4tvGFrGatlu2" +
+	"iPtaFRmjJB59QUk8+vocjz7rx+Jz9AqXaWpLsjE+0/RRJUBPs4bsAjb2PJwpIUTxSYV5Qym0BhxmP+W1jOS8eJQe00EwIjkvHkFH" +
+	"dzX9BHD2ieixnB7H1UK6mssgY4+d22oOtVajC8Z8ac6dR3LuPJJwHc/L7nB2LEPPQC4USz07cl7SJ87DDqFYnHP4EoolkFAswTmG" +
+	"YoFCjzWJzJ3bR4mJesKRtqC12qmkA+YFNVy+TnrC+isTpCTkYCihqeaxJRW9r5nrtBFgeIfj0F1wPZMOecMC40yrnDf0zocrLRxO" +
+	"ww7xUjJntE7xUhipPRLalEX56f8ZZea17qhns/PU5u2EZkjjnSMay+/xEXy4F2qtLImk7GxYU8ZdkFkQNMDa0pJtLdOtBnI4MNRB" +
+	"KJuoVqqXIPvaRJ7F5DETrYuqTC0b2dA5OEok7shzM1PZHJnAAdGhB3yyE4G+jfe31qbq4DQoLPlzIoRlmtQIJGqE90rlGcthK+gO" +
+	"2JPUul0l7YaMgkyFZj0cW+RMwR39su46fYaoTPs5MjQrkcQKFHKinX5HLZForYR0cNRHD32tb7HFxjlLGGZ4XpNW3qlP5CVWd+ny" +
+	"AOFuwwDRGTCHEp1BCWgEbtehZvRECZE9Q79kM5rJtSDjwovjik6L4efrMME8BOxiicNhHHHMbNLMwtYsvKs2lrBtvm68JnSIETOH" +
+	"uVl3nD1nB3XrP6g9C6v5NUonuXayD2RJelwn+84O9k4lPWZU2f4RsO4zCEHb7VjPVhV4KzGAW0vKXYhDVMgOcLE3R4HTOp7xBJdZ" +
+	"Ej4lPLbbHNLCVVqTQ1x2tph5foLe8MrzFYdhZWwgfccRBjwO6pdlw9Sk0WraBL5LC8yUjoavBPmLHKk8yQ4XuPAdjoMKM31F6yIZ" +
+	"YyYjx18L//MW6W1YpLpdJHep6gVPFQqBX9D/hQiKlH71XWYxcCXxbgt2vYq8Fu66fv345777J//wxT84ResaZYvGpPFz9vQdrtIq" +
+	"6grt1FelPfvNV+0JPkE3BQsgT9tvcJVOzgCkZAHkXgsguOIoH3kA2YnHhbMCkMoPCEDu6yGZIIvMo3MPjWO9t+mHOrgrSB6O9OQu" +
+	"3gvaXSGAu0LouCucueo2d4VWm7GF3vU9RVYoF4qeSQOSPr2DF3I3H9jSjg2BJCm0uZkCODTMpCs+5IUPZ3KsIRag2XWpT5+yxdEY" +
+	"+MKmActUkq+w3+Sk41oT43yEWoN+t1aOK90Hz6Oi7d+X0b+ebvtXfFH6V2zrX8H27692jGkHuG76V3hR+ldo619k+/cn6F+52/5F" +
+	"XfTvL1BjeDb9i9r6F9r+/f5Z9S/son+fQI2Fs+mf6U1++utnUzw/vMAO7wM7mEh2Obygi+HtP+vhBS9seEHb8Hw7vF8aH/rFLcrP" +
+	"1C0B2CxccXkephmhdjbUlX7vvrMBCdXFnD2AbhbPZs6UO2c/j+KVs5kz1TpnfH4UCuzM5evMmP20Ll+BdfkKtMsX0gMH1uUrI3OZ" +
+	"25aviVugTzQpc5jFpAfVZ5v8cV2+dOy4C3RQkXAxS6uP8qFMcyoqfjO0Zkq6pFJ9lMYXikbySFqCVkqMJUoiTirTZL6m3pgk2JJW" +
+	"FL3nIj8WvYHOtdueUjEaLxlidDYpFc9cyzgpFaO0ZI60FURHyEfalvzDZ5745V96+vO/8y/eAi4Xps7BtogPtrUVMgfbCpwZUxuX" +
+	"PaRHMiKnaKd0YsXA5hrWYDsvt1PmkHQjhoAj3gbOeTOXmkNuRT7KPKchoTdoxzZK7IEF4zQU0OWkJlEv3FSL/niQ64+fbdFhRspJ" +
+	"dQiHB8W7I/Q9UQ+YepuV09XckZHCjDjnlzAZstUiU/Fp6+zIQZkMoGZWuY3PjDrc0oCJ84Bgbi6eK/FVXXYplzshjvm2nF2occsB" +
+	"ccxd5M2y6aGTRAdHSewpIGQmtSmhl5sqCy42GnD7wWX5qBJSX9YrZ1nyAzzBrAasx12WrUvoFY7FYrA8D63pZ4NDMI6EM1pSJY0e" +
+	"beWRhRsn4WxLJutc2lpO6DRLss3y0dIOteB5aybrIj+1SayL+jMUx0fNOP1clsQ6ToOh9Fh2D+pSdJJYuyXNXsY3Jon1gJ6Ynvr/" +
+	"cQA25J081+zKUCZonuS2jiSxdcgJr4EwdS6flpWtdrWyquPK9ne1skLNkqadYYmgMWuc9OMtcz0nN9du2vFZXZTutFJR+0p9xV0p" +
+	"EuG+Tvc6szBWIep2pRgPGGufwQNh/V3KTmDIlhVWJhHuiV/gWkI+TT3DMNQFi/ldYTHmFljod3BpeG64NDsWl+HS0FR82jrbned1" +
+	"lQJjhALbqVVHJKjOhAQfQYVVPn7eDRLMAfzvjjJHF1mO7izKiuMdlZ3Z9WZpRYPu4qquplUWt3BeCGV2Sixb3ODcCaWm6pZc2lNi" +
+	"urmvAJZK3dK+8EzLrg83dUv7QmfpBMEVuyzUSroCO6LPoQuVbkcUnOcRBecyoqDTiDL+5A/Pao3OyJ+c5Yj8HzJ6Um6nJ4/TiGZ7" +
+	"zL/H8Bh88t4cPSmfFT3pPzM9SV44PfFz9MTWT0L7WYHvGfHw++89K2Y0h0t/25TtP+uybGruBvTPhIC9s6CuERfsb3Bf+2QpZsoO" +
+	"V3VOZetZ5IHM76yaw6KVZdG4wDyE+w1EGIc6PI+iIeynARK0ldmnryzHI3Qm+kBsBwFcNLjOsMG6vpkmJR3mgL2fGSYMMMRCdOvt" +
+	"YU4CbWCWOIkDOpQRRjd+BvpIbzE2Er4ns2qFrUJ5IOJ0kHHfAc9tYMTpINvBDFodhfIua2m21qJLE9NRYD0Hy5zNgv78xKc++3MF" +
+	"8Ici7lt5POBN3P693sn42g00IzZf9qGDn69sZAnIUh/EIyCCuoRG4eAz9NtnnTt9lnM6RJ8JtYbGZzFmQEefCTtEn8mWRWy3oZgp" +
+	"PllQBddM4S5LKBM6jmgVnUa0cpely1qarbUYtYcN/xPKNLPag57/C+GESz2ZbJrq9F8zVBvS5AcdVSduHWapQrtUvsB1YIzqraqT" +
+	"bpSMKu3nuHA0vaI54Yh5MxvK6DqRpSBYjDhdMPu9UH2J7qycOW3pYtHF00WtVDV4OmHVZ6YRtVrMd3DElSRqV2EWTq/+vF/CPWQI" +
+	"/sxlHd3nz7LnUD+OW4Xp2iHOSuqLNrSUIbWSILWQ8VCVkXVV8FnJJtv0AfoBh/2TRoBhNWbrsIUCu4WMkjPKKTlPh9lYyXlggl8R" +
+	"hSOOq0qoWuS+9uvDdLVwiHHSTmI5itkc4CRms8T3SLTxHEF0GU2j0mc9YdklSuhIPumDsf7drh1TpCVMORrDqe2sFal/ccI1l5hu" +
+	"ScDPESEwt8uxUKkD3rFcxzH0RfpkgAdHX5oV+t2hsmcn6RnUjA/akzC8D0aQyMVUjjl9VOkQjJLktiiXOJpWtuVoPh8GrYvn+3vx" +
+	"W5jvP6SET9mjBGZuWBDs1zFyOcttfYrZhwOOXt1RsmuCVXAIFhNjjs1YlwwTh97FMhphgPrmLFq8zPAexS59uJQI/s67h7N3+1rf" +
+	"7Tfv7NEwz8SD51UvLNH9o7HvpBEtGZUAf8GddF1aENxOr2rz/TfRz6dZy03TCU+Ya+jnUzqqoc7ziwP4A2ZOjnpDfEPz3RQc1gyd" +
+	"hYETysNK1lXP4KV6HQJzMI9XBkcEAxzOa9akn1g1u31xJAq0bnZwp93Oty8IaJVqjzSLSBZswxQAQzKmlSP99OEe5UQuZGh5mE+p" +
+	"SGx1a7Pvy6wl2tenJcx4VUJ0yl4szDfONdTCXBucK9LF4ecA+MoXL9niRbf4YlvcDYgZckBOhOuHw0AUy2BuWODWSYxVdcA5EFZx" +
+	"D4OV9U3EoT6tS7epOJSKwzidywHekBWAYGEup+yRGPqMYOcKM9PfZEiuwwtk7wNW3pCdW2D9qEgZJH/sy96HbBV074kcHXTvidEe" +
+	"c+8HhtIjTv0CRMENONMJAjd9SJZaeWaHqolYqtxpzmC2qtvTnEE6JTvMiVWe7d+wwExfwU6f5lABoq+Q3cbtParPXTIL5cG1mDZ3" +
+	"2PAC4+wsUey1C7UNZI+X+lyDfl/Pvef1bnrijR8LvA+IHz4aySV0cLZ0Udfty7d8VtQ27GUvq+ZlvfWlAsPntRyicV4m5uWs3Etz" +
+	"nKKBV/PyJxrkXJOZEn2iIXTTEYRuOgIfJxp8faJhToP9hueaEw1e7kSDbnrAX9j05HTL1eZ0i6d9Ijvs0kKr50xdB6XJtllJ7zKL" +
+	"dL0c0vVySNfLIV3pk6BcT5J1m/VR0itFWwpx9v5fXScvWyc7Xfpo6aerhmvZpbIA+/XbWxmNuU0IBGlkMBMoVrTA/2nBTG8RtMOq" +
+	"dfr4LYKabmoWgJz+5l1WuQ7uP/3CuyyHTh/9dKMITFIy9Ou4J7Mgmx+HyENLJt4CWkRfJvQzqgSOCtDOKw1oRbliQMNVUhIsFDAW" +
+	"4ojPFtGEGZbRgvo8TuCOXGPsVgyG9LK0NELc0KXq8rQ+MtwsCScHglrvY5f+E2CTLDO0eD3T3KfBJ9mHRxgfBzhJ34zt06Oab3rO" +
+	"y/FNP82cIE2hMPCepmZ5EpPhyD5L4XLU0+oxDTFiFUhGvQ1h5Jt6FgkwkOYwx5JzjVjBMDHjixIzqEISj9NpaKg7dzpwAo5o4q6P" +
+	"qRv/Oppa3X0/JlC6gHlrcMyhZqBdPmYuQEBgU4cxCnJhjGRcbolRlQ0MCIdYx0i4XodxPKnv5sraGklQoFJY8Z8W3vEtEpvD8o4R" +
+	"8Y4FSyM85udGlUFxSzzj/LskNEGjl2QIr2RxWrUF3fWZMKL9rTgnsDgnaMU5OCtf6oYyvVCkFlqk5rE0xUgtbEdqkYvUoo5ILcNm" +
+	"THINJgtzmCzMMFkouL0zXxidiS/MgC0+xxU47fwvNREG/v+2AB1JyS4mJf98kV+VDJGnfCYl7Z6l+mgVDtUgIwi8Sz0V0wTEWnQn" +
+	"HCHn49L9PlEhpup+U2Wkh8MWMOm5O+F493eKqGxIz51N1gyMqqZ/ZtpzdyME7QFy3uUPiWDGmgaL2e5s9i4IdvqJDuvukJrQkhol" +
+	"pKbQSmp6LakpOaSmxIBzwOdWn2WJW9Oaj/iG2PyOb6nNQV9HeOpjT+cdkOosDRnzcayOZe+M2viCL3c7z47SM5C2h/Iy+t0kS7Nu" +
+	"5U6H2Oz3WxG3QaIS4cLF2ErfFPJpHaBHKFrCc9KJNCupNXx7B1SsXNpDrUNvILn8oLEf5mHvUi6O3qnvqPORUdmMmgRevKxKjwou" +
+	"PV5LpP2cBid0scN+30EPB03icBId64u9GHn36kvgv1Z/zQ9w3xbcfVs4v4hTaKy/PcXBBVA0En2mp+xlN+Df0IyW0W2C1HM+PO5j" +
+	"PibIemiqXiL6iJbKTwsSDFRH0HZp/q58gBS7eBnRH80nZL9zAQdu4B4YjPymFoz80wbtClluebvWvH06/5apcyn7rt98N7NDJQPm" +
+	"5ZxWZDdPc/OBzCeHV1DjcvPjJ4HLuHlmPRkFLtRlmJtXLdx8gBCYLGoFCPzWInaZPfOUN1TfYhDH6TBGs9gJZzTLbRjCFQPuNPpG" +
+	"k/rXclfYuXuV7QZxwmH9bxmWCIvqOId6l3IZ5o/qgoF0GaD8izQuzrq8xx9Ksq7u9V2FoXRxp5/r2kklI6dq3A7upA4eV7qZl2PU" +
+	"SpAPULzRRfa+ynx+XGlN4yk15KjqnnVVdSeV6Oq42uJ8/0GM5ausnEOfEDvwMF59kR+NZbNDshcr6fYz1dnpy92DuuMH8x0HWuIE" +
+	"PE8rQeMIzQelXTzffwrNnOTqj3GyKom/dcKXaoFideX0xUGok5RMOdhbWDtO+lLRYSXsry+pTQPp3Hz/aVgjdPJoxURXH5i+k7/h" +
+	"FKk7uXO+Pi8t/dOB/pnUNXl9DvpyYtrHiekwMQEWiLxHuSPTvhPr30/lyLTPsf6Z3h/wRbe/3zeaVD+/1phGh3Dm1v/O3Iy6c3mY" +
+	"J2ufowA9yk8kwFMzEp9qbV2zdSKa0yFfV6oASEdwB3LXFNj2M/rGwixvQftkF0BOg7myyXzq3NEB/6RqMj07obQ1/7jiLG3mnBeg" +
+	"JVwBLQHiAvgJn310s4Q+tuSId0+zVwcG8JLeRwhXe9fKiTGpUCIDKHadOOIhNIBvQwP4kihUAnRxolAJrwIbGlIwSD68aCnShCpZ" +
+	"UJymWsG5sjk7Ba/YcTZfU+0bCTBxWEdO9Psmiyctggb8vc4qntIru8eZrpNaFtzl53zo23b2MY2cmlqFeFQJ02F2Ona+WSO73ZsT" +
+	"FgRjSuiWsgSr11IqOb2ZTHDYo0Bi0C2VrHEPS0gtVzl8DswU6LI+4i+Mbbdn/GUe4UMvS4moRRIn2FLiplGuK0uLmxX7yFDjptEo" +
+	"KEOPmY0RGG0axk6xZ4VMvscciCxMs6yXBlFmZP2asX3EGb/2ussHMGcj5kmNHut3CGw+q4R4BulzVtuqExrpfukzxlHLlh31h5y1" +
+	"P8LPAGEZ2znGzwBhVfsM+KKmYazHha7jgjGLAhSh5V4riSkcJW0F7oQrfCt5ZJrwELfdYi3bQXhkN97uY6ptmWfNOO/1jbWEs5sp" +
+	"C0MzeQn2+tZgEneyN3h6nf6bsXIZK8R0sAGVXpRZcZUA/2WmpZ3f9Bx+M+vWKV9HvLlniUIflgDOvCXP/+7hP278OFGUJeruJvXg" +
+	"pxCbFdxSOjPd+QsaZz2DmB6+PpvjiaIggPwZYhN5EtPFk5gunoS0I4CG65qAObzXRIBDTBdGr1dLaLw5dCf8yUAiohwilHg2mIsn" +
+	"wVwQGgezVKXN5rFr1zJhQRIf8ZMUUz9J6Sjhi+CkwdSvDf/6i7MkRSbuFOwiyiQpMqFxqiKJlrhBJHDdQ9OR8i0SpG2WHpXsClad" +
+	"FQStijCjYRK5M7rXzKghGV57VBgwUUwgfslXxe1pyKepxAQBjIaQD+g8/WmFDVYiZUrrrSC2gXNh8wFjPPQk3o0Cx1GA1iPxiOPA" +
+	"cQCP85J7yEv+fLA58ZZz0BR/hB4Ry+HrAH10T1POKZ8Y0kz0IT4XLtGHlMR9gpj6Wh1liE+W+9sagvxtAsSQ43E1YFHFpOzHhE5I" +
+	"D2NSvkUDFj7D0wfCzJw9aecs1G8+nuU+UwKM6Vh1sZdul9yFXSpyYWVKWEclcdWWJAuyo2oqTbQbT1P8oSA1n/gFa1VFeLJmwMbX" +
+	"+ZqupU9nrzmJ4DPuff9Q+pxTXHOZdYjlmLwK+8SwUZbf1RconPfzc0ZZxUZYbZRVaSFnlFWz/bpVYAUDrlFWIvBxMKIEGoQHX+n3" +
+	"yHY+HuioZKf/n2eA4/HJaS7tcFOSE384rSqKsT5DJDmymOizbk8kBDq0TPX1EgpdpdtfJ0GtFyJ8tpEkInbEaoa8KJFEXdLuFhzi" +
+	"elRJJBUO9YyY6DqJxKiSfHE7qewQOw5BJcmM1RDYCo7mU2Lsnm4f4mANCBCNQ84kfXFgXeKUGhUE/wGvWf+AkgtEXKqTkByKQFmW" +
+	"n4r8+PhBLhawIvI9jVGa1o+Ihas/rDSUcWxXH1B2yJdoUgd9+ZJhjfo/5jc5vLaoAohv2aUE1kraMcGT4IHCKGgoFJaD1TlFhsYx" +
+	"yHGRjm+1Q2X6YxPieczPlokaOeizcS4QS6zMD+a0oeeU0KgXI+8dB14e83UQbbcKWGp4MEe4Aep//RYUPkIsYVrf1kBax9I2ghd6" +
+	"9riSBo4q9hXTmS1ul4DAdDVirfQcdJzX43b5CNU5muWa0kYnqbWop130s/7F3qcV62f9S9VnFOtnsdBHuef1PlnzJo79PgmONxtO" +
+	"+rgPPtM3qwtlS03A3YfY2ewRyxLPRbN3QEIAXao+7kuc8z/yJdD5IV9CEh30bZR1woQVd95ofYN9AQefhtyP43GIYHNMxB+8Beo4" +
+	"obQw46fHlVtDab6PDKgwmRxVAlKP81Z5JY0YiHcRzQG2zCLvSA5O61sSiTKeDVsGvF8lenTdjQt5qu3cYHhuhGUe4n4eol2/il7l" +
+	"xxkuK5nF5cns3bHsHW6P8xuON39C4QJvOCI3rApteymSvQTthd5TEeKGOYaFoMWwQF/RmApAxYEdRn6T+Ngk+5CFMhpqlNKgEXIH" +
+	"WFAlxBvKQrEYKz4rXNCozFom/yKZJY5S9KTbDCDPLAODmplZ6YzE20ZXLVhxXHvU+XL8csePBbmOHw1ISg40Lvr5rCO4fbfKw+dR" +
+	"ybnh53JuHPIxAM6HhcabRY38lfSL5pBTIOuTGAo+sEU5iSEfcoIfCEjmKAf8eZWmKIyeaQd3VbzZXhzDLKYFIdWIf8xfEgX5o9G8" +
+	"HemTo9brGFiuoEv6LSWNbzG+0b7FPF9Fkgt+4SxmqxATqYlG2LNfJkn2DM0ffIUblaSA+KVA05E4L1hv4rDjQYXwNK7E0kWQvRIB" +
+	"6E1DnABeXJkF1dJeiLUvLu+IhtKABLh4UvKFHPZltAzRAj2BRifxfNq51UjyXyrOf5kI3gVuHNDhj/YwB6pa9/4ulYdQcZV3USCU" +
+	"OvsQJJN+j+rfsUAbeTp4/pXFL7GQMxKJcmYssByQidhV0jREvIktUdLplmi0HO9TVudxpnmYkWbRlemls5GeDsXToUy9xdRz0w67" +
+	"tT+p7OwcNeYu3YwgPRq8IXu7laV7elGCbEE69UCjOxvmHnPcL/sfpRydii8LpfVnmGIQ/cYEbVszCk5XW9Zn1GdGuWaTPu1SRKJM" +
+	"1qc9Mvv4NtRpn0pQLQjoFyDtqkDkiLu1waumQ0s4BrYEOTsAuNB5xpxcvQzRFk6WcN8siIbO0pBSjoaUcjSklKMhzrvj2bsT2Tt2" +
+	"vCjrSRf8uT+PP/edHn8GxGIk4TCOmo4Mm2XtDkcog1GzIyAuRjVn2wLn6IVBiUH+DAdHrWWeHxjVnBPponizvbh2QRXjvEf7gL90" +
+	"QsaJT2lRx7iQM3Hup1nK5mIOhYY4W6NzFxJCshnPNG0LM9omKEnTv7ZNUNCbIMhvw7BtGwYt2zDMtmHBsIx2G5Zy27DkbMMw60FB" +
+	"elB0e6C34R/shJZhvG0YnI9tGJzNNlQcjboWim0m1B4unSC9WbS4eF/QdHBvqYM7qG6IGEzoEgKWTsFetnW26HhQ+LHdNZAB/1Zp" +
+	"5rMkM3tIaWnnoC9M7mEjpVjbJpJ0b2fIPoDCsCYxHN0uMsqILOidvMT7gtw+pVsTppDPpue2557xtueuLHvpIWoNCCApM7t4OLBV" +
+	"j1HVT3KAuySoR7pmDXN7lLOkgSNp6ZqdKj6PKpBZ5dyr+NP7WDOlJB3kWVSxS4j/GDP2hwOTuXGXQ8QeUi6Jt1t1t909BDVm9zwa" +
+	"uLvnoYyI7cn2L0HGbk3EjPCcfg1EbKbGquMQMfZLaaFnC4KbZMCGGzX7XPPORcvnRExNrAu/9PyQ0vkUYRYdcFw25PWosukWRYwu" +
+	"aILlSLRGayFSGn25X4/4KZHh9gUC08cyTPKkxSSMVCSBzZKgTUyYlqp2giJp8RypQWe1ejyD4dNBNbZb4AbUfhTrKsnzwIOxBTzg" +
+	"GR7DSbyDcr0LdIGp4NFAbyqdJIpF+mOBzPSTgcDGUcnhicVMECCZ/goySbJEZphM/WLpq02Ll0+KZwXNUFbMNynxBIUdyYlPBtC5" +
+	"p3P15uCkeNztDknx6HlbUryIZR03KZ484Y/vRdHEFN3OufASX2eVT5qltvx3prZpxJWrzY1CyodKmSniqEskT3Gk5SJHWg4I0ZkE" +
+	"eBF4V77lEMl8W7Jp70q5tHcFSXsX6Yx5HdLe6TGUspISmjnKpb3jr3QjwA0lnfbuIZ34bY/OObWbQdMs0C6b9q7sYySc9i5Kf+k9" +
+	"EipUrpy0d2WxQIlWqiwHKqU1SXtH6Ceyae/26KZ327R3Dyl4mx8IBJnsD4QpACRjM+0LdLauvYqk9HLsG/MAoNY2QUiLm1CyZ6WJ" +
+	"RwPTxIEATexV0sRDWsmyR+shgb9Sxl+cZQ99N0PszTBmmGFMZwAYHTyUaxVR+8i4J5tp1Oly0ffx5l3nKntIf7JH69N2q4ZG6jLO" +
+	"ejbM5TWOrl2SZHwFia4c5ZPxRRwCVibKGc+E7sYjwMXUhDcGa2ipHLNz0K2WsnXldI9SYSlfWYAsjBzdtaW6d6izLZ56Uke3+FJ1" +
+	"iysPZLiyeL5wpeVClZsdT+lYK6E5B7NbG9jNMjgGdoxKWxPR8sPKeJupuklh03UFvlOBE2zXFFdOcWUye522dksCeEVO8sHXYznE" +
+	"fTSj5ppEtOqNsWB6tY3SwaHzARQuvG1lUt0NXeWsdDgwspTge5jD1usxHwgsUYbSr2gFjAyX6N3w3M/QbniJXs1JdhT7XF0nptCO" +
+	"ImOVzPYp6r2iNLwGqYoNfR8LLKdxOHA5DR68TAWnmYsNAw+FimViyq1MTNnoTkfNS8PCGAlATgvzOFxtzE3w5i2RTJuOfj/YLKr0" +
+	"YSIhEG4Vn7Rt9c6tW16/ar1zS1ZxI2qZ7LCgZuiFuaavrxUx6hrhBzXuaRezz0msNlaqTAfHOjYtF0eOhs3IxVFeyZYXqyNtSOui" +
+	"eLO9uNgUC0ZWNjbFAuNZrahEnIr0l99jFZUwLxZ0Sb+lZCZl2/iR2viyeH19UE+fMpKw2Fu08P04HBICAyEdRHGjHQyT8UTxLKlB" +
+	"J1FcnYtGrGCRYJQTxaPTasQKHTRidq/+kGjEfOuWQVfXZIovPz0ewCXj+Ax/ihzD2OWbTAzwuvfq2+jqFKsucaUTO9/UnISftXXk" +
+	"k4Y3N77PDg+wYbSZ4R2ryHpQiS1WEH0jtli+UWvPQ53xdjuVZr51YszGtMSmC+5JTB7VBuxpYvlpTElYpsdlr8VKbNc6LJcTZG1w" +
+	"ORGYXS4ny8qiCRhQd/L2fy6f/3tkQTCKtePgCQP+KdWs4vdZJSy9NTMLg7vQyXMdMxN+lZPnmrkvfMzM7lxTlFn6ecy3DIjM0Axb" +
+	"RATf1je7AYZds/Wew9oHp2HtE0JJnEmlYtl7flSXR6GVMUy7SZbZupFlti5pFh+RYwjHlbiRMtdS1glGctm4JcH1NJ3agNrLJ7ne" +
+	"6SS53q0stWIO70Gb5LoFKmgqSszx3zlEgw10agMYcIhz8X1xcjSpJ4LW1BM+OAPOHx2kh8xHfCWpBxTS25TcFNklSetIP+s5Ianm" +
+	"f0NZzJ1O5uydbubsXdqW/aAVIXazCPE6ofLXCiK5RjDZYpEZJG2erTP1jDm8adK/8DyFuv6iqb/a42QX97cjkroRtbzpaY+RtnBS" +
+	"o23mGZf065o8O89WDiI8FNNy98M/mFrsf4Saq+mR8zSnJQgg2OtN8xHjBotod0JY4HIEafJJhPuyTlOYH3R3A65LrrbcgOu5AU/o" +
+	"asCq04BV24B7zYA1oHQ5YN4klVoV6yOfTsXzipCtxetN9vLFPFwRmMKcwORmL+cNpDOYZ5Bi1mBCrhP6SE02Y6ZfgCkO6JlVoMfU" +
+	"ZQWQnZ381dq9A1iM5a/d2sdll8hh7lqGUnUhX23ZuhJWULHqUPE7crtLdVNRRSpie/lThLhrQsVxlCzWGjEv87PmLOfsQLfAMKW3" +
+	"L8DeTIVBBNZvVKU2ERB3ZupNOAMnU6Ua6OMq863XhMp4pOnyLdTMk60KOpmgTRVwtpDLA3D21+IBXU6RS8TF6ctEyWlSGXSdZmAI" +
+	"kfNYEj22JHlgh9W6jsKfGKyKp502JvK5DT/pITFDW1lZv8DzLA4dAEsz+cT/1H8Uw38G7FvVsG9r6GaSuVmsNR+vQ6Yt2hXXNN2d" +
+	"w0ALcAfsct6oCiS4CIEO2PlfxKIInqZl9jB1WBVEnwmtWRiZ4wuPJUUZoj+iofdZGEFmaEiZpNeY58YyJ0f5icuuHOEDhsyeeHaV" +
+	"BciAk+OlSU0UtyL79CxN+uS+h+8n0NUE2Bu9pLfd3hi59sbItTd6sDd6bG+sL03q1pW2lz+auDSZzOOfqNXBVc2/PcNMafv0Q5hT" +
+	"wq6J+LJ4PWKGGc+67Ra5sp98JFVBhuAiqXZke1axFMFAbkK8+vKCZYhnlYUHfPD3yC3ja7Z+p1i8RKPVdRuLTt+Elb12Gt0Ue/rl" +
+	"VtAyl9Zk8qDiNOyyQ5sR203W2GqFt12ka9WCAWqNXCvFTlujU4zhp351e1HfOXHaWlwzizcBfa9hwcSHv1kgAcXOZrK+17IgMEg9" +
+	"y84evg4LIURshNHus/xErF+jvp5QGvkCFsxZ7BL/nQet2AVcNsDO4xDYSQKT0poUKI1fM5IQN/SGHPVjgxyt/PRMTn56zlpPeMI8" +
+	"K8GN2C3Ne/jkzxFMnfT0Ju63nPhD8CWmJW/26znmw4dQmzWnCVeOSWfWnRfdnvJQhCJZJOoXhD4deDB9Ci7Pr9Bt7oSL9qn7rf8z" +
+	"phf4iao8xfwBOggopzoYiaJUfavZU179FQIZeuqAdga04z37SG4YYiZZo4YBE7NFZaf7s9TIJpNyTVwt3ffi9KuSQCIOMd0SnRk3" +
+	"wpmMdfga+k2YQrHT/cev8CeIhDcWGglvNDAS3q7ASHjHxUoxGjSZFdkZsIx3MBAZ7+kgQ5pjgbiPPZN3VjgRLAigD0+fDazv2HNy" +
+	"Weks4o0n9h0PjNg3GrJMBnS+M7Si44OhFeV2hVbNslsu4Se6Ry4xgIdCKy3uDa1c+HBoJcB90gRkyH0sqx8KctjlAA1rr9WoEvsZ" +
+	"NNnQuScQue+on7flHPWZ/ZyA68/6TU+kPkL5TRII0krDq07K2XOOmfJNVZ8P1sEXJ+Hj9IsofiwTHrPap5O+HnBgd+VTfoPDd4pm" +
+	"62Lv8+LIgIqVZqSPoSPMMwVSOnRKg8v1oO0rJsqwDo1J1E1ajQKHXihEYeAVtc8VZ61DO0dkpL3s7upjnLUek6NujL/jvh8WVy9t" +
+	"dBvzrUh7UMrXcP1Rnw+tkDQbBAYSPG1NYjmWLUkcG9CYqLzqZC2OsomKbzWTj5OyppX90sokXH/Eb7CPv2OlooJc9/JaH4mzXrWP" +
+	"9dFO+b1SfiKu3+9DmJhgmjnmZ2LrcV8sIbwYPOMCwx6n+c6ODyWetfyc1L5wJ2AAmRTbJdvhLJlsgxMZkj5u1l/aCqTgPmdZ9ukB" +
+	"p2Cu6P5DvkjnIhxKn+9vbYIHYCsVMnPCh8LqUCiC6sFQUOGBUPjI/WF1IircHw4Z8WV/6NR3KJTRHQyl3gPQhHCJPXp2U0/Emvf6" +
+	"0tE9vsg3IlY+41sW+pRv/TWe9rMTbTwveW59f7AAp5yZkzsm4ndA8qWdXNl3tM0u9b7ko82vcPCM1LObrOnNaLCy4imf5Ee60e0Q" +
+	"SmA0dNwo/esaxeokjsBcF3u7ZO49uXuXbArPbIqd2aZ4UDbFqGyKndmmGPVtJsh7z2VPlPJ7omT3xLMqg2kwEMZw+x11mj1R5D0h" +
+	"phOn/EmVmW+/hYSVHI7wvO+JwrnuCS4oykvp8CnpcF8aCqh9W/HwekWUO597IjrrPcEljqv8nviqko4eV7InYCXlSFc9DU+MSrxo" +
+	"jLSjx5D8WZA2KyiOqTxUHlMuVH5e5aGSdeoClU+KE/oRUfYeVRYqjygLlZ9W5xMqxxyoGnOg8pNdQuV+p/x+Byo/8sMKlQcdqDyo" +
+	"NKYOZLE/KlDZIzrUHwKo3NcClR/SULnvLKGyRxt5c1C5NweV72+Byj0ZVD6kjEePaOYsVO7KoPJd5xUqdzpQtdOBynd2CZUQckz5" +
+	"OzOgvOuHFCZHHZgcleFOTDVRvldgsia+7D8EMHnKy8PkZunn7WcHkTXjDeBC5FoXINfl4fG4Z+FxDYPjMS3ZW2h8iwXGnzyfsHjU" +
+	"gaUbMlD8se4gkXXLUnhxBojpDykgHtELkmo8c20mSq3gkVVlVD8EYLgwD4VXSYcXnh0UsgPX3GzzzeXxcoWXMfj5BvwGLPTNYehL" +
+	"GPgGmnkznRIz3dlDXzkPfZllbhxD3mmAr8TAV3IMUZkRqmAtUNGLAXzFcwW+orYimXUQC1I19WRVJ8iMpTENrHa+4a9w1vBXcMxD" +
+	"gZiHPMc8xOeYW3QL2nRyDCfoTN3SR0zGlUaYogsXMk8aHzJr4thL/ezTRge6nChi2G667JHLXaE1RTxIl1W53BkaV+N0R2itHc9S" +
+	"9ZOksmcCI+xBitsbLAiOY/68Rd6jmKne+f6eUMwUo/glYfCUOLzth/PbvtCO9Thrtl6N8e0OsuM1A/6OAJqGOgCo1u32LMS85FG3" +
+	"32evcAi4iAPADQatg6EZqV18OI/qbqdfhOniAgEDNl38M0/8SbEtKUzQ3/GT486T/+Anp8Q5Rmm9HJ4EfDLf6KKP+QvU11j/InVm" +
+	"b9M/5Sf/FpiT/PheHTdavBM8nx+Tt7xez/CTzwRDA8rLpHE//VT2DWr4nbwMXlqaVMS6Ia758dJkQs6mAmuK2FCq7TaUwLWhBOPY" +
+	"UGpLk5q1oYh5pHdpMpFtKL1auWo80XYHOR+1HXxbN36I5vgGViUcbig/s6A4+Mhn31au7uFA1PZ7A6u23xdEiSiS8dCxo+wL2MjB" +
+	"fn2sbeVSOTvKcarW2lG6bmPR6ZuwdhToVUWLKMDwdF7TKQpca0g5QaB6VHbZSWiB2ZLyZFbzUVYdL9IVa3sIKvZcW8jxrFKnHAO3" +
+	"2FLyZQVCuyhPwFh/5VmW3y8a3KOMNJ8MjDlmP0/6srNc2O+1LGyTPXO1kBcYTzN2C4V2+lCQGWSA7blmmr8FFudrg8yh0NKpg6FT" +
+	"Dc4G+Bor26cnpPKnAzHIKENVtEFmb2CPeD4cuEc8DwXWIINZC+wRT3Zs98SxHfOdfh+nY/7Ok9ljk8xB7v0zgTbJTDImmacDbZIh" +
+	"ArE/lLlnxypAT2CjgigiIGySmSQYYzJwfWaSQZtsktn1gGOS2RcArzJpgBYOPTyJnk4SQoFSbJLhTcqelmyWOZnR/FP6MBzeEa/D" +
+	"ZpnMJBO1mGSiFpNM1GKSidpMMoYZiQUtCu6Czy83JqjJuP4+4xt3Z1bM+a3+zErsN2Mh7DcfeKnfK/ab/T6HnKFmjyux3yhWICo+" +
+	"YFrD70l2RlOiZcBL4RJsjBXk6jpo5W7n/3Gc8MwblPuoavramOHDmKHSSsOv9se6oXvR7phptxnVr6SrxxWMGQoapUYFlYxpU64y" +
+	"LpjakCEHzxucKvQwGiLi6nHlrAsKdd0RmC76ZAyfTNGBDtm5Rirh0szW6PJ24CFHkIkkgsx+rop4PWW0Rjqw0DRu8CPKuurxo0gG" +
+	"t49Lcfb5ffBB4uKPoniTcMEzdAuqfgqfQtdR1A3sNQ34toH3I+6OZ5I0WTadY103A2HTA2Y6CeMwi+5XL4z1WX05xgIw4FYBMEXp" +
+	"4B6n1T2yytNx/V7FkZF07fZwTiCHc/zqDMzJTqew1nlMw/U7lTmfwx8Ws4Uugm1X7EDLM39EmfVr+C4IyYKJz14AWyAN5G7ESQhX" +
+	"GiiU5Qm9IPDF4Xb3mAY0OeMIj7wAw64IDvy8eT9AXO+/f/nTXywsh0efMi5VOJPI7+H29/Uvf/QDj/z2sY88470Bc+c3KiTt4KU4" +
+	"CaU+3PoqDB9BKGnTjuJYSRLbgbpQt0wS2h6Br5GMVsdEeFzBT/shhP7BeR38Ep7azaE25/u7/OoFxO3gCFnF7hR4OVyuaymZeZL9" +
+	"cUSJizA70YTuhqk/QvNcouW4CCPugStiFdWWOlZbOKtqCwxpKbycaCbKtbDjFCyXeBEcskCmwm496hQwgAYm4A/EF7V6JfbKU6xW" +
+	"Ere8NniaHRyBT7NUV7awI7mMsS5JGQkszQDQa7Qp9724FwmIbTPFOte0y+de1xhZQTlXFLzIPbsLMbBETyenkXiX9qDNSZhYHiw6" +
+	"qA/L1icIYpNZU9qbGNbHck0PRZ+J7fylg6Z4cNTe9DhfrhdHgggNZquU1SJFZLXiWj9jFI0bd7hLVKtjDjSiEwxu9KUK+lJGL0wc" +
+	"UsxnvLQ2k9ni6kxGL0CHeDhDHs4QLJj6fFILOguDP+dqI64yOouqwZcDGl2KzgJsO7qndRbK6CwYITY4LHtOZyHoqirIsNqms/Bh" +
+	"1Mt0FnwbSrtGZ6GMzmICLqdlOLBqdBY+V7y8NjGdRDVMlOBitrDoLOq4ZJ1Fr2lgzPiQtiA9B9ElvtFZ+OYMk+gsDBBrvFmdMM7y" +
+	"MdCdBnakYD1bh3qG76GvwABpXWk1ZeEkwbfueoe97O7hNnQWanQW5NBZ3eyyMLfLpL6HfBnkHl/q3e2LzVRlmgsFzQXvPaO5iOP8" +
+	"TutyH8BoL+U0tei23CQzJcLCEMdyqfcnPJt/prRd2PAsTR+mYLo7rOBXPIMD5x3N1udxuz5mqYLqFFQPfbVZo9sdVqPIgL/Z4TSK" +
+	"ltM46dmts4G3DmufOXqorukmh6eQmlbZLZRxFRreQ9lEoT4K4G6iSn4TVeA8xF04xo5y0thb9BkABdX2aTmJMkZ8xCl6rVYaKmhw" +
+	"LR9Rts2MMSd7Jj7C5SCU5iCKy/nc2Z+8w3AKuEqL9pxvnsgvrWWt5Ym5f47QX80YBQLbkqWUXteUEqgPCnovRygX5+hkqslk23Tl" +
+	"yKRlHVwyWWojkzVDJsu4L2Rk0suTSQAUFNyes1Ovkp260KGSOK291BJHPf3dkrxyZ7zHKPK0FeTSh+Sa7u2u6aRQ49Ogik0rZmve" +
+	"oDeUIX3UzBRpZgpvrx9jQqEEmaqYt3+GOd5hhuB1gboJVtV1DLJqPdRjHGloqjQ2ld2fY6IcU4TwMg6Be0+vnMV72Nee60qyG8gl" +
+	"4kQX5HKPcZZhQGWA5T4CaG2fd7F+q34NuoSIRFOSmjRm+YECXKa4S5PicfE5Kzx9BC+mcfDpVhN+g8WvHoETVpz69mP9shKfYZZi" +
+	"4I2yPpCdQ+8dYSzuAGSB7XoMirDbb+b2g+lRvJQmnbtfdrufdRjqXI/VuXwK80Ffq7MVu/SEMtuEHnZonn/U13OsY4sIkEyi36d4" +
+	"+vfZI42KzxYqE+WcnzzOT/Y4TxS76/Ou3+UvQPoFZSJJ1bQmQvt+yzyFwzjS2II5cfksQSh+xdtYWW9jJd7Gkiwwc/9W8sL4Zguy" +
+	"UHn3b2mDUQfr0M6mlUUdG4F2o1AfdJvTOg5P6zh26QmwJz1sDzQC7LoH32sZZ9Nnr2oADjaVOPXyYghJ5xfmzvmGUx2INob7W6gv" +
+	"QJ2j0inGCA9m1e20FWhPawHUZ1lOV+x4XJf9y4SnviDYpSFKHI+xpjpm32GdxtJsAyeNJXWCRdj9Poff1btWRxkWp22eBTn+rYzT" +
+	"tjn+DSj3MigPBMpDDeW+A+VHHyRY+IqnwbwfDAyDMOGqZgEawQkcz1+mq+njyUROwsDwjKFy4H+PQ5GLKnIiZ4rC+VfPTIDREUqb" +
+	"rCMcNTrCL/T7k05zjNUzTs77zDHWXvzIMdY9SlycDzjnQvbqY6yH8sdYHzUxBcesU7w5V1rupDxj1aU9wyqiNx9R1YxjJbGnknXo" +
+	"1QHtZvhUdlD2uLJ+zSeU9Ws+mZ2CfTo72XpKmpiamIMLD+WPSuxWHG7XOtPc3uS4EHeKK/Ncc+jdsUbrI6zl2Bql9RHWsj4EZe3T" +
+	"cqdt1NBKd7RR6yOsUl8DwZHAs0aipGSeNWg3VgcSWYZ5Vj6nGlme1T222masLomx2h5bjTKWNRKWVY+jnpWsZ2buCYZjdc+q7nPO" +
+	"qh7Q5+H26zOej9qzqs7KE8c6kT2pS8s5Ov64R1KZbSUhNLJHTieKhnifcyRyn3u21GnXxI7hs6XXiDJ/sZwtvVrMjgv1Aa2D+syq" +
+	"B3N8h7q1heuAPm65X4/xUXC6Nfd86eKuTphm84WYnI3Jui7PzpAYuw7aE6aTmUQXksmPSINy34f7omj3Flpj+UI5MOlaxKP2A5Mt" +
+	"hyX35Q9LHsjOOu53IvQ9qud2nzJAIuFcAOR8wvGAPuFIhcyXLScco3xlxaW1suDmlurekVtZNX7xkhQv6fMfwv2Ji5DKzi9mpxkP" +
+	"t55m3KUWABzAUCzk/d8oSW375TRjdo6J/YWLjyV92UlCOeCYhYWekAUbc04fVrNAY3EWMmGS7l52wBGM6BSpDCcgy9b6j3je+7Ql" +
+	"6xklhxSP47cicRY44LLKwt2cUok+fS8z+gzN6KswuBFrc6abNZi9Xjt7+1oOLT+qmgbuXIiQ5WdQTIo1cRYa8K/RRxyvbuZglfnF" +
+	"ANEO+fyiiu0BxoeyoBHmMFH0WFLQJxh3622SfvjnqUMXaqDgMy9sXz/EOLw5WSYLxzKFEuGODzDmSBeAYbI9zrhQWyg56YViPjez" +
+	"RFWWJnW5l3jXNbqqiSk+PkdTfHVpUrWmeHtocgKb4nu0Uc0E7Btx4vXxAmlrfcIeiWKIX9jJDr/QmuF3KrHW7tCn50az03M7lbHW" +
+	"juaPM+4UVnOnshAz2nqccZ9yzPBdt7Ho9E1YM/w+lQ8BeSBPo4XJsGb4R5UceeU9qq3wI7ZeYW0W6WqdyIs5I/y+rEqnHEOPGOHz" +
+	"ZbmpbsoTDhIj/FmUN5wH8POIMcHvUo4JvusJ/17LojY5XYOY4PfYYC8mcoCOSeKeiRTHV3Mm0hv/TCRXk52J3O9U/qhUfkDlTPD2" +
+	"TORodiYynw7hoexc+B4bXgZYYreuYpdBC0ffSaD5916SRai0eEFM8JONCf6AORU5xZyKJPQ1VYOOa4KfIib4yUIYpgLhZib4feZU" +
+	"5HH3VOROpU3wo0zE2UlAif1+n+6pMcEvzCzwR+zJVH1GcjwL/As/FImWrxUD/OPe6Q3w9uwks1fHvHEM8HKAcoZHfE4pUSu44179" +
+	"CX3G8oiKYxxShzBE+PATKr5YKWSGqXM+Y0lnV6UfD3l8kLE4PYC4djTj9U/RpE4ReaeJf0xen/v8eLWthHOj6G+Yl+H9AfnnIdaO" +
+	"34vsAx5jmiovf3Uo3U2v6qc09iAJzHxJt/VvK+Z0aUhVaZoa/FXl3OxR8c3S+lwRorwBfah1phuKDpLIkfeM8bD8dCqyKjE4zOFO" +
+	"JJxwCVdz6CrB7CB9+vdEwJjVedw/49POUdvNcCWrUQYMvG7xZ3TmnZapkfPttJevZwTkYTbotr6CF7cqDgijo+EKPXuv3kzfDi/y" +
+	"eviuuo0ELLqr8l1hm31dS1nad0v08t3EbSRmdSphK8yqYHwhSaHMeIDTPMZnHgfXl6xRQONAZjIjElyfcY3TfM00OKab58Glftzk" +
+	"2hDyK2RnFV2hyUUDiT8a4ghisv6pF/+UrHOihWUteYciRGGbWX4CbrFUtaVQEqwQKx8Smtgz5sn6fl3x2tP6rrIgJLvAl12gZBfM" +
+	"GjIJQv0Ffh892PHLY5zBi3YpQAjmiQEGnID3jOTmCqgb9R+nH4agA74Ktks+MBH1uQJfUoAxf4jTvNOQAgxw2GchUlKA+enVLjjP" +
+	"seA8wB9xFjBtgpxlh8iZwJaGWrSrSlizuiQpDSTNVaDxfImRlT4gnoJ/DDVeQV4ExTlKA0kwJCiCucISZ/6aHZR0yC8b184XpCek" +
+	"YvF6zqR0FIhkAXcrfY46mB79RZPy8ej7xpBybOx9rSkfz3Jd9p39unxUSRO0LtEsZDrkJiJuAjabEOFY5w2l7OkboUnO+xBJk1E6" +
+	"+pA0GUmTEajh1fQD4ZV+It0BXC2kq7mY0sj23FZz4pdbqtEFY740XY+k6xFPafxIKLjFglRdzpk6p0PqjvpCWcci83ZCM8x77rAx" +
+	"J9QqCzHRhUs5ACRn1KqK1tITIblkW8vkaZZgyxA1Qm1v+56OUqWt13ISQchnoIt6WVGVaS8QgNLqaQIWxiUJuMg4c4STGdA5kZcm" +
+	"IfHrgSSoY92J6BskASDTckkzGCH96CTRihJSeaXyGGPyYQWM5n5lVCEDVrGg21XSbsh7zFQoIJ64If5myuboF96xX1f8Uyjdz8Iy" +
+	"WhbMmYYc77F/QbZxEpOVnTs46qOHvpbSbLG6zeBa1Wp9N9l7KKxZrPde/O2SAMqoMnrGhdkhojxILLQHgq6i4f/Gdrg8Na/jRFf/" +
+	"C1mBKitrBdZ3qfU9laL5L/Q6HkbwM4UU9Qre9mz/klWVg0y8rKe8jDXdINO8Vp+iSKfo4PxwTCgJs1PSx9WMBuQZT5tVPAyb8751" +
+	"CLCJWWKFSopnYLjZf6tJm+h0//mhCmwhztIHDYHAzFwzi0bzKDN3mQ2fwEOO7JDNgRiCLTlqcbE3h0pxrBd0/SaZi0bgb+fMahIz" +
+	"9TgJLD+pj3qJCHaMU2zbRM3IFgv6dgPPH1Pgi7110CFNieVsrCea4WP52KU3LAiwklNYZtTrIWdQ1jYVAaqdfzk99IxdJbNgmGo0" +
+	"2TrpQQ4gZdZCPWuefosOLBayUbXqH5FUEndaEzutjfSL7rSGdlr77bT2m2md2d20/s8f1LTe13Fawxc0rRlY+meaVqVVoKq+0yJr" +
+	"mdQyZ1UiIrBMSMDw0hQU8kve8hlLs44GZq5tDXllJXpiMA7Jp4RyJmgGPeOVxVSiO8ajwAc09zOEkadPntA80lyDpko5gpZhKaf/" +
+	"zQCuIsD1IfWaTfEgYYGLYhF4moHYfaYsaBoiYEmbS9c82xR0qn6+DjP7Qh5jmTiGpSTD+iLu+ZL31pplZi0IWBucsAKVp9rg+hx1" +
+	"yBbdR5JL32D/bGXdDk3Si8CszanAZv0UhjnbVc6oDHrOKukxo8rovODpPgup9ewwWlUQdokRtl2kIL0LpKO6MvPZFnxb1XjVI7Qb" +
+	"8NT9798W24Jcpb3whsnWhT3APkQv2IWVr9I6fcKxutNP2rKfNC9qQjdDFGa/v8/Zb3Al3jY8yaMFfAM8kj7FDfj6Kp3EDfhm19Bo" +
+	"Tv02W/Hfkk2U5nJtOmqh5KUFej/q1eT1GS29EkxtlAsPTmChN7tD1MMOmz2yRF11WPFQrziLMV8M/9NWXK/6HVj1aKXrqS/rHkWe" +
+	"kFKa4g+ZBcGVhC6N7IKzk83f2U9wlfZYmJBZZS+aZz9kgAJXadUCxe/+pimMK36hgaKKwuyZseM3TWFcpWVb+F38ItRX+gVuP2Fr" +
+	"/YR5ocGox8LpXlsYV2lNCjtg9PSjrL7vDozCDJCDDF59C1EauCo/IOD6O/8/Cbho3u4BWKmV0KERMPEq/IpOlqEupeb/8m8QBDWH" +
+	"zNNfe8+Y0AO+SgNeDOUsxsN47He5GMpC3w9irn9Po+7jXn6yDQFsn/Al946Ojh5XC3KcUd3O9Uw71/2ZDNUnc13Xpgl0tSryfUkX" +
+	"JnnqZvMJi1NPeq4aAlFGT3wQkitoHkmuP83aiGOIx3mD1V68JdFm+sXr64jkKVE65yKxlUhac5tGDTEvs9PIiMb8BRn98xdb+me0" +
+	"tCJ+1dkzLBGuPLHSQFvg/eh0vJS05xkEjvb6hJPyHL3GTN0qiQ9uNgjiJmnZ3jXgT9xeukcvXGTzRN+TF7QyVoZKSjZx4UD9EQLz" +
+	"YCMnuAhFEVODM6OEfEYO39rSGues8US9UJvvzxVlw7zEr79XNauPLSncIyY+eCD424mdm/wIz+90KjYXonQBVtq5zRp+FnICaOQK" +
+	"K9R/2U9qSZgUhoZSNlIESTgUN3uppfTgp8dYr1rihS4iUreL5CWAdVRRflAh/rjUKNKMphX68XEcGSbwtNooxv5iaJKT4noo9hN/" +
+	"5Qyq56ah5oSkuJLdGAtJlQdLLGlM69gsJPGKWpwU6FPCqpV0OiwClRW1CpXuXVljv4WE+Mskphe0K2gwl9G/k5c8r34MdjpM2lD2" +
+	"fAqew8ZHT+XEBb7wnS+m2i9854vA+aLffhE4X4TOF9PsF6HzReR8Md1+ETlfFJwvZtgvCs4XReeLmfTFGwi7xfS0QQx4+NiS0gNJ" +
+	"zOZZRFSqJLWhZk8yZcm8B5LJSxY9sCOZuuQS+rd/yQD9O21Jk/6dvmQm/TtjyRT6d+aS+gM7aOYW4vOBByQn/OjY8959O+hBv35w" +
+	"/NX30V1J7k5ddN8OvCztxO2zvXjVj+vnilxogK6/H+Dpwp07duxYQFirJOfHErEtYDRld8XSMpLU0Luy826KflfR7yrOu6n6Xazf" +
+	"xc67fv2uqt9VnXfT9Luafldz3k3X73r0ux7n3Qz9rle/63Xe6RWJkzIvRUhAaVejh9bxh20t6uL/xeOoD7XtnnRCo8zvJrTuIHo3" +
+	"Ub+b2Lp36N0k/W5S666hd336XV/rfqF3k/W7ya07hd5N0e+mtO4RejdVv5vavjvMtijn16L6Q7cW/Rqe+tv2RCmdpt9Na9sTpXS6" +
+	"fje9bU+U0hn63Yy2PVFKZ+p3M9v2RCm9QL+7oG1PlNIL9bsL2/ZEKb1Iv7uoZU/QO5rziT9sc55UcaAzSEhELRNp6cdh36BRTQrN" +
+	"OJlwHSs1CpKoIb4+iYlCDdcqQSsBL8CAVUwKMOMVDZUqQC1UgSkPqWaa1SSEpaWKRxH99IoKFWSLvqy2IUF6U9Vkq5wjWwWQrYIQ" +
+	"g6omW+Uc2SqAbNkvgtaF5y8C54uwdfn5i9D5ImoFAv4icr4otIICf1Fwvii2IUliMkC2SGZifyLBmDuqk+P01HuJJ5+ZHtirLUHm" +
+	"wZOtD06YB0zpfthgizmqahJcVwvSXdTTtJHueL/pMFIuHEb3n5LuV9NncPcl3BF4yB2/Cwkmi8IVUSnihfi8Wbrr17jG0V8zNRI3" +
+	"lJ74NVMK7mCHzV2642H6+JL0AP3Uv8UfIxX5EjhEEP83Tww9hliZlYhi0+1nfq11JVofHLDdAIUuNIrYRnALDYjZK65vaLMTqxnZ" +
+	"paOqd8t1zPPhy54lXmNKyjGXwR7CPklywlBjIv0QnHBOCaRBlEobVaqLXq1vTKIbvxNnDT1vUh1q1tHihKS+Am61ScAH+qniZh8g" +
+	"Mk5/FHJPJfVeV8P8lqGnK66oFSQ6zpLR//B24JhePfU5TlUlRYaEawl6y0k9qcwgugOHNzjgsy9HgTi1MpYXr9Fa6r12hq5TuXVO" +
+	"ABGqQP1Qonk3W/8N1HAxqesCIXg5Il0SqKdazwD//S3z/0zrgx2/bh7oNdz96y1fPNz64Mn3ZpbX7VuSPmAalUzGiWLiItO3bkhf" +
+	"tfmxB5IpOxpTkpgAskIAOYGQJWF1GruK4xa82JOWIKz3NPmIeskjwcALowJOCyKlhTF+kgj5Onpy8H0QIa8V4+cs+tn5ATwQq2nC" +
+	"EmQEeS99kp7Xf1+xiFEBkNbpJ4yJfFYgcCR81D4d4BP16dxGRXcrmZJUHmv2P3ADVjtcTMA2dcnCxmQCkjDpvZ6WIWQUkkzeeTXt" +
+	"3clL7rybNkeYsq/s1CWlu5tTk/43MUXoTcLrCZdDcOITEBB9kh5esutZK0HbngRoHM6n5Xte3Ycl9HkJl/g7sHfNKn6gZY3sA/Qp" +
+	"fQZ3X2BdAwTUhbHeqyy5NTiwIbDIk+83eIOJj2JlWwFyU4juFEBrQtlTgPQSb6dmD4C/kpEc7EZCAzCrQZAiIrKCqyrx8mcfgw69" +
+	"QagMtZUix/TYr5h+FmVvF68nTkCPcf+v6CHpunynrqm2rgB1IdfTcacuv7Wuoy11BU5d/bauEHWBuIz+alZX0FrXqZa6Qqeuabau" +
+	"CHVFdLHXqStsrWvXr+bripy6ptu6CqirgPly6ora5qulroJT1wxbVxF1FTFfTl2FtvkydXFNRacmocBINl/UhYp2Ykyh1KP/d5n1" +
+	"SBVmwd4ZHGLpWUxMUiPmTdQE93D2xHhcsrsk2fmjHg7VdEt9X0VfF6HWKa7gX6JG2BENKGts1w/nSPFuu4lov6QH3m/IaMAEscIE" +
+	"MbbUUu/AZPIjcHCAw61n0IHilJk6+YqccYv4JMCr2KNj8iPNAtw4jPIGrj9zracH3EymM/Zj9w6D/eYO4WREJ6Qp7j/X5nDkYU/7" +
+	"/jC6NM4/qJv6TkiKFT5y4q0ZapcQKPkYd6HXQSNM9ZEx1hH5sPzj+BXbvtr8dKCb1WoqiRmgXfigN3wtdFV+bLRTRlfFUyW1NzjJ" +
+	"q8/+LfSP9rVkX3wa1Gz/WnESHVefqvV6JFkX0vq2Vyo2fy7yIlGr6hyEk20Owqp8rrS+L9AuRsYvSShM3jEp6Zykk1qs2RZraWkb" +
+	"N5rUpFHRDkZaJXiB9j8PF3N1h1lbauy68ZuVz7F5PD6bi5LA1nJKjhYoLaVhfYX4fCpxblCJL53I19QbgyjS1xjSo74qOHZUrr9o" +
+	"/YNKzbD+SzmD6oBfFy/mviZRsNcx8jd+YOzzgyOr9TEFNWJgT9P8kijq2XMH3mUF0L2QtlRI/Gih/ovaNA+Pvgd9cX5RHBxIFMwe" +
+	"tLGhMVyLybI3Tg/+Bi3AxelJ+qmv9OL6TPAk4FjTZ/HmFenDHzSMLDKJHvig2a5sVZ25gB07FbYPHycyvjjpe1G6yl1Kn8Yi7/sN" +
+	"vcgzsYrsIWgByzoBxIdC6x7mW3WuTJuYr9f3hH4Qegz40OOL7rzNWW/HI9pZD76nMzOfPask70/EQ6T+PdUQzwBdnfZv9MRIQr+s" +
+	"wK//jVhQ0hkye1drvzSun4YJvzK0u3Nf3kmwzle6m7rphW7TvFB6TWea9MiJOLXa7MjnqXPHHzm3zvm2c37WOccic7qFOGQXYmY3" +
+	"C8HKfapEGxoE6S7Mqturq3PKGLerxItfn/dYxZRkvQltfXh1gfTGM26fMh9UrXghs1XoH1b6NTkxejzUHpGcFgdfjcKi9SzOvOKR" +
+	"xCMui3iUBBd7z/r2YGQoaJ8efgc5f7+rrZrseFeRc70BMszomm7Cl/tCORbYo99f6j0W4vmHQ850jCge+00MQHr8KLXCPpcHQnHC" +
+	"3SfUkSu8V7n1N320gA9RFLXwMfDtptVl7D2oowqKZwYUNQM+t4E0elzzxd6HICxLxT7OBtIn+8JmaCPYoHTBKY1jD+xQ+ii6WuGI" +
+	"rCEO0ePU/2liy53l/yQ1gk/Q40AqGl/O/JXRaZ2OponrT3dcjR+J7aD+l52Tms8rMQse8nY6/WYNn+Sn8nTTGMl8KJmPJg++jErz" +
+	"s1nLzaY+lG9nM9JroRJbPjd4B0EGnGGnBscaamHMhciXcqOf9O1ZXX5Uk8Ed5FIMEYcY0gDlnIi5pOva71s/V1PXR/wGIke58eoS" +
+	"1emUbmhP6YbV2dpvlV1V+bak5xcBKnRjOlfNy3iaOHqi1Kuyg7pKDuqG1QGMeo9TeI8Ufimu3+sbb1f+sJQtZQlG20ACaOJ3f2hW" +
+	"yYxHFsSc1S0w+xguZ/v4z2tHhRBx5v7+rz76gcJyOaYLFwU8Z860sLSWtZbfLr5uMlvaQDqDQAOHYbkjwjoWiB/2IfwSh3QwqL4i" +
+	"5mVBoKCXxzh62LF+HQDxAAKWtLRTnYPp2uVM1y4XSAZ4Yd/VzaxVddWxrrpo50vSSR3kSGE0siIhkWoj4r5zWNFS/af4g4D7WuPt" +
+	"t1P3iKOvURfe6Qua2+nbs7oMdkVmodEXXgTixt4s+1H2i8WRzsSiVwZOZLPtaEFdp68gBiAiQmr1ZXG+6Z/orukkrv0IbxkOzWI2" +
+	"515n3tHGgLQhK/B+n+PKXMT7M8W6FVAexzpN+WudZbuQC61wtvaFdmsvXm929jV0t5D39eJmWdey0NnVUstVelMDmnObOpJNHXXY" +
+	"1BfmN/WFiJjCjc8dSkxLc9v6e1m2sXtN4mhoZlD/BVzRBTEbbGwd/U4dF3Ad0zJIvcC2ilUun3F/t+5xv9HLe/xtQzRU3+SNjjgA" +
+	"pM9ao/RXtJ+bXEn8Sc4Y3cvHONJft+9/3frBccboXhcv9ErG6F6bMdps6UZsO39+0EXC2jO7ZVrqPQ2aoCEVq82YzZZ29uvO7Dd4" +
+	"9idks98Yb/YNniidDk8AR5RoWFUO+VC0M2HmucudhticpUYsiaMLMm5gHDNuQTk6391YIBUcCsx8Hg4wnwdDmc8Doczn/lDm81E+" +
+	"pgMmRmYG3dPL3C0iqNQSd5FXmalYJitxUK9EJ5itCqKlVz36lWHRCsL3yThzmHVGzD4AvIAGsZYFr5YctDojh1b1jHeJVnm1ZsRm" +
+	"sp056bICoL4LBfVd2BlDy+GW02JoqFdNQLcLOVKNVDaQocsBAd7sWzZ28KIAvxcMfr/Ym82od6qgXuLd+JgMx00xde1Uzk6YwoXe" +
+	"qRzkO8Ui31Fl+aodLD14jH9HlUXAdzoIWKq66xwQ8JQ8Ap5iNyMOZJumbm/r9ebTYODJXBPnUjzuVHKTU8lkrmRVhgQmn08UHORQ" +
+	"cNAtDv6oeY84Kv/8mSfu+/cnvvjRb0gclW4R8bTzjYj7XxAino7uHHMW4S3OIkzjRfjJbBGmnVdMPO0cMXH/DwYTTzfdw7KnpbPA" +
+	"xP3uKp8VJp529piYg9Ie9fKo+McEFd/goOJJLxQVT8qhYj0pZ4GKpwgqnnJeUPEUQatc2UmHdV17Olz8UikkIuY6yccouFgRLuYT" +
+	"IEccXHzExcU9XOjTLi7usbh4LMPFh1nmVYyLx5SVcQ8qBxlLXR9V5yDjTshj4wlWhEI4HStQK50mF5K0Or2MW2fhwSm8Vwr3sKyg" +
+	"sr1fP38y7md/25Fxv3rkXe8u/oBk3EkZHp54bjJuH6sE9HT5Eo8qsLHvOSAVdAMm1njbjM0OqLr6abEkvCElaCRHSOc25b5qY413" +
+	"lHZ7WP5WeWn3XUpQwS7l4IKeHC7AcpyFtFt/AdJur+CA3jjfdPfSbo03D4eEMdt0n8pLuz8ibfyIaMIkmWBJtjlJ/qIgHXWk5VFf" +
+	"p6kLkACXA4qHZmM/m23s55jnkY39rGoWdGmd5zXA9bfNjjaeZnrfVWQ/V3RIcXc/x/n9DLc1afkkh3SUNnTyW9Y5fsvZzxVzLjvk" +
+	"upfXKmCyJMLyMae8zsZawvXnGTSLphmsY0HvZXcPO7s3CTmoCTquVfQZeXWJmaR0OwfMLgWPO0t6XDo8C9dfVTa2uFUrpoHB19L/" +
+	"Drv3HLBDyeyrQm5fSX2HAxmpy1BIiaN6ps2O+6zecUf1jhOIO5XpjkPR35/KtDQ9Wgv+7Q5K5B7MuFEit6jz5V2LOj9M2tT5PYlR" +
+	"5jcdTCKZK1wVdNSuze+ogq4aTX5OBd0DFXKYKfSrut4oW58eRwVdzSn0qRZb3tXfc6IJbvu4r2MnA0BkzyqZya/6mrQlmD0GTT4O" +
+	"shdx3UKeQxa0jnBnbmTaboZ0sfeXDCGXek9Ij49a6LzYezzEBGBC6N8nxRqCiBWcT4mPowRJgTPGBQlCwND0N9UreTlwt20RrYnh" +
+	"J/UwpSvESYTTdS+PSy+xmqGOx27mDc3uDYeaJTv13CZy3jXRscPZM+kUh8DaI9abQ9p6szPM41Gdl8NMyrMB+zrOpu9grw84M17J" +
+	"NjWKDWYbUZwFKcDne8IF/tNo55hel5ThTJKabxbqcjIYqiesXyTEI3pH34xwP08qfZGGw81IbLbNgjR6FIn8srPcAfwJCHQxT5hp" +
+	"HhZWsNEjfoWiwpQ5W+WCqcU7FWdTiIGKQbZkpwTUOkD6JgtjJ305pgWk62vUXWJUaFPLpXK4o+VhGJutUDSpEGhmKtg11I8SS15l" +
+	"7rI5tIT1N1hAJo2wnd74e3meWla/+7WP7VTvdQtg/fSEgsfAYXmCkHrMmzDjQARPMHomMIns9rC1HCGgORZQKQSsDjlgdSmpPJZU" +
+	"JUNhnWa6LLBwJNABwwNOG1gXYHscucBmUxWyr56k28iFNkbPJaBntK1xqOz6o9n6fNbXsdIFm1HlYhfUGw/V6j2NXG64ZPnq8aBR" +
+	"Yayt1wQG1+rLJWAg6LXQPcWm6TQUgnMwkNNqMUda6vipZdD0hwIkMRtVZcphbGNTXQvqLrej7rAVdXMiiUh0fYmZj0zjYWMuBIi5" +
+	"wAmd+HyNgtIDP6zwCF7GoSP58XVhxq3gpfJf7nGE8qIAtZG5EDW+NltEqNnjfjAgHwyYDxwDh37wUnnw0nGr+BGp4kfG/WCWfDDL" +
+	"VvkSqfIl9kFTHjQNfUOaLMYdsQFNQAivuWLx1qfaUom2x9KOTE9szKIzY55Ezk5T1NlpxmMKTFHdFU6W9biyVZHImhdOYxFOJ8ug" +
+	"Jp+uYld9YmeDw26jAd2gl2+vd5z2eqS9nvPdXslOVVGmqjh+C4iELy3AAfh0s5atBxBNmREN9iOimvo6YKzeWHHDh/eE4azhGCJO" +
+	"DrPpC8JIrnlf4yakaRRynj4a6MyyAdLQSorYIH04Q157GRFW5WYP3cQalwHb7A7EK2FXkLheGO8w/E9Z+B/ZyaWMPDk8W9UwCrzP" +
+	"E8ktiH8iSXkQcjxHIKNYzsx23MIFPuiMAlzU3T2RLHw07gehfBCaD3AUVXsO6Qe+PGD6hOSMkXgQNfWsMHckEdwkELsm4BN5jdgr" +
+	"r565a+nIJ3Lat29IArGzz98EuZplvf9MlBknpwKIak/iN8qG0FUfw7ExHUE4wFoyKSgDAaQfVHwOl13ygiUfVAtkxZResV2Esz+I" +
+	"0Ha0BHuCJq8anh3/RRJTPyix7QLku6V5/iAi5mkquCtYEIAvQgchWMA59CDzSQCC+T5YsPTC+f7jQp134tkRuT6IdMKg2oAxExBN" +
+	"90s7GO2Smo6g1F5c7TFx8LjH9a+rmi/6lkNBU28yCRPnI0xcSDCEc9ks0FRzceJCzl2LjQ7fOsVx4sKlCG7dIhvFGtZ9GX5TWWbk" +
+	"YWEfQdNdvuAkzcguzSkeJOL+UABvURDgT0lKVM4gTFvfLUTTqD4mhagAptSqkfqWyxCIZzOj2c9HuCXbjpdE4joashcnVaCoVVXI" +
+	"zo/3DeluWU/U9OM2DzFDmzJHw4nZCcWd8YPiu6h9TAU+ug1+95drVA/cx8Yi4z/2LGIHkPjhpzsiCeQ9Gg3ptBkcxP7Z0AQz2hlp" +
+	"JiC0x/2fC7XLD0ttF3vfCdkrVCqVaOrPhsRIZ/G8lQhYunRM3IXx2nQO1XcKtlWWAAdtzlB8TrwYhYGvvCLHvD0S2GhPnonNfiTI" +
+	"BWf/dMAqFWKvdViDscBmlieiUE50Gl2G2lBc3wKdA5Q+Oahrw/VHAxLHyhy9PzvLlxRYF1IUHUtRM+pZ9H5O0p5F7+dbHSgeaN8E" +
+	"4d8fZHHsPhLw4Taut2x0LBG1DCazkE6iSnj0+5zy+4IsaNqHAqM25Q9NWPooC+O/P9Jh1iMdyD0SVUskGDjDr5HNO38wwnoP+Aei" +
+	"BqKC82GqAkizxPeS2rP4d/siwIIOWk3Nhbo5E7U/ahJL9HAkMe/2RhK3/6FI/G73ROI6uYcr5dh3e6Jm1peHdV/26nofijBhUTo6" +
+	"evdmwsh0cc9m6dZePUUmTP77sYT0uxfTVcMQQdg5kjnnzMnXwTWQrAsg2W8FDwkzHELYSUe/H2yW1R1OqsOQUABpvMPA83myyXQo" +
+	"dGyrZgWl94QSanQXvSvbamkGngtlBp4N9ZyaoGo0n2U9/vSA7E5sv/1RFpBDZhc7sp4k5bh1pxU77DTwFBIR70RoO0zYx3T4JF1W" +
+	"bGDUU7hzQ5xR8ad0h4+hw3uCLIjfnkCL4U5oDET3QO+4yYu993LQMPAXgFBGgtyIrp+RJvfBhlThIBrHswec7z3M4mygYo6xF5qm" +
+	"jM8+Z6Xf1QFd7Mqji3e1ooudGbp4UHJPjwq62BmwEtbiAfpgNMhFzMSje3EAQjJ++E7SYba2mCiXTgzNfN6Pcj7vR1lrZzmDsmhX" +
+	"/Zxzrsky8h0/C6IZG+s3CR/cRInr4kU/5VRzyq1G1ufb1lmOnxQyPFIw6UA0/nA2eOAg7Ayz0IzEbIJ5e0f7tzh4PqZ9D+UqLVj7" +
+	"t7Y5/N8Pmfe44pg8YvoGrx2aFCKxmL5ja/oW5FVD/jrT+/vNnnIyi+ihPMpD0TiqpnFUpHFUqHFUwDgKBxeFhe9Ur8kqEuF4S75+" +
+	"VsBwZpHjzgocd1egyivwVWcFquOtQFFXX9LVl+28W8xdI0kqTIogInwGtmwnw0z1T2R4pZbhFWdGUCX1Apn/RDVUrYXdjNuU3h8J" +
+	"zJ10RnzSHXGRR/wtZ8TF8Ubcq0dc0Q1UO40Yx55xprhX9v6eSBTrQks4wiLH1jrmW/U5U4jPI2GUr9WIJpVKIXbW2UzZm7ubMqNt" +
+	"q2YVaPB9s520+jaWxkjULHTE7xnkELAnPbDFlCWtCp+BplYSBD/pYVG5oBN+ZA39xOkb6tj7Sk24DKa2NU1tI01t2Y+fJzdmptqp" +
+	"Fm80sGHksWlNf0QyXlXk6yprBGQ8JkuHZKOrQdrn/EXCBEGATkqs3S4lsRBYUM5eIVIPRlpONgRWJ2RpocGR0KxQE9nSWRNZZ2W7" +
+	"ILL5uLoCgy1ENuqKyIaayJY0kYUW0hDZo/5piKxsKNZNnl8iWxyPyApP7nfgyf08T+638uR+xpP7TGThtg+e3G8jsgf9NiL7Uf/F" +
+	"ILL7HUy1v53IfqRLIrvPqWZfO5H90A+YyL7PEtn3/b9CZPc4K7Cnnci+13/Riez7fsBEdq8z4r3tRPb9P2giu6uFyL5LE9ld4xPZ" +
+	"9/2wEdnwv4nsDzuRLZw3IrvTIbI7T0dkC7yh3nn+iWxhPCIbmWxOrUR2NE9k720lss8qS2Sf41R/7E7ksztRSRRfVUtXtmdqwVPG" +
+	"wQlxdHxNcr+tHJLrENqcHuxMhLakg/kb9z/f+hpxNspvqYzChpnvYGgSWfIOPO4U1n47rOH5qsrR1VKG40pnpKsuTVWNYuY7GNjz" +
+	"cUHr+biiSx6LOo1lpH37uqKDxTPQQaOFg4NVJSOIpXEIQ7GFMHAKxmPObB1TbczI57uZtFiPoKwbqGSEwbeaworGlnGGJ+3RMgdP" +
+	"xl0h5KRcq/AEMIEpdSAwRzO3QyYwn1VCYI6qPIHJ5i07ZHfW3YGNEYYy4OYyPY4bRd4bQcyEKM5QerETSudpsSg9zlC6pOlwUTnn" +
+	"Rq8IKq/oYLGnVUgiptyIaCUD0UrGGqVXOyomo64Uk/9J6Dw6b+j8iMrQuXGr7ojOI94Gn1bnHZ1H46FzBt8x1Y7Ox1QOnX9StaDz" +
+	"gxk6PyTofL+g84Oq2aPtGAT2VYvONUpP2Zax39TOosxZInOwRHV9W5qe9GR5b3uMoOOg8Q+dDo1rftUpqh3ACzLs94u/aNZIhC/H" +
+	"ReCl8RE4B9spWfmmlJdvLAIvtSLwngy/ls4NgZeAwJOSg8LpJo/EnUYqnZF4RRhwg8j3ODO2J0PkVaNat4icn3SevC4RebUVkbcw" +
+	"vFXBVNWzRuQ9FpGXMklBj8tKChqRZ/7jLWi8hc0/i84IGg/yaDzmWIGnQ+G0di4SzzXISDxu6tarLxSFV/MovLNt6YcahYfnjyN3" +
+	"UPjO06HwUDjy84/Cw/FQeKAz17Rz5HkUfm8rCn/Wsyj8bmHIPcbgd56WH7+9Azu++Txy417GIq7NsPi6bnhxp+hNGSu+6vxy4oFw" +
+	"4uMoqv7LcOLOXL2ljRH/yReRD++krzhvfLiX58N/TLD3DeNy4Z20NC+MC1f/zYWfVxQenD8u3MtQ+LWnweABb4EV5x2BB+MhcF+i" +
+	"brXh78U59J22YO+FFnlfzch7LuPuhafF3XM74O7Lzh/uHshwykCGumd3gbqTrGSSYe7Gf2Pudszdn01VfxvinvZfFHHX83h7guDt" +
+	"+g8Qb3v/jbfPK972zxveLmVou3QatC05LsvnHW3746FtXtBdYQefrjDv0xW2+nSFmU9XKD5dofh0he0+XWG7T1f4ovh0BY5PV5C2" +
+	"mpu/E3Tp0+VUcypow0/fDs6/ufmucWOaBOkRa27GlcmfmJmb/9K+x1Va+f+Bufm4swLH3RXQPl3Bi25u1lP9g/PpckZ80h2x9ukK" +
+	"ftA+XUGLT1egfbqCcc3NesrO3dyswfe8mZuL45mbdUP/bW7+Tzc3q/Pn0+U4Th89neO0EKDPnn/HaTUOkWXrRIT4wVNQDBHAddxw" +
+	"fVzlf0iUYpt1eaYbpRjHeuQQEcIpT53P8bXpbk4isZdNvOI5Q4i5rZjXkJDFnIN4tdIHMbx0+2tDvhDhifrGkXbRyfqKJqfbTasm" +
+	"3LmOho6EFRw5WiUc61z1SkBwjw/VVHW48+XcRuJhwnGGjM9mSa5pAlyeY4Lf3jjdAY53SvrQhyS2ePoo7i9IH9f3gafidyg/2K7u" +
+	"8RebvnBIgAJ3BT8473roXWM6Uruqe01fEKvHwdERgxysBc4U0QzL8nCEcxmqHA9Cf3qz/rf2Yy2th+0AKGgoEdNDSWgZYi48/drj" +
+	"saKFkvyEchLOw2d1xTPAUTfc9szFwYAHa8ObM2wQ0lLmkDv/n+tIIB0JpCMAkzThBaTRq9z6LUkWcLj6QKaKLut+M1iqYzfg+whR" +
+	"18G8KZ4tzgIgoC3jsGAd6FWNcKyLo3KggI+vQ4n2DrCd54ItQWl6cJ9kkfHTi+b7VzOULhxCEgIfM7RQRCbAcP1JzkQAoH35UKJT" +
+	"pOJ0mS9Jt1UTu0WOh3lyPMyfrRI5Hcb78qXZOTAl42WQ8HRaUz5nhqUAS8R95wjh7pokOZBuBYnW+z88Lyv3BW+cpfuC17Z2wQ92" +
+	"7Z76r7R2X/DOavE+rRMpE8INJaeAnv0A3DLGV5IfHYAAGXFDnQQCPfN5kYDfeNULsgYh58glpmkdX6bhMAAB5/hKcibQ46wqzrFZ" +
+	"m/KgLikP7H0/7uvZPZLZ64nViMwpzC+TjHD1y8f5yp3KSm5jOFcu5y8DGYPOAmGn8gwo+0eRP8PDLDDG9xq+xYvhYltLYlNOKAdB" +
+	"evEKRrQcMEwmlvZB69q1dMAWZvjnXYLuv1UFWNH6+qaa5aWBJmnUBU62RjsqWJDl7lApcvCiBiHlnJNDYrIPETP4L0ReLvW8V3p6" +
+	"tvw0kHPCqT+U/iu9vNjj9LyYVaKwn4tc8t0euD7xOF30+p6iHyAFl5uqwuOruk7kMNOmaZgzBEqP7egLB3DqN1s4AIf0mwwOYZ4D" +
+	"YP4+ydJEtHEVJx4dv862ygpnqOzxs6ksOkNlj55NZeF4lempe+a3zqKy4BzW5tiZGhiQVXLb8c+hnf3n0A7z1zNb0mf0ZzP9oK7T" +
+	"logN4/iwTrxSX9+aeOV0YE379zHar+X6qG8HyBwsy4Z0UR1K9z1EW/mkssPGe5HIcu8FflW647dZWGupUJSn+QIFKfDv6EGtmwKR" +
+	"FHgaBeJuCoRS4AQKlFoLiDdcvkAgBZ7qWED8s8eflE498KXCo6iw2lrhY+dQoV620d/SFQpgKl3i93g1pcTYY7rG+j8ppv8EJVdY" +
+	"IPGQcAVZVKr08/4/ELAC/kz3fJjq8NIxelb/FFGDaR6TCqbxAz7flCC6+a25mh798PnK1ZSvKZer6d6Qqj0N+h7/fxF2grTvOla+" +
+	"0Vbok61QKlfiaq2ntz5h4qS+ybylDnMHBtIqosP46e//ntw2ERnST39F305DeEs/vVffzkSISz/95gG5nYFYoX76Z/r2IoRx9NP3" +
+	"69uXIkyMn37nd+V2AGFh/PRT+naB6NV+Wd++TBRdX/8duV0oho3fsrcV3H7vI3J7idhsvqFv+0WP8jf6VuuRPvMRM0D2IP/wR0yf" +
+	"2QNxt71l5dKf7Jfbv9Sm0Xfq+6tF435UT9ZVApyQJIHcwC7SjMeNnIycznFFYoPXDn1Y48rE5+xuiYMu22TkOrOewpsypE31hIEu" +
+	"2agm8vwSw+00FWcdTRQSt/E3JWKajv7+GKcRPKgDSdxITAk06gygwmCUmt5KEz0vCBanE8GwIzlgesdQM0ynrkyJCRtJ3z06Gm5G" +
+	"FIHpkLzRlukNEp3G6ZQY3fk40owhBogve6AZaVbmuppJOmbU6ulEVt9KHsIAjRXSl6xkuP2St7wG/T2nj/SRKVlpcTadwpuFYb2Q" +
+	"+ut7moWCV2j7z33E2QobvPN4hmQNouU1INvYaUWZViJO0ovktLp3vvSOp6KQTUWAAlxc2PNouQRmbJv1tgfUKsaINZ3EA4JiAPP3" +
+	"aUXzp/cyz1+o588uUcQzF2AI3LcC+laUmStwLInAGVPUPnNIpUnzFgRe0Paf+ygEk5qbs1BW2odFTjQY6LgeQdsYP6lUtP0MA4my" +
+	"gcgUX7aSo87w0s49Yxfz/3XZYTs5xIq3rUtutuKfmahiBxEvdhemKOOJ7HgKSYT9w3qnIOFEnWlzGSu2yklgfFgB+UkJOWHDpATr" +
+	"R4h0m9RVXeIpDhRbRh/pZ0WNg+U0y+vhKIgM5Oko0ay0kR61sNX6ADWmxw+a1Jx6iKfs60DqDlY0Shz2hPrA0eYUIJDBWHIxcwZI" +
+	"ulvfLLOeLtVaBNav044qIcgd0GGZsASEm9LSGhMWT7K2lfQaCNUWHg8/M5MyYpLQeLmKYioJnCPMiwB0soJbQ0RhMU4ZEoi+bw5l" +
+	"798wxArnyooaIeEGjLyf2A5R6UdW1ibTd4X1PdMnj/sfJ2NElllG2wkMDovAOiKXJ6aHD4YUROyfScO7ATWb5tgeSI3xA07zHqa/" +
+	"Zm4JjSDeepiuGBLLYph+aDtdxlyqtI226g2o36msmK+s2FZZEZVx8JQiVxZJZfVtTUwD1ehUVshXVmirrIDKiqiswJUVpbJ+XVl4" +
+	"9pWFWWWI7pWWRmj+qyPDiXQsHEEEFa53GDZHBGE5FWxOR/cHYiI0d8uYUcJiIpxdRWLX0LQ/T6+f/4NAUoPT8iDsZgMOFGlBJ4aQ" +
+	"b0YPBgCckjyM9cOjdw8RacQ1aBFhx7SXA6ONIrUnHlN/KtRFejIa4tPR0cPeMq7z1SuHwYaeev7V0r9hgizwebhVw4u8GXw3cVt6" +
+	"Cnf9fFfYZl9P5wfV7ME0pvLE5HGUnnqTLRklNlH3z/c8/hxdoP1DI0UQSR0SqDQkO4D7poebqBnorJIc06N1pl8YA7CJ20vbjeMd" +
+	"ezktDbRIsAiOL4abd/os349xae3e1DYJVL3z+fhTAj9qZcYfmPGzgojjoDGlt1MQgVUrSggkvcn/11Czmv61t7I2iU9CKMNPaARR" +
+	"Bc/7xmnOf960s/hPPlb05+snAf2F06LYYwNbNVHLwu2yjGYYBFWxHgiQCD8jHMIs6ZlKpCW3RLGbElW3RKGbEhW3RNRNiZpbIuym" +
+	"RNktgZB+aZge+n1DgzSJevr3DQ0qEJ1bJi5kqc4tXGGNZDpB8g+c3VzHDDCxpiQ0jSUkagd5I5JQBtUE8ACQalW0wI3V2BZdEmN8" +
+	"tREafzmbmzYRHWl2fERMd6eUVVr6s/3EajTB1Wt1aA3sRilTeotaWeXtjFWkPblAp7NF7u2xgyZvLQy1fPeljswjKDjH0UQah7gR" +
+	"GK6FaPZKTkkvHZ27gN1s2ssbMyj6wcbvktVsV61mOzHpd+tv9eItzJXmGTnL0YNyt3CjU1fqTME+MoUXDctsH+b4tBB56rnbWFQ2" +
+	"XdHaxguhicW5JqvW5janc6MJJ45HTE6E+vSQ41mJUt+Ll1JBq0BN2X4KQFIMRXhOokUsqlXiwhexTjUQi6JizTjyxMav8bqt43vj" +
+	"1vG2lp40gzPXYyuQyQl4sOfQ9rdUuxLBqAeUUQ/UBN9DsafVcaKWGBCxdSY9eOijop9TjKaNovB0JQ60lXDUy6GUzZSrM61oDP7w" +
+	"YuYWE0m7KzrkPpv7F+X7smqtXvClNM3paEkH4SQgGy2xit1bMlpakInKX4JAIpqhcJYnFhGevnAJW/TRtcrt6VyiU2U4dQnvmVaI" +
+	"wiLiX8A3G8HnA60Qp7gSKUMShDI35TAfJ7wVCUKKFRqIKYndGl+PpOcqJb5lfTPCIlLTzQKbF2QdpZqISaBezALvKbbasl6r16LR" +
+	"hz6WIYKK3AFPfFv5kdj/EpMIeCoWycNVfUjGGOCHTUlLKu94hPs8A+kdZKxLKj/FDmg81iWVUcKNkYgqMlbGXYQibVms4QnvTQmM" +
+	"eCU93jCdrMfbl4Trm8XceCMzXl1VMT/kiARusWskuSE/aoccpn1yhyG/r0eV9YqWYPC0zYTtmxbPiQ01G6eJzstTlofQagkgXYWY" +
+	"wdiQGBFiNVFRo8h1axhQHF4z8eew3gKikbjAECIu6EzBVHMFtKMCD1jRePtDEqMQnFPMGSsu2tzQzjPE0nyF96RPbGthZDirabGu" +
+	"iYXIikgUBciJr95MDwosyiJKIvShBY71XSXWawUcdKiqqlvVdqQ0RqfoXjoFOoKeSH1gmSeOEAd5ykMsZKptGHJzJAJteifE8+mi" +
+	"n3lKO/eBHV1e85lLA54Ks+mO8tP9FG+0iB/b+e6ujJTgsOgOo1cAozc98Auqg/qnUIjCF6VL/5zrUuFFaeObuTZenKn9x1wbL85c" +
+	"PZNrI3hR2vhaC4i8GG003SZ41yQsc2mSGmgZLNAyWGBkMLjZGbmnh++MDFblO0csqokMVgLjUxUZzC1dM9+zDFYVGcz9oJfvjNTV" +
+	"Vr1tPWuPxLBQD7UZaJxc4iiiEo6acDPrG87/dP7rOZSZe36KkOiPNGiVFRbvBmm4rVkmUkYIbwGns1ArZhDKPH3lzJ+eXX8IX1Ej" +
+	"BTQSz3AAKpbCflJhVaUhW25FSlwMWyHS8HygeWK9SiV2eEnMYPPZNMXKBv7Gd5Y6/ce/+8jXC/OzWkCO2PAbv6lrtveLwnpq/wkj" +
+	"50BYy7Gx4EV//Cw48u92X+3HfOtiEWp/JzAYnRuIcg2kiK3gVpawgSAJ6ru0hwy0/sEyUe52x78vOnv2vWWwnUbJahDWI1ZN9G4B" +
+	"CALTEFbGgrUI5SNvB1bcLLCHoSderMJNxqd6/dL24J5xzIgqnUE8BOInA8JuIZGOhEu1ckajwtrr6XhQgl0GT5H3i/g1elSAvpql" +
+	"UDDsBaQ0APdN0BfPJmaptmThA82eJYt2PjKk/VWgbKVPepLakoEH2BdpdHTsee++HfTZvJ2PEMLKvvTNl/36y+Ovvo/uSnJ36qL7" +
+	"duxo9i65pKUUIkUnvUsGWh6H8rjZ8riAx1TnTtT5bC/q78f1c8X7dqCPdP39AE8X7qTWeh4hbDJlW66GImoopPX800j63rtjycyW" +
+	"FsEGg+kbGm+Cyl1PUOWcJijuPEHVzhNU48dnMUXtI+7hbnaYut6OU1eXCaqOO0ETup6giec0QZM6T1Bf5wma8oIhaGrHaZg8LgT1" +
+	"ywRNHHeCpnU9QdPPaYJmdJ6gmZ0n6IIXDkEXjgdBF3WYuiIhyBJNUrOUVK4TGgtvu9ub8fpmGdgLnFqZDS+NQrUSM4ILcDihhCwX" +
+	"FXxSSoopJ0crwqJaAIIrs+m6WTUILoL2q5j620y7VUE9wFt4VXK6VBWsR3WOpHM3MxKU0uG24ewbX77xR9KrN1OXCtm7Ag6S0njK" +
+	"K2BvLsfpqY+Jhu+QsQnqB0ftg7GPsRx9qvWL0T9qebDXPABqT8cOGZUkYfX0uL2DWLzrj8wdjozst3cxNAbFVLFBurct34MvGR/4" +
+	"DEmiwChT8ethtE4Y+3pJeah1LtkcWsHXoci8CkVAwH13Ir2kwkU94oM3u5NZoMmUMgVTxpOJzb4IoOYoXF9TZiZ2fdzMBPezIP0s" +
+	"tvbTc/qZ9a2UtcN9c3vCJ/56udp07I+0jpwn8ai9wwQft3ecJDR3N/pxc4da9n7crWX/x90vx8zdBxW7igibkz766THwjPX3SKYq" +
+	"Nm7DFfw6NicFt6fBFmySiG3A4Xq204dD0Bw0VABjcbCM/R/8ofRHxfMUHiGsi41WaG0uiCwxCb0wSQfpiT/UWhtJLfcJYxx4hp6n" +
+	"L0/3Ari+pXA8hD2hk/XNiHU6wUbixJ5/nm1e/nJ0hJZOHEzoMtjAoBRKZimkNYYFjdodFqO/9u4NUrUF3E/EiqV4v9/JQdb6D0pU" +
+	"XVFZDpjTPfSSmLNiq0PkFaLJDMTJ5/gnjEPkvHGdYotD4zhenl1Th8bOvqkW38tDH2vxvXx4bDzfS+hYqZNFTK4HM+uT9Gl6RfrU" +
+	"J/WyYaW2xX+iZGIT9noWfTNmNWFPe0+SyMFLuf5RJQaFOvse0C06p8QV3PiVA1XDYT/wYoFR8cWD4UF8yku5D70FQV0q/5hqsDxQ" +
+	"l7w+dNVn0/qkz937SeqPAK1WM/7OZ2gwr0j3fkZv+R6824+Hfv3LKh5w/U7F/csTt4YqGy6t7+Ey0bmL72H6LDUEuYzt3OzOBYj0" +
+	"iOUSl8TqUP0PdX9kilBH6i2Pf7SlPZU+8OfswnqvL25loiOvDqW76Hn9FBwgv207cbnMO2ag/n0l0/s8/+LJVxV7G6n6L/j64n/7" +
+	"8aHQD7f70B0f91h5nHcqpKkl7t5/bEnwwN1Ed4o/xXWVaF3SMXGWbIa0XdNPeoQ/STD2r+MBP+6BZvJRE35VSCRPVxMHta22mwGO" +
+	"XpF89H7gpmg9nCmTaIhTEkOvuY1fEAFtmnrEnkbPFm/WKgtxzQ1Z5AAxl85wWl5ooSPsAz5AMUucv0LGT7oa6Qk70RFCg/aYEH26" +
+	"63GmAyeeMCRSPzjc+uDAZ82DvX/BZPbwXxjSEdEEpU8+YWhjgM13legi+khilKurh0QKD1jvPR9KJbqaRVfXsLJV79cAO38OPXn6" +
+	"L8XiEqACTpN1FA/eIjm+XkcPDvKDa7keWFIWSq4vj/EDLg/jss6Xi9ezoJoODKXHqOL6cVGzU7+fecJgbL7d8RdmGJ52FUJoKAK4" +
+	"v3i1arIT4sxGEz/9jQQ/fY0L8VNvXICfauMlciqjwd6TjYsESCNxl2X19VBjVgDPIDXAPnf9I8BC0Eg/V9w8jOttEsWBXm0bHm72" +
+	"Jc3kgnT6HfTP5Du20L/FO7YMJRcmCV1vpn+HhtI9X9m3m2CiTpU2iNFKLtpMkvxFdL1lC/jIO8De34HLChUdatbwqMaPaniU1FBm" +
+	"C7VIFSeiWeiqa4Sc6slLYCS6ILmQyl+4Bcol6mrAXQ24q+m73vmldxJC7YXbZm0z7bCIfrYAPvEv+uJzX3zuHo2MER51oI4O1J0O" +
+	"1G0H6tIB2mG9SYPqpiW+gDpwAZXvRQd6uQO90oG//Pf7fvttQ80yMcdorcStlXjkEOBrVLBIBS+Ag0eXDZP0Uk4ugjIgSHqpfEDl" +
+	"i2i4yA0XpeHf+IO/feIe4p6xNKXNzUlwF+OR1+nfOvpS577UZeSc95g7UEYHyk4HyrYDZekAScFRUsXUF7kDvR2n/os7vviZgDtQ" +
+	"Rmtlbq3MIy/T2OtUcNIWUJg+bjZCs5HTbGSbjaTZ6QmJr9WkRkAEY76euRoarnHDNWn42x84/JcRNxwlZYy8zGOO5N9WkBRaSB3o" +
+	"RQd6nQ702g70Sgd6SByhCazKmtf01Lev+cP3f/9dd3EHimityK0VeeQAwLId+cwuYZ1YTnhN8JTXeMprHaf8c3/07WNvJUgDtBc3" +
+	"k+QU0c/40F6TI2SQadGFmtOFmu1CTboQowN1mXqz5u1Tv+dzvzr29qHmpHGgHWtW5ZHP6hLWJ2LNy8mkM035vb//87sVbzOG9moX" +
+	"0D7AXQjQhcDpQmC7EEgXJgDay2ea+o//3J++3+eRjwvtMvI5XUL7ZAJ0ApdJZ5ryP/7uR/YRtBc1tFfPAO291IW5XcL7lCGRwE8/" +
+	"9c987DvvKfLIx4V2Gfm8LqF9hkA7gMiZ8hIaLnHDJWn4+1//jwfupHXR0D6tC2hf2CW01wAidegeSnbqq+hAlTtQlQ488vOnvnwX" +
+	"d2ASWpvErU3ikU/S0D6NR351l9BeB7QHADbATJXKl6h8GQ2XueGynvKv/dODdw81pwEoJ21uzqTGJvHIg80AyAsBm5MYNnnk/SQ2" +
+	"0D/T6El/2kP/IlkrYKkXsFQTUrxtCxYaB4oTqrePwKiqV64P7fdx+33S/if/+h8evofxzDQ0No0bm8YDn0b9n0QFZ1LBEO2G3G7I" +
+	"7RLNpt1UR7t1brcu7YKaELCUhhh8+6h4ueOEP/B/vvM9apc1TdMw7oB+tiDR+GZARR7jDCVT0f5Ubn8qt08kezJAuBcgXGMQ5vaL" +
+	"NOU0e1RvPxEYtN/H00bt93P7/dL+n/7hA9/3GMuEaCzkxkIed0gLNk2Pu4J2K9xuhdslij0FmXV7sRlrshnRro+VYOzWR+32jzvf" +
+	"j//x7+zVyCUJMe5QRkwbHjCRxzegkdPRg+ncg+ncAyLZM8Al9GKD1mSDogcwYqD9qUkft9/P00btT+X2p0r7/3Ts4X+S9itorMKN" +
+	"VXjcFepLWY+7B632cKs93Or0ISAxH6363KovrQaAhEmAs35qd+q48/2b7/yrvw4ZziclFYy7TD9AqID21j1HEwcd0zb6Bz0ocA96" +
+	"CNwASb2ApBpDEvegDxiG6p2eTOVxT6Xi09H+dG5/urT/1F9/6hsRt9+Dxnq4sR4edw+N3MB5jFZjbjXmVgtDvJHRasCtBtJqKWHN" +
+	"8zTMd38yfdz5/sK7vvkHRchwhNR6MO4eGTFDe+uWo4lLJqIHE7kHE7kHMXvc96EHfdyDPukBa7LLkBmncvvTt8BuSu0XuP2CtP9X" +
+	"v/6dT97F7cdoLObGYh53TH0xcD4BrU7gVidwq4RUisDUvdiINdmIaLWcsNq7gvku8Hp3nu9/ePDPPkn8SwXyYYxxxzziUP5t2XI0" +
+	"cclk9GAy92Ay94DQiw9I6gUk1RiSuAc07xXA+URqH+MuUPGJaH8itz9R2j/ylw+Ovo3bn4DGJnBjE3jcE2AB0OOeglancKtTuFVC" +
+	"KlXg6V5sxJpsRLQ6CZBQISCk+Z54mvn+s+e/8R/Ubg80sBNkf0/gcQPaW7ccTVwyAz2YwT2YwT0g9BIAknoBSTWGJO4BzXsP4G0y" +
+	"jXsitT+Rik9G+5O5/cnS/r/80ugf3M3tT0FjU7ixKTzuKdT/CXrcNbRa41Zr3CqhFNpIk9DqJG51krQ6DZAQY9xQl07W8x2j3Zjb" +
+	"jfW4f/1XHyN2cSI1nUzZTIS/Qj/AKT2bsc/yWw6Eg9qvc/t1bp+QSwlw1As4qjEccfs10R9PxLzH3P7kjvP+2T/90HwG84loayK3" +
+	"NZGHPZGmbQqVm8EsDzXby832crOEUWgfTUOz07jZadJsPWET0gQCHxo2oCXmXTIdoDSZQYmb/b9/9fD7iVmdDDCfiGH30A+Wu6cj" +
+	"mEdoP+L2I24fLHFn8h0D3CbLtE8Yd9rvf89Tf+QzmE9GY5O5sck87smYNj3uItotcrtFbpcwytTO5JvBfIpMN9qd0HG6D37q6VPU" +
+	"7hSA+WSMO6Sf8cHcR/s+t+9z+4RbJnUm3xMA5lPONO/PffEj3wrHB/PJetxVtFvldqvcLmGU6Z3Jd4x2J55pvt/9vuPvjboH8wDt" +
+	"B9x+wO0TbpnWkXjnwHz8eX/ymx/aVzgznPeh3T5ut4/bDcYl32wQnnym+f7D9+z73WL3cF5C+yVuv2TY1M7Eu0s4/+1f+Ln/feeZ" +
+	"4byNPS6NS767g/P7P3/k3Xd1D+dltF/m9suGTe5MvLuE8/d/9vn3vPXMcN7GHpfHJd/dwfmfP/6tsbd2D+eT0P4kbn+SYZM7E+8u" +
+	"4fzUL/72L9x9ZjhvY44njUu+u4PzD3zgI394d/dwPg3tT+P2pxk2uTPx7hLO33v0zx98+5nhvI18TxuXfHcH5w8/eOLA27uH87Mg" +
+	"393B+f1/f3Dv9jPD+VnQ7+7g/NTYb96nuofzs6DfBs5DZ95DtB9y+6G0/4vvePcT0n4BjRW4sQKPu+DA+YTuKTjN90TA2UTN5cYd" +
+	"2eOdx770beFbiOJj3AUecST/tqma4u4puIHzogbXsKNK+/F/fP/HiH+Y2VEMpS6dnn5PQKsTuNUJ0upkKASmJDMx30XLHrfP9+99" +
+	"51O/ETCc+xBDZ1BbldMomiaOR8Fj9CDmHsTSg4kazuHjYhTa7WqP333vqSMBj7uDGEpdOj39noxWJ3Ork6XVKViHAsaN+a6OO9+f" +
+	"efJr/0bIdAYofo+omXpOo2Yah35PRPsTuf2J0n6BT+KQ9ECUvHgaNdNf7v6Xp4hvijqqW6hLWiwZh35PQbtTuN0p0u4MrEMEMRQH" +
+	"+fr0fLcrFv/lKx/9ZEEbEMLNhNwC+oGaKeoI553o9wTgy17szZrsTaHfxn5QTEpWzdQ+7/94/8cfJr7JxzncCwELk4T3wb/wd6KC" +
+	"lXHoN22kGWh3Brc7Q9qtYCV8iGNlaNnGVSv+05++50+K0I0QnFc3E3KL6Gd8OO9Evyd3pt8lqJn6ZN7L48777lPv/6AodPvQWB83" +
+	"1sfj7sO8UcGecej3RGjdeoETaoIThH4X5dgXz3dZqxXb53vnjoe+KXwidRLj9ulnfDjvRL+ndKLf0LQIvEXOvEdoP+L2I2n/P57/" +
+	"7v13QUfRyXYQMKD0jEO/C53o9yTMd5WWo4lAK8Vx5/vvP//JX38rq7eqMB1MheqZ4Ryr3gp6UKC3UnBWKoZoP+T2Q25foHwaoCji" +
+	"UUcszE0H+Exm8OHW//3Pvvnvb2PDRU0rm6fyIPP2YVh5Wwk4k++2YYO4FQFkHBlDT3Ydzda52bo0+8/f/pPfvRsW4UZEI7poqHER" +
+	"TVVjqNGgTrxkqPESWHOBPxoXUMsXDjUupNqSoUZCENQcajQTlS5e31TJrOXaA6uJcBteksDs7yUX4vikl1wAy7+XvISdUJKGRPG5" +
+	"SPyk4CIe/0Lgh9vVPexl0fDFkaMRsBsIx8Z3PEMk8NAYhy3muDP19yM+t9J+HeLT8+qVNQkQCX+LdJRd3MVpRPzlwhUcsFZJRF34" +
+	"bfj26zG6WF//Zd84mtDwtGeHSovXOa2avuBlpB1EqBxOOx/2aP7z3q90v/CBHfSv6+ka5TxdcWtdVCPHRTWyLqoRu6juWMC+G6Yb" +
+	"PPBejBs+E08YNzftK7LbOI/I+4eNi0W76wi/P2BcR/40UCX4BB1V4lnF0cYW84IjrGfAcWVwZFGOtKoBf7FMbyTTq7C0i/Wpa6oZ" +
+	"EEKlOf8a6jjssRMMrFB82OGwGtKBrmGSw8GR9JDzaIC9ZNIDzqNkiA9fPOo86gc5VenDzqM68J1KH3IelYAKVLo7e4RAmg8qXJqY" +
+	"V3J6VVbVhr+GntzezKQNZm9mEfKxN3No9e3NPMIJ9uZqwsD2BlDi2zu41HA80tfRzZPeUHrEPMBb+OQcdR+coAfHsgcKqWvZs0wv" +
+	"+3N/rpf1YOCr7aE+pQvvLq/+6+JtJ+3MxikdiS9eH5KW8EinIOkfkrbwKJKpoiZf1yw80vRgwPMcA55nDXgeG/Dm88GSoKsv4QEY" +
+	"dvVlHZDV1ZdwTCxInxFCt5sioXxb7ebbqnzb1823ffLtzG6+ncl+lR3iCvaIE987/9qJK3j4s2OIK7jnr3VcwZbogcc+e76iB+Zr" +
+	"ykUP/HAgp6KMbyu7WxEugFOeRtshO7kq6kL9NyQa2Rw3RCmGvOOvzJH8V/Khfe3z6Bnv0pZwAISpX2n9VH3rp8oBBDrV1DSeruye" +
+	"VmqvVeWDDMh4nSADp/hBf6qyMogNEabBSHrnENFknG0OtSOkRo4e6F/AuR0kNF97tc99tiV2QY2HpySSX9vcXC0j8t1YBZ5TWPEA" +
+	"Gx47QOOsNtAuH75OM9famrfkO3/yW//8xD8e/bc/2h5XvSU/846PvfvIsY98fGO8yvqT+tabVqJ6aw9PApz6O3wMyozQuoaOAQj/" +
+	"BT1Q6EHAQQuYL/Drz3TpLPrzxkf0Xebi5/z4YrsROMoAILBKP564BNIueOZL2AXUDLbAtZ44Voc1Lz1+vzjEcp4Z3CwBksdsnJA3" +
+	"HH2Ev1jkITHnAMfkZJIWNyQyM0Oz4g/F/ZcdfDkW909Z32PXTTcUvGkCThvUagJOBzyJfFXiGBPpPpo48X/+ujKb/yOKNpWwPOKo" +
+	"3Qw0w7E+5eP2JvriCgDXtcM1ZeNt0EwEMzhpgA+nedrJnF5EgqFI/FZErmgoiYtCcEIz91rxZ7df+fYr+mZpzbi2e9wdRgpxb1z/" +
+	"nGxmxXjgRp0CAZ3NXKF9yx/0ac/oOkd7oYuZdGEKeOn2ZZhiPd0EO5LnQC23kc8/qE9fiqs3h6fa/Y5P6tLEvHmIGYcnkptgj37n" +
+	"M4zpTA6Y5D9QDd+UrAV8lW5nXGjLCFzYng3JwNEX/qT+OwRBuOCJkBp0hTpOJbBvzc/qTnwd0F2fmaWnr6up1pJyvhbrn/gg5Z8j" +
+	"sO5ntibd8bd0vY8e1P+ephpByJ+kB3V5eeJv6HrUvPxuqArbtWc1vMJ3vcPZBLgB7pGBSC6I+u8qmnFem/4mNrO/rOnPEBYePDKI" +
+	"RM3Lc+BQE/HsXux5kr0GXDZx4r6/ONt2CCShdxrHhMj2oOxAHYv8Yi9hksMdADwG3DrDLdoDe4XW6r+n0v1fHkNs9Kfpp/6YuDj7" +
+	"SHjH/YrgC4yQep70PhB3ZfogkEF0hxEUBpPE3CaDL22a9Dk0nKQHv2IaZqfkaxMG8eWLvNelu7FGr6C1RaS3Mdw8dEyvSfooXaVT" +
+	"08PHbGl4YV81X/OIJEXM14ykuGYL7zmf9/+Tx4D+r9FsoHwIYkeyVbr3b8Y4/KHHNcyh3wf/RnyzPfHN9tJnj4lvNqqUOLCe9BFu" +
+	"2poRRVYDXMI3e4APBqSjn6eu/j53VRzVOTy90rtB6Xj+2FC/w2lFxDV7rt3DzCvOYJ5hKQBpGdL4pCe+QPMwPz3wRT0P8ZWtRw+E" +
+	"ouiTJjKY0F4ByX+Siv2eSIzCdSjn+ADiN+ltz6GbwK6GSOegdFhwQcXJUP0WxgapPrYh0F//Rx5miUPbDXQ8GZLwcT4qf1AJ28Sh" +
+	"ooha+OnJ+/lcBe1rXPGGoM1w6t5P8nmGaMn2N4RyS3KcuhuEIFrivYE+P/g3fBrk2Oetnz9dpQvSI5/XsyQVyoEWky4Dh6r4ydy0" +
+	"JElYAlnzWSLE9AuAQgD3RQCPQHEkVFedpZskeAwMhmK2IEGsUgW/eV8Pfrbfp1n++XYC05Po2qU8Bekz6PeeLxgYb90h3FfOoIAu" +
+	"4uJjoGpEPD+i2k65N8NlQtXqBT7E3tCZJbLYXRxyTIAQp8A8nl5otLigCV8TShzREEtIzxCODDtxVvrQkzaKkRA5n6k4awzAoCZB" +
+	"/bVNnzOLKpOxwcllE5e1cOjFVzshkE99ho+14cC9phZPy5Omz/HmmItBSw/5SwktxfHHAhsUSjId+cON0JzckYCngTkTb5PhKU6G" +
+	"5/kR4amHnxrD8bWjT5lZRllTAfWIGpvBAFvjs3OiEQk2ayTIgZDPSBFo6gKiCo2I603CrHo+iDdDeGRaAY5sbAI63L2ZpsuXs3og" +
+	"CRd7iNBa5VCkJjsRHzrha41IAiHxPmMR6egyLhQHi+sHlK7Fk5yrnjBi7bjIVJFNJFY+u1EceBn3OnEGoa/0qBxJnIBELSkvtV8/" +
+	"pGLNqRIB/iJhqfSqdLeZ6lOBHBVcPJQdNvMlMoIJ0c0iL42i6Ym0BiiwsWTvpsfMOEtiKBHEdMgJTqjCSIPjA/ZzhG9fiwaElDUv" +
+	"qE86cQJDAoZ9X5ATQl8wZIUmZoBkr5k6/gbjo1Dq9ZjEEYbsRJ5zxJngVOSlEJSZk5tpnljqb+eMZO8OMC1oejqyiE5Ro7HbqacM" +
+	"dmujpEowFOuyiJL2GUoKZC2E9EmDZNhzWlNLOM6L7KeEHKr0mc8JOVSaHCo+SQJCpzQZvToR3C7nFOGNDNqnWBHFEiHaPP6Upn09" +
+	"xFdBuJhGO/g+n7NzrQRSL3E4Z5pLueBAHk/IidD6EZoCZHGRKNtB/TNAAlAw4vgqQcfKGfX3+cLTDmgRg8poyE7v5qjMwWaBc481" +
+	"iipe7eZ24dNNktpFz+3+L9lQi0BLgfNq7EuZfs+bbzMqQWP0Ja3i1ILT/b4IfwlHN0HAEgHcDjyTISGGbzJQxPo/Ofbqsw6Wcz3B" +
+	"g48hOdRUvM9e1dMdhk0HR+zxbWJCNcpt+qD+JAi3pzvlWjINCe3G/g2hZCvhOCbCjQDDVOmxqRwBUDSsRqm6jjrmxePwHF58wLeb" +
+	"XCe1CnXaKQRm0+mtQhHpExbkA0k/0j/Eh/2Q0Dt0Zb+5Q9xbK/uFfEXyO8BXy34DkP2mM+ZpcoTMRAsNs4N+otpyEDVsRgIjVgyN" +
+	"JG0RPiuxUpjDe2iOhalogHVXIktJPJdQNKASSZN2DvSHNvOWm7BIx+dmRa4FG1rqL7maIOoytnn8ZZWfNcwVU/DQZOzig9SnmbOg" +
+	"Zc4Cd86CM8xZcLZzFto5Y0gKZM7i3PhCZ3wvQHW288vjqs5a1HX5LzmKUf5U7h5gzlL7qdxn/lafyk1sIhR5sffL7cd1/8/L/RKn" +
+	"F8ysKum/ShaH9Inn33Y904uYxNj0zs3EAezVuw+0nLjlaD2CERpOGwHGPU6RfBHyET//fHEl2O4oLSTerU1vfXrR5m2pgnJTjaxP" +
+	"XzUUNznAxKM7PumxUsPjk7PAr8VUbcPndHHRshkjDT9wlB/UE8SEXFHDTo/WS+AEah4hiZqFmsepTaGjwPE5CcfIERP89IJtabh5" +
+	"CIf50NryGXgxo8Ec5QiIL1slmH/kDNGBZBUmkRH92DzShDbFWz+MY1TCYfP95rX5UUBBArIOrVTWY9o512P7wEJWWLGZZGoOnVtI" +
+	"6KLAJ1WbCoGaItNvIZ2IBkm9ePR+rYugWlKEjsTcXz8D+hywB0XhKRjVNZn+BmlfWudzvk3JJDZDOldjAxmPjiPvCrpjrl7sZbz1" +
+	"0CkfOj0oMZs4h6g5iKX6AH2DQ4fWG6za4CO6vGOoE8WlNc/0ZaadGE5ss5zjy9fTPoQrq4N11BGjkORPZ3ETZtVjQDPQlt7Dld2x" +
+	"eb4ke1NIjtqPZ3WGGe59XUr3xzxoq7dB3xPD5+G1j+iNdZ1dIA2GJaldxPE3UjUsyQgLEraKnoz6LEAAMRI5YTgvuHBeyOC8MD6c" +
+	"c+IBz86GkxBxr6V4YHgAZNFmm/3NQk9S4IzFDJZcBJz2sDCYjJg4f2I/oL7AG8gzG8iTDaRYQauyHdCM9BThKUE/td7wHXhFi2zF" +
+	"FXE/FHMt04BQBMpQkI6kAoUiMTJd8XkZQinEPyUAHE6gECwFI+nD78AkgPt8yBm+GmlygAY0CV4C74A4eZyeRFrWREjX7HaASR/c" +
+	"rWmXyqoikYxwp6EsLOcYHpKgME7LdcZwGDP9rpiBvOO0vtxVzpkbmd13Hey+tIHMmo2PA1Q2j0CRED6DxVq3IFvIgigUze7XQA/A" +
+	"C0Q/Zkh0NRLhsDS0a6HrYwFw+xaGLx7rCA8FjkB13baJQxswOWuGyyWMikxWMN+ZXZ+2lsf7KmaGOxhxZk/lZ6+KxA+ECr2RZgG7" +
+	"gPAgFRnZ3DQzIGsrnQkMkuUO4KrAk9/kaBQFrYvDJst3RunOeHZvetibMABT3xh9+nqXooOicrXIkalWgFwSGZClB+430nQGbsT4" +
+	"EThitxXaII5gyEBcwfgFFBw0iR/0O+aamRHiHugkt72xSGyCkn2xIDJK9hkl+xYlB20o2R8HJbPJIJjR4KxAGUoO8ig56IiSgZB9" +
+	"6cfp0bHvomMOk27RsWpFx75Fx1lgXJdGIUTL/ux2ZQ2BBBG6+sPIckFczLbFLLEvq8EcXx9phktrxfQQZpFkrFESjeVuAAkoWYE/" +
+	"BsFi7H6jeGBxaCVUD+lB/ZCTxh6mm4YXGMODKB8RCgnxghiXeTpLkgMWTEwJrnqR5OPA/VrvweNJtbovtyuAlxscsB5bA4Bj119g" +
+	"AvFDmgUdR7sVXIUdFgBHKGaj92bUlB4zs4ZNlYTXzQAXjoeSoSl9Cp16/iI0ddg8TY/aPh/BM6QOT/djxbAHeI5w86i+edR9M4ab" +
+	"A/rmgPvZEdwc0jeH3M+O4uawvjnsfnYMN4/rm8fdz47j5kl986T72UncPKVvnnI/O4WbE/rmhPvZ0+6bZ3HztL55xr0Zve+T8gQ3" +
+	"z73DudmJN8/pmx3uzS7c4AluHnRv9uDmQX2z273Zi5vd+uYh92Yfbh7SNw+7N/tx87C+OYibR/XNo+6bMdwc0DcH3M+O4OaQvjnk" +
+	"fnYUN4f1zWH3s2O4eVzfPO5+dhw3T+qbJ93PTuLmKX3zlPvZKdyc0Dcn3M+exc3T+uZp97PRHVgFffOM+9lOvHlO3zx3n/PZLrzZ" +
+	"sUMvlvvZHtw8qN886H62Fze79c1u97N9uHlI3zzkfnbQbqiH3W/2855K3zKk1WBgrvSWDofSAbpKn8QGHB0dVYISE52Zj0OaDbOa" +
+	"Z5htiD5HmA+5JYu4vLR3iCWE9M4h057BnogxwO5o64eYegqmuBsCsRIqBe13ex+UYJIMnbAEFK6YISMoIpaa4ErjnaAJgsaRIEd2" +
+	"GNz1UFpjZSUPgsMLBpphjhghJuF68DuRkD4zwOUaq2I4UOcmmq9lBku0hWBCMQP1T7Ov2FBDous1ODgZ49l7NmtmwM59NJRo7ZLw" +
+	"6J7w6B7z6IgZwUq0DrKouhV2c82jK8ujq/ls/bA8ut/GoxfApDLXEIBr8Ft49Eh49ILl0QPNSbB47kliGtYeGuZcjcecM2UvOMx5" +
+	"oaUhsOYFYc2ZJSmIjczaegrCrzNnHMXiH2FyReO9KKSFxeB+EdfkZ1yTyyth1P5I09diicOv6eGpmENmiZHO1KzkxxfeX7aW1wbd" +
+	"ESdb0jp4ZjKVZqsyCvzoeN8KSWXlCDO6RD0N+beeBzqkIIMtdlIgzqCwJWGTxM6eP8ttzj6XN2HjIjkB289o5VYgLRbsnNyV2T4S" +
+	"J7AS+XG5Zfe8pLzAL3Uk2hlJf5z5CPZPwu/MIeFq0qIGKFjKvJU1CbO4zJhBzD7hpDzrm5pzt0Ki3imB7BSEA+PNEroCbZhtlvC0" +
+	"Aq3XrUBbtAKtIxaFy9km5wq0RS3QBnqzhB0lWRakXF1Os2glWaPHgaiYa8qRZAMBTEmaCX8JUY8K3AayYwKOOMqAFbD3jnyihRYt" +
+	"QXEMjxcqz4oZ09Ts9CF2+efraj76pPUe/jh6D79LvYdv8bYvspXRewRG79EuUYXdSFQhL3fYNuZQ6ypozKGRqMIWiSrsJFEVhJSh" +
+	"n3nB3m8V7H3e/4j4KvuYOXpliHpGajFgcRB0sIgVNamS+H6Y9bSdVRMWsfvrNDXG4CIOD32sPTDqMS3RKZbofGYEdFg/0cIaRYWk" +
+	"oM4kOqJ2Td9KdEpcaFiiw1qz11WNw/kJFg/ZOpjA6xggboU6pWl4yEJdCKHOg1AnA9ZCndZE++1CnWeEul5L+ZviIynqUpPZ11hH" +
+	"emV5DQhAeidQsVo6Tr7ZbzSbi2X4dWm/P9POSu4vB1r5dfzuSBU56Vf6+PNvI2zE3k2ipVHQ0rCy6m6txipAOR3kzgYUUqbRHpBz" +
+	"Ebl0OO0QAuxqWEhKy2q+5UtKkA9Lmi8ppP5mRNwFS4L4Yk0uGUo2xFF/BaP+cHjFMC7Wp/eOlq4frkVQgeGeUEIdkegjEEjCmsOp" +
+	"r7mAoritC7CVkpKFX+yG+p/Dzm0po7R63Xj1KGlKlxeYDy0Pq9lQSzoDk8mYFbPcRci62FVA64GpOpCqle2Y8RkYdywGcTCbKiYg" +
+	"h1/TGzq0G1rzpjT4Alg9M10cMhO7nK2G482YbsVvmzbuYNBaum0wBHOSLVTBwir+iKxIYBgKQcmJEgKtb54Bq0KInAPGOoswwvEn" +
+	"ClDRg+3IuMPMLgFntc3sMgKDrdLpzvAxY/r1TYs5lwn3iJbSgAhhNjmt7K6ZYV+bi9iG/Gn2bfFtq8ya4ZaaRsGOPFJVFxqXKafC" +
+	"BO8+1oXJFLKRttSR2BEsp71jO907Ln+n2nm21hqEBbJal95x+b8OdZl+8XkX2pjs9CRSgOFtFHgbX/M2KuNtFPE2/q1N3/I2vuVt" +
+	"JBpqiyBg+Rp2DsoU9a1CgBIhILB8jSF+WggI2fROfE1g+JpA+JqQhYCwVUMfQMccgq+hDdDSELiawBECAiMEGK7GM9wOcxS92CbG" +
+	"sNmvyUJOFOiVOMrjSQLB+JJA0JUkEH+5pKLtgpQV42+pluDOFbiSlo0k2tIEvIfwter0G8lr20jKYeaVUJpe5pydvSRqar2bQruV" +
+	"OhQ9m62kNLfSWkei2raS52wl1baVtCI0649tkz13OmylmOUVTcWvr/kZF7QUlMAymEE6G2msWXZSgWbVqavX8TwAf1KXV9YkbQwB" +
+	"G2c1f0q8h56/iCUVQ8inmwDtqWA9o3DFzuPtd9HKGbEWnoipFYFqu7PtVdu2bxt3bgk93vZKPBfqLChltmiz7b1s23tnue1bhJoz" +
+	"bH5v/M2vtFDjneXm9ztufs/Z/J45AqFPMJrNr6UKbUfwsflFVlZ28yvDhIvEwbrlp7TzznY97wCWmGc6jxi8cRCD1wkxeKLoVoIY" +
+	"8py/L6xkBkYEzZmWuzceB6T4GxeuMLa/9wm/0Ho98fzbwGLjb+UMEmATdR0f2WhhIpscgzvjBggvQZJNPEmx6bP5TzV0DkWX29SH" +
+	"HWH3AjJjLT6O9qr10iJdEJAh/aHmQ5NAyyuaP9HmSTaTG3amAwvkSjmYK83usO+Jlh9DTa7ni/3dc5RrIggCFbETkswBJx9SuTY4" +
+	"aQG0d5DzMhErx2uRiEaNxywPwJEoeEHHg45/pZvjQVWplqp5r4+o+Prms0RB44NZ+i0cNFuccCKFxCQlZ++iapLLTb5CaxqzzHRu" +
+	"bnIW0DrkxXNL9CZugvG2ErZCJ9WdlQKtNwi7B7Nrs8f5uAI5ajSUyNFkOeTA2bda06HXTIMmFZ+kM2cja6CxNtdkUgNwMj1aOQYO" +
+	"M6vn6aDSEVrC1oNK04U9GzUG8vRT9GDGBOV58Z/7chBFp4dlCC762i0LBttmtJSTfnG2G/E+YRX6EPvXleByEmGvRQATLaCzCCkZ" +
+	"ONiBpADkARR78Lg5+ew1olQ1EOGfRZ6irAGQGlwTAOQ4oYzJ4Vyq7Eipy/D0I0Y8e9kXgNttymxPHw0WPxFP3OKYAQu1ziibyKBl" +
+	"IgM7kYGdyEAmcim77ui8EMdoEOkVcqrtCK73flU7vk700j+j63RCeuyr2qNzd2h97iT9bkmSC7zTF18czgZX1bc6W0UgrnX1ofrP" +
+	"OszXgD+3yY56HrcqYqAvIj29nNeE62fCLpqI4H815tNfbE6LRfZjnrVoaQ36Z/H1CzBN/ZIJYaaeJvHwT5pcN8fgzOAWSC3hGSGi" +
+	"FpgvxecJZtiTNPhLPe+VHrtHD6V/R/c61Q17DKB4LOOtw2FQj3cA45VxKsZNdHm1dIfH55uWIt0npZM0BIlkRT4PfWrviQ6aEMBh" +
+	"kQUlJ5keYpFLcndfHzUKNQgyq6PP4CWB9rJmCGVY/RXtiWmxJLNwOm+weH1iyWXprLgfNPgkIC1fuFSOoGqWPrTMTMhezgVxWeDz" +
+	"zAhzQI8krQYDXzCkNTr6XLtxpZec8+jemjanxr8HWNfqo5lTY/osHkVCN0a/RuB+Unwbq+LbmO76WvZ+j3lf/ydLRTThmB4sZgea" +
+	"22zyHXuAVO9cj1Xi4kQe2JQ3AQ7e4uDYrm/IWesAz2fZfBn9ohIe4Achu5Lz5vM5l8W1FtnKruwzCud039fEOz3AeYtQOjJTOiJZ" +
+	"MvqHNKfMFZ1zt589OX63ub8DMoBct8+5tUe/dQ6tfaqgqtslW4fV4gnRrLGfOYBxmWhU+ASEUX3CsRu+xCDTfHREBDNB4b72nUH+" +
+	"bK1sBLNRSVQzaMBBkxNQw1a5PQmQTTskjAY3fgSGkaTXpaTI20HyZkOFiFReUQxPUyTQRihyzlUNq4na3OxBIu2ams7ZrnUdVVsH" +
+	"p82uylHsCKrwKhKrjQxTvaimStX0IH22VBByBUnUCCRdNsJpoF2TLrucpcvmWoap/yYjNlJ8oUNoJAKzVgF/VWHPq3g9tKGEtpCG" +
+	"Niku16ZeKbyMfTcLS6HukyN68KotsOIUbk9DNE/p4mtBLxXqRZr4ALpYNjjNgKa6hqVi7aNJrwInUsWa7kimYUyBn1kGK2+gR8V5" +
+	"3oX/S6L1omIvXs969MI5VkRl1kMFGIjinqQCDyfBgdWjNFg2g09RKU/5DDvbMCU4OExXbIWEbzwnNy9wzWwmFzZ1qYFETx/cmYlz" +
+	"Bx7k31jOeMELHBxE4EAsfwZwhduydBbPRKTpZ+uerh6zzpq+SE40ODUHUnuHTRD/Skn4q1OepC6LRPyLWL6ivpBoKPxWeq8PcR8i" +
+	"SqNM4nOjwPbSAtIOeQz0nl7oT+AQBvL9iffmdtpJ/L4gu7HAUTFpfbQqKoJ5qk90THU2dUdJaQaQWsTILj34D8To4Em/3eSE8ldy" +
+	"tha1cnipHJ1ZidPrtIkxRoZBXFxXE0VKBn9Bg0VaPsdpPhZ7+RCDCE7Di8VCCr2+xmai7bAE+BgAU7BlSakB2S9mKzieJMUZQFIR" +
+	"DsLK6duIT9p+xZPrJ7NrnDOaR4+O/wNw3ly6Ipz3OqRmlgO1i/kSaPwtfFWnqxv4CieOrhXD+wmn7tZ2rpBL9CSUFkNp8aF/PFOL" +
+	"N9kWpe2qbbvEbTNrW2JvUhg/cMKnYBAvsg0wRvQZI7LrnuhfCZUyZzDESFmjxQKjxQZSYAlS9IF7CBWuFCBhHOeLBoJfgTBgDuRc" +
+	"Hkd/YF/44nKSjIqyN4qC5wiYAHqowdP1ntLryhhTDpLzOYwkQPHAlPNzKNITFOlrLGdAZxaLBtIX2HYUdgbtCFzXcU00C9ckO84A" +
+	"LiCAZuCgYWEa5+jF0X4oTzvL17qsx51l/aoGn0gW85RZTFDZt5xmTdfaNZXVnWXXNOE1pZm4/8QYzsqFJv8XjhsxiT39yerHT5iT" +
+	"1cKVePpkNQTwK1nm3uXHN1EV6W/S+7zxQ2ctDJUfhKxd+ujXqQ8vrX9ZsTr5IdxdYO7+7Wv2XXqUqxL/VjyKf7agyi7XgZP+YnpP" +
+	"g0UeMQ4SLCtkySkRiUIcXvinbjMpMlMaagVdI5KIPyXmEFmBg+VbOQMONspYzQmy2dZaTiQ7Ov5h5Sej7IB3kIw7WKwTPQ74WuuO" +
+	"E5bM4g8kgcmPLY7yYjgGVtUOEvRMcyLre0hq4jMPxevZjTwYQfaBCjQuX2NFYoWROxUn3N9Q2omnT5gxOdrWXnm1Q+Vh15XPlMr7" +
+	"JQM5FV7BeVJRXFyGhWkDfSOqVCsmMXiZGOdvIGAnIqrfrZ1jPd5TLLhDRtLaG1zPkmnFeeAiCz3FdMdJkzYUd7vHuWtdT3PAJzTr" +
+	"6ckqhh1X0dermIqLcGgHpFcTYwoSidqBMfm8mGZMIuQATpwxQYSCr4gzJto1jNUKbOZEHlr+ErZWO43MOWSFaioLyxNIWJ47bHgT" +
+	"rd2iPfgNiZAjZF/cvREGKMuoKZvXyah54uuaGzeKmqWi6oCCQVgVqANRs9HgfFir1UYVH/yUw12+NgoEaX2zDsMBlEus/WbsoMcY" +
+	"P2hFl2fnl/2mnmdtVO9mKjsXGaT+1oM4W1kxg3VdIMfoeLgNh+1Znc/+1fdyal6V7vwmoQtfahpVonCtf1cJzh+3jQXnoQnmiCWK" +
+	"GhOG9NlvmHSE+duZrMvqT58GnovN6mimzLz8d7zsaXn53sCkvVw8BJYtFQZNohXhJwIvxggLODbkqS+guk9S4+kff4O5qdRPA/oh" +
+	"6HLqNtbnguEVO77lrh08weNXLR+IEhd7pErbosEyTMl24BA6EHSslckkTphPpZ95Q+nebwhBIfif7eMoJhOsqZwAkp6UCOPST73B" +
+	"YYqJPV/kLURgUKhroqSMEBtc0sMhUcO+zOVfVsv8g6pJIns9EHkQ6Mzk0vsa44G56Se+wUOl63laciwCHqivEfDMIg8erXzA83+2" +
+	"i79OkKlZOlwZS+6EkNP9J7QozyR/Dsu6cqjcpMAc0MfqmBZPYGuWwQOgfKM6ca0AgnACVeGkS8LJ9wnDAr7axDiLEDTm65qX4OBg" +
+	"UXrohGCAiHsyj2ds7pDwLMI6LMzNYr/hD/qMdp37JPMW7wlVMZsHNqxJJBUMr1EyPIActygRkGrckB7ETIe2KgIOVgJWeCKW/Kwc" +
+	"GE8yRaBn1YKWvrHWs8Jr/I9KKDurMcVhGLpMtpoFSSiqN2DtkEULeTKriVMouOoT6MUVojqvYICJkEybj4+yBq/OecUgFECmjxCg" +
+	"A/ICjh0iJvMKwvGFFZAvOKfrUPoyEHwuiIDN9MV6RIsJJARMwDR4mZjouA7xeELvWREohx15MCZxdyzh4khu1BsPMni4bLhZELEo" +
+	"5MNn1JgRzNgfMAQw8/yGSfZhmPsw5A+ZfsHC40sMOJzXS8P02LdMVlVQAEime0iYqX9FP0mYJTRPHPL0uBjaDL+FGBchQQatOYxI" +
+	"EtkDZDkgshyKGYp490bEglmBJy9aBgIrWcv5sGFCg+cjeis4jW8AZX9pJRtvwPff+44dpQ2NIteA4zVCs5PiFihuEGD3+e99946h" +
+	"zUBQ7U+H01Eqf6c+z1dCsNASjv6z59B1ur2xkyYcqjI6gILoAZJouThOMuGHj3ugR6S0/7yMieXiwB0ToEVcwlWDz0gWhoAh2W5g" +
+	"jpXHd/IhJML70AYQ3mcqj9p9dmdXCFY1XeNsi3LlCC5Cg6iMPHjpnwmS8yeIp5WX/ikeKH4QNyWynUyotzz+Y98PLAkCqpPmqA8l" +
+	"3aIByGZoG4Gnp6VCfw4qFIEK0RzOYFbYoQjTDaLzBaOwq5ZTxaels22lmJDweU0mJIFLSALETOgXGSDQhCQEISngp96ANiHUhITk" +
+	"SNb7I/RnIiWZkBi0PDflE/psEDe0Yjrr4w2xCCyxiBGMxdhjh8TOw8TiF5VevpIoc8KmbxdQ6RlFeEjeKBLC7mKvbIINctA0mtga" +
+	"LxIm5XG9YC2TEjtE+wn5JM9PYHFLzuIeallcs7QhLy3Yi7alpcmIwu25BW5dHclVTRQzjMdb1b+Q3v3XWFVMgmEBIrAAelVjT+zq" +
+	"9X9W8eWQfJVszlAf0tfm7v70uIw2r42L/0PlrONijvatYzHbqI1xGnIpIxSSba/nw5rnYKPml1xRqs5gNzbfaZveCzJvazd2kgD+" +
+	"wRpcfqLVwHwO1uUn/2+7ddl6ADDfF39PtTkgdDHP3vmaZ6/LefbO4zy7s3yezPidJvonbOwfCd/SInByHOh8/Z5bv2es2631HoVT" +
+	"d/oKVsLJDiqnanMzmtGoyBkyxGkpayqjbZGi+McRejAPxSTKKf6LkDWg/4d6GsE/2IJAskFxfaMEmhtpS5LYkbxEOMrnlNHhB3zQ" +
+	"x7UmRUI2jaqodF135RBWAPnAdd9ZRUjMpeGSBfUmxIAWW4wPBZgc2BObjQ8lYTxKSQHckIwOFg+ML1oqsS3PYHqAxmYusxfUYkOL" +
+	"OEluEFkPldHmmR7C8T3fQyU9VLqHmco16yFzQaaP3ZhHFNbGmcrMPAJoUWy0g3lExssa/SKqL3IsA6aUUilHh6OxxTLEMrsrmFYK" +
+	"0ko2cK9jkyRHO006FhlpMrPIFFyLDI09fi1CXCsxvDEB1NYZCxr4mIm3b2vhTcTBYXIUI7/pPLvpPLvputrUbZtO+A3asJvTcJu9" +
+	"Szan0TYE6FSmOzlmQkLRCVX0QRXFNTOex1SxYxFhj7SLdrB0kVcWD4WQdc+iB/nLDnzLeE29u+JXJaonTUmJ1SMVo93tT5sZf8RT" +
+	"zL4N2oGIHfSriWcsW40eMeKJrbcAy0Yxs/VWl2kzZ5U9iLRRA0y82HoLHPyngVTjNbb1FtnWG2e23oKpo2LrYFtvRQQBqqNIEBiw" +
+	"FSPgamDrjTNbb5ErSCKIG+IBpWCiLRpbby0zZARiyAisrRdn1cTWG6CCOPWzLBn09R2cqJa1kOlTnlh702c8HZ4ixi6/UPm+53f4" +
+	"L+B/wjj9ZyiAgjj9R49tgai4vsOXOD9MTEO5YMktSHc9t3yI/olX0PXvfR5Zor74ChyWSb/2cyND6df4+a/to8tf618xjOfff/55" +
+	"b3P6q6cqy+jmy1+jNz38/a//ztahtInP//xddFW5bniYZHA+0Joe+ycCpdnpb56in1/308f/mX5/z6/f7wMlMJN577/C1pA+gZ8P" +
+	"q/S5f6PfDwT0RU3cBYZvTf1taXHLY2xWOOrtaPSmAYug0AuVxOehJD4PJYk8UwJ+qfLUlUWTEML0GIqdMoQJVbEq0QjftJhlfFGW" +
+	"L8rmi7L7hT8g8VmR8Dpcz8E1rIIcUF9lVXKJbXj9fAUutw9XYJNLcOB6gHYMUHADRK9CS90Ihfr5NraXrHagIi8L2pH0Lkl2PsIS" +
+	"A72LAl95BZqB3iXP03//56b7eGrm6plJ/xUHaIqtrxPz+rt4XcDZyd5HmhE8qWdu3ga9bAy9bMy63xiKWRKpe5dsv5tr6b2PrlHN" +
+	"7UhuUgunt9Re34HVtM90U99DU6H4GBTpMohZugnEhDcdLL5Y8KazCS8QEx4txhCXa2ljzNsRm8XnxQj1IhhUjyLlpGc9pPcy9vty" +
+	"1h2YxedIZEoSx2RrzzJ/xVldlV9dMVYwKrONNSq01BL0uyIx79lZ16pgftGcTky9tJfw5sPvC3HkOJEofgkfXx/77hhHIUpjZJeI" +
+	"R5r+Svkk7PiJeeuf9q0a923Q8hDeYMtAb1cgd2PTTy9EWJWPRGxHuVBcB6nEYVNi8rYGL6Ri6zyfemN0ctH17F7oVv488ERDR/b1" +
+	"c68mb4MX+pCEjVfrGz67gjSUthME6f5oJYw7dHXoOSpDqBVWHn+lxOiz6RHErwUHUFjxf1F6+D+M8SmgQaRPOrcXpSfM3Vd9cLaq" +
+	"URYKJfE6iY8ZgcORxzk90tI25sWgFqgxv4Q5h38QFDiq6cMzIWZljnhYqiRGl0tEPao0qZXlzOvQri8C3xJGWsn6yOKQUUKJOXM7" +
+	"B2djbZS4zNck7UOjDMTZUhs2ayk9+E/GtgGjJd+xTpANaqyyArfy/PPBZrjiwyLNbtsSOedidhToH0n7tzaJ9Xo9axck1wC9gxoA" +
+	"yL0EWz0igQ7FEtwBp3i8a2kEJAJsaZRZxya+SNqInR75JxOsFFJCPAdchFGBMBNiFMziMg0o2Gn2SazP9kG5fiji8ODgO7DdXpax" +
+	"H7y9CTh9wwNrsR5UMxSq6eWoZqDNrN1SzUBTzUDiJN2TeEQ3l4a6LUM6PUM6PYd0ei7p9DLS6bmk03NJp5eRTs+QTtBLuhPyubIW" +
+	"SXCSM9FO7/S00+tAO0Voan9O/BPhVRYZFIz9CEIasCsXaBSYOj9Ho8IONKrQiUaBGBFD3kKoiPyADLW+SHZIFIzCI03foUse6JLH" +
+	"dMljuoRyGV0qWLrkgy4V2ukS6JDTFlabDaMNPutjwM1id5xQbyc8PEHytTDXDuGJ4zp70DqBQP+trCqZ6l1ZfaN2qD/T/5nj43Y+" +
+	"NVscapaxhSt6C8fp9teyspKrr6QP/L1gy/Qe6NwZNxfYwcAPxCN8Ra2In+tqYP/1eZSinC6CL5+cHYk4ZoxX5fCM/hDfA1vgE1ju" +
+	"0w99bYwmcoYj3EPTyNWXpPpydUp8unIiaVOP93JPayZcOve25u5QRnTpcWpmL5pBvSUZPY2dLveq64drkwHRcXrREANejHHnPhpz" +
+	"Pvo+ffGPajk9/YRaOex8N1zrw4ADpN9j39FwiIPnEXVaiUCD3PsoN2qlR00YMX37ylol4QMKcXVqd4PfxYMPc4MP7ZhPeTKcuSto" +
+	"2H0tw96FEU1iQy/GgZys8rXfMqaJcbfDQS6OIk9AF5+Kh3AsR9WdENXWJ8nug6LxjM884M1HvbEBGp6+skxfpTpJP4ccdvfKWizP" +
+	"dSw/rwPkhuvluHJVvABRAUTr0b8zxDHK3eHd8dy747l3Y85dKHdMcMOWd366K/flrtyXu3Jf7s19uTf3pX3ns1OlxP2ApUuHKUj3" +
+	"5zq7f9zPA/n8aO7z7M5L7WHasPNEMgoQjVpqzv93M+u+PqlcGoLQ5cU/rzLPFDZVAewzX5PA8QMJgddDxuthN44gzJPmfDwcv5Ag" +
+	"5xfCIgbUKp8u+oXtDuECz8dOtXzoPNg8o1ECuKd3DbF/EnHK7D0bMv8EgyyQbqSRboHVEeLelsAVIod2aaut1G/rxMUV4wybA5fr" +
+	"ZGrsVazjPCwBt1eO8WMfX1/jSDOoVPMxEaPKKEOVUUdUGWSo0vahypFxNEZw+sbP90KvYR8hUlUOjY5fcKy1YABE5HzFdWlsm39s" +
+	"K31dzbPPK5iBCs2Ar2egbGYgIzI0nS3TVGybpl08TWFumkI7Oy5S7dil17Z2dVdunJX2cVYwTot98y86jrQcO6Mstoxyea2g5yFo" +
+	"hYSM7OhEpPSdrbQUm8u2CouxcBGhRPMI10usXBbMitfXvEBgvBloKz1cIowNvqZ0XNqkuILVqCGfL9BFID2zXODLknD0JgkHhfb4" +
+	"6xX6rJQyUXtMdHXBRTKUMXcoiiEBZWilH9uRVHZkZ6zYy/UyT3u5tqGZvEvbDxrNfBhGXJOxkx3yWJMsKlNohwKJRsA+AK/gLDMc" +
+	"W58kaZYHRQaM2RfVHK4RWTGQMjcAtMsQg2k9lnhLKvVfkdPCxKqau9lBaYn3Bj42CG8Y+rm7WUoKb7IO91bupHf1ZjmJHrubPiy/" +
+	"CW4WfENS5pvgC/5TM5bKpPim92EsByVgo1ByQt6+Y2GFelKU9Ku0zgVOSMKD1lPAZ+Yw86Gouz/h+5FNBoXUFS+DhJF4S3b9hfcm" +
+	"TBx7A8H9My0P4ZRzgHCKnnww2izi5563Q38FeTc94W1EBLyR9GdpoYb0wb6AsDI7dJ7wNqyX71q+YI9RWtYlz3/yby/98aTYkDQo" +
+	"xUeoua97fNAUmN3nXgzxc6rVthiMWzMrX9PK61trCFq+kxOY290P5g5J0hY5jOGZCSAJxWeQC8W158GzprMLXkT4b+vNGbfji9mb" +
+	"Z30m+siE7Ml23K4hC1T/hhm0/V4GYH4E8u7L+DxSIva7kK2mhGL2ilcxdko/dgr2VAVVyL6bzUqzgsAkNh6BYjEDjFIroBUAitDh" +
+	"jAuKBgYVtrC6Afwv9DllmpdKQ83ArJQfiQneBS4D5ji4J0PsKmjbjk4LlJEAZb54B6CUQ072Aw2UfgaUPAMElIEGyqOcSg7+ibM1" +
+	"FsCvqr/bt+61D353DIeN8u4wv+67Rx58k5KLjyvomKMouwNlw3ZTlglnBSRTFr8CTh/Lvj82jsHRb+iUGuL9I6mFHe8f3/X+8XRq" +
+	"Ku39Y3MyGe8fz/H+8dq8f3zrcfJEJ4+TFu8fHSWiP93ZYXw2FEAWYOEbKv5Y3vVbR3EYxwOckULmAb7keXVf5gWexxj/L3iB/1ve" +
+	"F4Vn/l2Y+XKLsTMQiOKjZjB22qhp+rDz+1BI5zR+5j+yw846kUv6G1Ipv9//Xf1ePAd913PQ06DHrk8626j1HFTaqJ54medXYDwH" +
+	"lfYcDIznoHI8BwVuMh8zpKVmFw1PQhkyT7Eo0z3rY5lCiYRri+GCI4nPxBtWxwBBAjc5BR+/htPdskgVCKwhDBwLTBJ2URewnAdS" +
+	"bMkxVvbNRHSSYQV1axIsSXaiMH6iezDr99y9xLubnouSD2HrzKW6p4nPIbPec3fTp8u7wZJSKf3F3U3UczdnTrQ1oO4HdiCL6Y8r" +
+	"JNKBHOPJlC7VwaBevfmxZvgAFBqE1XC3cwefWAsf0Cn55JsHGhDcxIAtKRl9air+KFjA6J7MD3sxq5L9G7DYzLVgJ3xie3p54t/1" +
+	"CO3d9Y2I4BZBesCeNwjxy2HoQORx6OPrv+qLRzoL3fzk/T67zxFy5Y3dz1clZvqwkMTYwRePF660RD1A7OGrd+4AG4gbn28KS7bf" +
+	"LxzU3UnxTY/Rd9vvp8m+e8mrf4Yee/e9nVhAfn1fs/ymx94OJ5ek/HYct0MdCnVgXnBT2UGNFpeo+5pFllyy88Hup0X+eEfbnBk3" +
+	"5YWtyaITv/5rvhmTZwYsC6pMrI5Z4y2lXb8HzJI1w53oKM3hThsigxdYZr11KeMP3atKUPePefFXp60aGRm87faRZGRTsmrNmuSO" +
+	"dSNrk03bBodv2bDpjiWrNq6/bsutWwY3rrlleNNtN9IHw4NbtoxsMlerbtu0dePIT6zacht9tm7jlpFVG0fWrRoZXL1pzeCN69bc" +
+	"tuXWDatuHtwweOfg6q14unFkeNXqES59BxWi79ev23jr6q1U5W03U2NvlAdU2fDgGip3K9W1ZXj1jdtWbVi3ZtXIpuE1W0ayG/R1" +
+	"zfCqO+yD4cHV625fN7hxZOtGU9r8rti4buQNg1tu37Rxy+CGTbe+btOt6cjI8LqbqVvrB++iGrYOXrtq45oNg+aj69bdOkzlzO1r" +
+	"Nq2jOjduug1z8mNbB4fvWrVhw403r9qwauPqwS16OvQt5oM/ob5tuW3V8IgeFj+znd2iu7Zuk+nspmHUmT3ecjNerbmR2003bFgi" +
+	"1Zsu3bxh0+r1t2EVbrVTu5SW8q61g+tuXTsysu62wdVrV63bSAuBFRwcpn9HbryF5mbLG0fWLB0e3jSMeeKVvm3dxq30zZatN1M1" +
+	"a+lq68ZVW0fWbhpe99ZBKj28jnr91sEbB4eHt2zaOrx68PZVVBtuR1YN3zo4snETVUygsIaGuWbdRh7jjVtHbllorm9etWVw/rxb" +
+	"BzdSVatR7o26TpqWhOflDYObtw5uGVmUeJ53h1/3ptMvXqwbHE623LWFgDQZRJfxwUP0fgq9vxRwduntq1avpynYcumWkTWXErRc" +
+	"Onjn7ZuGR7ZcMryFvjlE3zboF9c15/5VLfc3tty/Qd+vXrWRRpfQBNOmGEleQxM9eOeI7BNax1XYOC5woUyJ/kL666G/XvpbE9Q9" +
+	"/LcquWbdlts3rLorWXfb7RsGb6P14IVOhgdHtg5vHFyTrNooo0y2bqRRDK4mAN5w16XDtEFWX3rl6stuufyy1VfcvPDyy69aMH/B" +
+	"ZavWXLFq8LLBhauvmnf5mstXr7rq8sGF8265nOdgwzoCRgIQmpRhmmOZiw9QP5bR737qYJl+12LmaOstWmTgZ9GiN9BWpbU1w1m9" +
+	"actt2Ko30uQuWjRy1+2DWxYtWrpxW6eiP06rdQutlynb6RvZZLTBO73EPNKrfJubAR2LFrlAcnXnXgH2X7V60/DgokW0G7du4NHg" +
+	"t+X7ddTMjWu5I4sWtezzOUn+W14NqpxubhT4W2Q2z6s69rN9n3YaKY+Gh3p2vc3jqLPr7Nm15ML0eW5ncCPtWwLKRYuWrNu4aviu" +
+	"s6m+02TqJRwHqAj5jgzKXg71H3bjBPqbCBxybpsLw7z0tlWrhzdtufS2TWtkf9U8+U/R3yTGHRs20Ka+6Q16NrZuvGN41e0Ds29K" +
+	"aM/TVr+JxnRTwsTH66PvZ+r+TUYlxbq3gn4qnuzVNxEmXANks2Utbej1TK+TDcC+w8nqVYQD143cddu6LVuAUGkTbqBmb3qc6gD+" +
+	"+SL9ok9rtt6+Yd1qmg7zBT17mt5NcL7ZunH9xk13bKReEYLeOEK1zElG1g4ODyar6G/jJvMC462XpGyDfvvplz41WCsx715Hv1X6" +
+	"paFev16Tg+TN6zaOXHb5wuRlL0suJnx+m/72HvrF+M1nQjUWJbvpeV3Pr8GtwP+0yLesu/V1gyPoIdYeg795kDgRXnRafkKuw0RP" +
+	"qZ41hDcI2vDkVPybWEUDIrJ2/1aSuXpUzzcxHiOXEGtgnn9MP7+NwCUBRbh5MAHjdJdT9lN67dz7STJ2QunrNia3Dd62afiuS24d" +
+	"3nRHAnZpeCt38U1r121JbicaRg9HiCjQI4Kcu5JbVhHYDK6x9X2R/iL626YR7c1ET29Zt3od7aFbmJSvvn3rjRs2bbp9C3iJWwf5" +
+	"WtrkS6YINDE3MtzcKG9uX7Vx3WrP+yq1UaS6nyjXeY4/V5Z1+yr9xoAT+sXYVKXOfTAYbBOAwzBCw0I81gzePrL2jk3D6+m7A7q+" +
+	"xVQO63ddRdppJRYtdGftqi1rB9dcv56mzvN+hcr4VObX6DfwIK1lcABa3aS/I5UfDhpbjPM0dhx8+KIiyXG/ZT7Kfs53UkLm1ODH" +
+	"l9DfLPr7kfOMH19Kf326jZfpdXTx5Qtpi4D+UsJMI2upqQerde9aqu+3CWiu8NJT7yVJ512F00k62HfDtOkMjzmIe4KVrYQ+VhEX" +
+	"ffOGwQTMJrjPaq3uvRy9r75wDD1Sk519X218DL27JtjRfHM2GPqgLvvpWjuGNu+eqclOf+Om2wZdKBigv9dv2ph7NhurtHXL8KVA" +
+	"JBsuXU2j23TpMGFc2gJ38XrcStO69eZLVm+67eLLBlevnn/5VVetufmqwdULL7/iUsHnF8+95LLLLpnLX69hUVGgY2FP3Xsz/f4Z" +
+	"DfIi5/6JlvujSiiBuf+blvefb3n/lZb3X2t5f7Ll/d+3vP92y/tnWt7/R8v777W8D/z8+4Kff38b5hSYK4PP2wgfrLudKEAOSJ0y" +
+	"z9Lfgpb7Wc79dzyRcNz72c79FAUOKN21l3bGZ6c7LYsEuHpk3JYvUvmacD/FuX9Jy3vcDzj3Tbqf7twPKOHFzP18JXKTub9KCa5w" +
+	"7yc691cr2X3m/joluMTc/4+W/tzWsjp3tLS/XQkNN/f30f1l9Lvitts30d4lRLAoYR5g00Zan7Wrtg0mczFxC4l2376V2IK7iHMA" +
+	"rd+wij5avZZ26xyZTNqZhrHZMLjx1pG1W+ZNqHtvOne8B3lXUCxtoF1U1zWe8LbufPxKy3g/qOfrer20yR0k7hN+2rB66wYilISb" +
+	"Nm0d4YFsveUWwlzS1az8L2i8fT6xANM6YIEVhj9807KF1MY1E6XNKz3he4ClgU03jdt33ekt695KLL9n5gTjnwrMieddljZtv53+" +
+	"+p2+jHqymzEHhgd5udfOs75pkvCsb964Zevt0EcQxmURMYG4uijZMEkw8ps1Nnd1HA/Suz7GvrQfV69NrDBDje6jd4D/14heghUx" +
+	"xNsI17QoIRBblBymbzDeL04SXq3lW6toOTVJ9Cjmu9WrNm4iZlB0PVqnJT0aXIPv+/vq3oX03dqttxHT2PmbxX2iuxlHN6M54OEt" +
+	"byBwIa7s9k1MehNigDdu3bCBKGBfnef2Kr3m+rstI6uGR7Ykq0b4M1PMy75/tf7e0THdBp2kq39yNVM5lVWLOquDwsvVh42vNdMT" +
+	"bZFoArVp4v3kZIGFdfRb4DXPKhudbOUjYkm3OGoxwEnC+rDdk2U9f2uy8MHyLTqN72gmNsnHY5MFpsx3LKfwIDP++CS9q2QwIQSY" +
+	"N5xFXSADzNGi8XBKnfk2szWX8ExmH8ybIn1bLvNulXRvmiKczVN9Ij/8Hf0Crz/bJ+MNqR/AQxP0bzJZ5IMr9Hy8Wj9/s5nsmwmU" +
+	"MKuDeV71FfRnlImDNDE3YCnp9/WbRpZh5Lrfb6Yl15cyAt1f+tJAhd4YPAB9nb2S/WV+N266ERvzRrMxsQ80W7Y12++mRq9nqszF" +
+	"7KkCB6n+ffNUkYV+mn5Bsy5xcAo4grktz0CHHp0q62jW47VvvP71+CNSRNuDUMVtt61KVt2CPUVMoVAh1jCAJpHos2p4mGQjAp/b" +
+	"Vt1+iS1I+/7iO9auo/WnHTuY0KDWbQBsEeuL8Q0Ob3Hq5Oou4dkFhwsWcctWmjXo2YnWrV0HlAUp604iCGiWm7mZaIpWpkJ0ZpTP" +
+	"7DLB3sULL7n+5iFiSxOSuBkX0FerNJBdYoa6lXATgJX/YQxgXwH8zfXGrbfdPDhsXw1uIeabeoi1IBH1kqWG/x2B1G0HyMwPkAy1" +
+	"y/2VUWZfb9L4c3AdmG366iYS3weJob7pllUbtuBiEz8FfrrpdK3c7Nbxsjkvu0kXfNnbX+aUw8Tekc1rMnDNa+bO/drou69ZtmzZ" +
+	"7LOtHlf/M1/72nW3rs1Vv1CqX3Lm6lHbItR2/TKsNolGBhXlp67Da72iHV5tTDYxBHQstoEA6RKo3oc3bXC6w6gNAKarPXeehLb2" +
+	"msGLh7ZgG5DYTMzJ5ZdcpiWUS7duFBhiMeXY9Lp3syeyMevpvBzn3iZZmu+v8IQPbfm+M79NYtfFawfvTNI3vmbFitYBZ314rye8" +
+	"8os0biu3H51R9/4n/V6oeUtz/076m+fcv03jY/c+ce7v9kRfY3kejbdFQcOI1xudWfdm0DdLhBcj8LuF+YKHZ9ZftLHSGzvYQ9TO" +
+	"/6J2HtdjNfef1fddrZ+XnvoYSVbvn3Q6yHhROGjq3YMXCKf6S55ITmfkeomcGy7flH2I/qY5df2hhmD3PnHuD3nscZG7/xHn/jlP" +
+	"uGhzr5RQOPd+pnPv0/38lvuGc19XQhnd+7JzP1GJ5c3cT2h539dyP6Xlvr+l/v6W99P0/dnojW64SPRGmSRJvPhtq2BXHt5xkXD8" +
+	"pv5LtVRt7hfr9gxRY7K+5a7bbiZ8SITnllu2DI4k1OExXc+TFwn39KWLRGe01JBb2C41FWbZdVUy/+Kb141kPbnEe5bKvMJpC2wh" +
+	"1npOIhxaa93jS5JCial7IgezWMxS8ouiQvqZRObqoCeSwCWXzr3s8ivmXTl/wcKr0iWvuWbpsuXXrnjtytdd9/rrb/ixN7zxTW/+" +
+	"8Z94y0/+1KqbV68ZvOXWteuG1m+4beOm2zcPbxnZuu2OO+9667mUyVq8+MYXVv4Vlz5/Vv95CH0cFYqlciXWj6q1nt76hImT+iZP" +
+	"mdo/bfqMmRdceFHSaL5k1o/I+5e+bGD2y18x52J3oha98uoffdWrz67pH4b/XvX889kA9DN3SvJzgbevft6dkfxc/CcO5Jz+o9E/" +
+	"/+qzHP/zP+zj39Coe3Po72na14++hP6a9Ef3V3qZ9AcaAT3oC9Cgbblr4+pLN0EyIOp5bFbdWw6JS2sNB2YbG+71t4NJabXhJjdB" +
+	"Q65tuG948xvfdOOS9DUr3/SG9DVLL1v/I4If+fHrVizJXi2xoqw7loUviLvReo+LWb4Dfpx3yRVmmMIX7KX+/CT93qO641xfAJKG" +
+	"Z9LFok2jrlylMbXuyZyX1r2foLZ3aI7K3N/fcv8znmjtzP3P0d8M5x78xkta7mvO/Stb7tOW+zdze+nevyBO7ZsXdKMDNyX/Rvd0" +
+	"1RbiHJl3vYXkZei/bhrYMHjLSPKjP5oMwwdr9k2VJMGTRbDSVOQhXZ9Ho9rPv0ysUEbvexN0L/TsYrq+92WiSzn5MrGbOjZwErjW" +
+	"JKLaSrZsWLd6cIvwA2vWYdGIjTE66nCgzjz+rd8sD93/27f+4fC/bLx60b9e/xvb39DzYw+ujX75tz5/30XTnjj+P14AX7521eUM" +
+	"JZoZp9sr5/PYbqK24Yd1kR7bj3qZLgTWjVe3PFuM8UMdQRzHtwZEtzKHWJdnB0QfdnJAdqT5Zcn7puy+b7bo58z9C5U1iEW55LK5" +
+	"8/Ly1OCdMPGtgzoWhvd5s4Vr+VxJ7BqvcTACdNdL6W9Zy/Pl547xOtplj87OWzJXbSDudc1dyc2biKu9Y3DNC2lr9eCGDWjl6pfX" +
+	"eQy3Bfk2bts6Qlxy1pb57oLgBe4xWteDL5d98I2Xyz749stlXV+jsR+ur9V7pvXbma+QPXMW+D9XL+bztXpfYs1WtrT7Ou/s/IOu" +
+	"c+D89V531mYXZoD9HKo3shbTr0EgnCN6458NxBa2jqSFjVsI0oEHbl81vB6asZFBz3y3JxAbG/ZU9hp6io23svCT+VV4b6Ey2L+m" +
+	"7HsCwcKymug2Uy6U37px3eatg4l0LVlxzaKEJBNRSA7euXYVweDgGlvPJaH01dzPC0Vi0qU3rrptMLlt1V2Jdt4cWcWyB0ln62jP" +
+	"sw2B1d22/B2h2CVzY5dxUcmtGzFO8+2HQ9mr5v6PQtHDaBoiw9q6ZZD1sRvXrGPwZas8hD6mKiN3wMpL4tjgllZeBEXoY1qX910s" +
+	"tpRX+9LeOFxL+xbhft9IKP5GmlCa0pcmb3xT+qalN16XvnElNs4b3vz61694/fLDF4vu/hHNEVxP9SXixk0X0AvfPjy4bd2mrVuw" +
+	"QQcH2VyzhQB+jeeZsj+ny5r7k1pSNfewlkNvdAOXFMvF26gRWvNFySWXJG93BsUYcb240rz3Ein/Di3Jm/v79P31zt66gf5+zBOf" +
+	"3ap+9ka938yegZ31zS3Pfhx7aesG7pS7V36C9+bGl40kzMnIit0xeHOCmb6NsFVuIbZcCnWSs2zedZeKnWyyxqda4BbvqHXbBmk2" +
+	"V63evHXd8KDAQKfq+AVV9hDVNdOp6y1OP8HN/RT9/Y+W5z/9AunDLbeNWOJw6lLxZ1qr7epvcXAY7lclt2wavo0gn01Ww6uIsJ3J" +
+	"8SrnO0VtcTs/N1dsIK/1RXrPfTS86o4btw2upg8NarNs2f65AhfX6HL/9Ik/Wja86TbYe3hdZZfn5we6SNE25p9Dz3eTp7UWd2za" +
+	"umEN1N1vHRzehEGykYRvYG3QX41sIgx8G/USW/6WdWzuECNi61eMmzt/ZWzHa9YRT9Gq1V7tGm+NBZztiux+aHTf0FZ95TLhc5Yw" +
+	"LeXhy+V1W0f47iyI2cBlYmeC5spcL7xc6l/jwMAg48w1g3fCbwCM183o/pZFYn0ilLFuS8K2H9zLh/SEYPRysdvefbnYs9c4e/cW" +
+	"+rsVMEd/Cf57W2VOZQ79JG9P3j5QGZgzu/I/1jjrtg5r2grANPUArK9eLjZu7H9oxubeOXfu3MvmXj73irnz5l45d/7cBXMXzr3q" +
+	"srmXXXbZ5Zddcdm8y668bP5lCy5beNlVl8+9/LLLL7/8isvnXX7l5fMvX3D5wsuvumLuFZddcfkVV1wx74orr5h/xYIrFl5x1by5" +
+	"8y6bd/m8K+bNm3flvPnzFsxbOO+qK+deedmVl195xZXzrrzyyvlXLrhy4ZVXzZ87/7L5l8+/Yv68+VfOnz9/wfyF869aMHfBZQsu" +
+	"X3DFgnkLrqTduWDBwgVXLZy78LKFly+8YuG8hVcunL9wwcKFC6+6irp4FTV/FVV9FRW7ih7JnJk5GKI/+FtuyPZO215++gqZizdq" +
+	"2mnu/2co3kNuORYKYK5fvZZx2qx5opcHjo3smptFHwbl503C5fBI5IfkhnnCU/84/cJPU95L6cyozxACUzpuvJ3zpF+/Ok80jrl+" +
+	"/X/FHVlwVMfx9Rxv3rWX0LWSdrXCQiy6VgKhlVYCgUFCApUkDOIGCZBIgIAIEjHEZfNWiHI4BMh2AoGCSA7mtAEnsUkAcwRz+eCo" +
+	"ModxYgQFFariArlctokJKDO7yAaK/7z9eK93Znp6unt6umd63zYt5OtEk9h2De/X54TXSLEOpTyj7i8aGhtFvsHtnPBabUrhne0e" +
+	"WMR1rsfgl56ChY1Iegz++VPlM58qn/Co/Gk6wjKA//MlDT39FQ93BzzrnXGPvT3uqYuIK/xiO/1m5IKBYemYLLyH3wMffwomShie" +
+	"nJmZOTW0ifxIZ/j8f8JWiKda7kXlhjMFTueG2wurIyxMKKHbUzhI6IfHKx76hbeahSqJVYe3vZcbPh2S/eEMcs0fPmHvwfHTmbI4" +
+	"TAv3O33hkgKPsMViX7pxdl29x+MNp0D0C1HUQ0+hP5wBMcMfzmSe4w9rdA/uxyX96Ijat4DbZBHXCPdsgz9s6UR0JVbyHlhorTdk" +
+	"RjGlsoyZrCiqQ0vQnUakxW612IgdR0T0UqMhhsYiJ45jCcgFidEenIbTUYaeCVk4G/WH7Wgn2kV2Kz+g+/QBeYi71XcWL1nV+mbW" +
+	"+AmrVq9LcP3TahtVfv+/mb6iKVNr3rvR0rqm7bWd7x44eOLkmY++vHmrWyKOiH7ZOf5AwaCykVNb1vDCvxw4ePKjs+du3pKIxRoq" +
+	"DRQUl5SNnFZX39K2afOZs+csjn7FZePr6lvbdvLKJ85cu3mry+IoLqurN1v+dOjwkYuXu75etnzV1m2Hj5w4dfbc1S9KN3zw6cmz" +
+	"58oqKsdPnFazYs3ad9/ff+TYyVOXHdExk6d89/3DbtMy75dfXrPaEuc3JLhqXn5lz96Dh36IjnEnloyoqJwwacq0V5a+d+Kzi//o" +
+	"+vrbhY1rmxb9zleTkunbvnf/kVPnLl/bOGT9hqy1iUePne2uqJw0mSk2e1/fnbvzG/yDip4vXtf2sHvMzxadPnP+wpXP//WwW/LU" +
+	"9G6+RpqHK/FEdgTftpq7B3p1sxM7FSA+kkMYBiYzh1Zli2DVDJMETcUKZljMDYNQrMtgjaIB7GAytbPxDLEoSxUZhjMwEIdsMwLE" +
+	"1afGM4/M6WOeps37cJzc/ABPZFF6jBppRBpzZE2OkyeyNFqipRODAM7W00mcrGPzbV6UVFROMnCTUohtuJDlKWm0udsRq/gcGdhj" +
+	"T7KZq0nzeqce9Zs3qI8WMGSNVc0/ZzQZ5qU4g5rd1Lxm3LHgllbsV4NTIs2/KubHqXlYk/OUEsWQm3Q3nkQmquay2AQtWi0n5kp5" +
+	"91YjhmR3kODVFGZQar7lCM6zL/amyry0lZiHcTy2WSQZgA8PcTVFGtWRldjBgSJoL0ckRKEY5LQkUJeSDHPwXHQIXUCfGRfVS+gy" +
+	"ugqd9Dq6je54usg99B/EFRWMvgWDKyrXbtnyB5mpuYMGj/vm/AUSGZvrHzd+6a49ez8Y2Bnx6oo1W35UP6F9FZV19VPe3x+fwBRN" +
+	"j4zJzQ/s2Hnlc9W/rm0H0woGz5q99rWGmjt3J83YuKmvt3pze8cft27f8c6BQx/KuhHlChQVj962/ZNP25kzrnefwUUnThLPc31S" +
+	"vAPyAqUjy6vGVI8TOlY7s37W3MbFLy9duXXX3n1Hz+/Zu+ST+Q2vT+v9EsVcJLMw+DLNZhfOtiWQZNVN0+hwYk01d8nJJJl4lRwd" +
+	"zHVBvxqtKeaGfDxTUbOiaRKOpzAkj4yiPqIxlQ3x9CWGmosDNI4Rg1WV+QdYBrBMRQumvFDhVVLHJUTGqBXEbRtmdTJNLlX6qov0" +
+	"oqGpcgHV5NEyUDum5qoZ7lJFM7dN612sa7KlVz7TctOJ3TxaWDfGKFW1kuL4UmWMKz/ISjQXHlHmx1ZFk3mtYK7T3A+2/pZlm2Yt" +
+	"0s0PVx5q8a290Dyi42/N+SyVoNoUrUTz0l7N+ybXjyL5zDFEiHr9PaXlUqr65vfBxGzsIEpw9Qoyl1qwyuyv15rfaY3K/JgSc2Ok" +
+	"MV51mq8GR+Dlz9uillelmRczcBxBwQUBCssl83K/cqIRtMwxvHyQ+fdCGUg1jc9BQWs6qTPGaeaePJclnahcpWVz47IrigVbcJMx" +
+	"kfEpQ/I4/V6ld0VwrBGFMWWqkzEVW1g/8+M+Wov8LDP86F4jfqgZ8hYSB9lDe8ajHtneHlhEfNGPwcLLGSw96WGJPG2RIy+c5JA3" +
+	"/WOM8SircEFNU0MorKjhvs+TbecLO4959Eo8Uhutlab2apciYtrdhqfTfTfdk5aa1Z7WsK02He3oTHff78yQHrYP3NLdOfABTM8F" +
+	"rSM32TLdv9t6Pc8Xm5SflTC95Bt3x8iuOdMrKhs6KjYfSqqsv3C9UrqaVCV1Xh+ddX36mK9udIw9f/v6WI+UWd0FR6qlBRKTMrgD" +
+	"gPgHSvWsKDvUMyQjBOQ5cMdP1gOqCrEEVD7DaRp+0QYeP69OFG6amMbXnYBoTBReQUNxgFA+NwREuArgFi/hFDDlFSASRXMzERA9" +
+	"8doMa8gNBbytwVt6OfJ8TLkNYUgP4RTk8A6RgBNQPvqpDxeUgvg7VwAFRgNihjIDkKrLI1F8yJPxW4H3R3VIVmEWAZmThJyIYDux" +
+	"8EcZbMD5jV3YhdxoCAKmANJVyMAvot7wK0yQCjL+gg+fU8oEPqTIGoIsRxHJ4jAFr2ogDx8g4DwIkYEDCkIbMFiAie4wOhkAKsHx" +
+	"JAm3Qq1HkmcjiYDmQVVIEmYSnIjCehQXYYEUxaln4iwQDOsLw2ThYxl8XD4YwPEiRPm4U5ECdwTTgDsVdrsItOAG/JZKmI+SeDGB" +
+	"tzh+Cf1e3we5tjyczXEV42QKymAwUI7KZxXUYMFAGdoBK1EhbgJEg5VhelwRQ4gRnJSFaATj/83pkfk9HlUr4ps5EGoM9ZiLkUoq" +
+	"oG+5HLgOwDreFxF/1yqHpCMjnMmZLDHOBnghGjGB7dcyFlg570pFV8Dp50uTBEVktHjm4yRUURBzkzew5Cf9FbBCNAUbx+IIYaBc" +
+	"Q4nE5jGp1uyShnYFj0k6wP8AY5D1XRu8AgA="
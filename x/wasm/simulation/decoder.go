@@ -0,0 +1,29 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	tmkv "github.com/tendermint/tendermint/libs/kv"
+
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+// DecodeStore unmarshals the code and contract infos of the KVPairs to print them, other values are printed as hex
+func DecodeStore(cdc *codec.Codec, kvA, kvB tmkv.Pair) string {
+	switch {
+	case bytes.HasPrefix(kvA.Key, types.CodeKeyPrefix):
+		var codeA, codeB types.CodeInfo
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &codeA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &codeB)
+		return fmt.Sprintf("%v\n%v", codeA, codeB)
+	case bytes.HasPrefix(kvA.Key, types.ContractKeyPrefix):
+		var contractA, contractB types.ContractInfo
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &contractA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &contractB)
+		return fmt.Sprintf("%v\n%v", contractA, contractB)
+	default:
+		return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+	}
+}
@@ -0,0 +1,44 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	tmkv "github.com/tendermint/tendermint/libs/kv"
+
+	"github.com/fetchai/fetchd/x/wasm/internal/keeper"
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := keeper.MakeTestCodec()
+	codeInfo := types.CodeInfoFixture()
+	contractInfo := types.ContractInfoFixture()
+	contractAddr := sdk.AccAddress(bytes.Repeat([]byte{1}, sdk.AddrLen))
+
+	specs := map[string]struct {
+		kv     tmkv.Pair
+		expLog string
+	}{
+		"code info": {
+			kv:     tmkv.Pair{Key: types.GetCodeKey(1), Value: cdc.MustMarshalBinaryBare(codeInfo)},
+			expLog: fmt.Sprintf("%v\n%v", codeInfo, codeInfo),
+		},
+		"contract info": {
+			kv:     tmkv.Pair{Key: types.GetContractAddressKey(contractAddr), Value: cdc.MustMarshalBinaryBare(contractInfo)},
+			expLog: fmt.Sprintf("%v\n%v", contractInfo, contractInfo),
+		},
+		"other": {
+			kv:     tmkv.Pair{Key: types.KeyLastCodeID, Value: []byte{0x01, 0xab}},
+			expLog: "01AB\n01AB",
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			assert.Equal(t, spec.expLog, DecodeStore(cdc, spec.kv, spec.kv))
+		})
+	}
+}
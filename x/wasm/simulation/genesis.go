@@ -0,0 +1,16 @@
+package simulation
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+// RandomizedGenState generates the wasm genesis of the simulation. It starts without codes and contracts, these are
+// created by the simulation operations.
+func RandomizedGenState(simState *module.SimulationState) {
+	genesis := types.GenesisState{
+		Params: types.DefaultParams(),
+	}
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
+}
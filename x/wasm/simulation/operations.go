@@ -0,0 +1,242 @@
+package simulation
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/fetchai/fetchd/x/wasm/internal/keeper"
+	"github.com/fetchai/fetchd/x/wasm/internal/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgStoreCode           = "op_weight_msg_store_code"
+	OpWeightMsgInstantiateContract = "op_weight_msg_instantiate_contract"
+	OpWeightMsgExecuteContract     = "op_weight_msg_execute_contract"
+	OpWeightMsgMigrateContract     = "op_weight_msg_migrate_contract"
+
+	DefaultWeightMsgStoreCode           = 10
+	DefaultWeightMsgInstantiateContract = 50
+	DefaultWeightMsgExecuteContract     = 80
+	DefaultWeightMsgMigrateContract     = 20
+)
+
+// simTxGas is the gas limit of the simulated txs, uploads need more than helpers.DefaultGenTxGas
+const simTxGas = 10000000
+
+// hackatom is the contract of the simulation corpus. Every contract is instantiated with a verifier that can
+// release the contract funds to the beneficiary, and migrated to another verifier.
+var hackatom []byte
+
+func init() {
+	var err error
+	if hackatom, err = base64.StdEncoding.DecodeString(hackatomWasmGzipBase64); err != nil {
+		panic(err)
+	}
+}
+
+// AccountKeeper defines the account expected by the simulation operations
+type AccountKeeper interface {
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authexported.Account
+}
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(appParams simulation.AppParams, cdc *codec.Codec, ak AccountKeeper, k keeper.Keeper) simulation.WeightedOperations {
+	var weightMsgStoreCode, weightMsgInstantiateContract, weightMsgExecuteContract, weightMsgMigrateContract int
+	appParams.GetOrGenerate(cdc, OpWeightMsgStoreCode, &weightMsgStoreCode, nil,
+		func(_ *rand.Rand) { weightMsgStoreCode = DefaultWeightMsgStoreCode })
+	appParams.GetOrGenerate(cdc, OpWeightMsgInstantiateContract, &weightMsgInstantiateContract, nil,
+		func(_ *rand.Rand) { weightMsgInstantiateContract = DefaultWeightMsgInstantiateContract })
+	appParams.GetOrGenerate(cdc, OpWeightMsgExecuteContract, &weightMsgExecuteContract, nil,
+		func(_ *rand.Rand) { weightMsgExecuteContract = DefaultWeightMsgExecuteContract })
+	appParams.GetOrGenerate(cdc, OpWeightMsgMigrateContract, &weightMsgMigrateContract, nil,
+		func(_ *rand.Rand) { weightMsgMigrateContract = DefaultWeightMsgMigrateContract })
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgStoreCode, SimulateMsgStoreCode(ak)),
+		simulation.NewWeightedOperation(weightMsgInstantiateContract, SimulateMsgInstantiateContract(ak, k)),
+		simulation.NewWeightedOperation(weightMsgExecuteContract, SimulateMsgExecuteContract(ak, k)),
+		simulation.NewWeightedOperation(weightMsgMigrateContract, SimulateMsgMigrateContract(ak, k)),
+	}
+}
+
+// SimulateMsgStoreCode generates a MsgStoreCode of a corpus contract, deduplicated or not
+func SimulateMsgStoreCode(ak AccountKeeper) simulation.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		simAccount, _ := simulation.RandomAcc(r, accs)
+		msg := types.MsgStoreCode{
+			Sender:       simAccount.Address,
+			WASMByteCode: hackatom,
+			NoDedup:      r.Intn(2) == 0,
+		}
+		if err := deliver(app, ctx, ak, chainID, msg, simAccount.PrivKey); err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgInstantiateContract generates a MsgInstantiateContract of a random code with random funds, verifier
+// and beneficiary
+func SimulateMsgInstantiateContract(ak AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		codeID, ok := randomCodeID(r, ctx, k)
+		if !ok {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		simAccount, _ := simulation.RandomAcc(r, accs)
+		// the contract needs funds to release
+		funds := simulation.RandSubsetCoins(r, ak.GetAccount(ctx, simAccount.Address).SpendableCoins(ctx.BlockTime()))
+		if funds.Empty() {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		verifier, _ := simulation.RandomAcc(r, accs)
+		beneficiary, _ := simulation.RandomAcc(r, accs)
+		initMsg, err := json.Marshal(map[string]sdk.AccAddress{
+			"verifier":    verifier.Address,
+			"beneficiary": beneficiary.Address,
+		})
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		msg := types.MsgInstantiateContract{
+			Sender:    simAccount.Address,
+			Admin:     simAccount.Address,
+			CodeID:    codeID,
+			Label:     fmt.Sprintf("simulation %d", r.Int()),
+			InitMsg:   initMsg,
+			InitFunds: funds,
+		}
+		if err := deliver(app, ctx, ak, chainID, msg, simAccount.PrivKey); err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgExecuteContract generates a MsgExecuteContract of the verifier of a random contract with funds,
+// that releases them to the beneficiary
+func SimulateMsgExecuteContract(ak AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		var funded []sdk.AccAddress
+		k.IterateContractInfo(ctx, func(addr sdk.AccAddress, _ types.ContractInfo) bool {
+			if acc := ak.GetAccount(ctx, addr); acc != nil && !acc.GetCoins().IsZero() {
+				funded = append(funded, addr)
+			}
+			return false
+		})
+		if len(funded) == 0 {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		contractAddr := funded[r.Intn(len(funded))]
+
+		res, err := k.QuerySmart(ctx, contractAddr, []byte(`{"verifier":{}}`))
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+		var config struct {
+			Verifier sdk.AccAddress `json:"verifier"`
+		}
+		if err := json.Unmarshal(res, &config); err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+		verifier, ok := simulation.FindAccount(accs, config.Verifier)
+		if !ok {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		msg := types.MsgExecuteContract{
+			Sender:   verifier.Address,
+			Contract: contractAddr,
+			Msg:      []byte(`{"release":{}}`),
+		}
+		if err := deliver(app, ctx, ak, chainID, msg, verifier.PrivKey); err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgMigrateContract generates a MsgMigrateContract of the admin of a random contract to a random code,
+// that sets a random verifier
+func SimulateMsgMigrateContract(ak AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		var contracts []sdk.AccAddress
+		k.IterateContractInfo(ctx, func(addr sdk.AccAddress, info types.ContractInfo) bool {
+			if _, ok := simulation.FindAccount(accs, info.Admin); ok {
+				contracts = append(contracts, addr)
+			}
+			return false
+		})
+		if len(contracts) == 0 {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		contractAddr := contracts[r.Intn(len(contracts))]
+		admin, _ := simulation.FindAccount(accs, k.GetContractInfo(ctx, contractAddr).Admin)
+		codeID, ok := randomCodeID(r, ctx, k)
+		if !ok {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		verifier, _ := simulation.RandomAcc(r, accs)
+		migrateMsg, err := json.Marshal(map[string]sdk.AccAddress{"verifier": verifier.Address})
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		msg := types.MsgMigrateContract{
+			Sender:     admin.Address,
+			Contract:   contractAddr,
+			CodeID:     codeID,
+			MigrateMsg: migrateMsg,
+		}
+		if err := deliver(app, ctx, ak, chainID, msg, admin.PrivKey); err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// randomCodeID returns a random stored code, false when there is none
+func randomCodeID(r *rand.Rand, ctx sdk.Context, k keeper.Keeper) (uint64, bool) {
+	var codeIDs []uint64
+	k.IterateCodeInfos(ctx, func(codeID uint64, _ types.CodeInfo) bool {
+		codeIDs = append(codeIDs, codeID)
+		return false
+	})
+	if len(codeIDs) == 0 {
+		return 0, false
+	}
+	return codeIDs[r.Intn(len(codeIDs))], true
+}
+
+// deliver signs the msg with the key of the sender and delivers it in a tx without fees
+func deliver(app *baseapp.BaseApp, ctx sdk.Context, ak AccountKeeper, chainID string, msg sdk.Msg, privKey crypto.PrivKey) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	account := ak.GetAccount(ctx, msg.GetSigners()[0])
+	tx := helpers.GenTx(
+		[]sdk.Msg{msg},
+		sdk.Coins{},
+		simTxGas,
+		chainID,
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		privKey,
+	)
+	if _, _, err := app.Deliver(tx); err != nil {
+		return fmt.Errorf("unable to deliver tx: %w", err)
+	}
+	return nil
+}
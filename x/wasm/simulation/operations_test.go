@@ -0,0 +1,15 @@
+package simulation
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCorpusMatchesTestdata(t *testing.T) {
+	wasmCode, err := ioutil.ReadFile("../internal/keeper/testdata/contract.wasm.gzip")
+	require.NoError(t, err)
+	assert.Equal(t, wasmCode, hackatom, "regenerate contracts_gen.go")
+}
@@ -0,0 +1,185 @@
+// Package wasmtest sets up the real wasm keeper with the modules it depends on, so that contracts can be tested
+// with Go integration tests outside of the wasm module.
+package wasmtest
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/fetchai/fetchd/x/wasm"
+)
+
+// SupportedFeatures are the features of the VM of the fetchd app
+const SupportedFeatures = "staking"
+
+// Keeper is the wasm keeper on a fresh in-memory chain state. The helpers fail the test on errors, the embedded
+// wasm.Keeper is there to test failing calls.
+type Keeper struct {
+	wasm.Keeper
+	// Ctx is the context the helpers run in, see NextBlock
+	Ctx sdk.Context
+
+	AccountKeeper auth.AccountKeeper
+	BankKeeper    bank.Keeper
+	StakingKeeper staking.Keeper
+
+	// Events are the events emitted by the last Instantiate or Execute
+	Events sdk.Events
+
+	t          *testing.T
+	tempDir    string
+	keyCounter uint64
+}
+
+// NewKeeper returns the keeper with the default message encoders and query plugins. Call Cleanup when done.
+func NewKeeper(t *testing.T) *Keeper {
+	return NewKeeperWithPlugins(t, nil, nil)
+}
+
+// NewKeeperWithPlugins returns the keeper with custom message encoders and query plugins, nil for the defaults.
+// Call Cleanup when done.
+func NewKeeperWithPlugins(t *testing.T, encoders *wasm.MessageEncoders, queriers *wasm.QueryPlugins) *Keeper {
+	tempDir, err := ioutil.TempDir("", "wasmtest")
+	require.NoError(t, err)
+	ctx, keepers := wasm.CreateTestInput(t, false, tempDir, SupportedFeatures, encoders, queriers)
+	return &Keeper{
+		Keeper:        keepers.WasmKeeper,
+		Ctx:           ctx,
+		AccountKeeper: keepers.AccountKeeper,
+		BankKeeper:    keepers.BankKeeper,
+		StakingKeeper: keepers.StakingKeeper,
+		t:             t,
+		tempDir:       tempDir,
+	}
+}
+
+// Cleanup removes the wasm cache of the keeper
+func (k *Keeper) Cleanup() {
+	os.RemoveAll(k.tempDir)
+}
+
+// NextBlock moves the context to the next block, 5 seconds later
+func (k *Keeper) NextBlock() {
+	k.Ctx = k.Ctx.WithBlockHeight(k.Ctx.BlockHeight() + 1).WithBlockTime(k.Ctx.BlockTime().Add(5 * time.Second))
+}
+
+// FundedAccount returns a new account with the coins. The addresses are the same in every test run.
+func (k *Keeper) FundedAccount(coins sdk.Coins) sdk.AccAddress {
+	k.keyCounter++
+	seed := make([]byte, 8)
+	binary.BigEndian.PutUint64(seed, k.keyCounter)
+	addr := sdk.AccAddress(ed25519.GenPrivKeyFromSecret(seed).PubKey().Address())
+
+	acc := auth.NewBaseAccountWithAddress(addr)
+	require.NoError(k.t, acc.SetCoins(coins))
+	k.AccountKeeper.SetAccount(k.Ctx, &acc)
+	return addr
+}
+
+// Balance returns the coins of the account
+func (k *Keeper) Balance(addr sdk.AccAddress) sdk.Coins {
+	return k.BankKeeper.GetCoins(k.Ctx, addr)
+}
+
+// StoreCodeFile uploads the wasm file, e.g. a build artifact, and returns the code id
+func (k *Keeper) StoreCodeFile(creator sdk.AccAddress, file string) uint64 {
+	wasmCode, err := ioutil.ReadFile(file)
+	require.NoError(k.t, err)
+	return k.StoreCode(creator, wasmCode)
+}
+
+// StoreCode uploads the wasm code and returns the code id
+func (k *Keeper) StoreCode(creator sdk.AccAddress, wasmCode []byte) uint64 {
+	codeID, err := k.Create(k.Ctx, creator, wasmCode, "", "", nil)
+	require.NoError(k.t, err)
+	return codeID
+}
+
+// Instantiate creates a contract of the code. The init msg is json encoded unless it is a []byte.
+func (k *Keeper) Instantiate(codeID uint64, creator sdk.AccAddress, initMsg interface{}, funds sdk.Coins) sdk.AccAddress {
+	ctx := k.Ctx.WithEventManager(sdk.NewEventManager())
+	contractAddr, _, err := k.Keeper.Instantiate(ctx, codeID, creator, nil, k.encode(initMsg), "wasmtest", funds)
+	require.NoError(k.t, err)
+	k.Events = ctx.EventManager().Events()
+	return contractAddr
+}
+
+// Execute calls the contract and returns the result data. The msg is json encoded unless it is a []byte.
+func (k *Keeper) Execute(contract, sender sdk.AccAddress, msg interface{}, funds sdk.Coins) []byte {
+	ctx := k.Ctx.WithEventManager(sdk.NewEventManager())
+	res, err := k.Keeper.Execute(ctx, contract, sender, k.encode(msg), funds)
+	require.NoError(k.t, err)
+	k.Events = ctx.EventManager().Events()
+	return res.Data
+}
+
+// Query runs the smart query and decodes the json response into out. The msg is json encoded unless it is a []byte.
+func (k *Keeper) Query(contract sdk.AccAddress, msg interface{}, out interface{}) {
+	res, err := k.QuerySmart(k.Ctx, contract, k.encode(msg))
+	require.NoError(k.t, err)
+	require.NoError(k.t, json.Unmarshal(res, out))
+}
+
+// RequireQuery asserts the json response of the smart query
+func (k *Keeper) RequireQuery(contract sdk.AccAddress, msg interface{}, expJSON string) {
+	res, err := k.QuerySmart(k.Ctx, contract, k.encode(msg))
+	require.NoError(k.t, err)
+	assert.JSONEq(k.t, expJSON, string(res))
+}
+
+// RawState returns the value of the key in the contract state, nil when it is not set
+func (k *Keeper) RawState(contract sdk.AccAddress, key []byte) []byte {
+	models := k.QueryRaw(k.Ctx, contract, key)
+	if len(models) == 0 {
+		return nil
+	}
+	return models[0].Value
+}
+
+// RequireEvent asserts that the last Instantiate or Execute emitted an event of the type with the attributes,
+// e.g. the type "wasm" for the attributes of the contract log
+func (k *Keeper) RequireEvent(eventType string, attrs ...sdk.Attribute) {
+	for _, e := range k.Events {
+		if e.Type == eventType && hasAttributes(e, attrs) {
+			return
+		}
+	}
+	require.Failf(k.t, "event not found", "no %q event with %v in %v", eventType, attrs, k.Events)
+}
+
+func hasAttributes(e sdk.Event, attrs []sdk.Attribute) bool {
+	for _, a := range attrs {
+		found := false
+		for _, ea := range e.Attributes {
+			if string(ea.Key) == a.Key && string(ea.Value) == a.Value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func (k *Keeper) encode(msg interface{}) []byte {
+	if bz, ok := msg.([]byte); ok {
+		return bz
+	}
+	bz, err := json.Marshal(msg)
+	require.NoError(k.t, err)
+	return bz
+}
@@ -0,0 +1,50 @@
+package wasmtest
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fetchai/fetchd/x/wasm"
+)
+
+func TestKeeper(t *testing.T) {
+	k := NewKeeper(t)
+	defer k.Cleanup()
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := k.FundedAccount(deposit)
+	verifier := k.FundedAccount(nil)
+	beneficiary := k.FundedAccount(nil)
+	assert.NotEqual(t, creator, verifier)
+
+	codeID := k.StoreCodeFile(creator, "../internal/keeper/testdata/contract.wasm")
+	initMsg := map[string]sdk.AccAddress{"verifier": verifier, "beneficiary": beneficiary}
+	contractAddr := k.Instantiate(codeID, creator, initMsg, deposit)
+	assert.Equal(t, deposit, k.Balance(contractAddr))
+	assert.True(t, k.Balance(creator).IsZero())
+
+	k.RequireQuery(contractAddr, []byte(`{"verifier":{}}`), `{"verifier":"`+verifier.String()+`"}`)
+	var res struct {
+		Verifier sdk.AccAddress `json:"verifier"`
+	}
+	k.Query(contractAddr, map[string]struct{}{"verifier": {}}, &res)
+	assert.Equal(t, verifier, res.Verifier)
+	assert.NotNil(t, k.RawState(contractAddr, []byte("config")))
+	assert.Nil(t, k.RawState(contractAddr, []byte("unknown")))
+
+	// failing calls go through the embedded keeper
+	_, err := k.Keeper.Execute(k.Ctx, contractAddr, beneficiary, []byte(`{"release":{}}`), nil)
+	assert.True(t, wasm.ErrExecuteFailed.Is(err), "got %+v", err)
+
+	k.NextBlock()
+	k.Execute(contractAddr, verifier, []byte(`{"release":{}}`), nil)
+	assert.Equal(t, deposit, k.Balance(beneficiary))
+	k.RequireEvent(wasm.CustomEventType,
+		sdk.NewAttribute(wasm.AttributeKeyContractAddr, contractAddr.String()),
+		sdk.NewAttribute("action", "release"),
+	)
+	require.NotEmpty(t, k.Events)
+}